@@ -512,3 +512,495 @@ func (c *Client) CheckQueryNodeDistribution(ctx context.Context, req *querypb.Ch
 		return client.CheckQueryNodeDistribution(ctx, req)
 	})
 }
+
+func (c *Client) GetClusterLoadSummary(ctx context.Context, req *querypb.GetClusterLoadSummaryRequest, opts ...grpc.CallOption) (*querypb.GetClusterLoadSummaryResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.GetClusterLoadSummaryResponse, error) {
+		return client.GetClusterLoadSummary(ctx, req)
+	})
+}
+
+func (c *Client) ForceSync(ctx context.Context, req *querypb.ForceSyncRequest, opts ...grpc.CallOption) (*querypb.ForceSyncResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.ForceSyncResponse, error) {
+		return client.ForceSync(ctx, req)
+	})
+}
+
+func (c *Client) GetTransferNodeStatus(ctx context.Context, req *querypb.GetTransferNodeStatusRequest, opts ...grpc.CallOption) (*querypb.GetTransferNodeStatusResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.GetTransferNodeStatusResponse, error) {
+		return client.GetTransferNodeStatus(ctx, req)
+	})
+}
+
+func (c *Client) TriggerCheckerRun(ctx context.Context, req *querypb.TriggerCheckerRunRequest, opts ...grpc.CallOption) (*querypb.TriggerCheckerRunResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.TriggerCheckerRunResponse, error) {
+		return client.TriggerCheckerRun(ctx, req)
+	})
+}
+
+func (c *Client) GetAvailabilitySLA(ctx context.Context, req *querypb.GetAvailabilitySLARequest, opts ...grpc.CallOption) (*querypb.GetAvailabilitySLAResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.GetAvailabilitySLAResponse, error) {
+		return client.GetAvailabilitySLA(ctx, req)
+	})
+}
+
+func (c *Client) GetReleaseProgress(ctx context.Context, req *querypb.GetReleaseProgressRequest, opts ...grpc.CallOption) (*querypb.GetReleaseProgressResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.GetReleaseProgressResponse, error) {
+		return client.GetReleaseProgress(ctx, req)
+	})
+}
+
+func (c *Client) GetTenantViolations(ctx context.Context, req *querypb.GetTenantViolationsRequest, opts ...grpc.CallOption) (*querypb.GetTenantViolationsResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.GetTenantViolationsResponse, error) {
+		return client.GetTenantViolations(ctx, req)
+	})
+}
+
+func (c *Client) CaptureBalanceLayout(ctx context.Context, req *querypb.CaptureBalanceLayoutRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*commonpb.Status, error) {
+		return client.CaptureBalanceLayout(ctx, req)
+	})
+}
+
+func (c *Client) ClearBalanceLayout(ctx context.Context, req *querypb.ClearBalanceLayoutRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*commonpb.Status, error) {
+		return client.ClearBalanceLayout(ctx, req)
+	})
+}
+
+func (c *Client) GetBalanceLayoutStatus(ctx context.Context, req *querypb.GetBalanceLayoutStatusRequest, opts ...grpc.CallOption) (*querypb.GetBalanceLayoutStatusResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.GetBalanceLayoutStatusResponse, error) {
+		return client.GetBalanceLayoutStatus(ctx, req)
+	})
+}
+
+func (c *Client) GetCollectionLoadInfo(ctx context.Context, req *querypb.GetCollectionLoadInfoRequest, opts ...grpc.CallOption) (*querypb.GetCollectionLoadInfoResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.GetCollectionLoadInfoResponse, error) {
+		return client.GetCollectionLoadInfo(ctx, req)
+	})
+}
+
+func (c *Client) RecommendReplicaCount(ctx context.Context, req *querypb.RecommendReplicaCountRequest, opts ...grpc.CallOption) (*querypb.RecommendReplicaCountResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.RecommendReplicaCountResponse, error) {
+		return client.RecommendReplicaCount(ctx, req)
+	})
+}
+
+func (c *Client) PauseTargetUpdates(ctx context.Context, req *querypb.PauseTargetUpdatesRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*commonpb.Status, error) {
+		return client.PauseTargetUpdates(ctx, req)
+	})
+}
+
+func (c *Client) ResumeTargetUpdates(ctx context.Context, req *querypb.ResumeTargetUpdatesRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*commonpb.Status, error) {
+		return client.ResumeTargetUpdates(ctx, req)
+	})
+}
+
+func (c *Client) GetNodeLoadHistory(ctx context.Context, req *querypb.GetNodeLoadHistoryRequest, opts ...grpc.CallOption) (*querypb.GetNodeLoadHistoryResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.GetNodeLoadHistoryResponse, error) {
+		return client.GetNodeLoadHistory(ctx, req)
+	})
+}
+
+func (c *Client) BlockShard(ctx context.Context, req *querypb.BlockShardRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*commonpb.Status, error) {
+		return client.BlockShard(ctx, req)
+	})
+}
+
+func (c *Client) UnblockShard(ctx context.Context, req *querypb.UnblockShardRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*commonpb.Status, error) {
+		return client.UnblockShard(ctx, req)
+	})
+}
+
+func (c *Client) GetResourceGroupDrift(ctx context.Context, req *querypb.GetResourceGroupDriftRequest, opts ...grpc.CallOption) (*querypb.GetResourceGroupDriftResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.GetResourceGroupDriftResponse, error) {
+		return client.GetResourceGroupDrift(ctx, req)
+	})
+}
+
+func (c *Client) CanStopNode(ctx context.Context, req *querypb.CanStopNodeRequest, opts ...grpc.CallOption) (*querypb.CanStopNodeResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.CanStopNodeResponse, error) {
+		return client.CanStopNode(ctx, req)
+	})
+}
+
+func (c *Client) MoveCollectionToResourceGroup(ctx context.Context, req *querypb.MoveCollectionToResourceGroupRequest, opts ...grpc.CallOption) (*querypb.MoveCollectionToResourceGroupResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.MoveCollectionToResourceGroupResponse, error) {
+		return client.MoveCollectionToResourceGroup(ctx, req)
+	})
+}
+
+func (c *Client) GetShardLeadersBatch(ctx context.Context, req *querypb.GetShardLeadersBatchRequest, opts ...grpc.CallOption) (*querypb.GetShardLeadersBatchResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.GetShardLeadersBatchResponse, error) {
+		return client.GetShardLeadersBatch(ctx, req)
+	})
+}
+
+func (c *Client) GetHandoffLag(ctx context.Context, req *querypb.GetHandoffLagRequest, opts ...grpc.CallOption) (*querypb.GetHandoffLagResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.GetHandoffLagResponse, error) {
+		return client.GetHandoffLag(ctx, req)
+	})
+}
+
+func (c *Client) CreateResourceGroupWithAutoFill(ctx context.Context, req *querypb.CreateResourceGroupWithAutoFillRequest, opts ...grpc.CallOption) (*querypb.CreateResourceGroupWithAutoFillResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.CreateResourceGroupWithAutoFillResponse, error) {
+		return client.CreateResourceGroupWithAutoFill(ctx, req)
+	})
+}
+
+func (c *Client) GetLoadInfo(ctx context.Context, req *querypb.GetLoadInfoRequest, opts ...grpc.CallOption) (*querypb.GetLoadInfoResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.GetLoadInfoResponse, error) {
+		return client.GetLoadInfo(ctx, req)
+	})
+}
+
+func (c *Client) ReleaseSegments(ctx context.Context, req *querypb.ReleaseSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*commonpb.Status, error) {
+		return client.ReleaseSegments(ctx, req)
+	})
+}
+
+func (c *Client) DryRunLoadBalance(ctx context.Context, req *querypb.LoadBalanceRequest, opts ...grpc.CallOption) (*querypb.DryRunLoadBalanceResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.DryRunLoadBalanceResponse, error) {
+		return client.DryRunLoadBalance(ctx, req)
+	})
+}
+
+func (c *Client) TransferNodeByFraction(ctx context.Context, req *querypb.TransferNodeByFractionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*commonpb.Status, error) {
+		return client.TransferNodeByFraction(ctx, req)
+	})
+}
+
+func (c *Client) CheckNodeHealth(ctx context.Context, req *querypb.CheckNodeHealthRequest, opts ...grpc.CallOption) (*querypb.CheckNodeHealthResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.CheckNodeHealthResponse, error) {
+		return client.CheckNodeHealth(ctx, req)
+	})
+}
+
+func (c *Client) DescribeChecker(ctx context.Context, req *querypb.DescribeCheckerRequest, opts ...grpc.CallOption) (*querypb.DescribeCheckerResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.DescribeCheckerResponse, error) {
+		return client.DescribeChecker(ctx, req)
+	})
+}
+
+func (c *Client) ListReplicas(ctx context.Context, req *querypb.ListReplicasRequest, opts ...grpc.CallOption) (*querypb.ListReplicasResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.ListReplicasResponse, error) {
+		return client.ListReplicas(ctx, req)
+	})
+}
+
+func (c *Client) CancelLoad(ctx context.Context, req *querypb.CancelLoadRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*commonpb.Status, error) {
+		return client.CancelLoad(ctx, req)
+	})
+}
+
+func (c *Client) GetLoadState(ctx context.Context, req *querypb.GetLoadStateRequest, opts ...grpc.CallOption) (*querypb.GetLoadStateResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.GetLoadStateResponse, error) {
+		return client.GetLoadState(ctx, req)
+	})
+}
+
+func (c *Client) RebalanceCollection(ctx context.Context, req *querypb.RebalanceCollectionRequest, opts ...grpc.CallOption) (*querypb.RebalanceCollectionResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.RebalanceCollectionResponse, error) {
+		return client.RebalanceCollection(ctx, req)
+	})
+}
+
+func (c *Client) DryRunRebalanceCollection(ctx context.Context, req *querypb.RebalanceCollectionRequest, opts ...grpc.CallOption) (*querypb.RebalanceCollectionResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.RebalanceCollectionResponse, error) {
+		return client.DryRunRebalanceCollection(ctx, req)
+	})
+}
+
+func (c *Client) GetResourceGroupUtilization(ctx context.Context, req *querypb.GetResourceGroupUtilizationRequest, opts ...grpc.CallOption) (*querypb.GetResourceGroupUtilizationResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.GetResourceGroupUtilizationResponse, error) {
+		return client.GetResourceGroupUtilization(ctx, req)
+	})
+}
+
+func (c *Client) SyncNewCreatedPartitions(ctx context.Context, req *querypb.SyncNewCreatedPartitionsRequest, opts ...grpc.CallOption) (*querypb.SyncNewCreatedPartitionsResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.SyncNewCreatedPartitionsResponse, error) {
+		return client.SyncNewCreatedPartitions(ctx, req)
+	})
+}
+
+func (c *Client) WatchCollections(ctx context.Context, req *querypb.WatchCollectionsRequest, opts ...grpc.CallOption) (querypb.QueryCoord_WatchCollectionsClient, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	ret, err := c.grpcClient.ReCall(ctx, func(client querypb.QueryCoordClient) (any, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+
+		return client.WatchCollections(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(querypb.QueryCoord_WatchCollectionsClient), nil
+}
+
+func (c *Client) SetCollectionBalanceMode(ctx context.Context, req *querypb.SetCollectionBalanceModeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*commonpb.Status, error) {
+		return client.SetCollectionBalanceMode(ctx, req)
+	})
+}
+
+func (c *Client) GetLoadBalanceStatus(ctx context.Context, req *querypb.GetLoadBalanceStatusRequest, opts ...grpc.CallOption) (*querypb.GetLoadBalanceStatusResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.GetLoadBalanceStatusResponse, error) {
+		return client.GetLoadBalanceStatus(ctx, req)
+	})
+}
+
+func (c *Client) SetBalancePolicy(ctx context.Context, req *querypb.SetBalancePolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*commonpb.Status, error) {
+		return client.SetBalancePolicy(ctx, req)
+	})
+}
+
+func (c *Client) DescribeReplica(ctx context.Context, req *querypb.DescribeReplicaRequest, opts ...grpc.CallOption) (*querypb.DescribeReplicaResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.DescribeReplicaResponse, error) {
+		return client.DescribeReplica(ctx, req)
+	})
+}
+
+func (c *Client) GetCollectionTargets(ctx context.Context, req *querypb.GetCollectionTargetsRequest, opts ...grpc.CallOption) (*querypb.GetCollectionTargetsResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.GetCollectionTargetsResponse, error) {
+		return client.GetCollectionTargets(ctx, req)
+	})
+}
+
+func (c *Client) DecommissionNode(ctx context.Context, req *querypb.DecommissionNodeRequest, opts ...grpc.CallOption) (*querypb.DecommissionNodeResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.DecommissionNodeResponse, error) {
+		return client.DecommissionNode(ctx, req)
+	})
+}
+
+func (c *Client) GetDecommissionProgress(ctx context.Context, req *querypb.GetDecommissionProgressRequest, opts ...grpc.CallOption) (*querypb.GetDecommissionProgressResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.GetDecommissionProgressResponse, error) {
+		return client.GetDecommissionProgress(ctx, req)
+	})
+}
@@ -185,6 +185,135 @@ func Test_NewClient(t *testing.T) {
 
 		r39, err := client.CheckQueryNodeDistribution(ctx, nil)
 		retCheck(retNotNil, r39, err)
+
+		r40, err := client.GetClusterLoadSummary(ctx, nil)
+		retCheck(retNotNil, r40, err)
+
+		r41, err := client.ForceSync(ctx, nil)
+		retCheck(retNotNil, r41, err)
+
+		r42, err := client.GetTransferNodeStatus(ctx, nil)
+		retCheck(retNotNil, r42, err)
+
+		r43, err := client.TriggerCheckerRun(ctx, nil)
+		retCheck(retNotNil, r43, err)
+
+		r44, err := client.GetAvailabilitySLA(ctx, nil)
+		retCheck(retNotNil, r44, err)
+
+		r45, err := client.GetReleaseProgress(ctx, nil)
+		retCheck(retNotNil, r45, err)
+
+		r46, err := client.GetTenantViolations(ctx, nil)
+		retCheck(retNotNil, r46, err)
+
+		r47, err := client.CaptureBalanceLayout(ctx, nil)
+		retCheck(retNotNil, r47, err)
+
+		r48, err := client.ClearBalanceLayout(ctx, nil)
+		retCheck(retNotNil, r48, err)
+
+		r49, err := client.GetBalanceLayoutStatus(ctx, nil)
+		retCheck(retNotNil, r49, err)
+
+		r50, err := client.GetCollectionLoadInfo(ctx, nil)
+		retCheck(retNotNil, r50, err)
+
+		r51, err := client.RecommendReplicaCount(ctx, nil)
+		retCheck(retNotNil, r51, err)
+
+		r52, err := client.PauseTargetUpdates(ctx, nil)
+		retCheck(retNotNil, r52, err)
+
+		r53, err := client.ResumeTargetUpdates(ctx, nil)
+		retCheck(retNotNil, r53, err)
+
+		r54, err := client.GetNodeLoadHistory(ctx, nil)
+		retCheck(retNotNil, r54, err)
+
+		r55, err := client.BlockShard(ctx, nil)
+		retCheck(retNotNil, r55, err)
+
+		r56, err := client.UnblockShard(ctx, nil)
+		retCheck(retNotNil, r56, err)
+
+		r57, err := client.GetResourceGroupDrift(ctx, nil)
+		retCheck(retNotNil, r57, err)
+
+		r58, err := client.CanStopNode(ctx, nil)
+		retCheck(retNotNil, r58, err)
+
+		r59, err := client.MoveCollectionToResourceGroup(ctx, nil)
+		retCheck(retNotNil, r59, err)
+
+		r60, err := client.GetShardLeadersBatch(ctx, nil)
+		retCheck(retNotNil, r60, err)
+
+		r61, err := client.GetHandoffLag(ctx, nil)
+		retCheck(retNotNil, r61, err)
+
+		r62, err := client.CreateResourceGroupWithAutoFill(ctx, nil)
+		retCheck(retNotNil, r62, err)
+
+		r63, err := client.GetLoadInfo(ctx, nil)
+		retCheck(retNotNil, r63, err)
+
+		r64, err := client.ReleaseSegments(ctx, nil)
+		retCheck(retNotNil, r64, err)
+
+		r65, err := client.DryRunLoadBalance(ctx, nil)
+		retCheck(retNotNil, r65, err)
+
+		r66, err := client.TransferNodeByFraction(ctx, nil)
+		retCheck(retNotNil, r66, err)
+
+		r67, err := client.CheckNodeHealth(ctx, nil)
+		retCheck(retNotNil, r67, err)
+
+		r68, err := client.DescribeChecker(ctx, nil)
+		retCheck(retNotNil, r68, err)
+
+		r69, err := client.ListReplicas(ctx, nil)
+		retCheck(retNotNil, r69, err)
+
+		r70, err := client.CancelLoad(ctx, nil)
+		retCheck(retNotNil, r70, err)
+
+		r71, err := client.GetLoadState(ctx, nil)
+		retCheck(retNotNil, r71, err)
+
+		r72, err := client.RebalanceCollection(ctx, nil)
+		retCheck(retNotNil, r72, err)
+
+		r73, err := client.GetResourceGroupUtilization(ctx, nil)
+		retCheck(retNotNil, r73, err)
+
+		r74, err := client.SyncNewCreatedPartitions(ctx, nil)
+		retCheck(retNotNil, r74, err)
+
+		r75, err := client.SetCollectionBalanceMode(ctx, nil)
+		retCheck(retNotNil, r75, err)
+
+		r76, err := client.GetLoadBalanceStatus(ctx, nil)
+		retCheck(retNotNil, r76, err)
+
+		r77, err := client.SetBalancePolicy(ctx, nil)
+		retCheck(retNotNil, r77, err)
+
+		r78, err := client.DescribeReplica(ctx, nil)
+		retCheck(retNotNil, r78, err)
+
+		r79, err := client.GetCollectionTargets(ctx, nil)
+		retCheck(retNotNil, r79, err)
+
+		r80, err := client.DecommissionNode(ctx, nil)
+		retCheck(retNotNil, r80, err)
+
+		r81, err := client.GetDecommissionProgress(ctx, nil)
+		retCheck(retNotNil, r81, err)
+
+		r82, err := client.DryRunRebalanceCollection(ctx, nil)
+		retCheck(retNotNil, r82, err)
 	}
 
 	client.(*Client).grpcClient = &mock.GRPCClientBase[querypb.QueryCoordClient]{
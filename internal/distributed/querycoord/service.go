@@ -486,3 +486,179 @@ func (s *Server) TransferChannel(ctx context.Context, req *querypb.TransferChann
 func (s *Server) CheckQueryNodeDistribution(ctx context.Context, req *querypb.CheckQueryNodeDistributionRequest) (*commonpb.Status, error) {
 	return s.queryCoord.CheckQueryNodeDistribution(ctx, req)
 }
+
+func (s *Server) GetClusterLoadSummary(ctx context.Context, req *querypb.GetClusterLoadSummaryRequest) (*querypb.GetClusterLoadSummaryResponse, error) {
+	return s.queryCoord.GetClusterLoadSummary(ctx, req)
+}
+
+func (s *Server) ForceSync(ctx context.Context, req *querypb.ForceSyncRequest) (*querypb.ForceSyncResponse, error) {
+	return s.queryCoord.ForceSync(ctx, req)
+}
+
+func (s *Server) GetTransferNodeStatus(ctx context.Context, req *querypb.GetTransferNodeStatusRequest) (*querypb.GetTransferNodeStatusResponse, error) {
+	return s.queryCoord.GetTransferNodeStatus(ctx, req)
+}
+
+func (s *Server) TriggerCheckerRun(ctx context.Context, req *querypb.TriggerCheckerRunRequest) (*querypb.TriggerCheckerRunResponse, error) {
+	return s.queryCoord.TriggerCheckerRun(ctx, req)
+}
+
+func (s *Server) GetAvailabilitySLA(ctx context.Context, req *querypb.GetAvailabilitySLARequest) (*querypb.GetAvailabilitySLAResponse, error) {
+	return s.queryCoord.GetAvailabilitySLA(ctx, req)
+}
+
+func (s *Server) GetReleaseProgress(ctx context.Context, req *querypb.GetReleaseProgressRequest) (*querypb.GetReleaseProgressResponse, error) {
+	return s.queryCoord.GetReleaseProgress(ctx, req)
+}
+
+func (s *Server) GetTenantViolations(ctx context.Context, req *querypb.GetTenantViolationsRequest) (*querypb.GetTenantViolationsResponse, error) {
+	return s.queryCoord.GetTenantViolations(ctx, req)
+}
+
+func (s *Server) CaptureBalanceLayout(ctx context.Context, req *querypb.CaptureBalanceLayoutRequest) (*commonpb.Status, error) {
+	return s.queryCoord.CaptureBalanceLayout(ctx, req)
+}
+
+func (s *Server) ClearBalanceLayout(ctx context.Context, req *querypb.ClearBalanceLayoutRequest) (*commonpb.Status, error) {
+	return s.queryCoord.ClearBalanceLayout(ctx, req)
+}
+
+func (s *Server) GetBalanceLayoutStatus(ctx context.Context, req *querypb.GetBalanceLayoutStatusRequest) (*querypb.GetBalanceLayoutStatusResponse, error) {
+	return s.queryCoord.GetBalanceLayoutStatus(ctx, req)
+}
+
+func (s *Server) GetCollectionLoadInfo(ctx context.Context, req *querypb.GetCollectionLoadInfoRequest) (*querypb.GetCollectionLoadInfoResponse, error) {
+	return s.queryCoord.GetCollectionLoadInfo(ctx, req)
+}
+
+func (s *Server) RecommendReplicaCount(ctx context.Context, req *querypb.RecommendReplicaCountRequest) (*querypb.RecommendReplicaCountResponse, error) {
+	return s.queryCoord.RecommendReplicaCount(ctx, req)
+}
+
+func (s *Server) PauseTargetUpdates(ctx context.Context, req *querypb.PauseTargetUpdatesRequest) (*commonpb.Status, error) {
+	return s.queryCoord.PauseTargetUpdates(ctx, req)
+}
+
+func (s *Server) ResumeTargetUpdates(ctx context.Context, req *querypb.ResumeTargetUpdatesRequest) (*commonpb.Status, error) {
+	return s.queryCoord.ResumeTargetUpdates(ctx, req)
+}
+
+func (s *Server) GetNodeLoadHistory(ctx context.Context, req *querypb.GetNodeLoadHistoryRequest) (*querypb.GetNodeLoadHistoryResponse, error) {
+	return s.queryCoord.GetNodeLoadHistory(ctx, req)
+}
+
+func (s *Server) BlockShard(ctx context.Context, req *querypb.BlockShardRequest) (*commonpb.Status, error) {
+	return s.queryCoord.BlockShard(ctx, req)
+}
+
+func (s *Server) UnblockShard(ctx context.Context, req *querypb.UnblockShardRequest) (*commonpb.Status, error) {
+	return s.queryCoord.UnblockShard(ctx, req)
+}
+
+func (s *Server) GetResourceGroupDrift(ctx context.Context, req *querypb.GetResourceGroupDriftRequest) (*querypb.GetResourceGroupDriftResponse, error) {
+	return s.queryCoord.GetResourceGroupDrift(ctx, req)
+}
+
+func (s *Server) CanStopNode(ctx context.Context, req *querypb.CanStopNodeRequest) (*querypb.CanStopNodeResponse, error) {
+	return s.queryCoord.CanStopNode(ctx, req)
+}
+
+func (s *Server) MoveCollectionToResourceGroup(ctx context.Context, req *querypb.MoveCollectionToResourceGroupRequest) (*querypb.MoveCollectionToResourceGroupResponse, error) {
+	return s.queryCoord.MoveCollectionToResourceGroup(ctx, req)
+}
+
+func (s *Server) GetShardLeadersBatch(ctx context.Context, req *querypb.GetShardLeadersBatchRequest) (*querypb.GetShardLeadersBatchResponse, error) {
+	return s.queryCoord.GetShardLeadersBatch(ctx, req)
+}
+
+func (s *Server) GetHandoffLag(ctx context.Context, req *querypb.GetHandoffLagRequest) (*querypb.GetHandoffLagResponse, error) {
+	return s.queryCoord.GetHandoffLag(ctx, req)
+}
+
+func (s *Server) CreateResourceGroupWithAutoFill(ctx context.Context, req *querypb.CreateResourceGroupWithAutoFillRequest) (*querypb.CreateResourceGroupWithAutoFillResponse, error) {
+	return s.queryCoord.CreateResourceGroupWithAutoFill(ctx, req)
+}
+
+func (s *Server) GetLoadInfo(ctx context.Context, req *querypb.GetLoadInfoRequest) (*querypb.GetLoadInfoResponse, error) {
+	return s.queryCoord.GetLoadInfo(ctx, req)
+}
+
+func (s *Server) ReleaseSegments(ctx context.Context, req *querypb.ReleaseSegmentsRequest) (*commonpb.Status, error) {
+	return s.queryCoord.ReleaseSegments(ctx, req)
+}
+
+func (s *Server) DryRunLoadBalance(ctx context.Context, req *querypb.LoadBalanceRequest) (*querypb.DryRunLoadBalanceResponse, error) {
+	return s.queryCoord.DryRunLoadBalance(ctx, req)
+}
+
+func (s *Server) TransferNodeByFraction(ctx context.Context, req *querypb.TransferNodeByFractionRequest) (*commonpb.Status, error) {
+	return s.queryCoord.TransferNodeByFraction(ctx, req)
+}
+
+func (s *Server) CheckNodeHealth(ctx context.Context, req *querypb.CheckNodeHealthRequest) (*querypb.CheckNodeHealthResponse, error) {
+	return s.queryCoord.CheckNodeHealth(ctx, req)
+}
+
+func (s *Server) DescribeChecker(ctx context.Context, req *querypb.DescribeCheckerRequest) (*querypb.DescribeCheckerResponse, error) {
+	return s.queryCoord.DescribeChecker(ctx, req)
+}
+
+func (s *Server) ListReplicas(ctx context.Context, req *querypb.ListReplicasRequest) (*querypb.ListReplicasResponse, error) {
+	return s.queryCoord.ListReplicas(ctx, req)
+}
+
+func (s *Server) CancelLoad(ctx context.Context, req *querypb.CancelLoadRequest) (*commonpb.Status, error) {
+	return s.queryCoord.CancelLoad(ctx, req)
+}
+
+func (s *Server) GetLoadState(ctx context.Context, req *querypb.GetLoadStateRequest) (*querypb.GetLoadStateResponse, error) {
+	return s.queryCoord.GetLoadState(ctx, req)
+}
+
+func (s *Server) RebalanceCollection(ctx context.Context, req *querypb.RebalanceCollectionRequest) (*querypb.RebalanceCollectionResponse, error) {
+	return s.queryCoord.RebalanceCollection(ctx, req)
+}
+
+func (s *Server) DryRunRebalanceCollection(ctx context.Context, req *querypb.RebalanceCollectionRequest) (*querypb.RebalanceCollectionResponse, error) {
+	return s.queryCoord.DryRunRebalanceCollection(ctx, req)
+}
+
+func (s *Server) GetResourceGroupUtilization(ctx context.Context, req *querypb.GetResourceGroupUtilizationRequest) (*querypb.GetResourceGroupUtilizationResponse, error) {
+	return s.queryCoord.GetResourceGroupUtilization(ctx, req)
+}
+
+func (s *Server) SyncNewCreatedPartitions(ctx context.Context, req *querypb.SyncNewCreatedPartitionsRequest) (*querypb.SyncNewCreatedPartitionsResponse, error) {
+	return s.queryCoord.SyncNewCreatedPartitions(ctx, req)
+}
+
+func (s *Server) WatchCollections(req *querypb.WatchCollectionsRequest, srv querypb.QueryCoord_WatchCollectionsServer) error {
+	return s.queryCoord.WatchCollections(req, srv)
+}
+
+func (s *Server) SetCollectionBalanceMode(ctx context.Context, req *querypb.SetCollectionBalanceModeRequest) (*commonpb.Status, error) {
+	return s.queryCoord.SetCollectionBalanceMode(ctx, req)
+}
+
+func (s *Server) GetLoadBalanceStatus(ctx context.Context, req *querypb.GetLoadBalanceStatusRequest) (*querypb.GetLoadBalanceStatusResponse, error) {
+	return s.queryCoord.GetLoadBalanceStatus(ctx, req)
+}
+
+func (s *Server) SetBalancePolicy(ctx context.Context, req *querypb.SetBalancePolicyRequest) (*commonpb.Status, error) {
+	return s.queryCoord.SetBalancePolicy(ctx, req)
+}
+
+func (s *Server) DescribeReplica(ctx context.Context, req *querypb.DescribeReplicaRequest) (*querypb.DescribeReplicaResponse, error) {
+	return s.queryCoord.DescribeReplica(ctx, req)
+}
+
+func (s *Server) GetCollectionTargets(ctx context.Context, req *querypb.GetCollectionTargetsRequest) (*querypb.GetCollectionTargetsResponse, error) {
+	return s.queryCoord.GetCollectionTargets(ctx, req)
+}
+
+func (s *Server) DecommissionNode(ctx context.Context, req *querypb.DecommissionNodeRequest) (*querypb.DecommissionNodeResponse, error) {
+	return s.queryCoord.DecommissionNode(ctx, req)
+}
+
+func (s *Server) GetDecommissionProgress(ctx context.Context, req *querypb.GetDecommissionProgressRequest) (*querypb.GetDecommissionProgressResponse, error) {
+	return s.queryCoord.GetDecommissionProgress(ctx, req)
+}
@@ -355,6 +355,357 @@ func Test_NewServer(t *testing.T) {
 			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
 		})
 
+		t.Run("GetClusterLoadSummary", func(t *testing.T) {
+			req := &querypb.GetClusterLoadSummaryRequest{}
+			mqc.EXPECT().GetClusterLoadSummary(mock.Anything, req).Return(&querypb.GetClusterLoadSummaryResponse{Status: merr.Success()}, nil)
+			resp, err := server.GetClusterLoadSummary(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("ForceSync", func(t *testing.T) {
+			req := &querypb.ForceSyncRequest{}
+			mqc.EXPECT().ForceSync(mock.Anything, req).Return(&querypb.ForceSyncResponse{Status: merr.Success()}, nil)
+			resp, err := server.ForceSync(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("GetTransferNodeStatus", func(t *testing.T) {
+			req := &querypb.GetTransferNodeStatusRequest{}
+			mqc.EXPECT().GetTransferNodeStatus(mock.Anything, req).Return(&querypb.GetTransferNodeStatusResponse{Status: merr.Success()}, nil)
+			resp, err := server.GetTransferNodeStatus(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("TriggerCheckerRun", func(t *testing.T) {
+			req := &querypb.TriggerCheckerRunRequest{}
+			mqc.EXPECT().TriggerCheckerRun(mock.Anything, req).Return(&querypb.TriggerCheckerRunResponse{Status: merr.Success()}, nil)
+			resp, err := server.TriggerCheckerRun(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("GetAvailabilitySLA", func(t *testing.T) {
+			req := &querypb.GetAvailabilitySLARequest{}
+			mqc.EXPECT().GetAvailabilitySLA(mock.Anything, req).Return(&querypb.GetAvailabilitySLAResponse{Status: merr.Success()}, nil)
+			resp, err := server.GetAvailabilitySLA(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("GetReleaseProgress", func(t *testing.T) {
+			req := &querypb.GetReleaseProgressRequest{}
+			mqc.EXPECT().GetReleaseProgress(mock.Anything, req).Return(&querypb.GetReleaseProgressResponse{Status: merr.Success()}, nil)
+			resp, err := server.GetReleaseProgress(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("GetTenantViolations", func(t *testing.T) {
+			req := &querypb.GetTenantViolationsRequest{}
+			mqc.EXPECT().GetTenantViolations(mock.Anything, req).Return(&querypb.GetTenantViolationsResponse{Status: merr.Success()}, nil)
+			resp, err := server.GetTenantViolations(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("CaptureBalanceLayout", func(t *testing.T) {
+			req := &querypb.CaptureBalanceLayoutRequest{}
+			mqc.EXPECT().CaptureBalanceLayout(mock.Anything, req).Return(merr.Success(), nil)
+			resp, err := server.CaptureBalanceLayout(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		})
+
+		t.Run("ClearBalanceLayout", func(t *testing.T) {
+			req := &querypb.ClearBalanceLayoutRequest{}
+			mqc.EXPECT().ClearBalanceLayout(mock.Anything, req).Return(merr.Success(), nil)
+			resp, err := server.ClearBalanceLayout(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		})
+
+		t.Run("GetBalanceLayoutStatus", func(t *testing.T) {
+			req := &querypb.GetBalanceLayoutStatusRequest{}
+			mqc.EXPECT().GetBalanceLayoutStatus(mock.Anything, req).Return(&querypb.GetBalanceLayoutStatusResponse{Status: merr.Success()}, nil)
+			resp, err := server.GetBalanceLayoutStatus(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("GetCollectionLoadInfo", func(t *testing.T) {
+			req := &querypb.GetCollectionLoadInfoRequest{}
+			mqc.EXPECT().GetCollectionLoadInfo(mock.Anything, req).Return(&querypb.GetCollectionLoadInfoResponse{Status: merr.Success()}, nil)
+			resp, err := server.GetCollectionLoadInfo(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("RecommendReplicaCount", func(t *testing.T) {
+			req := &querypb.RecommendReplicaCountRequest{}
+			mqc.EXPECT().RecommendReplicaCount(mock.Anything, req).Return(&querypb.RecommendReplicaCountResponse{Status: merr.Success()}, nil)
+			resp, err := server.RecommendReplicaCount(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("PauseTargetUpdates", func(t *testing.T) {
+			req := &querypb.PauseTargetUpdatesRequest{}
+			mqc.EXPECT().PauseTargetUpdates(mock.Anything, req).Return(merr.Success(), nil)
+			resp, err := server.PauseTargetUpdates(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		})
+
+		t.Run("ResumeTargetUpdates", func(t *testing.T) {
+			req := &querypb.ResumeTargetUpdatesRequest{}
+			mqc.EXPECT().ResumeTargetUpdates(mock.Anything, req).Return(merr.Success(), nil)
+			resp, err := server.ResumeTargetUpdates(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		})
+
+		t.Run("GetNodeLoadHistory", func(t *testing.T) {
+			req := &querypb.GetNodeLoadHistoryRequest{}
+			mqc.EXPECT().GetNodeLoadHistory(mock.Anything, req).Return(&querypb.GetNodeLoadHistoryResponse{Status: merr.Success()}, nil)
+			resp, err := server.GetNodeLoadHistory(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("BlockShard", func(t *testing.T) {
+			req := &querypb.BlockShardRequest{}
+			mqc.EXPECT().BlockShard(mock.Anything, req).Return(merr.Success(), nil)
+			resp, err := server.BlockShard(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		})
+
+		t.Run("UnblockShard", func(t *testing.T) {
+			req := &querypb.UnblockShardRequest{}
+			mqc.EXPECT().UnblockShard(mock.Anything, req).Return(merr.Success(), nil)
+			resp, err := server.UnblockShard(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		})
+
+		t.Run("GetResourceGroupDrift", func(t *testing.T) {
+			req := &querypb.GetResourceGroupDriftRequest{}
+			mqc.EXPECT().GetResourceGroupDrift(mock.Anything, req).Return(&querypb.GetResourceGroupDriftResponse{Status: merr.Success()}, nil)
+			resp, err := server.GetResourceGroupDrift(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("CanStopNode", func(t *testing.T) {
+			req := &querypb.CanStopNodeRequest{}
+			mqc.EXPECT().CanStopNode(mock.Anything, req).Return(&querypb.CanStopNodeResponse{Status: merr.Success()}, nil)
+			resp, err := server.CanStopNode(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("MoveCollectionToResourceGroup", func(t *testing.T) {
+			req := &querypb.MoveCollectionToResourceGroupRequest{}
+			mqc.EXPECT().MoveCollectionToResourceGroup(mock.Anything, req).Return(&querypb.MoveCollectionToResourceGroupResponse{Status: merr.Success()}, nil)
+			resp, err := server.MoveCollectionToResourceGroup(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("GetShardLeadersBatch", func(t *testing.T) {
+			req := &querypb.GetShardLeadersBatchRequest{}
+			mqc.EXPECT().GetShardLeadersBatch(mock.Anything, req).Return(&querypb.GetShardLeadersBatchResponse{Status: merr.Success()}, nil)
+			resp, err := server.GetShardLeadersBatch(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("GetHandoffLag", func(t *testing.T) {
+			req := &querypb.GetHandoffLagRequest{}
+			mqc.EXPECT().GetHandoffLag(mock.Anything, req).Return(&querypb.GetHandoffLagResponse{Status: merr.Success()}, nil)
+			resp, err := server.GetHandoffLag(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("CreateResourceGroupWithAutoFill", func(t *testing.T) {
+			req := &querypb.CreateResourceGroupWithAutoFillRequest{}
+			mqc.EXPECT().CreateResourceGroupWithAutoFill(mock.Anything, req).Return(&querypb.CreateResourceGroupWithAutoFillResponse{Status: merr.Success()}, nil)
+			resp, err := server.CreateResourceGroupWithAutoFill(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("GetLoadInfo", func(t *testing.T) {
+			req := &querypb.GetLoadInfoRequest{}
+			mqc.EXPECT().GetLoadInfo(mock.Anything, req).Return(&querypb.GetLoadInfoResponse{Status: merr.Success()}, nil)
+			resp, err := server.GetLoadInfo(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("ReleaseSegments", func(t *testing.T) {
+			req := &querypb.ReleaseSegmentsRequest{}
+			mqc.EXPECT().ReleaseSegments(mock.Anything, req).Return(merr.Success(), nil)
+			resp, err := server.ReleaseSegments(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		})
+
+		t.Run("DryRunLoadBalance", func(t *testing.T) {
+			req := &querypb.LoadBalanceRequest{}
+			mqc.EXPECT().DryRunLoadBalance(mock.Anything, req).Return(&querypb.DryRunLoadBalanceResponse{Status: merr.Success()}, nil)
+			resp, err := server.DryRunLoadBalance(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("TransferNodeByFraction", func(t *testing.T) {
+			req := &querypb.TransferNodeByFractionRequest{}
+			mqc.EXPECT().TransferNodeByFraction(mock.Anything, req).Return(merr.Success(), nil)
+			resp, err := server.TransferNodeByFraction(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		})
+
+		t.Run("CheckNodeHealth", func(t *testing.T) {
+			req := &querypb.CheckNodeHealthRequest{}
+			mqc.EXPECT().CheckNodeHealth(mock.Anything, req).Return(&querypb.CheckNodeHealthResponse{Status: merr.Success()}, nil)
+			resp, err := server.CheckNodeHealth(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("DescribeChecker", func(t *testing.T) {
+			req := &querypb.DescribeCheckerRequest{}
+			mqc.EXPECT().DescribeChecker(mock.Anything, req).Return(&querypb.DescribeCheckerResponse{Status: merr.Success()}, nil)
+			resp, err := server.DescribeChecker(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("ListReplicas", func(t *testing.T) {
+			req := &querypb.ListReplicasRequest{}
+			mqc.EXPECT().ListReplicas(mock.Anything, req).Return(&querypb.ListReplicasResponse{Status: merr.Success()}, nil)
+			resp, err := server.ListReplicas(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("CancelLoad", func(t *testing.T) {
+			req := &querypb.CancelLoadRequest{}
+			mqc.EXPECT().CancelLoad(mock.Anything, req).Return(merr.Success(), nil)
+			resp, err := server.CancelLoad(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		})
+
+		t.Run("GetLoadState", func(t *testing.T) {
+			req := &querypb.GetLoadStateRequest{}
+			mqc.EXPECT().GetLoadState(mock.Anything, req).Return(&querypb.GetLoadStateResponse{Status: merr.Success()}, nil)
+			resp, err := server.GetLoadState(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("RebalanceCollection", func(t *testing.T) {
+			req := &querypb.RebalanceCollectionRequest{}
+			mqc.EXPECT().RebalanceCollection(mock.Anything, req).Return(&querypb.RebalanceCollectionResponse{Status: merr.Success()}, nil)
+			resp, err := server.RebalanceCollection(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("DryRunRebalanceCollection", func(t *testing.T) {
+			req := &querypb.RebalanceCollectionRequest{}
+			mqc.EXPECT().DryRunRebalanceCollection(mock.Anything, req).Return(&querypb.RebalanceCollectionResponse{Status: merr.Success()}, nil)
+			resp, err := server.DryRunRebalanceCollection(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("GetResourceGroupUtilization", func(t *testing.T) {
+			req := &querypb.GetResourceGroupUtilizationRequest{}
+			mqc.EXPECT().GetResourceGroupUtilization(mock.Anything, req).Return(&querypb.GetResourceGroupUtilizationResponse{Status: merr.Success()}, nil)
+			resp, err := server.GetResourceGroupUtilization(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("SyncNewCreatedPartitions", func(t *testing.T) {
+			req := &querypb.SyncNewCreatedPartitionsRequest{}
+			mqc.EXPECT().SyncNewCreatedPartitions(mock.Anything, req).Return(&querypb.SyncNewCreatedPartitionsResponse{Status: merr.Success()}, nil)
+			resp, err := server.SyncNewCreatedPartitions(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("WatchCollections", func(t *testing.T) {
+			req := &querypb.WatchCollectionsRequest{}
+			mqc.EXPECT().WatchCollections(req, mock.Anything).Return(nil)
+			err := server.WatchCollections(req, nil)
+			assert.NoError(t, err)
+		})
+
+		t.Run("SetCollectionBalanceMode", func(t *testing.T) {
+			req := &querypb.SetCollectionBalanceModeRequest{}
+			mqc.EXPECT().SetCollectionBalanceMode(mock.Anything, req).Return(merr.Success(), nil)
+			resp, err := server.SetCollectionBalanceMode(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		})
+
+		t.Run("GetLoadBalanceStatus", func(t *testing.T) {
+			req := &querypb.GetLoadBalanceStatusRequest{}
+			mqc.EXPECT().GetLoadBalanceStatus(mock.Anything, req).Return(&querypb.GetLoadBalanceStatusResponse{Status: merr.Success()}, nil)
+			resp, err := server.GetLoadBalanceStatus(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("SetBalancePolicy", func(t *testing.T) {
+			req := &querypb.SetBalancePolicyRequest{}
+			mqc.EXPECT().SetBalancePolicy(mock.Anything, req).Return(merr.Success(), nil)
+			resp, err := server.SetBalancePolicy(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		})
+
+		t.Run("DescribeReplica", func(t *testing.T) {
+			req := &querypb.DescribeReplicaRequest{}
+			mqc.EXPECT().DescribeReplica(mock.Anything, req).Return(&querypb.DescribeReplicaResponse{Status: merr.Success()}, nil)
+			resp, err := server.DescribeReplica(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("GetCollectionTargets", func(t *testing.T) {
+			req := &querypb.GetCollectionTargetsRequest{}
+			mqc.EXPECT().GetCollectionTargets(mock.Anything, req).Return(&querypb.GetCollectionTargetsResponse{Status: merr.Success()}, nil)
+			resp, err := server.GetCollectionTargets(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("DecommissionNode", func(t *testing.T) {
+			req := &querypb.DecommissionNodeRequest{}
+			mqc.EXPECT().DecommissionNode(mock.Anything, req).Return(&querypb.DecommissionNodeResponse{Status: merr.Success()}, nil)
+			resp, err := server.DecommissionNode(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("GetDecommissionProgress", func(t *testing.T) {
+			req := &querypb.GetDecommissionProgressRequest{}
+			mqc.EXPECT().GetDecommissionProgress(mock.Anything, req).Return(&querypb.GetDecommissionProgressResponse{Status: merr.Success()}, nil)
+			resp, err := server.GetDecommissionProgress(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
 		err = server.Stop()
 		assert.NoError(t, err)
 	}
@@ -168,4 +168,26 @@ type QueryCoordCatalog interface {
 	SaveCollectionTargets(target ...*querypb.CollectionTarget) error
 	RemoveCollectionTarget(collectionID int64) error
 	GetCollectionTargets() (map[int64]*querypb.CollectionTarget, error)
+
+	SaveLoadCheckpoints(checkpoints ...*querypb.LoadCheckpoint) error
+	RemoveLoadCheckpoints(collectionIDs ...int64) error
+	GetLoadCheckpoints() (map[int64]*querypb.LoadCheckpoint, error)
+
+	SaveTargetUpdateState(state *querypb.TargetUpdateState) error
+	GetTargetUpdateState() (*querypb.TargetUpdateState, error)
+
+	SaveBalanceState(state *querypb.BalanceState) error
+	GetBalanceState() (*querypb.BalanceState, error)
+
+	SaveShardBlock(block *querypb.ShardBlock) error
+	RemoveShardBlock(collectionID int64, channel string) error
+	GetShardBlocks() ([]*querypb.ShardBlock, error)
+
+	SaveBalanceLayout(layout *querypb.BalanceLayout) error
+	RemoveBalanceLayout(collectionID int64) error
+	GetBalanceLayouts() ([]*querypb.BalanceLayout, error)
+
+	SaveNodeDecommission(decommission *querypb.NodeDecommission) error
+	RemoveNodeDecommission(nodeID int64) error
+	GetNodeDecommissions() ([]*querypb.NodeDecommission, error)
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/pkg/util/compressor"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 var ErrInvalidKey = errors.New("invalid load info key")
@@ -30,6 +31,12 @@ const (
 
 	MetaOpsBatchSize       = 128
 	CollectionTargetPrefix = "queryCoord-Collection-Target"
+	LoadCheckpointPrefix   = "queryCoord-Load-Checkpoint"
+	TargetUpdateStateKey   = "queryCoord-Target-Update-State"
+	BalanceStateKey        = "queryCoord-Balance-State"
+	ShardBlockPrefix       = "queryCoord-Shard-Block"
+	BalanceLayoutPrefix    = "queryCoord-Balance-Layout"
+	NodeDecommissionPrefix = "queryCoord-Node-Decommission"
 )
 
 type Catalog struct {
@@ -292,6 +299,187 @@ func (s Catalog) GetCollectionTargets() (map[int64]*querypb.CollectionTarget, er
 	return ret, nil
 }
 
+func (s Catalog) SaveLoadCheckpoints(checkpoints ...*querypb.LoadCheckpoint) error {
+	kvs := make(map[string]string)
+	for _, checkpoint := range checkpoints {
+		k := encodeLoadCheckpointKey(checkpoint.GetCollectionID())
+		v, err := proto.Marshal(checkpoint)
+		if err != nil {
+			return err
+		}
+		kvs[k] = string(v)
+	}
+	return s.cli.MultiSave(kvs)
+}
+
+func (s Catalog) RemoveLoadCheckpoints(collectionIDs ...int64) error {
+	keys := lo.Map(collectionIDs, func(collectionID int64, _ int) string {
+		return encodeLoadCheckpointKey(collectionID)
+	})
+	for _, batch := range lo.Chunk(keys, MetaOpsBatchSize) {
+		if err := s.cli.MultiRemove(batch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s Catalog) GetLoadCheckpoints() (map[int64]*querypb.LoadCheckpoint, error) {
+	keys, values, err := s.cli.LoadWithPrefix(LoadCheckpointPrefix)
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[int64]*querypb.LoadCheckpoint)
+	for i, v := range values {
+		checkpoint := &querypb.LoadCheckpoint{}
+		if err := proto.Unmarshal([]byte(v), checkpoint); err != nil {
+			// checkpoint is only used to speed up the recovery, skip when failure happens
+			log.Warn("failed to unmarshal load checkpoint", zap.String("key", keys[i]), zap.Error(err))
+			continue
+		}
+		ret[checkpoint.GetCollectionID()] = checkpoint
+	}
+	return ret, nil
+}
+
+func (s Catalog) SaveTargetUpdateState(state *querypb.TargetUpdateState) error {
+	v, err := proto.Marshal(state)
+	if err != nil {
+		return err
+	}
+	return s.cli.Save(TargetUpdateStateKey, string(v))
+}
+
+// GetTargetUpdateState returns the target update state, an empty state if it has never been saved.
+func (s Catalog) GetTargetUpdateState() (*querypb.TargetUpdateState, error) {
+	v, err := s.cli.Load(TargetUpdateStateKey)
+	if errors.Is(err, merr.ErrIoKeyNotFound) {
+		return &querypb.TargetUpdateState{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	state := &querypb.TargetUpdateState{}
+	if err := proto.Unmarshal([]byte(v), state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+func (s Catalog) SaveBalanceState(state *querypb.BalanceState) error {
+	v, err := proto.Marshal(state)
+	if err != nil {
+		return err
+	}
+	return s.cli.Save(BalanceStateKey, string(v))
+}
+
+// GetBalanceState returns the balance state, an empty state if it has never been saved.
+func (s Catalog) GetBalanceState() (*querypb.BalanceState, error) {
+	v, err := s.cli.Load(BalanceStateKey)
+	if errors.Is(err, merr.ErrIoKeyNotFound) {
+		return &querypb.BalanceState{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	state := &querypb.BalanceState{}
+	if err := proto.Unmarshal([]byte(v), state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+func (s Catalog) SaveShardBlock(block *querypb.ShardBlock) error {
+	k := encodeShardBlockKey(block.GetCollectionID(), block.GetChannel())
+	v, err := proto.Marshal(block)
+	if err != nil {
+		return err
+	}
+	return s.cli.Save(k, string(v))
+}
+
+func (s Catalog) RemoveShardBlock(collectionID int64, channel string) error {
+	k := encodeShardBlockKey(collectionID, channel)
+	return s.cli.Remove(k)
+}
+
+func (s Catalog) GetShardBlocks() ([]*querypb.ShardBlock, error) {
+	_, values, err := s.cli.LoadWithPrefix(ShardBlockPrefix)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*querypb.ShardBlock, 0, len(values))
+	for _, v := range values {
+		block := &querypb.ShardBlock{}
+		if err := proto.Unmarshal([]byte(v), block); err != nil {
+			return nil, err
+		}
+		ret = append(ret, block)
+	}
+	return ret, nil
+}
+
+func (s Catalog) SaveBalanceLayout(layout *querypb.BalanceLayout) error {
+	k := encodeBalanceLayoutKey(layout.GetCollectionID())
+	v, err := proto.Marshal(layout)
+	if err != nil {
+		return err
+	}
+	return s.cli.Save(k, string(v))
+}
+
+func (s Catalog) RemoveBalanceLayout(collectionID int64) error {
+	k := encodeBalanceLayoutKey(collectionID)
+	return s.cli.Remove(k)
+}
+
+func (s Catalog) GetBalanceLayouts() ([]*querypb.BalanceLayout, error) {
+	_, values, err := s.cli.LoadWithPrefix(BalanceLayoutPrefix)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*querypb.BalanceLayout, 0, len(values))
+	for _, v := range values {
+		layout := &querypb.BalanceLayout{}
+		if err := proto.Unmarshal([]byte(v), layout); err != nil {
+			return nil, err
+		}
+		ret = append(ret, layout)
+	}
+	return ret, nil
+}
+
+func (s Catalog) SaveNodeDecommission(decommission *querypb.NodeDecommission) error {
+	k := encodeNodeDecommissionKey(decommission.GetNodeID())
+	v, err := proto.Marshal(decommission)
+	if err != nil {
+		return err
+	}
+	return s.cli.Save(k, string(v))
+}
+
+func (s Catalog) RemoveNodeDecommission(nodeID int64) error {
+	k := encodeNodeDecommissionKey(nodeID)
+	return s.cli.Remove(k)
+}
+
+func (s Catalog) GetNodeDecommissions() ([]*querypb.NodeDecommission, error) {
+	_, values, err := s.cli.LoadWithPrefix(NodeDecommissionPrefix)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*querypb.NodeDecommission, 0, len(values))
+	for _, v := range values {
+		decommission := &querypb.NodeDecommission{}
+		if err := proto.Unmarshal([]byte(v), decommission); err != nil {
+			return nil, err
+		}
+		ret = append(ret, decommission)
+	}
+	return ret, nil
+}
+
 func EncodeCollectionLoadInfoKey(collection int64) string {
 	return fmt.Sprintf("%s/%d", CollectionLoadInfoPrefix, collection)
 }
@@ -315,3 +503,19 @@ func encodeResourceGroupKey(rgName string) string {
 func encodeCollectionTargetKey(collection int64) string {
 	return fmt.Sprintf("%s/%d", CollectionTargetPrefix, collection)
 }
+
+func encodeLoadCheckpointKey(collection int64) string {
+	return fmt.Sprintf("%s/%d", LoadCheckpointPrefix, collection)
+}
+
+func encodeShardBlockKey(collection int64, channel string) string {
+	return fmt.Sprintf("%s/%d/%s", ShardBlockPrefix, collection, channel)
+}
+
+func encodeBalanceLayoutKey(collection int64) string {
+	return fmt.Sprintf("%s/%d", BalanceLayoutPrefix, collection)
+}
+
+func encodeNodeDecommissionKey(nodeID int64) string {
+	return fmt.Sprintf("%s/%d", NodeDecommissionPrefix, nodeID)
+}
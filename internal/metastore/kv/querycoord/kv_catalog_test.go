@@ -245,6 +245,148 @@ func (suite *CatalogTestSuite) TestCollectionTarget() {
 	suite.Error(err)
 }
 
+func (suite *CatalogTestSuite) TestLoadCheckpoint() {
+	err := suite.catalog.SaveLoadCheckpoints(
+		&querypb.LoadCheckpoint{CollectionID: 1, TargetVersion: 1},
+		&querypb.LoadCheckpoint{CollectionID: 2, TargetVersion: 2},
+	)
+	suite.NoError(err)
+	err = suite.catalog.SaveLoadCheckpoints(&querypb.LoadCheckpoint{
+		CollectionID:  1,
+		TargetVersion: 3,
+		Replicas:      []*querypb.Replica{{ID: 1, CollectionID: 1, Nodes: []int64{1, 2}}},
+	})
+	suite.NoError(err)
+	suite.NoError(suite.catalog.RemoveLoadCheckpoints(2))
+
+	checkpoints, err := suite.catalog.GetLoadCheckpoints()
+	suite.NoError(err)
+	suite.Len(checkpoints, 1)
+	suite.Equal(int64(3), checkpoints[1].GetTargetVersion())
+	suite.Equal([]int64{1, 2}, checkpoints[1].GetReplicas()[0].GetNodes())
+
+	// remove more checkpoints than a batch
+	collectionIDs := make([]int64, 0, MetaOpsBatchSize+1)
+	for i := 0; i <= MetaOpsBatchSize; i++ {
+		collectionID := int64(100 + i)
+		suite.NoError(suite.catalog.SaveLoadCheckpoints(&querypb.LoadCheckpoint{CollectionID: collectionID}))
+		collectionIDs = append(collectionIDs, collectionID)
+	}
+	suite.NoError(suite.catalog.RemoveLoadCheckpoints(collectionIDs...))
+	checkpoints, err = suite.catalog.GetLoadCheckpoints()
+	suite.NoError(err)
+	suite.Len(checkpoints, 1)
+
+	// test access meta store failed
+	mockStore := mocks.NewMetaKv(suite.T())
+	mockErr := errors.New("failed to access etcd")
+	mockStore.EXPECT().MultiSave(mock.Anything).Return(mockErr)
+	mockStore.EXPECT().LoadWithPrefix(mock.Anything).Return(nil, nil, mockErr)
+
+	suite.catalog.cli = mockStore
+	err = suite.catalog.SaveLoadCheckpoints(&querypb.LoadCheckpoint{})
+	suite.ErrorIs(err, mockErr)
+
+	_, err = suite.catalog.GetLoadCheckpoints()
+	suite.ErrorIs(err, mockErr)
+}
+
+func (suite *CatalogTestSuite) TestTargetUpdateState() {
+	state, err := suite.catalog.GetTargetUpdateState()
+	suite.NoError(err)
+	suite.False(state.GetPaused())
+
+	err = suite.catalog.SaveTargetUpdateState(&querypb.TargetUpdateState{Paused: true, PausedTime: 100})
+	suite.NoError(err)
+	state, err = suite.catalog.GetTargetUpdateState()
+	suite.NoError(err)
+	suite.True(state.GetPaused())
+	suite.Equal(int64(100), state.GetPausedTime())
+
+	// test access meta store failed
+	mockStore := mocks.NewMetaKv(suite.T())
+	mockErr := errors.New("failed to access etcd")
+	mockStore.EXPECT().Load(mock.Anything).Return("", mockErr)
+
+	suite.catalog.cli = mockStore
+	_, err = suite.catalog.GetTargetUpdateState()
+	suite.ErrorIs(err, mockErr)
+}
+
+func (suite *CatalogTestSuite) TestBalanceState() {
+	state, err := suite.catalog.GetBalanceState()
+	suite.NoError(err)
+	suite.False(state.GetSuspended())
+
+	err = suite.catalog.SaveBalanceState(&querypb.BalanceState{Suspended: true, SuspendedTime: 100})
+	suite.NoError(err)
+	state, err = suite.catalog.GetBalanceState()
+	suite.NoError(err)
+	suite.True(state.GetSuspended())
+	suite.Equal(int64(100), state.GetSuspendedTime())
+
+	// test access meta store failed
+	mockStore := mocks.NewMetaKv(suite.T())
+	mockErr := errors.New("failed to access etcd")
+	mockStore.EXPECT().Load(mock.Anything).Return("", mockErr)
+
+	suite.catalog.cli = mockStore
+	_, err = suite.catalog.GetBalanceState()
+	suite.ErrorIs(err, mockErr)
+}
+
+func (suite *CatalogTestSuite) TestShardBlock() {
+	suite.NoError(suite.catalog.SaveShardBlock(&querypb.ShardBlock{CollectionID: 1, Channel: "dmc0", Reason: "corrupt index"}))
+	suite.NoError(suite.catalog.SaveShardBlock(&querypb.ShardBlock{CollectionID: 1, Channel: "dmc1"}))
+	suite.NoError(suite.catalog.SaveShardBlock(&querypb.ShardBlock{CollectionID: 2, Channel: "dmc0"}))
+	suite.NoError(suite.catalog.RemoveShardBlock(1, "dmc1"))
+
+	blocks, err := suite.catalog.GetShardBlocks()
+	suite.NoError(err)
+	suite.Len(blocks, 2)
+	for _, block := range blocks {
+		suite.Equal("dmc0", block.GetChannel())
+		if block.GetCollectionID() == 1 {
+			suite.Equal("corrupt index", block.GetReason())
+		}
+	}
+}
+
+func (suite *CatalogTestSuite) TestBalanceLayout() {
+	suite.NoError(suite.catalog.SaveBalanceLayout(&querypb.BalanceLayout{
+		CollectionID: 1,
+		Replicas: []*querypb.ReplicaLayout{
+			{ReplicaID: 1, Segments: map[int64]int64{1: 1}, Channels: map[string]int64{"dmc0": 1}},
+		},
+	}))
+	suite.NoError(suite.catalog.SaveBalanceLayout(&querypb.BalanceLayout{CollectionID: 2}))
+	suite.NoError(suite.catalog.RemoveBalanceLayout(2))
+
+	layouts, err := suite.catalog.GetBalanceLayouts()
+	suite.NoError(err)
+	suite.Len(layouts, 1)
+	suite.EqualValues(1, layouts[0].GetCollectionID())
+	suite.EqualValues(1, layouts[0].GetReplicas()[0].GetSegments()[1])
+	suite.EqualValues(1, layouts[0].GetReplicas()[0].GetChannels()["dmc0"])
+}
+
+func (suite *CatalogTestSuite) TestNodeDecommission() {
+	suite.NoError(suite.catalog.SaveNodeDecommission(&querypb.NodeDecommission{
+		NodeID:        1,
+		TotalSegments: 10,
+		TotalChannels: 2,
+	}))
+	suite.NoError(suite.catalog.SaveNodeDecommission(&querypb.NodeDecommission{NodeID: 2}))
+	suite.NoError(suite.catalog.RemoveNodeDecommission(2))
+
+	decommissions, err := suite.catalog.GetNodeDecommissions()
+	suite.NoError(err)
+	suite.Len(decommissions, 1)
+	suite.EqualValues(1, decommissions[0].GetNodeID())
+	suite.EqualValues(10, decommissions[0].GetTotalSegments())
+	suite.EqualValues(2, decommissions[0].GetTotalChannels())
+}
+
 func (suite *CatalogTestSuite) TestLoadRelease() {
 	// TODO(sunby): add ut
 }
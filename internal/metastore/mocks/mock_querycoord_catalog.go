@@ -20,6 +20,112 @@ func (_m *QueryCoordCatalog) EXPECT() *QueryCoordCatalog_Expecter {
 	return &QueryCoordCatalog_Expecter{mock: &_m.Mock}
 }
 
+// GetBalanceLayouts provides a mock function with given fields:
+func (_m *QueryCoordCatalog) GetBalanceLayouts() ([]*querypb.BalanceLayout, error) {
+	ret := _m.Called()
+
+	var r0 []*querypb.BalanceLayout
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*querypb.BalanceLayout, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*querypb.BalanceLayout); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*querypb.BalanceLayout)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryCoordCatalog_GetBalanceLayouts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBalanceLayouts'
+type QueryCoordCatalog_GetBalanceLayouts_Call struct {
+	*mock.Call
+}
+
+// GetBalanceLayouts is a helper method to define mock.On call
+func (_e *QueryCoordCatalog_Expecter) GetBalanceLayouts() *QueryCoordCatalog_GetBalanceLayouts_Call {
+	return &QueryCoordCatalog_GetBalanceLayouts_Call{Call: _e.mock.On("GetBalanceLayouts")}
+}
+
+func (_c *QueryCoordCatalog_GetBalanceLayouts_Call) Run(run func()) *QueryCoordCatalog_GetBalanceLayouts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *QueryCoordCatalog_GetBalanceLayouts_Call) Return(_a0 []*querypb.BalanceLayout, _a1 error) *QueryCoordCatalog_GetBalanceLayouts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *QueryCoordCatalog_GetBalanceLayouts_Call) RunAndReturn(run func() ([]*querypb.BalanceLayout, error)) *QueryCoordCatalog_GetBalanceLayouts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBalanceState provides a mock function with given fields:
+func (_m *QueryCoordCatalog) GetBalanceState() (*querypb.BalanceState, error) {
+	ret := _m.Called()
+
+	var r0 *querypb.BalanceState
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*querypb.BalanceState, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *querypb.BalanceState); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.BalanceState)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryCoordCatalog_GetBalanceState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBalanceState'
+type QueryCoordCatalog_GetBalanceState_Call struct {
+	*mock.Call
+}
+
+// GetBalanceState is a helper method to define mock.On call
+func (_e *QueryCoordCatalog_Expecter) GetBalanceState() *QueryCoordCatalog_GetBalanceState_Call {
+	return &QueryCoordCatalog_GetBalanceState_Call{Call: _e.mock.On("GetBalanceState")}
+}
+
+func (_c *QueryCoordCatalog_GetBalanceState_Call) Run(run func()) *QueryCoordCatalog_GetBalanceState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *QueryCoordCatalog_GetBalanceState_Call) Return(_a0 *querypb.BalanceState, _a1 error) *QueryCoordCatalog_GetBalanceState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *QueryCoordCatalog_GetBalanceState_Call) RunAndReturn(run func() (*querypb.BalanceState, error)) *QueryCoordCatalog_GetBalanceState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCollectionTargets provides a mock function with given fields:
 func (_m *QueryCoordCatalog) GetCollectionTargets() (map[int64]*querypb.CollectionTarget, error) {
 	ret := _m.Called()
@@ -126,6 +232,112 @@ func (_c *QueryCoordCatalog_GetCollections_Call) RunAndReturn(run func() ([]*que
 	return _c
 }
 
+// GetLoadCheckpoints provides a mock function with given fields:
+func (_m *QueryCoordCatalog) GetLoadCheckpoints() (map[int64]*querypb.LoadCheckpoint, error) {
+	ret := _m.Called()
+
+	var r0 map[int64]*querypb.LoadCheckpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (map[int64]*querypb.LoadCheckpoint, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() map[int64]*querypb.LoadCheckpoint); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64]*querypb.LoadCheckpoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryCoordCatalog_GetLoadCheckpoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoadCheckpoints'
+type QueryCoordCatalog_GetLoadCheckpoints_Call struct {
+	*mock.Call
+}
+
+// GetLoadCheckpoints is a helper method to define mock.On call
+func (_e *QueryCoordCatalog_Expecter) GetLoadCheckpoints() *QueryCoordCatalog_GetLoadCheckpoints_Call {
+	return &QueryCoordCatalog_GetLoadCheckpoints_Call{Call: _e.mock.On("GetLoadCheckpoints")}
+}
+
+func (_c *QueryCoordCatalog_GetLoadCheckpoints_Call) Run(run func()) *QueryCoordCatalog_GetLoadCheckpoints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *QueryCoordCatalog_GetLoadCheckpoints_Call) Return(_a0 map[int64]*querypb.LoadCheckpoint, _a1 error) *QueryCoordCatalog_GetLoadCheckpoints_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *QueryCoordCatalog_GetLoadCheckpoints_Call) RunAndReturn(run func() (map[int64]*querypb.LoadCheckpoint, error)) *QueryCoordCatalog_GetLoadCheckpoints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNodeDecommissions provides a mock function with given fields:
+func (_m *QueryCoordCatalog) GetNodeDecommissions() ([]*querypb.NodeDecommission, error) {
+	ret := _m.Called()
+
+	var r0 []*querypb.NodeDecommission
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*querypb.NodeDecommission, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*querypb.NodeDecommission); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*querypb.NodeDecommission)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryCoordCatalog_GetNodeDecommissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNodeDecommissions'
+type QueryCoordCatalog_GetNodeDecommissions_Call struct {
+	*mock.Call
+}
+
+// GetNodeDecommissions is a helper method to define mock.On call
+func (_e *QueryCoordCatalog_Expecter) GetNodeDecommissions() *QueryCoordCatalog_GetNodeDecommissions_Call {
+	return &QueryCoordCatalog_GetNodeDecommissions_Call{Call: _e.mock.On("GetNodeDecommissions")}
+}
+
+func (_c *QueryCoordCatalog_GetNodeDecommissions_Call) Run(run func()) *QueryCoordCatalog_GetNodeDecommissions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *QueryCoordCatalog_GetNodeDecommissions_Call) Return(_a0 []*querypb.NodeDecommission, _a1 error) *QueryCoordCatalog_GetNodeDecommissions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *QueryCoordCatalog_GetNodeDecommissions_Call) RunAndReturn(run func() ([]*querypb.NodeDecommission, error)) *QueryCoordCatalog_GetNodeDecommissions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPartitions provides a mock function with given fields:
 func (_m *QueryCoordCatalog) GetPartitions() (map[int64][]*querypb.PartitionLoadInfo, error) {
 	ret := _m.Called()
@@ -285,6 +497,112 @@ func (_c *QueryCoordCatalog_GetResourceGroups_Call) RunAndReturn(run func() ([]*
 	return _c
 }
 
+// GetShardBlocks provides a mock function with given fields:
+func (_m *QueryCoordCatalog) GetShardBlocks() ([]*querypb.ShardBlock, error) {
+	ret := _m.Called()
+
+	var r0 []*querypb.ShardBlock
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*querypb.ShardBlock, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*querypb.ShardBlock); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*querypb.ShardBlock)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryCoordCatalog_GetShardBlocks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetShardBlocks'
+type QueryCoordCatalog_GetShardBlocks_Call struct {
+	*mock.Call
+}
+
+// GetShardBlocks is a helper method to define mock.On call
+func (_e *QueryCoordCatalog_Expecter) GetShardBlocks() *QueryCoordCatalog_GetShardBlocks_Call {
+	return &QueryCoordCatalog_GetShardBlocks_Call{Call: _e.mock.On("GetShardBlocks")}
+}
+
+func (_c *QueryCoordCatalog_GetShardBlocks_Call) Run(run func()) *QueryCoordCatalog_GetShardBlocks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *QueryCoordCatalog_GetShardBlocks_Call) Return(_a0 []*querypb.ShardBlock, _a1 error) *QueryCoordCatalog_GetShardBlocks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *QueryCoordCatalog_GetShardBlocks_Call) RunAndReturn(run func() ([]*querypb.ShardBlock, error)) *QueryCoordCatalog_GetShardBlocks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTargetUpdateState provides a mock function with given fields:
+func (_m *QueryCoordCatalog) GetTargetUpdateState() (*querypb.TargetUpdateState, error) {
+	ret := _m.Called()
+
+	var r0 *querypb.TargetUpdateState
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*querypb.TargetUpdateState, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *querypb.TargetUpdateState); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.TargetUpdateState)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryCoordCatalog_GetTargetUpdateState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTargetUpdateState'
+type QueryCoordCatalog_GetTargetUpdateState_Call struct {
+	*mock.Call
+}
+
+// GetTargetUpdateState is a helper method to define mock.On call
+func (_e *QueryCoordCatalog_Expecter) GetTargetUpdateState() *QueryCoordCatalog_GetTargetUpdateState_Call {
+	return &QueryCoordCatalog_GetTargetUpdateState_Call{Call: _e.mock.On("GetTargetUpdateState")}
+}
+
+func (_c *QueryCoordCatalog_GetTargetUpdateState_Call) Run(run func()) *QueryCoordCatalog_GetTargetUpdateState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *QueryCoordCatalog_GetTargetUpdateState_Call) Return(_a0 *querypb.TargetUpdateState, _a1 error) *QueryCoordCatalog_GetTargetUpdateState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *QueryCoordCatalog_GetTargetUpdateState_Call) RunAndReturn(run func() (*querypb.TargetUpdateState, error)) *QueryCoordCatalog_GetTargetUpdateState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReleaseCollection provides a mock function with given fields: collection
 func (_m *QueryCoordCatalog) ReleaseCollection(collection int64) error {
 	ret := _m.Called(collection)
@@ -469,8 +787,8 @@ func (_c *QueryCoordCatalog_ReleaseReplicas_Call) RunAndReturn(run func(int64) e
 	return _c
 }
 
-// RemoveCollectionTarget provides a mock function with given fields: collectionID
-func (_m *QueryCoordCatalog) RemoveCollectionTarget(collectionID int64) error {
+// RemoveBalanceLayout provides a mock function with given fields: collectionID
+func (_m *QueryCoordCatalog) RemoveBalanceLayout(collectionID int64) error {
 	ret := _m.Called(collectionID)
 
 	var r0 error
@@ -483,41 +801,41 @@ func (_m *QueryCoordCatalog) RemoveCollectionTarget(collectionID int64) error {
 	return r0
 }
 
-// QueryCoordCatalog_RemoveCollectionTarget_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveCollectionTarget'
-type QueryCoordCatalog_RemoveCollectionTarget_Call struct {
+// QueryCoordCatalog_RemoveBalanceLayout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveBalanceLayout'
+type QueryCoordCatalog_RemoveBalanceLayout_Call struct {
 	*mock.Call
 }
 
-// RemoveCollectionTarget is a helper method to define mock.On call
+// RemoveBalanceLayout is a helper method to define mock.On call
 //   - collectionID int64
-func (_e *QueryCoordCatalog_Expecter) RemoveCollectionTarget(collectionID interface{}) *QueryCoordCatalog_RemoveCollectionTarget_Call {
-	return &QueryCoordCatalog_RemoveCollectionTarget_Call{Call: _e.mock.On("RemoveCollectionTarget", collectionID)}
+func (_e *QueryCoordCatalog_Expecter) RemoveBalanceLayout(collectionID interface{}) *QueryCoordCatalog_RemoveBalanceLayout_Call {
+	return &QueryCoordCatalog_RemoveBalanceLayout_Call{Call: _e.mock.On("RemoveBalanceLayout", collectionID)}
 }
 
-func (_c *QueryCoordCatalog_RemoveCollectionTarget_Call) Run(run func(collectionID int64)) *QueryCoordCatalog_RemoveCollectionTarget_Call {
+func (_c *QueryCoordCatalog_RemoveBalanceLayout_Call) Run(run func(collectionID int64)) *QueryCoordCatalog_RemoveBalanceLayout_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(int64))
 	})
 	return _c
 }
 
-func (_c *QueryCoordCatalog_RemoveCollectionTarget_Call) Return(_a0 error) *QueryCoordCatalog_RemoveCollectionTarget_Call {
+func (_c *QueryCoordCatalog_RemoveBalanceLayout_Call) Return(_a0 error) *QueryCoordCatalog_RemoveBalanceLayout_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *QueryCoordCatalog_RemoveCollectionTarget_Call) RunAndReturn(run func(int64) error) *QueryCoordCatalog_RemoveCollectionTarget_Call {
+func (_c *QueryCoordCatalog_RemoveBalanceLayout_Call) RunAndReturn(run func(int64) error) *QueryCoordCatalog_RemoveBalanceLayout_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveResourceGroup provides a mock function with given fields: rgName
-func (_m *QueryCoordCatalog) RemoveResourceGroup(rgName string) error {
-	ret := _m.Called(rgName)
+// RemoveCollectionTarget provides a mock function with given fields: collectionID
+func (_m *QueryCoordCatalog) RemoveCollectionTarget(collectionID int64) error {
+	ret := _m.Called(collectionID)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(rgName)
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(collectionID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -525,8 +843,147 @@ func (_m *QueryCoordCatalog) RemoveResourceGroup(rgName string) error {
 	return r0
 }
 
-// QueryCoordCatalog_RemoveResourceGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveResourceGroup'
-type QueryCoordCatalog_RemoveResourceGroup_Call struct {
+// QueryCoordCatalog_RemoveCollectionTarget_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveCollectionTarget'
+type QueryCoordCatalog_RemoveCollectionTarget_Call struct {
+	*mock.Call
+}
+
+// RemoveCollectionTarget is a helper method to define mock.On call
+//   - collectionID int64
+func (_e *QueryCoordCatalog_Expecter) RemoveCollectionTarget(collectionID interface{}) *QueryCoordCatalog_RemoveCollectionTarget_Call {
+	return &QueryCoordCatalog_RemoveCollectionTarget_Call{Call: _e.mock.On("RemoveCollectionTarget", collectionID)}
+}
+
+func (_c *QueryCoordCatalog_RemoveCollectionTarget_Call) Run(run func(collectionID int64)) *QueryCoordCatalog_RemoveCollectionTarget_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *QueryCoordCatalog_RemoveCollectionTarget_Call) Return(_a0 error) *QueryCoordCatalog_RemoveCollectionTarget_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QueryCoordCatalog_RemoveCollectionTarget_Call) RunAndReturn(run func(int64) error) *QueryCoordCatalog_RemoveCollectionTarget_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveLoadCheckpoints provides a mock function with given fields: collectionIDs
+func (_m *QueryCoordCatalog) RemoveLoadCheckpoints(collectionIDs ...int64) error {
+	_va := make([]interface{}, len(collectionIDs))
+	for _i := range collectionIDs {
+		_va[_i] = collectionIDs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(...int64) error); ok {
+		r0 = rf(collectionIDs...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// QueryCoordCatalog_RemoveLoadCheckpoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveLoadCheckpoints'
+type QueryCoordCatalog_RemoveLoadCheckpoints_Call struct {
+	*mock.Call
+}
+
+// RemoveLoadCheckpoints is a helper method to define mock.On call
+//   - collectionIDs ...int64
+func (_e *QueryCoordCatalog_Expecter) RemoveLoadCheckpoints(collectionIDs ...interface{}) *QueryCoordCatalog_RemoveLoadCheckpoints_Call {
+	return &QueryCoordCatalog_RemoveLoadCheckpoints_Call{Call: _e.mock.On("RemoveLoadCheckpoints",
+		append([]interface{}{}, collectionIDs...)...)}
+}
+
+func (_c *QueryCoordCatalog_RemoveLoadCheckpoints_Call) Run(run func(collectionIDs ...int64)) *QueryCoordCatalog_RemoveLoadCheckpoints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]int64, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(int64)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *QueryCoordCatalog_RemoveLoadCheckpoints_Call) Return(_a0 error) *QueryCoordCatalog_RemoveLoadCheckpoints_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QueryCoordCatalog_RemoveLoadCheckpoints_Call) RunAndReturn(run func(...int64) error) *QueryCoordCatalog_RemoveLoadCheckpoints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveNodeDecommission provides a mock function with given fields: nodeID
+func (_m *QueryCoordCatalog) RemoveNodeDecommission(nodeID int64) error {
+	ret := _m.Called(nodeID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(nodeID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// QueryCoordCatalog_RemoveNodeDecommission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveNodeDecommission'
+type QueryCoordCatalog_RemoveNodeDecommission_Call struct {
+	*mock.Call
+}
+
+// RemoveNodeDecommission is a helper method to define mock.On call
+//   - nodeID int64
+func (_e *QueryCoordCatalog_Expecter) RemoveNodeDecommission(nodeID interface{}) *QueryCoordCatalog_RemoveNodeDecommission_Call {
+	return &QueryCoordCatalog_RemoveNodeDecommission_Call{Call: _e.mock.On("RemoveNodeDecommission", nodeID)}
+}
+
+func (_c *QueryCoordCatalog_RemoveNodeDecommission_Call) Run(run func(nodeID int64)) *QueryCoordCatalog_RemoveNodeDecommission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *QueryCoordCatalog_RemoveNodeDecommission_Call) Return(_a0 error) *QueryCoordCatalog_RemoveNodeDecommission_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QueryCoordCatalog_RemoveNodeDecommission_Call) RunAndReturn(run func(int64) error) *QueryCoordCatalog_RemoveNodeDecommission_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveResourceGroup provides a mock function with given fields: rgName
+func (_m *QueryCoordCatalog) RemoveResourceGroup(rgName string) error {
+	ret := _m.Called(rgName)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(rgName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// QueryCoordCatalog_RemoveResourceGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveResourceGroup'
+type QueryCoordCatalog_RemoveResourceGroup_Call struct {
 	*mock.Call
 }
 
@@ -553,6 +1010,133 @@ func (_c *QueryCoordCatalog_RemoveResourceGroup_Call) RunAndReturn(run func(stri
 	return _c
 }
 
+// RemoveShardBlock provides a mock function with given fields: collectionID, channel
+func (_m *QueryCoordCatalog) RemoveShardBlock(collectionID int64, channel string) error {
+	ret := _m.Called(collectionID, channel)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, string) error); ok {
+		r0 = rf(collectionID, channel)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// QueryCoordCatalog_RemoveShardBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveShardBlock'
+type QueryCoordCatalog_RemoveShardBlock_Call struct {
+	*mock.Call
+}
+
+// RemoveShardBlock is a helper method to define mock.On call
+//   - collectionID int64
+//   - channel string
+func (_e *QueryCoordCatalog_Expecter) RemoveShardBlock(collectionID interface{}, channel interface{}) *QueryCoordCatalog_RemoveShardBlock_Call {
+	return &QueryCoordCatalog_RemoveShardBlock_Call{Call: _e.mock.On("RemoveShardBlock", collectionID, channel)}
+}
+
+func (_c *QueryCoordCatalog_RemoveShardBlock_Call) Run(run func(collectionID int64, channel string)) *QueryCoordCatalog_RemoveShardBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *QueryCoordCatalog_RemoveShardBlock_Call) Return(_a0 error) *QueryCoordCatalog_RemoveShardBlock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QueryCoordCatalog_RemoveShardBlock_Call) RunAndReturn(run func(int64, string) error) *QueryCoordCatalog_RemoveShardBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveBalanceLayout provides a mock function with given fields: layout
+func (_m *QueryCoordCatalog) SaveBalanceLayout(layout *querypb.BalanceLayout) error {
+	ret := _m.Called(layout)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*querypb.BalanceLayout) error); ok {
+		r0 = rf(layout)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// QueryCoordCatalog_SaveBalanceLayout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveBalanceLayout'
+type QueryCoordCatalog_SaveBalanceLayout_Call struct {
+	*mock.Call
+}
+
+// SaveBalanceLayout is a helper method to define mock.On call
+//   - layout *querypb.BalanceLayout
+func (_e *QueryCoordCatalog_Expecter) SaveBalanceLayout(layout interface{}) *QueryCoordCatalog_SaveBalanceLayout_Call {
+	return &QueryCoordCatalog_SaveBalanceLayout_Call{Call: _e.mock.On("SaveBalanceLayout", layout)}
+}
+
+func (_c *QueryCoordCatalog_SaveBalanceLayout_Call) Run(run func(layout *querypb.BalanceLayout)) *QueryCoordCatalog_SaveBalanceLayout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*querypb.BalanceLayout))
+	})
+	return _c
+}
+
+func (_c *QueryCoordCatalog_SaveBalanceLayout_Call) Return(_a0 error) *QueryCoordCatalog_SaveBalanceLayout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QueryCoordCatalog_SaveBalanceLayout_Call) RunAndReturn(run func(*querypb.BalanceLayout) error) *QueryCoordCatalog_SaveBalanceLayout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveBalanceState provides a mock function with given fields: state
+func (_m *QueryCoordCatalog) SaveBalanceState(state *querypb.BalanceState) error {
+	ret := _m.Called(state)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*querypb.BalanceState) error); ok {
+		r0 = rf(state)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// QueryCoordCatalog_SaveBalanceState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveBalanceState'
+type QueryCoordCatalog_SaveBalanceState_Call struct {
+	*mock.Call
+}
+
+// SaveBalanceState is a helper method to define mock.On call
+//   - state *querypb.BalanceState
+func (_e *QueryCoordCatalog_Expecter) SaveBalanceState(state interface{}) *QueryCoordCatalog_SaveBalanceState_Call {
+	return &QueryCoordCatalog_SaveBalanceState_Call{Call: _e.mock.On("SaveBalanceState", state)}
+}
+
+func (_c *QueryCoordCatalog_SaveBalanceState_Call) Run(run func(state *querypb.BalanceState)) *QueryCoordCatalog_SaveBalanceState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*querypb.BalanceState))
+	})
+	return _c
+}
+
+func (_c *QueryCoordCatalog_SaveBalanceState_Call) Return(_a0 error) *QueryCoordCatalog_SaveBalanceState_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QueryCoordCatalog_SaveBalanceState_Call) RunAndReturn(run func(*querypb.BalanceState) error) *QueryCoordCatalog_SaveBalanceState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveCollection provides a mock function with given fields: collection, partitions
 func (_m *QueryCoordCatalog) SaveCollection(collection *querypb.CollectionLoadInfo, partitions ...*querypb.PartitionLoadInfo) error {
 	_va := make([]interface{}, len(partitions))
@@ -665,6 +1249,103 @@ func (_c *QueryCoordCatalog_SaveCollectionTargets_Call) RunAndReturn(run func(..
 	return _c
 }
 
+// SaveLoadCheckpoints provides a mock function with given fields: checkpoints
+func (_m *QueryCoordCatalog) SaveLoadCheckpoints(checkpoints ...*querypb.LoadCheckpoint) error {
+	_va := make([]interface{}, len(checkpoints))
+	for _i := range checkpoints {
+		_va[_i] = checkpoints[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(...*querypb.LoadCheckpoint) error); ok {
+		r0 = rf(checkpoints...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// QueryCoordCatalog_SaveLoadCheckpoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveLoadCheckpoints'
+type QueryCoordCatalog_SaveLoadCheckpoints_Call struct {
+	*mock.Call
+}
+
+// SaveLoadCheckpoints is a helper method to define mock.On call
+//   - checkpoints ...*querypb.LoadCheckpoint
+func (_e *QueryCoordCatalog_Expecter) SaveLoadCheckpoints(checkpoints ...interface{}) *QueryCoordCatalog_SaveLoadCheckpoints_Call {
+	return &QueryCoordCatalog_SaveLoadCheckpoints_Call{Call: _e.mock.On("SaveLoadCheckpoints",
+		append([]interface{}{}, checkpoints...)...)}
+}
+
+func (_c *QueryCoordCatalog_SaveLoadCheckpoints_Call) Run(run func(checkpoints ...*querypb.LoadCheckpoint)) *QueryCoordCatalog_SaveLoadCheckpoints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]*querypb.LoadCheckpoint, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(*querypb.LoadCheckpoint)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *QueryCoordCatalog_SaveLoadCheckpoints_Call) Return(_a0 error) *QueryCoordCatalog_SaveLoadCheckpoints_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QueryCoordCatalog_SaveLoadCheckpoints_Call) RunAndReturn(run func(...*querypb.LoadCheckpoint) error) *QueryCoordCatalog_SaveLoadCheckpoints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveNodeDecommission provides a mock function with given fields: decommission
+func (_m *QueryCoordCatalog) SaveNodeDecommission(decommission *querypb.NodeDecommission) error {
+	ret := _m.Called(decommission)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*querypb.NodeDecommission) error); ok {
+		r0 = rf(decommission)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// QueryCoordCatalog_SaveNodeDecommission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveNodeDecommission'
+type QueryCoordCatalog_SaveNodeDecommission_Call struct {
+	*mock.Call
+}
+
+// SaveNodeDecommission is a helper method to define mock.On call
+//   - decommission *querypb.NodeDecommission
+func (_e *QueryCoordCatalog_Expecter) SaveNodeDecommission(decommission interface{}) *QueryCoordCatalog_SaveNodeDecommission_Call {
+	return &QueryCoordCatalog_SaveNodeDecommission_Call{Call: _e.mock.On("SaveNodeDecommission", decommission)}
+}
+
+func (_c *QueryCoordCatalog_SaveNodeDecommission_Call) Run(run func(decommission *querypb.NodeDecommission)) *QueryCoordCatalog_SaveNodeDecommission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*querypb.NodeDecommission))
+	})
+	return _c
+}
+
+func (_c *QueryCoordCatalog_SaveNodeDecommission_Call) Return(_a0 error) *QueryCoordCatalog_SaveNodeDecommission_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QueryCoordCatalog_SaveNodeDecommission_Call) RunAndReturn(run func(*querypb.NodeDecommission) error) *QueryCoordCatalog_SaveNodeDecommission_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SavePartition provides a mock function with given fields: info
 func (_m *QueryCoordCatalog) SavePartition(info ...*querypb.PartitionLoadInfo) error {
 	_va := make([]interface{}, len(info))
@@ -830,6 +1511,90 @@ func (_c *QueryCoordCatalog_SaveResourceGroup_Call) RunAndReturn(run func(...*qu
 	return _c
 }
 
+// SaveShardBlock provides a mock function with given fields: block
+func (_m *QueryCoordCatalog) SaveShardBlock(block *querypb.ShardBlock) error {
+	ret := _m.Called(block)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*querypb.ShardBlock) error); ok {
+		r0 = rf(block)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// QueryCoordCatalog_SaveShardBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveShardBlock'
+type QueryCoordCatalog_SaveShardBlock_Call struct {
+	*mock.Call
+}
+
+// SaveShardBlock is a helper method to define mock.On call
+//   - block *querypb.ShardBlock
+func (_e *QueryCoordCatalog_Expecter) SaveShardBlock(block interface{}) *QueryCoordCatalog_SaveShardBlock_Call {
+	return &QueryCoordCatalog_SaveShardBlock_Call{Call: _e.mock.On("SaveShardBlock", block)}
+}
+
+func (_c *QueryCoordCatalog_SaveShardBlock_Call) Run(run func(block *querypb.ShardBlock)) *QueryCoordCatalog_SaveShardBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*querypb.ShardBlock))
+	})
+	return _c
+}
+
+func (_c *QueryCoordCatalog_SaveShardBlock_Call) Return(_a0 error) *QueryCoordCatalog_SaveShardBlock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QueryCoordCatalog_SaveShardBlock_Call) RunAndReturn(run func(*querypb.ShardBlock) error) *QueryCoordCatalog_SaveShardBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveTargetUpdateState provides a mock function with given fields: state
+func (_m *QueryCoordCatalog) SaveTargetUpdateState(state *querypb.TargetUpdateState) error {
+	ret := _m.Called(state)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*querypb.TargetUpdateState) error); ok {
+		r0 = rf(state)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// QueryCoordCatalog_SaveTargetUpdateState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveTargetUpdateState'
+type QueryCoordCatalog_SaveTargetUpdateState_Call struct {
+	*mock.Call
+}
+
+// SaveTargetUpdateState is a helper method to define mock.On call
+//   - state *querypb.TargetUpdateState
+func (_e *QueryCoordCatalog_Expecter) SaveTargetUpdateState(state interface{}) *QueryCoordCatalog_SaveTargetUpdateState_Call {
+	return &QueryCoordCatalog_SaveTargetUpdateState_Call{Call: _e.mock.On("SaveTargetUpdateState", state)}
+}
+
+func (_c *QueryCoordCatalog_SaveTargetUpdateState_Call) Run(run func(state *querypb.TargetUpdateState)) *QueryCoordCatalog_SaveTargetUpdateState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*querypb.TargetUpdateState))
+	})
+	return _c
+}
+
+func (_c *QueryCoordCatalog_SaveTargetUpdateState_Call) Return(_a0 error) *QueryCoordCatalog_SaveTargetUpdateState_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QueryCoordCatalog_SaveTargetUpdateState_Call) RunAndReturn(run func(*querypb.TargetUpdateState) error) *QueryCoordCatalog_SaveTargetUpdateState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewQueryCoordCatalog creates a new instance of QueryCoordCatalog. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewQueryCoordCatalog(t interface {
@@ -89,24 +89,24 @@ func (_c *MockQueryCoord_ActivateChecker_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
-// CheckHealth provides a mock function with given fields: _a0, _a1
-func (_m *MockQueryCoord) CheckHealth(_a0 context.Context, _a1 *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
+// BlockShard provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) BlockShard(_a0 context.Context, _a1 *querypb.BlockShardRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *milvuspb.CheckHealthResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.BlockShardRequest) (*commonpb.Status, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CheckHealthRequest) *milvuspb.CheckHealthResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.BlockShardRequest) *commonpb.Status); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.CheckHealthResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CheckHealthRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.BlockShardRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -115,53 +115,53 @@ func (_m *MockQueryCoord) CheckHealth(_a0 context.Context, _a1 *milvuspb.CheckHe
 	return r0, r1
 }
 
-// MockQueryCoord_CheckHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckHealth'
-type MockQueryCoord_CheckHealth_Call struct {
+// MockQueryCoord_BlockShard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockShard'
+type MockQueryCoord_BlockShard_Call struct {
 	*mock.Call
 }
 
-// CheckHealth is a helper method to define mock.On call
+// BlockShard is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *milvuspb.CheckHealthRequest
-func (_e *MockQueryCoord_Expecter) CheckHealth(_a0 interface{}, _a1 interface{}) *MockQueryCoord_CheckHealth_Call {
-	return &MockQueryCoord_CheckHealth_Call{Call: _e.mock.On("CheckHealth", _a0, _a1)}
+//   - _a1 *querypb.BlockShardRequest
+func (_e *MockQueryCoord_Expecter) BlockShard(_a0 interface{}, _a1 interface{}) *MockQueryCoord_BlockShard_Call {
+	return &MockQueryCoord_BlockShard_Call{Call: _e.mock.On("BlockShard", _a0, _a1)}
 }
 
-func (_c *MockQueryCoord_CheckHealth_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.CheckHealthRequest)) *MockQueryCoord_CheckHealth_Call {
+func (_c *MockQueryCoord_BlockShard_Call) Run(run func(_a0 context.Context, _a1 *querypb.BlockShardRequest)) *MockQueryCoord_BlockShard_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.CheckHealthRequest))
+		run(args[0].(context.Context), args[1].(*querypb.BlockShardRequest))
 	})
 	return _c
 }
 
-func (_c *MockQueryCoord_CheckHealth_Call) Return(_a0 *milvuspb.CheckHealthResponse, _a1 error) *MockQueryCoord_CheckHealth_Call {
+func (_c *MockQueryCoord_BlockShard_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_BlockShard_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoord_CheckHealth_Call) RunAndReturn(run func(context.Context, *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)) *MockQueryCoord_CheckHealth_Call {
+func (_c *MockQueryCoord_BlockShard_Call) RunAndReturn(run func(context.Context, *querypb.BlockShardRequest) (*commonpb.Status, error)) *MockQueryCoord_BlockShard_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CheckQueryNodeDistribution provides a mock function with given fields: _a0, _a1
-func (_m *MockQueryCoord) CheckQueryNodeDistribution(_a0 context.Context, _a1 *querypb.CheckQueryNodeDistributionRequest) (*commonpb.Status, error) {
+// CanStopNode provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) CanStopNode(_a0 context.Context, _a1 *querypb.CanStopNodeRequest) (*querypb.CanStopNodeResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *commonpb.Status
+	var r0 *querypb.CanStopNodeResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CheckQueryNodeDistributionRequest) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CanStopNodeRequest) (*querypb.CanStopNodeResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CheckQueryNodeDistributionRequest) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CanStopNodeRequest) *querypb.CanStopNodeResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*commonpb.Status)
+			r0 = ret.Get(0).(*querypb.CanStopNodeResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.CheckQueryNodeDistributionRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.CanStopNodeRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -170,45 +170,45 @@ func (_m *MockQueryCoord) CheckQueryNodeDistribution(_a0 context.Context, _a1 *q
 	return r0, r1
 }
 
-// MockQueryCoord_CheckQueryNodeDistribution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckQueryNodeDistribution'
-type MockQueryCoord_CheckQueryNodeDistribution_Call struct {
+// MockQueryCoord_CanStopNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CanStopNode'
+type MockQueryCoord_CanStopNode_Call struct {
 	*mock.Call
 }
 
-// CheckQueryNodeDistribution is a helper method to define mock.On call
+// CanStopNode is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *querypb.CheckQueryNodeDistributionRequest
-func (_e *MockQueryCoord_Expecter) CheckQueryNodeDistribution(_a0 interface{}, _a1 interface{}) *MockQueryCoord_CheckQueryNodeDistribution_Call {
-	return &MockQueryCoord_CheckQueryNodeDistribution_Call{Call: _e.mock.On("CheckQueryNodeDistribution", _a0, _a1)}
+//   - _a1 *querypb.CanStopNodeRequest
+func (_e *MockQueryCoord_Expecter) CanStopNode(_a0 interface{}, _a1 interface{}) *MockQueryCoord_CanStopNode_Call {
+	return &MockQueryCoord_CanStopNode_Call{Call: _e.mock.On("CanStopNode", _a0, _a1)}
 }
 
-func (_c *MockQueryCoord_CheckQueryNodeDistribution_Call) Run(run func(_a0 context.Context, _a1 *querypb.CheckQueryNodeDistributionRequest)) *MockQueryCoord_CheckQueryNodeDistribution_Call {
+func (_c *MockQueryCoord_CanStopNode_Call) Run(run func(_a0 context.Context, _a1 *querypb.CanStopNodeRequest)) *MockQueryCoord_CanStopNode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*querypb.CheckQueryNodeDistributionRequest))
+		run(args[0].(context.Context), args[1].(*querypb.CanStopNodeRequest))
 	})
 	return _c
 }
 
-func (_c *MockQueryCoord_CheckQueryNodeDistribution_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_CheckQueryNodeDistribution_Call {
+func (_c *MockQueryCoord_CanStopNode_Call) Return(_a0 *querypb.CanStopNodeResponse, _a1 error) *MockQueryCoord_CanStopNode_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoord_CheckQueryNodeDistribution_Call) RunAndReturn(run func(context.Context, *querypb.CheckQueryNodeDistributionRequest) (*commonpb.Status, error)) *MockQueryCoord_CheckQueryNodeDistribution_Call {
+func (_c *MockQueryCoord_CanStopNode_Call) RunAndReturn(run func(context.Context, *querypb.CanStopNodeRequest) (*querypb.CanStopNodeResponse, error)) *MockQueryCoord_CanStopNode_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateResourceGroup provides a mock function with given fields: _a0, _a1
-func (_m *MockQueryCoord) CreateResourceGroup(_a0 context.Context, _a1 *milvuspb.CreateResourceGroupRequest) (*commonpb.Status, error) {
+// CancelLoad provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) CancelLoad(_a0 context.Context, _a1 *querypb.CancelLoadRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateResourceGroupRequest) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CancelLoadRequest) (*commonpb.Status, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateResourceGroupRequest) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CancelLoadRequest) *commonpb.Status); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
@@ -216,7 +216,7 @@ func (_m *MockQueryCoord) CreateResourceGroup(_a0 context.Context, _a1 *milvuspb
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CreateResourceGroupRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.CancelLoadRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -225,45 +225,45 @@ func (_m *MockQueryCoord) CreateResourceGroup(_a0 context.Context, _a1 *milvuspb
 	return r0, r1
 }
 
-// MockQueryCoord_CreateResourceGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateResourceGroup'
-type MockQueryCoord_CreateResourceGroup_Call struct {
+// MockQueryCoord_CancelLoad_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelLoad'
+type MockQueryCoord_CancelLoad_Call struct {
 	*mock.Call
 }
 
-// CreateResourceGroup is a helper method to define mock.On call
+// CancelLoad is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *milvuspb.CreateResourceGroupRequest
-func (_e *MockQueryCoord_Expecter) CreateResourceGroup(_a0 interface{}, _a1 interface{}) *MockQueryCoord_CreateResourceGroup_Call {
-	return &MockQueryCoord_CreateResourceGroup_Call{Call: _e.mock.On("CreateResourceGroup", _a0, _a1)}
+//   - _a1 *querypb.CancelLoadRequest
+func (_e *MockQueryCoord_Expecter) CancelLoad(_a0 interface{}, _a1 interface{}) *MockQueryCoord_CancelLoad_Call {
+	return &MockQueryCoord_CancelLoad_Call{Call: _e.mock.On("CancelLoad", _a0, _a1)}
 }
 
-func (_c *MockQueryCoord_CreateResourceGroup_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.CreateResourceGroupRequest)) *MockQueryCoord_CreateResourceGroup_Call {
+func (_c *MockQueryCoord_CancelLoad_Call) Run(run func(_a0 context.Context, _a1 *querypb.CancelLoadRequest)) *MockQueryCoord_CancelLoad_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.CreateResourceGroupRequest))
+		run(args[0].(context.Context), args[1].(*querypb.CancelLoadRequest))
 	})
 	return _c
 }
 
-func (_c *MockQueryCoord_CreateResourceGroup_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_CreateResourceGroup_Call {
+func (_c *MockQueryCoord_CancelLoad_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_CancelLoad_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoord_CreateResourceGroup_Call) RunAndReturn(run func(context.Context, *milvuspb.CreateResourceGroupRequest) (*commonpb.Status, error)) *MockQueryCoord_CreateResourceGroup_Call {
+func (_c *MockQueryCoord_CancelLoad_Call) RunAndReturn(run func(context.Context, *querypb.CancelLoadRequest) (*commonpb.Status, error)) *MockQueryCoord_CancelLoad_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeactivateChecker provides a mock function with given fields: _a0, _a1
-func (_m *MockQueryCoord) DeactivateChecker(_a0 context.Context, _a1 *querypb.DeactivateCheckerRequest) (*commonpb.Status, error) {
+// CaptureBalanceLayout provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) CaptureBalanceLayout(_a0 context.Context, _a1 *querypb.CaptureBalanceLayoutRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DeactivateCheckerRequest) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CaptureBalanceLayoutRequest) (*commonpb.Status, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DeactivateCheckerRequest) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CaptureBalanceLayoutRequest) *commonpb.Status); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
@@ -271,7 +271,7 @@ func (_m *MockQueryCoord) DeactivateChecker(_a0 context.Context, _a1 *querypb.De
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.DeactivateCheckerRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.CaptureBalanceLayoutRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -280,53 +280,53 @@ func (_m *MockQueryCoord) DeactivateChecker(_a0 context.Context, _a1 *querypb.De
 	return r0, r1
 }
 
-// MockQueryCoord_DeactivateChecker_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeactivateChecker'
-type MockQueryCoord_DeactivateChecker_Call struct {
+// MockQueryCoord_CaptureBalanceLayout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CaptureBalanceLayout'
+type MockQueryCoord_CaptureBalanceLayout_Call struct {
 	*mock.Call
 }
 
-// DeactivateChecker is a helper method to define mock.On call
+// CaptureBalanceLayout is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *querypb.DeactivateCheckerRequest
-func (_e *MockQueryCoord_Expecter) DeactivateChecker(_a0 interface{}, _a1 interface{}) *MockQueryCoord_DeactivateChecker_Call {
-	return &MockQueryCoord_DeactivateChecker_Call{Call: _e.mock.On("DeactivateChecker", _a0, _a1)}
+//   - _a1 *querypb.CaptureBalanceLayoutRequest
+func (_e *MockQueryCoord_Expecter) CaptureBalanceLayout(_a0 interface{}, _a1 interface{}) *MockQueryCoord_CaptureBalanceLayout_Call {
+	return &MockQueryCoord_CaptureBalanceLayout_Call{Call: _e.mock.On("CaptureBalanceLayout", _a0, _a1)}
 }
 
-func (_c *MockQueryCoord_DeactivateChecker_Call) Run(run func(_a0 context.Context, _a1 *querypb.DeactivateCheckerRequest)) *MockQueryCoord_DeactivateChecker_Call {
+func (_c *MockQueryCoord_CaptureBalanceLayout_Call) Run(run func(_a0 context.Context, _a1 *querypb.CaptureBalanceLayoutRequest)) *MockQueryCoord_CaptureBalanceLayout_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*querypb.DeactivateCheckerRequest))
+		run(args[0].(context.Context), args[1].(*querypb.CaptureBalanceLayoutRequest))
 	})
 	return _c
 }
 
-func (_c *MockQueryCoord_DeactivateChecker_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_DeactivateChecker_Call {
+func (_c *MockQueryCoord_CaptureBalanceLayout_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_CaptureBalanceLayout_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoord_DeactivateChecker_Call) RunAndReturn(run func(context.Context, *querypb.DeactivateCheckerRequest) (*commonpb.Status, error)) *MockQueryCoord_DeactivateChecker_Call {
+func (_c *MockQueryCoord_CaptureBalanceLayout_Call) RunAndReturn(run func(context.Context, *querypb.CaptureBalanceLayoutRequest) (*commonpb.Status, error)) *MockQueryCoord_CaptureBalanceLayout_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DescribeResourceGroup provides a mock function with given fields: _a0, _a1
-func (_m *MockQueryCoord) DescribeResourceGroup(_a0 context.Context, _a1 *querypb.DescribeResourceGroupRequest) (*querypb.DescribeResourceGroupResponse, error) {
+// CheckHealth provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) CheckHealth(_a0 context.Context, _a1 *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *querypb.DescribeResourceGroupResponse
+	var r0 *milvuspb.CheckHealthResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DescribeResourceGroupRequest) (*querypb.DescribeResourceGroupResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DescribeResourceGroupRequest) *querypb.DescribeResourceGroupResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CheckHealthRequest) *milvuspb.CheckHealthResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*querypb.DescribeResourceGroupResponse)
+			r0 = ret.Get(0).(*milvuspb.CheckHealthResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.DescribeResourceGroupRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CheckHealthRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -335,53 +335,53 @@ func (_m *MockQueryCoord) DescribeResourceGroup(_a0 context.Context, _a1 *queryp
 	return r0, r1
 }
 
-// MockQueryCoord_DescribeResourceGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeResourceGroup'
-type MockQueryCoord_DescribeResourceGroup_Call struct {
+// MockQueryCoord_CheckHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckHealth'
+type MockQueryCoord_CheckHealth_Call struct {
 	*mock.Call
 }
 
-// DescribeResourceGroup is a helper method to define mock.On call
+// CheckHealth is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *querypb.DescribeResourceGroupRequest
-func (_e *MockQueryCoord_Expecter) DescribeResourceGroup(_a0 interface{}, _a1 interface{}) *MockQueryCoord_DescribeResourceGroup_Call {
-	return &MockQueryCoord_DescribeResourceGroup_Call{Call: _e.mock.On("DescribeResourceGroup", _a0, _a1)}
+//   - _a1 *milvuspb.CheckHealthRequest
+func (_e *MockQueryCoord_Expecter) CheckHealth(_a0 interface{}, _a1 interface{}) *MockQueryCoord_CheckHealth_Call {
+	return &MockQueryCoord_CheckHealth_Call{Call: _e.mock.On("CheckHealth", _a0, _a1)}
 }
 
-func (_c *MockQueryCoord_DescribeResourceGroup_Call) Run(run func(_a0 context.Context, _a1 *querypb.DescribeResourceGroupRequest)) *MockQueryCoord_DescribeResourceGroup_Call {
+func (_c *MockQueryCoord_CheckHealth_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.CheckHealthRequest)) *MockQueryCoord_CheckHealth_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*querypb.DescribeResourceGroupRequest))
+		run(args[0].(context.Context), args[1].(*milvuspb.CheckHealthRequest))
 	})
 	return _c
 }
 
-func (_c *MockQueryCoord_DescribeResourceGroup_Call) Return(_a0 *querypb.DescribeResourceGroupResponse, _a1 error) *MockQueryCoord_DescribeResourceGroup_Call {
+func (_c *MockQueryCoord_CheckHealth_Call) Return(_a0 *milvuspb.CheckHealthResponse, _a1 error) *MockQueryCoord_CheckHealth_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoord_DescribeResourceGroup_Call) RunAndReturn(run func(context.Context, *querypb.DescribeResourceGroupRequest) (*querypb.DescribeResourceGroupResponse, error)) *MockQueryCoord_DescribeResourceGroup_Call {
+func (_c *MockQueryCoord_CheckHealth_Call) RunAndReturn(run func(context.Context, *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)) *MockQueryCoord_CheckHealth_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DropResourceGroup provides a mock function with given fields: _a0, _a1
-func (_m *MockQueryCoord) DropResourceGroup(_a0 context.Context, _a1 *milvuspb.DropResourceGroupRequest) (*commonpb.Status, error) {
+// CheckNodeHealth provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) CheckNodeHealth(_a0 context.Context, _a1 *querypb.CheckNodeHealthRequest) (*querypb.CheckNodeHealthResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *commonpb.Status
+	var r0 *querypb.CheckNodeHealthResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropResourceGroupRequest) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CheckNodeHealthRequest) (*querypb.CheckNodeHealthResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropResourceGroupRequest) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CheckNodeHealthRequest) *querypb.CheckNodeHealthResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*commonpb.Status)
+			r0 = ret.Get(0).(*querypb.CheckNodeHealthResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DropResourceGroupRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.CheckNodeHealthRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -390,53 +390,53 @@ func (_m *MockQueryCoord) DropResourceGroup(_a0 context.Context, _a1 *milvuspb.D
 	return r0, r1
 }
 
-// MockQueryCoord_DropResourceGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropResourceGroup'
-type MockQueryCoord_DropResourceGroup_Call struct {
+// MockQueryCoord_CheckNodeHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckNodeHealth'
+type MockQueryCoord_CheckNodeHealth_Call struct {
 	*mock.Call
 }
 
-// DropResourceGroup is a helper method to define mock.On call
+// CheckNodeHealth is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *milvuspb.DropResourceGroupRequest
-func (_e *MockQueryCoord_Expecter) DropResourceGroup(_a0 interface{}, _a1 interface{}) *MockQueryCoord_DropResourceGroup_Call {
-	return &MockQueryCoord_DropResourceGroup_Call{Call: _e.mock.On("DropResourceGroup", _a0, _a1)}
+//   - _a1 *querypb.CheckNodeHealthRequest
+func (_e *MockQueryCoord_Expecter) CheckNodeHealth(_a0 interface{}, _a1 interface{}) *MockQueryCoord_CheckNodeHealth_Call {
+	return &MockQueryCoord_CheckNodeHealth_Call{Call: _e.mock.On("CheckNodeHealth", _a0, _a1)}
 }
 
-func (_c *MockQueryCoord_DropResourceGroup_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.DropResourceGroupRequest)) *MockQueryCoord_DropResourceGroup_Call {
+func (_c *MockQueryCoord_CheckNodeHealth_Call) Run(run func(_a0 context.Context, _a1 *querypb.CheckNodeHealthRequest)) *MockQueryCoord_CheckNodeHealth_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.DropResourceGroupRequest))
+		run(args[0].(context.Context), args[1].(*querypb.CheckNodeHealthRequest))
 	})
 	return _c
 }
 
-func (_c *MockQueryCoord_DropResourceGroup_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_DropResourceGroup_Call {
+func (_c *MockQueryCoord_CheckNodeHealth_Call) Return(_a0 *querypb.CheckNodeHealthResponse, _a1 error) *MockQueryCoord_CheckNodeHealth_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoord_DropResourceGroup_Call) RunAndReturn(run func(context.Context, *milvuspb.DropResourceGroupRequest) (*commonpb.Status, error)) *MockQueryCoord_DropResourceGroup_Call {
+func (_c *MockQueryCoord_CheckNodeHealth_Call) RunAndReturn(run func(context.Context, *querypb.CheckNodeHealthRequest) (*querypb.CheckNodeHealthResponse, error)) *MockQueryCoord_CheckNodeHealth_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetComponentStates provides a mock function with given fields: _a0, _a1
-func (_m *MockQueryCoord) GetComponentStates(_a0 context.Context, _a1 *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error) {
+// CheckQueryNodeDistribution provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) CheckQueryNodeDistribution(_a0 context.Context, _a1 *querypb.CheckQueryNodeDistributionRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *milvuspb.ComponentStates
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CheckQueryNodeDistributionRequest) (*commonpb.Status, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest) *milvuspb.ComponentStates); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CheckQueryNodeDistributionRequest) *commonpb.Status); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.ComponentStates)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetComponentStatesRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.CheckQueryNodeDistributionRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -445,53 +445,53 @@ func (_m *MockQueryCoord) GetComponentStates(_a0 context.Context, _a1 *milvuspb.
 	return r0, r1
 }
 
-// MockQueryCoord_GetComponentStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComponentStates'
-type MockQueryCoord_GetComponentStates_Call struct {
+// MockQueryCoord_CheckQueryNodeDistribution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckQueryNodeDistribution'
+type MockQueryCoord_CheckQueryNodeDistribution_Call struct {
 	*mock.Call
 }
 
-// GetComponentStates is a helper method to define mock.On call
+// CheckQueryNodeDistribution is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *milvuspb.GetComponentStatesRequest
-func (_e *MockQueryCoord_Expecter) GetComponentStates(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetComponentStates_Call {
-	return &MockQueryCoord_GetComponentStates_Call{Call: _e.mock.On("GetComponentStates", _a0, _a1)}
+//   - _a1 *querypb.CheckQueryNodeDistributionRequest
+func (_e *MockQueryCoord_Expecter) CheckQueryNodeDistribution(_a0 interface{}, _a1 interface{}) *MockQueryCoord_CheckQueryNodeDistribution_Call {
+	return &MockQueryCoord_CheckQueryNodeDistribution_Call{Call: _e.mock.On("CheckQueryNodeDistribution", _a0, _a1)}
 }
 
-func (_c *MockQueryCoord_GetComponentStates_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.GetComponentStatesRequest)) *MockQueryCoord_GetComponentStates_Call {
+func (_c *MockQueryCoord_CheckQueryNodeDistribution_Call) Run(run func(_a0 context.Context, _a1 *querypb.CheckQueryNodeDistributionRequest)) *MockQueryCoord_CheckQueryNodeDistribution_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.GetComponentStatesRequest))
+		run(args[0].(context.Context), args[1].(*querypb.CheckQueryNodeDistributionRequest))
 	})
 	return _c
 }
 
-func (_c *MockQueryCoord_GetComponentStates_Call) Return(_a0 *milvuspb.ComponentStates, _a1 error) *MockQueryCoord_GetComponentStates_Call {
+func (_c *MockQueryCoord_CheckQueryNodeDistribution_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_CheckQueryNodeDistribution_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoord_GetComponentStates_Call) RunAndReturn(run func(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)) *MockQueryCoord_GetComponentStates_Call {
+func (_c *MockQueryCoord_CheckQueryNodeDistribution_Call) RunAndReturn(run func(context.Context, *querypb.CheckQueryNodeDistributionRequest) (*commonpb.Status, error)) *MockQueryCoord_CheckQueryNodeDistribution_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetMetrics provides a mock function with given fields: _a0, _a1
-func (_m *MockQueryCoord) GetMetrics(_a0 context.Context, _a1 *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+// ClearBalanceLayout provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) ClearBalanceLayout(_a0 context.Context, _a1 *querypb.ClearBalanceLayoutRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *milvuspb.GetMetricsResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ClearBalanceLayoutRequest) (*commonpb.Status, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetMetricsRequest) *milvuspb.GetMetricsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ClearBalanceLayoutRequest) *commonpb.Status); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.GetMetricsResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetMetricsRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ClearBalanceLayoutRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -500,53 +500,53 @@ func (_m *MockQueryCoord) GetMetrics(_a0 context.Context, _a1 *milvuspb.GetMetri
 	return r0, r1
 }
 
-// MockQueryCoord_GetMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMetrics'
-type MockQueryCoord_GetMetrics_Call struct {
+// MockQueryCoord_ClearBalanceLayout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearBalanceLayout'
+type MockQueryCoord_ClearBalanceLayout_Call struct {
 	*mock.Call
 }
 
-// GetMetrics is a helper method to define mock.On call
+// ClearBalanceLayout is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *milvuspb.GetMetricsRequest
-func (_e *MockQueryCoord_Expecter) GetMetrics(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetMetrics_Call {
-	return &MockQueryCoord_GetMetrics_Call{Call: _e.mock.On("GetMetrics", _a0, _a1)}
+//   - _a1 *querypb.ClearBalanceLayoutRequest
+func (_e *MockQueryCoord_Expecter) ClearBalanceLayout(_a0 interface{}, _a1 interface{}) *MockQueryCoord_ClearBalanceLayout_Call {
+	return &MockQueryCoord_ClearBalanceLayout_Call{Call: _e.mock.On("ClearBalanceLayout", _a0, _a1)}
 }
 
-func (_c *MockQueryCoord_GetMetrics_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.GetMetricsRequest)) *MockQueryCoord_GetMetrics_Call {
+func (_c *MockQueryCoord_ClearBalanceLayout_Call) Run(run func(_a0 context.Context, _a1 *querypb.ClearBalanceLayoutRequest)) *MockQueryCoord_ClearBalanceLayout_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.GetMetricsRequest))
+		run(args[0].(context.Context), args[1].(*querypb.ClearBalanceLayoutRequest))
 	})
 	return _c
 }
 
-func (_c *MockQueryCoord_GetMetrics_Call) Return(_a0 *milvuspb.GetMetricsResponse, _a1 error) *MockQueryCoord_GetMetrics_Call {
+func (_c *MockQueryCoord_ClearBalanceLayout_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_ClearBalanceLayout_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoord_GetMetrics_Call) RunAndReturn(run func(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)) *MockQueryCoord_GetMetrics_Call {
+func (_c *MockQueryCoord_ClearBalanceLayout_Call) RunAndReturn(run func(context.Context, *querypb.ClearBalanceLayoutRequest) (*commonpb.Status, error)) *MockQueryCoord_ClearBalanceLayout_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPartitionStates provides a mock function with given fields: _a0, _a1
-func (_m *MockQueryCoord) GetPartitionStates(_a0 context.Context, _a1 *querypb.GetPartitionStatesRequest) (*querypb.GetPartitionStatesResponse, error) {
+// CreateResourceGroup provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) CreateResourceGroup(_a0 context.Context, _a1 *milvuspb.CreateResourceGroupRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *querypb.GetPartitionStatesResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetPartitionStatesRequest) (*querypb.GetPartitionStatesResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateResourceGroupRequest) (*commonpb.Status, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetPartitionStatesRequest) *querypb.GetPartitionStatesResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateResourceGroupRequest) *commonpb.Status); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*querypb.GetPartitionStatesResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetPartitionStatesRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CreateResourceGroupRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -555,53 +555,53 @@ func (_m *MockQueryCoord) GetPartitionStates(_a0 context.Context, _a1 *querypb.G
 	return r0, r1
 }
 
-// MockQueryCoord_GetPartitionStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPartitionStates'
-type MockQueryCoord_GetPartitionStates_Call struct {
+// MockQueryCoord_CreateResourceGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateResourceGroup'
+type MockQueryCoord_CreateResourceGroup_Call struct {
 	*mock.Call
 }
 
-// GetPartitionStates is a helper method to define mock.On call
+// CreateResourceGroup is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *querypb.GetPartitionStatesRequest
-func (_e *MockQueryCoord_Expecter) GetPartitionStates(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetPartitionStates_Call {
-	return &MockQueryCoord_GetPartitionStates_Call{Call: _e.mock.On("GetPartitionStates", _a0, _a1)}
+//   - _a1 *milvuspb.CreateResourceGroupRequest
+func (_e *MockQueryCoord_Expecter) CreateResourceGroup(_a0 interface{}, _a1 interface{}) *MockQueryCoord_CreateResourceGroup_Call {
+	return &MockQueryCoord_CreateResourceGroup_Call{Call: _e.mock.On("CreateResourceGroup", _a0, _a1)}
 }
 
-func (_c *MockQueryCoord_GetPartitionStates_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetPartitionStatesRequest)) *MockQueryCoord_GetPartitionStates_Call {
+func (_c *MockQueryCoord_CreateResourceGroup_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.CreateResourceGroupRequest)) *MockQueryCoord_CreateResourceGroup_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*querypb.GetPartitionStatesRequest))
+		run(args[0].(context.Context), args[1].(*milvuspb.CreateResourceGroupRequest))
 	})
 	return _c
 }
 
-func (_c *MockQueryCoord_GetPartitionStates_Call) Return(_a0 *querypb.GetPartitionStatesResponse, _a1 error) *MockQueryCoord_GetPartitionStates_Call {
+func (_c *MockQueryCoord_CreateResourceGroup_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_CreateResourceGroup_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoord_GetPartitionStates_Call) RunAndReturn(run func(context.Context, *querypb.GetPartitionStatesRequest) (*querypb.GetPartitionStatesResponse, error)) *MockQueryCoord_GetPartitionStates_Call {
+func (_c *MockQueryCoord_CreateResourceGroup_Call) RunAndReturn(run func(context.Context, *milvuspb.CreateResourceGroupRequest) (*commonpb.Status, error)) *MockQueryCoord_CreateResourceGroup_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetQueryNodeDistribution provides a mock function with given fields: _a0, _a1
-func (_m *MockQueryCoord) GetQueryNodeDistribution(_a0 context.Context, _a1 *querypb.GetQueryNodeDistributionRequest) (*querypb.GetQueryNodeDistributionResponse, error) {
+// CreateResourceGroupWithAutoFill provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) CreateResourceGroupWithAutoFill(_a0 context.Context, _a1 *querypb.CreateResourceGroupWithAutoFillRequest) (*querypb.CreateResourceGroupWithAutoFillResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *querypb.GetQueryNodeDistributionResponse
+	var r0 *querypb.CreateResourceGroupWithAutoFillResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetQueryNodeDistributionRequest) (*querypb.GetQueryNodeDistributionResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CreateResourceGroupWithAutoFillRequest) (*querypb.CreateResourceGroupWithAutoFillResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetQueryNodeDistributionRequest) *querypb.GetQueryNodeDistributionResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CreateResourceGroupWithAutoFillRequest) *querypb.CreateResourceGroupWithAutoFillResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*querypb.GetQueryNodeDistributionResponse)
+			r0 = ret.Get(0).(*querypb.CreateResourceGroupWithAutoFillResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetQueryNodeDistributionRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.CreateResourceGroupWithAutoFillRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -610,53 +610,53 @@ func (_m *MockQueryCoord) GetQueryNodeDistribution(_a0 context.Context, _a1 *que
 	return r0, r1
 }
 
-// MockQueryCoord_GetQueryNodeDistribution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQueryNodeDistribution'
-type MockQueryCoord_GetQueryNodeDistribution_Call struct {
+// MockQueryCoord_CreateResourceGroupWithAutoFill_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateResourceGroupWithAutoFill'
+type MockQueryCoord_CreateResourceGroupWithAutoFill_Call struct {
 	*mock.Call
 }
 
-// GetQueryNodeDistribution is a helper method to define mock.On call
+// CreateResourceGroupWithAutoFill is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *querypb.GetQueryNodeDistributionRequest
-func (_e *MockQueryCoord_Expecter) GetQueryNodeDistribution(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetQueryNodeDistribution_Call {
-	return &MockQueryCoord_GetQueryNodeDistribution_Call{Call: _e.mock.On("GetQueryNodeDistribution", _a0, _a1)}
+//   - _a1 *querypb.CreateResourceGroupWithAutoFillRequest
+func (_e *MockQueryCoord_Expecter) CreateResourceGroupWithAutoFill(_a0 interface{}, _a1 interface{}) *MockQueryCoord_CreateResourceGroupWithAutoFill_Call {
+	return &MockQueryCoord_CreateResourceGroupWithAutoFill_Call{Call: _e.mock.On("CreateResourceGroupWithAutoFill", _a0, _a1)}
 }
 
-func (_c *MockQueryCoord_GetQueryNodeDistribution_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetQueryNodeDistributionRequest)) *MockQueryCoord_GetQueryNodeDistribution_Call {
+func (_c *MockQueryCoord_CreateResourceGroupWithAutoFill_Call) Run(run func(_a0 context.Context, _a1 *querypb.CreateResourceGroupWithAutoFillRequest)) *MockQueryCoord_CreateResourceGroupWithAutoFill_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*querypb.GetQueryNodeDistributionRequest))
+		run(args[0].(context.Context), args[1].(*querypb.CreateResourceGroupWithAutoFillRequest))
 	})
 	return _c
 }
 
-func (_c *MockQueryCoord_GetQueryNodeDistribution_Call) Return(_a0 *querypb.GetQueryNodeDistributionResponse, _a1 error) *MockQueryCoord_GetQueryNodeDistribution_Call {
+func (_c *MockQueryCoord_CreateResourceGroupWithAutoFill_Call) Return(_a0 *querypb.CreateResourceGroupWithAutoFillResponse, _a1 error) *MockQueryCoord_CreateResourceGroupWithAutoFill_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoord_GetQueryNodeDistribution_Call) RunAndReturn(run func(context.Context, *querypb.GetQueryNodeDistributionRequest) (*querypb.GetQueryNodeDistributionResponse, error)) *MockQueryCoord_GetQueryNodeDistribution_Call {
+func (_c *MockQueryCoord_CreateResourceGroupWithAutoFill_Call) RunAndReturn(run func(context.Context, *querypb.CreateResourceGroupWithAutoFillRequest) (*querypb.CreateResourceGroupWithAutoFillResponse, error)) *MockQueryCoord_CreateResourceGroupWithAutoFill_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetReplicas provides a mock function with given fields: _a0, _a1
-func (_m *MockQueryCoord) GetReplicas(_a0 context.Context, _a1 *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error) {
+// DeactivateChecker provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) DeactivateChecker(_a0 context.Context, _a1 *querypb.DeactivateCheckerRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *milvuspb.GetReplicasResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DeactivateCheckerRequest) (*commonpb.Status, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetReplicasRequest) *milvuspb.GetReplicasResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DeactivateCheckerRequest) *commonpb.Status); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.GetReplicasResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetReplicasRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.DeactivateCheckerRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -665,53 +665,53 @@ func (_m *MockQueryCoord) GetReplicas(_a0 context.Context, _a1 *milvuspb.GetRepl
 	return r0, r1
 }
 
-// MockQueryCoord_GetReplicas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReplicas'
-type MockQueryCoord_GetReplicas_Call struct {
+// MockQueryCoord_DeactivateChecker_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeactivateChecker'
+type MockQueryCoord_DeactivateChecker_Call struct {
 	*mock.Call
 }
 
-// GetReplicas is a helper method to define mock.On call
+// DeactivateChecker is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *milvuspb.GetReplicasRequest
-func (_e *MockQueryCoord_Expecter) GetReplicas(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetReplicas_Call {
-	return &MockQueryCoord_GetReplicas_Call{Call: _e.mock.On("GetReplicas", _a0, _a1)}
+//   - _a1 *querypb.DeactivateCheckerRequest
+func (_e *MockQueryCoord_Expecter) DeactivateChecker(_a0 interface{}, _a1 interface{}) *MockQueryCoord_DeactivateChecker_Call {
+	return &MockQueryCoord_DeactivateChecker_Call{Call: _e.mock.On("DeactivateChecker", _a0, _a1)}
 }
 
-func (_c *MockQueryCoord_GetReplicas_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.GetReplicasRequest)) *MockQueryCoord_GetReplicas_Call {
+func (_c *MockQueryCoord_DeactivateChecker_Call) Run(run func(_a0 context.Context, _a1 *querypb.DeactivateCheckerRequest)) *MockQueryCoord_DeactivateChecker_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.GetReplicasRequest))
+		run(args[0].(context.Context), args[1].(*querypb.DeactivateCheckerRequest))
 	})
 	return _c
 }
 
-func (_c *MockQueryCoord_GetReplicas_Call) Return(_a0 *milvuspb.GetReplicasResponse, _a1 error) *MockQueryCoord_GetReplicas_Call {
+func (_c *MockQueryCoord_DeactivateChecker_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_DeactivateChecker_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoord_GetReplicas_Call) RunAndReturn(run func(context.Context, *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error)) *MockQueryCoord_GetReplicas_Call {
+func (_c *MockQueryCoord_DeactivateChecker_Call) RunAndReturn(run func(context.Context, *querypb.DeactivateCheckerRequest) (*commonpb.Status, error)) *MockQueryCoord_DeactivateChecker_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSegmentInfo provides a mock function with given fields: _a0, _a1
-func (_m *MockQueryCoord) GetSegmentInfo(_a0 context.Context, _a1 *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
+// DecommissionNode provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) DecommissionNode(_a0 context.Context, _a1 *querypb.DecommissionNodeRequest) (*querypb.DecommissionNodeResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *querypb.GetSegmentInfoResponse
+	var r0 *querypb.DecommissionNodeResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DecommissionNodeRequest) (*querypb.DecommissionNodeResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetSegmentInfoRequest) *querypb.GetSegmentInfoResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DecommissionNodeRequest) *querypb.DecommissionNodeResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*querypb.GetSegmentInfoResponse)
+			r0 = ret.Get(0).(*querypb.DecommissionNodeResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetSegmentInfoRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.DecommissionNodeRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -720,53 +720,53 @@ func (_m *MockQueryCoord) GetSegmentInfo(_a0 context.Context, _a1 *querypb.GetSe
 	return r0, r1
 }
 
-// MockQueryCoord_GetSegmentInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentInfo'
-type MockQueryCoord_GetSegmentInfo_Call struct {
+// MockQueryCoord_DecommissionNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DecommissionNode'
+type MockQueryCoord_DecommissionNode_Call struct {
 	*mock.Call
 }
 
-// GetSegmentInfo is a helper method to define mock.On call
+// DecommissionNode is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *querypb.GetSegmentInfoRequest
-func (_e *MockQueryCoord_Expecter) GetSegmentInfo(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetSegmentInfo_Call {
-	return &MockQueryCoord_GetSegmentInfo_Call{Call: _e.mock.On("GetSegmentInfo", _a0, _a1)}
+//   - _a1 *querypb.DecommissionNodeRequest
+func (_e *MockQueryCoord_Expecter) DecommissionNode(_a0 interface{}, _a1 interface{}) *MockQueryCoord_DecommissionNode_Call {
+	return &MockQueryCoord_DecommissionNode_Call{Call: _e.mock.On("DecommissionNode", _a0, _a1)}
 }
 
-func (_c *MockQueryCoord_GetSegmentInfo_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetSegmentInfoRequest)) *MockQueryCoord_GetSegmentInfo_Call {
+func (_c *MockQueryCoord_DecommissionNode_Call) Run(run func(_a0 context.Context, _a1 *querypb.DecommissionNodeRequest)) *MockQueryCoord_DecommissionNode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*querypb.GetSegmentInfoRequest))
+		run(args[0].(context.Context), args[1].(*querypb.DecommissionNodeRequest))
 	})
 	return _c
 }
 
-func (_c *MockQueryCoord_GetSegmentInfo_Call) Return(_a0 *querypb.GetSegmentInfoResponse, _a1 error) *MockQueryCoord_GetSegmentInfo_Call {
+func (_c *MockQueryCoord_DecommissionNode_Call) Return(_a0 *querypb.DecommissionNodeResponse, _a1 error) *MockQueryCoord_DecommissionNode_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoord_GetSegmentInfo_Call) RunAndReturn(run func(context.Context, *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error)) *MockQueryCoord_GetSegmentInfo_Call {
+func (_c *MockQueryCoord_DecommissionNode_Call) RunAndReturn(run func(context.Context, *querypb.DecommissionNodeRequest) (*querypb.DecommissionNodeResponse, error)) *MockQueryCoord_DecommissionNode_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetShardLeaders provides a mock function with given fields: _a0, _a1
-func (_m *MockQueryCoord) GetShardLeaders(_a0 context.Context, _a1 *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error) {
+// DescribeChecker provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) DescribeChecker(_a0 context.Context, _a1 *querypb.DescribeCheckerRequest) (*querypb.DescribeCheckerResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *querypb.GetShardLeadersResponse
+	var r0 *querypb.DescribeCheckerResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DescribeCheckerRequest) (*querypb.DescribeCheckerResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetShardLeadersRequest) *querypb.GetShardLeadersResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DescribeCheckerRequest) *querypb.DescribeCheckerResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*querypb.GetShardLeadersResponse)
+			r0 = ret.Get(0).(*querypb.DescribeCheckerResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetShardLeadersRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.DescribeCheckerRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -775,53 +775,53 @@ func (_m *MockQueryCoord) GetShardLeaders(_a0 context.Context, _a1 *querypb.GetS
 	return r0, r1
 }
 
-// MockQueryCoord_GetShardLeaders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetShardLeaders'
-type MockQueryCoord_GetShardLeaders_Call struct {
+// MockQueryCoord_DescribeChecker_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeChecker'
+type MockQueryCoord_DescribeChecker_Call struct {
 	*mock.Call
 }
 
-// GetShardLeaders is a helper method to define mock.On call
+// DescribeChecker is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *querypb.GetShardLeadersRequest
-func (_e *MockQueryCoord_Expecter) GetShardLeaders(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetShardLeaders_Call {
-	return &MockQueryCoord_GetShardLeaders_Call{Call: _e.mock.On("GetShardLeaders", _a0, _a1)}
+//   - _a1 *querypb.DescribeCheckerRequest
+func (_e *MockQueryCoord_Expecter) DescribeChecker(_a0 interface{}, _a1 interface{}) *MockQueryCoord_DescribeChecker_Call {
+	return &MockQueryCoord_DescribeChecker_Call{Call: _e.mock.On("DescribeChecker", _a0, _a1)}
 }
 
-func (_c *MockQueryCoord_GetShardLeaders_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetShardLeadersRequest)) *MockQueryCoord_GetShardLeaders_Call {
+func (_c *MockQueryCoord_DescribeChecker_Call) Run(run func(_a0 context.Context, _a1 *querypb.DescribeCheckerRequest)) *MockQueryCoord_DescribeChecker_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*querypb.GetShardLeadersRequest))
+		run(args[0].(context.Context), args[1].(*querypb.DescribeCheckerRequest))
 	})
 	return _c
 }
 
-func (_c *MockQueryCoord_GetShardLeaders_Call) Return(_a0 *querypb.GetShardLeadersResponse, _a1 error) *MockQueryCoord_GetShardLeaders_Call {
+func (_c *MockQueryCoord_DescribeChecker_Call) Return(_a0 *querypb.DescribeCheckerResponse, _a1 error) *MockQueryCoord_DescribeChecker_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoord_GetShardLeaders_Call) RunAndReturn(run func(context.Context, *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error)) *MockQueryCoord_GetShardLeaders_Call {
+func (_c *MockQueryCoord_DescribeChecker_Call) RunAndReturn(run func(context.Context, *querypb.DescribeCheckerRequest) (*querypb.DescribeCheckerResponse, error)) *MockQueryCoord_DescribeChecker_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetStatisticsChannel provides a mock function with given fields: _a0, _a1
-func (_m *MockQueryCoord) GetStatisticsChannel(_a0 context.Context, _a1 *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error) {
+// DescribeReplica provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) DescribeReplica(_a0 context.Context, _a1 *querypb.DescribeReplicaRequest) (*querypb.DescribeReplicaResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *milvuspb.StringResponse
+	var r0 *querypb.DescribeReplicaResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DescribeReplicaRequest) (*querypb.DescribeReplicaResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetStatisticsChannelRequest) *milvuspb.StringResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DescribeReplicaRequest) *querypb.DescribeReplicaResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.StringResponse)
+			r0 = ret.Get(0).(*querypb.DescribeReplicaResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetStatisticsChannelRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.DescribeReplicaRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -830,53 +830,53 @@ func (_m *MockQueryCoord) GetStatisticsChannel(_a0 context.Context, _a1 *interna
 	return r0, r1
 }
 
-// MockQueryCoord_GetStatisticsChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatisticsChannel'
-type MockQueryCoord_GetStatisticsChannel_Call struct {
+// MockQueryCoord_DescribeReplica_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeReplica'
+type MockQueryCoord_DescribeReplica_Call struct {
 	*mock.Call
 }
 
-// GetStatisticsChannel is a helper method to define mock.On call
+// DescribeReplica is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *internalpb.GetStatisticsChannelRequest
-func (_e *MockQueryCoord_Expecter) GetStatisticsChannel(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetStatisticsChannel_Call {
-	return &MockQueryCoord_GetStatisticsChannel_Call{Call: _e.mock.On("GetStatisticsChannel", _a0, _a1)}
+//   - _a1 *querypb.DescribeReplicaRequest
+func (_e *MockQueryCoord_Expecter) DescribeReplica(_a0 interface{}, _a1 interface{}) *MockQueryCoord_DescribeReplica_Call {
+	return &MockQueryCoord_DescribeReplica_Call{Call: _e.mock.On("DescribeReplica", _a0, _a1)}
 }
 
-func (_c *MockQueryCoord_GetStatisticsChannel_Call) Run(run func(_a0 context.Context, _a1 *internalpb.GetStatisticsChannelRequest)) *MockQueryCoord_GetStatisticsChannel_Call {
+func (_c *MockQueryCoord_DescribeReplica_Call) Run(run func(_a0 context.Context, _a1 *querypb.DescribeReplicaRequest)) *MockQueryCoord_DescribeReplica_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*internalpb.GetStatisticsChannelRequest))
+		run(args[0].(context.Context), args[1].(*querypb.DescribeReplicaRequest))
 	})
 	return _c
 }
 
-func (_c *MockQueryCoord_GetStatisticsChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockQueryCoord_GetStatisticsChannel_Call {
+func (_c *MockQueryCoord_DescribeReplica_Call) Return(_a0 *querypb.DescribeReplicaResponse, _a1 error) *MockQueryCoord_DescribeReplica_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoord_GetStatisticsChannel_Call) RunAndReturn(run func(context.Context, *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error)) *MockQueryCoord_GetStatisticsChannel_Call {
+func (_c *MockQueryCoord_DescribeReplica_Call) RunAndReturn(run func(context.Context, *querypb.DescribeReplicaRequest) (*querypb.DescribeReplicaResponse, error)) *MockQueryCoord_DescribeReplica_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTimeTickChannel provides a mock function with given fields: _a0, _a1
-func (_m *MockQueryCoord) GetTimeTickChannel(_a0 context.Context, _a1 *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error) {
+// DescribeResourceGroup provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) DescribeResourceGroup(_a0 context.Context, _a1 *querypb.DescribeResourceGroupRequest) (*querypb.DescribeResourceGroupResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *milvuspb.StringResponse
+	var r0 *querypb.DescribeResourceGroupResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DescribeResourceGroupRequest) (*querypb.DescribeResourceGroupResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetTimeTickChannelRequest) *milvuspb.StringResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DescribeResourceGroupRequest) *querypb.DescribeResourceGroupResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.StringResponse)
+			r0 = ret.Get(0).(*querypb.DescribeResourceGroupResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetTimeTickChannelRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.DescribeResourceGroupRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -885,94 +885,108 @@ func (_m *MockQueryCoord) GetTimeTickChannel(_a0 context.Context, _a1 *internalp
 	return r0, r1
 }
 
-// MockQueryCoord_GetTimeTickChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTimeTickChannel'
-type MockQueryCoord_GetTimeTickChannel_Call struct {
+// MockQueryCoord_DescribeResourceGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeResourceGroup'
+type MockQueryCoord_DescribeResourceGroup_Call struct {
 	*mock.Call
 }
 
-// GetTimeTickChannel is a helper method to define mock.On call
+// DescribeResourceGroup is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *internalpb.GetTimeTickChannelRequest
-func (_e *MockQueryCoord_Expecter) GetTimeTickChannel(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetTimeTickChannel_Call {
-	return &MockQueryCoord_GetTimeTickChannel_Call{Call: _e.mock.On("GetTimeTickChannel", _a0, _a1)}
+//   - _a1 *querypb.DescribeResourceGroupRequest
+func (_e *MockQueryCoord_Expecter) DescribeResourceGroup(_a0 interface{}, _a1 interface{}) *MockQueryCoord_DescribeResourceGroup_Call {
+	return &MockQueryCoord_DescribeResourceGroup_Call{Call: _e.mock.On("DescribeResourceGroup", _a0, _a1)}
 }
 
-func (_c *MockQueryCoord_GetTimeTickChannel_Call) Run(run func(_a0 context.Context, _a1 *internalpb.GetTimeTickChannelRequest)) *MockQueryCoord_GetTimeTickChannel_Call {
+func (_c *MockQueryCoord_DescribeResourceGroup_Call) Run(run func(_a0 context.Context, _a1 *querypb.DescribeResourceGroupRequest)) *MockQueryCoord_DescribeResourceGroup_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*internalpb.GetTimeTickChannelRequest))
+		run(args[0].(context.Context), args[1].(*querypb.DescribeResourceGroupRequest))
 	})
 	return _c
 }
 
-func (_c *MockQueryCoord_GetTimeTickChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockQueryCoord_GetTimeTickChannel_Call {
+func (_c *MockQueryCoord_DescribeResourceGroup_Call) Return(_a0 *querypb.DescribeResourceGroupResponse, _a1 error) *MockQueryCoord_DescribeResourceGroup_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoord_GetTimeTickChannel_Call) RunAndReturn(run func(context.Context, *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error)) *MockQueryCoord_GetTimeTickChannel_Call {
+func (_c *MockQueryCoord_DescribeResourceGroup_Call) RunAndReturn(run func(context.Context, *querypb.DescribeResourceGroupRequest) (*querypb.DescribeResourceGroupResponse, error)) *MockQueryCoord_DescribeResourceGroup_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Init provides a mock function with given fields:
-func (_m *MockQueryCoord) Init() error {
-	ret := _m.Called()
+// DropResourceGroup provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) DropResourceGroup(_a0 context.Context, _a1 *milvuspb.DropResourceGroupRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func() error); ok {
-		r0 = rf()
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropResourceGroupRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropResourceGroupRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DropResourceGroupRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockQueryCoord_Init_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Init'
-type MockQueryCoord_Init_Call struct {
+// MockQueryCoord_DropResourceGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropResourceGroup'
+type MockQueryCoord_DropResourceGroup_Call struct {
 	*mock.Call
 }
 
-// Init is a helper method to define mock.On call
-func (_e *MockQueryCoord_Expecter) Init() *MockQueryCoord_Init_Call {
-	return &MockQueryCoord_Init_Call{Call: _e.mock.On("Init")}
+// DropResourceGroup is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.DropResourceGroupRequest
+func (_e *MockQueryCoord_Expecter) DropResourceGroup(_a0 interface{}, _a1 interface{}) *MockQueryCoord_DropResourceGroup_Call {
+	return &MockQueryCoord_DropResourceGroup_Call{Call: _e.mock.On("DropResourceGroup", _a0, _a1)}
 }
 
-func (_c *MockQueryCoord_Init_Call) Run(run func()) *MockQueryCoord_Init_Call {
+func (_c *MockQueryCoord_DropResourceGroup_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.DropResourceGroupRequest)) *MockQueryCoord_DropResourceGroup_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(context.Context), args[1].(*milvuspb.DropResourceGroupRequest))
 	})
 	return _c
 }
 
-func (_c *MockQueryCoord_Init_Call) Return(_a0 error) *MockQueryCoord_Init_Call {
-	_c.Call.Return(_a0)
+func (_c *MockQueryCoord_DropResourceGroup_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_DropResourceGroup_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoord_Init_Call) RunAndReturn(run func() error) *MockQueryCoord_Init_Call {
+func (_c *MockQueryCoord_DropResourceGroup_Call) RunAndReturn(run func(context.Context, *milvuspb.DropResourceGroupRequest) (*commonpb.Status, error)) *MockQueryCoord_DropResourceGroup_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListCheckers provides a mock function with given fields: _a0, _a1
-func (_m *MockQueryCoord) ListCheckers(_a0 context.Context, _a1 *querypb.ListCheckersRequest) (*querypb.ListCheckersResponse, error) {
+// DryRunLoadBalance provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) DryRunLoadBalance(_a0 context.Context, _a1 *querypb.LoadBalanceRequest) (*querypb.DryRunLoadBalanceResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *querypb.ListCheckersResponse
+	var r0 *querypb.DryRunLoadBalanceResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ListCheckersRequest) (*querypb.ListCheckersResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.LoadBalanceRequest) (*querypb.DryRunLoadBalanceResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ListCheckersRequest) *querypb.ListCheckersResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.LoadBalanceRequest) *querypb.DryRunLoadBalanceResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*querypb.ListCheckersResponse)
+			r0 = ret.Get(0).(*querypb.DryRunLoadBalanceResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ListCheckersRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.LoadBalanceRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -981,53 +995,53 @@ func (_m *MockQueryCoord) ListCheckers(_a0 context.Context, _a1 *querypb.ListChe
 	return r0, r1
 }
 
-// MockQueryCoord_ListCheckers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCheckers'
-type MockQueryCoord_ListCheckers_Call struct {
+// MockQueryCoord_DryRunLoadBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DryRunLoadBalance'
+type MockQueryCoord_DryRunLoadBalance_Call struct {
 	*mock.Call
 }
 
-// ListCheckers is a helper method to define mock.On call
+// DryRunLoadBalance is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *querypb.ListCheckersRequest
-func (_e *MockQueryCoord_Expecter) ListCheckers(_a0 interface{}, _a1 interface{}) *MockQueryCoord_ListCheckers_Call {
-	return &MockQueryCoord_ListCheckers_Call{Call: _e.mock.On("ListCheckers", _a0, _a1)}
+//   - _a1 *querypb.LoadBalanceRequest
+func (_e *MockQueryCoord_Expecter) DryRunLoadBalance(_a0 interface{}, _a1 interface{}) *MockQueryCoord_DryRunLoadBalance_Call {
+	return &MockQueryCoord_DryRunLoadBalance_Call{Call: _e.mock.On("DryRunLoadBalance", _a0, _a1)}
 }
 
-func (_c *MockQueryCoord_ListCheckers_Call) Run(run func(_a0 context.Context, _a1 *querypb.ListCheckersRequest)) *MockQueryCoord_ListCheckers_Call {
+func (_c *MockQueryCoord_DryRunLoadBalance_Call) Run(run func(_a0 context.Context, _a1 *querypb.LoadBalanceRequest)) *MockQueryCoord_DryRunLoadBalance_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*querypb.ListCheckersRequest))
+		run(args[0].(context.Context), args[1].(*querypb.LoadBalanceRequest))
 	})
 	return _c
 }
 
-func (_c *MockQueryCoord_ListCheckers_Call) Return(_a0 *querypb.ListCheckersResponse, _a1 error) *MockQueryCoord_ListCheckers_Call {
+func (_c *MockQueryCoord_DryRunLoadBalance_Call) Return(_a0 *querypb.DryRunLoadBalanceResponse, _a1 error) *MockQueryCoord_DryRunLoadBalance_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoord_ListCheckers_Call) RunAndReturn(run func(context.Context, *querypb.ListCheckersRequest) (*querypb.ListCheckersResponse, error)) *MockQueryCoord_ListCheckers_Call {
+func (_c *MockQueryCoord_DryRunLoadBalance_Call) RunAndReturn(run func(context.Context, *querypb.LoadBalanceRequest) (*querypb.DryRunLoadBalanceResponse, error)) *MockQueryCoord_DryRunLoadBalance_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListQueryNode provides a mock function with given fields: _a0, _a1
-func (_m *MockQueryCoord) ListQueryNode(_a0 context.Context, _a1 *querypb.ListQueryNodeRequest) (*querypb.ListQueryNodeResponse, error) {
+// DryRunRebalanceCollection provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) DryRunRebalanceCollection(_a0 context.Context, _a1 *querypb.RebalanceCollectionRequest) (*querypb.RebalanceCollectionResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *querypb.ListQueryNodeResponse
+	var r0 *querypb.RebalanceCollectionResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ListQueryNodeRequest) (*querypb.ListQueryNodeResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.RebalanceCollectionRequest) (*querypb.RebalanceCollectionResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ListQueryNodeRequest) *querypb.ListQueryNodeResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.RebalanceCollectionRequest) *querypb.RebalanceCollectionResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*querypb.ListQueryNodeResponse)
+			r0 = ret.Get(0).(*querypb.RebalanceCollectionResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ListQueryNodeRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.RebalanceCollectionRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1036,53 +1050,53 @@ func (_m *MockQueryCoord) ListQueryNode(_a0 context.Context, _a1 *querypb.ListQu
 	return r0, r1
 }
 
-// MockQueryCoord_ListQueryNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListQueryNode'
-type MockQueryCoord_ListQueryNode_Call struct {
+// MockQueryCoord_DryRunRebalanceCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DryRunRebalanceCollection'
+type MockQueryCoord_DryRunRebalanceCollection_Call struct {
 	*mock.Call
 }
 
-// ListQueryNode is a helper method to define mock.On call
+// DryRunRebalanceCollection is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *querypb.ListQueryNodeRequest
-func (_e *MockQueryCoord_Expecter) ListQueryNode(_a0 interface{}, _a1 interface{}) *MockQueryCoord_ListQueryNode_Call {
-	return &MockQueryCoord_ListQueryNode_Call{Call: _e.mock.On("ListQueryNode", _a0, _a1)}
+//   - _a1 *querypb.RebalanceCollectionRequest
+func (_e *MockQueryCoord_Expecter) DryRunRebalanceCollection(_a0 interface{}, _a1 interface{}) *MockQueryCoord_DryRunRebalanceCollection_Call {
+	return &MockQueryCoord_DryRunRebalanceCollection_Call{Call: _e.mock.On("DryRunRebalanceCollection", _a0, _a1)}
 }
 
-func (_c *MockQueryCoord_ListQueryNode_Call) Run(run func(_a0 context.Context, _a1 *querypb.ListQueryNodeRequest)) *MockQueryCoord_ListQueryNode_Call {
+func (_c *MockQueryCoord_DryRunRebalanceCollection_Call) Run(run func(_a0 context.Context, _a1 *querypb.RebalanceCollectionRequest)) *MockQueryCoord_DryRunRebalanceCollection_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*querypb.ListQueryNodeRequest))
+		run(args[0].(context.Context), args[1].(*querypb.RebalanceCollectionRequest))
 	})
 	return _c
 }
 
-func (_c *MockQueryCoord_ListQueryNode_Call) Return(_a0 *querypb.ListQueryNodeResponse, _a1 error) *MockQueryCoord_ListQueryNode_Call {
+func (_c *MockQueryCoord_DryRunRebalanceCollection_Call) Return(_a0 *querypb.RebalanceCollectionResponse, _a1 error) *MockQueryCoord_DryRunRebalanceCollection_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoord_ListQueryNode_Call) RunAndReturn(run func(context.Context, *querypb.ListQueryNodeRequest) (*querypb.ListQueryNodeResponse, error)) *MockQueryCoord_ListQueryNode_Call {
+func (_c *MockQueryCoord_DryRunRebalanceCollection_Call) RunAndReturn(run func(context.Context, *querypb.RebalanceCollectionRequest) (*querypb.RebalanceCollectionResponse, error)) *MockQueryCoord_DryRunRebalanceCollection_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListResourceGroups provides a mock function with given fields: _a0, _a1
-func (_m *MockQueryCoord) ListResourceGroups(_a0 context.Context, _a1 *milvuspb.ListResourceGroupsRequest) (*milvuspb.ListResourceGroupsResponse, error) {
+// ForceSync provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) ForceSync(_a0 context.Context, _a1 *querypb.ForceSyncRequest) (*querypb.ForceSyncResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *milvuspb.ListResourceGroupsResponse
+	var r0 *querypb.ForceSyncResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListResourceGroupsRequest) (*milvuspb.ListResourceGroupsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ForceSyncRequest) (*querypb.ForceSyncResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListResourceGroupsRequest) *milvuspb.ListResourceGroupsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ForceSyncRequest) *querypb.ForceSyncResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.ListResourceGroupsResponse)
+			r0 = ret.Get(0).(*querypb.ForceSyncResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.ListResourceGroupsRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ForceSyncRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1091,53 +1105,53 @@ func (_m *MockQueryCoord) ListResourceGroups(_a0 context.Context, _a1 *milvuspb.
 	return r0, r1
 }
 
-// MockQueryCoord_ListResourceGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListResourceGroups'
-type MockQueryCoord_ListResourceGroups_Call struct {
+// MockQueryCoord_ForceSync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForceSync'
+type MockQueryCoord_ForceSync_Call struct {
 	*mock.Call
 }
 
-// ListResourceGroups is a helper method to define mock.On call
+// ForceSync is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *milvuspb.ListResourceGroupsRequest
-func (_e *MockQueryCoord_Expecter) ListResourceGroups(_a0 interface{}, _a1 interface{}) *MockQueryCoord_ListResourceGroups_Call {
-	return &MockQueryCoord_ListResourceGroups_Call{Call: _e.mock.On("ListResourceGroups", _a0, _a1)}
+//   - _a1 *querypb.ForceSyncRequest
+func (_e *MockQueryCoord_Expecter) ForceSync(_a0 interface{}, _a1 interface{}) *MockQueryCoord_ForceSync_Call {
+	return &MockQueryCoord_ForceSync_Call{Call: _e.mock.On("ForceSync", _a0, _a1)}
 }
 
-func (_c *MockQueryCoord_ListResourceGroups_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.ListResourceGroupsRequest)) *MockQueryCoord_ListResourceGroups_Call {
+func (_c *MockQueryCoord_ForceSync_Call) Run(run func(_a0 context.Context, _a1 *querypb.ForceSyncRequest)) *MockQueryCoord_ForceSync_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.ListResourceGroupsRequest))
+		run(args[0].(context.Context), args[1].(*querypb.ForceSyncRequest))
 	})
 	return _c
 }
 
-func (_c *MockQueryCoord_ListResourceGroups_Call) Return(_a0 *milvuspb.ListResourceGroupsResponse, _a1 error) *MockQueryCoord_ListResourceGroups_Call {
+func (_c *MockQueryCoord_ForceSync_Call) Return(_a0 *querypb.ForceSyncResponse, _a1 error) *MockQueryCoord_ForceSync_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoord_ListResourceGroups_Call) RunAndReturn(run func(context.Context, *milvuspb.ListResourceGroupsRequest) (*milvuspb.ListResourceGroupsResponse, error)) *MockQueryCoord_ListResourceGroups_Call {
+func (_c *MockQueryCoord_ForceSync_Call) RunAndReturn(run func(context.Context, *querypb.ForceSyncRequest) (*querypb.ForceSyncResponse, error)) *MockQueryCoord_ForceSync_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LoadBalance provides a mock function with given fields: _a0, _a1
-func (_m *MockQueryCoord) LoadBalance(_a0 context.Context, _a1 *querypb.LoadBalanceRequest) (*commonpb.Status, error) {
+// GetAvailabilitySLA provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetAvailabilitySLA(_a0 context.Context, _a1 *querypb.GetAvailabilitySLARequest) (*querypb.GetAvailabilitySLAResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *commonpb.Status
+	var r0 *querypb.GetAvailabilitySLAResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.LoadBalanceRequest) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetAvailabilitySLARequest) (*querypb.GetAvailabilitySLAResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.LoadBalanceRequest) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetAvailabilitySLARequest) *querypb.GetAvailabilitySLAResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*commonpb.Status)
+			r0 = ret.Get(0).(*querypb.GetAvailabilitySLAResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.LoadBalanceRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetAvailabilitySLARequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1146,53 +1160,53 @@ func (_m *MockQueryCoord) LoadBalance(_a0 context.Context, _a1 *querypb.LoadBala
 	return r0, r1
 }
 
-// MockQueryCoord_LoadBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadBalance'
-type MockQueryCoord_LoadBalance_Call struct {
+// MockQueryCoord_GetAvailabilitySLA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAvailabilitySLA'
+type MockQueryCoord_GetAvailabilitySLA_Call struct {
 	*mock.Call
 }
 
-// LoadBalance is a helper method to define mock.On call
+// GetAvailabilitySLA is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *querypb.LoadBalanceRequest
-func (_e *MockQueryCoord_Expecter) LoadBalance(_a0 interface{}, _a1 interface{}) *MockQueryCoord_LoadBalance_Call {
-	return &MockQueryCoord_LoadBalance_Call{Call: _e.mock.On("LoadBalance", _a0, _a1)}
+//   - _a1 *querypb.GetAvailabilitySLARequest
+func (_e *MockQueryCoord_Expecter) GetAvailabilitySLA(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetAvailabilitySLA_Call {
+	return &MockQueryCoord_GetAvailabilitySLA_Call{Call: _e.mock.On("GetAvailabilitySLA", _a0, _a1)}
 }
 
-func (_c *MockQueryCoord_LoadBalance_Call) Run(run func(_a0 context.Context, _a1 *querypb.LoadBalanceRequest)) *MockQueryCoord_LoadBalance_Call {
+func (_c *MockQueryCoord_GetAvailabilitySLA_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetAvailabilitySLARequest)) *MockQueryCoord_GetAvailabilitySLA_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*querypb.LoadBalanceRequest))
+		run(args[0].(context.Context), args[1].(*querypb.GetAvailabilitySLARequest))
 	})
 	return _c
 }
 
-func (_c *MockQueryCoord_LoadBalance_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_LoadBalance_Call {
+func (_c *MockQueryCoord_GetAvailabilitySLA_Call) Return(_a0 *querypb.GetAvailabilitySLAResponse, _a1 error) *MockQueryCoord_GetAvailabilitySLA_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoord_LoadBalance_Call) RunAndReturn(run func(context.Context, *querypb.LoadBalanceRequest) (*commonpb.Status, error)) *MockQueryCoord_LoadBalance_Call {
+func (_c *MockQueryCoord_GetAvailabilitySLA_Call) RunAndReturn(run func(context.Context, *querypb.GetAvailabilitySLARequest) (*querypb.GetAvailabilitySLAResponse, error)) *MockQueryCoord_GetAvailabilitySLA_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LoadCollection provides a mock function with given fields: _a0, _a1
-func (_m *MockQueryCoord) LoadCollection(_a0 context.Context, _a1 *querypb.LoadCollectionRequest) (*commonpb.Status, error) {
+// GetBalanceLayoutStatus provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetBalanceLayoutStatus(_a0 context.Context, _a1 *querypb.GetBalanceLayoutStatusRequest) (*querypb.GetBalanceLayoutStatusResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *commonpb.Status
+	var r0 *querypb.GetBalanceLayoutStatusResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.LoadCollectionRequest) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetBalanceLayoutStatusRequest) (*querypb.GetBalanceLayoutStatusResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.LoadCollectionRequest) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetBalanceLayoutStatusRequest) *querypb.GetBalanceLayoutStatusResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*commonpb.Status)
+			r0 = ret.Get(0).(*querypb.GetBalanceLayoutStatusResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.LoadCollectionRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetBalanceLayoutStatusRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1201,53 +1215,53 @@ func (_m *MockQueryCoord) LoadCollection(_a0 context.Context, _a1 *querypb.LoadC
 	return r0, r1
 }
 
-// MockQueryCoord_LoadCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadCollection'
-type MockQueryCoord_LoadCollection_Call struct {
+// MockQueryCoord_GetBalanceLayoutStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBalanceLayoutStatus'
+type MockQueryCoord_GetBalanceLayoutStatus_Call struct {
 	*mock.Call
 }
 
-// LoadCollection is a helper method to define mock.On call
+// GetBalanceLayoutStatus is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *querypb.LoadCollectionRequest
-func (_e *MockQueryCoord_Expecter) LoadCollection(_a0 interface{}, _a1 interface{}) *MockQueryCoord_LoadCollection_Call {
-	return &MockQueryCoord_LoadCollection_Call{Call: _e.mock.On("LoadCollection", _a0, _a1)}
+//   - _a1 *querypb.GetBalanceLayoutStatusRequest
+func (_e *MockQueryCoord_Expecter) GetBalanceLayoutStatus(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetBalanceLayoutStatus_Call {
+	return &MockQueryCoord_GetBalanceLayoutStatus_Call{Call: _e.mock.On("GetBalanceLayoutStatus", _a0, _a1)}
 }
 
-func (_c *MockQueryCoord_LoadCollection_Call) Run(run func(_a0 context.Context, _a1 *querypb.LoadCollectionRequest)) *MockQueryCoord_LoadCollection_Call {
+func (_c *MockQueryCoord_GetBalanceLayoutStatus_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetBalanceLayoutStatusRequest)) *MockQueryCoord_GetBalanceLayoutStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*querypb.LoadCollectionRequest))
+		run(args[0].(context.Context), args[1].(*querypb.GetBalanceLayoutStatusRequest))
 	})
 	return _c
 }
 
-func (_c *MockQueryCoord_LoadCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_LoadCollection_Call {
+func (_c *MockQueryCoord_GetBalanceLayoutStatus_Call) Return(_a0 *querypb.GetBalanceLayoutStatusResponse, _a1 error) *MockQueryCoord_GetBalanceLayoutStatus_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoord_LoadCollection_Call) RunAndReturn(run func(context.Context, *querypb.LoadCollectionRequest) (*commonpb.Status, error)) *MockQueryCoord_LoadCollection_Call {
+func (_c *MockQueryCoord_GetBalanceLayoutStatus_Call) RunAndReturn(run func(context.Context, *querypb.GetBalanceLayoutStatusRequest) (*querypb.GetBalanceLayoutStatusResponse, error)) *MockQueryCoord_GetBalanceLayoutStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LoadPartitions provides a mock function with given fields: _a0, _a1
-func (_m *MockQueryCoord) LoadPartitions(_a0 context.Context, _a1 *querypb.LoadPartitionsRequest) (*commonpb.Status, error) {
+// GetClusterLoadSummary provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetClusterLoadSummary(_a0 context.Context, _a1 *querypb.GetClusterLoadSummaryRequest) (*querypb.GetClusterLoadSummaryResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *commonpb.Status
+	var r0 *querypb.GetClusterLoadSummaryResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.LoadPartitionsRequest) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetClusterLoadSummaryRequest) (*querypb.GetClusterLoadSummaryResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.LoadPartitionsRequest) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetClusterLoadSummaryRequest) *querypb.GetClusterLoadSummaryResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*commonpb.Status)
+			r0 = ret.Get(0).(*querypb.GetClusterLoadSummaryResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.LoadPartitionsRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetClusterLoadSummaryRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1256,49 +1270,1960 @@ func (_m *MockQueryCoord) LoadPartitions(_a0 context.Context, _a1 *querypb.LoadP
 	return r0, r1
 }
 
-// MockQueryCoord_LoadPartitions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadPartitions'
-type MockQueryCoord_LoadPartitions_Call struct {
+// MockQueryCoord_GetClusterLoadSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClusterLoadSummary'
+type MockQueryCoord_GetClusterLoadSummary_Call struct {
 	*mock.Call
 }
 
-// LoadPartitions is a helper method to define mock.On call
+// GetClusterLoadSummary is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *querypb.LoadPartitionsRequest
-func (_e *MockQueryCoord_Expecter) LoadPartitions(_a0 interface{}, _a1 interface{}) *MockQueryCoord_LoadPartitions_Call {
-	return &MockQueryCoord_LoadPartitions_Call{Call: _e.mock.On("LoadPartitions", _a0, _a1)}
+//   - _a1 *querypb.GetClusterLoadSummaryRequest
+func (_e *MockQueryCoord_Expecter) GetClusterLoadSummary(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetClusterLoadSummary_Call {
+	return &MockQueryCoord_GetClusterLoadSummary_Call{Call: _e.mock.On("GetClusterLoadSummary", _a0, _a1)}
 }
 
-func (_c *MockQueryCoord_LoadPartitions_Call) Run(run func(_a0 context.Context, _a1 *querypb.LoadPartitionsRequest)) *MockQueryCoord_LoadPartitions_Call {
+func (_c *MockQueryCoord_GetClusterLoadSummary_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetClusterLoadSummaryRequest)) *MockQueryCoord_GetClusterLoadSummary_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*querypb.LoadPartitionsRequest))
+		run(args[0].(context.Context), args[1].(*querypb.GetClusterLoadSummaryRequest))
 	})
 	return _c
 }
 
-func (_c *MockQueryCoord_LoadPartitions_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_LoadPartitions_Call {
+func (_c *MockQueryCoord_GetClusterLoadSummary_Call) Return(_a0 *querypb.GetClusterLoadSummaryResponse, _a1 error) *MockQueryCoord_GetClusterLoadSummary_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoord_LoadPartitions_Call) RunAndReturn(run func(context.Context, *querypb.LoadPartitionsRequest) (*commonpb.Status, error)) *MockQueryCoord_LoadPartitions_Call {
+func (_c *MockQueryCoord_GetClusterLoadSummary_Call) RunAndReturn(run func(context.Context, *querypb.GetClusterLoadSummaryRequest) (*querypb.GetClusterLoadSummaryResponse, error)) *MockQueryCoord_GetClusterLoadSummary_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Register provides a mock function with given fields:
-func (_m *MockQueryCoord) Register() error {
-	ret := _m.Called()
+// GetCollectionLoadInfo provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetCollectionLoadInfo(_a0 context.Context, _a1 *querypb.GetCollectionLoadInfoRequest) (*querypb.GetCollectionLoadInfoResponse, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func() error); ok {
-		r0 = rf()
+	var r0 *querypb.GetCollectionLoadInfoResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetCollectionLoadInfoRequest) (*querypb.GetCollectionLoadInfoResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetCollectionLoadInfoRequest) *querypb.GetCollectionLoadInfoResponse); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetCollectionLoadInfoResponse)
+		}
 	}
 
-	return r0
-}
-
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetCollectionLoadInfoRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_GetCollectionLoadInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionLoadInfo'
+type MockQueryCoord_GetCollectionLoadInfo_Call struct {
+	*mock.Call
+}
+
+// GetCollectionLoadInfo is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.GetCollectionLoadInfoRequest
+func (_e *MockQueryCoord_Expecter) GetCollectionLoadInfo(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetCollectionLoadInfo_Call {
+	return &MockQueryCoord_GetCollectionLoadInfo_Call{Call: _e.mock.On("GetCollectionLoadInfo", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_GetCollectionLoadInfo_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetCollectionLoadInfoRequest)) *MockQueryCoord_GetCollectionLoadInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.GetCollectionLoadInfoRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_GetCollectionLoadInfo_Call) Return(_a0 *querypb.GetCollectionLoadInfoResponse, _a1 error) *MockQueryCoord_GetCollectionLoadInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_GetCollectionLoadInfo_Call) RunAndReturn(run func(context.Context, *querypb.GetCollectionLoadInfoRequest) (*querypb.GetCollectionLoadInfoResponse, error)) *MockQueryCoord_GetCollectionLoadInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCollectionTargets provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetCollectionTargets(_a0 context.Context, _a1 *querypb.GetCollectionTargetsRequest) (*querypb.GetCollectionTargetsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.GetCollectionTargetsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetCollectionTargetsRequest) (*querypb.GetCollectionTargetsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetCollectionTargetsRequest) *querypb.GetCollectionTargetsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetCollectionTargetsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetCollectionTargetsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_GetCollectionTargets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionTargets'
+type MockQueryCoord_GetCollectionTargets_Call struct {
+	*mock.Call
+}
+
+// GetCollectionTargets is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.GetCollectionTargetsRequest
+func (_e *MockQueryCoord_Expecter) GetCollectionTargets(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetCollectionTargets_Call {
+	return &MockQueryCoord_GetCollectionTargets_Call{Call: _e.mock.On("GetCollectionTargets", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_GetCollectionTargets_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetCollectionTargetsRequest)) *MockQueryCoord_GetCollectionTargets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.GetCollectionTargetsRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_GetCollectionTargets_Call) Return(_a0 *querypb.GetCollectionTargetsResponse, _a1 error) *MockQueryCoord_GetCollectionTargets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_GetCollectionTargets_Call) RunAndReturn(run func(context.Context, *querypb.GetCollectionTargetsRequest) (*querypb.GetCollectionTargetsResponse, error)) *MockQueryCoord_GetCollectionTargets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetComponentStates provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetComponentStates(_a0 context.Context, _a1 *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *milvuspb.ComponentStates
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest) *milvuspb.ComponentStates); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.ComponentStates)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetComponentStatesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_GetComponentStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComponentStates'
+type MockQueryCoord_GetComponentStates_Call struct {
+	*mock.Call
+}
+
+// GetComponentStates is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.GetComponentStatesRequest
+func (_e *MockQueryCoord_Expecter) GetComponentStates(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetComponentStates_Call {
+	return &MockQueryCoord_GetComponentStates_Call{Call: _e.mock.On("GetComponentStates", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_GetComponentStates_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.GetComponentStatesRequest)) *MockQueryCoord_GetComponentStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.GetComponentStatesRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_GetComponentStates_Call) Return(_a0 *milvuspb.ComponentStates, _a1 error) *MockQueryCoord_GetComponentStates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_GetComponentStates_Call) RunAndReturn(run func(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)) *MockQueryCoord_GetComponentStates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDecommissionProgress provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetDecommissionProgress(_a0 context.Context, _a1 *querypb.GetDecommissionProgressRequest) (*querypb.GetDecommissionProgressResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.GetDecommissionProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetDecommissionProgressRequest) (*querypb.GetDecommissionProgressResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetDecommissionProgressRequest) *querypb.GetDecommissionProgressResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetDecommissionProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetDecommissionProgressRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_GetDecommissionProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDecommissionProgress'
+type MockQueryCoord_GetDecommissionProgress_Call struct {
+	*mock.Call
+}
+
+// GetDecommissionProgress is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.GetDecommissionProgressRequest
+func (_e *MockQueryCoord_Expecter) GetDecommissionProgress(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetDecommissionProgress_Call {
+	return &MockQueryCoord_GetDecommissionProgress_Call{Call: _e.mock.On("GetDecommissionProgress", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_GetDecommissionProgress_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetDecommissionProgressRequest)) *MockQueryCoord_GetDecommissionProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.GetDecommissionProgressRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_GetDecommissionProgress_Call) Return(_a0 *querypb.GetDecommissionProgressResponse, _a1 error) *MockQueryCoord_GetDecommissionProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_GetDecommissionProgress_Call) RunAndReturn(run func(context.Context, *querypb.GetDecommissionProgressRequest) (*querypb.GetDecommissionProgressResponse, error)) *MockQueryCoord_GetDecommissionProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHandoffLag provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetHandoffLag(_a0 context.Context, _a1 *querypb.GetHandoffLagRequest) (*querypb.GetHandoffLagResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.GetHandoffLagResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetHandoffLagRequest) (*querypb.GetHandoffLagResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetHandoffLagRequest) *querypb.GetHandoffLagResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetHandoffLagResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetHandoffLagRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_GetHandoffLag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHandoffLag'
+type MockQueryCoord_GetHandoffLag_Call struct {
+	*mock.Call
+}
+
+// GetHandoffLag is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.GetHandoffLagRequest
+func (_e *MockQueryCoord_Expecter) GetHandoffLag(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetHandoffLag_Call {
+	return &MockQueryCoord_GetHandoffLag_Call{Call: _e.mock.On("GetHandoffLag", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_GetHandoffLag_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetHandoffLagRequest)) *MockQueryCoord_GetHandoffLag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.GetHandoffLagRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_GetHandoffLag_Call) Return(_a0 *querypb.GetHandoffLagResponse, _a1 error) *MockQueryCoord_GetHandoffLag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_GetHandoffLag_Call) RunAndReturn(run func(context.Context, *querypb.GetHandoffLagRequest) (*querypb.GetHandoffLagResponse, error)) *MockQueryCoord_GetHandoffLag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoadBalanceStatus provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetLoadBalanceStatus(_a0 context.Context, _a1 *querypb.GetLoadBalanceStatusRequest) (*querypb.GetLoadBalanceStatusResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.GetLoadBalanceStatusResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetLoadBalanceStatusRequest) (*querypb.GetLoadBalanceStatusResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetLoadBalanceStatusRequest) *querypb.GetLoadBalanceStatusResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetLoadBalanceStatusResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetLoadBalanceStatusRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_GetLoadBalanceStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoadBalanceStatus'
+type MockQueryCoord_GetLoadBalanceStatus_Call struct {
+	*mock.Call
+}
+
+// GetLoadBalanceStatus is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.GetLoadBalanceStatusRequest
+func (_e *MockQueryCoord_Expecter) GetLoadBalanceStatus(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetLoadBalanceStatus_Call {
+	return &MockQueryCoord_GetLoadBalanceStatus_Call{Call: _e.mock.On("GetLoadBalanceStatus", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_GetLoadBalanceStatus_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetLoadBalanceStatusRequest)) *MockQueryCoord_GetLoadBalanceStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.GetLoadBalanceStatusRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_GetLoadBalanceStatus_Call) Return(_a0 *querypb.GetLoadBalanceStatusResponse, _a1 error) *MockQueryCoord_GetLoadBalanceStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_GetLoadBalanceStatus_Call) RunAndReturn(run func(context.Context, *querypb.GetLoadBalanceStatusRequest) (*querypb.GetLoadBalanceStatusResponse, error)) *MockQueryCoord_GetLoadBalanceStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoadInfo provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetLoadInfo(_a0 context.Context, _a1 *querypb.GetLoadInfoRequest) (*querypb.GetLoadInfoResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.GetLoadInfoResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetLoadInfoRequest) (*querypb.GetLoadInfoResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetLoadInfoRequest) *querypb.GetLoadInfoResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetLoadInfoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetLoadInfoRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_GetLoadInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoadInfo'
+type MockQueryCoord_GetLoadInfo_Call struct {
+	*mock.Call
+}
+
+// GetLoadInfo is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.GetLoadInfoRequest
+func (_e *MockQueryCoord_Expecter) GetLoadInfo(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetLoadInfo_Call {
+	return &MockQueryCoord_GetLoadInfo_Call{Call: _e.mock.On("GetLoadInfo", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_GetLoadInfo_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetLoadInfoRequest)) *MockQueryCoord_GetLoadInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.GetLoadInfoRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_GetLoadInfo_Call) Return(_a0 *querypb.GetLoadInfoResponse, _a1 error) *MockQueryCoord_GetLoadInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_GetLoadInfo_Call) RunAndReturn(run func(context.Context, *querypb.GetLoadInfoRequest) (*querypb.GetLoadInfoResponse, error)) *MockQueryCoord_GetLoadInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoadState provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetLoadState(_a0 context.Context, _a1 *querypb.GetLoadStateRequest) (*querypb.GetLoadStateResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.GetLoadStateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetLoadStateRequest) (*querypb.GetLoadStateResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetLoadStateRequest) *querypb.GetLoadStateResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetLoadStateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetLoadStateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_GetLoadState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoadState'
+type MockQueryCoord_GetLoadState_Call struct {
+	*mock.Call
+}
+
+// GetLoadState is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.GetLoadStateRequest
+func (_e *MockQueryCoord_Expecter) GetLoadState(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetLoadState_Call {
+	return &MockQueryCoord_GetLoadState_Call{Call: _e.mock.On("GetLoadState", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_GetLoadState_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetLoadStateRequest)) *MockQueryCoord_GetLoadState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.GetLoadStateRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_GetLoadState_Call) Return(_a0 *querypb.GetLoadStateResponse, _a1 error) *MockQueryCoord_GetLoadState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_GetLoadState_Call) RunAndReturn(run func(context.Context, *querypb.GetLoadStateRequest) (*querypb.GetLoadStateResponse, error)) *MockQueryCoord_GetLoadState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMetrics provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetMetrics(_a0 context.Context, _a1 *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *milvuspb.GetMetricsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetMetricsRequest) *milvuspb.GetMetricsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.GetMetricsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetMetricsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_GetMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMetrics'
+type MockQueryCoord_GetMetrics_Call struct {
+	*mock.Call
+}
+
+// GetMetrics is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.GetMetricsRequest
+func (_e *MockQueryCoord_Expecter) GetMetrics(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetMetrics_Call {
+	return &MockQueryCoord_GetMetrics_Call{Call: _e.mock.On("GetMetrics", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_GetMetrics_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.GetMetricsRequest)) *MockQueryCoord_GetMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.GetMetricsRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_GetMetrics_Call) Return(_a0 *milvuspb.GetMetricsResponse, _a1 error) *MockQueryCoord_GetMetrics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_GetMetrics_Call) RunAndReturn(run func(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)) *MockQueryCoord_GetMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNodeLoadHistory provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetNodeLoadHistory(_a0 context.Context, _a1 *querypb.GetNodeLoadHistoryRequest) (*querypb.GetNodeLoadHistoryResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.GetNodeLoadHistoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetNodeLoadHistoryRequest) (*querypb.GetNodeLoadHistoryResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetNodeLoadHistoryRequest) *querypb.GetNodeLoadHistoryResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetNodeLoadHistoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetNodeLoadHistoryRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_GetNodeLoadHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNodeLoadHistory'
+type MockQueryCoord_GetNodeLoadHistory_Call struct {
+	*mock.Call
+}
+
+// GetNodeLoadHistory is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.GetNodeLoadHistoryRequest
+func (_e *MockQueryCoord_Expecter) GetNodeLoadHistory(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetNodeLoadHistory_Call {
+	return &MockQueryCoord_GetNodeLoadHistory_Call{Call: _e.mock.On("GetNodeLoadHistory", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_GetNodeLoadHistory_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetNodeLoadHistoryRequest)) *MockQueryCoord_GetNodeLoadHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.GetNodeLoadHistoryRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_GetNodeLoadHistory_Call) Return(_a0 *querypb.GetNodeLoadHistoryResponse, _a1 error) *MockQueryCoord_GetNodeLoadHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_GetNodeLoadHistory_Call) RunAndReturn(run func(context.Context, *querypb.GetNodeLoadHistoryRequest) (*querypb.GetNodeLoadHistoryResponse, error)) *MockQueryCoord_GetNodeLoadHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPartitionStates provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetPartitionStates(_a0 context.Context, _a1 *querypb.GetPartitionStatesRequest) (*querypb.GetPartitionStatesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.GetPartitionStatesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetPartitionStatesRequest) (*querypb.GetPartitionStatesResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetPartitionStatesRequest) *querypb.GetPartitionStatesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetPartitionStatesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetPartitionStatesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_GetPartitionStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPartitionStates'
+type MockQueryCoord_GetPartitionStates_Call struct {
+	*mock.Call
+}
+
+// GetPartitionStates is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.GetPartitionStatesRequest
+func (_e *MockQueryCoord_Expecter) GetPartitionStates(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetPartitionStates_Call {
+	return &MockQueryCoord_GetPartitionStates_Call{Call: _e.mock.On("GetPartitionStates", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_GetPartitionStates_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetPartitionStatesRequest)) *MockQueryCoord_GetPartitionStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.GetPartitionStatesRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_GetPartitionStates_Call) Return(_a0 *querypb.GetPartitionStatesResponse, _a1 error) *MockQueryCoord_GetPartitionStates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_GetPartitionStates_Call) RunAndReturn(run func(context.Context, *querypb.GetPartitionStatesRequest) (*querypb.GetPartitionStatesResponse, error)) *MockQueryCoord_GetPartitionStates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQueryNodeDistribution provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetQueryNodeDistribution(_a0 context.Context, _a1 *querypb.GetQueryNodeDistributionRequest) (*querypb.GetQueryNodeDistributionResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.GetQueryNodeDistributionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetQueryNodeDistributionRequest) (*querypb.GetQueryNodeDistributionResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetQueryNodeDistributionRequest) *querypb.GetQueryNodeDistributionResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetQueryNodeDistributionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetQueryNodeDistributionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_GetQueryNodeDistribution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQueryNodeDistribution'
+type MockQueryCoord_GetQueryNodeDistribution_Call struct {
+	*mock.Call
+}
+
+// GetQueryNodeDistribution is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.GetQueryNodeDistributionRequest
+func (_e *MockQueryCoord_Expecter) GetQueryNodeDistribution(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetQueryNodeDistribution_Call {
+	return &MockQueryCoord_GetQueryNodeDistribution_Call{Call: _e.mock.On("GetQueryNodeDistribution", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_GetQueryNodeDistribution_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetQueryNodeDistributionRequest)) *MockQueryCoord_GetQueryNodeDistribution_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.GetQueryNodeDistributionRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_GetQueryNodeDistribution_Call) Return(_a0 *querypb.GetQueryNodeDistributionResponse, _a1 error) *MockQueryCoord_GetQueryNodeDistribution_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_GetQueryNodeDistribution_Call) RunAndReturn(run func(context.Context, *querypb.GetQueryNodeDistributionRequest) (*querypb.GetQueryNodeDistributionResponse, error)) *MockQueryCoord_GetQueryNodeDistribution_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReleaseProgress provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetReleaseProgress(_a0 context.Context, _a1 *querypb.GetReleaseProgressRequest) (*querypb.GetReleaseProgressResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.GetReleaseProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetReleaseProgressRequest) (*querypb.GetReleaseProgressResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetReleaseProgressRequest) *querypb.GetReleaseProgressResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetReleaseProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetReleaseProgressRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_GetReleaseProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReleaseProgress'
+type MockQueryCoord_GetReleaseProgress_Call struct {
+	*mock.Call
+}
+
+// GetReleaseProgress is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.GetReleaseProgressRequest
+func (_e *MockQueryCoord_Expecter) GetReleaseProgress(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetReleaseProgress_Call {
+	return &MockQueryCoord_GetReleaseProgress_Call{Call: _e.mock.On("GetReleaseProgress", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_GetReleaseProgress_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetReleaseProgressRequest)) *MockQueryCoord_GetReleaseProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.GetReleaseProgressRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_GetReleaseProgress_Call) Return(_a0 *querypb.GetReleaseProgressResponse, _a1 error) *MockQueryCoord_GetReleaseProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_GetReleaseProgress_Call) RunAndReturn(run func(context.Context, *querypb.GetReleaseProgressRequest) (*querypb.GetReleaseProgressResponse, error)) *MockQueryCoord_GetReleaseProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReplicas provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetReplicas(_a0 context.Context, _a1 *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *milvuspb.GetReplicasResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetReplicasRequest) *milvuspb.GetReplicasResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.GetReplicasResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetReplicasRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_GetReplicas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReplicas'
+type MockQueryCoord_GetReplicas_Call struct {
+	*mock.Call
+}
+
+// GetReplicas is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.GetReplicasRequest
+func (_e *MockQueryCoord_Expecter) GetReplicas(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetReplicas_Call {
+	return &MockQueryCoord_GetReplicas_Call{Call: _e.mock.On("GetReplicas", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_GetReplicas_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.GetReplicasRequest)) *MockQueryCoord_GetReplicas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.GetReplicasRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_GetReplicas_Call) Return(_a0 *milvuspb.GetReplicasResponse, _a1 error) *MockQueryCoord_GetReplicas_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_GetReplicas_Call) RunAndReturn(run func(context.Context, *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error)) *MockQueryCoord_GetReplicas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetResourceGroupDrift provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetResourceGroupDrift(_a0 context.Context, _a1 *querypb.GetResourceGroupDriftRequest) (*querypb.GetResourceGroupDriftResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.GetResourceGroupDriftResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetResourceGroupDriftRequest) (*querypb.GetResourceGroupDriftResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetResourceGroupDriftRequest) *querypb.GetResourceGroupDriftResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetResourceGroupDriftResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetResourceGroupDriftRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_GetResourceGroupDrift_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetResourceGroupDrift'
+type MockQueryCoord_GetResourceGroupDrift_Call struct {
+	*mock.Call
+}
+
+// GetResourceGroupDrift is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.GetResourceGroupDriftRequest
+func (_e *MockQueryCoord_Expecter) GetResourceGroupDrift(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetResourceGroupDrift_Call {
+	return &MockQueryCoord_GetResourceGroupDrift_Call{Call: _e.mock.On("GetResourceGroupDrift", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_GetResourceGroupDrift_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetResourceGroupDriftRequest)) *MockQueryCoord_GetResourceGroupDrift_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.GetResourceGroupDriftRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_GetResourceGroupDrift_Call) Return(_a0 *querypb.GetResourceGroupDriftResponse, _a1 error) *MockQueryCoord_GetResourceGroupDrift_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_GetResourceGroupDrift_Call) RunAndReturn(run func(context.Context, *querypb.GetResourceGroupDriftRequest) (*querypb.GetResourceGroupDriftResponse, error)) *MockQueryCoord_GetResourceGroupDrift_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetResourceGroupUtilization provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetResourceGroupUtilization(_a0 context.Context, _a1 *querypb.GetResourceGroupUtilizationRequest) (*querypb.GetResourceGroupUtilizationResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.GetResourceGroupUtilizationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetResourceGroupUtilizationRequest) (*querypb.GetResourceGroupUtilizationResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetResourceGroupUtilizationRequest) *querypb.GetResourceGroupUtilizationResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetResourceGroupUtilizationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetResourceGroupUtilizationRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_GetResourceGroupUtilization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetResourceGroupUtilization'
+type MockQueryCoord_GetResourceGroupUtilization_Call struct {
+	*mock.Call
+}
+
+// GetResourceGroupUtilization is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.GetResourceGroupUtilizationRequest
+func (_e *MockQueryCoord_Expecter) GetResourceGroupUtilization(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetResourceGroupUtilization_Call {
+	return &MockQueryCoord_GetResourceGroupUtilization_Call{Call: _e.mock.On("GetResourceGroupUtilization", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_GetResourceGroupUtilization_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetResourceGroupUtilizationRequest)) *MockQueryCoord_GetResourceGroupUtilization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.GetResourceGroupUtilizationRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_GetResourceGroupUtilization_Call) Return(_a0 *querypb.GetResourceGroupUtilizationResponse, _a1 error) *MockQueryCoord_GetResourceGroupUtilization_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_GetResourceGroupUtilization_Call) RunAndReturn(run func(context.Context, *querypb.GetResourceGroupUtilizationRequest) (*querypb.GetResourceGroupUtilizationResponse, error)) *MockQueryCoord_GetResourceGroupUtilization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSegmentInfo provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetSegmentInfo(_a0 context.Context, _a1 *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.GetSegmentInfoResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetSegmentInfoRequest) *querypb.GetSegmentInfoResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetSegmentInfoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetSegmentInfoRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_GetSegmentInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentInfo'
+type MockQueryCoord_GetSegmentInfo_Call struct {
+	*mock.Call
+}
+
+// GetSegmentInfo is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.GetSegmentInfoRequest
+func (_e *MockQueryCoord_Expecter) GetSegmentInfo(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetSegmentInfo_Call {
+	return &MockQueryCoord_GetSegmentInfo_Call{Call: _e.mock.On("GetSegmentInfo", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_GetSegmentInfo_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetSegmentInfoRequest)) *MockQueryCoord_GetSegmentInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.GetSegmentInfoRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_GetSegmentInfo_Call) Return(_a0 *querypb.GetSegmentInfoResponse, _a1 error) *MockQueryCoord_GetSegmentInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_GetSegmentInfo_Call) RunAndReturn(run func(context.Context, *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error)) *MockQueryCoord_GetSegmentInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetShardLeaders provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetShardLeaders(_a0 context.Context, _a1 *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.GetShardLeadersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetShardLeadersRequest) *querypb.GetShardLeadersResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetShardLeadersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetShardLeadersRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_GetShardLeaders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetShardLeaders'
+type MockQueryCoord_GetShardLeaders_Call struct {
+	*mock.Call
+}
+
+// GetShardLeaders is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.GetShardLeadersRequest
+func (_e *MockQueryCoord_Expecter) GetShardLeaders(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetShardLeaders_Call {
+	return &MockQueryCoord_GetShardLeaders_Call{Call: _e.mock.On("GetShardLeaders", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_GetShardLeaders_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetShardLeadersRequest)) *MockQueryCoord_GetShardLeaders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.GetShardLeadersRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_GetShardLeaders_Call) Return(_a0 *querypb.GetShardLeadersResponse, _a1 error) *MockQueryCoord_GetShardLeaders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_GetShardLeaders_Call) RunAndReturn(run func(context.Context, *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error)) *MockQueryCoord_GetShardLeaders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetShardLeadersBatch provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetShardLeadersBatch(_a0 context.Context, _a1 *querypb.GetShardLeadersBatchRequest) (*querypb.GetShardLeadersBatchResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.GetShardLeadersBatchResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetShardLeadersBatchRequest) (*querypb.GetShardLeadersBatchResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetShardLeadersBatchRequest) *querypb.GetShardLeadersBatchResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetShardLeadersBatchResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetShardLeadersBatchRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_GetShardLeadersBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetShardLeadersBatch'
+type MockQueryCoord_GetShardLeadersBatch_Call struct {
+	*mock.Call
+}
+
+// GetShardLeadersBatch is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.GetShardLeadersBatchRequest
+func (_e *MockQueryCoord_Expecter) GetShardLeadersBatch(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetShardLeadersBatch_Call {
+	return &MockQueryCoord_GetShardLeadersBatch_Call{Call: _e.mock.On("GetShardLeadersBatch", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_GetShardLeadersBatch_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetShardLeadersBatchRequest)) *MockQueryCoord_GetShardLeadersBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.GetShardLeadersBatchRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_GetShardLeadersBatch_Call) Return(_a0 *querypb.GetShardLeadersBatchResponse, _a1 error) *MockQueryCoord_GetShardLeadersBatch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_GetShardLeadersBatch_Call) RunAndReturn(run func(context.Context, *querypb.GetShardLeadersBatchRequest) (*querypb.GetShardLeadersBatchResponse, error)) *MockQueryCoord_GetShardLeadersBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStatisticsChannel provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetStatisticsChannel(_a0 context.Context, _a1 *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *milvuspb.StringResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetStatisticsChannelRequest) *milvuspb.StringResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.StringResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetStatisticsChannelRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_GetStatisticsChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatisticsChannel'
+type MockQueryCoord_GetStatisticsChannel_Call struct {
+	*mock.Call
+}
+
+// GetStatisticsChannel is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.GetStatisticsChannelRequest
+func (_e *MockQueryCoord_Expecter) GetStatisticsChannel(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetStatisticsChannel_Call {
+	return &MockQueryCoord_GetStatisticsChannel_Call{Call: _e.mock.On("GetStatisticsChannel", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_GetStatisticsChannel_Call) Run(run func(_a0 context.Context, _a1 *internalpb.GetStatisticsChannelRequest)) *MockQueryCoord_GetStatisticsChannel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.GetStatisticsChannelRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_GetStatisticsChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockQueryCoord_GetStatisticsChannel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_GetStatisticsChannel_Call) RunAndReturn(run func(context.Context, *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error)) *MockQueryCoord_GetStatisticsChannel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTenantViolations provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetTenantViolations(_a0 context.Context, _a1 *querypb.GetTenantViolationsRequest) (*querypb.GetTenantViolationsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.GetTenantViolationsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetTenantViolationsRequest) (*querypb.GetTenantViolationsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetTenantViolationsRequest) *querypb.GetTenantViolationsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetTenantViolationsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetTenantViolationsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_GetTenantViolations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTenantViolations'
+type MockQueryCoord_GetTenantViolations_Call struct {
+	*mock.Call
+}
+
+// GetTenantViolations is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.GetTenantViolationsRequest
+func (_e *MockQueryCoord_Expecter) GetTenantViolations(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetTenantViolations_Call {
+	return &MockQueryCoord_GetTenantViolations_Call{Call: _e.mock.On("GetTenantViolations", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_GetTenantViolations_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetTenantViolationsRequest)) *MockQueryCoord_GetTenantViolations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.GetTenantViolationsRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_GetTenantViolations_Call) Return(_a0 *querypb.GetTenantViolationsResponse, _a1 error) *MockQueryCoord_GetTenantViolations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_GetTenantViolations_Call) RunAndReturn(run func(context.Context, *querypb.GetTenantViolationsRequest) (*querypb.GetTenantViolationsResponse, error)) *MockQueryCoord_GetTenantViolations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTimeTickChannel provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetTimeTickChannel(_a0 context.Context, _a1 *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *milvuspb.StringResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetTimeTickChannelRequest) *milvuspb.StringResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.StringResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetTimeTickChannelRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_GetTimeTickChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTimeTickChannel'
+type MockQueryCoord_GetTimeTickChannel_Call struct {
+	*mock.Call
+}
+
+// GetTimeTickChannel is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.GetTimeTickChannelRequest
+func (_e *MockQueryCoord_Expecter) GetTimeTickChannel(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetTimeTickChannel_Call {
+	return &MockQueryCoord_GetTimeTickChannel_Call{Call: _e.mock.On("GetTimeTickChannel", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_GetTimeTickChannel_Call) Run(run func(_a0 context.Context, _a1 *internalpb.GetTimeTickChannelRequest)) *MockQueryCoord_GetTimeTickChannel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.GetTimeTickChannelRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_GetTimeTickChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockQueryCoord_GetTimeTickChannel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_GetTimeTickChannel_Call) RunAndReturn(run func(context.Context, *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error)) *MockQueryCoord_GetTimeTickChannel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTransferNodeStatus provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetTransferNodeStatus(_a0 context.Context, _a1 *querypb.GetTransferNodeStatusRequest) (*querypb.GetTransferNodeStatusResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.GetTransferNodeStatusResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetTransferNodeStatusRequest) (*querypb.GetTransferNodeStatusResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetTransferNodeStatusRequest) *querypb.GetTransferNodeStatusResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetTransferNodeStatusResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetTransferNodeStatusRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_GetTransferNodeStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTransferNodeStatus'
+type MockQueryCoord_GetTransferNodeStatus_Call struct {
+	*mock.Call
+}
+
+// GetTransferNodeStatus is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.GetTransferNodeStatusRequest
+func (_e *MockQueryCoord_Expecter) GetTransferNodeStatus(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetTransferNodeStatus_Call {
+	return &MockQueryCoord_GetTransferNodeStatus_Call{Call: _e.mock.On("GetTransferNodeStatus", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_GetTransferNodeStatus_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetTransferNodeStatusRequest)) *MockQueryCoord_GetTransferNodeStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.GetTransferNodeStatusRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_GetTransferNodeStatus_Call) Return(_a0 *querypb.GetTransferNodeStatusResponse, _a1 error) *MockQueryCoord_GetTransferNodeStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_GetTransferNodeStatus_Call) RunAndReturn(run func(context.Context, *querypb.GetTransferNodeStatusRequest) (*querypb.GetTransferNodeStatusResponse, error)) *MockQueryCoord_GetTransferNodeStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Init provides a mock function with given fields:
+func (_m *MockQueryCoord) Init() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQueryCoord_Init_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Init'
+type MockQueryCoord_Init_Call struct {
+	*mock.Call
+}
+
+// Init is a helper method to define mock.On call
+func (_e *MockQueryCoord_Expecter) Init() *MockQueryCoord_Init_Call {
+	return &MockQueryCoord_Init_Call{Call: _e.mock.On("Init")}
+}
+
+func (_c *MockQueryCoord_Init_Call) Run(run func()) *MockQueryCoord_Init_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_Init_Call) Return(_a0 error) *MockQueryCoord_Init_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQueryCoord_Init_Call) RunAndReturn(run func() error) *MockQueryCoord_Init_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCheckers provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) ListCheckers(_a0 context.Context, _a1 *querypb.ListCheckersRequest) (*querypb.ListCheckersResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.ListCheckersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ListCheckersRequest) (*querypb.ListCheckersResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ListCheckersRequest) *querypb.ListCheckersResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.ListCheckersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ListCheckersRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_ListCheckers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCheckers'
+type MockQueryCoord_ListCheckers_Call struct {
+	*mock.Call
+}
+
+// ListCheckers is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.ListCheckersRequest
+func (_e *MockQueryCoord_Expecter) ListCheckers(_a0 interface{}, _a1 interface{}) *MockQueryCoord_ListCheckers_Call {
+	return &MockQueryCoord_ListCheckers_Call{Call: _e.mock.On("ListCheckers", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_ListCheckers_Call) Run(run func(_a0 context.Context, _a1 *querypb.ListCheckersRequest)) *MockQueryCoord_ListCheckers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.ListCheckersRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_ListCheckers_Call) Return(_a0 *querypb.ListCheckersResponse, _a1 error) *MockQueryCoord_ListCheckers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_ListCheckers_Call) RunAndReturn(run func(context.Context, *querypb.ListCheckersRequest) (*querypb.ListCheckersResponse, error)) *MockQueryCoord_ListCheckers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListQueryNode provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) ListQueryNode(_a0 context.Context, _a1 *querypb.ListQueryNodeRequest) (*querypb.ListQueryNodeResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.ListQueryNodeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ListQueryNodeRequest) (*querypb.ListQueryNodeResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ListQueryNodeRequest) *querypb.ListQueryNodeResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.ListQueryNodeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ListQueryNodeRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_ListQueryNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListQueryNode'
+type MockQueryCoord_ListQueryNode_Call struct {
+	*mock.Call
+}
+
+// ListQueryNode is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.ListQueryNodeRequest
+func (_e *MockQueryCoord_Expecter) ListQueryNode(_a0 interface{}, _a1 interface{}) *MockQueryCoord_ListQueryNode_Call {
+	return &MockQueryCoord_ListQueryNode_Call{Call: _e.mock.On("ListQueryNode", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_ListQueryNode_Call) Run(run func(_a0 context.Context, _a1 *querypb.ListQueryNodeRequest)) *MockQueryCoord_ListQueryNode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.ListQueryNodeRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_ListQueryNode_Call) Return(_a0 *querypb.ListQueryNodeResponse, _a1 error) *MockQueryCoord_ListQueryNode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_ListQueryNode_Call) RunAndReturn(run func(context.Context, *querypb.ListQueryNodeRequest) (*querypb.ListQueryNodeResponse, error)) *MockQueryCoord_ListQueryNode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReplicas provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) ListReplicas(_a0 context.Context, _a1 *querypb.ListReplicasRequest) (*querypb.ListReplicasResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.ListReplicasResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ListReplicasRequest) (*querypb.ListReplicasResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ListReplicasRequest) *querypb.ListReplicasResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.ListReplicasResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ListReplicasRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_ListReplicas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReplicas'
+type MockQueryCoord_ListReplicas_Call struct {
+	*mock.Call
+}
+
+// ListReplicas is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.ListReplicasRequest
+func (_e *MockQueryCoord_Expecter) ListReplicas(_a0 interface{}, _a1 interface{}) *MockQueryCoord_ListReplicas_Call {
+	return &MockQueryCoord_ListReplicas_Call{Call: _e.mock.On("ListReplicas", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_ListReplicas_Call) Run(run func(_a0 context.Context, _a1 *querypb.ListReplicasRequest)) *MockQueryCoord_ListReplicas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.ListReplicasRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_ListReplicas_Call) Return(_a0 *querypb.ListReplicasResponse, _a1 error) *MockQueryCoord_ListReplicas_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_ListReplicas_Call) RunAndReturn(run func(context.Context, *querypb.ListReplicasRequest) (*querypb.ListReplicasResponse, error)) *MockQueryCoord_ListReplicas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListResourceGroups provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) ListResourceGroups(_a0 context.Context, _a1 *milvuspb.ListResourceGroupsRequest) (*milvuspb.ListResourceGroupsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *milvuspb.ListResourceGroupsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListResourceGroupsRequest) (*milvuspb.ListResourceGroupsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListResourceGroupsRequest) *milvuspb.ListResourceGroupsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.ListResourceGroupsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.ListResourceGroupsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_ListResourceGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListResourceGroups'
+type MockQueryCoord_ListResourceGroups_Call struct {
+	*mock.Call
+}
+
+// ListResourceGroups is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.ListResourceGroupsRequest
+func (_e *MockQueryCoord_Expecter) ListResourceGroups(_a0 interface{}, _a1 interface{}) *MockQueryCoord_ListResourceGroups_Call {
+	return &MockQueryCoord_ListResourceGroups_Call{Call: _e.mock.On("ListResourceGroups", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_ListResourceGroups_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.ListResourceGroupsRequest)) *MockQueryCoord_ListResourceGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.ListResourceGroupsRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_ListResourceGroups_Call) Return(_a0 *milvuspb.ListResourceGroupsResponse, _a1 error) *MockQueryCoord_ListResourceGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_ListResourceGroups_Call) RunAndReturn(run func(context.Context, *milvuspb.ListResourceGroupsRequest) (*milvuspb.ListResourceGroupsResponse, error)) *MockQueryCoord_ListResourceGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoadBalance provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) LoadBalance(_a0 context.Context, _a1 *querypb.LoadBalanceRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.LoadBalanceRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.LoadBalanceRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.LoadBalanceRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_LoadBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadBalance'
+type MockQueryCoord_LoadBalance_Call struct {
+	*mock.Call
+}
+
+// LoadBalance is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.LoadBalanceRequest
+func (_e *MockQueryCoord_Expecter) LoadBalance(_a0 interface{}, _a1 interface{}) *MockQueryCoord_LoadBalance_Call {
+	return &MockQueryCoord_LoadBalance_Call{Call: _e.mock.On("LoadBalance", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_LoadBalance_Call) Run(run func(_a0 context.Context, _a1 *querypb.LoadBalanceRequest)) *MockQueryCoord_LoadBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.LoadBalanceRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_LoadBalance_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_LoadBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_LoadBalance_Call) RunAndReturn(run func(context.Context, *querypb.LoadBalanceRequest) (*commonpb.Status, error)) *MockQueryCoord_LoadBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoadCollection provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) LoadCollection(_a0 context.Context, _a1 *querypb.LoadCollectionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.LoadCollectionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.LoadCollectionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.LoadCollectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_LoadCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadCollection'
+type MockQueryCoord_LoadCollection_Call struct {
+	*mock.Call
+}
+
+// LoadCollection is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.LoadCollectionRequest
+func (_e *MockQueryCoord_Expecter) LoadCollection(_a0 interface{}, _a1 interface{}) *MockQueryCoord_LoadCollection_Call {
+	return &MockQueryCoord_LoadCollection_Call{Call: _e.mock.On("LoadCollection", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_LoadCollection_Call) Run(run func(_a0 context.Context, _a1 *querypb.LoadCollectionRequest)) *MockQueryCoord_LoadCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.LoadCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_LoadCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_LoadCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_LoadCollection_Call) RunAndReturn(run func(context.Context, *querypb.LoadCollectionRequest) (*commonpb.Status, error)) *MockQueryCoord_LoadCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoadPartitions provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) LoadPartitions(_a0 context.Context, _a1 *querypb.LoadPartitionsRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.LoadPartitionsRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.LoadPartitionsRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.LoadPartitionsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_LoadPartitions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadPartitions'
+type MockQueryCoord_LoadPartitions_Call struct {
+	*mock.Call
+}
+
+// LoadPartitions is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.LoadPartitionsRequest
+func (_e *MockQueryCoord_Expecter) LoadPartitions(_a0 interface{}, _a1 interface{}) *MockQueryCoord_LoadPartitions_Call {
+	return &MockQueryCoord_LoadPartitions_Call{Call: _e.mock.On("LoadPartitions", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_LoadPartitions_Call) Run(run func(_a0 context.Context, _a1 *querypb.LoadPartitionsRequest)) *MockQueryCoord_LoadPartitions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.LoadPartitionsRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_LoadPartitions_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_LoadPartitions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_LoadPartitions_Call) RunAndReturn(run func(context.Context, *querypb.LoadPartitionsRequest) (*commonpb.Status, error)) *MockQueryCoord_LoadPartitions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveCollectionToResourceGroup provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) MoveCollectionToResourceGroup(_a0 context.Context, _a1 *querypb.MoveCollectionToResourceGroupRequest) (*querypb.MoveCollectionToResourceGroupResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.MoveCollectionToResourceGroupResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.MoveCollectionToResourceGroupRequest) (*querypb.MoveCollectionToResourceGroupResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.MoveCollectionToResourceGroupRequest) *querypb.MoveCollectionToResourceGroupResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.MoveCollectionToResourceGroupResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.MoveCollectionToResourceGroupRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_MoveCollectionToResourceGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveCollectionToResourceGroup'
+type MockQueryCoord_MoveCollectionToResourceGroup_Call struct {
+	*mock.Call
+}
+
+// MoveCollectionToResourceGroup is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.MoveCollectionToResourceGroupRequest
+func (_e *MockQueryCoord_Expecter) MoveCollectionToResourceGroup(_a0 interface{}, _a1 interface{}) *MockQueryCoord_MoveCollectionToResourceGroup_Call {
+	return &MockQueryCoord_MoveCollectionToResourceGroup_Call{Call: _e.mock.On("MoveCollectionToResourceGroup", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_MoveCollectionToResourceGroup_Call) Run(run func(_a0 context.Context, _a1 *querypb.MoveCollectionToResourceGroupRequest)) *MockQueryCoord_MoveCollectionToResourceGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.MoveCollectionToResourceGroupRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_MoveCollectionToResourceGroup_Call) Return(_a0 *querypb.MoveCollectionToResourceGroupResponse, _a1 error) *MockQueryCoord_MoveCollectionToResourceGroup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_MoveCollectionToResourceGroup_Call) RunAndReturn(run func(context.Context, *querypb.MoveCollectionToResourceGroupRequest) (*querypb.MoveCollectionToResourceGroupResponse, error)) *MockQueryCoord_MoveCollectionToResourceGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PauseTargetUpdates provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) PauseTargetUpdates(_a0 context.Context, _a1 *querypb.PauseTargetUpdatesRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.PauseTargetUpdatesRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.PauseTargetUpdatesRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.PauseTargetUpdatesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_PauseTargetUpdates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseTargetUpdates'
+type MockQueryCoord_PauseTargetUpdates_Call struct {
+	*mock.Call
+}
+
+// PauseTargetUpdates is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.PauseTargetUpdatesRequest
+func (_e *MockQueryCoord_Expecter) PauseTargetUpdates(_a0 interface{}, _a1 interface{}) *MockQueryCoord_PauseTargetUpdates_Call {
+	return &MockQueryCoord_PauseTargetUpdates_Call{Call: _e.mock.On("PauseTargetUpdates", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_PauseTargetUpdates_Call) Run(run func(_a0 context.Context, _a1 *querypb.PauseTargetUpdatesRequest)) *MockQueryCoord_PauseTargetUpdates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.PauseTargetUpdatesRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_PauseTargetUpdates_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_PauseTargetUpdates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_PauseTargetUpdates_Call) RunAndReturn(run func(context.Context, *querypb.PauseTargetUpdatesRequest) (*commonpb.Status, error)) *MockQueryCoord_PauseTargetUpdates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RebalanceCollection provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) RebalanceCollection(_a0 context.Context, _a1 *querypb.RebalanceCollectionRequest) (*querypb.RebalanceCollectionResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.RebalanceCollectionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.RebalanceCollectionRequest) (*querypb.RebalanceCollectionResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.RebalanceCollectionRequest) *querypb.RebalanceCollectionResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.RebalanceCollectionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.RebalanceCollectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_RebalanceCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RebalanceCollection'
+type MockQueryCoord_RebalanceCollection_Call struct {
+	*mock.Call
+}
+
+// RebalanceCollection is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.RebalanceCollectionRequest
+func (_e *MockQueryCoord_Expecter) RebalanceCollection(_a0 interface{}, _a1 interface{}) *MockQueryCoord_RebalanceCollection_Call {
+	return &MockQueryCoord_RebalanceCollection_Call{Call: _e.mock.On("RebalanceCollection", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_RebalanceCollection_Call) Run(run func(_a0 context.Context, _a1 *querypb.RebalanceCollectionRequest)) *MockQueryCoord_RebalanceCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.RebalanceCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_RebalanceCollection_Call) Return(_a0 *querypb.RebalanceCollectionResponse, _a1 error) *MockQueryCoord_RebalanceCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_RebalanceCollection_Call) RunAndReturn(run func(context.Context, *querypb.RebalanceCollectionRequest) (*querypb.RebalanceCollectionResponse, error)) *MockQueryCoord_RebalanceCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecommendReplicaCount provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) RecommendReplicaCount(_a0 context.Context, _a1 *querypb.RecommendReplicaCountRequest) (*querypb.RecommendReplicaCountResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.RecommendReplicaCountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.RecommendReplicaCountRequest) (*querypb.RecommendReplicaCountResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.RecommendReplicaCountRequest) *querypb.RecommendReplicaCountResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.RecommendReplicaCountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.RecommendReplicaCountRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_RecommendReplicaCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecommendReplicaCount'
+type MockQueryCoord_RecommendReplicaCount_Call struct {
+	*mock.Call
+}
+
+// RecommendReplicaCount is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.RecommendReplicaCountRequest
+func (_e *MockQueryCoord_Expecter) RecommendReplicaCount(_a0 interface{}, _a1 interface{}) *MockQueryCoord_RecommendReplicaCount_Call {
+	return &MockQueryCoord_RecommendReplicaCount_Call{Call: _e.mock.On("RecommendReplicaCount", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_RecommendReplicaCount_Call) Run(run func(_a0 context.Context, _a1 *querypb.RecommendReplicaCountRequest)) *MockQueryCoord_RecommendReplicaCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.RecommendReplicaCountRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_RecommendReplicaCount_Call) Return(_a0 *querypb.RecommendReplicaCountResponse, _a1 error) *MockQueryCoord_RecommendReplicaCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_RecommendReplicaCount_Call) RunAndReturn(run func(context.Context, *querypb.RecommendReplicaCountRequest) (*querypb.RecommendReplicaCountResponse, error)) *MockQueryCoord_RecommendReplicaCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Register provides a mock function with given fields:
+func (_m *MockQueryCoord) Register() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // MockQueryCoord_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
 type MockQueryCoord_Register_Call struct {
 	*mock.Call
@@ -1421,31 +3346,284 @@ func (_e *MockQueryCoord_Expecter) ReleasePartitions(_a0 interface{}, _a1 interf
 
 func (_c *MockQueryCoord_ReleasePartitions_Call) Run(run func(_a0 context.Context, _a1 *querypb.ReleasePartitionsRequest)) *MockQueryCoord_ReleasePartitions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*querypb.ReleasePartitionsRequest))
+		run(args[0].(context.Context), args[1].(*querypb.ReleasePartitionsRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_ReleasePartitions_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_ReleasePartitions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_ReleasePartitions_Call) RunAndReturn(run func(context.Context, *querypb.ReleasePartitionsRequest) (*commonpb.Status, error)) *MockQueryCoord_ReleasePartitions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseSegments provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) ReleaseSegments(_a0 context.Context, _a1 *querypb.ReleaseSegmentsRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ReleaseSegmentsRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ReleaseSegmentsRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ReleaseSegmentsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_ReleaseSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseSegments'
+type MockQueryCoord_ReleaseSegments_Call struct {
+	*mock.Call
+}
+
+// ReleaseSegments is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.ReleaseSegmentsRequest
+func (_e *MockQueryCoord_Expecter) ReleaseSegments(_a0 interface{}, _a1 interface{}) *MockQueryCoord_ReleaseSegments_Call {
+	return &MockQueryCoord_ReleaseSegments_Call{Call: _e.mock.On("ReleaseSegments", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_ReleaseSegments_Call) Run(run func(_a0 context.Context, _a1 *querypb.ReleaseSegmentsRequest)) *MockQueryCoord_ReleaseSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.ReleaseSegmentsRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_ReleaseSegments_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_ReleaseSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_ReleaseSegments_Call) RunAndReturn(run func(context.Context, *querypb.ReleaseSegmentsRequest) (*commonpb.Status, error)) *MockQueryCoord_ReleaseSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResumeBalance provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) ResumeBalance(_a0 context.Context, _a1 *querypb.ResumeBalanceRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ResumeBalanceRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ResumeBalanceRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ResumeBalanceRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_ResumeBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeBalance'
+type MockQueryCoord_ResumeBalance_Call struct {
+	*mock.Call
+}
+
+// ResumeBalance is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.ResumeBalanceRequest
+func (_e *MockQueryCoord_Expecter) ResumeBalance(_a0 interface{}, _a1 interface{}) *MockQueryCoord_ResumeBalance_Call {
+	return &MockQueryCoord_ResumeBalance_Call{Call: _e.mock.On("ResumeBalance", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_ResumeBalance_Call) Run(run func(_a0 context.Context, _a1 *querypb.ResumeBalanceRequest)) *MockQueryCoord_ResumeBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.ResumeBalanceRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_ResumeBalance_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_ResumeBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_ResumeBalance_Call) RunAndReturn(run func(context.Context, *querypb.ResumeBalanceRequest) (*commonpb.Status, error)) *MockQueryCoord_ResumeBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResumeNode provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) ResumeNode(_a0 context.Context, _a1 *querypb.ResumeNodeRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ResumeNodeRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ResumeNodeRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ResumeNodeRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_ResumeNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeNode'
+type MockQueryCoord_ResumeNode_Call struct {
+	*mock.Call
+}
+
+// ResumeNode is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.ResumeNodeRequest
+func (_e *MockQueryCoord_Expecter) ResumeNode(_a0 interface{}, _a1 interface{}) *MockQueryCoord_ResumeNode_Call {
+	return &MockQueryCoord_ResumeNode_Call{Call: _e.mock.On("ResumeNode", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_ResumeNode_Call) Run(run func(_a0 context.Context, _a1 *querypb.ResumeNodeRequest)) *MockQueryCoord_ResumeNode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.ResumeNodeRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_ResumeNode_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_ResumeNode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_ResumeNode_Call) RunAndReturn(run func(context.Context, *querypb.ResumeNodeRequest) (*commonpb.Status, error)) *MockQueryCoord_ResumeNode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResumeTargetUpdates provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) ResumeTargetUpdates(_a0 context.Context, _a1 *querypb.ResumeTargetUpdatesRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ResumeTargetUpdatesRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ResumeTargetUpdatesRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ResumeTargetUpdatesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_ResumeTargetUpdates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeTargetUpdates'
+type MockQueryCoord_ResumeTargetUpdates_Call struct {
+	*mock.Call
+}
+
+// ResumeTargetUpdates is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.ResumeTargetUpdatesRequest
+func (_e *MockQueryCoord_Expecter) ResumeTargetUpdates(_a0 interface{}, _a1 interface{}) *MockQueryCoord_ResumeTargetUpdates_Call {
+	return &MockQueryCoord_ResumeTargetUpdates_Call{Call: _e.mock.On("ResumeTargetUpdates", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_ResumeTargetUpdates_Call) Run(run func(_a0 context.Context, _a1 *querypb.ResumeTargetUpdatesRequest)) *MockQueryCoord_ResumeTargetUpdates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.ResumeTargetUpdatesRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_ResumeTargetUpdates_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_ResumeTargetUpdates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_ResumeTargetUpdates_Call) RunAndReturn(run func(context.Context, *querypb.ResumeTargetUpdatesRequest) (*commonpb.Status, error)) *MockQueryCoord_ResumeTargetUpdates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetAddress provides a mock function with given fields: address
+func (_m *MockQueryCoord) SetAddress(address string) {
+	_m.Called(address)
+}
+
+// MockQueryCoord_SetAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAddress'
+type MockQueryCoord_SetAddress_Call struct {
+	*mock.Call
+}
+
+// SetAddress is a helper method to define mock.On call
+//   - address string
+func (_e *MockQueryCoord_Expecter) SetAddress(address interface{}) *MockQueryCoord_SetAddress_Call {
+	return &MockQueryCoord_SetAddress_Call{Call: _e.mock.On("SetAddress", address)}
+}
+
+func (_c *MockQueryCoord_SetAddress_Call) Run(run func(address string)) *MockQueryCoord_SetAddress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockQueryCoord_ReleasePartitions_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_ReleasePartitions_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockQueryCoord_SetAddress_Call) Return() *MockQueryCoord_SetAddress_Call {
+	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockQueryCoord_ReleasePartitions_Call) RunAndReturn(run func(context.Context, *querypb.ReleasePartitionsRequest) (*commonpb.Status, error)) *MockQueryCoord_ReleasePartitions_Call {
+func (_c *MockQueryCoord_SetAddress_Call) RunAndReturn(run func(string)) *MockQueryCoord_SetAddress_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ResumeBalance provides a mock function with given fields: _a0, _a1
-func (_m *MockQueryCoord) ResumeBalance(_a0 context.Context, _a1 *querypb.ResumeBalanceRequest) (*commonpb.Status, error) {
+// SetBalancePolicy provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) SetBalancePolicy(_a0 context.Context, _a1 *querypb.SetBalancePolicyRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ResumeBalanceRequest) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SetBalancePolicyRequest) (*commonpb.Status, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ResumeBalanceRequest) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SetBalancePolicyRequest) *commonpb.Status); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
@@ -1453,7 +3631,7 @@ func (_m *MockQueryCoord) ResumeBalance(_a0 context.Context, _a1 *querypb.Resume
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ResumeBalanceRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.SetBalancePolicyRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1462,45 +3640,45 @@ func (_m *MockQueryCoord) ResumeBalance(_a0 context.Context, _a1 *querypb.Resume
 	return r0, r1
 }
 
-// MockQueryCoord_ResumeBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeBalance'
-type MockQueryCoord_ResumeBalance_Call struct {
+// MockQueryCoord_SetBalancePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetBalancePolicy'
+type MockQueryCoord_SetBalancePolicy_Call struct {
 	*mock.Call
 }
 
-// ResumeBalance is a helper method to define mock.On call
+// SetBalancePolicy is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *querypb.ResumeBalanceRequest
-func (_e *MockQueryCoord_Expecter) ResumeBalance(_a0 interface{}, _a1 interface{}) *MockQueryCoord_ResumeBalance_Call {
-	return &MockQueryCoord_ResumeBalance_Call{Call: _e.mock.On("ResumeBalance", _a0, _a1)}
+//   - _a1 *querypb.SetBalancePolicyRequest
+func (_e *MockQueryCoord_Expecter) SetBalancePolicy(_a0 interface{}, _a1 interface{}) *MockQueryCoord_SetBalancePolicy_Call {
+	return &MockQueryCoord_SetBalancePolicy_Call{Call: _e.mock.On("SetBalancePolicy", _a0, _a1)}
 }
 
-func (_c *MockQueryCoord_ResumeBalance_Call) Run(run func(_a0 context.Context, _a1 *querypb.ResumeBalanceRequest)) *MockQueryCoord_ResumeBalance_Call {
+func (_c *MockQueryCoord_SetBalancePolicy_Call) Run(run func(_a0 context.Context, _a1 *querypb.SetBalancePolicyRequest)) *MockQueryCoord_SetBalancePolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*querypb.ResumeBalanceRequest))
+		run(args[0].(context.Context), args[1].(*querypb.SetBalancePolicyRequest))
 	})
 	return _c
 }
 
-func (_c *MockQueryCoord_ResumeBalance_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_ResumeBalance_Call {
+func (_c *MockQueryCoord_SetBalancePolicy_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_SetBalancePolicy_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoord_ResumeBalance_Call) RunAndReturn(run func(context.Context, *querypb.ResumeBalanceRequest) (*commonpb.Status, error)) *MockQueryCoord_ResumeBalance_Call {
+func (_c *MockQueryCoord_SetBalancePolicy_Call) RunAndReturn(run func(context.Context, *querypb.SetBalancePolicyRequest) (*commonpb.Status, error)) *MockQueryCoord_SetBalancePolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ResumeNode provides a mock function with given fields: _a0, _a1
-func (_m *MockQueryCoord) ResumeNode(_a0 context.Context, _a1 *querypb.ResumeNodeRequest) (*commonpb.Status, error) {
+// SetCollectionBalanceMode provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) SetCollectionBalanceMode(_a0 context.Context, _a1 *querypb.SetCollectionBalanceModeRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ResumeNodeRequest) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SetCollectionBalanceModeRequest) (*commonpb.Status, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ResumeNodeRequest) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SetCollectionBalanceModeRequest) *commonpb.Status); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
@@ -1508,7 +3686,7 @@ func (_m *MockQueryCoord) ResumeNode(_a0 context.Context, _a1 *querypb.ResumeNod
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ResumeNodeRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.SetCollectionBalanceModeRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1517,64 +3695,31 @@ func (_m *MockQueryCoord) ResumeNode(_a0 context.Context, _a1 *querypb.ResumeNod
 	return r0, r1
 }
 
-// MockQueryCoord_ResumeNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeNode'
-type MockQueryCoord_ResumeNode_Call struct {
+// MockQueryCoord_SetCollectionBalanceMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCollectionBalanceMode'
+type MockQueryCoord_SetCollectionBalanceMode_Call struct {
 	*mock.Call
 }
 
-// ResumeNode is a helper method to define mock.On call
+// SetCollectionBalanceMode is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *querypb.ResumeNodeRequest
-func (_e *MockQueryCoord_Expecter) ResumeNode(_a0 interface{}, _a1 interface{}) *MockQueryCoord_ResumeNode_Call {
-	return &MockQueryCoord_ResumeNode_Call{Call: _e.mock.On("ResumeNode", _a0, _a1)}
+//   - _a1 *querypb.SetCollectionBalanceModeRequest
+func (_e *MockQueryCoord_Expecter) SetCollectionBalanceMode(_a0 interface{}, _a1 interface{}) *MockQueryCoord_SetCollectionBalanceMode_Call {
+	return &MockQueryCoord_SetCollectionBalanceMode_Call{Call: _e.mock.On("SetCollectionBalanceMode", _a0, _a1)}
 }
 
-func (_c *MockQueryCoord_ResumeNode_Call) Run(run func(_a0 context.Context, _a1 *querypb.ResumeNodeRequest)) *MockQueryCoord_ResumeNode_Call {
+func (_c *MockQueryCoord_SetCollectionBalanceMode_Call) Run(run func(_a0 context.Context, _a1 *querypb.SetCollectionBalanceModeRequest)) *MockQueryCoord_SetCollectionBalanceMode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*querypb.ResumeNodeRequest))
+		run(args[0].(context.Context), args[1].(*querypb.SetCollectionBalanceModeRequest))
 	})
 	return _c
 }
 
-func (_c *MockQueryCoord_ResumeNode_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_ResumeNode_Call {
+func (_c *MockQueryCoord_SetCollectionBalanceMode_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_SetCollectionBalanceMode_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoord_ResumeNode_Call) RunAndReturn(run func(context.Context, *querypb.ResumeNodeRequest) (*commonpb.Status, error)) *MockQueryCoord_ResumeNode_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
-// SetAddress provides a mock function with given fields: address
-func (_m *MockQueryCoord) SetAddress(address string) {
-	_m.Called(address)
-}
-
-// MockQueryCoord_SetAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAddress'
-type MockQueryCoord_SetAddress_Call struct {
-	*mock.Call
-}
-
-// SetAddress is a helper method to define mock.On call
-//   - address string
-func (_e *MockQueryCoord_Expecter) SetAddress(address interface{}) *MockQueryCoord_SetAddress_Call {
-	return &MockQueryCoord_SetAddress_Call{Call: _e.mock.On("SetAddress", address)}
-}
-
-func (_c *MockQueryCoord_SetAddress_Call) Run(run func(address string)) *MockQueryCoord_SetAddress_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
-	})
-	return _c
-}
-
-func (_c *MockQueryCoord_SetAddress_Call) Return() *MockQueryCoord_SetAddress_Call {
-	_c.Call.Return()
-	return _c
-}
-
-func (_c *MockQueryCoord_SetAddress_Call) RunAndReturn(run func(string)) *MockQueryCoord_SetAddress_Call {
+func (_c *MockQueryCoord_SetCollectionBalanceMode_Call) RunAndReturn(run func(context.Context, *querypb.SetCollectionBalanceModeRequest) (*commonpb.Status, error)) *MockQueryCoord_SetCollectionBalanceMode_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2174,6 +4319,61 @@ func (_c *MockQueryCoord_SyncNewCreatedPartition_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// SyncNewCreatedPartitions provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) SyncNewCreatedPartitions(_a0 context.Context, _a1 *querypb.SyncNewCreatedPartitionsRequest) (*querypb.SyncNewCreatedPartitionsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.SyncNewCreatedPartitionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SyncNewCreatedPartitionsRequest) (*querypb.SyncNewCreatedPartitionsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SyncNewCreatedPartitionsRequest) *querypb.SyncNewCreatedPartitionsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.SyncNewCreatedPartitionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.SyncNewCreatedPartitionsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_SyncNewCreatedPartitions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncNewCreatedPartitions'
+type MockQueryCoord_SyncNewCreatedPartitions_Call struct {
+	*mock.Call
+}
+
+// SyncNewCreatedPartitions is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.SyncNewCreatedPartitionsRequest
+func (_e *MockQueryCoord_Expecter) SyncNewCreatedPartitions(_a0 interface{}, _a1 interface{}) *MockQueryCoord_SyncNewCreatedPartitions_Call {
+	return &MockQueryCoord_SyncNewCreatedPartitions_Call{Call: _e.mock.On("SyncNewCreatedPartitions", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_SyncNewCreatedPartitions_Call) Run(run func(_a0 context.Context, _a1 *querypb.SyncNewCreatedPartitionsRequest)) *MockQueryCoord_SyncNewCreatedPartitions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.SyncNewCreatedPartitionsRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_SyncNewCreatedPartitions_Call) Return(_a0 *querypb.SyncNewCreatedPartitionsResponse, _a1 error) *MockQueryCoord_SyncNewCreatedPartitions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_SyncNewCreatedPartitions_Call) RunAndReturn(run func(context.Context, *querypb.SyncNewCreatedPartitionsRequest) (*querypb.SyncNewCreatedPartitionsResponse, error)) *MockQueryCoord_SyncNewCreatedPartitions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TransferChannel provides a mock function with given fields: _a0, _a1
 func (_m *MockQueryCoord) TransferChannel(_a0 context.Context, _a1 *querypb.TransferChannelRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2284,6 +4484,61 @@ func (_c *MockQueryCoord_TransferNode_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// TransferNodeByFraction provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) TransferNodeByFraction(_a0 context.Context, _a1 *querypb.TransferNodeByFractionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.TransferNodeByFractionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.TransferNodeByFractionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.TransferNodeByFractionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_TransferNodeByFraction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferNodeByFraction'
+type MockQueryCoord_TransferNodeByFraction_Call struct {
+	*mock.Call
+}
+
+// TransferNodeByFraction is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.TransferNodeByFractionRequest
+func (_e *MockQueryCoord_Expecter) TransferNodeByFraction(_a0 interface{}, _a1 interface{}) *MockQueryCoord_TransferNodeByFraction_Call {
+	return &MockQueryCoord_TransferNodeByFraction_Call{Call: _e.mock.On("TransferNodeByFraction", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_TransferNodeByFraction_Call) Run(run func(_a0 context.Context, _a1 *querypb.TransferNodeByFractionRequest)) *MockQueryCoord_TransferNodeByFraction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.TransferNodeByFractionRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_TransferNodeByFraction_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_TransferNodeByFraction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_TransferNodeByFraction_Call) RunAndReturn(run func(context.Context, *querypb.TransferNodeByFractionRequest) (*commonpb.Status, error)) *MockQueryCoord_TransferNodeByFraction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TransferReplica provides a mock function with given fields: _a0, _a1
 func (_m *MockQueryCoord) TransferReplica(_a0 context.Context, _a1 *querypb.TransferReplicaRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2394,6 +4649,116 @@ func (_c *MockQueryCoord_TransferSegment_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// TriggerCheckerRun provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) TriggerCheckerRun(_a0 context.Context, _a1 *querypb.TriggerCheckerRunRequest) (*querypb.TriggerCheckerRunResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.TriggerCheckerRunResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.TriggerCheckerRunRequest) (*querypb.TriggerCheckerRunResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.TriggerCheckerRunRequest) *querypb.TriggerCheckerRunResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.TriggerCheckerRunResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.TriggerCheckerRunRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_TriggerCheckerRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TriggerCheckerRun'
+type MockQueryCoord_TriggerCheckerRun_Call struct {
+	*mock.Call
+}
+
+// TriggerCheckerRun is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.TriggerCheckerRunRequest
+func (_e *MockQueryCoord_Expecter) TriggerCheckerRun(_a0 interface{}, _a1 interface{}) *MockQueryCoord_TriggerCheckerRun_Call {
+	return &MockQueryCoord_TriggerCheckerRun_Call{Call: _e.mock.On("TriggerCheckerRun", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_TriggerCheckerRun_Call) Run(run func(_a0 context.Context, _a1 *querypb.TriggerCheckerRunRequest)) *MockQueryCoord_TriggerCheckerRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.TriggerCheckerRunRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_TriggerCheckerRun_Call) Return(_a0 *querypb.TriggerCheckerRunResponse, _a1 error) *MockQueryCoord_TriggerCheckerRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_TriggerCheckerRun_Call) RunAndReturn(run func(context.Context, *querypb.TriggerCheckerRunRequest) (*querypb.TriggerCheckerRunResponse, error)) *MockQueryCoord_TriggerCheckerRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnblockShard provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) UnblockShard(_a0 context.Context, _a1 *querypb.UnblockShardRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.UnblockShardRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.UnblockShardRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.UnblockShardRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_UnblockShard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnblockShard'
+type MockQueryCoord_UnblockShard_Call struct {
+	*mock.Call
+}
+
+// UnblockShard is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.UnblockShardRequest
+func (_e *MockQueryCoord_Expecter) UnblockShard(_a0 interface{}, _a1 interface{}) *MockQueryCoord_UnblockShard_Call {
+	return &MockQueryCoord_UnblockShard_Call{Call: _e.mock.On("UnblockShard", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_UnblockShard_Call) Run(run func(_a0 context.Context, _a1 *querypb.UnblockShardRequest)) *MockQueryCoord_UnblockShard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.UnblockShardRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_UnblockShard_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_UnblockShard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_UnblockShard_Call) RunAndReturn(run func(context.Context, *querypb.UnblockShardRequest) (*commonpb.Status, error)) *MockQueryCoord_UnblockShard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateResourceGroups provides a mock function with given fields: _a0, _a1
 func (_m *MockQueryCoord) UpdateResourceGroups(_a0 context.Context, _a1 *querypb.UpdateResourceGroupsRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2482,6 +4847,49 @@ func (_c *MockQueryCoord_UpdateStateCode_Call) RunAndReturn(run func(commonpb.St
 	return _c
 }
 
+// WatchCollections provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) WatchCollections(_a0 *querypb.WatchCollectionsRequest, _a1 querypb.QueryCoord_WatchCollectionsServer) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*querypb.WatchCollectionsRequest, querypb.QueryCoord_WatchCollectionsServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQueryCoord_WatchCollections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchCollections'
+type MockQueryCoord_WatchCollections_Call struct {
+	*mock.Call
+}
+
+// WatchCollections is a helper method to define mock.On call
+//   - _a0 *querypb.WatchCollectionsRequest
+//   - _a1 querypb.QueryCoord_WatchCollectionsServer
+func (_e *MockQueryCoord_Expecter) WatchCollections(_a0 interface{}, _a1 interface{}) *MockQueryCoord_WatchCollections_Call {
+	return &MockQueryCoord_WatchCollections_Call{Call: _e.mock.On("WatchCollections", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_WatchCollections_Call) Run(run func(_a0 *querypb.WatchCollectionsRequest, _a1 querypb.QueryCoord_WatchCollectionsServer)) *MockQueryCoord_WatchCollections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*querypb.WatchCollectionsRequest), args[1].(querypb.QueryCoord_WatchCollectionsServer))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_WatchCollections_Call) Return(_a0 error) *MockQueryCoord_WatchCollections_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQueryCoord_WatchCollections_Call) RunAndReturn(run func(*querypb.WatchCollectionsRequest, querypb.QueryCoord_WatchCollectionsServer) error) *MockQueryCoord_WatchCollections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockQueryCoord creates a new instance of MockQueryCoord. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockQueryCoord(t interface {
@@ -101,8 +101,8 @@ func (_c *MockQueryCoordClient_ActivateChecker_Call) RunAndReturn(run func(conte
 	return _c
 }
 
-// CheckHealth provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
+// BlockShard provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) BlockShard(ctx context.Context, in *querypb.BlockShardRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -112,20 +112,20 @@ func (_m *MockQueryCoordClient) CheckHealth(ctx context.Context, in *milvuspb.Ch
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.CheckHealthResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CheckHealthRequest, ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.BlockShardRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CheckHealthRequest, ...grpc.CallOption) *milvuspb.CheckHealthResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.BlockShardRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.CheckHealthResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CheckHealthRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.BlockShardRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -134,21 +134,21 @@ func (_m *MockQueryCoordClient) CheckHealth(ctx context.Context, in *milvuspb.Ch
 	return r0, r1
 }
 
-// MockQueryCoordClient_CheckHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckHealth'
-type MockQueryCoordClient_CheckHealth_Call struct {
+// MockQueryCoordClient_BlockShard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockShard'
+type MockQueryCoordClient_BlockShard_Call struct {
 	*mock.Call
 }
 
-// CheckHealth is a helper method to define mock.On call
+// BlockShard is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.CheckHealthRequest
+//   - in *querypb.BlockShardRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) CheckHealth(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_CheckHealth_Call {
-	return &MockQueryCoordClient_CheckHealth_Call{Call: _e.mock.On("CheckHealth",
+func (_e *MockQueryCoordClient_Expecter) BlockShard(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_BlockShard_Call {
+	return &MockQueryCoordClient_BlockShard_Call{Call: _e.mock.On("BlockShard",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_CheckHealth_Call) Run(run func(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_CheckHealth_Call {
+func (_c *MockQueryCoordClient_BlockShard_Call) Run(run func(ctx context.Context, in *querypb.BlockShardRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_BlockShard_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -156,23 +156,23 @@ func (_c *MockQueryCoordClient_CheckHealth_Call) Run(run func(ctx context.Contex
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.CheckHealthRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.BlockShardRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_CheckHealth_Call) Return(_a0 *milvuspb.CheckHealthResponse, _a1 error) *MockQueryCoordClient_CheckHealth_Call {
+func (_c *MockQueryCoordClient_BlockShard_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_BlockShard_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_CheckHealth_Call) RunAndReturn(run func(context.Context, *milvuspb.CheckHealthRequest, ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error)) *MockQueryCoordClient_CheckHealth_Call {
+func (_c *MockQueryCoordClient_BlockShard_Call) RunAndReturn(run func(context.Context, *querypb.BlockShardRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_BlockShard_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CheckQueryNodeDistribution provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) CheckQueryNodeDistribution(ctx context.Context, in *querypb.CheckQueryNodeDistributionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// CanStopNode provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) CanStopNode(ctx context.Context, in *querypb.CanStopNodeRequest, opts ...grpc.CallOption) (*querypb.CanStopNodeResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -182,20 +182,20 @@ func (_m *MockQueryCoordClient) CheckQueryNodeDistribution(ctx context.Context,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *commonpb.Status
+	var r0 *querypb.CanStopNodeResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CheckQueryNodeDistributionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CanStopNodeRequest, ...grpc.CallOption) (*querypb.CanStopNodeResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CheckQueryNodeDistributionRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CanStopNodeRequest, ...grpc.CallOption) *querypb.CanStopNodeResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*commonpb.Status)
+			r0 = ret.Get(0).(*querypb.CanStopNodeResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.CheckQueryNodeDistributionRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.CanStopNodeRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -204,21 +204,21 @@ func (_m *MockQueryCoordClient) CheckQueryNodeDistribution(ctx context.Context,
 	return r0, r1
 }
 
-// MockQueryCoordClient_CheckQueryNodeDistribution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckQueryNodeDistribution'
-type MockQueryCoordClient_CheckQueryNodeDistribution_Call struct {
+// MockQueryCoordClient_CanStopNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CanStopNode'
+type MockQueryCoordClient_CanStopNode_Call struct {
 	*mock.Call
 }
 
-// CheckQueryNodeDistribution is a helper method to define mock.On call
+// CanStopNode is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *querypb.CheckQueryNodeDistributionRequest
+//   - in *querypb.CanStopNodeRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) CheckQueryNodeDistribution(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_CheckQueryNodeDistribution_Call {
-	return &MockQueryCoordClient_CheckQueryNodeDistribution_Call{Call: _e.mock.On("CheckQueryNodeDistribution",
+func (_e *MockQueryCoordClient_Expecter) CanStopNode(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_CanStopNode_Call {
+	return &MockQueryCoordClient_CanStopNode_Call{Call: _e.mock.On("CanStopNode",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_CheckQueryNodeDistribution_Call) Run(run func(ctx context.Context, in *querypb.CheckQueryNodeDistributionRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_CheckQueryNodeDistribution_Call {
+func (_c *MockQueryCoordClient_CanStopNode_Call) Run(run func(ctx context.Context, in *querypb.CanStopNodeRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_CanStopNode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -226,64 +226,93 @@ func (_c *MockQueryCoordClient_CheckQueryNodeDistribution_Call) Run(run func(ctx
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*querypb.CheckQueryNodeDistributionRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.CanStopNodeRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_CheckQueryNodeDistribution_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_CheckQueryNodeDistribution_Call {
+func (_c *MockQueryCoordClient_CanStopNode_Call) Return(_a0 *querypb.CanStopNodeResponse, _a1 error) *MockQueryCoordClient_CanStopNode_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_CheckQueryNodeDistribution_Call) RunAndReturn(run func(context.Context, *querypb.CheckQueryNodeDistributionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_CheckQueryNodeDistribution_Call {
+func (_c *MockQueryCoordClient_CanStopNode_Call) RunAndReturn(run func(context.Context, *querypb.CanStopNodeRequest, ...grpc.CallOption) (*querypb.CanStopNodeResponse, error)) *MockQueryCoordClient_CanStopNode_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Close provides a mock function with given fields:
-func (_m *MockQueryCoordClient) Close() error {
-	ret := _m.Called()
+// CancelLoad provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) CancelLoad(ctx context.Context, in *querypb.CancelLoadRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func() error); ok {
-		r0 = rf()
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CancelLoadRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CancelLoadRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.CancelLoadRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockQueryCoordClient_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
-type MockQueryCoordClient_Close_Call struct {
+// MockQueryCoordClient_CancelLoad_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelLoad'
+type MockQueryCoordClient_CancelLoad_Call struct {
 	*mock.Call
 }
 
-// Close is a helper method to define mock.On call
-func (_e *MockQueryCoordClient_Expecter) Close() *MockQueryCoordClient_Close_Call {
-	return &MockQueryCoordClient_Close_Call{Call: _e.mock.On("Close")}
+// CancelLoad is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.CancelLoadRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) CancelLoad(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_CancelLoad_Call {
+	return &MockQueryCoordClient_CancelLoad_Call{Call: _e.mock.On("CancelLoad",
+		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_Close_Call) Run(run func()) *MockQueryCoordClient_Close_Call {
+func (_c *MockQueryCoordClient_CancelLoad_Call) Run(run func(ctx context.Context, in *querypb.CancelLoadRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_CancelLoad_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.CancelLoadRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_Close_Call) Return(_a0 error) *MockQueryCoordClient_Close_Call {
-	_c.Call.Return(_a0)
+func (_c *MockQueryCoordClient_CancelLoad_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_CancelLoad_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_Close_Call) RunAndReturn(run func() error) *MockQueryCoordClient_Close_Call {
+func (_c *MockQueryCoordClient_CancelLoad_Call) RunAndReturn(run func(context.Context, *querypb.CancelLoadRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_CancelLoad_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateResourceGroup provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) CreateResourceGroup(ctx context.Context, in *milvuspb.CreateResourceGroupRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// CaptureBalanceLayout provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) CaptureBalanceLayout(ctx context.Context, in *querypb.CaptureBalanceLayoutRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -295,10 +324,10 @@ func (_m *MockQueryCoordClient) CreateResourceGroup(ctx context.Context, in *mil
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateResourceGroupRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CaptureBalanceLayoutRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateResourceGroupRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CaptureBalanceLayoutRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -306,7 +335,7 @@ func (_m *MockQueryCoordClient) CreateResourceGroup(ctx context.Context, in *mil
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CreateResourceGroupRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.CaptureBalanceLayoutRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -315,21 +344,21 @@ func (_m *MockQueryCoordClient) CreateResourceGroup(ctx context.Context, in *mil
 	return r0, r1
 }
 
-// MockQueryCoordClient_CreateResourceGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateResourceGroup'
-type MockQueryCoordClient_CreateResourceGroup_Call struct {
+// MockQueryCoordClient_CaptureBalanceLayout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CaptureBalanceLayout'
+type MockQueryCoordClient_CaptureBalanceLayout_Call struct {
 	*mock.Call
 }
 
-// CreateResourceGroup is a helper method to define mock.On call
+// CaptureBalanceLayout is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.CreateResourceGroupRequest
+//   - in *querypb.CaptureBalanceLayoutRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) CreateResourceGroup(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_CreateResourceGroup_Call {
-	return &MockQueryCoordClient_CreateResourceGroup_Call{Call: _e.mock.On("CreateResourceGroup",
+func (_e *MockQueryCoordClient_Expecter) CaptureBalanceLayout(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_CaptureBalanceLayout_Call {
+	return &MockQueryCoordClient_CaptureBalanceLayout_Call{Call: _e.mock.On("CaptureBalanceLayout",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_CreateResourceGroup_Call) Run(run func(ctx context.Context, in *milvuspb.CreateResourceGroupRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_CreateResourceGroup_Call {
+func (_c *MockQueryCoordClient_CaptureBalanceLayout_Call) Run(run func(ctx context.Context, in *querypb.CaptureBalanceLayoutRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_CaptureBalanceLayout_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -337,23 +366,23 @@ func (_c *MockQueryCoordClient_CreateResourceGroup_Call) Run(run func(ctx contex
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.CreateResourceGroupRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.CaptureBalanceLayoutRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_CreateResourceGroup_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_CreateResourceGroup_Call {
+func (_c *MockQueryCoordClient_CaptureBalanceLayout_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_CaptureBalanceLayout_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_CreateResourceGroup_Call) RunAndReturn(run func(context.Context, *milvuspb.CreateResourceGroupRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_CreateResourceGroup_Call {
+func (_c *MockQueryCoordClient_CaptureBalanceLayout_Call) RunAndReturn(run func(context.Context, *querypb.CaptureBalanceLayoutRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_CaptureBalanceLayout_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeactivateChecker provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) DeactivateChecker(ctx context.Context, in *querypb.DeactivateCheckerRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// CheckHealth provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -363,20 +392,20 @@ func (_m *MockQueryCoordClient) DeactivateChecker(ctx context.Context, in *query
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *commonpb.Status
+	var r0 *milvuspb.CheckHealthResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DeactivateCheckerRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CheckHealthRequest, ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DeactivateCheckerRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CheckHealthRequest, ...grpc.CallOption) *milvuspb.CheckHealthResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*commonpb.Status)
+			r0 = ret.Get(0).(*milvuspb.CheckHealthResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.DeactivateCheckerRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CheckHealthRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -385,21 +414,21 @@ func (_m *MockQueryCoordClient) DeactivateChecker(ctx context.Context, in *query
 	return r0, r1
 }
 
-// MockQueryCoordClient_DeactivateChecker_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeactivateChecker'
-type MockQueryCoordClient_DeactivateChecker_Call struct {
+// MockQueryCoordClient_CheckHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckHealth'
+type MockQueryCoordClient_CheckHealth_Call struct {
 	*mock.Call
 }
 
-// DeactivateChecker is a helper method to define mock.On call
+// CheckHealth is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *querypb.DeactivateCheckerRequest
+//   - in *milvuspb.CheckHealthRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) DeactivateChecker(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_DeactivateChecker_Call {
-	return &MockQueryCoordClient_DeactivateChecker_Call{Call: _e.mock.On("DeactivateChecker",
+func (_e *MockQueryCoordClient_Expecter) CheckHealth(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_CheckHealth_Call {
+	return &MockQueryCoordClient_CheckHealth_Call{Call: _e.mock.On("CheckHealth",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_DeactivateChecker_Call) Run(run func(ctx context.Context, in *querypb.DeactivateCheckerRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_DeactivateChecker_Call {
+func (_c *MockQueryCoordClient_CheckHealth_Call) Run(run func(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_CheckHealth_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -407,23 +436,23 @@ func (_c *MockQueryCoordClient_DeactivateChecker_Call) Run(run func(ctx context.
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*querypb.DeactivateCheckerRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*milvuspb.CheckHealthRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_DeactivateChecker_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_DeactivateChecker_Call {
+func (_c *MockQueryCoordClient_CheckHealth_Call) Return(_a0 *milvuspb.CheckHealthResponse, _a1 error) *MockQueryCoordClient_CheckHealth_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_DeactivateChecker_Call) RunAndReturn(run func(context.Context, *querypb.DeactivateCheckerRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_DeactivateChecker_Call {
+func (_c *MockQueryCoordClient_CheckHealth_Call) RunAndReturn(run func(context.Context, *milvuspb.CheckHealthRequest, ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error)) *MockQueryCoordClient_CheckHealth_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DescribeResourceGroup provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) DescribeResourceGroup(ctx context.Context, in *querypb.DescribeResourceGroupRequest, opts ...grpc.CallOption) (*querypb.DescribeResourceGroupResponse, error) {
+// CheckNodeHealth provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) CheckNodeHealth(ctx context.Context, in *querypb.CheckNodeHealthRequest, opts ...grpc.CallOption) (*querypb.CheckNodeHealthResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -433,20 +462,20 @@ func (_m *MockQueryCoordClient) DescribeResourceGroup(ctx context.Context, in *q
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *querypb.DescribeResourceGroupResponse
+	var r0 *querypb.CheckNodeHealthResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DescribeResourceGroupRequest, ...grpc.CallOption) (*querypb.DescribeResourceGroupResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CheckNodeHealthRequest, ...grpc.CallOption) (*querypb.CheckNodeHealthResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DescribeResourceGroupRequest, ...grpc.CallOption) *querypb.DescribeResourceGroupResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CheckNodeHealthRequest, ...grpc.CallOption) *querypb.CheckNodeHealthResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*querypb.DescribeResourceGroupResponse)
+			r0 = ret.Get(0).(*querypb.CheckNodeHealthResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.DescribeResourceGroupRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.CheckNodeHealthRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -455,21 +484,21 @@ func (_m *MockQueryCoordClient) DescribeResourceGroup(ctx context.Context, in *q
 	return r0, r1
 }
 
-// MockQueryCoordClient_DescribeResourceGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeResourceGroup'
-type MockQueryCoordClient_DescribeResourceGroup_Call struct {
+// MockQueryCoordClient_CheckNodeHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckNodeHealth'
+type MockQueryCoordClient_CheckNodeHealth_Call struct {
 	*mock.Call
 }
 
-// DescribeResourceGroup is a helper method to define mock.On call
+// CheckNodeHealth is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *querypb.DescribeResourceGroupRequest
+//   - in *querypb.CheckNodeHealthRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) DescribeResourceGroup(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_DescribeResourceGroup_Call {
-	return &MockQueryCoordClient_DescribeResourceGroup_Call{Call: _e.mock.On("DescribeResourceGroup",
+func (_e *MockQueryCoordClient_Expecter) CheckNodeHealth(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_CheckNodeHealth_Call {
+	return &MockQueryCoordClient_CheckNodeHealth_Call{Call: _e.mock.On("CheckNodeHealth",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_DescribeResourceGroup_Call) Run(run func(ctx context.Context, in *querypb.DescribeResourceGroupRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_DescribeResourceGroup_Call {
+func (_c *MockQueryCoordClient_CheckNodeHealth_Call) Run(run func(ctx context.Context, in *querypb.CheckNodeHealthRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_CheckNodeHealth_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -477,23 +506,23 @@ func (_c *MockQueryCoordClient_DescribeResourceGroup_Call) Run(run func(ctx cont
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*querypb.DescribeResourceGroupRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.CheckNodeHealthRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_DescribeResourceGroup_Call) Return(_a0 *querypb.DescribeResourceGroupResponse, _a1 error) *MockQueryCoordClient_DescribeResourceGroup_Call {
+func (_c *MockQueryCoordClient_CheckNodeHealth_Call) Return(_a0 *querypb.CheckNodeHealthResponse, _a1 error) *MockQueryCoordClient_CheckNodeHealth_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_DescribeResourceGroup_Call) RunAndReturn(run func(context.Context, *querypb.DescribeResourceGroupRequest, ...grpc.CallOption) (*querypb.DescribeResourceGroupResponse, error)) *MockQueryCoordClient_DescribeResourceGroup_Call {
+func (_c *MockQueryCoordClient_CheckNodeHealth_Call) RunAndReturn(run func(context.Context, *querypb.CheckNodeHealthRequest, ...grpc.CallOption) (*querypb.CheckNodeHealthResponse, error)) *MockQueryCoordClient_CheckNodeHealth_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DropResourceGroup provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) DropResourceGroup(ctx context.Context, in *milvuspb.DropResourceGroupRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// CheckQueryNodeDistribution provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) CheckQueryNodeDistribution(ctx context.Context, in *querypb.CheckQueryNodeDistributionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -505,10 +534,10 @@ func (_m *MockQueryCoordClient) DropResourceGroup(ctx context.Context, in *milvu
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropResourceGroupRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CheckQueryNodeDistributionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropResourceGroupRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CheckQueryNodeDistributionRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -516,7 +545,7 @@ func (_m *MockQueryCoordClient) DropResourceGroup(ctx context.Context, in *milvu
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DropResourceGroupRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.CheckQueryNodeDistributionRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -525,21 +554,21 @@ func (_m *MockQueryCoordClient) DropResourceGroup(ctx context.Context, in *milvu
 	return r0, r1
 }
 
-// MockQueryCoordClient_DropResourceGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropResourceGroup'
-type MockQueryCoordClient_DropResourceGroup_Call struct {
+// MockQueryCoordClient_CheckQueryNodeDistribution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckQueryNodeDistribution'
+type MockQueryCoordClient_CheckQueryNodeDistribution_Call struct {
 	*mock.Call
 }
 
-// DropResourceGroup is a helper method to define mock.On call
+// CheckQueryNodeDistribution is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.DropResourceGroupRequest
+//   - in *querypb.CheckQueryNodeDistributionRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) DropResourceGroup(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_DropResourceGroup_Call {
-	return &MockQueryCoordClient_DropResourceGroup_Call{Call: _e.mock.On("DropResourceGroup",
+func (_e *MockQueryCoordClient_Expecter) CheckQueryNodeDistribution(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_CheckQueryNodeDistribution_Call {
+	return &MockQueryCoordClient_CheckQueryNodeDistribution_Call{Call: _e.mock.On("CheckQueryNodeDistribution",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_DropResourceGroup_Call) Run(run func(ctx context.Context, in *milvuspb.DropResourceGroupRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_DropResourceGroup_Call {
+func (_c *MockQueryCoordClient_CheckQueryNodeDistribution_Call) Run(run func(ctx context.Context, in *querypb.CheckQueryNodeDistributionRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_CheckQueryNodeDistribution_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -547,23 +576,23 @@ func (_c *MockQueryCoordClient_DropResourceGroup_Call) Run(run func(ctx context.
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.DropResourceGroupRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.CheckQueryNodeDistributionRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_DropResourceGroup_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_DropResourceGroup_Call {
+func (_c *MockQueryCoordClient_CheckQueryNodeDistribution_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_CheckQueryNodeDistribution_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_DropResourceGroup_Call) RunAndReturn(run func(context.Context, *milvuspb.DropResourceGroupRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_DropResourceGroup_Call {
+func (_c *MockQueryCoordClient_CheckQueryNodeDistribution_Call) RunAndReturn(run func(context.Context, *querypb.CheckQueryNodeDistributionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_CheckQueryNodeDistribution_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetComponentStates provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
+// ClearBalanceLayout provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) ClearBalanceLayout(ctx context.Context, in *querypb.ClearBalanceLayoutRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -573,20 +602,3071 @@ func (_m *MockQueryCoordClient) GetComponentStates(ctx context.Context, in *milv
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.ComponentStates
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ClearBalanceLayoutRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ClearBalanceLayoutRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ClearBalanceLayoutRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_ClearBalanceLayout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearBalanceLayout'
+type MockQueryCoordClient_ClearBalanceLayout_Call struct {
+	*mock.Call
+}
+
+// ClearBalanceLayout is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.ClearBalanceLayoutRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) ClearBalanceLayout(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_ClearBalanceLayout_Call {
+	return &MockQueryCoordClient_ClearBalanceLayout_Call{Call: _e.mock.On("ClearBalanceLayout",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_ClearBalanceLayout_Call) Run(run func(ctx context.Context, in *querypb.ClearBalanceLayoutRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_ClearBalanceLayout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.ClearBalanceLayoutRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_ClearBalanceLayout_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_ClearBalanceLayout_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_ClearBalanceLayout_Call) RunAndReturn(run func(context.Context, *querypb.ClearBalanceLayoutRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_ClearBalanceLayout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Close provides a mock function with given fields:
+func (_m *MockQueryCoordClient) Close() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQueryCoordClient_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type MockQueryCoordClient_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *MockQueryCoordClient_Expecter) Close() *MockQueryCoordClient_Close_Call {
+	return &MockQueryCoordClient_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *MockQueryCoordClient_Close_Call) Run(run func()) *MockQueryCoordClient_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_Close_Call) Return(_a0 error) *MockQueryCoordClient_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_Close_Call) RunAndReturn(run func() error) *MockQueryCoordClient_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateResourceGroup provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) CreateResourceGroup(ctx context.Context, in *milvuspb.CreateResourceGroupRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateResourceGroupRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateResourceGroupRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CreateResourceGroupRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_CreateResourceGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateResourceGroup'
+type MockQueryCoordClient_CreateResourceGroup_Call struct {
+	*mock.Call
+}
+
+// CreateResourceGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *milvuspb.CreateResourceGroupRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) CreateResourceGroup(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_CreateResourceGroup_Call {
+	return &MockQueryCoordClient_CreateResourceGroup_Call{Call: _e.mock.On("CreateResourceGroup",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_CreateResourceGroup_Call) Run(run func(ctx context.Context, in *milvuspb.CreateResourceGroupRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_CreateResourceGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*milvuspb.CreateResourceGroupRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_CreateResourceGroup_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_CreateResourceGroup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_CreateResourceGroup_Call) RunAndReturn(run func(context.Context, *milvuspb.CreateResourceGroupRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_CreateResourceGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateResourceGroupWithAutoFill provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) CreateResourceGroupWithAutoFill(ctx context.Context, in *querypb.CreateResourceGroupWithAutoFillRequest, opts ...grpc.CallOption) (*querypb.CreateResourceGroupWithAutoFillResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.CreateResourceGroupWithAutoFillResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CreateResourceGroupWithAutoFillRequest, ...grpc.CallOption) (*querypb.CreateResourceGroupWithAutoFillResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CreateResourceGroupWithAutoFillRequest, ...grpc.CallOption) *querypb.CreateResourceGroupWithAutoFillResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.CreateResourceGroupWithAutoFillResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.CreateResourceGroupWithAutoFillRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_CreateResourceGroupWithAutoFill_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateResourceGroupWithAutoFill'
+type MockQueryCoordClient_CreateResourceGroupWithAutoFill_Call struct {
+	*mock.Call
+}
+
+// CreateResourceGroupWithAutoFill is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.CreateResourceGroupWithAutoFillRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) CreateResourceGroupWithAutoFill(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_CreateResourceGroupWithAutoFill_Call {
+	return &MockQueryCoordClient_CreateResourceGroupWithAutoFill_Call{Call: _e.mock.On("CreateResourceGroupWithAutoFill",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_CreateResourceGroupWithAutoFill_Call) Run(run func(ctx context.Context, in *querypb.CreateResourceGroupWithAutoFillRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_CreateResourceGroupWithAutoFill_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.CreateResourceGroupWithAutoFillRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_CreateResourceGroupWithAutoFill_Call) Return(_a0 *querypb.CreateResourceGroupWithAutoFillResponse, _a1 error) *MockQueryCoordClient_CreateResourceGroupWithAutoFill_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_CreateResourceGroupWithAutoFill_Call) RunAndReturn(run func(context.Context, *querypb.CreateResourceGroupWithAutoFillRequest, ...grpc.CallOption) (*querypb.CreateResourceGroupWithAutoFillResponse, error)) *MockQueryCoordClient_CreateResourceGroupWithAutoFill_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeactivateChecker provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) DeactivateChecker(ctx context.Context, in *querypb.DeactivateCheckerRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DeactivateCheckerRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DeactivateCheckerRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.DeactivateCheckerRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_DeactivateChecker_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeactivateChecker'
+type MockQueryCoordClient_DeactivateChecker_Call struct {
+	*mock.Call
+}
+
+// DeactivateChecker is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.DeactivateCheckerRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) DeactivateChecker(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_DeactivateChecker_Call {
+	return &MockQueryCoordClient_DeactivateChecker_Call{Call: _e.mock.On("DeactivateChecker",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_DeactivateChecker_Call) Run(run func(ctx context.Context, in *querypb.DeactivateCheckerRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_DeactivateChecker_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.DeactivateCheckerRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_DeactivateChecker_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_DeactivateChecker_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_DeactivateChecker_Call) RunAndReturn(run func(context.Context, *querypb.DeactivateCheckerRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_DeactivateChecker_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DecommissionNode provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) DecommissionNode(ctx context.Context, in *querypb.DecommissionNodeRequest, opts ...grpc.CallOption) (*querypb.DecommissionNodeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.DecommissionNodeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DecommissionNodeRequest, ...grpc.CallOption) (*querypb.DecommissionNodeResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DecommissionNodeRequest, ...grpc.CallOption) *querypb.DecommissionNodeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.DecommissionNodeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.DecommissionNodeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_DecommissionNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DecommissionNode'
+type MockQueryCoordClient_DecommissionNode_Call struct {
+	*mock.Call
+}
+
+// DecommissionNode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.DecommissionNodeRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) DecommissionNode(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_DecommissionNode_Call {
+	return &MockQueryCoordClient_DecommissionNode_Call{Call: _e.mock.On("DecommissionNode",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_DecommissionNode_Call) Run(run func(ctx context.Context, in *querypb.DecommissionNodeRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_DecommissionNode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.DecommissionNodeRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_DecommissionNode_Call) Return(_a0 *querypb.DecommissionNodeResponse, _a1 error) *MockQueryCoordClient_DecommissionNode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_DecommissionNode_Call) RunAndReturn(run func(context.Context, *querypb.DecommissionNodeRequest, ...grpc.CallOption) (*querypb.DecommissionNodeResponse, error)) *MockQueryCoordClient_DecommissionNode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DescribeChecker provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) DescribeChecker(ctx context.Context, in *querypb.DescribeCheckerRequest, opts ...grpc.CallOption) (*querypb.DescribeCheckerResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.DescribeCheckerResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DescribeCheckerRequest, ...grpc.CallOption) (*querypb.DescribeCheckerResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DescribeCheckerRequest, ...grpc.CallOption) *querypb.DescribeCheckerResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.DescribeCheckerResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.DescribeCheckerRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_DescribeChecker_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeChecker'
+type MockQueryCoordClient_DescribeChecker_Call struct {
+	*mock.Call
+}
+
+// DescribeChecker is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.DescribeCheckerRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) DescribeChecker(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_DescribeChecker_Call {
+	return &MockQueryCoordClient_DescribeChecker_Call{Call: _e.mock.On("DescribeChecker",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_DescribeChecker_Call) Run(run func(ctx context.Context, in *querypb.DescribeCheckerRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_DescribeChecker_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.DescribeCheckerRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_DescribeChecker_Call) Return(_a0 *querypb.DescribeCheckerResponse, _a1 error) *MockQueryCoordClient_DescribeChecker_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_DescribeChecker_Call) RunAndReturn(run func(context.Context, *querypb.DescribeCheckerRequest, ...grpc.CallOption) (*querypb.DescribeCheckerResponse, error)) *MockQueryCoordClient_DescribeChecker_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DescribeReplica provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) DescribeReplica(ctx context.Context, in *querypb.DescribeReplicaRequest, opts ...grpc.CallOption) (*querypb.DescribeReplicaResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.DescribeReplicaResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DescribeReplicaRequest, ...grpc.CallOption) (*querypb.DescribeReplicaResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DescribeReplicaRequest, ...grpc.CallOption) *querypb.DescribeReplicaResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.DescribeReplicaResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.DescribeReplicaRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_DescribeReplica_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeReplica'
+type MockQueryCoordClient_DescribeReplica_Call struct {
+	*mock.Call
+}
+
+// DescribeReplica is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.DescribeReplicaRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) DescribeReplica(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_DescribeReplica_Call {
+	return &MockQueryCoordClient_DescribeReplica_Call{Call: _e.mock.On("DescribeReplica",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_DescribeReplica_Call) Run(run func(ctx context.Context, in *querypb.DescribeReplicaRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_DescribeReplica_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.DescribeReplicaRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_DescribeReplica_Call) Return(_a0 *querypb.DescribeReplicaResponse, _a1 error) *MockQueryCoordClient_DescribeReplica_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_DescribeReplica_Call) RunAndReturn(run func(context.Context, *querypb.DescribeReplicaRequest, ...grpc.CallOption) (*querypb.DescribeReplicaResponse, error)) *MockQueryCoordClient_DescribeReplica_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DescribeResourceGroup provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) DescribeResourceGroup(ctx context.Context, in *querypb.DescribeResourceGroupRequest, opts ...grpc.CallOption) (*querypb.DescribeResourceGroupResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.DescribeResourceGroupResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DescribeResourceGroupRequest, ...grpc.CallOption) (*querypb.DescribeResourceGroupResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.DescribeResourceGroupRequest, ...grpc.CallOption) *querypb.DescribeResourceGroupResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.DescribeResourceGroupResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.DescribeResourceGroupRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_DescribeResourceGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeResourceGroup'
+type MockQueryCoordClient_DescribeResourceGroup_Call struct {
+	*mock.Call
+}
+
+// DescribeResourceGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.DescribeResourceGroupRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) DescribeResourceGroup(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_DescribeResourceGroup_Call {
+	return &MockQueryCoordClient_DescribeResourceGroup_Call{Call: _e.mock.On("DescribeResourceGroup",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_DescribeResourceGroup_Call) Run(run func(ctx context.Context, in *querypb.DescribeResourceGroupRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_DescribeResourceGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.DescribeResourceGroupRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_DescribeResourceGroup_Call) Return(_a0 *querypb.DescribeResourceGroupResponse, _a1 error) *MockQueryCoordClient_DescribeResourceGroup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_DescribeResourceGroup_Call) RunAndReturn(run func(context.Context, *querypb.DescribeResourceGroupRequest, ...grpc.CallOption) (*querypb.DescribeResourceGroupResponse, error)) *MockQueryCoordClient_DescribeResourceGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DropResourceGroup provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) DropResourceGroup(ctx context.Context, in *milvuspb.DropResourceGroupRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropResourceGroupRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropResourceGroupRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DropResourceGroupRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_DropResourceGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropResourceGroup'
+type MockQueryCoordClient_DropResourceGroup_Call struct {
+	*mock.Call
+}
+
+// DropResourceGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *milvuspb.DropResourceGroupRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) DropResourceGroup(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_DropResourceGroup_Call {
+	return &MockQueryCoordClient_DropResourceGroup_Call{Call: _e.mock.On("DropResourceGroup",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_DropResourceGroup_Call) Run(run func(ctx context.Context, in *milvuspb.DropResourceGroupRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_DropResourceGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*milvuspb.DropResourceGroupRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_DropResourceGroup_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_DropResourceGroup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_DropResourceGroup_Call) RunAndReturn(run func(context.Context, *milvuspb.DropResourceGroupRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_DropResourceGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DryRunLoadBalance provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) DryRunLoadBalance(ctx context.Context, in *querypb.LoadBalanceRequest, opts ...grpc.CallOption) (*querypb.DryRunLoadBalanceResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.DryRunLoadBalanceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.LoadBalanceRequest, ...grpc.CallOption) (*querypb.DryRunLoadBalanceResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.LoadBalanceRequest, ...grpc.CallOption) *querypb.DryRunLoadBalanceResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.DryRunLoadBalanceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.LoadBalanceRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_DryRunLoadBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DryRunLoadBalance'
+type MockQueryCoordClient_DryRunLoadBalance_Call struct {
+	*mock.Call
+}
+
+// DryRunLoadBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.LoadBalanceRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) DryRunLoadBalance(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_DryRunLoadBalance_Call {
+	return &MockQueryCoordClient_DryRunLoadBalance_Call{Call: _e.mock.On("DryRunLoadBalance",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_DryRunLoadBalance_Call) Run(run func(ctx context.Context, in *querypb.LoadBalanceRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_DryRunLoadBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.LoadBalanceRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_DryRunLoadBalance_Call) Return(_a0 *querypb.DryRunLoadBalanceResponse, _a1 error) *MockQueryCoordClient_DryRunLoadBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_DryRunLoadBalance_Call) RunAndReturn(run func(context.Context, *querypb.LoadBalanceRequest, ...grpc.CallOption) (*querypb.DryRunLoadBalanceResponse, error)) *MockQueryCoordClient_DryRunLoadBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DryRunRebalanceCollection provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) DryRunRebalanceCollection(ctx context.Context, in *querypb.RebalanceCollectionRequest, opts ...grpc.CallOption) (*querypb.RebalanceCollectionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.RebalanceCollectionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.RebalanceCollectionRequest, ...grpc.CallOption) (*querypb.RebalanceCollectionResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.RebalanceCollectionRequest, ...grpc.CallOption) *querypb.RebalanceCollectionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.RebalanceCollectionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.RebalanceCollectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_DryRunRebalanceCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DryRunRebalanceCollection'
+type MockQueryCoordClient_DryRunRebalanceCollection_Call struct {
+	*mock.Call
+}
+
+// DryRunRebalanceCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.RebalanceCollectionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) DryRunRebalanceCollection(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_DryRunRebalanceCollection_Call {
+	return &MockQueryCoordClient_DryRunRebalanceCollection_Call{Call: _e.mock.On("DryRunRebalanceCollection",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_DryRunRebalanceCollection_Call) Run(run func(ctx context.Context, in *querypb.RebalanceCollectionRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_DryRunRebalanceCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.RebalanceCollectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_DryRunRebalanceCollection_Call) Return(_a0 *querypb.RebalanceCollectionResponse, _a1 error) *MockQueryCoordClient_DryRunRebalanceCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_DryRunRebalanceCollection_Call) RunAndReturn(run func(context.Context, *querypb.RebalanceCollectionRequest, ...grpc.CallOption) (*querypb.RebalanceCollectionResponse, error)) *MockQueryCoordClient_DryRunRebalanceCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ForceSync provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) ForceSync(ctx context.Context, in *querypb.ForceSyncRequest, opts ...grpc.CallOption) (*querypb.ForceSyncResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.ForceSyncResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ForceSyncRequest, ...grpc.CallOption) (*querypb.ForceSyncResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ForceSyncRequest, ...grpc.CallOption) *querypb.ForceSyncResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.ForceSyncResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ForceSyncRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_ForceSync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForceSync'
+type MockQueryCoordClient_ForceSync_Call struct {
+	*mock.Call
+}
+
+// ForceSync is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.ForceSyncRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) ForceSync(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_ForceSync_Call {
+	return &MockQueryCoordClient_ForceSync_Call{Call: _e.mock.On("ForceSync",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_ForceSync_Call) Run(run func(ctx context.Context, in *querypb.ForceSyncRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_ForceSync_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.ForceSyncRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_ForceSync_Call) Return(_a0 *querypb.ForceSyncResponse, _a1 error) *MockQueryCoordClient_ForceSync_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_ForceSync_Call) RunAndReturn(run func(context.Context, *querypb.ForceSyncRequest, ...grpc.CallOption) (*querypb.ForceSyncResponse, error)) *MockQueryCoordClient_ForceSync_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAvailabilitySLA provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetAvailabilitySLA(ctx context.Context, in *querypb.GetAvailabilitySLARequest, opts ...grpc.CallOption) (*querypb.GetAvailabilitySLAResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.GetAvailabilitySLAResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetAvailabilitySLARequest, ...grpc.CallOption) (*querypb.GetAvailabilitySLAResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetAvailabilitySLARequest, ...grpc.CallOption) *querypb.GetAvailabilitySLAResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetAvailabilitySLAResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetAvailabilitySLARequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetAvailabilitySLA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAvailabilitySLA'
+type MockQueryCoordClient_GetAvailabilitySLA_Call struct {
+	*mock.Call
+}
+
+// GetAvailabilitySLA is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.GetAvailabilitySLARequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetAvailabilitySLA(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetAvailabilitySLA_Call {
+	return &MockQueryCoordClient_GetAvailabilitySLA_Call{Call: _e.mock.On("GetAvailabilitySLA",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetAvailabilitySLA_Call) Run(run func(ctx context.Context, in *querypb.GetAvailabilitySLARequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetAvailabilitySLA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.GetAvailabilitySLARequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetAvailabilitySLA_Call) Return(_a0 *querypb.GetAvailabilitySLAResponse, _a1 error) *MockQueryCoordClient_GetAvailabilitySLA_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetAvailabilitySLA_Call) RunAndReturn(run func(context.Context, *querypb.GetAvailabilitySLARequest, ...grpc.CallOption) (*querypb.GetAvailabilitySLAResponse, error)) *MockQueryCoordClient_GetAvailabilitySLA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBalanceLayoutStatus provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetBalanceLayoutStatus(ctx context.Context, in *querypb.GetBalanceLayoutStatusRequest, opts ...grpc.CallOption) (*querypb.GetBalanceLayoutStatusResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.GetBalanceLayoutStatusResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetBalanceLayoutStatusRequest, ...grpc.CallOption) (*querypb.GetBalanceLayoutStatusResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetBalanceLayoutStatusRequest, ...grpc.CallOption) *querypb.GetBalanceLayoutStatusResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetBalanceLayoutStatusResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetBalanceLayoutStatusRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetBalanceLayoutStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBalanceLayoutStatus'
+type MockQueryCoordClient_GetBalanceLayoutStatus_Call struct {
+	*mock.Call
+}
+
+// GetBalanceLayoutStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.GetBalanceLayoutStatusRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetBalanceLayoutStatus(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetBalanceLayoutStatus_Call {
+	return &MockQueryCoordClient_GetBalanceLayoutStatus_Call{Call: _e.mock.On("GetBalanceLayoutStatus",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetBalanceLayoutStatus_Call) Run(run func(ctx context.Context, in *querypb.GetBalanceLayoutStatusRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetBalanceLayoutStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.GetBalanceLayoutStatusRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetBalanceLayoutStatus_Call) Return(_a0 *querypb.GetBalanceLayoutStatusResponse, _a1 error) *MockQueryCoordClient_GetBalanceLayoutStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetBalanceLayoutStatus_Call) RunAndReturn(run func(context.Context, *querypb.GetBalanceLayoutStatusRequest, ...grpc.CallOption) (*querypb.GetBalanceLayoutStatusResponse, error)) *MockQueryCoordClient_GetBalanceLayoutStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetClusterLoadSummary provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetClusterLoadSummary(ctx context.Context, in *querypb.GetClusterLoadSummaryRequest, opts ...grpc.CallOption) (*querypb.GetClusterLoadSummaryResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.GetClusterLoadSummaryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetClusterLoadSummaryRequest, ...grpc.CallOption) (*querypb.GetClusterLoadSummaryResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetClusterLoadSummaryRequest, ...grpc.CallOption) *querypb.GetClusterLoadSummaryResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetClusterLoadSummaryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetClusterLoadSummaryRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetClusterLoadSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClusterLoadSummary'
+type MockQueryCoordClient_GetClusterLoadSummary_Call struct {
+	*mock.Call
+}
+
+// GetClusterLoadSummary is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.GetClusterLoadSummaryRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetClusterLoadSummary(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetClusterLoadSummary_Call {
+	return &MockQueryCoordClient_GetClusterLoadSummary_Call{Call: _e.mock.On("GetClusterLoadSummary",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetClusterLoadSummary_Call) Run(run func(ctx context.Context, in *querypb.GetClusterLoadSummaryRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetClusterLoadSummary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.GetClusterLoadSummaryRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetClusterLoadSummary_Call) Return(_a0 *querypb.GetClusterLoadSummaryResponse, _a1 error) *MockQueryCoordClient_GetClusterLoadSummary_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetClusterLoadSummary_Call) RunAndReturn(run func(context.Context, *querypb.GetClusterLoadSummaryRequest, ...grpc.CallOption) (*querypb.GetClusterLoadSummaryResponse, error)) *MockQueryCoordClient_GetClusterLoadSummary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCollectionLoadInfo provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetCollectionLoadInfo(ctx context.Context, in *querypb.GetCollectionLoadInfoRequest, opts ...grpc.CallOption) (*querypb.GetCollectionLoadInfoResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.GetCollectionLoadInfoResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetCollectionLoadInfoRequest, ...grpc.CallOption) (*querypb.GetCollectionLoadInfoResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetCollectionLoadInfoRequest, ...grpc.CallOption) *querypb.GetCollectionLoadInfoResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetCollectionLoadInfoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetCollectionLoadInfoRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetCollectionLoadInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionLoadInfo'
+type MockQueryCoordClient_GetCollectionLoadInfo_Call struct {
+	*mock.Call
+}
+
+// GetCollectionLoadInfo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.GetCollectionLoadInfoRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetCollectionLoadInfo(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetCollectionLoadInfo_Call {
+	return &MockQueryCoordClient_GetCollectionLoadInfo_Call{Call: _e.mock.On("GetCollectionLoadInfo",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetCollectionLoadInfo_Call) Run(run func(ctx context.Context, in *querypb.GetCollectionLoadInfoRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetCollectionLoadInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.GetCollectionLoadInfoRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetCollectionLoadInfo_Call) Return(_a0 *querypb.GetCollectionLoadInfoResponse, _a1 error) *MockQueryCoordClient_GetCollectionLoadInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetCollectionLoadInfo_Call) RunAndReturn(run func(context.Context, *querypb.GetCollectionLoadInfoRequest, ...grpc.CallOption) (*querypb.GetCollectionLoadInfoResponse, error)) *MockQueryCoordClient_GetCollectionLoadInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCollectionTargets provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetCollectionTargets(ctx context.Context, in *querypb.GetCollectionTargetsRequest, opts ...grpc.CallOption) (*querypb.GetCollectionTargetsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.GetCollectionTargetsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetCollectionTargetsRequest, ...grpc.CallOption) (*querypb.GetCollectionTargetsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetCollectionTargetsRequest, ...grpc.CallOption) *querypb.GetCollectionTargetsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetCollectionTargetsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetCollectionTargetsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetCollectionTargets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionTargets'
+type MockQueryCoordClient_GetCollectionTargets_Call struct {
+	*mock.Call
+}
+
+// GetCollectionTargets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.GetCollectionTargetsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetCollectionTargets(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetCollectionTargets_Call {
+	return &MockQueryCoordClient_GetCollectionTargets_Call{Call: _e.mock.On("GetCollectionTargets",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetCollectionTargets_Call) Run(run func(ctx context.Context, in *querypb.GetCollectionTargetsRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetCollectionTargets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.GetCollectionTargetsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetCollectionTargets_Call) Return(_a0 *querypb.GetCollectionTargetsResponse, _a1 error) *MockQueryCoordClient_GetCollectionTargets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetCollectionTargets_Call) RunAndReturn(run func(context.Context, *querypb.GetCollectionTargetsRequest, ...grpc.CallOption) (*querypb.GetCollectionTargetsResponse, error)) *MockQueryCoordClient_GetCollectionTargets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetComponentStates provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *milvuspb.ComponentStates
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) (*milvuspb.ComponentStates, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) *milvuspb.ComponentStates); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.ComponentStates)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetComponentStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComponentStates'
+type MockQueryCoordClient_GetComponentStates_Call struct {
+	*mock.Call
+}
+
+// GetComponentStates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *milvuspb.GetComponentStatesRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetComponentStates(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetComponentStates_Call {
+	return &MockQueryCoordClient_GetComponentStates_Call{Call: _e.mock.On("GetComponentStates",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetComponentStates_Call) Run(run func(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetComponentStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*milvuspb.GetComponentStatesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetComponentStates_Call) Return(_a0 *milvuspb.ComponentStates, _a1 error) *MockQueryCoordClient_GetComponentStates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetComponentStates_Call) RunAndReturn(run func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) (*milvuspb.ComponentStates, error)) *MockQueryCoordClient_GetComponentStates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDecommissionProgress provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetDecommissionProgress(ctx context.Context, in *querypb.GetDecommissionProgressRequest, opts ...grpc.CallOption) (*querypb.GetDecommissionProgressResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.GetDecommissionProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetDecommissionProgressRequest, ...grpc.CallOption) (*querypb.GetDecommissionProgressResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetDecommissionProgressRequest, ...grpc.CallOption) *querypb.GetDecommissionProgressResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetDecommissionProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetDecommissionProgressRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetDecommissionProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDecommissionProgress'
+type MockQueryCoordClient_GetDecommissionProgress_Call struct {
+	*mock.Call
+}
+
+// GetDecommissionProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.GetDecommissionProgressRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetDecommissionProgress(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetDecommissionProgress_Call {
+	return &MockQueryCoordClient_GetDecommissionProgress_Call{Call: _e.mock.On("GetDecommissionProgress",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetDecommissionProgress_Call) Run(run func(ctx context.Context, in *querypb.GetDecommissionProgressRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetDecommissionProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.GetDecommissionProgressRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetDecommissionProgress_Call) Return(_a0 *querypb.GetDecommissionProgressResponse, _a1 error) *MockQueryCoordClient_GetDecommissionProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetDecommissionProgress_Call) RunAndReturn(run func(context.Context, *querypb.GetDecommissionProgressRequest, ...grpc.CallOption) (*querypb.GetDecommissionProgressResponse, error)) *MockQueryCoordClient_GetDecommissionProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHandoffLag provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetHandoffLag(ctx context.Context, in *querypb.GetHandoffLagRequest, opts ...grpc.CallOption) (*querypb.GetHandoffLagResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.GetHandoffLagResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetHandoffLagRequest, ...grpc.CallOption) (*querypb.GetHandoffLagResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetHandoffLagRequest, ...grpc.CallOption) *querypb.GetHandoffLagResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetHandoffLagResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetHandoffLagRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetHandoffLag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHandoffLag'
+type MockQueryCoordClient_GetHandoffLag_Call struct {
+	*mock.Call
+}
+
+// GetHandoffLag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.GetHandoffLagRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetHandoffLag(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetHandoffLag_Call {
+	return &MockQueryCoordClient_GetHandoffLag_Call{Call: _e.mock.On("GetHandoffLag",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetHandoffLag_Call) Run(run func(ctx context.Context, in *querypb.GetHandoffLagRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetHandoffLag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.GetHandoffLagRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetHandoffLag_Call) Return(_a0 *querypb.GetHandoffLagResponse, _a1 error) *MockQueryCoordClient_GetHandoffLag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetHandoffLag_Call) RunAndReturn(run func(context.Context, *querypb.GetHandoffLagRequest, ...grpc.CallOption) (*querypb.GetHandoffLagResponse, error)) *MockQueryCoordClient_GetHandoffLag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoadBalanceStatus provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetLoadBalanceStatus(ctx context.Context, in *querypb.GetLoadBalanceStatusRequest, opts ...grpc.CallOption) (*querypb.GetLoadBalanceStatusResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.GetLoadBalanceStatusResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetLoadBalanceStatusRequest, ...grpc.CallOption) (*querypb.GetLoadBalanceStatusResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetLoadBalanceStatusRequest, ...grpc.CallOption) *querypb.GetLoadBalanceStatusResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetLoadBalanceStatusResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetLoadBalanceStatusRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetLoadBalanceStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoadBalanceStatus'
+type MockQueryCoordClient_GetLoadBalanceStatus_Call struct {
+	*mock.Call
+}
+
+// GetLoadBalanceStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.GetLoadBalanceStatusRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetLoadBalanceStatus(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetLoadBalanceStatus_Call {
+	return &MockQueryCoordClient_GetLoadBalanceStatus_Call{Call: _e.mock.On("GetLoadBalanceStatus",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetLoadBalanceStatus_Call) Run(run func(ctx context.Context, in *querypb.GetLoadBalanceStatusRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetLoadBalanceStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.GetLoadBalanceStatusRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetLoadBalanceStatus_Call) Return(_a0 *querypb.GetLoadBalanceStatusResponse, _a1 error) *MockQueryCoordClient_GetLoadBalanceStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetLoadBalanceStatus_Call) RunAndReturn(run func(context.Context, *querypb.GetLoadBalanceStatusRequest, ...grpc.CallOption) (*querypb.GetLoadBalanceStatusResponse, error)) *MockQueryCoordClient_GetLoadBalanceStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoadInfo provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetLoadInfo(ctx context.Context, in *querypb.GetLoadInfoRequest, opts ...grpc.CallOption) (*querypb.GetLoadInfoResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.GetLoadInfoResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetLoadInfoRequest, ...grpc.CallOption) (*querypb.GetLoadInfoResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetLoadInfoRequest, ...grpc.CallOption) *querypb.GetLoadInfoResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetLoadInfoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetLoadInfoRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetLoadInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoadInfo'
+type MockQueryCoordClient_GetLoadInfo_Call struct {
+	*mock.Call
+}
+
+// GetLoadInfo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.GetLoadInfoRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetLoadInfo(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetLoadInfo_Call {
+	return &MockQueryCoordClient_GetLoadInfo_Call{Call: _e.mock.On("GetLoadInfo",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetLoadInfo_Call) Run(run func(ctx context.Context, in *querypb.GetLoadInfoRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetLoadInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.GetLoadInfoRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetLoadInfo_Call) Return(_a0 *querypb.GetLoadInfoResponse, _a1 error) *MockQueryCoordClient_GetLoadInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetLoadInfo_Call) RunAndReturn(run func(context.Context, *querypb.GetLoadInfoRequest, ...grpc.CallOption) (*querypb.GetLoadInfoResponse, error)) *MockQueryCoordClient_GetLoadInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoadState provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetLoadState(ctx context.Context, in *querypb.GetLoadStateRequest, opts ...grpc.CallOption) (*querypb.GetLoadStateResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.GetLoadStateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetLoadStateRequest, ...grpc.CallOption) (*querypb.GetLoadStateResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetLoadStateRequest, ...grpc.CallOption) *querypb.GetLoadStateResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetLoadStateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetLoadStateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetLoadState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoadState'
+type MockQueryCoordClient_GetLoadState_Call struct {
+	*mock.Call
+}
+
+// GetLoadState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.GetLoadStateRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetLoadState(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetLoadState_Call {
+	return &MockQueryCoordClient_GetLoadState_Call{Call: _e.mock.On("GetLoadState",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetLoadState_Call) Run(run func(ctx context.Context, in *querypb.GetLoadStateRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetLoadState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.GetLoadStateRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetLoadState_Call) Return(_a0 *querypb.GetLoadStateResponse, _a1 error) *MockQueryCoordClient_GetLoadState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetLoadState_Call) RunAndReturn(run func(context.Context, *querypb.GetLoadStateRequest, ...grpc.CallOption) (*querypb.GetLoadStateResponse, error)) *MockQueryCoordClient_GetLoadState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMetrics provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *milvuspb.GetMetricsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) *milvuspb.GetMetricsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.GetMetricsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMetrics'
+type MockQueryCoordClient_GetMetrics_Call struct {
+	*mock.Call
+}
+
+// GetMetrics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *milvuspb.GetMetricsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetMetrics(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetMetrics_Call {
+	return &MockQueryCoordClient_GetMetrics_Call{Call: _e.mock.On("GetMetrics",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetMetrics_Call) Run(run func(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*milvuspb.GetMetricsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetMetrics_Call) Return(_a0 *milvuspb.GetMetricsResponse, _a1 error) *MockQueryCoordClient_GetMetrics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetMetrics_Call) RunAndReturn(run func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)) *MockQueryCoordClient_GetMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNodeLoadHistory provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetNodeLoadHistory(ctx context.Context, in *querypb.GetNodeLoadHistoryRequest, opts ...grpc.CallOption) (*querypb.GetNodeLoadHistoryResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.GetNodeLoadHistoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetNodeLoadHistoryRequest, ...grpc.CallOption) (*querypb.GetNodeLoadHistoryResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetNodeLoadHistoryRequest, ...grpc.CallOption) *querypb.GetNodeLoadHistoryResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetNodeLoadHistoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetNodeLoadHistoryRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetNodeLoadHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNodeLoadHistory'
+type MockQueryCoordClient_GetNodeLoadHistory_Call struct {
+	*mock.Call
+}
+
+// GetNodeLoadHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.GetNodeLoadHistoryRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetNodeLoadHistory(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetNodeLoadHistory_Call {
+	return &MockQueryCoordClient_GetNodeLoadHistory_Call{Call: _e.mock.On("GetNodeLoadHistory",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetNodeLoadHistory_Call) Run(run func(ctx context.Context, in *querypb.GetNodeLoadHistoryRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetNodeLoadHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.GetNodeLoadHistoryRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetNodeLoadHistory_Call) Return(_a0 *querypb.GetNodeLoadHistoryResponse, _a1 error) *MockQueryCoordClient_GetNodeLoadHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetNodeLoadHistory_Call) RunAndReturn(run func(context.Context, *querypb.GetNodeLoadHistoryRequest, ...grpc.CallOption) (*querypb.GetNodeLoadHistoryResponse, error)) *MockQueryCoordClient_GetNodeLoadHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPartitionStates provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetPartitionStates(ctx context.Context, in *querypb.GetPartitionStatesRequest, opts ...grpc.CallOption) (*querypb.GetPartitionStatesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.GetPartitionStatesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetPartitionStatesRequest, ...grpc.CallOption) (*querypb.GetPartitionStatesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetPartitionStatesRequest, ...grpc.CallOption) *querypb.GetPartitionStatesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetPartitionStatesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetPartitionStatesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetPartitionStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPartitionStates'
+type MockQueryCoordClient_GetPartitionStates_Call struct {
+	*mock.Call
+}
+
+// GetPartitionStates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.GetPartitionStatesRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetPartitionStates(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetPartitionStates_Call {
+	return &MockQueryCoordClient_GetPartitionStates_Call{Call: _e.mock.On("GetPartitionStates",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetPartitionStates_Call) Run(run func(ctx context.Context, in *querypb.GetPartitionStatesRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetPartitionStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.GetPartitionStatesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetPartitionStates_Call) Return(_a0 *querypb.GetPartitionStatesResponse, _a1 error) *MockQueryCoordClient_GetPartitionStates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetPartitionStates_Call) RunAndReturn(run func(context.Context, *querypb.GetPartitionStatesRequest, ...grpc.CallOption) (*querypb.GetPartitionStatesResponse, error)) *MockQueryCoordClient_GetPartitionStates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQueryNodeDistribution provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetQueryNodeDistribution(ctx context.Context, in *querypb.GetQueryNodeDistributionRequest, opts ...grpc.CallOption) (*querypb.GetQueryNodeDistributionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.GetQueryNodeDistributionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetQueryNodeDistributionRequest, ...grpc.CallOption) (*querypb.GetQueryNodeDistributionResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetQueryNodeDistributionRequest, ...grpc.CallOption) *querypb.GetQueryNodeDistributionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetQueryNodeDistributionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetQueryNodeDistributionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetQueryNodeDistribution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQueryNodeDistribution'
+type MockQueryCoordClient_GetQueryNodeDistribution_Call struct {
+	*mock.Call
+}
+
+// GetQueryNodeDistribution is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.GetQueryNodeDistributionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetQueryNodeDistribution(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetQueryNodeDistribution_Call {
+	return &MockQueryCoordClient_GetQueryNodeDistribution_Call{Call: _e.mock.On("GetQueryNodeDistribution",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetQueryNodeDistribution_Call) Run(run func(ctx context.Context, in *querypb.GetQueryNodeDistributionRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetQueryNodeDistribution_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.GetQueryNodeDistributionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetQueryNodeDistribution_Call) Return(_a0 *querypb.GetQueryNodeDistributionResponse, _a1 error) *MockQueryCoordClient_GetQueryNodeDistribution_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetQueryNodeDistribution_Call) RunAndReturn(run func(context.Context, *querypb.GetQueryNodeDistributionRequest, ...grpc.CallOption) (*querypb.GetQueryNodeDistributionResponse, error)) *MockQueryCoordClient_GetQueryNodeDistribution_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReleaseProgress provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetReleaseProgress(ctx context.Context, in *querypb.GetReleaseProgressRequest, opts ...grpc.CallOption) (*querypb.GetReleaseProgressResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.GetReleaseProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetReleaseProgressRequest, ...grpc.CallOption) (*querypb.GetReleaseProgressResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetReleaseProgressRequest, ...grpc.CallOption) *querypb.GetReleaseProgressResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetReleaseProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetReleaseProgressRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetReleaseProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReleaseProgress'
+type MockQueryCoordClient_GetReleaseProgress_Call struct {
+	*mock.Call
+}
+
+// GetReleaseProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.GetReleaseProgressRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetReleaseProgress(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetReleaseProgress_Call {
+	return &MockQueryCoordClient_GetReleaseProgress_Call{Call: _e.mock.On("GetReleaseProgress",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetReleaseProgress_Call) Run(run func(ctx context.Context, in *querypb.GetReleaseProgressRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetReleaseProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.GetReleaseProgressRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetReleaseProgress_Call) Return(_a0 *querypb.GetReleaseProgressResponse, _a1 error) *MockQueryCoordClient_GetReleaseProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetReleaseProgress_Call) RunAndReturn(run func(context.Context, *querypb.GetReleaseProgressRequest, ...grpc.CallOption) (*querypb.GetReleaseProgressResponse, error)) *MockQueryCoordClient_GetReleaseProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReplicas provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetReplicas(ctx context.Context, in *milvuspb.GetReplicasRequest, opts ...grpc.CallOption) (*milvuspb.GetReplicasResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *milvuspb.GetReplicasResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetReplicasRequest, ...grpc.CallOption) (*milvuspb.GetReplicasResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetReplicasRequest, ...grpc.CallOption) *milvuspb.GetReplicasResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.GetReplicasResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetReplicasRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetReplicas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReplicas'
+type MockQueryCoordClient_GetReplicas_Call struct {
+	*mock.Call
+}
+
+// GetReplicas is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *milvuspb.GetReplicasRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetReplicas(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetReplicas_Call {
+	return &MockQueryCoordClient_GetReplicas_Call{Call: _e.mock.On("GetReplicas",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetReplicas_Call) Run(run func(ctx context.Context, in *milvuspb.GetReplicasRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetReplicas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*milvuspb.GetReplicasRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetReplicas_Call) Return(_a0 *milvuspb.GetReplicasResponse, _a1 error) *MockQueryCoordClient_GetReplicas_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetReplicas_Call) RunAndReturn(run func(context.Context, *milvuspb.GetReplicasRequest, ...grpc.CallOption) (*milvuspb.GetReplicasResponse, error)) *MockQueryCoordClient_GetReplicas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetResourceGroupDrift provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetResourceGroupDrift(ctx context.Context, in *querypb.GetResourceGroupDriftRequest, opts ...grpc.CallOption) (*querypb.GetResourceGroupDriftResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.GetResourceGroupDriftResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetResourceGroupDriftRequest, ...grpc.CallOption) (*querypb.GetResourceGroupDriftResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetResourceGroupDriftRequest, ...grpc.CallOption) *querypb.GetResourceGroupDriftResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetResourceGroupDriftResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetResourceGroupDriftRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetResourceGroupDrift_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetResourceGroupDrift'
+type MockQueryCoordClient_GetResourceGroupDrift_Call struct {
+	*mock.Call
+}
+
+// GetResourceGroupDrift is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.GetResourceGroupDriftRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetResourceGroupDrift(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetResourceGroupDrift_Call {
+	return &MockQueryCoordClient_GetResourceGroupDrift_Call{Call: _e.mock.On("GetResourceGroupDrift",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetResourceGroupDrift_Call) Run(run func(ctx context.Context, in *querypb.GetResourceGroupDriftRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetResourceGroupDrift_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.GetResourceGroupDriftRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetResourceGroupDrift_Call) Return(_a0 *querypb.GetResourceGroupDriftResponse, _a1 error) *MockQueryCoordClient_GetResourceGroupDrift_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetResourceGroupDrift_Call) RunAndReturn(run func(context.Context, *querypb.GetResourceGroupDriftRequest, ...grpc.CallOption) (*querypb.GetResourceGroupDriftResponse, error)) *MockQueryCoordClient_GetResourceGroupDrift_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetResourceGroupUtilization provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetResourceGroupUtilization(ctx context.Context, in *querypb.GetResourceGroupUtilizationRequest, opts ...grpc.CallOption) (*querypb.GetResourceGroupUtilizationResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.GetResourceGroupUtilizationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetResourceGroupUtilizationRequest, ...grpc.CallOption) (*querypb.GetResourceGroupUtilizationResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetResourceGroupUtilizationRequest, ...grpc.CallOption) *querypb.GetResourceGroupUtilizationResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetResourceGroupUtilizationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetResourceGroupUtilizationRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetResourceGroupUtilization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetResourceGroupUtilization'
+type MockQueryCoordClient_GetResourceGroupUtilization_Call struct {
+	*mock.Call
+}
+
+// GetResourceGroupUtilization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.GetResourceGroupUtilizationRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetResourceGroupUtilization(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetResourceGroupUtilization_Call {
+	return &MockQueryCoordClient_GetResourceGroupUtilization_Call{Call: _e.mock.On("GetResourceGroupUtilization",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetResourceGroupUtilization_Call) Run(run func(ctx context.Context, in *querypb.GetResourceGroupUtilizationRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetResourceGroupUtilization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.GetResourceGroupUtilizationRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetResourceGroupUtilization_Call) Return(_a0 *querypb.GetResourceGroupUtilizationResponse, _a1 error) *MockQueryCoordClient_GetResourceGroupUtilization_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetResourceGroupUtilization_Call) RunAndReturn(run func(context.Context, *querypb.GetResourceGroupUtilizationRequest, ...grpc.CallOption) (*querypb.GetResourceGroupUtilizationResponse, error)) *MockQueryCoordClient_GetResourceGroupUtilization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSegmentInfo provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetSegmentInfo(ctx context.Context, in *querypb.GetSegmentInfoRequest, opts ...grpc.CallOption) (*querypb.GetSegmentInfoResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.GetSegmentInfoResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetSegmentInfoRequest, ...grpc.CallOption) (*querypb.GetSegmentInfoResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetSegmentInfoRequest, ...grpc.CallOption) *querypb.GetSegmentInfoResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetSegmentInfoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetSegmentInfoRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetSegmentInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentInfo'
+type MockQueryCoordClient_GetSegmentInfo_Call struct {
+	*mock.Call
+}
+
+// GetSegmentInfo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.GetSegmentInfoRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetSegmentInfo(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetSegmentInfo_Call {
+	return &MockQueryCoordClient_GetSegmentInfo_Call{Call: _e.mock.On("GetSegmentInfo",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetSegmentInfo_Call) Run(run func(ctx context.Context, in *querypb.GetSegmentInfoRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetSegmentInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.GetSegmentInfoRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetSegmentInfo_Call) Return(_a0 *querypb.GetSegmentInfoResponse, _a1 error) *MockQueryCoordClient_GetSegmentInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetSegmentInfo_Call) RunAndReturn(run func(context.Context, *querypb.GetSegmentInfoRequest, ...grpc.CallOption) (*querypb.GetSegmentInfoResponse, error)) *MockQueryCoordClient_GetSegmentInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetShardLeaders provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetShardLeaders(ctx context.Context, in *querypb.GetShardLeadersRequest, opts ...grpc.CallOption) (*querypb.GetShardLeadersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.GetShardLeadersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetShardLeadersRequest, ...grpc.CallOption) (*querypb.GetShardLeadersResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetShardLeadersRequest, ...grpc.CallOption) *querypb.GetShardLeadersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetShardLeadersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetShardLeadersRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetShardLeaders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetShardLeaders'
+type MockQueryCoordClient_GetShardLeaders_Call struct {
+	*mock.Call
+}
+
+// GetShardLeaders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.GetShardLeadersRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetShardLeaders(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetShardLeaders_Call {
+	return &MockQueryCoordClient_GetShardLeaders_Call{Call: _e.mock.On("GetShardLeaders",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetShardLeaders_Call) Run(run func(ctx context.Context, in *querypb.GetShardLeadersRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetShardLeaders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.GetShardLeadersRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetShardLeaders_Call) Return(_a0 *querypb.GetShardLeadersResponse, _a1 error) *MockQueryCoordClient_GetShardLeaders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetShardLeaders_Call) RunAndReturn(run func(context.Context, *querypb.GetShardLeadersRequest, ...grpc.CallOption) (*querypb.GetShardLeadersResponse, error)) *MockQueryCoordClient_GetShardLeaders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetShardLeadersBatch provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetShardLeadersBatch(ctx context.Context, in *querypb.GetShardLeadersBatchRequest, opts ...grpc.CallOption) (*querypb.GetShardLeadersBatchResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.GetShardLeadersBatchResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetShardLeadersBatchRequest, ...grpc.CallOption) (*querypb.GetShardLeadersBatchResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetShardLeadersBatchRequest, ...grpc.CallOption) *querypb.GetShardLeadersBatchResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetShardLeadersBatchResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetShardLeadersBatchRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetShardLeadersBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetShardLeadersBatch'
+type MockQueryCoordClient_GetShardLeadersBatch_Call struct {
+	*mock.Call
+}
+
+// GetShardLeadersBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.GetShardLeadersBatchRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetShardLeadersBatch(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetShardLeadersBatch_Call {
+	return &MockQueryCoordClient_GetShardLeadersBatch_Call{Call: _e.mock.On("GetShardLeadersBatch",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetShardLeadersBatch_Call) Run(run func(ctx context.Context, in *querypb.GetShardLeadersBatchRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetShardLeadersBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.GetShardLeadersBatchRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetShardLeadersBatch_Call) Return(_a0 *querypb.GetShardLeadersBatchResponse, _a1 error) *MockQueryCoordClient_GetShardLeadersBatch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetShardLeadersBatch_Call) RunAndReturn(run func(context.Context, *querypb.GetShardLeadersBatchRequest, ...grpc.CallOption) (*querypb.GetShardLeadersBatchResponse, error)) *MockQueryCoordClient_GetShardLeadersBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStatisticsChannel provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *milvuspb.StringResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) *milvuspb.StringResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.StringResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetStatisticsChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatisticsChannel'
+type MockQueryCoordClient_GetStatisticsChannel_Call struct {
+	*mock.Call
+}
+
+// GetStatisticsChannel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.GetStatisticsChannelRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetStatisticsChannel(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetStatisticsChannel_Call {
+	return &MockQueryCoordClient_GetStatisticsChannel_Call{Call: _e.mock.On("GetStatisticsChannel",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetStatisticsChannel_Call) Run(run func(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetStatisticsChannel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.GetStatisticsChannelRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetStatisticsChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockQueryCoordClient_GetStatisticsChannel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetStatisticsChannel_Call) RunAndReturn(run func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)) *MockQueryCoordClient_GetStatisticsChannel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTenantViolations provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetTenantViolations(ctx context.Context, in *querypb.GetTenantViolationsRequest, opts ...grpc.CallOption) (*querypb.GetTenantViolationsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.GetTenantViolationsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetTenantViolationsRequest, ...grpc.CallOption) (*querypb.GetTenantViolationsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetTenantViolationsRequest, ...grpc.CallOption) *querypb.GetTenantViolationsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetTenantViolationsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetTenantViolationsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetTenantViolations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTenantViolations'
+type MockQueryCoordClient_GetTenantViolations_Call struct {
+	*mock.Call
+}
+
+// GetTenantViolations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.GetTenantViolationsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetTenantViolations(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetTenantViolations_Call {
+	return &MockQueryCoordClient_GetTenantViolations_Call{Call: _e.mock.On("GetTenantViolations",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetTenantViolations_Call) Run(run func(ctx context.Context, in *querypb.GetTenantViolationsRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetTenantViolations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.GetTenantViolationsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetTenantViolations_Call) Return(_a0 *querypb.GetTenantViolationsResponse, _a1 error) *MockQueryCoordClient_GetTenantViolations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetTenantViolations_Call) RunAndReturn(run func(context.Context, *querypb.GetTenantViolationsRequest, ...grpc.CallOption) (*querypb.GetTenantViolationsResponse, error)) *MockQueryCoordClient_GetTenantViolations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTimeTickChannel provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *milvuspb.StringResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) *milvuspb.StringResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.StringResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetTimeTickChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTimeTickChannel'
+type MockQueryCoordClient_GetTimeTickChannel_Call struct {
+	*mock.Call
+}
+
+// GetTimeTickChannel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.GetTimeTickChannelRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetTimeTickChannel(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetTimeTickChannel_Call {
+	return &MockQueryCoordClient_GetTimeTickChannel_Call{Call: _e.mock.On("GetTimeTickChannel",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetTimeTickChannel_Call) Run(run func(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetTimeTickChannel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.GetTimeTickChannelRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetTimeTickChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockQueryCoordClient_GetTimeTickChannel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetTimeTickChannel_Call) RunAndReturn(run func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)) *MockQueryCoordClient_GetTimeTickChannel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTransferNodeStatus provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetTransferNodeStatus(ctx context.Context, in *querypb.GetTransferNodeStatusRequest, opts ...grpc.CallOption) (*querypb.GetTransferNodeStatusResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.GetTransferNodeStatusResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetTransferNodeStatusRequest, ...grpc.CallOption) (*querypb.GetTransferNodeStatusResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetTransferNodeStatusRequest, ...grpc.CallOption) *querypb.GetTransferNodeStatusResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetTransferNodeStatusResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetTransferNodeStatusRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetTransferNodeStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTransferNodeStatus'
+type MockQueryCoordClient_GetTransferNodeStatus_Call struct {
+	*mock.Call
+}
+
+// GetTransferNodeStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.GetTransferNodeStatusRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetTransferNodeStatus(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetTransferNodeStatus_Call {
+	return &MockQueryCoordClient_GetTransferNodeStatus_Call{Call: _e.mock.On("GetTransferNodeStatus",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetTransferNodeStatus_Call) Run(run func(ctx context.Context, in *querypb.GetTransferNodeStatusRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetTransferNodeStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.GetTransferNodeStatusRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetTransferNodeStatus_Call) Return(_a0 *querypb.GetTransferNodeStatusResponse, _a1 error) *MockQueryCoordClient_GetTransferNodeStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetTransferNodeStatus_Call) RunAndReturn(run func(context.Context, *querypb.GetTransferNodeStatusRequest, ...grpc.CallOption) (*querypb.GetTransferNodeStatusResponse, error)) *MockQueryCoordClient_GetTransferNodeStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCheckers provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) ListCheckers(ctx context.Context, in *querypb.ListCheckersRequest, opts ...grpc.CallOption) (*querypb.ListCheckersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.ListCheckersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ListCheckersRequest, ...grpc.CallOption) (*querypb.ListCheckersResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ListCheckersRequest, ...grpc.CallOption) *querypb.ListCheckersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.ListCheckersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ListCheckersRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_ListCheckers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCheckers'
+type MockQueryCoordClient_ListCheckers_Call struct {
+	*mock.Call
+}
+
+// ListCheckers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.ListCheckersRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) ListCheckers(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_ListCheckers_Call {
+	return &MockQueryCoordClient_ListCheckers_Call{Call: _e.mock.On("ListCheckers",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_ListCheckers_Call) Run(run func(ctx context.Context, in *querypb.ListCheckersRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_ListCheckers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.ListCheckersRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_ListCheckers_Call) Return(_a0 *querypb.ListCheckersResponse, _a1 error) *MockQueryCoordClient_ListCheckers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_ListCheckers_Call) RunAndReturn(run func(context.Context, *querypb.ListCheckersRequest, ...grpc.CallOption) (*querypb.ListCheckersResponse, error)) *MockQueryCoordClient_ListCheckers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListQueryNode provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) ListQueryNode(ctx context.Context, in *querypb.ListQueryNodeRequest, opts ...grpc.CallOption) (*querypb.ListQueryNodeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.ListQueryNodeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ListQueryNodeRequest, ...grpc.CallOption) (*querypb.ListQueryNodeResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ListQueryNodeRequest, ...grpc.CallOption) *querypb.ListQueryNodeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.ListQueryNodeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ListQueryNodeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_ListQueryNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListQueryNode'
+type MockQueryCoordClient_ListQueryNode_Call struct {
+	*mock.Call
+}
+
+// ListQueryNode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.ListQueryNodeRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) ListQueryNode(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_ListQueryNode_Call {
+	return &MockQueryCoordClient_ListQueryNode_Call{Call: _e.mock.On("ListQueryNode",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_ListQueryNode_Call) Run(run func(ctx context.Context, in *querypb.ListQueryNodeRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_ListQueryNode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.ListQueryNodeRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_ListQueryNode_Call) Return(_a0 *querypb.ListQueryNodeResponse, _a1 error) *MockQueryCoordClient_ListQueryNode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_ListQueryNode_Call) RunAndReturn(run func(context.Context, *querypb.ListQueryNodeRequest, ...grpc.CallOption) (*querypb.ListQueryNodeResponse, error)) *MockQueryCoordClient_ListQueryNode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReplicas provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) ListReplicas(ctx context.Context, in *querypb.ListReplicasRequest, opts ...grpc.CallOption) (*querypb.ListReplicasResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.ListReplicasResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ListReplicasRequest, ...grpc.CallOption) (*querypb.ListReplicasResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ListReplicasRequest, ...grpc.CallOption) *querypb.ListReplicasResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.ListReplicasResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ListReplicasRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_ListReplicas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReplicas'
+type MockQueryCoordClient_ListReplicas_Call struct {
+	*mock.Call
+}
+
+// ListReplicas is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.ListReplicasRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) ListReplicas(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_ListReplicas_Call {
+	return &MockQueryCoordClient_ListReplicas_Call{Call: _e.mock.On("ListReplicas",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_ListReplicas_Call) Run(run func(ctx context.Context, in *querypb.ListReplicasRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_ListReplicas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.ListReplicasRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_ListReplicas_Call) Return(_a0 *querypb.ListReplicasResponse, _a1 error) *MockQueryCoordClient_ListReplicas_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_ListReplicas_Call) RunAndReturn(run func(context.Context, *querypb.ListReplicasRequest, ...grpc.CallOption) (*querypb.ListReplicasResponse, error)) *MockQueryCoordClient_ListReplicas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListResourceGroups provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) ListResourceGroups(ctx context.Context, in *milvuspb.ListResourceGroupsRequest, opts ...grpc.CallOption) (*milvuspb.ListResourceGroupsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *milvuspb.ListResourceGroupsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListResourceGroupsRequest, ...grpc.CallOption) (*milvuspb.ListResourceGroupsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListResourceGroupsRequest, ...grpc.CallOption) *milvuspb.ListResourceGroupsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.ListResourceGroupsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.ListResourceGroupsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_ListResourceGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListResourceGroups'
+type MockQueryCoordClient_ListResourceGroups_Call struct {
+	*mock.Call
+}
+
+// ListResourceGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *milvuspb.ListResourceGroupsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) ListResourceGroups(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_ListResourceGroups_Call {
+	return &MockQueryCoordClient_ListResourceGroups_Call{Call: _e.mock.On("ListResourceGroups",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_ListResourceGroups_Call) Run(run func(ctx context.Context, in *milvuspb.ListResourceGroupsRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_ListResourceGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*milvuspb.ListResourceGroupsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_ListResourceGroups_Call) Return(_a0 *milvuspb.ListResourceGroupsResponse, _a1 error) *MockQueryCoordClient_ListResourceGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_ListResourceGroups_Call) RunAndReturn(run func(context.Context, *milvuspb.ListResourceGroupsRequest, ...grpc.CallOption) (*milvuspb.ListResourceGroupsResponse, error)) *MockQueryCoordClient_ListResourceGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoadBalance provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) LoadBalance(ctx context.Context, in *querypb.LoadBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.LoadBalanceRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.LoadBalanceRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.LoadBalanceRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_LoadBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadBalance'
+type MockQueryCoordClient_LoadBalance_Call struct {
+	*mock.Call
+}
+
+// LoadBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.LoadBalanceRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) LoadBalance(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_LoadBalance_Call {
+	return &MockQueryCoordClient_LoadBalance_Call{Call: _e.mock.On("LoadBalance",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_LoadBalance_Call) Run(run func(ctx context.Context, in *querypb.LoadBalanceRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_LoadBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.LoadBalanceRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_LoadBalance_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_LoadBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_LoadBalance_Call) RunAndReturn(run func(context.Context, *querypb.LoadBalanceRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_LoadBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoadCollection provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) LoadCollection(ctx context.Context, in *querypb.LoadCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) (*milvuspb.ComponentStates, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.LoadCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) *milvuspb.ComponentStates); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.LoadCollectionRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.ComponentStates)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.LoadCollectionRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -595,21 +3675,21 @@ func (_m *MockQueryCoordClient) GetComponentStates(ctx context.Context, in *milv
 	return r0, r1
 }
 
-// MockQueryCoordClient_GetComponentStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComponentStates'
-type MockQueryCoordClient_GetComponentStates_Call struct {
+// MockQueryCoordClient_LoadCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadCollection'
+type MockQueryCoordClient_LoadCollection_Call struct {
 	*mock.Call
 }
 
-// GetComponentStates is a helper method to define mock.On call
+// LoadCollection is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.GetComponentStatesRequest
+//   - in *querypb.LoadCollectionRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) GetComponentStates(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetComponentStates_Call {
-	return &MockQueryCoordClient_GetComponentStates_Call{Call: _e.mock.On("GetComponentStates",
+func (_e *MockQueryCoordClient_Expecter) LoadCollection(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_LoadCollection_Call {
+	return &MockQueryCoordClient_LoadCollection_Call{Call: _e.mock.On("LoadCollection",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_GetComponentStates_Call) Run(run func(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetComponentStates_Call {
+func (_c *MockQueryCoordClient_LoadCollection_Call) Run(run func(ctx context.Context, in *querypb.LoadCollectionRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_LoadCollection_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -617,23 +3697,23 @@ func (_c *MockQueryCoordClient_GetComponentStates_Call) Run(run func(ctx context
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.GetComponentStatesRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.LoadCollectionRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_GetComponentStates_Call) Return(_a0 *milvuspb.ComponentStates, _a1 error) *MockQueryCoordClient_GetComponentStates_Call {
+func (_c *MockQueryCoordClient_LoadCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_LoadCollection_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_GetComponentStates_Call) RunAndReturn(run func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) (*milvuspb.ComponentStates, error)) *MockQueryCoordClient_GetComponentStates_Call {
+func (_c *MockQueryCoordClient_LoadCollection_Call) RunAndReturn(run func(context.Context, *querypb.LoadCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_LoadCollection_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetMetrics provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
+// LoadPartitions provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) LoadPartitions(ctx context.Context, in *querypb.LoadPartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -643,20 +3723,20 @@ func (_m *MockQueryCoordClient) GetMetrics(ctx context.Context, in *milvuspb.Get
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.GetMetricsResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.LoadPartitionsRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) *milvuspb.GetMetricsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.LoadPartitionsRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.GetMetricsResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.LoadPartitionsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -665,21 +3745,21 @@ func (_m *MockQueryCoordClient) GetMetrics(ctx context.Context, in *milvuspb.Get
 	return r0, r1
 }
 
-// MockQueryCoordClient_GetMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMetrics'
-type MockQueryCoordClient_GetMetrics_Call struct {
+// MockQueryCoordClient_LoadPartitions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadPartitions'
+type MockQueryCoordClient_LoadPartitions_Call struct {
 	*mock.Call
 }
 
-// GetMetrics is a helper method to define mock.On call
+// LoadPartitions is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.GetMetricsRequest
+//   - in *querypb.LoadPartitionsRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) GetMetrics(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetMetrics_Call {
-	return &MockQueryCoordClient_GetMetrics_Call{Call: _e.mock.On("GetMetrics",
+func (_e *MockQueryCoordClient_Expecter) LoadPartitions(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_LoadPartitions_Call {
+	return &MockQueryCoordClient_LoadPartitions_Call{Call: _e.mock.On("LoadPartitions",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_GetMetrics_Call) Run(run func(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetMetrics_Call {
+func (_c *MockQueryCoordClient_LoadPartitions_Call) Run(run func(ctx context.Context, in *querypb.LoadPartitionsRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_LoadPartitions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -687,23 +3767,23 @@ func (_c *MockQueryCoordClient_GetMetrics_Call) Run(run func(ctx context.Context
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.GetMetricsRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.LoadPartitionsRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_GetMetrics_Call) Return(_a0 *milvuspb.GetMetricsResponse, _a1 error) *MockQueryCoordClient_GetMetrics_Call {
+func (_c *MockQueryCoordClient_LoadPartitions_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_LoadPartitions_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_GetMetrics_Call) RunAndReturn(run func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)) *MockQueryCoordClient_GetMetrics_Call {
+func (_c *MockQueryCoordClient_LoadPartitions_Call) RunAndReturn(run func(context.Context, *querypb.LoadPartitionsRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_LoadPartitions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPartitionStates provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) GetPartitionStates(ctx context.Context, in *querypb.GetPartitionStatesRequest, opts ...grpc.CallOption) (*querypb.GetPartitionStatesResponse, error) {
+// MoveCollectionToResourceGroup provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) MoveCollectionToResourceGroup(ctx context.Context, in *querypb.MoveCollectionToResourceGroupRequest, opts ...grpc.CallOption) (*querypb.MoveCollectionToResourceGroupResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -713,20 +3793,20 @@ func (_m *MockQueryCoordClient) GetPartitionStates(ctx context.Context, in *quer
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *querypb.GetPartitionStatesResponse
+	var r0 *querypb.MoveCollectionToResourceGroupResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetPartitionStatesRequest, ...grpc.CallOption) (*querypb.GetPartitionStatesResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.MoveCollectionToResourceGroupRequest, ...grpc.CallOption) (*querypb.MoveCollectionToResourceGroupResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetPartitionStatesRequest, ...grpc.CallOption) *querypb.GetPartitionStatesResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.MoveCollectionToResourceGroupRequest, ...grpc.CallOption) *querypb.MoveCollectionToResourceGroupResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*querypb.GetPartitionStatesResponse)
+			r0 = ret.Get(0).(*querypb.MoveCollectionToResourceGroupResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetPartitionStatesRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.MoveCollectionToResourceGroupRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -735,21 +3815,21 @@ func (_m *MockQueryCoordClient) GetPartitionStates(ctx context.Context, in *quer
 	return r0, r1
 }
 
-// MockQueryCoordClient_GetPartitionStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPartitionStates'
-type MockQueryCoordClient_GetPartitionStates_Call struct {
+// MockQueryCoordClient_MoveCollectionToResourceGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveCollectionToResourceGroup'
+type MockQueryCoordClient_MoveCollectionToResourceGroup_Call struct {
 	*mock.Call
 }
 
-// GetPartitionStates is a helper method to define mock.On call
+// MoveCollectionToResourceGroup is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *querypb.GetPartitionStatesRequest
+//   - in *querypb.MoveCollectionToResourceGroupRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) GetPartitionStates(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetPartitionStates_Call {
-	return &MockQueryCoordClient_GetPartitionStates_Call{Call: _e.mock.On("GetPartitionStates",
+func (_e *MockQueryCoordClient_Expecter) MoveCollectionToResourceGroup(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_MoveCollectionToResourceGroup_Call {
+	return &MockQueryCoordClient_MoveCollectionToResourceGroup_Call{Call: _e.mock.On("MoveCollectionToResourceGroup",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_GetPartitionStates_Call) Run(run func(ctx context.Context, in *querypb.GetPartitionStatesRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetPartitionStates_Call {
+func (_c *MockQueryCoordClient_MoveCollectionToResourceGroup_Call) Run(run func(ctx context.Context, in *querypb.MoveCollectionToResourceGroupRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_MoveCollectionToResourceGroup_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -757,23 +3837,23 @@ func (_c *MockQueryCoordClient_GetPartitionStates_Call) Run(run func(ctx context
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*querypb.GetPartitionStatesRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.MoveCollectionToResourceGroupRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_GetPartitionStates_Call) Return(_a0 *querypb.GetPartitionStatesResponse, _a1 error) *MockQueryCoordClient_GetPartitionStates_Call {
+func (_c *MockQueryCoordClient_MoveCollectionToResourceGroup_Call) Return(_a0 *querypb.MoveCollectionToResourceGroupResponse, _a1 error) *MockQueryCoordClient_MoveCollectionToResourceGroup_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_GetPartitionStates_Call) RunAndReturn(run func(context.Context, *querypb.GetPartitionStatesRequest, ...grpc.CallOption) (*querypb.GetPartitionStatesResponse, error)) *MockQueryCoordClient_GetPartitionStates_Call {
+func (_c *MockQueryCoordClient_MoveCollectionToResourceGroup_Call) RunAndReturn(run func(context.Context, *querypb.MoveCollectionToResourceGroupRequest, ...grpc.CallOption) (*querypb.MoveCollectionToResourceGroupResponse, error)) *MockQueryCoordClient_MoveCollectionToResourceGroup_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetQueryNodeDistribution provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) GetQueryNodeDistribution(ctx context.Context, in *querypb.GetQueryNodeDistributionRequest, opts ...grpc.CallOption) (*querypb.GetQueryNodeDistributionResponse, error) {
+// PauseTargetUpdates provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) PauseTargetUpdates(ctx context.Context, in *querypb.PauseTargetUpdatesRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -783,20 +3863,20 @@ func (_m *MockQueryCoordClient) GetQueryNodeDistribution(ctx context.Context, in
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *querypb.GetQueryNodeDistributionResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetQueryNodeDistributionRequest, ...grpc.CallOption) (*querypb.GetQueryNodeDistributionResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.PauseTargetUpdatesRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetQueryNodeDistributionRequest, ...grpc.CallOption) *querypb.GetQueryNodeDistributionResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.PauseTargetUpdatesRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*querypb.GetQueryNodeDistributionResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetQueryNodeDistributionRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.PauseTargetUpdatesRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -805,21 +3885,21 @@ func (_m *MockQueryCoordClient) GetQueryNodeDistribution(ctx context.Context, in
 	return r0, r1
 }
 
-// MockQueryCoordClient_GetQueryNodeDistribution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQueryNodeDistribution'
-type MockQueryCoordClient_GetQueryNodeDistribution_Call struct {
+// MockQueryCoordClient_PauseTargetUpdates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseTargetUpdates'
+type MockQueryCoordClient_PauseTargetUpdates_Call struct {
 	*mock.Call
 }
 
-// GetQueryNodeDistribution is a helper method to define mock.On call
+// PauseTargetUpdates is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *querypb.GetQueryNodeDistributionRequest
+//   - in *querypb.PauseTargetUpdatesRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) GetQueryNodeDistribution(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetQueryNodeDistribution_Call {
-	return &MockQueryCoordClient_GetQueryNodeDistribution_Call{Call: _e.mock.On("GetQueryNodeDistribution",
+func (_e *MockQueryCoordClient_Expecter) PauseTargetUpdates(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_PauseTargetUpdates_Call {
+	return &MockQueryCoordClient_PauseTargetUpdates_Call{Call: _e.mock.On("PauseTargetUpdates",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_GetQueryNodeDistribution_Call) Run(run func(ctx context.Context, in *querypb.GetQueryNodeDistributionRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetQueryNodeDistribution_Call {
+func (_c *MockQueryCoordClient_PauseTargetUpdates_Call) Run(run func(ctx context.Context, in *querypb.PauseTargetUpdatesRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_PauseTargetUpdates_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -827,23 +3907,23 @@ func (_c *MockQueryCoordClient_GetQueryNodeDistribution_Call) Run(run func(ctx c
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*querypb.GetQueryNodeDistributionRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.PauseTargetUpdatesRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_GetQueryNodeDistribution_Call) Return(_a0 *querypb.GetQueryNodeDistributionResponse, _a1 error) *MockQueryCoordClient_GetQueryNodeDistribution_Call {
+func (_c *MockQueryCoordClient_PauseTargetUpdates_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_PauseTargetUpdates_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_GetQueryNodeDistribution_Call) RunAndReturn(run func(context.Context, *querypb.GetQueryNodeDistributionRequest, ...grpc.CallOption) (*querypb.GetQueryNodeDistributionResponse, error)) *MockQueryCoordClient_GetQueryNodeDistribution_Call {
+func (_c *MockQueryCoordClient_PauseTargetUpdates_Call) RunAndReturn(run func(context.Context, *querypb.PauseTargetUpdatesRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_PauseTargetUpdates_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetReplicas provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) GetReplicas(ctx context.Context, in *milvuspb.GetReplicasRequest, opts ...grpc.CallOption) (*milvuspb.GetReplicasResponse, error) {
+// RebalanceCollection provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) RebalanceCollection(ctx context.Context, in *querypb.RebalanceCollectionRequest, opts ...grpc.CallOption) (*querypb.RebalanceCollectionResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -853,20 +3933,20 @@ func (_m *MockQueryCoordClient) GetReplicas(ctx context.Context, in *milvuspb.Ge
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.GetReplicasResponse
+	var r0 *querypb.RebalanceCollectionResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetReplicasRequest, ...grpc.CallOption) (*milvuspb.GetReplicasResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.RebalanceCollectionRequest, ...grpc.CallOption) (*querypb.RebalanceCollectionResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetReplicasRequest, ...grpc.CallOption) *milvuspb.GetReplicasResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.RebalanceCollectionRequest, ...grpc.CallOption) *querypb.RebalanceCollectionResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.GetReplicasResponse)
+			r0 = ret.Get(0).(*querypb.RebalanceCollectionResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetReplicasRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.RebalanceCollectionRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -875,21 +3955,21 @@ func (_m *MockQueryCoordClient) GetReplicas(ctx context.Context, in *milvuspb.Ge
 	return r0, r1
 }
 
-// MockQueryCoordClient_GetReplicas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReplicas'
-type MockQueryCoordClient_GetReplicas_Call struct {
+// MockQueryCoordClient_RebalanceCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RebalanceCollection'
+type MockQueryCoordClient_RebalanceCollection_Call struct {
 	*mock.Call
 }
 
-// GetReplicas is a helper method to define mock.On call
+// RebalanceCollection is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.GetReplicasRequest
+//   - in *querypb.RebalanceCollectionRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) GetReplicas(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetReplicas_Call {
-	return &MockQueryCoordClient_GetReplicas_Call{Call: _e.mock.On("GetReplicas",
+func (_e *MockQueryCoordClient_Expecter) RebalanceCollection(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_RebalanceCollection_Call {
+	return &MockQueryCoordClient_RebalanceCollection_Call{Call: _e.mock.On("RebalanceCollection",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_GetReplicas_Call) Run(run func(ctx context.Context, in *milvuspb.GetReplicasRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetReplicas_Call {
+func (_c *MockQueryCoordClient_RebalanceCollection_Call) Run(run func(ctx context.Context, in *querypb.RebalanceCollectionRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_RebalanceCollection_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -897,23 +3977,23 @@ func (_c *MockQueryCoordClient_GetReplicas_Call) Run(run func(ctx context.Contex
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.GetReplicasRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.RebalanceCollectionRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_GetReplicas_Call) Return(_a0 *milvuspb.GetReplicasResponse, _a1 error) *MockQueryCoordClient_GetReplicas_Call {
+func (_c *MockQueryCoordClient_RebalanceCollection_Call) Return(_a0 *querypb.RebalanceCollectionResponse, _a1 error) *MockQueryCoordClient_RebalanceCollection_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_GetReplicas_Call) RunAndReturn(run func(context.Context, *milvuspb.GetReplicasRequest, ...grpc.CallOption) (*milvuspb.GetReplicasResponse, error)) *MockQueryCoordClient_GetReplicas_Call {
+func (_c *MockQueryCoordClient_RebalanceCollection_Call) RunAndReturn(run func(context.Context, *querypb.RebalanceCollectionRequest, ...grpc.CallOption) (*querypb.RebalanceCollectionResponse, error)) *MockQueryCoordClient_RebalanceCollection_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSegmentInfo provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) GetSegmentInfo(ctx context.Context, in *querypb.GetSegmentInfoRequest, opts ...grpc.CallOption) (*querypb.GetSegmentInfoResponse, error) {
+// RecommendReplicaCount provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) RecommendReplicaCount(ctx context.Context, in *querypb.RecommendReplicaCountRequest, opts ...grpc.CallOption) (*querypb.RecommendReplicaCountResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -923,20 +4003,20 @@ func (_m *MockQueryCoordClient) GetSegmentInfo(ctx context.Context, in *querypb.
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *querypb.GetSegmentInfoResponse
+	var r0 *querypb.RecommendReplicaCountResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetSegmentInfoRequest, ...grpc.CallOption) (*querypb.GetSegmentInfoResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.RecommendReplicaCountRequest, ...grpc.CallOption) (*querypb.RecommendReplicaCountResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetSegmentInfoRequest, ...grpc.CallOption) *querypb.GetSegmentInfoResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.RecommendReplicaCountRequest, ...grpc.CallOption) *querypb.RecommendReplicaCountResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*querypb.GetSegmentInfoResponse)
+			r0 = ret.Get(0).(*querypb.RecommendReplicaCountResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetSegmentInfoRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.RecommendReplicaCountRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -945,21 +4025,21 @@ func (_m *MockQueryCoordClient) GetSegmentInfo(ctx context.Context, in *querypb.
 	return r0, r1
 }
 
-// MockQueryCoordClient_GetSegmentInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentInfo'
-type MockQueryCoordClient_GetSegmentInfo_Call struct {
+// MockQueryCoordClient_RecommendReplicaCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecommendReplicaCount'
+type MockQueryCoordClient_RecommendReplicaCount_Call struct {
 	*mock.Call
 }
 
-// GetSegmentInfo is a helper method to define mock.On call
+// RecommendReplicaCount is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *querypb.GetSegmentInfoRequest
+//   - in *querypb.RecommendReplicaCountRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) GetSegmentInfo(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetSegmentInfo_Call {
-	return &MockQueryCoordClient_GetSegmentInfo_Call{Call: _e.mock.On("GetSegmentInfo",
+func (_e *MockQueryCoordClient_Expecter) RecommendReplicaCount(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_RecommendReplicaCount_Call {
+	return &MockQueryCoordClient_RecommendReplicaCount_Call{Call: _e.mock.On("RecommendReplicaCount",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_GetSegmentInfo_Call) Run(run func(ctx context.Context, in *querypb.GetSegmentInfoRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetSegmentInfo_Call {
+func (_c *MockQueryCoordClient_RecommendReplicaCount_Call) Run(run func(ctx context.Context, in *querypb.RecommendReplicaCountRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_RecommendReplicaCount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -967,23 +4047,23 @@ func (_c *MockQueryCoordClient_GetSegmentInfo_Call) Run(run func(ctx context.Con
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*querypb.GetSegmentInfoRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.RecommendReplicaCountRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_GetSegmentInfo_Call) Return(_a0 *querypb.GetSegmentInfoResponse, _a1 error) *MockQueryCoordClient_GetSegmentInfo_Call {
+func (_c *MockQueryCoordClient_RecommendReplicaCount_Call) Return(_a0 *querypb.RecommendReplicaCountResponse, _a1 error) *MockQueryCoordClient_RecommendReplicaCount_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_GetSegmentInfo_Call) RunAndReturn(run func(context.Context, *querypb.GetSegmentInfoRequest, ...grpc.CallOption) (*querypb.GetSegmentInfoResponse, error)) *MockQueryCoordClient_GetSegmentInfo_Call {
+func (_c *MockQueryCoordClient_RecommendReplicaCount_Call) RunAndReturn(run func(context.Context, *querypb.RecommendReplicaCountRequest, ...grpc.CallOption) (*querypb.RecommendReplicaCountResponse, error)) *MockQueryCoordClient_RecommendReplicaCount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetShardLeaders provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) GetShardLeaders(ctx context.Context, in *querypb.GetShardLeadersRequest, opts ...grpc.CallOption) (*querypb.GetShardLeadersResponse, error) {
+// ReleaseCollection provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) ReleaseCollection(ctx context.Context, in *querypb.ReleaseCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -993,20 +4073,20 @@ func (_m *MockQueryCoordClient) GetShardLeaders(ctx context.Context, in *querypb
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *querypb.GetShardLeadersResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetShardLeadersRequest, ...grpc.CallOption) (*querypb.GetShardLeadersResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ReleaseCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetShardLeadersRequest, ...grpc.CallOption) *querypb.GetShardLeadersResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ReleaseCollectionRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*querypb.GetShardLeadersResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetShardLeadersRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ReleaseCollectionRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1015,21 +4095,21 @@ func (_m *MockQueryCoordClient) GetShardLeaders(ctx context.Context, in *querypb
 	return r0, r1
 }
 
-// MockQueryCoordClient_GetShardLeaders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetShardLeaders'
-type MockQueryCoordClient_GetShardLeaders_Call struct {
+// MockQueryCoordClient_ReleaseCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseCollection'
+type MockQueryCoordClient_ReleaseCollection_Call struct {
 	*mock.Call
 }
 
-// GetShardLeaders is a helper method to define mock.On call
+// ReleaseCollection is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *querypb.GetShardLeadersRequest
+//   - in *querypb.ReleaseCollectionRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) GetShardLeaders(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetShardLeaders_Call {
-	return &MockQueryCoordClient_GetShardLeaders_Call{Call: _e.mock.On("GetShardLeaders",
+func (_e *MockQueryCoordClient_Expecter) ReleaseCollection(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_ReleaseCollection_Call {
+	return &MockQueryCoordClient_ReleaseCollection_Call{Call: _e.mock.On("ReleaseCollection",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_GetShardLeaders_Call) Run(run func(ctx context.Context, in *querypb.GetShardLeadersRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetShardLeaders_Call {
+func (_c *MockQueryCoordClient_ReleaseCollection_Call) Run(run func(ctx context.Context, in *querypb.ReleaseCollectionRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_ReleaseCollection_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1037,23 +4117,23 @@ func (_c *MockQueryCoordClient_GetShardLeaders_Call) Run(run func(ctx context.Co
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*querypb.GetShardLeadersRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.ReleaseCollectionRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_GetShardLeaders_Call) Return(_a0 *querypb.GetShardLeadersResponse, _a1 error) *MockQueryCoordClient_GetShardLeaders_Call {
+func (_c *MockQueryCoordClient_ReleaseCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_ReleaseCollection_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_GetShardLeaders_Call) RunAndReturn(run func(context.Context, *querypb.GetShardLeadersRequest, ...grpc.CallOption) (*querypb.GetShardLeadersResponse, error)) *MockQueryCoordClient_GetShardLeaders_Call {
+func (_c *MockQueryCoordClient_ReleaseCollection_Call) RunAndReturn(run func(context.Context, *querypb.ReleaseCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_ReleaseCollection_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetStatisticsChannel provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+// ReleasePartitions provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) ReleasePartitions(ctx context.Context, in *querypb.ReleasePartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1063,20 +4143,20 @@ func (_m *MockQueryCoordClient) GetStatisticsChannel(ctx context.Context, in *in
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.StringResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ReleasePartitionsRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) *milvuspb.StringResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ReleasePartitionsRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.StringResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ReleasePartitionsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1085,21 +4165,21 @@ func (_m *MockQueryCoordClient) GetStatisticsChannel(ctx context.Context, in *in
 	return r0, r1
 }
 
-// MockQueryCoordClient_GetStatisticsChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatisticsChannel'
-type MockQueryCoordClient_GetStatisticsChannel_Call struct {
+// MockQueryCoordClient_ReleasePartitions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleasePartitions'
+type MockQueryCoordClient_ReleasePartitions_Call struct {
 	*mock.Call
 }
 
-// GetStatisticsChannel is a helper method to define mock.On call
+// ReleasePartitions is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *internalpb.GetStatisticsChannelRequest
+//   - in *querypb.ReleasePartitionsRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) GetStatisticsChannel(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetStatisticsChannel_Call {
-	return &MockQueryCoordClient_GetStatisticsChannel_Call{Call: _e.mock.On("GetStatisticsChannel",
+func (_e *MockQueryCoordClient_Expecter) ReleasePartitions(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_ReleasePartitions_Call {
+	return &MockQueryCoordClient_ReleasePartitions_Call{Call: _e.mock.On("ReleasePartitions",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_GetStatisticsChannel_Call) Run(run func(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetStatisticsChannel_Call {
+func (_c *MockQueryCoordClient_ReleasePartitions_Call) Run(run func(ctx context.Context, in *querypb.ReleasePartitionsRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_ReleasePartitions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1107,23 +4187,23 @@ func (_c *MockQueryCoordClient_GetStatisticsChannel_Call) Run(run func(ctx conte
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*internalpb.GetStatisticsChannelRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.ReleasePartitionsRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_GetStatisticsChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockQueryCoordClient_GetStatisticsChannel_Call {
+func (_c *MockQueryCoordClient_ReleasePartitions_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_ReleasePartitions_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_GetStatisticsChannel_Call) RunAndReturn(run func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)) *MockQueryCoordClient_GetStatisticsChannel_Call {
+func (_c *MockQueryCoordClient_ReleasePartitions_Call) RunAndReturn(run func(context.Context, *querypb.ReleasePartitionsRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_ReleasePartitions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTimeTickChannel provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+// ReleaseSegments provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) ReleaseSegments(ctx context.Context, in *querypb.ReleaseSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1133,20 +4213,20 @@ func (_m *MockQueryCoordClient) GetTimeTickChannel(ctx context.Context, in *inte
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.StringResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ReleaseSegmentsRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) *milvuspb.StringResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ReleaseSegmentsRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.StringResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ReleaseSegmentsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1155,21 +4235,21 @@ func (_m *MockQueryCoordClient) GetTimeTickChannel(ctx context.Context, in *inte
 	return r0, r1
 }
 
-// MockQueryCoordClient_GetTimeTickChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTimeTickChannel'
-type MockQueryCoordClient_GetTimeTickChannel_Call struct {
+// MockQueryCoordClient_ReleaseSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseSegments'
+type MockQueryCoordClient_ReleaseSegments_Call struct {
 	*mock.Call
 }
 
-// GetTimeTickChannel is a helper method to define mock.On call
+// ReleaseSegments is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *internalpb.GetTimeTickChannelRequest
+//   - in *querypb.ReleaseSegmentsRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) GetTimeTickChannel(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetTimeTickChannel_Call {
-	return &MockQueryCoordClient_GetTimeTickChannel_Call{Call: _e.mock.On("GetTimeTickChannel",
+func (_e *MockQueryCoordClient_Expecter) ReleaseSegments(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_ReleaseSegments_Call {
+	return &MockQueryCoordClient_ReleaseSegments_Call{Call: _e.mock.On("ReleaseSegments",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_GetTimeTickChannel_Call) Run(run func(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetTimeTickChannel_Call {
+func (_c *MockQueryCoordClient_ReleaseSegments_Call) Run(run func(ctx context.Context, in *querypb.ReleaseSegmentsRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_ReleaseSegments_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1177,23 +4257,23 @@ func (_c *MockQueryCoordClient_GetTimeTickChannel_Call) Run(run func(ctx context
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*internalpb.GetTimeTickChannelRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.ReleaseSegmentsRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_GetTimeTickChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockQueryCoordClient_GetTimeTickChannel_Call {
+func (_c *MockQueryCoordClient_ReleaseSegments_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_ReleaseSegments_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_GetTimeTickChannel_Call) RunAndReturn(run func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)) *MockQueryCoordClient_GetTimeTickChannel_Call {
+func (_c *MockQueryCoordClient_ReleaseSegments_Call) RunAndReturn(run func(context.Context, *querypb.ReleaseSegmentsRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_ReleaseSegments_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListCheckers provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) ListCheckers(ctx context.Context, in *querypb.ListCheckersRequest, opts ...grpc.CallOption) (*querypb.ListCheckersResponse, error) {
+// ResumeBalance provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) ResumeBalance(ctx context.Context, in *querypb.ResumeBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1203,20 +4283,20 @@ func (_m *MockQueryCoordClient) ListCheckers(ctx context.Context, in *querypb.Li
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *querypb.ListCheckersResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ListCheckersRequest, ...grpc.CallOption) (*querypb.ListCheckersResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ResumeBalanceRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ListCheckersRequest, ...grpc.CallOption) *querypb.ListCheckersResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ResumeBalanceRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*querypb.ListCheckersResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ListCheckersRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ResumeBalanceRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1225,21 +4305,21 @@ func (_m *MockQueryCoordClient) ListCheckers(ctx context.Context, in *querypb.Li
 	return r0, r1
 }
 
-// MockQueryCoordClient_ListCheckers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCheckers'
-type MockQueryCoordClient_ListCheckers_Call struct {
+// MockQueryCoordClient_ResumeBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeBalance'
+type MockQueryCoordClient_ResumeBalance_Call struct {
 	*mock.Call
 }
 
-// ListCheckers is a helper method to define mock.On call
+// ResumeBalance is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *querypb.ListCheckersRequest
+//   - in *querypb.ResumeBalanceRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) ListCheckers(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_ListCheckers_Call {
-	return &MockQueryCoordClient_ListCheckers_Call{Call: _e.mock.On("ListCheckers",
+func (_e *MockQueryCoordClient_Expecter) ResumeBalance(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_ResumeBalance_Call {
+	return &MockQueryCoordClient_ResumeBalance_Call{Call: _e.mock.On("ResumeBalance",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_ListCheckers_Call) Run(run func(ctx context.Context, in *querypb.ListCheckersRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_ListCheckers_Call {
+func (_c *MockQueryCoordClient_ResumeBalance_Call) Run(run func(ctx context.Context, in *querypb.ResumeBalanceRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_ResumeBalance_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1247,23 +4327,23 @@ func (_c *MockQueryCoordClient_ListCheckers_Call) Run(run func(ctx context.Conte
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*querypb.ListCheckersRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.ResumeBalanceRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_ListCheckers_Call) Return(_a0 *querypb.ListCheckersResponse, _a1 error) *MockQueryCoordClient_ListCheckers_Call {
+func (_c *MockQueryCoordClient_ResumeBalance_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_ResumeBalance_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_ListCheckers_Call) RunAndReturn(run func(context.Context, *querypb.ListCheckersRequest, ...grpc.CallOption) (*querypb.ListCheckersResponse, error)) *MockQueryCoordClient_ListCheckers_Call {
+func (_c *MockQueryCoordClient_ResumeBalance_Call) RunAndReturn(run func(context.Context, *querypb.ResumeBalanceRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_ResumeBalance_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListQueryNode provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) ListQueryNode(ctx context.Context, in *querypb.ListQueryNodeRequest, opts ...grpc.CallOption) (*querypb.ListQueryNodeResponse, error) {
+// ResumeNode provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) ResumeNode(ctx context.Context, in *querypb.ResumeNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1273,20 +4353,20 @@ func (_m *MockQueryCoordClient) ListQueryNode(ctx context.Context, in *querypb.L
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *querypb.ListQueryNodeResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ListQueryNodeRequest, ...grpc.CallOption) (*querypb.ListQueryNodeResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ResumeNodeRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ListQueryNodeRequest, ...grpc.CallOption) *querypb.ListQueryNodeResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ResumeNodeRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*querypb.ListQueryNodeResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ListQueryNodeRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ResumeNodeRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1295,21 +4375,21 @@ func (_m *MockQueryCoordClient) ListQueryNode(ctx context.Context, in *querypb.L
 	return r0, r1
 }
 
-// MockQueryCoordClient_ListQueryNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListQueryNode'
-type MockQueryCoordClient_ListQueryNode_Call struct {
+// MockQueryCoordClient_ResumeNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeNode'
+type MockQueryCoordClient_ResumeNode_Call struct {
 	*mock.Call
 }
 
-// ListQueryNode is a helper method to define mock.On call
+// ResumeNode is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *querypb.ListQueryNodeRequest
+//   - in *querypb.ResumeNodeRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) ListQueryNode(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_ListQueryNode_Call {
-	return &MockQueryCoordClient_ListQueryNode_Call{Call: _e.mock.On("ListQueryNode",
+func (_e *MockQueryCoordClient_Expecter) ResumeNode(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_ResumeNode_Call {
+	return &MockQueryCoordClient_ResumeNode_Call{Call: _e.mock.On("ResumeNode",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_ListQueryNode_Call) Run(run func(ctx context.Context, in *querypb.ListQueryNodeRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_ListQueryNode_Call {
+func (_c *MockQueryCoordClient_ResumeNode_Call) Run(run func(ctx context.Context, in *querypb.ResumeNodeRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_ResumeNode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1317,23 +4397,23 @@ func (_c *MockQueryCoordClient_ListQueryNode_Call) Run(run func(ctx context.Cont
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*querypb.ListQueryNodeRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.ResumeNodeRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_ListQueryNode_Call) Return(_a0 *querypb.ListQueryNodeResponse, _a1 error) *MockQueryCoordClient_ListQueryNode_Call {
+func (_c *MockQueryCoordClient_ResumeNode_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_ResumeNode_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_ListQueryNode_Call) RunAndReturn(run func(context.Context, *querypb.ListQueryNodeRequest, ...grpc.CallOption) (*querypb.ListQueryNodeResponse, error)) *MockQueryCoordClient_ListQueryNode_Call {
+func (_c *MockQueryCoordClient_ResumeNode_Call) RunAndReturn(run func(context.Context, *querypb.ResumeNodeRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_ResumeNode_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListResourceGroups provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) ListResourceGroups(ctx context.Context, in *milvuspb.ListResourceGroupsRequest, opts ...grpc.CallOption) (*milvuspb.ListResourceGroupsResponse, error) {
+// ResumeTargetUpdates provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) ResumeTargetUpdates(ctx context.Context, in *querypb.ResumeTargetUpdatesRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1343,20 +4423,20 @@ func (_m *MockQueryCoordClient) ListResourceGroups(ctx context.Context, in *milv
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.ListResourceGroupsResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListResourceGroupsRequest, ...grpc.CallOption) (*milvuspb.ListResourceGroupsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ResumeTargetUpdatesRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListResourceGroupsRequest, ...grpc.CallOption) *milvuspb.ListResourceGroupsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ResumeTargetUpdatesRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.ListResourceGroupsResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.ListResourceGroupsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ResumeTargetUpdatesRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1365,21 +4445,21 @@ func (_m *MockQueryCoordClient) ListResourceGroups(ctx context.Context, in *milv
 	return r0, r1
 }
 
-// MockQueryCoordClient_ListResourceGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListResourceGroups'
-type MockQueryCoordClient_ListResourceGroups_Call struct {
+// MockQueryCoordClient_ResumeTargetUpdates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeTargetUpdates'
+type MockQueryCoordClient_ResumeTargetUpdates_Call struct {
 	*mock.Call
 }
 
-// ListResourceGroups is a helper method to define mock.On call
+// ResumeTargetUpdates is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.ListResourceGroupsRequest
+//   - in *querypb.ResumeTargetUpdatesRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) ListResourceGroups(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_ListResourceGroups_Call {
-	return &MockQueryCoordClient_ListResourceGroups_Call{Call: _e.mock.On("ListResourceGroups",
+func (_e *MockQueryCoordClient_Expecter) ResumeTargetUpdates(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_ResumeTargetUpdates_Call {
+	return &MockQueryCoordClient_ResumeTargetUpdates_Call{Call: _e.mock.On("ResumeTargetUpdates",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_ListResourceGroups_Call) Run(run func(ctx context.Context, in *milvuspb.ListResourceGroupsRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_ListResourceGroups_Call {
+func (_c *MockQueryCoordClient_ResumeTargetUpdates_Call) Run(run func(ctx context.Context, in *querypb.ResumeTargetUpdatesRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_ResumeTargetUpdates_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1387,23 +4467,23 @@ func (_c *MockQueryCoordClient_ListResourceGroups_Call) Run(run func(ctx context
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.ListResourceGroupsRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.ResumeTargetUpdatesRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_ListResourceGroups_Call) Return(_a0 *milvuspb.ListResourceGroupsResponse, _a1 error) *MockQueryCoordClient_ListResourceGroups_Call {
+func (_c *MockQueryCoordClient_ResumeTargetUpdates_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_ResumeTargetUpdates_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_ListResourceGroups_Call) RunAndReturn(run func(context.Context, *milvuspb.ListResourceGroupsRequest, ...grpc.CallOption) (*milvuspb.ListResourceGroupsResponse, error)) *MockQueryCoordClient_ListResourceGroups_Call {
+func (_c *MockQueryCoordClient_ResumeTargetUpdates_Call) RunAndReturn(run func(context.Context, *querypb.ResumeTargetUpdatesRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_ResumeTargetUpdates_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LoadBalance provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) LoadBalance(ctx context.Context, in *querypb.LoadBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// SetBalancePolicy provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) SetBalancePolicy(ctx context.Context, in *querypb.SetBalancePolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1415,10 +4495,10 @@ func (_m *MockQueryCoordClient) LoadBalance(ctx context.Context, in *querypb.Loa
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.LoadBalanceRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SetBalancePolicyRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.LoadBalanceRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SetBalancePolicyRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -1426,7 +4506,7 @@ func (_m *MockQueryCoordClient) LoadBalance(ctx context.Context, in *querypb.Loa
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.LoadBalanceRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.SetBalancePolicyRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1435,21 +4515,21 @@ func (_m *MockQueryCoordClient) LoadBalance(ctx context.Context, in *querypb.Loa
 	return r0, r1
 }
 
-// MockQueryCoordClient_LoadBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadBalance'
-type MockQueryCoordClient_LoadBalance_Call struct {
+// MockQueryCoordClient_SetBalancePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetBalancePolicy'
+type MockQueryCoordClient_SetBalancePolicy_Call struct {
 	*mock.Call
 }
 
-// LoadBalance is a helper method to define mock.On call
+// SetBalancePolicy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *querypb.LoadBalanceRequest
+//   - in *querypb.SetBalancePolicyRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) LoadBalance(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_LoadBalance_Call {
-	return &MockQueryCoordClient_LoadBalance_Call{Call: _e.mock.On("LoadBalance",
+func (_e *MockQueryCoordClient_Expecter) SetBalancePolicy(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_SetBalancePolicy_Call {
+	return &MockQueryCoordClient_SetBalancePolicy_Call{Call: _e.mock.On("SetBalancePolicy",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_LoadBalance_Call) Run(run func(ctx context.Context, in *querypb.LoadBalanceRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_LoadBalance_Call {
+func (_c *MockQueryCoordClient_SetBalancePolicy_Call) Run(run func(ctx context.Context, in *querypb.SetBalancePolicyRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_SetBalancePolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1457,23 +4537,23 @@ func (_c *MockQueryCoordClient_LoadBalance_Call) Run(run func(ctx context.Contex
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*querypb.LoadBalanceRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.SetBalancePolicyRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_LoadBalance_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_LoadBalance_Call {
+func (_c *MockQueryCoordClient_SetBalancePolicy_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_SetBalancePolicy_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_LoadBalance_Call) RunAndReturn(run func(context.Context, *querypb.LoadBalanceRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_LoadBalance_Call {
+func (_c *MockQueryCoordClient_SetBalancePolicy_Call) RunAndReturn(run func(context.Context, *querypb.SetBalancePolicyRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_SetBalancePolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LoadCollection provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) LoadCollection(ctx context.Context, in *querypb.LoadCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// SetCollectionBalanceMode provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) SetCollectionBalanceMode(ctx context.Context, in *querypb.SetCollectionBalanceModeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1485,10 +4565,10 @@ func (_m *MockQueryCoordClient) LoadCollection(ctx context.Context, in *querypb.
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.LoadCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SetCollectionBalanceModeRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.LoadCollectionRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SetCollectionBalanceModeRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -1496,7 +4576,7 @@ func (_m *MockQueryCoordClient) LoadCollection(ctx context.Context, in *querypb.
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.LoadCollectionRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.SetCollectionBalanceModeRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1505,21 +4585,21 @@ func (_m *MockQueryCoordClient) LoadCollection(ctx context.Context, in *querypb.
 	return r0, r1
 }
 
-// MockQueryCoordClient_LoadCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadCollection'
-type MockQueryCoordClient_LoadCollection_Call struct {
+// MockQueryCoordClient_SetCollectionBalanceMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCollectionBalanceMode'
+type MockQueryCoordClient_SetCollectionBalanceMode_Call struct {
 	*mock.Call
 }
 
-// LoadCollection is a helper method to define mock.On call
+// SetCollectionBalanceMode is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *querypb.LoadCollectionRequest
+//   - in *querypb.SetCollectionBalanceModeRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) LoadCollection(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_LoadCollection_Call {
-	return &MockQueryCoordClient_LoadCollection_Call{Call: _e.mock.On("LoadCollection",
+func (_e *MockQueryCoordClient_Expecter) SetCollectionBalanceMode(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_SetCollectionBalanceMode_Call {
+	return &MockQueryCoordClient_SetCollectionBalanceMode_Call{Call: _e.mock.On("SetCollectionBalanceMode",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_LoadCollection_Call) Run(run func(ctx context.Context, in *querypb.LoadCollectionRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_LoadCollection_Call {
+func (_c *MockQueryCoordClient_SetCollectionBalanceMode_Call) Run(run func(ctx context.Context, in *querypb.SetCollectionBalanceModeRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_SetCollectionBalanceMode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1527,23 +4607,23 @@ func (_c *MockQueryCoordClient_LoadCollection_Call) Run(run func(ctx context.Con
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*querypb.LoadCollectionRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.SetCollectionBalanceModeRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_LoadCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_LoadCollection_Call {
+func (_c *MockQueryCoordClient_SetCollectionBalanceMode_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_SetCollectionBalanceMode_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_LoadCollection_Call) RunAndReturn(run func(context.Context, *querypb.LoadCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_LoadCollection_Call {
+func (_c *MockQueryCoordClient_SetCollectionBalanceMode_Call) RunAndReturn(run func(context.Context, *querypb.SetCollectionBalanceModeRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_SetCollectionBalanceMode_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LoadPartitions provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) LoadPartitions(ctx context.Context, in *querypb.LoadPartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// ShowCollections provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) ShowCollections(ctx context.Context, in *querypb.ShowCollectionsRequest, opts ...grpc.CallOption) (*querypb.ShowCollectionsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1553,20 +4633,20 @@ func (_m *MockQueryCoordClient) LoadPartitions(ctx context.Context, in *querypb.
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *commonpb.Status
+	var r0 *querypb.ShowCollectionsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.LoadPartitionsRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ShowCollectionsRequest, ...grpc.CallOption) (*querypb.ShowCollectionsResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.LoadPartitionsRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ShowCollectionsRequest, ...grpc.CallOption) *querypb.ShowCollectionsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*commonpb.Status)
+			r0 = ret.Get(0).(*querypb.ShowCollectionsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.LoadPartitionsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ShowCollectionsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1575,21 +4655,21 @@ func (_m *MockQueryCoordClient) LoadPartitions(ctx context.Context, in *querypb.
 	return r0, r1
 }
 
-// MockQueryCoordClient_LoadPartitions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadPartitions'
-type MockQueryCoordClient_LoadPartitions_Call struct {
+// MockQueryCoordClient_ShowCollections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShowCollections'
+type MockQueryCoordClient_ShowCollections_Call struct {
 	*mock.Call
 }
 
-// LoadPartitions is a helper method to define mock.On call
+// ShowCollections is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *querypb.LoadPartitionsRequest
+//   - in *querypb.ShowCollectionsRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) LoadPartitions(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_LoadPartitions_Call {
-	return &MockQueryCoordClient_LoadPartitions_Call{Call: _e.mock.On("LoadPartitions",
+func (_e *MockQueryCoordClient_Expecter) ShowCollections(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_ShowCollections_Call {
+	return &MockQueryCoordClient_ShowCollections_Call{Call: _e.mock.On("ShowCollections",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_LoadPartitions_Call) Run(run func(ctx context.Context, in *querypb.LoadPartitionsRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_LoadPartitions_Call {
+func (_c *MockQueryCoordClient_ShowCollections_Call) Run(run func(ctx context.Context, in *querypb.ShowCollectionsRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_ShowCollections_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1597,23 +4677,23 @@ func (_c *MockQueryCoordClient_LoadPartitions_Call) Run(run func(ctx context.Con
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*querypb.LoadPartitionsRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.ShowCollectionsRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_LoadPartitions_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_LoadPartitions_Call {
+func (_c *MockQueryCoordClient_ShowCollections_Call) Return(_a0 *querypb.ShowCollectionsResponse, _a1 error) *MockQueryCoordClient_ShowCollections_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_LoadPartitions_Call) RunAndReturn(run func(context.Context, *querypb.LoadPartitionsRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_LoadPartitions_Call {
+func (_c *MockQueryCoordClient_ShowCollections_Call) RunAndReturn(run func(context.Context, *querypb.ShowCollectionsRequest, ...grpc.CallOption) (*querypb.ShowCollectionsResponse, error)) *MockQueryCoordClient_ShowCollections_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReleaseCollection provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) ReleaseCollection(ctx context.Context, in *querypb.ReleaseCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// ShowConfigurations provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1623,20 +4703,20 @@ func (_m *MockQueryCoordClient) ReleaseCollection(ctx context.Context, in *query
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *commonpb.Status
+	var r0 *internalpb.ShowConfigurationsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ReleaseCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ShowConfigurationsRequest, ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ReleaseCollectionRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ShowConfigurationsRequest, ...grpc.CallOption) *internalpb.ShowConfigurationsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*commonpb.Status)
+			r0 = ret.Get(0).(*internalpb.ShowConfigurationsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ReleaseCollectionRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ShowConfigurationsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1645,21 +4725,21 @@ func (_m *MockQueryCoordClient) ReleaseCollection(ctx context.Context, in *query
 	return r0, r1
 }
 
-// MockQueryCoordClient_ReleaseCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseCollection'
-type MockQueryCoordClient_ReleaseCollection_Call struct {
+// MockQueryCoordClient_ShowConfigurations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShowConfigurations'
+type MockQueryCoordClient_ShowConfigurations_Call struct {
 	*mock.Call
 }
 
-// ReleaseCollection is a helper method to define mock.On call
+// ShowConfigurations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *querypb.ReleaseCollectionRequest
+//   - in *internalpb.ShowConfigurationsRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) ReleaseCollection(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_ReleaseCollection_Call {
-	return &MockQueryCoordClient_ReleaseCollection_Call{Call: _e.mock.On("ReleaseCollection",
+func (_e *MockQueryCoordClient_Expecter) ShowConfigurations(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_ShowConfigurations_Call {
+	return &MockQueryCoordClient_ShowConfigurations_Call{Call: _e.mock.On("ShowConfigurations",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_ReleaseCollection_Call) Run(run func(ctx context.Context, in *querypb.ReleaseCollectionRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_ReleaseCollection_Call {
+func (_c *MockQueryCoordClient_ShowConfigurations_Call) Run(run func(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_ShowConfigurations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1667,23 +4747,23 @@ func (_c *MockQueryCoordClient_ReleaseCollection_Call) Run(run func(ctx context.
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*querypb.ReleaseCollectionRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*internalpb.ShowConfigurationsRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_ReleaseCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_ReleaseCollection_Call {
+func (_c *MockQueryCoordClient_ShowConfigurations_Call) Return(_a0 *internalpb.ShowConfigurationsResponse, _a1 error) *MockQueryCoordClient_ShowConfigurations_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_ReleaseCollection_Call) RunAndReturn(run func(context.Context, *querypb.ReleaseCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_ReleaseCollection_Call {
+func (_c *MockQueryCoordClient_ShowConfigurations_Call) RunAndReturn(run func(context.Context, *internalpb.ShowConfigurationsRequest, ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error)) *MockQueryCoordClient_ShowConfigurations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReleasePartitions provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) ReleasePartitions(ctx context.Context, in *querypb.ReleasePartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// ShowPartitions provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) ShowPartitions(ctx context.Context, in *querypb.ShowPartitionsRequest, opts ...grpc.CallOption) (*querypb.ShowPartitionsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1693,20 +4773,20 @@ func (_m *MockQueryCoordClient) ReleasePartitions(ctx context.Context, in *query
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *commonpb.Status
+	var r0 *querypb.ShowPartitionsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ReleasePartitionsRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ShowPartitionsRequest, ...grpc.CallOption) (*querypb.ShowPartitionsResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ReleasePartitionsRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ShowPartitionsRequest, ...grpc.CallOption) *querypb.ShowPartitionsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*commonpb.Status)
+			r0 = ret.Get(0).(*querypb.ShowPartitionsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ReleasePartitionsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ShowPartitionsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1715,21 +4795,21 @@ func (_m *MockQueryCoordClient) ReleasePartitions(ctx context.Context, in *query
 	return r0, r1
 }
 
-// MockQueryCoordClient_ReleasePartitions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleasePartitions'
-type MockQueryCoordClient_ReleasePartitions_Call struct {
+// MockQueryCoordClient_ShowPartitions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShowPartitions'
+type MockQueryCoordClient_ShowPartitions_Call struct {
 	*mock.Call
 }
 
-// ReleasePartitions is a helper method to define mock.On call
+// ShowPartitions is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *querypb.ReleasePartitionsRequest
+//   - in *querypb.ShowPartitionsRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) ReleasePartitions(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_ReleasePartitions_Call {
-	return &MockQueryCoordClient_ReleasePartitions_Call{Call: _e.mock.On("ReleasePartitions",
+func (_e *MockQueryCoordClient_Expecter) ShowPartitions(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_ShowPartitions_Call {
+	return &MockQueryCoordClient_ShowPartitions_Call{Call: _e.mock.On("ShowPartitions",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_ReleasePartitions_Call) Run(run func(ctx context.Context, in *querypb.ReleasePartitionsRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_ReleasePartitions_Call {
+func (_c *MockQueryCoordClient_ShowPartitions_Call) Run(run func(ctx context.Context, in *querypb.ShowPartitionsRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_ShowPartitions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1737,23 +4817,23 @@ func (_c *MockQueryCoordClient_ReleasePartitions_Call) Run(run func(ctx context.
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*querypb.ReleasePartitionsRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.ShowPartitionsRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_ReleasePartitions_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_ReleasePartitions_Call {
+func (_c *MockQueryCoordClient_ShowPartitions_Call) Return(_a0 *querypb.ShowPartitionsResponse, _a1 error) *MockQueryCoordClient_ShowPartitions_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_ReleasePartitions_Call) RunAndReturn(run func(context.Context, *querypb.ReleasePartitionsRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_ReleasePartitions_Call {
+func (_c *MockQueryCoordClient_ShowPartitions_Call) RunAndReturn(run func(context.Context, *querypb.ShowPartitionsRequest, ...grpc.CallOption) (*querypb.ShowPartitionsResponse, error)) *MockQueryCoordClient_ShowPartitions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ResumeBalance provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) ResumeBalance(ctx context.Context, in *querypb.ResumeBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// SuspendBalance provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) SuspendBalance(ctx context.Context, in *querypb.SuspendBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1765,10 +4845,10 @@ func (_m *MockQueryCoordClient) ResumeBalance(ctx context.Context, in *querypb.R
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ResumeBalanceRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SuspendBalanceRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ResumeBalanceRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SuspendBalanceRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -1776,7 +4856,7 @@ func (_m *MockQueryCoordClient) ResumeBalance(ctx context.Context, in *querypb.R
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ResumeBalanceRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.SuspendBalanceRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1785,21 +4865,21 @@ func (_m *MockQueryCoordClient) ResumeBalance(ctx context.Context, in *querypb.R
 	return r0, r1
 }
 
-// MockQueryCoordClient_ResumeBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeBalance'
-type MockQueryCoordClient_ResumeBalance_Call struct {
+// MockQueryCoordClient_SuspendBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SuspendBalance'
+type MockQueryCoordClient_SuspendBalance_Call struct {
 	*mock.Call
 }
 
-// ResumeBalance is a helper method to define mock.On call
+// SuspendBalance is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *querypb.ResumeBalanceRequest
+//   - in *querypb.SuspendBalanceRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) ResumeBalance(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_ResumeBalance_Call {
-	return &MockQueryCoordClient_ResumeBalance_Call{Call: _e.mock.On("ResumeBalance",
+func (_e *MockQueryCoordClient_Expecter) SuspendBalance(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_SuspendBalance_Call {
+	return &MockQueryCoordClient_SuspendBalance_Call{Call: _e.mock.On("SuspendBalance",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_ResumeBalance_Call) Run(run func(ctx context.Context, in *querypb.ResumeBalanceRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_ResumeBalance_Call {
+func (_c *MockQueryCoordClient_SuspendBalance_Call) Run(run func(ctx context.Context, in *querypb.SuspendBalanceRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_SuspendBalance_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1807,23 +4887,23 @@ func (_c *MockQueryCoordClient_ResumeBalance_Call) Run(run func(ctx context.Cont
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*querypb.ResumeBalanceRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.SuspendBalanceRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_ResumeBalance_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_ResumeBalance_Call {
+func (_c *MockQueryCoordClient_SuspendBalance_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_SuspendBalance_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_ResumeBalance_Call) RunAndReturn(run func(context.Context, *querypb.ResumeBalanceRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_ResumeBalance_Call {
+func (_c *MockQueryCoordClient_SuspendBalance_Call) RunAndReturn(run func(context.Context, *querypb.SuspendBalanceRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_SuspendBalance_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ResumeNode provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) ResumeNode(ctx context.Context, in *querypb.ResumeNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// SuspendNode provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) SuspendNode(ctx context.Context, in *querypb.SuspendNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1835,10 +4915,10 @@ func (_m *MockQueryCoordClient) ResumeNode(ctx context.Context, in *querypb.Resu
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ResumeNodeRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SuspendNodeRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ResumeNodeRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SuspendNodeRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -1846,7 +4926,7 @@ func (_m *MockQueryCoordClient) ResumeNode(ctx context.Context, in *querypb.Resu
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ResumeNodeRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.SuspendNodeRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1855,21 +4935,21 @@ func (_m *MockQueryCoordClient) ResumeNode(ctx context.Context, in *querypb.Resu
 	return r0, r1
 }
 
-// MockQueryCoordClient_ResumeNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeNode'
-type MockQueryCoordClient_ResumeNode_Call struct {
+// MockQueryCoordClient_SuspendNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SuspendNode'
+type MockQueryCoordClient_SuspendNode_Call struct {
 	*mock.Call
 }
 
-// ResumeNode is a helper method to define mock.On call
+// SuspendNode is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *querypb.ResumeNodeRequest
+//   - in *querypb.SuspendNodeRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) ResumeNode(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_ResumeNode_Call {
-	return &MockQueryCoordClient_ResumeNode_Call{Call: _e.mock.On("ResumeNode",
+func (_e *MockQueryCoordClient_Expecter) SuspendNode(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_SuspendNode_Call {
+	return &MockQueryCoordClient_SuspendNode_Call{Call: _e.mock.On("SuspendNode",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_ResumeNode_Call) Run(run func(ctx context.Context, in *querypb.ResumeNodeRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_ResumeNode_Call {
+func (_c *MockQueryCoordClient_SuspendNode_Call) Run(run func(ctx context.Context, in *querypb.SuspendNodeRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_SuspendNode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1877,23 +4957,23 @@ func (_c *MockQueryCoordClient_ResumeNode_Call) Run(run func(ctx context.Context
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*querypb.ResumeNodeRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.SuspendNodeRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_ResumeNode_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_ResumeNode_Call {
+func (_c *MockQueryCoordClient_SuspendNode_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_SuspendNode_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_ResumeNode_Call) RunAndReturn(run func(context.Context, *querypb.ResumeNodeRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_ResumeNode_Call {
+func (_c *MockQueryCoordClient_SuspendNode_Call) RunAndReturn(run func(context.Context, *querypb.SuspendNodeRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_SuspendNode_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ShowCollections provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) ShowCollections(ctx context.Context, in *querypb.ShowCollectionsRequest, opts ...grpc.CallOption) (*querypb.ShowCollectionsResponse, error) {
+// SyncNewCreatedPartition provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) SyncNewCreatedPartition(ctx context.Context, in *querypb.SyncNewCreatedPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1903,20 +4983,20 @@ func (_m *MockQueryCoordClient) ShowCollections(ctx context.Context, in *querypb
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *querypb.ShowCollectionsResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ShowCollectionsRequest, ...grpc.CallOption) (*querypb.ShowCollectionsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SyncNewCreatedPartitionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ShowCollectionsRequest, ...grpc.CallOption) *querypb.ShowCollectionsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SyncNewCreatedPartitionRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*querypb.ShowCollectionsResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ShowCollectionsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.SyncNewCreatedPartitionRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1925,21 +5005,21 @@ func (_m *MockQueryCoordClient) ShowCollections(ctx context.Context, in *querypb
 	return r0, r1
 }
 
-// MockQueryCoordClient_ShowCollections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShowCollections'
-type MockQueryCoordClient_ShowCollections_Call struct {
+// MockQueryCoordClient_SyncNewCreatedPartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncNewCreatedPartition'
+type MockQueryCoordClient_SyncNewCreatedPartition_Call struct {
 	*mock.Call
 }
 
-// ShowCollections is a helper method to define mock.On call
+// SyncNewCreatedPartition is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *querypb.ShowCollectionsRequest
+//   - in *querypb.SyncNewCreatedPartitionRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) ShowCollections(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_ShowCollections_Call {
-	return &MockQueryCoordClient_ShowCollections_Call{Call: _e.mock.On("ShowCollections",
+func (_e *MockQueryCoordClient_Expecter) SyncNewCreatedPartition(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_SyncNewCreatedPartition_Call {
+	return &MockQueryCoordClient_SyncNewCreatedPartition_Call{Call: _e.mock.On("SyncNewCreatedPartition",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_ShowCollections_Call) Run(run func(ctx context.Context, in *querypb.ShowCollectionsRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_ShowCollections_Call {
+func (_c *MockQueryCoordClient_SyncNewCreatedPartition_Call) Run(run func(ctx context.Context, in *querypb.SyncNewCreatedPartitionRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_SyncNewCreatedPartition_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1947,23 +5027,23 @@ func (_c *MockQueryCoordClient_ShowCollections_Call) Run(run func(ctx context.Co
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*querypb.ShowCollectionsRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.SyncNewCreatedPartitionRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_ShowCollections_Call) Return(_a0 *querypb.ShowCollectionsResponse, _a1 error) *MockQueryCoordClient_ShowCollections_Call {
+func (_c *MockQueryCoordClient_SyncNewCreatedPartition_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_SyncNewCreatedPartition_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_ShowCollections_Call) RunAndReturn(run func(context.Context, *querypb.ShowCollectionsRequest, ...grpc.CallOption) (*querypb.ShowCollectionsResponse, error)) *MockQueryCoordClient_ShowCollections_Call {
+func (_c *MockQueryCoordClient_SyncNewCreatedPartition_Call) RunAndReturn(run func(context.Context, *querypb.SyncNewCreatedPartitionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_SyncNewCreatedPartition_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ShowConfigurations provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error) {
+// SyncNewCreatedPartitions provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) SyncNewCreatedPartitions(ctx context.Context, in *querypb.SyncNewCreatedPartitionsRequest, opts ...grpc.CallOption) (*querypb.SyncNewCreatedPartitionsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1973,20 +5053,20 @@ func (_m *MockQueryCoordClient) ShowConfigurations(ctx context.Context, in *inte
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *internalpb.ShowConfigurationsResponse
+	var r0 *querypb.SyncNewCreatedPartitionsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ShowConfigurationsRequest, ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SyncNewCreatedPartitionsRequest, ...grpc.CallOption) (*querypb.SyncNewCreatedPartitionsResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ShowConfigurationsRequest, ...grpc.CallOption) *internalpb.ShowConfigurationsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SyncNewCreatedPartitionsRequest, ...grpc.CallOption) *querypb.SyncNewCreatedPartitionsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*internalpb.ShowConfigurationsResponse)
+			r0 = ret.Get(0).(*querypb.SyncNewCreatedPartitionsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ShowConfigurationsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.SyncNewCreatedPartitionsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1995,21 +5075,21 @@ func (_m *MockQueryCoordClient) ShowConfigurations(ctx context.Context, in *inte
 	return r0, r1
 }
 
-// MockQueryCoordClient_ShowConfigurations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShowConfigurations'
-type MockQueryCoordClient_ShowConfigurations_Call struct {
+// MockQueryCoordClient_SyncNewCreatedPartitions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncNewCreatedPartitions'
+type MockQueryCoordClient_SyncNewCreatedPartitions_Call struct {
 	*mock.Call
 }
 
-// ShowConfigurations is a helper method to define mock.On call
+// SyncNewCreatedPartitions is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *internalpb.ShowConfigurationsRequest
+//   - in *querypb.SyncNewCreatedPartitionsRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) ShowConfigurations(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_ShowConfigurations_Call {
-	return &MockQueryCoordClient_ShowConfigurations_Call{Call: _e.mock.On("ShowConfigurations",
+func (_e *MockQueryCoordClient_Expecter) SyncNewCreatedPartitions(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_SyncNewCreatedPartitions_Call {
+	return &MockQueryCoordClient_SyncNewCreatedPartitions_Call{Call: _e.mock.On("SyncNewCreatedPartitions",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_ShowConfigurations_Call) Run(run func(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_ShowConfigurations_Call {
+func (_c *MockQueryCoordClient_SyncNewCreatedPartitions_Call) Run(run func(ctx context.Context, in *querypb.SyncNewCreatedPartitionsRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_SyncNewCreatedPartitions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2017,23 +5097,23 @@ func (_c *MockQueryCoordClient_ShowConfigurations_Call) Run(run func(ctx context
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*internalpb.ShowConfigurationsRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.SyncNewCreatedPartitionsRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_ShowConfigurations_Call) Return(_a0 *internalpb.ShowConfigurationsResponse, _a1 error) *MockQueryCoordClient_ShowConfigurations_Call {
+func (_c *MockQueryCoordClient_SyncNewCreatedPartitions_Call) Return(_a0 *querypb.SyncNewCreatedPartitionsResponse, _a1 error) *MockQueryCoordClient_SyncNewCreatedPartitions_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_ShowConfigurations_Call) RunAndReturn(run func(context.Context, *internalpb.ShowConfigurationsRequest, ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error)) *MockQueryCoordClient_ShowConfigurations_Call {
+func (_c *MockQueryCoordClient_SyncNewCreatedPartitions_Call) RunAndReturn(run func(context.Context, *querypb.SyncNewCreatedPartitionsRequest, ...grpc.CallOption) (*querypb.SyncNewCreatedPartitionsResponse, error)) *MockQueryCoordClient_SyncNewCreatedPartitions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ShowPartitions provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) ShowPartitions(ctx context.Context, in *querypb.ShowPartitionsRequest, opts ...grpc.CallOption) (*querypb.ShowPartitionsResponse, error) {
+// TransferChannel provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) TransferChannel(ctx context.Context, in *querypb.TransferChannelRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2043,20 +5123,20 @@ func (_m *MockQueryCoordClient) ShowPartitions(ctx context.Context, in *querypb.
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *querypb.ShowPartitionsResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ShowPartitionsRequest, ...grpc.CallOption) (*querypb.ShowPartitionsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.TransferChannelRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.ShowPartitionsRequest, ...grpc.CallOption) *querypb.ShowPartitionsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.TransferChannelRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*querypb.ShowPartitionsResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.ShowPartitionsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.TransferChannelRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2065,21 +5145,21 @@ func (_m *MockQueryCoordClient) ShowPartitions(ctx context.Context, in *querypb.
 	return r0, r1
 }
 
-// MockQueryCoordClient_ShowPartitions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShowPartitions'
-type MockQueryCoordClient_ShowPartitions_Call struct {
+// MockQueryCoordClient_TransferChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferChannel'
+type MockQueryCoordClient_TransferChannel_Call struct {
 	*mock.Call
 }
 
-// ShowPartitions is a helper method to define mock.On call
+// TransferChannel is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *querypb.ShowPartitionsRequest
+//   - in *querypb.TransferChannelRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) ShowPartitions(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_ShowPartitions_Call {
-	return &MockQueryCoordClient_ShowPartitions_Call{Call: _e.mock.On("ShowPartitions",
+func (_e *MockQueryCoordClient_Expecter) TransferChannel(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_TransferChannel_Call {
+	return &MockQueryCoordClient_TransferChannel_Call{Call: _e.mock.On("TransferChannel",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_ShowPartitions_Call) Run(run func(ctx context.Context, in *querypb.ShowPartitionsRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_ShowPartitions_Call {
+func (_c *MockQueryCoordClient_TransferChannel_Call) Run(run func(ctx context.Context, in *querypb.TransferChannelRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_TransferChannel_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2087,23 +5167,23 @@ func (_c *MockQueryCoordClient_ShowPartitions_Call) Run(run func(ctx context.Con
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*querypb.ShowPartitionsRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.TransferChannelRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_ShowPartitions_Call) Return(_a0 *querypb.ShowPartitionsResponse, _a1 error) *MockQueryCoordClient_ShowPartitions_Call {
+func (_c *MockQueryCoordClient_TransferChannel_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_TransferChannel_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_ShowPartitions_Call) RunAndReturn(run func(context.Context, *querypb.ShowPartitionsRequest, ...grpc.CallOption) (*querypb.ShowPartitionsResponse, error)) *MockQueryCoordClient_ShowPartitions_Call {
+func (_c *MockQueryCoordClient_TransferChannel_Call) RunAndReturn(run func(context.Context, *querypb.TransferChannelRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_TransferChannel_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SuspendBalance provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) SuspendBalance(ctx context.Context, in *querypb.SuspendBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// TransferNode provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) TransferNode(ctx context.Context, in *milvuspb.TransferNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2115,10 +5195,10 @@ func (_m *MockQueryCoordClient) SuspendBalance(ctx context.Context, in *querypb.
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SuspendBalanceRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.TransferNodeRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SuspendBalanceRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.TransferNodeRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -2126,7 +5206,7 @@ func (_m *MockQueryCoordClient) SuspendBalance(ctx context.Context, in *querypb.
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.SuspendBalanceRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.TransferNodeRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2135,21 +5215,21 @@ func (_m *MockQueryCoordClient) SuspendBalance(ctx context.Context, in *querypb.
 	return r0, r1
 }
 
-// MockQueryCoordClient_SuspendBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SuspendBalance'
-type MockQueryCoordClient_SuspendBalance_Call struct {
+// MockQueryCoordClient_TransferNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferNode'
+type MockQueryCoordClient_TransferNode_Call struct {
 	*mock.Call
 }
 
-// SuspendBalance is a helper method to define mock.On call
+// TransferNode is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *querypb.SuspendBalanceRequest
+//   - in *milvuspb.TransferNodeRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) SuspendBalance(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_SuspendBalance_Call {
-	return &MockQueryCoordClient_SuspendBalance_Call{Call: _e.mock.On("SuspendBalance",
+func (_e *MockQueryCoordClient_Expecter) TransferNode(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_TransferNode_Call {
+	return &MockQueryCoordClient_TransferNode_Call{Call: _e.mock.On("TransferNode",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_SuspendBalance_Call) Run(run func(ctx context.Context, in *querypb.SuspendBalanceRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_SuspendBalance_Call {
+func (_c *MockQueryCoordClient_TransferNode_Call) Run(run func(ctx context.Context, in *milvuspb.TransferNodeRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_TransferNode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2157,23 +5237,23 @@ func (_c *MockQueryCoordClient_SuspendBalance_Call) Run(run func(ctx context.Con
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*querypb.SuspendBalanceRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*milvuspb.TransferNodeRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_SuspendBalance_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_SuspendBalance_Call {
+func (_c *MockQueryCoordClient_TransferNode_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_TransferNode_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_SuspendBalance_Call) RunAndReturn(run func(context.Context, *querypb.SuspendBalanceRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_SuspendBalance_Call {
+func (_c *MockQueryCoordClient_TransferNode_Call) RunAndReturn(run func(context.Context, *milvuspb.TransferNodeRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_TransferNode_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SuspendNode provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) SuspendNode(ctx context.Context, in *querypb.SuspendNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// TransferNodeByFraction provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) TransferNodeByFraction(ctx context.Context, in *querypb.TransferNodeByFractionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2185,10 +5265,10 @@ func (_m *MockQueryCoordClient) SuspendNode(ctx context.Context, in *querypb.Sus
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SuspendNodeRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.TransferNodeByFractionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SuspendNodeRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.TransferNodeByFractionRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -2196,7 +5276,7 @@ func (_m *MockQueryCoordClient) SuspendNode(ctx context.Context, in *querypb.Sus
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.SuspendNodeRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.TransferNodeByFractionRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2205,21 +5285,21 @@ func (_m *MockQueryCoordClient) SuspendNode(ctx context.Context, in *querypb.Sus
 	return r0, r1
 }
 
-// MockQueryCoordClient_SuspendNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SuspendNode'
-type MockQueryCoordClient_SuspendNode_Call struct {
+// MockQueryCoordClient_TransferNodeByFraction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferNodeByFraction'
+type MockQueryCoordClient_TransferNodeByFraction_Call struct {
 	*mock.Call
 }
 
-// SuspendNode is a helper method to define mock.On call
+// TransferNodeByFraction is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *querypb.SuspendNodeRequest
+//   - in *querypb.TransferNodeByFractionRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) SuspendNode(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_SuspendNode_Call {
-	return &MockQueryCoordClient_SuspendNode_Call{Call: _e.mock.On("SuspendNode",
+func (_e *MockQueryCoordClient_Expecter) TransferNodeByFraction(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_TransferNodeByFraction_Call {
+	return &MockQueryCoordClient_TransferNodeByFraction_Call{Call: _e.mock.On("TransferNodeByFraction",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_SuspendNode_Call) Run(run func(ctx context.Context, in *querypb.SuspendNodeRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_SuspendNode_Call {
+func (_c *MockQueryCoordClient_TransferNodeByFraction_Call) Run(run func(ctx context.Context, in *querypb.TransferNodeByFractionRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_TransferNodeByFraction_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2227,23 +5307,23 @@ func (_c *MockQueryCoordClient_SuspendNode_Call) Run(run func(ctx context.Contex
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*querypb.SuspendNodeRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.TransferNodeByFractionRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_SuspendNode_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_SuspendNode_Call {
+func (_c *MockQueryCoordClient_TransferNodeByFraction_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_TransferNodeByFraction_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_SuspendNode_Call) RunAndReturn(run func(context.Context, *querypb.SuspendNodeRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_SuspendNode_Call {
+func (_c *MockQueryCoordClient_TransferNodeByFraction_Call) RunAndReturn(run func(context.Context, *querypb.TransferNodeByFractionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_TransferNodeByFraction_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SyncNewCreatedPartition provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) SyncNewCreatedPartition(ctx context.Context, in *querypb.SyncNewCreatedPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// TransferReplica provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) TransferReplica(ctx context.Context, in *querypb.TransferReplicaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2255,10 +5335,10 @@ func (_m *MockQueryCoordClient) SyncNewCreatedPartition(ctx context.Context, in
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SyncNewCreatedPartitionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.TransferReplicaRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SyncNewCreatedPartitionRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.TransferReplicaRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -2266,7 +5346,7 @@ func (_m *MockQueryCoordClient) SyncNewCreatedPartition(ctx context.Context, in
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.SyncNewCreatedPartitionRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.TransferReplicaRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2275,21 +5355,21 @@ func (_m *MockQueryCoordClient) SyncNewCreatedPartition(ctx context.Context, in
 	return r0, r1
 }
 
-// MockQueryCoordClient_SyncNewCreatedPartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncNewCreatedPartition'
-type MockQueryCoordClient_SyncNewCreatedPartition_Call struct {
+// MockQueryCoordClient_TransferReplica_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferReplica'
+type MockQueryCoordClient_TransferReplica_Call struct {
 	*mock.Call
 }
 
-// SyncNewCreatedPartition is a helper method to define mock.On call
+// TransferReplica is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *querypb.SyncNewCreatedPartitionRequest
+//   - in *querypb.TransferReplicaRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) SyncNewCreatedPartition(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_SyncNewCreatedPartition_Call {
-	return &MockQueryCoordClient_SyncNewCreatedPartition_Call{Call: _e.mock.On("SyncNewCreatedPartition",
+func (_e *MockQueryCoordClient_Expecter) TransferReplica(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_TransferReplica_Call {
+	return &MockQueryCoordClient_TransferReplica_Call{Call: _e.mock.On("TransferReplica",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_SyncNewCreatedPartition_Call) Run(run func(ctx context.Context, in *querypb.SyncNewCreatedPartitionRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_SyncNewCreatedPartition_Call {
+func (_c *MockQueryCoordClient_TransferReplica_Call) Run(run func(ctx context.Context, in *querypb.TransferReplicaRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_TransferReplica_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2297,23 +5377,23 @@ func (_c *MockQueryCoordClient_SyncNewCreatedPartition_Call) Run(run func(ctx co
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*querypb.SyncNewCreatedPartitionRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.TransferReplicaRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_SyncNewCreatedPartition_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_SyncNewCreatedPartition_Call {
+func (_c *MockQueryCoordClient_TransferReplica_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_TransferReplica_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_SyncNewCreatedPartition_Call) RunAndReturn(run func(context.Context, *querypb.SyncNewCreatedPartitionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_SyncNewCreatedPartition_Call {
+func (_c *MockQueryCoordClient_TransferReplica_Call) RunAndReturn(run func(context.Context, *querypb.TransferReplicaRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_TransferReplica_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// TransferChannel provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) TransferChannel(ctx context.Context, in *querypb.TransferChannelRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// TransferSegment provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) TransferSegment(ctx context.Context, in *querypb.TransferSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2325,10 +5405,10 @@ func (_m *MockQueryCoordClient) TransferChannel(ctx context.Context, in *querypb
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.TransferChannelRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.TransferSegmentRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.TransferChannelRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.TransferSegmentRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -2336,7 +5416,7 @@ func (_m *MockQueryCoordClient) TransferChannel(ctx context.Context, in *querypb
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.TransferChannelRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.TransferSegmentRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2345,21 +5425,21 @@ func (_m *MockQueryCoordClient) TransferChannel(ctx context.Context, in *querypb
 	return r0, r1
 }
 
-// MockQueryCoordClient_TransferChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferChannel'
-type MockQueryCoordClient_TransferChannel_Call struct {
+// MockQueryCoordClient_TransferSegment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferSegment'
+type MockQueryCoordClient_TransferSegment_Call struct {
 	*mock.Call
 }
 
-// TransferChannel is a helper method to define mock.On call
+// TransferSegment is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *querypb.TransferChannelRequest
+//   - in *querypb.TransferSegmentRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) TransferChannel(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_TransferChannel_Call {
-	return &MockQueryCoordClient_TransferChannel_Call{Call: _e.mock.On("TransferChannel",
+func (_e *MockQueryCoordClient_Expecter) TransferSegment(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_TransferSegment_Call {
+	return &MockQueryCoordClient_TransferSegment_Call{Call: _e.mock.On("TransferSegment",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_TransferChannel_Call) Run(run func(ctx context.Context, in *querypb.TransferChannelRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_TransferChannel_Call {
+func (_c *MockQueryCoordClient_TransferSegment_Call) Run(run func(ctx context.Context, in *querypb.TransferSegmentRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_TransferSegment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2367,23 +5447,23 @@ func (_c *MockQueryCoordClient_TransferChannel_Call) Run(run func(ctx context.Co
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*querypb.TransferChannelRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.TransferSegmentRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_TransferChannel_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_TransferChannel_Call {
+func (_c *MockQueryCoordClient_TransferSegment_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_TransferSegment_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_TransferChannel_Call) RunAndReturn(run func(context.Context, *querypb.TransferChannelRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_TransferChannel_Call {
+func (_c *MockQueryCoordClient_TransferSegment_Call) RunAndReturn(run func(context.Context, *querypb.TransferSegmentRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_TransferSegment_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// TransferNode provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) TransferNode(ctx context.Context, in *milvuspb.TransferNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// TriggerCheckerRun provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) TriggerCheckerRun(ctx context.Context, in *querypb.TriggerCheckerRunRequest, opts ...grpc.CallOption) (*querypb.TriggerCheckerRunResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2393,20 +5473,20 @@ func (_m *MockQueryCoordClient) TransferNode(ctx context.Context, in *milvuspb.T
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *commonpb.Status
+	var r0 *querypb.TriggerCheckerRunResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.TransferNodeRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.TriggerCheckerRunRequest, ...grpc.CallOption) (*querypb.TriggerCheckerRunResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.TransferNodeRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.TriggerCheckerRunRequest, ...grpc.CallOption) *querypb.TriggerCheckerRunResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*commonpb.Status)
+			r0 = ret.Get(0).(*querypb.TriggerCheckerRunResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.TransferNodeRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.TriggerCheckerRunRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2415,21 +5495,21 @@ func (_m *MockQueryCoordClient) TransferNode(ctx context.Context, in *milvuspb.T
 	return r0, r1
 }
 
-// MockQueryCoordClient_TransferNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferNode'
-type MockQueryCoordClient_TransferNode_Call struct {
+// MockQueryCoordClient_TriggerCheckerRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TriggerCheckerRun'
+type MockQueryCoordClient_TriggerCheckerRun_Call struct {
 	*mock.Call
 }
 
-// TransferNode is a helper method to define mock.On call
+// TriggerCheckerRun is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.TransferNodeRequest
+//   - in *querypb.TriggerCheckerRunRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) TransferNode(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_TransferNode_Call {
-	return &MockQueryCoordClient_TransferNode_Call{Call: _e.mock.On("TransferNode",
+func (_e *MockQueryCoordClient_Expecter) TriggerCheckerRun(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_TriggerCheckerRun_Call {
+	return &MockQueryCoordClient_TriggerCheckerRun_Call{Call: _e.mock.On("TriggerCheckerRun",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_TransferNode_Call) Run(run func(ctx context.Context, in *milvuspb.TransferNodeRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_TransferNode_Call {
+func (_c *MockQueryCoordClient_TriggerCheckerRun_Call) Run(run func(ctx context.Context, in *querypb.TriggerCheckerRunRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_TriggerCheckerRun_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2437,23 +5517,23 @@ func (_c *MockQueryCoordClient_TransferNode_Call) Run(run func(ctx context.Conte
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.TransferNodeRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.TriggerCheckerRunRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_TransferNode_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_TransferNode_Call {
+func (_c *MockQueryCoordClient_TriggerCheckerRun_Call) Return(_a0 *querypb.TriggerCheckerRunResponse, _a1 error) *MockQueryCoordClient_TriggerCheckerRun_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_TransferNode_Call) RunAndReturn(run func(context.Context, *milvuspb.TransferNodeRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_TransferNode_Call {
+func (_c *MockQueryCoordClient_TriggerCheckerRun_Call) RunAndReturn(run func(context.Context, *querypb.TriggerCheckerRunRequest, ...grpc.CallOption) (*querypb.TriggerCheckerRunResponse, error)) *MockQueryCoordClient_TriggerCheckerRun_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// TransferReplica provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) TransferReplica(ctx context.Context, in *querypb.TransferReplicaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// UnblockShard provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) UnblockShard(ctx context.Context, in *querypb.UnblockShardRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2465,10 +5545,10 @@ func (_m *MockQueryCoordClient) TransferReplica(ctx context.Context, in *querypb
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.TransferReplicaRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.UnblockShardRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.TransferReplicaRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.UnblockShardRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -2476,7 +5556,7 @@ func (_m *MockQueryCoordClient) TransferReplica(ctx context.Context, in *querypb
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.TransferReplicaRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.UnblockShardRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2485,21 +5565,21 @@ func (_m *MockQueryCoordClient) TransferReplica(ctx context.Context, in *querypb
 	return r0, r1
 }
 
-// MockQueryCoordClient_TransferReplica_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferReplica'
-type MockQueryCoordClient_TransferReplica_Call struct {
+// MockQueryCoordClient_UnblockShard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnblockShard'
+type MockQueryCoordClient_UnblockShard_Call struct {
 	*mock.Call
 }
 
-// TransferReplica is a helper method to define mock.On call
+// UnblockShard is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *querypb.TransferReplicaRequest
+//   - in *querypb.UnblockShardRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) TransferReplica(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_TransferReplica_Call {
-	return &MockQueryCoordClient_TransferReplica_Call{Call: _e.mock.On("TransferReplica",
+func (_e *MockQueryCoordClient_Expecter) UnblockShard(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_UnblockShard_Call {
+	return &MockQueryCoordClient_UnblockShard_Call{Call: _e.mock.On("UnblockShard",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_TransferReplica_Call) Run(run func(ctx context.Context, in *querypb.TransferReplicaRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_TransferReplica_Call {
+func (_c *MockQueryCoordClient_UnblockShard_Call) Run(run func(ctx context.Context, in *querypb.UnblockShardRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_UnblockShard_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2507,23 +5587,23 @@ func (_c *MockQueryCoordClient_TransferReplica_Call) Run(run func(ctx context.Co
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*querypb.TransferReplicaRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.UnblockShardRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_TransferReplica_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_TransferReplica_Call {
+func (_c *MockQueryCoordClient_UnblockShard_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_UnblockShard_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_TransferReplica_Call) RunAndReturn(run func(context.Context, *querypb.TransferReplicaRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_TransferReplica_Call {
+func (_c *MockQueryCoordClient_UnblockShard_Call) RunAndReturn(run func(context.Context, *querypb.UnblockShardRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_UnblockShard_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// TransferSegment provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) TransferSegment(ctx context.Context, in *querypb.TransferSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// UpdateResourceGroups provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) UpdateResourceGroups(ctx context.Context, in *querypb.UpdateResourceGroupsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2535,10 +5615,10 @@ func (_m *MockQueryCoordClient) TransferSegment(ctx context.Context, in *querypb
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.TransferSegmentRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.UpdateResourceGroupsRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.TransferSegmentRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.UpdateResourceGroupsRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -2546,7 +5626,7 @@ func (_m *MockQueryCoordClient) TransferSegment(ctx context.Context, in *querypb
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.TransferSegmentRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.UpdateResourceGroupsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2555,21 +5635,21 @@ func (_m *MockQueryCoordClient) TransferSegment(ctx context.Context, in *querypb
 	return r0, r1
 }
 
-// MockQueryCoordClient_TransferSegment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferSegment'
-type MockQueryCoordClient_TransferSegment_Call struct {
+// MockQueryCoordClient_UpdateResourceGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateResourceGroups'
+type MockQueryCoordClient_UpdateResourceGroups_Call struct {
 	*mock.Call
 }
 
-// TransferSegment is a helper method to define mock.On call
+// UpdateResourceGroups is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *querypb.TransferSegmentRequest
+//   - in *querypb.UpdateResourceGroupsRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) TransferSegment(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_TransferSegment_Call {
-	return &MockQueryCoordClient_TransferSegment_Call{Call: _e.mock.On("TransferSegment",
+func (_e *MockQueryCoordClient_Expecter) UpdateResourceGroups(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_UpdateResourceGroups_Call {
+	return &MockQueryCoordClient_UpdateResourceGroups_Call{Call: _e.mock.On("UpdateResourceGroups",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_TransferSegment_Call) Run(run func(ctx context.Context, in *querypb.TransferSegmentRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_TransferSegment_Call {
+func (_c *MockQueryCoordClient_UpdateResourceGroups_Call) Run(run func(ctx context.Context, in *querypb.UpdateResourceGroupsRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_UpdateResourceGroups_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2577,23 +5657,23 @@ func (_c *MockQueryCoordClient_TransferSegment_Call) Run(run func(ctx context.Co
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*querypb.TransferSegmentRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.UpdateResourceGroupsRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_TransferSegment_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_TransferSegment_Call {
+func (_c *MockQueryCoordClient_UpdateResourceGroups_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_UpdateResourceGroups_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_TransferSegment_Call) RunAndReturn(run func(context.Context, *querypb.TransferSegmentRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_TransferSegment_Call {
+func (_c *MockQueryCoordClient_UpdateResourceGroups_Call) RunAndReturn(run func(context.Context, *querypb.UpdateResourceGroupsRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_UpdateResourceGroups_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateResourceGroups provides a mock function with given fields: ctx, in, opts
-func (_m *MockQueryCoordClient) UpdateResourceGroups(ctx context.Context, in *querypb.UpdateResourceGroupsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// WatchCollections provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) WatchCollections(ctx context.Context, in *querypb.WatchCollectionsRequest, opts ...grpc.CallOption) (querypb.QueryCoord_WatchCollectionsClient, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2603,20 +5683,20 @@ func (_m *MockQueryCoordClient) UpdateResourceGroups(ctx context.Context, in *qu
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *commonpb.Status
+	var r0 querypb.QueryCoord_WatchCollectionsClient
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.UpdateResourceGroupsRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.WatchCollectionsRequest, ...grpc.CallOption) (querypb.QueryCoord_WatchCollectionsClient, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *querypb.UpdateResourceGroupsRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.WatchCollectionsRequest, ...grpc.CallOption) querypb.QueryCoord_WatchCollectionsClient); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*commonpb.Status)
+			r0 = ret.Get(0).(querypb.QueryCoord_WatchCollectionsClient)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *querypb.UpdateResourceGroupsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.WatchCollectionsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2625,21 +5705,21 @@ func (_m *MockQueryCoordClient) UpdateResourceGroups(ctx context.Context, in *qu
 	return r0, r1
 }
 
-// MockQueryCoordClient_UpdateResourceGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateResourceGroups'
-type MockQueryCoordClient_UpdateResourceGroups_Call struct {
+// MockQueryCoordClient_WatchCollections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchCollections'
+type MockQueryCoordClient_WatchCollections_Call struct {
 	*mock.Call
 }
 
-// UpdateResourceGroups is a helper method to define mock.On call
+// WatchCollections is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *querypb.UpdateResourceGroupsRequest
+//   - in *querypb.WatchCollectionsRequest
 //   - opts ...grpc.CallOption
-func (_e *MockQueryCoordClient_Expecter) UpdateResourceGroups(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_UpdateResourceGroups_Call {
-	return &MockQueryCoordClient_UpdateResourceGroups_Call{Call: _e.mock.On("UpdateResourceGroups",
+func (_e *MockQueryCoordClient_Expecter) WatchCollections(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_WatchCollections_Call {
+	return &MockQueryCoordClient_WatchCollections_Call{Call: _e.mock.On("WatchCollections",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockQueryCoordClient_UpdateResourceGroups_Call) Run(run func(ctx context.Context, in *querypb.UpdateResourceGroupsRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_UpdateResourceGroups_Call {
+func (_c *MockQueryCoordClient_WatchCollections_Call) Run(run func(ctx context.Context, in *querypb.WatchCollectionsRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_WatchCollections_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2647,17 +5727,17 @@ func (_c *MockQueryCoordClient_UpdateResourceGroups_Call) Run(run func(ctx conte
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*querypb.UpdateResourceGroupsRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*querypb.WatchCollectionsRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockQueryCoordClient_UpdateResourceGroups_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_UpdateResourceGroups_Call {
+func (_c *MockQueryCoordClient_WatchCollections_Call) Return(_a0 querypb.QueryCoord_WatchCollectionsClient, _a1 error) *MockQueryCoordClient_WatchCollections_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQueryCoordClient_UpdateResourceGroups_Call) RunAndReturn(run func(context.Context, *querypb.UpdateResourceGroupsRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_UpdateResourceGroups_Call {
+func (_c *MockQueryCoordClient_WatchCollections_Call) RunAndReturn(run func(context.Context, *querypb.WatchCollectionsRequest, ...grpc.CallOption) (querypb.QueryCoord_WatchCollectionsClient, error)) *MockQueryCoordClient_WatchCollections_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,17249 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: query_coord.proto
+
+package querypb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	commonpb "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	milvuspb "github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	msgpb "github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	rgpb "github.com/milvus-io/milvus-proto/go-api/v2/rgpb"
+	schemapb "github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	datapb "github.com/milvus-io/milvus/internal/proto/datapb"
+	indexpb "github.com/milvus-io/milvus/internal/proto/indexpb"
+	internalpb "github.com/milvus-io/milvus/internal/proto/internalpb"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type LoadScope int32
+
+const (
+	LoadScope_Full  LoadScope = 0
+	LoadScope_Delta LoadScope = 1
+	LoadScope_Index LoadScope = 2
+)
+
+var LoadScope_name = map[int32]string{
+	0: "Full",
+	1: "Delta",
+	2: "Index",
+}
+
+var LoadScope_value = map[string]int32{
+	"Full":  0,
+	"Delta": 1,
+	"Index": 2,
+}
+
+func (x LoadScope) String() string {
+	return proto.EnumName(LoadScope_name, int32(x))
+}
+
+func (LoadScope) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{0}
+}
+
+type DataScope int32
+
+const (
+	DataScope_UnKnown    DataScope = 0
+	DataScope_All        DataScope = 1
+	DataScope_Streaming  DataScope = 2
+	DataScope_Historical DataScope = 3
+)
+
+var DataScope_name = map[int32]string{
+	0: "UnKnown",
+	1: "All",
+	2: "Streaming",
+	3: "Historical",
+}
+
+var DataScope_value = map[string]int32{
+	"UnKnown":    0,
+	"All":        1,
+	"Streaming":  2,
+	"Historical": 3,
+}
+
+func (x DataScope) String() string {
+	return proto.EnumName(DataScope_name, int32(x))
+}
+
+func (DataScope) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{1}
+}
+
+type PartitionState int32
+
+const (
+	PartitionState_NotExist        PartitionState = 0
+	PartitionState_NotPresent      PartitionState = 1
+	PartitionState_OnDisk          PartitionState = 2
+	PartitionState_PartialInMemory PartitionState = 3
+	PartitionState_InMemory        PartitionState = 4
+	PartitionState_PartialInGPU    PartitionState = 5
+	PartitionState_InGPU           PartitionState = 6
+)
+
+var PartitionState_name = map[int32]string{
+	0: "NotExist",
+	1: "NotPresent",
+	2: "OnDisk",
+	3: "PartialInMemory",
+	4: "InMemory",
+	5: "PartialInGPU",
+	6: "InGPU",
+}
+
+var PartitionState_value = map[string]int32{
+	"NotExist":        0,
+	"NotPresent":      1,
+	"OnDisk":          2,
+	"PartialInMemory": 3,
+	"InMemory":        4,
+	"PartialInGPU":    5,
+	"InGPU":           6,
+}
+
+func (x PartitionState) String() string {
+	return proto.EnumName(PartitionState_name, int32(x))
+}
+
+func (PartitionState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{2}
+}
+
+type TriggerCondition int32
+
+const (
+	TriggerCondition_UnKnowCondition TriggerCondition = 0
+	TriggerCondition_Handoff         TriggerCondition = 1
+	TriggerCondition_LoadBalance     TriggerCondition = 2
+	TriggerCondition_GrpcRequest     TriggerCondition = 3
+	TriggerCondition_NodeDown        TriggerCondition = 4
+)
+
+var TriggerCondition_name = map[int32]string{
+	0: "UnKnowCondition",
+	1: "Handoff",
+	2: "LoadBalance",
+	3: "GrpcRequest",
+	4: "NodeDown",
+}
+
+var TriggerCondition_value = map[string]int32{
+	"UnKnowCondition": 0,
+	"Handoff":         1,
+	"LoadBalance":     2,
+	"GrpcRequest":     3,
+	"NodeDown":        4,
+}
+
+func (x TriggerCondition) String() string {
+	return proto.EnumName(TriggerCondition_name, int32(x))
+}
+
+func (TriggerCondition) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{3}
+}
+
+type LoadType int32
+
+const (
+	LoadType_UnKnownType    LoadType = 0
+	LoadType_LoadPartition  LoadType = 1
+	LoadType_LoadCollection LoadType = 2
+)
+
+var LoadType_name = map[int32]string{
+	0: "UnKnownType",
+	1: "LoadPartition",
+	2: "LoadCollection",
+}
+
+var LoadType_value = map[string]int32{
+	"UnKnownType":    0,
+	"LoadPartition":  1,
+	"LoadCollection": 2,
+}
+
+func (x LoadType) String() string {
+	return proto.EnumName(LoadType_name, int32(x))
+}
+
+func (LoadType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{4}
+}
+
+type LoadStatus int32
+
+const (
+	LoadStatus_Invalid LoadStatus = 0
+	LoadStatus_Loading LoadStatus = 1
+	LoadStatus_Loaded  LoadStatus = 2
+)
+
+var LoadStatus_name = map[int32]string{
+	0: "Invalid",
+	1: "Loading",
+	2: "Loaded",
+}
+
+var LoadStatus_value = map[string]int32{
+	"Invalid": 0,
+	"Loading": 1,
+	"Loaded":  2,
+}
+
+func (x LoadStatus) String() string {
+	return proto.EnumName(LoadStatus_name, int32(x))
+}
+
+func (LoadStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{5}
+}
+
+type SyncType int32
+
+const (
+	SyncType_Remove        SyncType = 0
+	SyncType_Set           SyncType = 1
+	SyncType_Amend         SyncType = 2
+	SyncType_UpdateVersion SyncType = 3
+)
+
+var SyncType_name = map[int32]string{
+	0: "Remove",
+	1: "Set",
+	2: "Amend",
+	3: "UpdateVersion",
+}
+
+var SyncType_value = map[string]int32{
+	"Remove":        0,
+	"Set":           1,
+	"Amend":         2,
+	"UpdateVersion": 3,
+}
+
+func (x SyncType) String() string {
+	return proto.EnumName(SyncType_name, int32(x))
+}
+
+func (SyncType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{6}
+}
+
+type ReplicaSortKey int32
+
+const (
+	ReplicaSortKey_DefaultReplicaOrder   ReplicaSortKey = 0
+	ReplicaSortKey_ReplicaNodeNum        ReplicaSortKey = 1
+	ReplicaSortKey_ReplicaLoadPercentage ReplicaSortKey = 2
+)
+
+var ReplicaSortKey_name = map[int32]string{
+	0: "DefaultReplicaOrder",
+	1: "ReplicaNodeNum",
+	2: "ReplicaLoadPercentage",
+}
+
+var ReplicaSortKey_value = map[string]int32{
+	"DefaultReplicaOrder":   0,
+	"ReplicaNodeNum":        1,
+	"ReplicaLoadPercentage": 2,
+}
+
+func (x ReplicaSortKey) String() string {
+	return proto.EnumName(ReplicaSortKey_name, int32(x))
+}
+
+func (ReplicaSortKey) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{7}
+}
+
+type ZonePlacementPolicy int32
+
+const (
+	// nodes are placed regardless of their zones
+	ZonePlacementPolicy_ZonePlacementNone ZonePlacementPolicy = 0
+	// nodes of a replica are spread across as many zones as possible
+	ZonePlacementPolicy_ZonePlacementSpread ZonePlacementPolicy = 1
+	// nodes of a replica are packed into a single zone
+	ZonePlacementPolicy_ZonePlacementPack ZonePlacementPolicy = 2
+)
+
+var ZonePlacementPolicy_name = map[int32]string{
+	0: "ZonePlacementNone",
+	1: "ZonePlacementSpread",
+	2: "ZonePlacementPack",
+}
+
+var ZonePlacementPolicy_value = map[string]int32{
+	"ZonePlacementNone":   0,
+	"ZonePlacementSpread": 1,
+	"ZonePlacementPack":   2,
+}
+
+func (x ZonePlacementPolicy) String() string {
+	return proto.EnumName(ZonePlacementPolicy_name, int32(x))
+}
+
+func (ZonePlacementPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{8}
+}
+
+type DecommissionState int32
+
+const (
+	DecommissionState_DecommissionDraining DecommissionState = 0
+	// the node serves nothing and is safe to remove
+	DecommissionState_DecommissionCompleted DecommissionState = 1
+	// the node came back healthy during draining
+	DecommissionState_DecommissionAborted DecommissionState = 2
+)
+
+var DecommissionState_name = map[int32]string{
+	0: "DecommissionDraining",
+	1: "DecommissionCompleted",
+	2: "DecommissionAborted",
+}
+
+var DecommissionState_value = map[string]int32{
+	"DecommissionDraining":  0,
+	"DecommissionCompleted": 1,
+	"DecommissionAborted":   2,
+}
+
+func (x DecommissionState) String() string {
+	return proto.EnumName(DecommissionState_name, int32(x))
+}
+
+func (DecommissionState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{9}
+}
+
+type ShowCollectionsRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// Not useful for now
+	DbID          int64   `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	CollectionIDs []int64 `protobuf:"varint,3,rep,packed,name=collectionIDs,proto3" json:"collectionIDs,omitempty"`
+	// return at most page_size collections ordered by id if positive, all collections otherwise
+	PageSize int64 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// the next_page_token of the previous page, empty for the first page
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// return the load percentage of each replica if set
+	WithReplicaDetail    bool     `protobuf:"varint,6,opt,name=with_replica_detail,json=withReplicaDetail,proto3" json:"with_replica_detail,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShowCollectionsRequest) Reset()         { *m = ShowCollectionsRequest{} }
+func (m *ShowCollectionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ShowCollectionsRequest) ProtoMessage()    {}
+func (*ShowCollectionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{0}
+}
+
+func (m *ShowCollectionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowCollectionsRequest.Unmarshal(m, b)
+}
+func (m *ShowCollectionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowCollectionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ShowCollectionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowCollectionsRequest.Merge(m, src)
+}
+func (m *ShowCollectionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ShowCollectionsRequest.Size(m)
+}
+func (m *ShowCollectionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowCollectionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShowCollectionsRequest proto.InternalMessageInfo
+
+func (m *ShowCollectionsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ShowCollectionsRequest) GetDbID() int64 {
+	if m != nil {
+		return m.DbID
+	}
+	return 0
+}
+
+func (m *ShowCollectionsRequest) GetCollectionIDs() []int64 {
+	if m != nil {
+		return m.CollectionIDs
+	}
+	return nil
+}
+
+func (m *ShowCollectionsRequest) GetPageSize() int64 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ShowCollectionsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *ShowCollectionsRequest) GetWithReplicaDetail() bool {
+	if m != nil {
+		return m.WithReplicaDetail
+	}
+	return false
+}
+
+type ShowCollectionsResponse struct {
+	Status                *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CollectionIDs         []int64          `protobuf:"varint,2,rep,packed,name=collectionIDs,proto3" json:"collectionIDs,omitempty"`
+	InMemoryPercentages   []int64          `protobuf:"varint,3,rep,packed,name=inMemory_percentages,json=inMemoryPercentages,proto3" json:"inMemory_percentages,omitempty"`
+	QueryServiceAvailable []bool           `protobuf:"varint,4,rep,packed,name=query_service_available,json=queryServiceAvailable,proto3" json:"query_service_available,omitempty"`
+	RefreshProgress       []int64          `protobuf:"varint,5,rep,packed,name=refresh_progress,json=refreshProgress,proto3" json:"refresh_progress,omitempty"`
+	// empty if there is no more collection
+	NextPageToken string `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// aligned with collectionIDs, only set if with_replica_detail is set
+	ReplicaPercentages []*ReplicaLoadPercentages `protobuf:"bytes,7,rep,name=replica_percentages,json=replicaPercentages,proto3" json:"replica_percentages,omitempty"`
+	// aligned with collectionIDs, the estimated remaining load time in milliseconds,
+	// 0 if the collection is loaded or there is no load history to estimate
+	EstimatedRemainingMs []int64  `protobuf:"varint,8,rep,packed,name=estimated_remaining_ms,json=estimatedRemainingMs,proto3" json:"estimated_remaining_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShowCollectionsResponse) Reset()         { *m = ShowCollectionsResponse{} }
+func (m *ShowCollectionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ShowCollectionsResponse) ProtoMessage()    {}
+func (*ShowCollectionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{1}
+}
+
+func (m *ShowCollectionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowCollectionsResponse.Unmarshal(m, b)
+}
+func (m *ShowCollectionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowCollectionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ShowCollectionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowCollectionsResponse.Merge(m, src)
+}
+func (m *ShowCollectionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ShowCollectionsResponse.Size(m)
+}
+func (m *ShowCollectionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowCollectionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShowCollectionsResponse proto.InternalMessageInfo
+
+func (m *ShowCollectionsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ShowCollectionsResponse) GetCollectionIDs() []int64 {
+	if m != nil {
+		return m.CollectionIDs
+	}
+	return nil
+}
+
+func (m *ShowCollectionsResponse) GetInMemoryPercentages() []int64 {
+	if m != nil {
+		return m.InMemoryPercentages
+	}
+	return nil
+}
+
+func (m *ShowCollectionsResponse) GetQueryServiceAvailable() []bool {
+	if m != nil {
+		return m.QueryServiceAvailable
+	}
+	return nil
+}
+
+func (m *ShowCollectionsResponse) GetRefreshProgress() []int64 {
+	if m != nil {
+		return m.RefreshProgress
+	}
+	return nil
+}
+
+func (m *ShowCollectionsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *ShowCollectionsResponse) GetReplicaPercentages() []*ReplicaLoadPercentages {
+	if m != nil {
+		return m.ReplicaPercentages
+	}
+	return nil
+}
+
+func (m *ShowCollectionsResponse) GetEstimatedRemainingMs() []int64 {
+	if m != nil {
+		return m.EstimatedRemainingMs
+	}
+	return nil
+}
+
+type ReplicaLoadPercentages struct {
+	// replicaID -> the percentage of target segments loaded on the nodes of the replica
+	Percentages          map[int64]int64 `protobuf:"bytes,1,rep,name=percentages,proto3" json:"percentages,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReplicaLoadPercentages) Reset()         { *m = ReplicaLoadPercentages{} }
+func (m *ReplicaLoadPercentages) String() string { return proto.CompactTextString(m) }
+func (*ReplicaLoadPercentages) ProtoMessage()    {}
+func (*ReplicaLoadPercentages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{2}
+}
+
+func (m *ReplicaLoadPercentages) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplicaLoadPercentages.Unmarshal(m, b)
+}
+func (m *ReplicaLoadPercentages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplicaLoadPercentages.Marshal(b, m, deterministic)
+}
+func (m *ReplicaLoadPercentages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicaLoadPercentages.Merge(m, src)
+}
+func (m *ReplicaLoadPercentages) XXX_Size() int {
+	return xxx_messageInfo_ReplicaLoadPercentages.Size(m)
+}
+func (m *ReplicaLoadPercentages) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicaLoadPercentages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicaLoadPercentages proto.InternalMessageInfo
+
+func (m *ReplicaLoadPercentages) GetPercentages() map[int64]int64 {
+	if m != nil {
+		return m.Percentages
+	}
+	return nil
+}
+
+type ShowPartitionsRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbID         int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	CollectionID int64             `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionIDs []int64           `protobuf:"varint,4,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	// report the failed partitions in failures instead of failing the request
+	WithFailureReasons bool `protobuf:"varint,5,opt,name=with_failure_reasons,json=withFailureReasons,proto3" json:"with_failure_reasons,omitempty"`
+	// report the status of each partition in partition_status instead of failing the request,
+	// it can't be set together with with_failure_reasons
+	WithPartitionStatus  bool     `protobuf:"varint,6,opt,name=with_partition_status,json=withPartitionStatus,proto3" json:"with_partition_status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShowPartitionsRequest) Reset()         { *m = ShowPartitionsRequest{} }
+func (m *ShowPartitionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ShowPartitionsRequest) ProtoMessage()    {}
+func (*ShowPartitionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{3}
+}
+
+func (m *ShowPartitionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowPartitionsRequest.Unmarshal(m, b)
+}
+func (m *ShowPartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowPartitionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ShowPartitionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowPartitionsRequest.Merge(m, src)
+}
+func (m *ShowPartitionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ShowPartitionsRequest.Size(m)
+}
+func (m *ShowPartitionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowPartitionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShowPartitionsRequest proto.InternalMessageInfo
+
+func (m *ShowPartitionsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ShowPartitionsRequest) GetDbID() int64 {
+	if m != nil {
+		return m.DbID
+	}
+	return 0
+}
+
+func (m *ShowPartitionsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ShowPartitionsRequest) GetPartitionIDs() []int64 {
+	if m != nil {
+		return m.PartitionIDs
+	}
+	return nil
+}
+
+func (m *ShowPartitionsRequest) GetWithFailureReasons() bool {
+	if m != nil {
+		return m.WithFailureReasons
+	}
+	return false
+}
+
+func (m *ShowPartitionsRequest) GetWithPartitionStatus() bool {
+	if m != nil {
+		return m.WithPartitionStatus
+	}
+	return false
+}
+
+type ShowPartitionsResponse struct {
+	Status              *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	PartitionIDs        []int64          `protobuf:"varint,2,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	InMemoryPercentages []int64          `protobuf:"varint,3,rep,packed,name=inMemory_percentages,json=inMemoryPercentages,proto3" json:"inMemory_percentages,omitempty"`
+	RefreshProgress     []int64          `protobuf:"varint,4,rep,packed,name=refresh_progress,json=refreshProgress,proto3" json:"refresh_progress,omitempty"`
+	// partitions failed to load, excluded from partitionIDs
+	Failures []*PartitionLoadFailure `protobuf:"bytes,5,rep,name=failures,proto3" json:"failures,omitempty"`
+	// status of each partition, parallel to partitionIDs, set only with with_partition_status
+	PartitionStatus      []*commonpb.Status `protobuf:"bytes,6,rep,name=partition_status,json=partitionStatus,proto3" json:"partition_status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ShowPartitionsResponse) Reset()         { *m = ShowPartitionsResponse{} }
+func (m *ShowPartitionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ShowPartitionsResponse) ProtoMessage()    {}
+func (*ShowPartitionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{4}
+}
+
+func (m *ShowPartitionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowPartitionsResponse.Unmarshal(m, b)
+}
+func (m *ShowPartitionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowPartitionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ShowPartitionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowPartitionsResponse.Merge(m, src)
+}
+func (m *ShowPartitionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ShowPartitionsResponse.Size(m)
+}
+func (m *ShowPartitionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowPartitionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShowPartitionsResponse proto.InternalMessageInfo
+
+func (m *ShowPartitionsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ShowPartitionsResponse) GetPartitionIDs() []int64 {
+	if m != nil {
+		return m.PartitionIDs
+	}
+	return nil
+}
+
+func (m *ShowPartitionsResponse) GetInMemoryPercentages() []int64 {
+	if m != nil {
+		return m.InMemoryPercentages
+	}
+	return nil
+}
+
+func (m *ShowPartitionsResponse) GetRefreshProgress() []int64 {
+	if m != nil {
+		return m.RefreshProgress
+	}
+	return nil
+}
+
+func (m *ShowPartitionsResponse) GetFailures() []*PartitionLoadFailure {
+	if m != nil {
+		return m.Failures
+	}
+	return nil
+}
+
+func (m *ShowPartitionsResponse) GetPartitionStatus() []*commonpb.Status {
+	if m != nil {
+		return m.PartitionStatus
+	}
+	return nil
+}
+
+type LoadCollectionRequest struct {
+	Base          *commonpb.MsgBase          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbID          int64                      `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	CollectionID  int64                      `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Schema        *schemapb.CollectionSchema `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	ReplicaNumber int32                      `protobuf:"varint,5,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
+	// fieldID -> indexID
+	FieldIndexID map[int64]int64 `protobuf:"bytes,6,rep,name=field_indexID,json=fieldIndexID,proto3" json:"field_indexID,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Refresh      bool            `protobuf:"varint,7,opt,name=refresh,proto3" json:"refresh,omitempty"`
+	// resource group names
+	ResourceGroups []string `protobuf:"bytes,8,rep,name=resource_groups,json=resourceGroups,proto3" json:"resource_groups,omitempty"`
+	// load as many replicas as resource groups can hold if nodes are not enough
+	BestEffort bool `protobuf:"varint,9,opt,name=best_effort,json=bestEffort,proto3" json:"best_effort,omitempty"`
+	// number of replicas kept as warm standby, should be less than replica_number
+	StandbyReplicaNumber int32 `protobuf:"varint,10,opt,name=standby_replica_number,json=standbyReplicaNumber,proto3" json:"standby_replica_number,omitempty"`
+	// collections with lower priority are evicted first from memory-pressured nodes,
+	// and queued load jobs with higher priority are scheduled first
+	Priority int32 `protobuf:"varint,11,opt,name=priority,proto3" json:"priority,omitempty"`
+	// replicas of collections with different tenants never share query nodes
+	Tenant string `protobuf:"bytes,13,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// wait for enough memory headroom of the cluster instead of rejecting the load
+	AutoRetry bool `protobuf:"varint,14,opt,name=auto_retry,json=autoRetry,proto3" json:"auto_retry,omitempty"`
+	// block until the new target is fully loaded when refreshing,
+	// otherwise return immediately and the progress could be polled by RefreshProgress
+	WaitRefresh bool `protobuf:"varint,15,opt,name=wait_refresh,json=waitRefresh,proto3" json:"wait_refresh,omitempty"`
+	// how the nodes of each replica are placed across availability zones
+	ZonePlacement ZonePlacementPolicy `protobuf:"varint,16,opt,name=zone_placement,json=zonePlacement,proto3,enum=milvus.proto.query.ZonePlacementPolicy" json:"zone_placement,omitempty"`
+	// name of the balancer used to balance the collection, use the global one if empty
+	BalancePolicy string `protobuf:"bytes,17,opt,name=balance_policy,json=balancePolicy,proto3" json:"balance_policy,omitempty"`
+	// segment id -> node id, the hinted segments are only loaded to the given nodes,
+	// in the replicas containing the nodes
+	SegmentNodeHints map[int64]int64 `protobuf:"bytes,18,rep,name=segment_node_hints,json=segmentNodeHints,proto3" json:"segment_node_hints,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// require the replicas to be spread evenly across the given resource groups
+	SpreadReplicasEvenly bool     `protobuf:"varint,19,opt,name=spread_replicas_evenly,json=spreadReplicasEvenly,proto3" json:"spread_replicas_evenly,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoadCollectionRequest) Reset()         { *m = LoadCollectionRequest{} }
+func (m *LoadCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*LoadCollectionRequest) ProtoMessage()    {}
+func (*LoadCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{5}
+}
+
+func (m *LoadCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadCollectionRequest.Unmarshal(m, b)
+}
+func (m *LoadCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *LoadCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadCollectionRequest.Merge(m, src)
+}
+func (m *LoadCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_LoadCollectionRequest.Size(m)
+}
+func (m *LoadCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadCollectionRequest proto.InternalMessageInfo
+
+func (m *LoadCollectionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *LoadCollectionRequest) GetDbID() int64 {
+	if m != nil {
+		return m.DbID
+	}
+	return 0
+}
+
+func (m *LoadCollectionRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *LoadCollectionRequest) GetSchema() *schemapb.CollectionSchema {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+func (m *LoadCollectionRequest) GetReplicaNumber() int32 {
+	if m != nil {
+		return m.ReplicaNumber
+	}
+	return 0
+}
+
+func (m *LoadCollectionRequest) GetFieldIndexID() map[int64]int64 {
+	if m != nil {
+		return m.FieldIndexID
+	}
+	return nil
+}
+
+func (m *LoadCollectionRequest) GetRefresh() bool {
+	if m != nil {
+		return m.Refresh
+	}
+	return false
+}
+
+func (m *LoadCollectionRequest) GetResourceGroups() []string {
+	if m != nil {
+		return m.ResourceGroups
+	}
+	return nil
+}
+
+func (m *LoadCollectionRequest) GetBestEffort() bool {
+	if m != nil {
+		return m.BestEffort
+	}
+	return false
+}
+
+func (m *LoadCollectionRequest) GetStandbyReplicaNumber() int32 {
+	if m != nil {
+		return m.StandbyReplicaNumber
+	}
+	return 0
+}
+
+func (m *LoadCollectionRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *LoadCollectionRequest) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+func (m *LoadCollectionRequest) GetAutoRetry() bool {
+	if m != nil {
+		return m.AutoRetry
+	}
+	return false
+}
+
+func (m *LoadCollectionRequest) GetWaitRefresh() bool {
+	if m != nil {
+		return m.WaitRefresh
+	}
+	return false
+}
+
+func (m *LoadCollectionRequest) GetZonePlacement() ZonePlacementPolicy {
+	if m != nil {
+		return m.ZonePlacement
+	}
+	return ZonePlacementPolicy_ZonePlacementNone
+}
+
+func (m *LoadCollectionRequest) GetBalancePolicy() string {
+	if m != nil {
+		return m.BalancePolicy
+	}
+	return ""
+}
+
+func (m *LoadCollectionRequest) GetSegmentNodeHints() map[int64]int64 {
+	if m != nil {
+		return m.SegmentNodeHints
+	}
+	return nil
+}
+
+func (m *LoadCollectionRequest) GetSpreadReplicasEvenly() bool {
+	if m != nil {
+		return m.SpreadReplicasEvenly
+	}
+	return false
+}
+
+type ReleaseCollectionRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbID         int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	CollectionID int64             `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	NodeID       int64             `protobuf:"varint,4,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	// if positive, the collection is removed from distribution forcibly after the timeout(in ms),
+	// even if some nodes haven't released it
+	ForceReleaseTimeoutMs int64    `protobuf:"varint,5,opt,name=force_release_timeout_ms,json=forceReleaseTimeoutMs,proto3" json:"force_release_timeout_ms,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *ReleaseCollectionRequest) Reset()         { *m = ReleaseCollectionRequest{} }
+func (m *ReleaseCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleaseCollectionRequest) ProtoMessage()    {}
+func (*ReleaseCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{6}
+}
+
+func (m *ReleaseCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseCollectionRequest.Unmarshal(m, b)
+}
+func (m *ReleaseCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *ReleaseCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseCollectionRequest.Merge(m, src)
+}
+func (m *ReleaseCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_ReleaseCollectionRequest.Size(m)
+}
+func (m *ReleaseCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseCollectionRequest proto.InternalMessageInfo
+
+func (m *ReleaseCollectionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ReleaseCollectionRequest) GetDbID() int64 {
+	if m != nil {
+		return m.DbID
+	}
+	return 0
+}
+
+func (m *ReleaseCollectionRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ReleaseCollectionRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *ReleaseCollectionRequest) GetForceReleaseTimeoutMs() int64 {
+	if m != nil {
+		return m.ForceReleaseTimeoutMs
+	}
+	return 0
+}
+
+type GetStatisticsRequest struct {
+	Req                  *internalpb.GetStatisticsRequest `protobuf:"bytes,1,opt,name=req,proto3" json:"req,omitempty"`
+	DmlChannels          []string                         `protobuf:"bytes,2,rep,name=dml_channels,json=dmlChannels,proto3" json:"dml_channels,omitempty"`
+	SegmentIDs           []int64                          `protobuf:"varint,3,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	FromShardLeader      bool                             `protobuf:"varint,4,opt,name=from_shard_leader,json=fromShardLeader,proto3" json:"from_shard_leader,omitempty"`
+	Scope                DataScope                        `protobuf:"varint,5,opt,name=scope,proto3,enum=milvus.proto.query.DataScope" json:"scope,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *GetStatisticsRequest) Reset()         { *m = GetStatisticsRequest{} }
+func (m *GetStatisticsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetStatisticsRequest) ProtoMessage()    {}
+func (*GetStatisticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{7}
+}
+
+func (m *GetStatisticsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStatisticsRequest.Unmarshal(m, b)
+}
+func (m *GetStatisticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStatisticsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetStatisticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStatisticsRequest.Merge(m, src)
+}
+func (m *GetStatisticsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetStatisticsRequest.Size(m)
+}
+func (m *GetStatisticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStatisticsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStatisticsRequest proto.InternalMessageInfo
+
+func (m *GetStatisticsRequest) GetReq() *internalpb.GetStatisticsRequest {
+	if m != nil {
+		return m.Req
+	}
+	return nil
+}
+
+func (m *GetStatisticsRequest) GetDmlChannels() []string {
+	if m != nil {
+		return m.DmlChannels
+	}
+	return nil
+}
+
+func (m *GetStatisticsRequest) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+func (m *GetStatisticsRequest) GetFromShardLeader() bool {
+	if m != nil {
+		return m.FromShardLeader
+	}
+	return false
+}
+
+func (m *GetStatisticsRequest) GetScope() DataScope {
+	if m != nil {
+		return m.Scope
+	}
+	return DataScope_UnKnown
+}
+
+type LoadPartitionsRequest struct {
+	Base          *commonpb.MsgBase          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbID          int64                      `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	CollectionID  int64                      `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionIDs  []int64                    `protobuf:"varint,4,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	Schema        *schemapb.CollectionSchema `protobuf:"bytes,5,opt,name=schema,proto3" json:"schema,omitempty"`
+	ReplicaNumber int32                      `protobuf:"varint,6,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
+	// fieldID -> indexID
+	FieldIndexID map[int64]int64 `protobuf:"bytes,7,rep,name=field_indexID,json=fieldIndexID,proto3" json:"field_indexID,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Refresh      bool            `protobuf:"varint,8,opt,name=refresh,proto3" json:"refresh,omitempty"`
+	// resource group names
+	ResourceGroups []string             `protobuf:"bytes,9,rep,name=resource_groups,json=resourceGroups,proto3" json:"resource_groups,omitempty"`
+	IndexInfoList  []*indexpb.IndexInfo `protobuf:"bytes,10,rep,name=index_info_list,json=indexInfoList,proto3" json:"index_info_list,omitempty"`
+	// wait for enough memory headroom of the cluster instead of rejecting the load
+	AutoRetry bool `protobuf:"varint,11,opt,name=auto_retry,json=autoRetry,proto3" json:"auto_retry,omitempty"`
+	// block until the new target is fully loaded when refreshing,
+	// otherwise return immediately and the progress could be polled by RefreshProgress
+	WaitRefresh bool `protobuf:"varint,12,opt,name=wait_refresh,json=waitRefresh,proto3" json:"wait_refresh,omitempty"`
+	// queued load jobs with higher priority are scheduled first,
+	// the priority of the loaded collection is used if not set
+	Priority             int32    `protobuf:"varint,13,opt,name=priority,proto3" json:"priority,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoadPartitionsRequest) Reset()         { *m = LoadPartitionsRequest{} }
+func (m *LoadPartitionsRequest) String() string { return proto.CompactTextString(m) }
+func (*LoadPartitionsRequest) ProtoMessage()    {}
+func (*LoadPartitionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{8}
+}
+
+func (m *LoadPartitionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadPartitionsRequest.Unmarshal(m, b)
+}
+func (m *LoadPartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadPartitionsRequest.Marshal(b, m, deterministic)
+}
+func (m *LoadPartitionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadPartitionsRequest.Merge(m, src)
+}
+func (m *LoadPartitionsRequest) XXX_Size() int {
+	return xxx_messageInfo_LoadPartitionsRequest.Size(m)
+}
+func (m *LoadPartitionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadPartitionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadPartitionsRequest proto.InternalMessageInfo
+
+func (m *LoadPartitionsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *LoadPartitionsRequest) GetDbID() int64 {
+	if m != nil {
+		return m.DbID
+	}
+	return 0
+}
+
+func (m *LoadPartitionsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *LoadPartitionsRequest) GetPartitionIDs() []int64 {
+	if m != nil {
+		return m.PartitionIDs
+	}
+	return nil
+}
+
+func (m *LoadPartitionsRequest) GetSchema() *schemapb.CollectionSchema {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+func (m *LoadPartitionsRequest) GetReplicaNumber() int32 {
+	if m != nil {
+		return m.ReplicaNumber
+	}
+	return 0
+}
+
+func (m *LoadPartitionsRequest) GetFieldIndexID() map[int64]int64 {
+	if m != nil {
+		return m.FieldIndexID
+	}
+	return nil
+}
+
+func (m *LoadPartitionsRequest) GetRefresh() bool {
+	if m != nil {
+		return m.Refresh
+	}
+	return false
+}
+
+func (m *LoadPartitionsRequest) GetResourceGroups() []string {
+	if m != nil {
+		return m.ResourceGroups
+	}
+	return nil
+}
+
+func (m *LoadPartitionsRequest) GetIndexInfoList() []*indexpb.IndexInfo {
+	if m != nil {
+		return m.IndexInfoList
+	}
+	return nil
+}
+
+func (m *LoadPartitionsRequest) GetAutoRetry() bool {
+	if m != nil {
+		return m.AutoRetry
+	}
+	return false
+}
+
+func (m *LoadPartitionsRequest) GetWaitRefresh() bool {
+	if m != nil {
+		return m.WaitRefresh
+	}
+	return false
+}
+
+func (m *LoadPartitionsRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+type ReleasePartitionsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbID                 int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	CollectionID         int64             `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionIDs         []int64           `protobuf:"varint,4,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	NodeID               int64             `protobuf:"varint,5,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ReleasePartitionsRequest) Reset()         { *m = ReleasePartitionsRequest{} }
+func (m *ReleasePartitionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleasePartitionsRequest) ProtoMessage()    {}
+func (*ReleasePartitionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{9}
+}
+
+func (m *ReleasePartitionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleasePartitionsRequest.Unmarshal(m, b)
+}
+func (m *ReleasePartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleasePartitionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ReleasePartitionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleasePartitionsRequest.Merge(m, src)
+}
+func (m *ReleasePartitionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ReleasePartitionsRequest.Size(m)
+}
+func (m *ReleasePartitionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleasePartitionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleasePartitionsRequest proto.InternalMessageInfo
+
+func (m *ReleasePartitionsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ReleasePartitionsRequest) GetDbID() int64 {
+	if m != nil {
+		return m.DbID
+	}
+	return 0
+}
+
+func (m *ReleasePartitionsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ReleasePartitionsRequest) GetPartitionIDs() []int64 {
+	if m != nil {
+		return m.PartitionIDs
+	}
+	return nil
+}
+
+func (m *ReleasePartitionsRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+type GetPartitionStatesRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbID                 int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	CollectionID         int64             `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionIDs         []int64           `protobuf:"varint,4,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetPartitionStatesRequest) Reset()         { *m = GetPartitionStatesRequest{} }
+func (m *GetPartitionStatesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPartitionStatesRequest) ProtoMessage()    {}
+func (*GetPartitionStatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{10}
+}
+
+func (m *GetPartitionStatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPartitionStatesRequest.Unmarshal(m, b)
+}
+func (m *GetPartitionStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPartitionStatesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPartitionStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPartitionStatesRequest.Merge(m, src)
+}
+func (m *GetPartitionStatesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPartitionStatesRequest.Size(m)
+}
+func (m *GetPartitionStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPartitionStatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPartitionStatesRequest proto.InternalMessageInfo
+
+func (m *GetPartitionStatesRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetPartitionStatesRequest) GetDbID() int64 {
+	if m != nil {
+		return m.DbID
+	}
+	return 0
+}
+
+func (m *GetPartitionStatesRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *GetPartitionStatesRequest) GetPartitionIDs() []int64 {
+	if m != nil {
+		return m.PartitionIDs
+	}
+	return nil
+}
+
+type GetPartitionStatesResponse struct {
+	Status                *commonpb.Status   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	PartitionDescriptions []*PartitionStates `protobuf:"bytes,2,rep,name=partition_descriptions,json=partitionDescriptions,proto3" json:"partition_descriptions,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}           `json:"-"`
+	XXX_unrecognized      []byte             `json:"-"`
+	XXX_sizecache         int32              `json:"-"`
+}
+
+func (m *GetPartitionStatesResponse) Reset()         { *m = GetPartitionStatesResponse{} }
+func (m *GetPartitionStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPartitionStatesResponse) ProtoMessage()    {}
+func (*GetPartitionStatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{11}
+}
+
+func (m *GetPartitionStatesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPartitionStatesResponse.Unmarshal(m, b)
+}
+func (m *GetPartitionStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPartitionStatesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPartitionStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPartitionStatesResponse.Merge(m, src)
+}
+func (m *GetPartitionStatesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPartitionStatesResponse.Size(m)
+}
+func (m *GetPartitionStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPartitionStatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPartitionStatesResponse proto.InternalMessageInfo
+
+func (m *GetPartitionStatesResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetPartitionStatesResponse) GetPartitionDescriptions() []*PartitionStates {
+	if m != nil {
+		return m.PartitionDescriptions
+	}
+	return nil
+}
+
+type GetSegmentInfoRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SegmentIDs   []int64           `protobuf:"varint,2,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	CollectionID int64             `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// list the sealed segments of the channel, ignored if segmentIDs given
+	Channel              string   `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSegmentInfoRequest) Reset()         { *m = GetSegmentInfoRequest{} }
+func (m *GetSegmentInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSegmentInfoRequest) ProtoMessage()    {}
+func (*GetSegmentInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{12}
+}
+
+func (m *GetSegmentInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSegmentInfoRequest.Unmarshal(m, b)
+}
+func (m *GetSegmentInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSegmentInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *GetSegmentInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSegmentInfoRequest.Merge(m, src)
+}
+func (m *GetSegmentInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSegmentInfoRequest.Size(m)
+}
+func (m *GetSegmentInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSegmentInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSegmentInfoRequest proto.InternalMessageInfo
+
+func (m *GetSegmentInfoRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetSegmentInfoRequest) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+func (m *GetSegmentInfoRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *GetSegmentInfoRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+type GetSegmentInfoResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Infos                []*SegmentInfo   `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetSegmentInfoResponse) Reset()         { *m = GetSegmentInfoResponse{} }
+func (m *GetSegmentInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSegmentInfoResponse) ProtoMessage()    {}
+func (*GetSegmentInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{13}
+}
+
+func (m *GetSegmentInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSegmentInfoResponse.Unmarshal(m, b)
+}
+func (m *GetSegmentInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSegmentInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *GetSegmentInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSegmentInfoResponse.Merge(m, src)
+}
+func (m *GetSegmentInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSegmentInfoResponse.Size(m)
+}
+func (m *GetSegmentInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSegmentInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSegmentInfoResponse proto.InternalMessageInfo
+
+func (m *GetSegmentInfoResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetSegmentInfoResponse) GetInfos() []*SegmentInfo {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+type GetShardLeadersRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// only return leaders on nodes of given resource group if set
+	ResourceGroup string `protobuf:"bytes,3,opt,name=resource_group,json=resourceGroup,proto3" json:"resource_group,omitempty"`
+	// prefer leaders on nodes in the given zone, fallback to other zones if none available
+	PreferZone string `protobuf:"bytes,4,opt,name=prefer_zone,json=preferZone,proto3" json:"prefer_zone,omitempty"`
+	// wait up to the timeout(in milliseconds) for all channels to have readable leaders,
+	// the queryCoord.shardLeaderWaitTimeout is used if zero, no wait if negative
+	WaitTimeout int64 `protobuf:"varint,5,opt,name=wait_timeout,json=waitTimeout,proto3" json:"wait_timeout,omitempty"`
+	// return the growing segment freshness of each leader if set
+	WithGrowingFreshness bool `protobuf:"varint,6,opt,name=with_growing_freshness,json=withGrowingFreshness,proto3" json:"with_growing_freshness,omitempty"`
+	// return the number of readable replicas of each shard and the whole collection if set
+	WithReadableReplicaCount bool `protobuf:"varint,7,opt,name=with_readable_replica_count,json=withReadableReplicaCount,proto3" json:"with_readable_replica_count,omitempty"`
+	// return the errors recently reported by leaders if set
+	WithLeaderErrors bool `protobuf:"varint,8,opt,name=with_leader_errors,json=withLeaderErrors,proto3" json:"with_leader_errors,omitempty"`
+	// only return leaders of the given replica if set, never fallback to other replicas
+	ReplicaID int64 `protobuf:"varint,9,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
+	// omit the channels blocked by operators instead of failing if set, the caller must route around them
+	AllowBlockedChannels bool     `protobuf:"varint,10,opt,name=allow_blocked_channels,json=allowBlockedChannels,proto3" json:"allow_blocked_channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetShardLeadersRequest) Reset()         { *m = GetShardLeadersRequest{} }
+func (m *GetShardLeadersRequest) String() string { return proto.CompactTextString(m) }
+func (*GetShardLeadersRequest) ProtoMessage()    {}
+func (*GetShardLeadersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{14}
+}
+
+func (m *GetShardLeadersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetShardLeadersRequest.Unmarshal(m, b)
+}
+func (m *GetShardLeadersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetShardLeadersRequest.Marshal(b, m, deterministic)
+}
+func (m *GetShardLeadersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShardLeadersRequest.Merge(m, src)
+}
+func (m *GetShardLeadersRequest) XXX_Size() int {
+	return xxx_messageInfo_GetShardLeadersRequest.Size(m)
+}
+func (m *GetShardLeadersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShardLeadersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetShardLeadersRequest proto.InternalMessageInfo
+
+func (m *GetShardLeadersRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetShardLeadersRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *GetShardLeadersRequest) GetResourceGroup() string {
+	if m != nil {
+		return m.ResourceGroup
+	}
+	return ""
+}
+
+func (m *GetShardLeadersRequest) GetPreferZone() string {
+	if m != nil {
+		return m.PreferZone
+	}
+	return ""
+}
+
+func (m *GetShardLeadersRequest) GetWaitTimeout() int64 {
+	if m != nil {
+		return m.WaitTimeout
+	}
+	return 0
+}
+
+func (m *GetShardLeadersRequest) GetWithGrowingFreshness() bool {
+	if m != nil {
+		return m.WithGrowingFreshness
+	}
+	return false
+}
+
+func (m *GetShardLeadersRequest) GetWithReadableReplicaCount() bool {
+	if m != nil {
+		return m.WithReadableReplicaCount
+	}
+	return false
+}
+
+func (m *GetShardLeadersRequest) GetWithLeaderErrors() bool {
+	if m != nil {
+		return m.WithLeaderErrors
+	}
+	return false
+}
+
+func (m *GetShardLeadersRequest) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
+func (m *GetShardLeadersRequest) GetAllowBlockedChannels() bool {
+	if m != nil {
+		return m.AllowBlockedChannels
+	}
+	return false
+}
+
+type GetShardLeadersResponse struct {
+	Status *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Shards []*ShardLeadersList `protobuf:"bytes,2,rep,name=shards,proto3" json:"shards,omitempty"`
+	// channels without any readable leader
+	UnavailableChannels []string `protobuf:"bytes,4,rep,name=unavailable_channels,json=unavailableChannels,proto3" json:"unavailable_channels,omitempty"`
+	// bumped on any shard leader or target change, shard leaders with the same generation are consistent
+	RoutingGeneration int64 `protobuf:"varint,5,opt,name=routing_generation,json=routingGeneration,proto3" json:"routing_generation,omitempty"`
+	// channels blocked by operators, which are omitted from shards intentionally
+	BlockedChannels []string `protobuf:"bytes,6,rep,name=blocked_channels,json=blockedChannels,proto3" json:"blocked_channels,omitempty"`
+	// the number of replicas with available leaders of all serving channels,
+	// only set if with_readable_replica_count is set
+	ReadableReplicaCount int32    `protobuf:"varint,7,opt,name=readable_replica_count,json=readableReplicaCount,proto3" json:"readable_replica_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetShardLeadersResponse) Reset()         { *m = GetShardLeadersResponse{} }
+func (m *GetShardLeadersResponse) String() string { return proto.CompactTextString(m) }
+func (*GetShardLeadersResponse) ProtoMessage()    {}
+func (*GetShardLeadersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{15}
+}
+
+func (m *GetShardLeadersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetShardLeadersResponse.Unmarshal(m, b)
+}
+func (m *GetShardLeadersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetShardLeadersResponse.Marshal(b, m, deterministic)
+}
+func (m *GetShardLeadersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShardLeadersResponse.Merge(m, src)
+}
+func (m *GetShardLeadersResponse) XXX_Size() int {
+	return xxx_messageInfo_GetShardLeadersResponse.Size(m)
+}
+func (m *GetShardLeadersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShardLeadersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetShardLeadersResponse proto.InternalMessageInfo
+
+func (m *GetShardLeadersResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetShardLeadersResponse) GetShards() []*ShardLeadersList {
+	if m != nil {
+		return m.Shards
+	}
+	return nil
+}
+
+func (m *GetShardLeadersResponse) GetUnavailableChannels() []string {
+	if m != nil {
+		return m.UnavailableChannels
+	}
+	return nil
+}
+
+func (m *GetShardLeadersResponse) GetRoutingGeneration() int64 {
+	if m != nil {
+		return m.RoutingGeneration
+	}
+	return 0
+}
+
+func (m *GetShardLeadersResponse) GetBlockedChannels() []string {
+	if m != nil {
+		return m.BlockedChannels
+	}
+	return nil
+}
+
+func (m *GetShardLeadersResponse) GetReadableReplicaCount() int32 {
+	if m != nil {
+		return m.ReadableReplicaCount
+	}
+	return 0
+}
+
+type UpdateResourceGroupsRequest struct {
+	Base                 *commonpb.MsgBase                    `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ResourceGroups       map[string]*rgpb.ResourceGroupConfig `protobuf:"bytes,2,rep,name=resource_groups,json=resourceGroups,proto3" json:"resource_groups,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
+	XXX_unrecognized     []byte                               `json:"-"`
+	XXX_sizecache        int32                                `json:"-"`
+}
+
+func (m *UpdateResourceGroupsRequest) Reset()         { *m = UpdateResourceGroupsRequest{} }
+func (m *UpdateResourceGroupsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateResourceGroupsRequest) ProtoMessage()    {}
+func (*UpdateResourceGroupsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{16}
+}
+
+func (m *UpdateResourceGroupsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateResourceGroupsRequest.Unmarshal(m, b)
+}
+func (m *UpdateResourceGroupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateResourceGroupsRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateResourceGroupsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateResourceGroupsRequest.Merge(m, src)
+}
+func (m *UpdateResourceGroupsRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateResourceGroupsRequest.Size(m)
+}
+func (m *UpdateResourceGroupsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateResourceGroupsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateResourceGroupsRequest proto.InternalMessageInfo
+
+func (m *UpdateResourceGroupsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *UpdateResourceGroupsRequest) GetResourceGroups() map[string]*rgpb.ResourceGroupConfig {
+	if m != nil {
+		return m.ResourceGroups
+	}
+	return nil
+}
+
+type ShardLeadersList struct {
+	ChannelName string   `protobuf:"bytes,1,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	NodeIds     []int64  `protobuf:"varint,2,rep,packed,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	NodeAddrs   []string `protobuf:"bytes,3,rep,name=node_addrs,json=nodeAddrs,proto3" json:"node_addrs,omitempty"`
+	// true if prefer_zone is set but no leader of the shard is available in the zone
+	ZoneFallback bool `protobuf:"varint,4,opt,name=zone_fallback,json=zoneFallback,proto3" json:"zone_fallback,omitempty"`
+	// only set if with_growing_freshness is set, aligned with node_ids
+	GrowingRowNums []int64 `protobuf:"varint,5,rep,packed,name=growing_row_nums,json=growingRowNums,proto3" json:"growing_row_nums,omitempty"`
+	// the latest start timestamp of growing segments on each leader, aligned with node_ids
+	GrowingTimestamps []uint64 `protobuf:"varint,6,rep,packed,name=growing_timestamps,json=growingTimestamps,proto3" json:"growing_timestamps,omitempty"`
+	// only set if with_readable_replica_count is set,
+	// the number of distinct replicas which have a readable leader of the shard
+	ReadableReplicaCount int32 `protobuf:"varint,7,opt,name=readable_replica_count,json=readableReplicaCount,proto3" json:"readable_replica_count,omitempty"`
+	// only set if with_leader_errors is set, the leaders which reported errors in the latest health check
+	LeaderErrors []*LeaderServingError `protobuf:"bytes,8,rep,name=leader_errors,json=leaderErrors,proto3" json:"leader_errors,omitempty"`
+	// the number of available leaders of the shard before the duplicated leaders of a replica are collapsed
+	ReadableLeaderNum    int32    `protobuf:"varint,9,opt,name=readable_leader_num,json=readableLeaderNum,proto3" json:"readable_leader_num,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShardLeadersList) Reset()         { *m = ShardLeadersList{} }
+func (m *ShardLeadersList) String() string { return proto.CompactTextString(m) }
+func (*ShardLeadersList) ProtoMessage()    {}
+func (*ShardLeadersList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{17}
+}
+
+func (m *ShardLeadersList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardLeadersList.Unmarshal(m, b)
+}
+func (m *ShardLeadersList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardLeadersList.Marshal(b, m, deterministic)
+}
+func (m *ShardLeadersList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardLeadersList.Merge(m, src)
+}
+func (m *ShardLeadersList) XXX_Size() int {
+	return xxx_messageInfo_ShardLeadersList.Size(m)
+}
+func (m *ShardLeadersList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardLeadersList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardLeadersList proto.InternalMessageInfo
+
+func (m *ShardLeadersList) GetChannelName() string {
+	if m != nil {
+		return m.ChannelName
+	}
+	return ""
+}
+
+func (m *ShardLeadersList) GetNodeIds() []int64 {
+	if m != nil {
+		return m.NodeIds
+	}
+	return nil
+}
+
+func (m *ShardLeadersList) GetNodeAddrs() []string {
+	if m != nil {
+		return m.NodeAddrs
+	}
+	return nil
+}
+
+func (m *ShardLeadersList) GetZoneFallback() bool {
+	if m != nil {
+		return m.ZoneFallback
+	}
+	return false
+}
+
+func (m *ShardLeadersList) GetGrowingRowNums() []int64 {
+	if m != nil {
+		return m.GrowingRowNums
+	}
+	return nil
+}
+
+func (m *ShardLeadersList) GetGrowingTimestamps() []uint64 {
+	if m != nil {
+		return m.GrowingTimestamps
+	}
+	return nil
+}
+
+func (m *ShardLeadersList) GetReadableReplicaCount() int32 {
+	if m != nil {
+		return m.ReadableReplicaCount
+	}
+	return 0
+}
+
+func (m *ShardLeadersList) GetLeaderErrors() []*LeaderServingError {
+	if m != nil {
+		return m.LeaderErrors
+	}
+	return nil
+}
+
+func (m *ShardLeadersList) GetReadableLeaderNum() int32 {
+	if m != nil {
+		return m.ReadableLeaderNum
+	}
+	return 0
+}
+
+// the error reported by a leader which is still regarded as available
+type LeaderServingError struct {
+	NodeID int64  `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// unix time in milliseconds when the error is reported
+	Timestamp            int64    `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeaderServingError) Reset()         { *m = LeaderServingError{} }
+func (m *LeaderServingError) String() string { return proto.CompactTextString(m) }
+func (*LeaderServingError) ProtoMessage()    {}
+func (*LeaderServingError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{18}
+}
+
+func (m *LeaderServingError) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaderServingError.Unmarshal(m, b)
+}
+func (m *LeaderServingError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaderServingError.Marshal(b, m, deterministic)
+}
+func (m *LeaderServingError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaderServingError.Merge(m, src)
+}
+func (m *LeaderServingError) XXX_Size() int {
+	return xxx_messageInfo_LeaderServingError.Size(m)
+}
+func (m *LeaderServingError) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaderServingError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaderServingError proto.InternalMessageInfo
+
+func (m *LeaderServingError) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *LeaderServingError) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *LeaderServingError) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type SyncNewCreatedPartitionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64             `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SyncNewCreatedPartitionRequest) Reset()         { *m = SyncNewCreatedPartitionRequest{} }
+func (m *SyncNewCreatedPartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*SyncNewCreatedPartitionRequest) ProtoMessage()    {}
+func (*SyncNewCreatedPartitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{19}
+}
+
+func (m *SyncNewCreatedPartitionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncNewCreatedPartitionRequest.Unmarshal(m, b)
+}
+func (m *SyncNewCreatedPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncNewCreatedPartitionRequest.Marshal(b, m, deterministic)
+}
+func (m *SyncNewCreatedPartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncNewCreatedPartitionRequest.Merge(m, src)
+}
+func (m *SyncNewCreatedPartitionRequest) XXX_Size() int {
+	return xxx_messageInfo_SyncNewCreatedPartitionRequest.Size(m)
+}
+func (m *SyncNewCreatedPartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncNewCreatedPartitionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncNewCreatedPartitionRequest proto.InternalMessageInfo
+
+func (m *SyncNewCreatedPartitionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SyncNewCreatedPartitionRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *SyncNewCreatedPartitionRequest) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+type LoadMetaInfo struct {
+	LoadType             LoadType `protobuf:"varint,1,opt,name=load_type,json=loadType,proto3,enum=milvus.proto.query.LoadType" json:"load_type,omitempty"`
+	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionIDs         []int64  `protobuf:"varint,3,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	MetricType           string   `protobuf:"bytes,4,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"` // Deprecated: Do not use.
+	DbName               string   `protobuf:"bytes,5,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	ResourceGroup        string   `protobuf:"bytes,6,opt,name=resource_group,json=resourceGroup,proto3" json:"resource_group,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoadMetaInfo) Reset()         { *m = LoadMetaInfo{} }
+func (m *LoadMetaInfo) String() string { return proto.CompactTextString(m) }
+func (*LoadMetaInfo) ProtoMessage()    {}
+func (*LoadMetaInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{20}
+}
+
+func (m *LoadMetaInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadMetaInfo.Unmarshal(m, b)
+}
+func (m *LoadMetaInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadMetaInfo.Marshal(b, m, deterministic)
+}
+func (m *LoadMetaInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadMetaInfo.Merge(m, src)
+}
+func (m *LoadMetaInfo) XXX_Size() int {
+	return xxx_messageInfo_LoadMetaInfo.Size(m)
+}
+func (m *LoadMetaInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadMetaInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadMetaInfo proto.InternalMessageInfo
+
+func (m *LoadMetaInfo) GetLoadType() LoadType {
+	if m != nil {
+		return m.LoadType
+	}
+	return LoadType_UnKnownType
+}
+
+func (m *LoadMetaInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *LoadMetaInfo) GetPartitionIDs() []int64 {
+	if m != nil {
+		return m.PartitionIDs
+	}
+	return nil
+}
+
+// Deprecated: Do not use.
+func (m *LoadMetaInfo) GetMetricType() string {
+	if m != nil {
+		return m.MetricType
+	}
+	return ""
+}
+
+func (m *LoadMetaInfo) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *LoadMetaInfo) GetResourceGroup() string {
+	if m != nil {
+		return m.ResourceGroup
+	}
+	return ""
+}
+
+type WatchDmChannelsRequest struct {
+	Base         *commonpb.MsgBase             `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	NodeID       int64                         `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	CollectionID int64                         `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionIDs []int64                       `protobuf:"varint,4,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	Infos        []*datapb.VchannelInfo        `protobuf:"bytes,5,rep,name=infos,proto3" json:"infos,omitempty"`
+	Schema       *schemapb.CollectionSchema    `protobuf:"bytes,6,opt,name=schema,proto3" json:"schema,omitempty"`
+	ExcludeInfos []*datapb.SegmentInfo         `protobuf:"bytes,7,rep,name=exclude_infos,json=excludeInfos,proto3" json:"exclude_infos,omitempty"`
+	LoadMeta     *LoadMetaInfo                 `protobuf:"bytes,8,opt,name=load_meta,json=loadMeta,proto3" json:"load_meta,omitempty"`
+	ReplicaID    int64                         `protobuf:"varint,9,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
+	SegmentInfos map[int64]*datapb.SegmentInfo `protobuf:"bytes,10,rep,name=segment_infos,json=segmentInfos,proto3" json:"segment_infos,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Deprecated
+	// for node down load balance, need to remove offline node in time after every watchDmChannel finish.
+	OfflineNodeID        int64                `protobuf:"varint,11,opt,name=offlineNodeID,proto3" json:"offlineNodeID,omitempty"`
+	Version              int64                `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"`
+	IndexInfoList        []*indexpb.IndexInfo `protobuf:"bytes,13,rep,name=index_info_list,json=indexInfoList,proto3" json:"index_info_list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *WatchDmChannelsRequest) Reset()         { *m = WatchDmChannelsRequest{} }
+func (m *WatchDmChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchDmChannelsRequest) ProtoMessage()    {}
+func (*WatchDmChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{21}
+}
+
+func (m *WatchDmChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchDmChannelsRequest.Unmarshal(m, b)
+}
+func (m *WatchDmChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchDmChannelsRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchDmChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchDmChannelsRequest.Merge(m, src)
+}
+func (m *WatchDmChannelsRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchDmChannelsRequest.Size(m)
+}
+func (m *WatchDmChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchDmChannelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchDmChannelsRequest proto.InternalMessageInfo
+
+func (m *WatchDmChannelsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *WatchDmChannelsRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *WatchDmChannelsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *WatchDmChannelsRequest) GetPartitionIDs() []int64 {
+	if m != nil {
+		return m.PartitionIDs
+	}
+	return nil
+}
+
+func (m *WatchDmChannelsRequest) GetInfos() []*datapb.VchannelInfo {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+func (m *WatchDmChannelsRequest) GetSchema() *schemapb.CollectionSchema {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+func (m *WatchDmChannelsRequest) GetExcludeInfos() []*datapb.SegmentInfo {
+	if m != nil {
+		return m.ExcludeInfos
+	}
+	return nil
+}
+
+func (m *WatchDmChannelsRequest) GetLoadMeta() *LoadMetaInfo {
+	if m != nil {
+		return m.LoadMeta
+	}
+	return nil
+}
+
+func (m *WatchDmChannelsRequest) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
+func (m *WatchDmChannelsRequest) GetSegmentInfos() map[int64]*datapb.SegmentInfo {
+	if m != nil {
+		return m.SegmentInfos
+	}
+	return nil
+}
+
+func (m *WatchDmChannelsRequest) GetOfflineNodeID() int64 {
+	if m != nil {
+		return m.OfflineNodeID
+	}
+	return 0
+}
+
+func (m *WatchDmChannelsRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *WatchDmChannelsRequest) GetIndexInfoList() []*indexpb.IndexInfo {
+	if m != nil {
+		return m.IndexInfoList
+	}
+	return nil
+}
+
+type UnsubDmChannelRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	NodeID               int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	CollectionID         int64             `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	ChannelName          string            `protobuf:"bytes,4,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UnsubDmChannelRequest) Reset()         { *m = UnsubDmChannelRequest{} }
+func (m *UnsubDmChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*UnsubDmChannelRequest) ProtoMessage()    {}
+func (*UnsubDmChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{22}
+}
+
+func (m *UnsubDmChannelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnsubDmChannelRequest.Unmarshal(m, b)
+}
+func (m *UnsubDmChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnsubDmChannelRequest.Marshal(b, m, deterministic)
+}
+func (m *UnsubDmChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsubDmChannelRequest.Merge(m, src)
+}
+func (m *UnsubDmChannelRequest) XXX_Size() int {
+	return xxx_messageInfo_UnsubDmChannelRequest.Size(m)
+}
+func (m *UnsubDmChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsubDmChannelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnsubDmChannelRequest proto.InternalMessageInfo
+
+func (m *UnsubDmChannelRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *UnsubDmChannelRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *UnsubDmChannelRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *UnsubDmChannelRequest) GetChannelName() string {
+	if m != nil {
+		return m.ChannelName
+	}
+	return ""
+}
+
+type SegmentLoadInfo struct {
+	SegmentID            int64                 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	PartitionID          int64                 `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	CollectionID         int64                 `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	DbID                 int64                 `protobuf:"varint,4,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	FlushTime            int64                 `protobuf:"varint,5,opt,name=flush_time,json=flushTime,proto3" json:"flush_time,omitempty"`
+	BinlogPaths          []*datapb.FieldBinlog `protobuf:"bytes,6,rep,name=binlog_paths,json=binlogPaths,proto3" json:"binlog_paths,omitempty"`
+	NumOfRows            int64                 `protobuf:"varint,7,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	Statslogs            []*datapb.FieldBinlog `protobuf:"bytes,8,rep,name=statslogs,proto3" json:"statslogs,omitempty"`
+	Deltalogs            []*datapb.FieldBinlog `protobuf:"bytes,9,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	CompactionFrom       []int64               `protobuf:"varint,10,rep,packed,name=compactionFrom,proto3" json:"compactionFrom,omitempty"`
+	IndexInfos           []*FieldIndexInfo     `protobuf:"bytes,11,rep,name=index_infos,json=indexInfos,proto3" json:"index_infos,omitempty"`
+	SegmentSize          int64                 `protobuf:"varint,12,opt,name=segment_size,json=segmentSize,proto3" json:"segment_size,omitempty"`
+	InsertChannel        string                `protobuf:"bytes,13,opt,name=insert_channel,json=insertChannel,proto3" json:"insert_channel,omitempty"`
+	StartPosition        *msgpb.MsgPosition    `protobuf:"bytes,14,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
+	DeltaPosition        *msgpb.MsgPosition    `protobuf:"bytes,15,opt,name=delta_position,json=deltaPosition,proto3" json:"delta_position,omitempty"`
+	ReadableVersion      int64                 `protobuf:"varint,16,opt,name=readableVersion,proto3" json:"readableVersion,omitempty"`
+	Level                datapb.SegmentLevel   `protobuf:"varint,17,opt,name=level,proto3,enum=milvus.proto.data.SegmentLevel" json:"level,omitempty"`
+	StorageVersion       int64                 `protobuf:"varint,18,opt,name=storageVersion,proto3" json:"storageVersion,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *SegmentLoadInfo) Reset()         { *m = SegmentLoadInfo{} }
+func (m *SegmentLoadInfo) String() string { return proto.CompactTextString(m) }
+func (*SegmentLoadInfo) ProtoMessage()    {}
+func (*SegmentLoadInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{23}
+}
+
+func (m *SegmentLoadInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentLoadInfo.Unmarshal(m, b)
+}
+func (m *SegmentLoadInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentLoadInfo.Marshal(b, m, deterministic)
+}
+func (m *SegmentLoadInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentLoadInfo.Merge(m, src)
+}
+func (m *SegmentLoadInfo) XXX_Size() int {
+	return xxx_messageInfo_SegmentLoadInfo.Size(m)
+}
+func (m *SegmentLoadInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentLoadInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentLoadInfo proto.InternalMessageInfo
+
+func (m *SegmentLoadInfo) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *SegmentLoadInfo) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *SegmentLoadInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *SegmentLoadInfo) GetDbID() int64 {
+	if m != nil {
+		return m.DbID
+	}
+	return 0
+}
+
+func (m *SegmentLoadInfo) GetFlushTime() int64 {
+	if m != nil {
+		return m.FlushTime
+	}
+	return 0
+}
+
+func (m *SegmentLoadInfo) GetBinlogPaths() []*datapb.FieldBinlog {
+	if m != nil {
+		return m.BinlogPaths
+	}
+	return nil
+}
+
+func (m *SegmentLoadInfo) GetNumOfRows() int64 {
+	if m != nil {
+		return m.NumOfRows
+	}
+	return 0
+}
+
+func (m *SegmentLoadInfo) GetStatslogs() []*datapb.FieldBinlog {
+	if m != nil {
+		return m.Statslogs
+	}
+	return nil
+}
+
+func (m *SegmentLoadInfo) GetDeltalogs() []*datapb.FieldBinlog {
+	if m != nil {
+		return m.Deltalogs
+	}
+	return nil
+}
+
+func (m *SegmentLoadInfo) GetCompactionFrom() []int64 {
+	if m != nil {
+		return m.CompactionFrom
+	}
+	return nil
+}
+
+func (m *SegmentLoadInfo) GetIndexInfos() []*FieldIndexInfo {
+	if m != nil {
+		return m.IndexInfos
+	}
+	return nil
+}
+
+func (m *SegmentLoadInfo) GetSegmentSize() int64 {
+	if m != nil {
+		return m.SegmentSize
+	}
+	return 0
+}
+
+func (m *SegmentLoadInfo) GetInsertChannel() string {
+	if m != nil {
+		return m.InsertChannel
+	}
+	return ""
+}
+
+func (m *SegmentLoadInfo) GetStartPosition() *msgpb.MsgPosition {
+	if m != nil {
+		return m.StartPosition
+	}
+	return nil
+}
+
+func (m *SegmentLoadInfo) GetDeltaPosition() *msgpb.MsgPosition {
+	if m != nil {
+		return m.DeltaPosition
+	}
+	return nil
+}
+
+func (m *SegmentLoadInfo) GetReadableVersion() int64 {
+	if m != nil {
+		return m.ReadableVersion
+	}
+	return 0
+}
+
+func (m *SegmentLoadInfo) GetLevel() datapb.SegmentLevel {
+	if m != nil {
+		return m.Level
+	}
+	return datapb.SegmentLevel_Legacy
+}
+
+func (m *SegmentLoadInfo) GetStorageVersion() int64 {
+	if m != nil {
+		return m.StorageVersion
+	}
+	return 0
+}
+
+type FieldIndexInfo struct {
+	FieldID int64 `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	// deprecated
+	EnableIndex          bool                     `protobuf:"varint,2,opt,name=enable_index,json=enableIndex,proto3" json:"enable_index,omitempty"`
+	IndexName            string                   `protobuf:"bytes,3,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	IndexID              int64                    `protobuf:"varint,4,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	BuildID              int64                    `protobuf:"varint,5,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
+	IndexFilePaths       []string                 `protobuf:"bytes,7,rep,name=index_file_paths,json=indexFilePaths,proto3" json:"index_file_paths,omitempty"`
+	IndexSize            int64                    `protobuf:"varint,8,opt,name=index_size,json=indexSize,proto3" json:"index_size,omitempty"`
+	IndexVersion         int64                    `protobuf:"varint,9,opt,name=index_version,json=indexVersion,proto3" json:"index_version,omitempty"`
+	NumRows              int64                    `protobuf:"varint,10,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	CurrentIndexVersion  int32                    `protobuf:"varint,11,opt,name=current_index_version,json=currentIndexVersion,proto3" json:"current_index_version,omitempty"`
+	IndexStoreVersion    int64                    `protobuf:"varint,12,opt,name=index_store_version,json=indexStoreVersion,proto3" json:"index_store_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *FieldIndexInfo) Reset()         { *m = FieldIndexInfo{} }
+func (m *FieldIndexInfo) String() string { return proto.CompactTextString(m) }
+func (*FieldIndexInfo) ProtoMessage()    {}
+func (*FieldIndexInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{24}
+}
+
+func (m *FieldIndexInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldIndexInfo.Unmarshal(m, b)
+}
+func (m *FieldIndexInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldIndexInfo.Marshal(b, m, deterministic)
+}
+func (m *FieldIndexInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldIndexInfo.Merge(m, src)
+}
+func (m *FieldIndexInfo) XXX_Size() int {
+	return xxx_messageInfo_FieldIndexInfo.Size(m)
+}
+func (m *FieldIndexInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldIndexInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldIndexInfo proto.InternalMessageInfo
+
+func (m *FieldIndexInfo) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
+func (m *FieldIndexInfo) GetEnableIndex() bool {
+	if m != nil {
+		return m.EnableIndex
+	}
+	return false
+}
+
+func (m *FieldIndexInfo) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
+func (m *FieldIndexInfo) GetIndexID() int64 {
+	if m != nil {
+		return m.IndexID
+	}
+	return 0
+}
+
+func (m *FieldIndexInfo) GetBuildID() int64 {
+	if m != nil {
+		return m.BuildID
+	}
+	return 0
+}
+
+func (m *FieldIndexInfo) GetIndexParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.IndexParams
+	}
+	return nil
+}
+
+func (m *FieldIndexInfo) GetIndexFilePaths() []string {
+	if m != nil {
+		return m.IndexFilePaths
+	}
+	return nil
+}
+
+func (m *FieldIndexInfo) GetIndexSize() int64 {
+	if m != nil {
+		return m.IndexSize
+	}
+	return 0
+}
+
+func (m *FieldIndexInfo) GetIndexVersion() int64 {
+	if m != nil {
+		return m.IndexVersion
+	}
+	return 0
+}
+
+func (m *FieldIndexInfo) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+func (m *FieldIndexInfo) GetCurrentIndexVersion() int32 {
+	if m != nil {
+		return m.CurrentIndexVersion
+	}
+	return 0
+}
+
+func (m *FieldIndexInfo) GetIndexStoreVersion() int64 {
+	if m != nil {
+		return m.IndexStoreVersion
+	}
+	return 0
+}
+
+type LoadSegmentsRequest struct {
+	Base                 *commonpb.MsgBase          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DstNodeID            int64                      `protobuf:"varint,2,opt,name=dst_nodeID,json=dstNodeID,proto3" json:"dst_nodeID,omitempty"`
+	Infos                []*SegmentLoadInfo         `protobuf:"bytes,3,rep,name=infos,proto3" json:"infos,omitempty"`
+	Schema               *schemapb.CollectionSchema `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	SourceNodeID         int64                      `protobuf:"varint,5,opt,name=source_nodeID,json=sourceNodeID,proto3" json:"source_nodeID,omitempty"`
+	CollectionID         int64                      `protobuf:"varint,6,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	LoadMeta             *LoadMetaInfo              `protobuf:"bytes,7,opt,name=load_meta,json=loadMeta,proto3" json:"load_meta,omitempty"`
+	ReplicaID            int64                      `protobuf:"varint,8,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
+	DeltaPositions       []*msgpb.MsgPosition       `protobuf:"bytes,9,rep,name=delta_positions,json=deltaPositions,proto3" json:"delta_positions,omitempty"`
+	Version              int64                      `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
+	NeedTransfer         bool                       `protobuf:"varint,11,opt,name=need_transfer,json=needTransfer,proto3" json:"need_transfer,omitempty"`
+	LoadScope            LoadScope                  `protobuf:"varint,12,opt,name=load_scope,json=loadScope,proto3,enum=milvus.proto.query.LoadScope" json:"load_scope,omitempty"`
+	IndexInfoList        []*indexpb.IndexInfo       `protobuf:"bytes,13,rep,name=index_info_list,json=indexInfoList,proto3" json:"index_info_list,omitempty"`
+	LazyLoad             bool                       `protobuf:"varint,14,opt,name=lazy_load,json=lazyLoad,proto3" json:"lazy_load,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *LoadSegmentsRequest) Reset()         { *m = LoadSegmentsRequest{} }
+func (m *LoadSegmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*LoadSegmentsRequest) ProtoMessage()    {}
+func (*LoadSegmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{25}
+}
+
+func (m *LoadSegmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadSegmentsRequest.Unmarshal(m, b)
+}
+func (m *LoadSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadSegmentsRequest.Marshal(b, m, deterministic)
+}
+func (m *LoadSegmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadSegmentsRequest.Merge(m, src)
+}
+func (m *LoadSegmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_LoadSegmentsRequest.Size(m)
+}
+func (m *LoadSegmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadSegmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadSegmentsRequest proto.InternalMessageInfo
+
+func (m *LoadSegmentsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *LoadSegmentsRequest) GetDstNodeID() int64 {
+	if m != nil {
+		return m.DstNodeID
+	}
+	return 0
+}
+
+func (m *LoadSegmentsRequest) GetInfos() []*SegmentLoadInfo {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+func (m *LoadSegmentsRequest) GetSchema() *schemapb.CollectionSchema {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+func (m *LoadSegmentsRequest) GetSourceNodeID() int64 {
+	if m != nil {
+		return m.SourceNodeID
+	}
+	return 0
+}
+
+func (m *LoadSegmentsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *LoadSegmentsRequest) GetLoadMeta() *LoadMetaInfo {
+	if m != nil {
+		return m.LoadMeta
+	}
+	return nil
+}
+
+func (m *LoadSegmentsRequest) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
+func (m *LoadSegmentsRequest) GetDeltaPositions() []*msgpb.MsgPosition {
+	if m != nil {
+		return m.DeltaPositions
+	}
+	return nil
+}
+
+func (m *LoadSegmentsRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *LoadSegmentsRequest) GetNeedTransfer() bool {
+	if m != nil {
+		return m.NeedTransfer
+	}
+	return false
+}
+
+func (m *LoadSegmentsRequest) GetLoadScope() LoadScope {
+	if m != nil {
+		return m.LoadScope
+	}
+	return LoadScope_Full
+}
+
+func (m *LoadSegmentsRequest) GetIndexInfoList() []*indexpb.IndexInfo {
+	if m != nil {
+		return m.IndexInfoList
+	}
+	return nil
+}
+
+func (m *LoadSegmentsRequest) GetLazyLoad() bool {
+	if m != nil {
+		return m.LazyLoad
+	}
+	return false
+}
+
+type ReleaseSegmentsRequest struct {
+	Base   *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	NodeID int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	// Not useful for now
+	DbID                 int64              `protobuf:"varint,3,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	CollectionID         int64              `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionIDs         []int64            `protobuf:"varint,5,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	SegmentIDs           []int64            `protobuf:"varint,6,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	Scope                DataScope          `protobuf:"varint,7,opt,name=scope,proto3,enum=milvus.proto.query.DataScope" json:"scope,omitempty"`
+	Shard                string             `protobuf:"bytes,8,opt,name=shard,proto3" json:"shard,omitempty"`
+	NeedTransfer         bool               `protobuf:"varint,11,opt,name=need_transfer,json=needTransfer,proto3" json:"need_transfer,omitempty"`
+	Checkpoint           *msgpb.MsgPosition `protobuf:"bytes,12,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ReleaseSegmentsRequest) Reset()         { *m = ReleaseSegmentsRequest{} }
+func (m *ReleaseSegmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleaseSegmentsRequest) ProtoMessage()    {}
+func (*ReleaseSegmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{26}
+}
+
+func (m *ReleaseSegmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseSegmentsRequest.Unmarshal(m, b)
+}
+func (m *ReleaseSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseSegmentsRequest.Marshal(b, m, deterministic)
+}
+func (m *ReleaseSegmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseSegmentsRequest.Merge(m, src)
+}
+func (m *ReleaseSegmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_ReleaseSegmentsRequest.Size(m)
+}
+func (m *ReleaseSegmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseSegmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseSegmentsRequest proto.InternalMessageInfo
+
+func (m *ReleaseSegmentsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ReleaseSegmentsRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *ReleaseSegmentsRequest) GetDbID() int64 {
+	if m != nil {
+		return m.DbID
+	}
+	return 0
+}
+
+func (m *ReleaseSegmentsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ReleaseSegmentsRequest) GetPartitionIDs() []int64 {
+	if m != nil {
+		return m.PartitionIDs
+	}
+	return nil
+}
+
+func (m *ReleaseSegmentsRequest) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+func (m *ReleaseSegmentsRequest) GetScope() DataScope {
+	if m != nil {
+		return m.Scope
+	}
+	return DataScope_UnKnown
+}
+
+func (m *ReleaseSegmentsRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *ReleaseSegmentsRequest) GetNeedTransfer() bool {
+	if m != nil {
+		return m.NeedTransfer
+	}
+	return false
+}
+
+func (m *ReleaseSegmentsRequest) GetCheckpoint() *msgpb.MsgPosition {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return nil
+}
+
+type SearchRequest struct {
+	Req                  *internalpb.SearchRequest `protobuf:"bytes,1,opt,name=req,proto3" json:"req,omitempty"`
+	DmlChannels          []string                  `protobuf:"bytes,2,rep,name=dml_channels,json=dmlChannels,proto3" json:"dml_channels,omitempty"`
+	SegmentIDs           []int64                   `protobuf:"varint,3,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	FromShardLeader      bool                      `protobuf:"varint,4,opt,name=from_shard_leader,json=fromShardLeader,proto3" json:"from_shard_leader,omitempty"`
+	Scope                DataScope                 `protobuf:"varint,5,opt,name=scope,proto3,enum=milvus.proto.query.DataScope" json:"scope,omitempty"`
+	TotalChannelNum      int32                     `protobuf:"varint,6,opt,name=total_channel_num,json=totalChannelNum,proto3" json:"total_channel_num,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
+func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchRequest) ProtoMessage()    {}
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{27}
+}
+
+func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchRequest.Unmarshal(m, b)
+}
+func (m *SearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchRequest.Marshal(b, m, deterministic)
+}
+func (m *SearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchRequest.Merge(m, src)
+}
+func (m *SearchRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchRequest.Size(m)
+}
+func (m *SearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchRequest proto.InternalMessageInfo
+
+func (m *SearchRequest) GetReq() *internalpb.SearchRequest {
+	if m != nil {
+		return m.Req
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetDmlChannels() []string {
+	if m != nil {
+		return m.DmlChannels
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetFromShardLeader() bool {
+	if m != nil {
+		return m.FromShardLeader
+	}
+	return false
+}
+
+func (m *SearchRequest) GetScope() DataScope {
+	if m != nil {
+		return m.Scope
+	}
+	return DataScope_UnKnown
+}
+
+func (m *SearchRequest) GetTotalChannelNum() int32 {
+	if m != nil {
+		return m.TotalChannelNum
+	}
+	return 0
+}
+
+type QueryRequest struct {
+	Req                  *internalpb.RetrieveRequest `protobuf:"bytes,1,opt,name=req,proto3" json:"req,omitempty"`
+	DmlChannels          []string                    `protobuf:"bytes,2,rep,name=dml_channels,json=dmlChannels,proto3" json:"dml_channels,omitempty"`
+	SegmentIDs           []int64                     `protobuf:"varint,3,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	FromShardLeader      bool                        `protobuf:"varint,4,opt,name=from_shard_leader,json=fromShardLeader,proto3" json:"from_shard_leader,omitempty"`
+	Scope                DataScope                   `protobuf:"varint,5,opt,name=scope,proto3,enum=milvus.proto.query.DataScope" json:"scope,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
+func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRequest) ProtoMessage()    {}
+func (*QueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{28}
+}
+
+func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRequest.Unmarshal(m, b)
+}
+func (m *QueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequest.Merge(m, src)
+}
+func (m *QueryRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryRequest.Size(m)
+}
+func (m *QueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRequest proto.InternalMessageInfo
+
+func (m *QueryRequest) GetReq() *internalpb.RetrieveRequest {
+	if m != nil {
+		return m.Req
+	}
+	return nil
+}
+
+func (m *QueryRequest) GetDmlChannels() []string {
+	if m != nil {
+		return m.DmlChannels
+	}
+	return nil
+}
+
+func (m *QueryRequest) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+func (m *QueryRequest) GetFromShardLeader() bool {
+	if m != nil {
+		return m.FromShardLeader
+	}
+	return false
+}
+
+func (m *QueryRequest) GetScope() DataScope {
+	if m != nil {
+		return m.Scope
+	}
+	return DataScope_UnKnown
+}
+
+type SyncReplicaSegmentsRequest struct {
+	Base                 *commonpb.MsgBase      `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	VchannelName         string                 `protobuf:"bytes,2,opt,name=vchannel_name,json=vchannelName,proto3" json:"vchannel_name,omitempty"`
+	ReplicaSegments      []*ReplicaSegmentsInfo `protobuf:"bytes,3,rep,name=replica_segments,json=replicaSegments,proto3" json:"replica_segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *SyncReplicaSegmentsRequest) Reset()         { *m = SyncReplicaSegmentsRequest{} }
+func (m *SyncReplicaSegmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*SyncReplicaSegmentsRequest) ProtoMessage()    {}
+func (*SyncReplicaSegmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{29}
+}
+
+func (m *SyncReplicaSegmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncReplicaSegmentsRequest.Unmarshal(m, b)
+}
+func (m *SyncReplicaSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncReplicaSegmentsRequest.Marshal(b, m, deterministic)
+}
+func (m *SyncReplicaSegmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncReplicaSegmentsRequest.Merge(m, src)
+}
+func (m *SyncReplicaSegmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_SyncReplicaSegmentsRequest.Size(m)
+}
+func (m *SyncReplicaSegmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncReplicaSegmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncReplicaSegmentsRequest proto.InternalMessageInfo
+
+func (m *SyncReplicaSegmentsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SyncReplicaSegmentsRequest) GetVchannelName() string {
+	if m != nil {
+		return m.VchannelName
+	}
+	return ""
+}
+
+func (m *SyncReplicaSegmentsRequest) GetReplicaSegments() []*ReplicaSegmentsInfo {
+	if m != nil {
+		return m.ReplicaSegments
+	}
+	return nil
+}
+
+type ReplicaSegmentsInfo struct {
+	NodeId               int64    `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	PartitionId          int64    `protobuf:"varint,2,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+	SegmentIds           []int64  `protobuf:"varint,3,rep,packed,name=segment_ids,json=segmentIds,proto3" json:"segment_ids,omitempty"`
+	Versions             []int64  `protobuf:"varint,4,rep,packed,name=versions,proto3" json:"versions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplicaSegmentsInfo) Reset()         { *m = ReplicaSegmentsInfo{} }
+func (m *ReplicaSegmentsInfo) String() string { return proto.CompactTextString(m) }
+func (*ReplicaSegmentsInfo) ProtoMessage()    {}
+func (*ReplicaSegmentsInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{30}
+}
+
+func (m *ReplicaSegmentsInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplicaSegmentsInfo.Unmarshal(m, b)
+}
+func (m *ReplicaSegmentsInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplicaSegmentsInfo.Marshal(b, m, deterministic)
+}
+func (m *ReplicaSegmentsInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicaSegmentsInfo.Merge(m, src)
+}
+func (m *ReplicaSegmentsInfo) XXX_Size() int {
+	return xxx_messageInfo_ReplicaSegmentsInfo.Size(m)
+}
+func (m *ReplicaSegmentsInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicaSegmentsInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicaSegmentsInfo proto.InternalMessageInfo
+
+func (m *ReplicaSegmentsInfo) GetNodeId() int64 {
+	if m != nil {
+		return m.NodeId
+	}
+	return 0
+}
+
+func (m *ReplicaSegmentsInfo) GetPartitionId() int64 {
+	if m != nil {
+		return m.PartitionId
+	}
+	return 0
+}
+
+func (m *ReplicaSegmentsInfo) GetSegmentIds() []int64 {
+	if m != nil {
+		return m.SegmentIds
+	}
+	return nil
+}
+
+func (m *ReplicaSegmentsInfo) GetVersions() []int64 {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+type GetLoadInfoRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionId         int64             `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetLoadInfoRequest) Reset()         { *m = GetLoadInfoRequest{} }
+func (m *GetLoadInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLoadInfoRequest) ProtoMessage()    {}
+func (*GetLoadInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{31}
+}
+
+func (m *GetLoadInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLoadInfoRequest.Unmarshal(m, b)
+}
+func (m *GetLoadInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLoadInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *GetLoadInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLoadInfoRequest.Merge(m, src)
+}
+func (m *GetLoadInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLoadInfoRequest.Size(m)
+}
+func (m *GetLoadInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLoadInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLoadInfoRequest proto.InternalMessageInfo
+
+func (m *GetLoadInfoRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetLoadInfoRequest) GetCollectionId() int64 {
+	if m != nil {
+		return m.CollectionId
+	}
+	return 0
+}
+
+type GetLoadInfoResponse struct {
+	Status         *commonpb.Status           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Schema         *schemapb.CollectionSchema `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	LoadType       LoadType                   `protobuf:"varint,3,opt,name=load_type,json=loadType,proto3,enum=milvus.proto.query.LoadType" json:"load_type,omitempty"`
+	Partitions     []int64                    `protobuf:"varint,4,rep,packed,name=partitions,proto3" json:"partitions,omitempty"`
+	LoadPercentage int32                      `protobuf:"varint,5,opt,name=load_percentage,json=loadPercentage,proto3" json:"load_percentage,omitempty"`
+	ReplicaNumber  int32                      `protobuf:"varint,6,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
+	// the resource groups where the replicas are placed
+	ResourceGroups  []string `protobuf:"bytes,7,rep,name=resource_groups,json=resourceGroups,proto3" json:"resource_groups,omitempty"`
+	RefreshProgress int64    `protobuf:"varint,8,opt,name=refresh_progress,json=refreshProgress,proto3" json:"refresh_progress,omitempty"`
+	// whether any replica is available to serve queries
+	Readable             bool     `protobuf:"varint,9,opt,name=readable,proto3" json:"readable,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLoadInfoResponse) Reset()         { *m = GetLoadInfoResponse{} }
+func (m *GetLoadInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLoadInfoResponse) ProtoMessage()    {}
+func (*GetLoadInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{32}
+}
+
+func (m *GetLoadInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLoadInfoResponse.Unmarshal(m, b)
+}
+func (m *GetLoadInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLoadInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *GetLoadInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLoadInfoResponse.Merge(m, src)
+}
+func (m *GetLoadInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_GetLoadInfoResponse.Size(m)
+}
+func (m *GetLoadInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLoadInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLoadInfoResponse proto.InternalMessageInfo
+
+func (m *GetLoadInfoResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetLoadInfoResponse) GetSchema() *schemapb.CollectionSchema {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+func (m *GetLoadInfoResponse) GetLoadType() LoadType {
+	if m != nil {
+		return m.LoadType
+	}
+	return LoadType_UnKnownType
+}
+
+func (m *GetLoadInfoResponse) GetPartitions() []int64 {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
+}
+
+func (m *GetLoadInfoResponse) GetLoadPercentage() int32 {
+	if m != nil {
+		return m.LoadPercentage
+	}
+	return 0
+}
+
+func (m *GetLoadInfoResponse) GetReplicaNumber() int32 {
+	if m != nil {
+		return m.ReplicaNumber
+	}
+	return 0
+}
+
+func (m *GetLoadInfoResponse) GetResourceGroups() []string {
+	if m != nil {
+		return m.ResourceGroups
+	}
+	return nil
+}
+
+func (m *GetLoadInfoResponse) GetRefreshProgress() int64 {
+	if m != nil {
+		return m.RefreshProgress
+	}
+	return 0
+}
+
+func (m *GetLoadInfoResponse) GetReadable() bool {
+	if m != nil {
+		return m.Readable
+	}
+	return false
+}
+
+type HandoffSegmentsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SegmentInfos         []*SegmentInfo    `protobuf:"bytes,2,rep,name=segmentInfos,proto3" json:"segmentInfos,omitempty"`
+	ReleasedSegments     []int64           `protobuf:"varint,3,rep,packed,name=released_segments,json=releasedSegments,proto3" json:"released_segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *HandoffSegmentsRequest) Reset()         { *m = HandoffSegmentsRequest{} }
+func (m *HandoffSegmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*HandoffSegmentsRequest) ProtoMessage()    {}
+func (*HandoffSegmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{33}
+}
+
+func (m *HandoffSegmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HandoffSegmentsRequest.Unmarshal(m, b)
+}
+func (m *HandoffSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HandoffSegmentsRequest.Marshal(b, m, deterministic)
+}
+func (m *HandoffSegmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandoffSegmentsRequest.Merge(m, src)
+}
+func (m *HandoffSegmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_HandoffSegmentsRequest.Size(m)
+}
+func (m *HandoffSegmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandoffSegmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandoffSegmentsRequest proto.InternalMessageInfo
+
+func (m *HandoffSegmentsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *HandoffSegmentsRequest) GetSegmentInfos() []*SegmentInfo {
+	if m != nil {
+		return m.SegmentInfos
+	}
+	return nil
+}
+
+func (m *HandoffSegmentsRequest) GetReleasedSegments() []int64 {
+	if m != nil {
+		return m.ReleasedSegments
+	}
+	return nil
+}
+
+type LoadBalanceRequest struct {
+	Base             *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SourceNodeIDs    []int64           `protobuf:"varint,2,rep,packed,name=source_nodeIDs,json=sourceNodeIDs,proto3" json:"source_nodeIDs,omitempty"`
+	BalanceReason    TriggerCondition  `protobuf:"varint,3,opt,name=balance_reason,json=balanceReason,proto3,enum=milvus.proto.query.TriggerCondition" json:"balance_reason,omitempty"`
+	DstNodeIDs       []int64           `protobuf:"varint,4,rep,packed,name=dst_nodeIDs,json=dstNodeIDs,proto3" json:"dst_nodeIDs,omitempty"`
+	SealedSegmentIDs []int64           `protobuf:"varint,5,rep,packed,name=sealed_segmentIDs,json=sealedSegmentIDs,proto3" json:"sealed_segmentIDs,omitempty"`
+	CollectionID     int64             `protobuf:"varint,6,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	BalanceChannels  bool              `protobuf:"varint,7,opt,name=balance_channels,json=balanceChannels,proto3" json:"balance_channels,omitempty"`
+	// only balance the segments of the partition if set
+	PartitionID int64 `protobuf:"varint,8,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	// return the balance operation id without waiting for the segment moves,
+	// the progress could be polled by GetLoadBalanceStatus, can't be used with balance_channels
+	Async                bool     `protobuf:"varint,9,opt,name=async,proto3" json:"async,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoadBalanceRequest) Reset()         { *m = LoadBalanceRequest{} }
+func (m *LoadBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*LoadBalanceRequest) ProtoMessage()    {}
+func (*LoadBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{34}
+}
+
+func (m *LoadBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadBalanceRequest.Unmarshal(m, b)
+}
+func (m *LoadBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadBalanceRequest.Marshal(b, m, deterministic)
+}
+func (m *LoadBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadBalanceRequest.Merge(m, src)
+}
+func (m *LoadBalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_LoadBalanceRequest.Size(m)
+}
+func (m *LoadBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadBalanceRequest proto.InternalMessageInfo
+
+func (m *LoadBalanceRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *LoadBalanceRequest) GetSourceNodeIDs() []int64 {
+	if m != nil {
+		return m.SourceNodeIDs
+	}
+	return nil
+}
+
+func (m *LoadBalanceRequest) GetBalanceReason() TriggerCondition {
+	if m != nil {
+		return m.BalanceReason
+	}
+	return TriggerCondition_UnKnowCondition
+}
+
+func (m *LoadBalanceRequest) GetDstNodeIDs() []int64 {
+	if m != nil {
+		return m.DstNodeIDs
+	}
+	return nil
+}
+
+func (m *LoadBalanceRequest) GetSealedSegmentIDs() []int64 {
+	if m != nil {
+		return m.SealedSegmentIDs
+	}
+	return nil
+}
+
+func (m *LoadBalanceRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *LoadBalanceRequest) GetBalanceChannels() bool {
+	if m != nil {
+		return m.BalanceChannels
+	}
+	return false
+}
+
+func (m *LoadBalanceRequest) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *LoadBalanceRequest) GetAsync() bool {
+	if m != nil {
+		return m.Async
+	}
+	return false
+}
+
+type DmChannelWatchInfo struct {
+	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	DmChannel            string   `protobuf:"bytes,2,opt,name=dmChannel,proto3" json:"dmChannel,omitempty"`
+	NodeIDLoaded         int64    `protobuf:"varint,3,opt,name=nodeID_loaded,json=nodeIDLoaded,proto3" json:"nodeID_loaded,omitempty"`
+	ReplicaID            int64    `protobuf:"varint,4,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
+	NodeIds              []int64  `protobuf:"varint,5,rep,packed,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DmChannelWatchInfo) Reset()         { *m = DmChannelWatchInfo{} }
+func (m *DmChannelWatchInfo) String() string { return proto.CompactTextString(m) }
+func (*DmChannelWatchInfo) ProtoMessage()    {}
+func (*DmChannelWatchInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{35}
+}
+
+func (m *DmChannelWatchInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DmChannelWatchInfo.Unmarshal(m, b)
+}
+func (m *DmChannelWatchInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DmChannelWatchInfo.Marshal(b, m, deterministic)
+}
+func (m *DmChannelWatchInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DmChannelWatchInfo.Merge(m, src)
+}
+func (m *DmChannelWatchInfo) XXX_Size() int {
+	return xxx_messageInfo_DmChannelWatchInfo.Size(m)
+}
+func (m *DmChannelWatchInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DmChannelWatchInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DmChannelWatchInfo proto.InternalMessageInfo
+
+func (m *DmChannelWatchInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *DmChannelWatchInfo) GetDmChannel() string {
+	if m != nil {
+		return m.DmChannel
+	}
+	return ""
+}
+
+func (m *DmChannelWatchInfo) GetNodeIDLoaded() int64 {
+	if m != nil {
+		return m.NodeIDLoaded
+	}
+	return 0
+}
+
+func (m *DmChannelWatchInfo) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
+func (m *DmChannelWatchInfo) GetNodeIds() []int64 {
+	if m != nil {
+		return m.NodeIds
+	}
+	return nil
+}
+
+type QueryChannelInfo struct {
+	CollectionID         int64              `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	QueryChannel         string             `protobuf:"bytes,2,opt,name=query_channel,json=queryChannel,proto3" json:"query_channel,omitempty"`
+	QueryResultChannel   string             `protobuf:"bytes,3,opt,name=query_result_channel,json=queryResultChannel,proto3" json:"query_result_channel,omitempty"`
+	GlobalSealedSegments []*SegmentInfo     `protobuf:"bytes,4,rep,name=global_sealed_segments,json=globalSealedSegments,proto3" json:"global_sealed_segments,omitempty"`
+	SeekPosition         *msgpb.MsgPosition `protobuf:"bytes,5,opt,name=seek_position,json=seekPosition,proto3" json:"seek_position,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *QueryChannelInfo) Reset()         { *m = QueryChannelInfo{} }
+func (m *QueryChannelInfo) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelInfo) ProtoMessage()    {}
+func (*QueryChannelInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{36}
+}
+
+func (m *QueryChannelInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryChannelInfo.Unmarshal(m, b)
+}
+func (m *QueryChannelInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryChannelInfo.Marshal(b, m, deterministic)
+}
+func (m *QueryChannelInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelInfo.Merge(m, src)
+}
+func (m *QueryChannelInfo) XXX_Size() int {
+	return xxx_messageInfo_QueryChannelInfo.Size(m)
+}
+func (m *QueryChannelInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelInfo proto.InternalMessageInfo
+
+func (m *QueryChannelInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *QueryChannelInfo) GetQueryChannel() string {
+	if m != nil {
+		return m.QueryChannel
+	}
+	return ""
+}
+
+func (m *QueryChannelInfo) GetQueryResultChannel() string {
+	if m != nil {
+		return m.QueryResultChannel
+	}
+	return ""
+}
+
+func (m *QueryChannelInfo) GetGlobalSealedSegments() []*SegmentInfo {
+	if m != nil {
+		return m.GlobalSealedSegments
+	}
+	return nil
+}
+
+func (m *QueryChannelInfo) GetSeekPosition() *msgpb.MsgPosition {
+	if m != nil {
+		return m.SeekPosition
+	}
+	return nil
+}
+
+type PartitionStates struct {
+	PartitionID          int64          `protobuf:"varint,1,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	State                PartitionState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.query.PartitionState" json:"state,omitempty"`
+	InMemoryPercentage   int64          `protobuf:"varint,3,opt,name=inMemory_percentage,json=inMemoryPercentage,proto3" json:"inMemory_percentage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *PartitionStates) Reset()         { *m = PartitionStates{} }
+func (m *PartitionStates) String() string { return proto.CompactTextString(m) }
+func (*PartitionStates) ProtoMessage()    {}
+func (*PartitionStates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{37}
+}
+
+func (m *PartitionStates) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PartitionStates.Unmarshal(m, b)
+}
+func (m *PartitionStates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PartitionStates.Marshal(b, m, deterministic)
+}
+func (m *PartitionStates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionStates.Merge(m, src)
+}
+func (m *PartitionStates) XXX_Size() int {
+	return xxx_messageInfo_PartitionStates.Size(m)
+}
+func (m *PartitionStates) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionStates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionStates proto.InternalMessageInfo
+
+func (m *PartitionStates) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *PartitionStates) GetState() PartitionState {
+	if m != nil {
+		return m.State
+	}
+	return PartitionState_NotExist
+}
+
+func (m *PartitionStates) GetInMemoryPercentage() int64 {
+	if m != nil {
+		return m.InMemoryPercentage
+	}
+	return 0
+}
+
+type SegmentInfo struct {
+	SegmentID    int64 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	CollectionID int64 `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID  int64 `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	// deprecated, check node_ids(NodeIds) field
+	NodeID              int64                 `protobuf:"varint,4,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	MemSize             int64                 `protobuf:"varint,5,opt,name=mem_size,json=memSize,proto3" json:"mem_size,omitempty"`
+	NumRows             int64                 `protobuf:"varint,6,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	IndexName           string                `protobuf:"bytes,7,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	IndexID             int64                 `protobuf:"varint,8,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	DmChannel           string                `protobuf:"bytes,9,opt,name=dmChannel,proto3" json:"dmChannel,omitempty"`
+	CompactionFrom      []int64               `protobuf:"varint,10,rep,packed,name=compactionFrom,proto3" json:"compactionFrom,omitempty"`
+	CreatedByCompaction bool                  `protobuf:"varint,11,opt,name=createdByCompaction,proto3" json:"createdByCompaction,omitempty"`
+	SegmentState        commonpb.SegmentState `protobuf:"varint,12,opt,name=segment_state,json=segmentState,proto3,enum=milvus.proto.common.SegmentState" json:"segment_state,omitempty"`
+	IndexInfos          []*FieldIndexInfo     `protobuf:"bytes,13,rep,name=index_infos,json=indexInfos,proto3" json:"index_infos,omitempty"`
+	ReplicaIds          []int64               `protobuf:"varint,14,rep,packed,name=replica_ids,json=replicaIds,proto3" json:"replica_ids,omitempty"`
+	NodeIds             []int64               `protobuf:"varint,15,rep,packed,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	EnableIndex         bool                  `protobuf:"varint,16,opt,name=enable_index,json=enableIndex,proto3" json:"enable_index,omitempty"`
+	IsFake              bool                  `protobuf:"varint,17,opt,name=is_fake,json=isFake,proto3" json:"is_fake,omitempty"`
+	// loaded bytes against the total size of the segment, 100 if loaded
+	LoadPercentage       int64    `protobuf:"varint,18,opt,name=load_percentage,json=loadPercentage,proto3" json:"load_percentage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentInfo) Reset()         { *m = SegmentInfo{} }
+func (m *SegmentInfo) String() string { return proto.CompactTextString(m) }
+func (*SegmentInfo) ProtoMessage()    {}
+func (*SegmentInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{38}
+}
+
+func (m *SegmentInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentInfo.Unmarshal(m, b)
+}
+func (m *SegmentInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentInfo.Marshal(b, m, deterministic)
+}
+func (m *SegmentInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentInfo.Merge(m, src)
+}
+func (m *SegmentInfo) XXX_Size() int {
+	return xxx_messageInfo_SegmentInfo.Size(m)
+}
+func (m *SegmentInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentInfo proto.InternalMessageInfo
+
+func (m *SegmentInfo) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *SegmentInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *SegmentInfo) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *SegmentInfo) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *SegmentInfo) GetMemSize() int64 {
+	if m != nil {
+		return m.MemSize
+	}
+	return 0
+}
+
+func (m *SegmentInfo) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+func (m *SegmentInfo) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
+func (m *SegmentInfo) GetIndexID() int64 {
+	if m != nil {
+		return m.IndexID
+	}
+	return 0
+}
+
+func (m *SegmentInfo) GetDmChannel() string {
+	if m != nil {
+		return m.DmChannel
+	}
+	return ""
+}
+
+func (m *SegmentInfo) GetCompactionFrom() []int64 {
+	if m != nil {
+		return m.CompactionFrom
+	}
+	return nil
+}
+
+func (m *SegmentInfo) GetCreatedByCompaction() bool {
+	if m != nil {
+		return m.CreatedByCompaction
+	}
+	return false
+}
+
+func (m *SegmentInfo) GetSegmentState() commonpb.SegmentState {
+	if m != nil {
+		return m.SegmentState
+	}
+	return commonpb.SegmentState_SegmentStateNone
+}
+
+func (m *SegmentInfo) GetIndexInfos() []*FieldIndexInfo {
+	if m != nil {
+		return m.IndexInfos
+	}
+	return nil
+}
+
+func (m *SegmentInfo) GetReplicaIds() []int64 {
+	if m != nil {
+		return m.ReplicaIds
+	}
+	return nil
+}
+
+func (m *SegmentInfo) GetNodeIds() []int64 {
+	if m != nil {
+		return m.NodeIds
+	}
+	return nil
+}
+
+func (m *SegmentInfo) GetEnableIndex() bool {
+	if m != nil {
+		return m.EnableIndex
+	}
+	return false
+}
+
+func (m *SegmentInfo) GetIsFake() bool {
+	if m != nil {
+		return m.IsFake
+	}
+	return false
+}
+
+func (m *SegmentInfo) GetLoadPercentage() int64 {
+	if m != nil {
+		return m.LoadPercentage
+	}
+	return 0
+}
+
+type CollectionInfo struct {
+	CollectionID         int64                      `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionIDs         []int64                    `protobuf:"varint,2,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	PartitionStates      []*PartitionStates         `protobuf:"bytes,3,rep,name=partition_states,json=partitionStates,proto3" json:"partition_states,omitempty"`
+	LoadType             LoadType                   `protobuf:"varint,4,opt,name=load_type,json=loadType,proto3,enum=milvus.proto.query.LoadType" json:"load_type,omitempty"`
+	Schema               *schemapb.CollectionSchema `protobuf:"bytes,5,opt,name=schema,proto3" json:"schema,omitempty"`
+	ReleasedPartitionIDs []int64                    `protobuf:"varint,6,rep,packed,name=released_partitionIDs,json=releasedPartitionIDs,proto3" json:"released_partitionIDs,omitempty"`
+	InMemoryPercentage   int64                      `protobuf:"varint,7,opt,name=inMemory_percentage,json=inMemoryPercentage,proto3" json:"inMemory_percentage,omitempty"`
+	ReplicaIds           []int64                    `protobuf:"varint,8,rep,packed,name=replica_ids,json=replicaIds,proto3" json:"replica_ids,omitempty"`
+	ReplicaNumber        int32                      `protobuf:"varint,9,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *CollectionInfo) Reset()         { *m = CollectionInfo{} }
+func (m *CollectionInfo) String() string { return proto.CompactTextString(m) }
+func (*CollectionInfo) ProtoMessage()    {}
+func (*CollectionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{39}
+}
+
+func (m *CollectionInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollectionInfo.Unmarshal(m, b)
+}
+func (m *CollectionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollectionInfo.Marshal(b, m, deterministic)
+}
+func (m *CollectionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionInfo.Merge(m, src)
+}
+func (m *CollectionInfo) XXX_Size() int {
+	return xxx_messageInfo_CollectionInfo.Size(m)
+}
+func (m *CollectionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionInfo proto.InternalMessageInfo
+
+func (m *CollectionInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *CollectionInfo) GetPartitionIDs() []int64 {
+	if m != nil {
+		return m.PartitionIDs
+	}
+	return nil
+}
+
+func (m *CollectionInfo) GetPartitionStates() []*PartitionStates {
+	if m != nil {
+		return m.PartitionStates
+	}
+	return nil
+}
+
+func (m *CollectionInfo) GetLoadType() LoadType {
+	if m != nil {
+		return m.LoadType
+	}
+	return LoadType_UnKnownType
+}
+
+func (m *CollectionInfo) GetSchema() *schemapb.CollectionSchema {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+func (m *CollectionInfo) GetReleasedPartitionIDs() []int64 {
+	if m != nil {
+		return m.ReleasedPartitionIDs
+	}
+	return nil
+}
+
+func (m *CollectionInfo) GetInMemoryPercentage() int64 {
+	if m != nil {
+		return m.InMemoryPercentage
+	}
+	return 0
+}
+
+func (m *CollectionInfo) GetReplicaIds() []int64 {
+	if m != nil {
+		return m.ReplicaIds
+	}
+	return nil
+}
+
+func (m *CollectionInfo) GetReplicaNumber() int32 {
+	if m != nil {
+		return m.ReplicaNumber
+	}
+	return 0
+}
+
+type UnsubscribeChannels struct {
+	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Channels             []string `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnsubscribeChannels) Reset()         { *m = UnsubscribeChannels{} }
+func (m *UnsubscribeChannels) String() string { return proto.CompactTextString(m) }
+func (*UnsubscribeChannels) ProtoMessage()    {}
+func (*UnsubscribeChannels) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{40}
+}
+
+func (m *UnsubscribeChannels) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnsubscribeChannels.Unmarshal(m, b)
+}
+func (m *UnsubscribeChannels) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnsubscribeChannels.Marshal(b, m, deterministic)
+}
+func (m *UnsubscribeChannels) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsubscribeChannels.Merge(m, src)
+}
+func (m *UnsubscribeChannels) XXX_Size() int {
+	return xxx_messageInfo_UnsubscribeChannels.Size(m)
+}
+func (m *UnsubscribeChannels) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsubscribeChannels.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnsubscribeChannels proto.InternalMessageInfo
+
+func (m *UnsubscribeChannels) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *UnsubscribeChannels) GetChannels() []string {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+type UnsubscribeChannelInfo struct {
+	NodeID               int64                  `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	CollectionChannels   []*UnsubscribeChannels `protobuf:"bytes,2,rep,name=collection_channels,json=collectionChannels,proto3" json:"collection_channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *UnsubscribeChannelInfo) Reset()         { *m = UnsubscribeChannelInfo{} }
+func (m *UnsubscribeChannelInfo) String() string { return proto.CompactTextString(m) }
+func (*UnsubscribeChannelInfo) ProtoMessage()    {}
+func (*UnsubscribeChannelInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{41}
+}
+
+func (m *UnsubscribeChannelInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnsubscribeChannelInfo.Unmarshal(m, b)
+}
+func (m *UnsubscribeChannelInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnsubscribeChannelInfo.Marshal(b, m, deterministic)
+}
+func (m *UnsubscribeChannelInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsubscribeChannelInfo.Merge(m, src)
+}
+func (m *UnsubscribeChannelInfo) XXX_Size() int {
+	return xxx_messageInfo_UnsubscribeChannelInfo.Size(m)
+}
+func (m *UnsubscribeChannelInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsubscribeChannelInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnsubscribeChannelInfo proto.InternalMessageInfo
+
+func (m *UnsubscribeChannelInfo) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *UnsubscribeChannelInfo) GetCollectionChannels() []*UnsubscribeChannels {
+	if m != nil {
+		return m.CollectionChannels
+	}
+	return nil
+}
+
+type SegmentChangeInfo struct {
+	OnlineNodeID         int64          `protobuf:"varint,1,opt,name=online_nodeID,json=onlineNodeID,proto3" json:"online_nodeID,omitempty"`
+	OnlineSegments       []*SegmentInfo `protobuf:"bytes,2,rep,name=online_segments,json=onlineSegments,proto3" json:"online_segments,omitempty"`
+	OfflineNodeID        int64          `protobuf:"varint,3,opt,name=offline_nodeID,json=offlineNodeID,proto3" json:"offline_nodeID,omitempty"`
+	OfflineSegments      []*SegmentInfo `protobuf:"bytes,4,rep,name=offline_segments,json=offlineSegments,proto3" json:"offline_segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SegmentChangeInfo) Reset()         { *m = SegmentChangeInfo{} }
+func (m *SegmentChangeInfo) String() string { return proto.CompactTextString(m) }
+func (*SegmentChangeInfo) ProtoMessage()    {}
+func (*SegmentChangeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{42}
+}
+
+func (m *SegmentChangeInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentChangeInfo.Unmarshal(m, b)
+}
+func (m *SegmentChangeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentChangeInfo.Marshal(b, m, deterministic)
+}
+func (m *SegmentChangeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentChangeInfo.Merge(m, src)
+}
+func (m *SegmentChangeInfo) XXX_Size() int {
+	return xxx_messageInfo_SegmentChangeInfo.Size(m)
+}
+func (m *SegmentChangeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentChangeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentChangeInfo proto.InternalMessageInfo
+
+func (m *SegmentChangeInfo) GetOnlineNodeID() int64 {
+	if m != nil {
+		return m.OnlineNodeID
+	}
+	return 0
+}
+
+func (m *SegmentChangeInfo) GetOnlineSegments() []*SegmentInfo {
+	if m != nil {
+		return m.OnlineSegments
+	}
+	return nil
+}
+
+func (m *SegmentChangeInfo) GetOfflineNodeID() int64 {
+	if m != nil {
+		return m.OfflineNodeID
+	}
+	return 0
+}
+
+func (m *SegmentChangeInfo) GetOfflineSegments() []*SegmentInfo {
+	if m != nil {
+		return m.OfflineSegments
+	}
+	return nil
+}
+
+type SealedSegmentsChangeInfo struct {
+	Base                 *commonpb.MsgBase    `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Infos                []*SegmentChangeInfo `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SealedSegmentsChangeInfo) Reset()         { *m = SealedSegmentsChangeInfo{} }
+func (m *SealedSegmentsChangeInfo) String() string { return proto.CompactTextString(m) }
+func (*SealedSegmentsChangeInfo) ProtoMessage()    {}
+func (*SealedSegmentsChangeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{43}
+}
+
+func (m *SealedSegmentsChangeInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SealedSegmentsChangeInfo.Unmarshal(m, b)
+}
+func (m *SealedSegmentsChangeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SealedSegmentsChangeInfo.Marshal(b, m, deterministic)
+}
+func (m *SealedSegmentsChangeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SealedSegmentsChangeInfo.Merge(m, src)
+}
+func (m *SealedSegmentsChangeInfo) XXX_Size() int {
+	return xxx_messageInfo_SealedSegmentsChangeInfo.Size(m)
+}
+func (m *SealedSegmentsChangeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SealedSegmentsChangeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SealedSegmentsChangeInfo proto.InternalMessageInfo
+
+func (m *SealedSegmentsChangeInfo) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SealedSegmentsChangeInfo) GetInfos() []*SegmentChangeInfo {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+type GetDataDistributionRequest struct {
+	Base                 *commonpb.MsgBase             `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Checkpoints          map[string]*msgpb.MsgPosition `protobuf:"bytes,2,rep,name=checkpoints,proto3" json:"checkpoints,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *GetDataDistributionRequest) Reset()         { *m = GetDataDistributionRequest{} }
+func (m *GetDataDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDataDistributionRequest) ProtoMessage()    {}
+func (*GetDataDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{44}
+}
+
+func (m *GetDataDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDataDistributionRequest.Unmarshal(m, b)
+}
+func (m *GetDataDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDataDistributionRequest.Marshal(b, m, deterministic)
+}
+func (m *GetDataDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDataDistributionRequest.Merge(m, src)
+}
+func (m *GetDataDistributionRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDataDistributionRequest.Size(m)
+}
+func (m *GetDataDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDataDistributionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDataDistributionRequest proto.InternalMessageInfo
+
+func (m *GetDataDistributionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetDataDistributionRequest) GetCheckpoints() map[string]*msgpb.MsgPosition {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
+type GetDataDistributionResponse struct {
+	Status      *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	NodeID      int64                 `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Segments    []*SegmentVersionInfo `protobuf:"bytes,3,rep,name=segments,proto3" json:"segments,omitempty"`
+	Channels    []*ChannelVersionInfo `protobuf:"bytes,4,rep,name=channels,proto3" json:"channels,omitempty"`
+	LeaderViews []*LeaderView         `protobuf:"bytes,5,rep,name=leader_views,json=leaderViews,proto3" json:"leader_views,omitempty"`
+	// sealed segments being loaded
+	LoadingSegments      []*SegmentLoadingProgress `protobuf:"bytes,6,rep,name=loading_segments,json=loadingSegments,proto3" json:"loading_segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *GetDataDistributionResponse) Reset()         { *m = GetDataDistributionResponse{} }
+func (m *GetDataDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDataDistributionResponse) ProtoMessage()    {}
+func (*GetDataDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{45}
+}
+
+func (m *GetDataDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDataDistributionResponse.Unmarshal(m, b)
+}
+func (m *GetDataDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDataDistributionResponse.Marshal(b, m, deterministic)
+}
+func (m *GetDataDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDataDistributionResponse.Merge(m, src)
+}
+func (m *GetDataDistributionResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDataDistributionResponse.Size(m)
+}
+func (m *GetDataDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDataDistributionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDataDistributionResponse proto.InternalMessageInfo
+
+func (m *GetDataDistributionResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetDataDistributionResponse) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *GetDataDistributionResponse) GetSegments() []*SegmentVersionInfo {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *GetDataDistributionResponse) GetChannels() []*ChannelVersionInfo {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *GetDataDistributionResponse) GetLeaderViews() []*LeaderView {
+	if m != nil {
+		return m.LeaderViews
+	}
+	return nil
+}
+
+func (m *GetDataDistributionResponse) GetLoadingSegments() []*SegmentLoadingProgress {
+	if m != nil {
+		return m.LoadingSegments
+	}
+	return nil
+}
+
+type LeaderView struct {
+	Collection           int64                        `protobuf:"varint,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Channel              string                       `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	SegmentDist          map[int64]*SegmentDist       `protobuf:"bytes,3,rep,name=segment_dist,json=segmentDist,proto3" json:"segment_dist,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	GrowingSegmentIDs    []int64                      `protobuf:"varint,4,rep,packed,name=growing_segmentIDs,json=growingSegmentIDs,proto3" json:"growing_segmentIDs,omitempty"`
+	GrowingSegments      map[int64]*msgpb.MsgPosition `protobuf:"bytes,5,rep,name=growing_segments,json=growingSegments,proto3" json:"growing_segments,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TargetVersion        int64                        `protobuf:"varint,6,opt,name=TargetVersion,proto3" json:"TargetVersion,omitempty"`
+	NumOfGrowingRows     int64                        `protobuf:"varint,7,opt,name=num_of_growing_rows,json=numOfGrowingRows,proto3" json:"num_of_growing_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *LeaderView) Reset()         { *m = LeaderView{} }
+func (m *LeaderView) String() string { return proto.CompactTextString(m) }
+func (*LeaderView) ProtoMessage()    {}
+func (*LeaderView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{46}
+}
+
+func (m *LeaderView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaderView.Unmarshal(m, b)
+}
+func (m *LeaderView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaderView.Marshal(b, m, deterministic)
+}
+func (m *LeaderView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaderView.Merge(m, src)
+}
+func (m *LeaderView) XXX_Size() int {
+	return xxx_messageInfo_LeaderView.Size(m)
+}
+func (m *LeaderView) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaderView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaderView proto.InternalMessageInfo
+
+func (m *LeaderView) GetCollection() int64 {
+	if m != nil {
+		return m.Collection
+	}
+	return 0
+}
+
+func (m *LeaderView) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *LeaderView) GetSegmentDist() map[int64]*SegmentDist {
+	if m != nil {
+		return m.SegmentDist
+	}
+	return nil
+}
+
+func (m *LeaderView) GetGrowingSegmentIDs() []int64 {
+	if m != nil {
+		return m.GrowingSegmentIDs
+	}
+	return nil
+}
+
+func (m *LeaderView) GetGrowingSegments() map[int64]*msgpb.MsgPosition {
+	if m != nil {
+		return m.GrowingSegments
+	}
+	return nil
+}
+
+func (m *LeaderView) GetTargetVersion() int64 {
+	if m != nil {
+		return m.TargetVersion
+	}
+	return 0
+}
+
+func (m *LeaderView) GetNumOfGrowingRows() int64 {
+	if m != nil {
+		return m.NumOfGrowingRows
+	}
+	return 0
+}
+
+type SegmentDist struct {
+	NodeID               int64    `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Version              int64    `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentDist) Reset()         { *m = SegmentDist{} }
+func (m *SegmentDist) String() string { return proto.CompactTextString(m) }
+func (*SegmentDist) ProtoMessage()    {}
+func (*SegmentDist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{47}
+}
+
+func (m *SegmentDist) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentDist.Unmarshal(m, b)
+}
+func (m *SegmentDist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentDist.Marshal(b, m, deterministic)
+}
+func (m *SegmentDist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentDist.Merge(m, src)
+}
+func (m *SegmentDist) XXX_Size() int {
+	return xxx_messageInfo_SegmentDist.Size(m)
+}
+func (m *SegmentDist) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentDist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentDist proto.InternalMessageInfo
+
+func (m *SegmentDist) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *SegmentDist) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type SegmentVersionInfo struct {
+	ID                   int64                     `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Collection           int64                     `protobuf:"varint,2,opt,name=collection,proto3" json:"collection,omitempty"`
+	Partition            int64                     `protobuf:"varint,3,opt,name=partition,proto3" json:"partition,omitempty"`
+	Channel              string                    `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	Version              int64                     `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	LastDeltaTimestamp   uint64                    `protobuf:"varint,6,opt,name=last_delta_timestamp,json=lastDeltaTimestamp,proto3" json:"last_delta_timestamp,omitempty"`
+	IndexInfo            map[int64]*FieldIndexInfo `protobuf:"bytes,7,rep,name=index_info,json=indexInfo,proto3" json:"index_info,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *SegmentVersionInfo) Reset()         { *m = SegmentVersionInfo{} }
+func (m *SegmentVersionInfo) String() string { return proto.CompactTextString(m) }
+func (*SegmentVersionInfo) ProtoMessage()    {}
+func (*SegmentVersionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{48}
+}
+
+func (m *SegmentVersionInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentVersionInfo.Unmarshal(m, b)
+}
+func (m *SegmentVersionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentVersionInfo.Marshal(b, m, deterministic)
+}
+func (m *SegmentVersionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentVersionInfo.Merge(m, src)
+}
+func (m *SegmentVersionInfo) XXX_Size() int {
+	return xxx_messageInfo_SegmentVersionInfo.Size(m)
+}
+func (m *SegmentVersionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentVersionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentVersionInfo proto.InternalMessageInfo
+
+func (m *SegmentVersionInfo) GetID() int64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *SegmentVersionInfo) GetCollection() int64 {
+	if m != nil {
+		return m.Collection
+	}
+	return 0
+}
+
+func (m *SegmentVersionInfo) GetPartition() int64 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+func (m *SegmentVersionInfo) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *SegmentVersionInfo) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SegmentVersionInfo) GetLastDeltaTimestamp() uint64 {
+	if m != nil {
+		return m.LastDeltaTimestamp
+	}
+	return 0
+}
+
+func (m *SegmentVersionInfo) GetIndexInfo() map[int64]*FieldIndexInfo {
+	if m != nil {
+		return m.IndexInfo
+	}
+	return nil
+}
+
+type ChannelVersionInfo struct {
+	Channel              string   `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Collection           int64    `protobuf:"varint,2,opt,name=collection,proto3" json:"collection,omitempty"`
+	Version              int64    `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelVersionInfo) Reset()         { *m = ChannelVersionInfo{} }
+func (m *ChannelVersionInfo) String() string { return proto.CompactTextString(m) }
+func (*ChannelVersionInfo) ProtoMessage()    {}
+func (*ChannelVersionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{49}
+}
+
+func (m *ChannelVersionInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelVersionInfo.Unmarshal(m, b)
+}
+func (m *ChannelVersionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelVersionInfo.Marshal(b, m, deterministic)
+}
+func (m *ChannelVersionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelVersionInfo.Merge(m, src)
+}
+func (m *ChannelVersionInfo) XXX_Size() int {
+	return xxx_messageInfo_ChannelVersionInfo.Size(m)
+}
+func (m *ChannelVersionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelVersionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelVersionInfo proto.InternalMessageInfo
+
+func (m *ChannelVersionInfo) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *ChannelVersionInfo) GetCollection() int64 {
+	if m != nil {
+		return m.Collection
+	}
+	return 0
+}
+
+func (m *ChannelVersionInfo) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type CollectionLoadInfo struct {
+	CollectionID       int64           `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	ReleasedPartitions []int64         `protobuf:"varint,2,rep,packed,name=released_partitions,json=releasedPartitions,proto3" json:"released_partitions,omitempty"`
+	ReplicaNumber      int32           `protobuf:"varint,3,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
+	Status             LoadStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=milvus.proto.query.LoadStatus" json:"status,omitempty"`
+	FieldIndexID       map[int64]int64 `protobuf:"bytes,5,rep,name=field_indexID,json=fieldIndexID,proto3" json:"field_indexID,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	LoadType           LoadType        `protobuf:"varint,6,opt,name=load_type,json=loadType,proto3,enum=milvus.proto.query.LoadType" json:"load_type,omitempty"`
+	RecoverTimes       int32           `protobuf:"varint,7,opt,name=recover_times,json=recoverTimes,proto3" json:"recover_times,omitempty"`
+	BestEffort         bool            `protobuf:"varint,8,opt,name=best_effort,json=bestEffort,proto3" json:"best_effort,omitempty"`
+	ResourceGroups     []string        `protobuf:"bytes,9,rep,name=resource_groups,json=resourceGroups,proto3" json:"resource_groups,omitempty"`
+	Priority           int32           `protobuf:"varint,10,opt,name=priority,proto3" json:"priority,omitempty"`
+	Tenant             string          `protobuf:"bytes,12,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// excluded from auto balance, manual balance is still allowed
+	BalanceExcluded bool                `protobuf:"varint,13,opt,name=balance_excluded,json=balanceExcluded,proto3" json:"balance_excluded,omitempty"`
+	ZonePlacement   ZonePlacementPolicy `protobuf:"varint,14,opt,name=zone_placement,json=zonePlacement,proto3,enum=milvus.proto.query.ZonePlacementPolicy" json:"zone_placement,omitempty"`
+	// balancer used by the collection, the global one is used if empty
+	BalancePolicy string `protobuf:"bytes,15,opt,name=balance_policy,json=balancePolicy,proto3" json:"balance_policy,omitempty"`
+	// segment id -> node id, where the segment is pinned to
+	SegmentNodeHints map[int64]int64 `protobuf:"bytes,16,rep,name=segment_node_hints,json=segmentNodeHints,proto3" json:"segment_node_hints,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// number of replicas kept as warm standby
+	StandbyReplicaNumber int32    `protobuf:"varint,17,opt,name=standby_replica_number,json=standbyReplicaNumber,proto3" json:"standby_replica_number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CollectionLoadInfo) Reset()         { *m = CollectionLoadInfo{} }
+func (m *CollectionLoadInfo) String() string { return proto.CompactTextString(m) }
+func (*CollectionLoadInfo) ProtoMessage()    {}
+func (*CollectionLoadInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{50}
+}
+
+func (m *CollectionLoadInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollectionLoadInfo.Unmarshal(m, b)
+}
+func (m *CollectionLoadInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollectionLoadInfo.Marshal(b, m, deterministic)
+}
+func (m *CollectionLoadInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionLoadInfo.Merge(m, src)
+}
+func (m *CollectionLoadInfo) XXX_Size() int {
+	return xxx_messageInfo_CollectionLoadInfo.Size(m)
+}
+func (m *CollectionLoadInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionLoadInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionLoadInfo proto.InternalMessageInfo
+
+func (m *CollectionLoadInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *CollectionLoadInfo) GetReleasedPartitions() []int64 {
+	if m != nil {
+		return m.ReleasedPartitions
+	}
+	return nil
+}
+
+func (m *CollectionLoadInfo) GetReplicaNumber() int32 {
+	if m != nil {
+		return m.ReplicaNumber
+	}
+	return 0
+}
+
+func (m *CollectionLoadInfo) GetStatus() LoadStatus {
+	if m != nil {
+		return m.Status
+	}
+	return LoadStatus_Invalid
+}
+
+func (m *CollectionLoadInfo) GetFieldIndexID() map[int64]int64 {
+	if m != nil {
+		return m.FieldIndexID
+	}
+	return nil
+}
+
+func (m *CollectionLoadInfo) GetLoadType() LoadType {
+	if m != nil {
+		return m.LoadType
+	}
+	return LoadType_UnKnownType
+}
+
+func (m *CollectionLoadInfo) GetRecoverTimes() int32 {
+	if m != nil {
+		return m.RecoverTimes
+	}
+	return 0
+}
+
+func (m *CollectionLoadInfo) GetBestEffort() bool {
+	if m != nil {
+		return m.BestEffort
+	}
+	return false
+}
+
+func (m *CollectionLoadInfo) GetResourceGroups() []string {
+	if m != nil {
+		return m.ResourceGroups
+	}
+	return nil
+}
+
+func (m *CollectionLoadInfo) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *CollectionLoadInfo) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+func (m *CollectionLoadInfo) GetBalanceExcluded() bool {
+	if m != nil {
+		return m.BalanceExcluded
+	}
+	return false
+}
+
+func (m *CollectionLoadInfo) GetZonePlacement() ZonePlacementPolicy {
+	if m != nil {
+		return m.ZonePlacement
+	}
+	return ZonePlacementPolicy_ZonePlacementNone
+}
+
+func (m *CollectionLoadInfo) GetBalancePolicy() string {
+	if m != nil {
+		return m.BalancePolicy
+	}
+	return ""
+}
+
+func (m *CollectionLoadInfo) GetSegmentNodeHints() map[int64]int64 {
+	if m != nil {
+		return m.SegmentNodeHints
+	}
+	return nil
+}
+
+func (m *CollectionLoadInfo) GetStandbyReplicaNumber() int32 {
+	if m != nil {
+		return m.StandbyReplicaNumber
+	}
+	return 0
+}
+
+type PartitionLoadInfo struct {
+	CollectionID         int64           `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64           `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	ReplicaNumber        int32           `protobuf:"varint,3,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
+	Status               LoadStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=milvus.proto.query.LoadStatus" json:"status,omitempty"`
+	FieldIndexID         map[int64]int64 `protobuf:"bytes,5,rep,name=field_indexID,json=fieldIndexID,proto3" json:"field_indexID,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	RecoverTimes         int32           `protobuf:"varint,7,opt,name=recover_times,json=recoverTimes,proto3" json:"recover_times,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *PartitionLoadInfo) Reset()         { *m = PartitionLoadInfo{} }
+func (m *PartitionLoadInfo) String() string { return proto.CompactTextString(m) }
+func (*PartitionLoadInfo) ProtoMessage()    {}
+func (*PartitionLoadInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{51}
+}
+
+func (m *PartitionLoadInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PartitionLoadInfo.Unmarshal(m, b)
+}
+func (m *PartitionLoadInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PartitionLoadInfo.Marshal(b, m, deterministic)
+}
+func (m *PartitionLoadInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionLoadInfo.Merge(m, src)
+}
+func (m *PartitionLoadInfo) XXX_Size() int {
+	return xxx_messageInfo_PartitionLoadInfo.Size(m)
+}
+func (m *PartitionLoadInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionLoadInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionLoadInfo proto.InternalMessageInfo
+
+func (m *PartitionLoadInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *PartitionLoadInfo) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *PartitionLoadInfo) GetReplicaNumber() int32 {
+	if m != nil {
+		return m.ReplicaNumber
+	}
+	return 0
+}
+
+func (m *PartitionLoadInfo) GetStatus() LoadStatus {
+	if m != nil {
+		return m.Status
+	}
+	return LoadStatus_Invalid
+}
+
+func (m *PartitionLoadInfo) GetFieldIndexID() map[int64]int64 {
+	if m != nil {
+		return m.FieldIndexID
+	}
+	return nil
+}
+
+func (m *PartitionLoadInfo) GetRecoverTimes() int32 {
+	if m != nil {
+		return m.RecoverTimes
+	}
+	return 0
+}
+
+type Replica struct {
+	ID            int64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CollectionID  int64   `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Nodes         []int64 `protobuf:"varint,3,rep,packed,name=nodes,proto3" json:"nodes,omitempty"`
+	ResourceGroup string  `protobuf:"bytes,4,opt,name=resource_group,json=resourceGroup,proto3" json:"resource_group,omitempty"`
+	RoNodes       []int64 `protobuf:"varint,5,rep,packed,name=ro_nodes,json=roNodes,proto3" json:"ro_nodes,omitempty"`
+	// can not load new channel or segment on it anymore.
+	Standby              bool     `protobuf:"varint,6,opt,name=standby,proto3" json:"standby,omitempty"`
+	Tenant               string   `protobuf:"bytes,7,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Replica) Reset()         { *m = Replica{} }
+func (m *Replica) String() string { return proto.CompactTextString(m) }
+func (*Replica) ProtoMessage()    {}
+func (*Replica) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{52}
+}
+
+func (m *Replica) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Replica.Unmarshal(m, b)
+}
+func (m *Replica) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Replica.Marshal(b, m, deterministic)
+}
+func (m *Replica) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Replica.Merge(m, src)
+}
+func (m *Replica) XXX_Size() int {
+	return xxx_messageInfo_Replica.Size(m)
+}
+func (m *Replica) XXX_DiscardUnknown() {
+	xxx_messageInfo_Replica.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Replica proto.InternalMessageInfo
+
+func (m *Replica) GetID() int64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *Replica) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *Replica) GetNodes() []int64 {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *Replica) GetResourceGroup() string {
+	if m != nil {
+		return m.ResourceGroup
+	}
+	return ""
+}
+
+func (m *Replica) GetRoNodes() []int64 {
+	if m != nil {
+		return m.RoNodes
+	}
+	return nil
+}
+
+func (m *Replica) GetStandby() bool {
+	if m != nil {
+		return m.Standby
+	}
+	return false
+}
+
+func (m *Replica) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+type SyncAction struct {
+	Type                 SyncType           `protobuf:"varint,1,opt,name=type,proto3,enum=milvus.proto.query.SyncType" json:"type,omitempty"`
+	PartitionID          int64              `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	SegmentID            int64              `protobuf:"varint,3,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	NodeID               int64              `protobuf:"varint,4,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Version              int64              `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	Info                 *SegmentLoadInfo   `protobuf:"bytes,6,opt,name=info,proto3" json:"info,omitempty"`
+	GrowingInTarget      []int64            `protobuf:"varint,7,rep,packed,name=growingInTarget,proto3" json:"growingInTarget,omitempty"`
+	SealedInTarget       []int64            `protobuf:"varint,8,rep,packed,name=sealedInTarget,proto3" json:"sealedInTarget,omitempty"`
+	TargetVersion        int64              `protobuf:"varint,9,opt,name=TargetVersion,proto3" json:"TargetVersion,omitempty"`
+	DroppedInTarget      []int64            `protobuf:"varint,10,rep,packed,name=droppedInTarget,proto3" json:"droppedInTarget,omitempty"`
+	Checkpoint           *msgpb.MsgPosition `protobuf:"bytes,11,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SyncAction) Reset()         { *m = SyncAction{} }
+func (m *SyncAction) String() string { return proto.CompactTextString(m) }
+func (*SyncAction) ProtoMessage()    {}
+func (*SyncAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{53}
+}
+
+func (m *SyncAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncAction.Unmarshal(m, b)
+}
+func (m *SyncAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncAction.Marshal(b, m, deterministic)
+}
+func (m *SyncAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncAction.Merge(m, src)
+}
+func (m *SyncAction) XXX_Size() int {
+	return xxx_messageInfo_SyncAction.Size(m)
+}
+func (m *SyncAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncAction proto.InternalMessageInfo
+
+func (m *SyncAction) GetType() SyncType {
+	if m != nil {
+		return m.Type
+	}
+	return SyncType_Remove
+}
+
+func (m *SyncAction) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *SyncAction) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *SyncAction) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *SyncAction) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SyncAction) GetInfo() *SegmentLoadInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (m *SyncAction) GetGrowingInTarget() []int64 {
+	if m != nil {
+		return m.GrowingInTarget
+	}
+	return nil
+}
+
+func (m *SyncAction) GetSealedInTarget() []int64 {
+	if m != nil {
+		return m.SealedInTarget
+	}
+	return nil
+}
+
+func (m *SyncAction) GetTargetVersion() int64 {
+	if m != nil {
+		return m.TargetVersion
+	}
+	return 0
+}
+
+func (m *SyncAction) GetDroppedInTarget() []int64 {
+	if m != nil {
+		return m.DroppedInTarget
+	}
+	return nil
+}
+
+func (m *SyncAction) GetCheckpoint() *msgpb.MsgPosition {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return nil
+}
+
+type SyncDistributionRequest struct {
+	Base                 *commonpb.MsgBase          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64                      `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Channel              string                     `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	Actions              []*SyncAction              `protobuf:"bytes,4,rep,name=actions,proto3" json:"actions,omitempty"`
+	Schema               *schemapb.CollectionSchema `protobuf:"bytes,5,opt,name=schema,proto3" json:"schema,omitempty"`
+	LoadMeta             *LoadMetaInfo              `protobuf:"bytes,6,opt,name=load_meta,json=loadMeta,proto3" json:"load_meta,omitempty"`
+	ReplicaID            int64                      `protobuf:"varint,7,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
+	Version              int64                      `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	IndexInfoList        []*indexpb.IndexInfo       `protobuf:"bytes,9,rep,name=index_info_list,json=indexInfoList,proto3" json:"index_info_list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *SyncDistributionRequest) Reset()         { *m = SyncDistributionRequest{} }
+func (m *SyncDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*SyncDistributionRequest) ProtoMessage()    {}
+func (*SyncDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{54}
+}
+
+func (m *SyncDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncDistributionRequest.Unmarshal(m, b)
+}
+func (m *SyncDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncDistributionRequest.Marshal(b, m, deterministic)
+}
+func (m *SyncDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncDistributionRequest.Merge(m, src)
+}
+func (m *SyncDistributionRequest) XXX_Size() int {
+	return xxx_messageInfo_SyncDistributionRequest.Size(m)
+}
+func (m *SyncDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncDistributionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncDistributionRequest proto.InternalMessageInfo
+
+func (m *SyncDistributionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SyncDistributionRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *SyncDistributionRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *SyncDistributionRequest) GetActions() []*SyncAction {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+func (m *SyncDistributionRequest) GetSchema() *schemapb.CollectionSchema {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+func (m *SyncDistributionRequest) GetLoadMeta() *LoadMetaInfo {
+	if m != nil {
+		return m.LoadMeta
+	}
+	return nil
+}
+
+func (m *SyncDistributionRequest) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
+func (m *SyncDistributionRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SyncDistributionRequest) GetIndexInfoList() []*indexpb.IndexInfo {
+	if m != nil {
+		return m.IndexInfoList
+	}
+	return nil
+}
+
+type ResourceGroup struct {
+	Name     string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Capacity int32                     `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"` // Deprecated: Do not use.
+	Nodes    []int64                   `protobuf:"varint,3,rep,packed,name=nodes,proto3" json:"nodes,omitempty"`
+	Config   *rgpb.ResourceGroupConfig `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	// only the nodes whose labels match all of the selector can be assigned automatically.
+	NodeSelector         map[string]string `protobuf:"bytes,5,rep,name=node_selector,json=nodeSelector,proto3" json:"node_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ResourceGroup) Reset()         { *m = ResourceGroup{} }
+func (m *ResourceGroup) String() string { return proto.CompactTextString(m) }
+func (*ResourceGroup) ProtoMessage()    {}
+func (*ResourceGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{55}
+}
+
+func (m *ResourceGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourceGroup.Unmarshal(m, b)
+}
+func (m *ResourceGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourceGroup.Marshal(b, m, deterministic)
+}
+func (m *ResourceGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceGroup.Merge(m, src)
+}
+func (m *ResourceGroup) XXX_Size() int {
+	return xxx_messageInfo_ResourceGroup.Size(m)
+}
+func (m *ResourceGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceGroup proto.InternalMessageInfo
+
+func (m *ResourceGroup) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (m *ResourceGroup) GetCapacity() int32 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *ResourceGroup) GetNodes() []int64 {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *ResourceGroup) GetConfig() *rgpb.ResourceGroupConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *ResourceGroup) GetNodeSelector() map[string]string {
+	if m != nil {
+		return m.NodeSelector
+	}
+	return nil
+}
+
+// transfer `replicaNum` replicas in `collectionID` from `source_resource_group` to `target_resource_groups`
+type TransferReplicaRequest struct {
+	Base                *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SourceResourceGroup string            `protobuf:"bytes,2,opt,name=source_resource_group,json=sourceResourceGroup,proto3" json:"source_resource_group,omitempty"`
+	TargetResourceGroup string            `protobuf:"bytes,3,opt,name=target_resource_group,json=targetResourceGroup,proto3" json:"target_resource_group,omitempty"`
+	CollectionID        int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	NumReplica          int64             `protobuf:"varint,5,opt,name=num_replica,json=numReplica,proto3" json:"num_replica,omitempty"`
+	// skip the capacity check of the target resource group
+	Force                bool     `protobuf:"varint,6,opt,name=force,proto3" json:"force,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransferReplicaRequest) Reset()         { *m = TransferReplicaRequest{} }
+func (m *TransferReplicaRequest) String() string { return proto.CompactTextString(m) }
+func (*TransferReplicaRequest) ProtoMessage()    {}
+func (*TransferReplicaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{56}
+}
+
+func (m *TransferReplicaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferReplicaRequest.Unmarshal(m, b)
+}
+func (m *TransferReplicaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferReplicaRequest.Marshal(b, m, deterministic)
+}
+func (m *TransferReplicaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferReplicaRequest.Merge(m, src)
+}
+func (m *TransferReplicaRequest) XXX_Size() int {
+	return xxx_messageInfo_TransferReplicaRequest.Size(m)
+}
+func (m *TransferReplicaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferReplicaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferReplicaRequest proto.InternalMessageInfo
+
+func (m *TransferReplicaRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *TransferReplicaRequest) GetSourceResourceGroup() string {
+	if m != nil {
+		return m.SourceResourceGroup
+	}
+	return ""
+}
+
+func (m *TransferReplicaRequest) GetTargetResourceGroup() string {
+	if m != nil {
+		return m.TargetResourceGroup
+	}
+	return ""
+}
+
+func (m *TransferReplicaRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *TransferReplicaRequest) GetNumReplica() int64 {
+	if m != nil {
+		return m.NumReplica
+	}
+	return 0
+}
+
+func (m *TransferReplicaRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+type DescribeResourceGroupRequest struct {
+	Base          *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ResourceGroup string            `protobuf:"bytes,2,opt,name=resource_group,json=resourceGroup,proto3" json:"resource_group,omitempty"`
+	// also list the replicas and collections in the resource group
+	Verbose              bool     `protobuf:"varint,3,opt,name=verbose,proto3" json:"verbose,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DescribeResourceGroupRequest) Reset()         { *m = DescribeResourceGroupRequest{} }
+func (m *DescribeResourceGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeResourceGroupRequest) ProtoMessage()    {}
+func (*DescribeResourceGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{57}
+}
+
+func (m *DescribeResourceGroupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeResourceGroupRequest.Unmarshal(m, b)
+}
+func (m *DescribeResourceGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeResourceGroupRequest.Marshal(b, m, deterministic)
+}
+func (m *DescribeResourceGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeResourceGroupRequest.Merge(m, src)
+}
+func (m *DescribeResourceGroupRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeResourceGroupRequest.Size(m)
+}
+func (m *DescribeResourceGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeResourceGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeResourceGroupRequest proto.InternalMessageInfo
+
+func (m *DescribeResourceGroupRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DescribeResourceGroupRequest) GetResourceGroup() string {
+	if m != nil {
+		return m.ResourceGroup
+	}
+	return ""
+}
+
+func (m *DescribeResourceGroupRequest) GetVerbose() bool {
+	if m != nil {
+		return m.Verbose
+	}
+	return false
+}
+
+type DescribeResourceGroupResponse struct {
+	Status               *commonpb.Status   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ResourceGroup        *ResourceGroupInfo `protobuf:"bytes,2,opt,name=resource_group,json=resourceGroup,proto3" json:"resource_group,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *DescribeResourceGroupResponse) Reset()         { *m = DescribeResourceGroupResponse{} }
+func (m *DescribeResourceGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeResourceGroupResponse) ProtoMessage()    {}
+func (*DescribeResourceGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{58}
+}
+
+func (m *DescribeResourceGroupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeResourceGroupResponse.Unmarshal(m, b)
+}
+func (m *DescribeResourceGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeResourceGroupResponse.Marshal(b, m, deterministic)
+}
+func (m *DescribeResourceGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeResourceGroupResponse.Merge(m, src)
+}
+func (m *DescribeResourceGroupResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeResourceGroupResponse.Size(m)
+}
+func (m *DescribeResourceGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeResourceGroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeResourceGroupResponse proto.InternalMessageInfo
+
+func (m *DescribeResourceGroupResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *DescribeResourceGroupResponse) GetResourceGroup() *ResourceGroupInfo {
+	if m != nil {
+		return m.ResourceGroup
+	}
+	return nil
+}
+
+type ResourceGroupInfo struct {
+	Name             string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Capacity         int32  `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"` // Deprecated: Do not use.
+	NumAvailableNode int32  `protobuf:"varint,3,opt,name=num_available_node,json=numAvailableNode,proto3" json:"num_available_node,omitempty"`
+	// collection id -> loaded replica num
+	NumLoadedReplica map[int64]int32 `protobuf:"bytes,4,rep,name=num_loaded_replica,json=numLoadedReplica,proto3" json:"num_loaded_replica,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// collection id -> accessed other rg's node num
+	NumOutgoingNode map[int64]int32 `protobuf:"bytes,5,rep,name=num_outgoing_node,json=numOutgoingNode,proto3" json:"num_outgoing_node,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// collection id -> be accessed node num by other rg
+	NumIncomingNode map[int64]int32 `protobuf:"bytes,6,rep,name=num_incoming_node,json=numIncomingNode,proto3" json:"num_incoming_node,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// resource group configuration.
+	Config *rgpb.ResourceGroupConfig `protobuf:"bytes,7,opt,name=config,proto3" json:"config,omitempty"`
+	Nodes  []*commonpb.NodeInfo      `protobuf:"bytes,8,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// the nodes which are suspended from new assignments
+	SuspendedNodes []int64           `protobuf:"varint,9,rep,packed,name=suspended_nodes,json=suspendedNodes,proto3" json:"suspended_nodes,omitempty"`
+	NodeSelector   map[string]string `protobuf:"bytes,10,rep,name=node_selector,json=nodeSelector,proto3" json:"node_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// the number of nodes currently assigned to the resource group.
+	NumNode int32 `protobuf:"varint,11,opt,name=num_node,json=numNode,proto3" json:"num_node,omitempty"`
+	// the number of nodes below config.requests.nodeNum, filled by incoming nodes first.
+	NumMissingNode int32 `protobuf:"varint,12,opt,name=num_missing_node,json=numMissingNode,proto3" json:"num_missing_node,omitempty"`
+	// the number of nodes above config.limits.nodeNum, moved to other resource groups.
+	NumRedundantNode int32 `protobuf:"varint,13,opt,name=num_redundant_node,json=numRedundantNode,proto3" json:"num_redundant_node,omitempty"`
+	// the replicas in the resource group, only filled in verbose mode.
+	ReplicaIDs []int64 `protobuf:"varint,14,rep,packed,name=replicaIDs,proto3" json:"replicaIDs,omitempty"`
+	// the collections having replicas in the resource group, only filled in verbose mode.
+	CollectionIDs        []int64  `protobuf:"varint,15,rep,packed,name=collectionIDs,proto3" json:"collectionIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResourceGroupInfo) Reset()         { *m = ResourceGroupInfo{} }
+func (m *ResourceGroupInfo) String() string { return proto.CompactTextString(m) }
+func (*ResourceGroupInfo) ProtoMessage()    {}
+func (*ResourceGroupInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{59}
+}
+
+func (m *ResourceGroupInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourceGroupInfo.Unmarshal(m, b)
+}
+func (m *ResourceGroupInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourceGroupInfo.Marshal(b, m, deterministic)
+}
+func (m *ResourceGroupInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceGroupInfo.Merge(m, src)
+}
+func (m *ResourceGroupInfo) XXX_Size() int {
+	return xxx_messageInfo_ResourceGroupInfo.Size(m)
+}
+func (m *ResourceGroupInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceGroupInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceGroupInfo proto.InternalMessageInfo
+
+func (m *ResourceGroupInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (m *ResourceGroupInfo) GetCapacity() int32 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *ResourceGroupInfo) GetNumAvailableNode() int32 {
+	if m != nil {
+		return m.NumAvailableNode
+	}
+	return 0
+}
+
+func (m *ResourceGroupInfo) GetNumLoadedReplica() map[int64]int32 {
+	if m != nil {
+		return m.NumLoadedReplica
+	}
+	return nil
+}
+
+func (m *ResourceGroupInfo) GetNumOutgoingNode() map[int64]int32 {
+	if m != nil {
+		return m.NumOutgoingNode
+	}
+	return nil
+}
+
+func (m *ResourceGroupInfo) GetNumIncomingNode() map[int64]int32 {
+	if m != nil {
+		return m.NumIncomingNode
+	}
+	return nil
+}
+
+func (m *ResourceGroupInfo) GetConfig() *rgpb.ResourceGroupConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *ResourceGroupInfo) GetNodes() []*commonpb.NodeInfo {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *ResourceGroupInfo) GetSuspendedNodes() []int64 {
+	if m != nil {
+		return m.SuspendedNodes
+	}
+	return nil
+}
+
+func (m *ResourceGroupInfo) GetNodeSelector() map[string]string {
+	if m != nil {
+		return m.NodeSelector
+	}
+	return nil
+}
+
+func (m *ResourceGroupInfo) GetNumNode() int32 {
+	if m != nil {
+		return m.NumNode
+	}
+	return 0
+}
+
+func (m *ResourceGroupInfo) GetNumMissingNode() int32 {
+	if m != nil {
+		return m.NumMissingNode
+	}
+	return 0
+}
+
+func (m *ResourceGroupInfo) GetNumRedundantNode() int32 {
+	if m != nil {
+		return m.NumRedundantNode
+	}
+	return 0
+}
+
+func (m *ResourceGroupInfo) GetReplicaIDs() []int64 {
+	if m != nil {
+		return m.ReplicaIDs
+	}
+	return nil
+}
+
+func (m *ResourceGroupInfo) GetCollectionIDs() []int64 {
+	if m != nil {
+		return m.CollectionIDs
+	}
+	return nil
+}
+
+type DeleteRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionId         int64             `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	PartitionId          int64             `protobuf:"varint,3,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+	VchannelName         string            `protobuf:"bytes,4,opt,name=vchannel_name,json=vchannelName,proto3" json:"vchannel_name,omitempty"`
+	SegmentId            int64             `protobuf:"varint,5,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	PrimaryKeys          *schemapb.IDs     `protobuf:"bytes,6,opt,name=primary_keys,json=primaryKeys,proto3" json:"primary_keys,omitempty"`
+	Timestamps           []uint64          `protobuf:"varint,7,rep,packed,name=timestamps,proto3" json:"timestamps,omitempty"`
+	Scope                DataScope         `protobuf:"varint,8,opt,name=scope,proto3,enum=milvus.proto.query.DataScope" json:"scope,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
+func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRequest) ProtoMessage()    {}
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{60}
+}
+
+func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
+}
+func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRequest.Merge(m, src)
+}
+func (m *DeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteRequest.Size(m)
+}
+func (m *DeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
+
+func (m *DeleteRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DeleteRequest) GetCollectionId() int64 {
+	if m != nil {
+		return m.CollectionId
+	}
+	return 0
+}
+
+func (m *DeleteRequest) GetPartitionId() int64 {
+	if m != nil {
+		return m.PartitionId
+	}
+	return 0
+}
+
+func (m *DeleteRequest) GetVchannelName() string {
+	if m != nil {
+		return m.VchannelName
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetSegmentId() int64 {
+	if m != nil {
+		return m.SegmentId
+	}
+	return 0
+}
+
+func (m *DeleteRequest) GetPrimaryKeys() *schemapb.IDs {
+	if m != nil {
+		return m.PrimaryKeys
+	}
+	return nil
+}
+
+func (m *DeleteRequest) GetTimestamps() []uint64 {
+	if m != nil {
+		return m.Timestamps
+	}
+	return nil
+}
+
+func (m *DeleteRequest) GetScope() DataScope {
+	if m != nil {
+		return m.Scope
+	}
+	return DataScope_UnKnown
+}
+
+type ActivateCheckerRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CheckerID            int32             `protobuf:"varint,2,opt,name=checkerID,proto3" json:"checkerID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ActivateCheckerRequest) Reset()         { *m = ActivateCheckerRequest{} }
+func (m *ActivateCheckerRequest) String() string { return proto.CompactTextString(m) }
+func (*ActivateCheckerRequest) ProtoMessage()    {}
+func (*ActivateCheckerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{61}
+}
+
+func (m *ActivateCheckerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActivateCheckerRequest.Unmarshal(m, b)
+}
+func (m *ActivateCheckerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActivateCheckerRequest.Marshal(b, m, deterministic)
+}
+func (m *ActivateCheckerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivateCheckerRequest.Merge(m, src)
+}
+func (m *ActivateCheckerRequest) XXX_Size() int {
+	return xxx_messageInfo_ActivateCheckerRequest.Size(m)
+}
+func (m *ActivateCheckerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivateCheckerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActivateCheckerRequest proto.InternalMessageInfo
+
+func (m *ActivateCheckerRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ActivateCheckerRequest) GetCheckerID() int32 {
+	if m != nil {
+		return m.CheckerID
+	}
+	return 0
+}
+
+type DeactivateCheckerRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CheckerID            int32             `protobuf:"varint,2,opt,name=checkerID,proto3" json:"checkerID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DeactivateCheckerRequest) Reset()         { *m = DeactivateCheckerRequest{} }
+func (m *DeactivateCheckerRequest) String() string { return proto.CompactTextString(m) }
+func (*DeactivateCheckerRequest) ProtoMessage()    {}
+func (*DeactivateCheckerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{62}
+}
+
+func (m *DeactivateCheckerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeactivateCheckerRequest.Unmarshal(m, b)
+}
+func (m *DeactivateCheckerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeactivateCheckerRequest.Marshal(b, m, deterministic)
+}
+func (m *DeactivateCheckerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeactivateCheckerRequest.Merge(m, src)
+}
+func (m *DeactivateCheckerRequest) XXX_Size() int {
+	return xxx_messageInfo_DeactivateCheckerRequest.Size(m)
+}
+func (m *DeactivateCheckerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeactivateCheckerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeactivateCheckerRequest proto.InternalMessageInfo
+
+func (m *DeactivateCheckerRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DeactivateCheckerRequest) GetCheckerID() int32 {
+	if m != nil {
+		return m.CheckerID
+	}
+	return 0
+}
+
+type ListCheckersRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CheckerIDs           []int32           `protobuf:"varint,2,rep,packed,name=checkerIDs,proto3" json:"checkerIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListCheckersRequest) Reset()         { *m = ListCheckersRequest{} }
+func (m *ListCheckersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCheckersRequest) ProtoMessage()    {}
+func (*ListCheckersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{63}
+}
+
+func (m *ListCheckersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCheckersRequest.Unmarshal(m, b)
+}
+func (m *ListCheckersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCheckersRequest.Marshal(b, m, deterministic)
+}
+func (m *ListCheckersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCheckersRequest.Merge(m, src)
+}
+func (m *ListCheckersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCheckersRequest.Size(m)
+}
+func (m *ListCheckersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCheckersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCheckersRequest proto.InternalMessageInfo
+
+func (m *ListCheckersRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ListCheckersRequest) GetCheckerIDs() []int32 {
+	if m != nil {
+		return m.CheckerIDs
+	}
+	return nil
+}
+
+type ListCheckersResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CheckerInfos         []*CheckerInfo   `protobuf:"bytes,2,rep,name=checkerInfos,proto3" json:"checkerInfos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListCheckersResponse) Reset()         { *m = ListCheckersResponse{} }
+func (m *ListCheckersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCheckersResponse) ProtoMessage()    {}
+func (*ListCheckersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{64}
+}
+
+func (m *ListCheckersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCheckersResponse.Unmarshal(m, b)
+}
+func (m *ListCheckersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCheckersResponse.Marshal(b, m, deterministic)
+}
+func (m *ListCheckersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCheckersResponse.Merge(m, src)
+}
+func (m *ListCheckersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListCheckersResponse.Size(m)
+}
+func (m *ListCheckersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCheckersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCheckersResponse proto.InternalMessageInfo
+
+func (m *ListCheckersResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListCheckersResponse) GetCheckerInfos() []*CheckerInfo {
+	if m != nil {
+		return m.CheckerInfos
+	}
+	return nil
+}
+
+type CheckerInfo struct {
+	Id        int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Desc      string `protobuf:"bytes,2,opt,name=desc,proto3" json:"desc,omitempty"`
+	Activated bool   `protobuf:"varint,3,opt,name=activated,proto3" json:"activated,omitempty"`
+	Found     bool   `protobuf:"varint,4,opt,name=found,proto3" json:"found,omitempty"`
+	// unix time in milliseconds of the latest run, 0 if never run
+	LastRunTime int64 `protobuf:"varint,5,opt,name=last_run_time,json=lastRunTime,proto3" json:"last_run_time,omitempty"`
+	// the number of tasks generated in the latest run
+	LastGeneratedTaskNum int32 `protobuf:"varint,6,opt,name=last_generated_task_num,json=lastGeneratedTaskNum,proto3" json:"last_generated_task_num,omitempty"`
+	// the number of tasks submitted to scheduler in the latest run
+	LastSubmittedTaskNum int32    `protobuf:"varint,7,opt,name=last_submitted_task_num,json=lastSubmittedTaskNum,proto3" json:"last_submitted_task_num,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckerInfo) Reset()         { *m = CheckerInfo{} }
+func (m *CheckerInfo) String() string { return proto.CompactTextString(m) }
+func (*CheckerInfo) ProtoMessage()    {}
+func (*CheckerInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{65}
+}
+
+func (m *CheckerInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckerInfo.Unmarshal(m, b)
+}
+func (m *CheckerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckerInfo.Marshal(b, m, deterministic)
+}
+func (m *CheckerInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckerInfo.Merge(m, src)
+}
+func (m *CheckerInfo) XXX_Size() int {
+	return xxx_messageInfo_CheckerInfo.Size(m)
+}
+func (m *CheckerInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckerInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckerInfo proto.InternalMessageInfo
+
+func (m *CheckerInfo) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *CheckerInfo) GetDesc() string {
+	if m != nil {
+		return m.Desc
+	}
+	return ""
+}
+
+func (m *CheckerInfo) GetActivated() bool {
+	if m != nil {
+		return m.Activated
+	}
+	return false
+}
+
+func (m *CheckerInfo) GetFound() bool {
+	if m != nil {
+		return m.Found
+	}
+	return false
+}
+
+func (m *CheckerInfo) GetLastRunTime() int64 {
+	if m != nil {
+		return m.LastRunTime
+	}
+	return 0
+}
+
+func (m *CheckerInfo) GetLastGeneratedTaskNum() int32 {
+	if m != nil {
+		return m.LastGeneratedTaskNum
+	}
+	return 0
+}
+
+func (m *CheckerInfo) GetLastSubmittedTaskNum() int32 {
+	if m != nil {
+		return m.LastSubmittedTaskNum
+	}
+	return 0
+}
+
+type SegmentTarget struct {
+	ID                   int64               `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Level                datapb.SegmentLevel `protobuf:"varint,2,opt,name=level,proto3,enum=milvus.proto.data.SegmentLevel" json:"level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *SegmentTarget) Reset()         { *m = SegmentTarget{} }
+func (m *SegmentTarget) String() string { return proto.CompactTextString(m) }
+func (*SegmentTarget) ProtoMessage()    {}
+func (*SegmentTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{66}
+}
+
+func (m *SegmentTarget) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentTarget.Unmarshal(m, b)
+}
+func (m *SegmentTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentTarget.Marshal(b, m, deterministic)
+}
+func (m *SegmentTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentTarget.Merge(m, src)
+}
+func (m *SegmentTarget) XXX_Size() int {
+	return xxx_messageInfo_SegmentTarget.Size(m)
+}
+func (m *SegmentTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentTarget proto.InternalMessageInfo
+
+func (m *SegmentTarget) GetID() int64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *SegmentTarget) GetLevel() datapb.SegmentLevel {
+	if m != nil {
+		return m.Level
+	}
+	return datapb.SegmentLevel_Legacy
+}
+
+type PartitionTarget struct {
+	PartitionID          int64            `protobuf:"varint,1,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	Segments             []*SegmentTarget `protobuf:"bytes,2,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *PartitionTarget) Reset()         { *m = PartitionTarget{} }
+func (m *PartitionTarget) String() string { return proto.CompactTextString(m) }
+func (*PartitionTarget) ProtoMessage()    {}
+func (*PartitionTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{67}
+}
+
+func (m *PartitionTarget) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PartitionTarget.Unmarshal(m, b)
+}
+func (m *PartitionTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PartitionTarget.Marshal(b, m, deterministic)
+}
+func (m *PartitionTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionTarget.Merge(m, src)
+}
+func (m *PartitionTarget) XXX_Size() int {
+	return xxx_messageInfo_PartitionTarget.Size(m)
+}
+func (m *PartitionTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionTarget proto.InternalMessageInfo
+
+func (m *PartitionTarget) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *PartitionTarget) GetSegments() []*SegmentTarget {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+type ChannelTarget struct {
+	ChannelName          string             `protobuf:"bytes,1,opt,name=channelName,proto3" json:"channelName,omitempty"`
+	DroppedSegmentIDs    []int64            `protobuf:"varint,2,rep,packed,name=dropped_segmentIDs,json=droppedSegmentIDs,proto3" json:"dropped_segmentIDs,omitempty"`
+	GrowingSegmentIDs    []int64            `protobuf:"varint,3,rep,packed,name=growing_segmentIDs,json=growingSegmentIDs,proto3" json:"growing_segmentIDs,omitempty"`
+	PartitionTargets     []*PartitionTarget `protobuf:"bytes,4,rep,name=partition_targets,json=partitionTargets,proto3" json:"partition_targets,omitempty"`
+	SeekPosition         *msgpb.MsgPosition `protobuf:"bytes,5,opt,name=seek_position,json=seekPosition,proto3" json:"seek_position,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ChannelTarget) Reset()         { *m = ChannelTarget{} }
+func (m *ChannelTarget) String() string { return proto.CompactTextString(m) }
+func (*ChannelTarget) ProtoMessage()    {}
+func (*ChannelTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{68}
+}
+
+func (m *ChannelTarget) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelTarget.Unmarshal(m, b)
+}
+func (m *ChannelTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelTarget.Marshal(b, m, deterministic)
+}
+func (m *ChannelTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelTarget.Merge(m, src)
+}
+func (m *ChannelTarget) XXX_Size() int {
+	return xxx_messageInfo_ChannelTarget.Size(m)
+}
+func (m *ChannelTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelTarget proto.InternalMessageInfo
+
+func (m *ChannelTarget) GetChannelName() string {
+	if m != nil {
+		return m.ChannelName
+	}
+	return ""
+}
+
+func (m *ChannelTarget) GetDroppedSegmentIDs() []int64 {
+	if m != nil {
+		return m.DroppedSegmentIDs
+	}
+	return nil
+}
+
+func (m *ChannelTarget) GetGrowingSegmentIDs() []int64 {
+	if m != nil {
+		return m.GrowingSegmentIDs
+	}
+	return nil
+}
+
+func (m *ChannelTarget) GetPartitionTargets() []*PartitionTarget {
+	if m != nil {
+		return m.PartitionTargets
+	}
+	return nil
+}
+
+func (m *ChannelTarget) GetSeekPosition() *msgpb.MsgPosition {
+	if m != nil {
+		return m.SeekPosition
+	}
+	return nil
+}
+
+type CollectionTarget struct {
+	CollectionID         int64            `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	ChannelTargets       []*ChannelTarget `protobuf:"bytes,2,rep,name=Channel_targets,json=ChannelTargets,proto3" json:"Channel_targets,omitempty"`
+	Version              int64            `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CollectionTarget) Reset()         { *m = CollectionTarget{} }
+func (m *CollectionTarget) String() string { return proto.CompactTextString(m) }
+func (*CollectionTarget) ProtoMessage()    {}
+func (*CollectionTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{69}
+}
+
+func (m *CollectionTarget) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollectionTarget.Unmarshal(m, b)
+}
+func (m *CollectionTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollectionTarget.Marshal(b, m, deterministic)
+}
+func (m *CollectionTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionTarget.Merge(m, src)
+}
+func (m *CollectionTarget) XXX_Size() int {
+	return xxx_messageInfo_CollectionTarget.Size(m)
+}
+func (m *CollectionTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionTarget proto.InternalMessageInfo
+
+func (m *CollectionTarget) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *CollectionTarget) GetChannelTargets() []*ChannelTarget {
+	if m != nil {
+		return m.ChannelTargets
+	}
+	return nil
+}
+
+func (m *CollectionTarget) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type NodeInfo struct {
+	ID                   int64             `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	Address              string            `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	State                string            `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Labels               map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *NodeInfo) Reset()         { *m = NodeInfo{} }
+func (m *NodeInfo) String() string { return proto.CompactTextString(m) }
+func (*NodeInfo) ProtoMessage()    {}
+func (*NodeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{70}
+}
+
+func (m *NodeInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeInfo.Unmarshal(m, b)
+}
+func (m *NodeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeInfo.Marshal(b, m, deterministic)
+}
+func (m *NodeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeInfo.Merge(m, src)
+}
+func (m *NodeInfo) XXX_Size() int {
+	return xxx_messageInfo_NodeInfo.Size(m)
+}
+func (m *NodeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeInfo proto.InternalMessageInfo
+
+func (m *NodeInfo) GetID() int64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *NodeInfo) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *NodeInfo) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *NodeInfo) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+type ListQueryNodeRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListQueryNodeRequest) Reset()         { *m = ListQueryNodeRequest{} }
+func (m *ListQueryNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*ListQueryNodeRequest) ProtoMessage()    {}
+func (*ListQueryNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{71}
+}
+
+func (m *ListQueryNodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListQueryNodeRequest.Unmarshal(m, b)
+}
+func (m *ListQueryNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListQueryNodeRequest.Marshal(b, m, deterministic)
+}
+func (m *ListQueryNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListQueryNodeRequest.Merge(m, src)
+}
+func (m *ListQueryNodeRequest) XXX_Size() int {
+	return xxx_messageInfo_ListQueryNodeRequest.Size(m)
+}
+func (m *ListQueryNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListQueryNodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListQueryNodeRequest proto.InternalMessageInfo
+
+func (m *ListQueryNodeRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type ListQueryNodeResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	NodeInfos            []*NodeInfo      `protobuf:"bytes,2,rep,name=nodeInfos,proto3" json:"nodeInfos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListQueryNodeResponse) Reset()         { *m = ListQueryNodeResponse{} }
+func (m *ListQueryNodeResponse) String() string { return proto.CompactTextString(m) }
+func (*ListQueryNodeResponse) ProtoMessage()    {}
+func (*ListQueryNodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{72}
+}
+
+func (m *ListQueryNodeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListQueryNodeResponse.Unmarshal(m, b)
+}
+func (m *ListQueryNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListQueryNodeResponse.Marshal(b, m, deterministic)
+}
+func (m *ListQueryNodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListQueryNodeResponse.Merge(m, src)
+}
+func (m *ListQueryNodeResponse) XXX_Size() int {
+	return xxx_messageInfo_ListQueryNodeResponse.Size(m)
+}
+func (m *ListQueryNodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListQueryNodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListQueryNodeResponse proto.InternalMessageInfo
+
+func (m *ListQueryNodeResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListQueryNodeResponse) GetNodeInfos() []*NodeInfo {
+	if m != nil {
+		return m.NodeInfos
+	}
+	return nil
+}
+
+type GetQueryNodeDistributionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	NodeID               int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetQueryNodeDistributionRequest) Reset()         { *m = GetQueryNodeDistributionRequest{} }
+func (m *GetQueryNodeDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetQueryNodeDistributionRequest) ProtoMessage()    {}
+func (*GetQueryNodeDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{73}
+}
+
+func (m *GetQueryNodeDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetQueryNodeDistributionRequest.Unmarshal(m, b)
+}
+func (m *GetQueryNodeDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetQueryNodeDistributionRequest.Marshal(b, m, deterministic)
+}
+func (m *GetQueryNodeDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQueryNodeDistributionRequest.Merge(m, src)
+}
+func (m *GetQueryNodeDistributionRequest) XXX_Size() int {
+	return xxx_messageInfo_GetQueryNodeDistributionRequest.Size(m)
+}
+func (m *GetQueryNodeDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQueryNodeDistributionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetQueryNodeDistributionRequest proto.InternalMessageInfo
+
+func (m *GetQueryNodeDistributionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetQueryNodeDistributionRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+type GetQueryNodeDistributionResponse struct {
+	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ID                   int64                 `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	ChannelNames         []string              `protobuf:"bytes,3,rep,name=channel_names,json=channelNames,proto3" json:"channel_names,omitempty"`
+	SealedSegmentIDs     []int64               `protobuf:"varint,4,rep,packed,name=sealed_segmentIDs,json=sealedSegmentIDs,proto3" json:"sealed_segmentIDs,omitempty"`
+	Segments             []*SegmentVersionInfo `protobuf:"bytes,5,rep,name=segments,proto3" json:"segments,omitempty"`
+	Channels             []*ChannelVersionInfo `protobuf:"bytes,6,rep,name=channels,proto3" json:"channels,omitempty"`
+	LeaderViews          []*LeaderView         `protobuf:"bytes,7,rep,name=leader_views,json=leaderViews,proto3" json:"leader_views,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetQueryNodeDistributionResponse) Reset()         { *m = GetQueryNodeDistributionResponse{} }
+func (m *GetQueryNodeDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*GetQueryNodeDistributionResponse) ProtoMessage()    {}
+func (*GetQueryNodeDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{74}
+}
+
+func (m *GetQueryNodeDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetQueryNodeDistributionResponse.Unmarshal(m, b)
+}
+func (m *GetQueryNodeDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetQueryNodeDistributionResponse.Marshal(b, m, deterministic)
+}
+func (m *GetQueryNodeDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQueryNodeDistributionResponse.Merge(m, src)
+}
+func (m *GetQueryNodeDistributionResponse) XXX_Size() int {
+	return xxx_messageInfo_GetQueryNodeDistributionResponse.Size(m)
+}
+func (m *GetQueryNodeDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQueryNodeDistributionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetQueryNodeDistributionResponse proto.InternalMessageInfo
+
+func (m *GetQueryNodeDistributionResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetQueryNodeDistributionResponse) GetID() int64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *GetQueryNodeDistributionResponse) GetChannelNames() []string {
+	if m != nil {
+		return m.ChannelNames
+	}
+	return nil
+}
+
+func (m *GetQueryNodeDistributionResponse) GetSealedSegmentIDs() []int64 {
+	if m != nil {
+		return m.SealedSegmentIDs
+	}
+	return nil
+}
+
+func (m *GetQueryNodeDistributionResponse) GetSegments() []*SegmentVersionInfo {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *GetQueryNodeDistributionResponse) GetChannels() []*ChannelVersionInfo {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *GetQueryNodeDistributionResponse) GetLeaderViews() []*LeaderView {
+	if m != nil {
+		return m.LeaderViews
+	}
+	return nil
+}
+
+type SuspendBalanceRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SuspendBalanceRequest) Reset()         { *m = SuspendBalanceRequest{} }
+func (m *SuspendBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*SuspendBalanceRequest) ProtoMessage()    {}
+func (*SuspendBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{75}
+}
+
+func (m *SuspendBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SuspendBalanceRequest.Unmarshal(m, b)
+}
+func (m *SuspendBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SuspendBalanceRequest.Marshal(b, m, deterministic)
+}
+func (m *SuspendBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuspendBalanceRequest.Merge(m, src)
+}
+func (m *SuspendBalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_SuspendBalanceRequest.Size(m)
+}
+func (m *SuspendBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuspendBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuspendBalanceRequest proto.InternalMessageInfo
+
+func (m *SuspendBalanceRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type ResumeBalanceRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ResumeBalanceRequest) Reset()         { *m = ResumeBalanceRequest{} }
+func (m *ResumeBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*ResumeBalanceRequest) ProtoMessage()    {}
+func (*ResumeBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{76}
+}
+
+func (m *ResumeBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumeBalanceRequest.Unmarshal(m, b)
+}
+func (m *ResumeBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumeBalanceRequest.Marshal(b, m, deterministic)
+}
+func (m *ResumeBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeBalanceRequest.Merge(m, src)
+}
+func (m *ResumeBalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_ResumeBalanceRequest.Size(m)
+}
+func (m *ResumeBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeBalanceRequest proto.InternalMessageInfo
+
+func (m *ResumeBalanceRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type SuspendNodeRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	NodeID               int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SuspendNodeRequest) Reset()         { *m = SuspendNodeRequest{} }
+func (m *SuspendNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*SuspendNodeRequest) ProtoMessage()    {}
+func (*SuspendNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{77}
+}
+
+func (m *SuspendNodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SuspendNodeRequest.Unmarshal(m, b)
+}
+func (m *SuspendNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SuspendNodeRequest.Marshal(b, m, deterministic)
+}
+func (m *SuspendNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuspendNodeRequest.Merge(m, src)
+}
+func (m *SuspendNodeRequest) XXX_Size() int {
+	return xxx_messageInfo_SuspendNodeRequest.Size(m)
+}
+func (m *SuspendNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuspendNodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuspendNodeRequest proto.InternalMessageInfo
+
+func (m *SuspendNodeRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SuspendNodeRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+type ResumeNodeRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	NodeID               int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ResumeNodeRequest) Reset()         { *m = ResumeNodeRequest{} }
+func (m *ResumeNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*ResumeNodeRequest) ProtoMessage()    {}
+func (*ResumeNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{78}
+}
+
+func (m *ResumeNodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumeNodeRequest.Unmarshal(m, b)
+}
+func (m *ResumeNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumeNodeRequest.Marshal(b, m, deterministic)
+}
+func (m *ResumeNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeNodeRequest.Merge(m, src)
+}
+func (m *ResumeNodeRequest) XXX_Size() int {
+	return xxx_messageInfo_ResumeNodeRequest.Size(m)
+}
+func (m *ResumeNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeNodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeNodeRequest proto.InternalMessageInfo
+
+func (m *ResumeNodeRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ResumeNodeRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+type TransferSegmentRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SegmentID            int64             `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	SourceNodeID         int64             `protobuf:"varint,3,opt,name=source_nodeID,json=sourceNodeID,proto3" json:"source_nodeID,omitempty"`
+	TargetNodeID         int64             `protobuf:"varint,4,opt,name=target_nodeID,json=targetNodeID,proto3" json:"target_nodeID,omitempty"`
+	TransferAll          bool              `protobuf:"varint,5,opt,name=transfer_all,json=transferAll,proto3" json:"transfer_all,omitempty"`
+	ToAllNodes           bool              `protobuf:"varint,6,opt,name=to_all_nodes,json=toAllNodes,proto3" json:"to_all_nodes,omitempty"`
+	CopyMode             bool              `protobuf:"varint,7,opt,name=copy_mode,json=copyMode,proto3" json:"copy_mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *TransferSegmentRequest) Reset()         { *m = TransferSegmentRequest{} }
+func (m *TransferSegmentRequest) String() string { return proto.CompactTextString(m) }
+func (*TransferSegmentRequest) ProtoMessage()    {}
+func (*TransferSegmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{79}
+}
+
+func (m *TransferSegmentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferSegmentRequest.Unmarshal(m, b)
+}
+func (m *TransferSegmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferSegmentRequest.Marshal(b, m, deterministic)
+}
+func (m *TransferSegmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferSegmentRequest.Merge(m, src)
+}
+func (m *TransferSegmentRequest) XXX_Size() int {
+	return xxx_messageInfo_TransferSegmentRequest.Size(m)
+}
+func (m *TransferSegmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferSegmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferSegmentRequest proto.InternalMessageInfo
+
+func (m *TransferSegmentRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *TransferSegmentRequest) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *TransferSegmentRequest) GetSourceNodeID() int64 {
+	if m != nil {
+		return m.SourceNodeID
+	}
+	return 0
+}
+
+func (m *TransferSegmentRequest) GetTargetNodeID() int64 {
+	if m != nil {
+		return m.TargetNodeID
+	}
+	return 0
+}
+
+func (m *TransferSegmentRequest) GetTransferAll() bool {
+	if m != nil {
+		return m.TransferAll
+	}
+	return false
+}
+
+func (m *TransferSegmentRequest) GetToAllNodes() bool {
+	if m != nil {
+		return m.ToAllNodes
+	}
+	return false
+}
+
+func (m *TransferSegmentRequest) GetCopyMode() bool {
+	if m != nil {
+		return m.CopyMode
+	}
+	return false
+}
+
+type TransferChannelRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ChannelName          string            `protobuf:"bytes,2,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	SourceNodeID         int64             `protobuf:"varint,3,opt,name=source_nodeID,json=sourceNodeID,proto3" json:"source_nodeID,omitempty"`
+	TargetNodeID         int64             `protobuf:"varint,4,opt,name=target_nodeID,json=targetNodeID,proto3" json:"target_nodeID,omitempty"`
+	TransferAll          bool              `protobuf:"varint,5,opt,name=transfer_all,json=transferAll,proto3" json:"transfer_all,omitempty"`
+	ToAllNodes           bool              `protobuf:"varint,6,opt,name=to_all_nodes,json=toAllNodes,proto3" json:"to_all_nodes,omitempty"`
+	CopyMode             bool              `protobuf:"varint,7,opt,name=copy_mode,json=copyMode,proto3" json:"copy_mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *TransferChannelRequest) Reset()         { *m = TransferChannelRequest{} }
+func (m *TransferChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*TransferChannelRequest) ProtoMessage()    {}
+func (*TransferChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{80}
+}
+
+func (m *TransferChannelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferChannelRequest.Unmarshal(m, b)
+}
+func (m *TransferChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferChannelRequest.Marshal(b, m, deterministic)
+}
+func (m *TransferChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferChannelRequest.Merge(m, src)
+}
+func (m *TransferChannelRequest) XXX_Size() int {
+	return xxx_messageInfo_TransferChannelRequest.Size(m)
+}
+func (m *TransferChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferChannelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferChannelRequest proto.InternalMessageInfo
+
+func (m *TransferChannelRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *TransferChannelRequest) GetChannelName() string {
+	if m != nil {
+		return m.ChannelName
+	}
+	return ""
+}
+
+func (m *TransferChannelRequest) GetSourceNodeID() int64 {
+	if m != nil {
+		return m.SourceNodeID
+	}
+	return 0
+}
+
+func (m *TransferChannelRequest) GetTargetNodeID() int64 {
+	if m != nil {
+		return m.TargetNodeID
+	}
+	return 0
+}
+
+func (m *TransferChannelRequest) GetTransferAll() bool {
+	if m != nil {
+		return m.TransferAll
+	}
+	return false
+}
+
+func (m *TransferChannelRequest) GetToAllNodes() bool {
+	if m != nil {
+		return m.ToAllNodes
+	}
+	return false
+}
+
+func (m *TransferChannelRequest) GetCopyMode() bool {
+	if m != nil {
+		return m.CopyMode
+	}
+	return false
+}
+
+type CheckQueryNodeDistributionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SourceNodeID         int64             `protobuf:"varint,3,opt,name=source_nodeID,json=sourceNodeID,proto3" json:"source_nodeID,omitempty"`
+	TargetNodeID         int64             `protobuf:"varint,4,opt,name=target_nodeID,json=targetNodeID,proto3" json:"target_nodeID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CheckQueryNodeDistributionRequest) Reset()         { *m = CheckQueryNodeDistributionRequest{} }
+func (m *CheckQueryNodeDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckQueryNodeDistributionRequest) ProtoMessage()    {}
+func (*CheckQueryNodeDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{81}
+}
+
+func (m *CheckQueryNodeDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckQueryNodeDistributionRequest.Unmarshal(m, b)
+}
+func (m *CheckQueryNodeDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckQueryNodeDistributionRequest.Marshal(b, m, deterministic)
+}
+func (m *CheckQueryNodeDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckQueryNodeDistributionRequest.Merge(m, src)
+}
+func (m *CheckQueryNodeDistributionRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckQueryNodeDistributionRequest.Size(m)
+}
+func (m *CheckQueryNodeDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckQueryNodeDistributionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckQueryNodeDistributionRequest proto.InternalMessageInfo
+
+func (m *CheckQueryNodeDistributionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CheckQueryNodeDistributionRequest) GetSourceNodeID() int64 {
+	if m != nil {
+		return m.SourceNodeID
+	}
+	return 0
+}
+
+func (m *CheckQueryNodeDistributionRequest) GetTargetNodeID() int64 {
+	if m != nil {
+		return m.TargetNodeID
+	}
+	return 0
+}
+
+type GetClusterLoadSummaryRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetClusterLoadSummaryRequest) Reset()         { *m = GetClusterLoadSummaryRequest{} }
+func (m *GetClusterLoadSummaryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetClusterLoadSummaryRequest) ProtoMessage()    {}
+func (*GetClusterLoadSummaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{82}
+}
+
+func (m *GetClusterLoadSummaryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetClusterLoadSummaryRequest.Unmarshal(m, b)
+}
+func (m *GetClusterLoadSummaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetClusterLoadSummaryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetClusterLoadSummaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetClusterLoadSummaryRequest.Merge(m, src)
+}
+func (m *GetClusterLoadSummaryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetClusterLoadSummaryRequest.Size(m)
+}
+func (m *GetClusterLoadSummaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetClusterLoadSummaryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetClusterLoadSummaryRequest proto.InternalMessageInfo
+
+func (m *GetClusterLoadSummaryRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type GetClusterLoadSummaryResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	NumLoadedCollections int32            `protobuf:"varint,2,opt,name=num_loaded_collections,json=numLoadedCollections,proto3" json:"num_loaded_collections,omitempty"`
+	NumReplicas          int32            `protobuf:"varint,3,opt,name=num_replicas,json=numReplicas,proto3" json:"num_replicas,omitempty"`
+	NumSegments          int64            `protobuf:"varint,4,opt,name=num_segments,json=numSegments,proto3" json:"num_segments,omitempty"`
+	MemorySize           int64            `protobuf:"varint,5,opt,name=memory_size,json=memorySize,proto3" json:"memory_size,omitempty"`
+	NumNodesPerRg        map[string]int32 `protobuf:"bytes,6,rep,name=num_nodes_per_rg,json=numNodesPerRg,proto3" json:"num_nodes_per_rg,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	NumUnhealthyShards   int32            `protobuf:"varint,7,opt,name=num_unhealthy_shards,json=numUnhealthyShards,proto3" json:"num_unhealthy_shards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetClusterLoadSummaryResponse) Reset()         { *m = GetClusterLoadSummaryResponse{} }
+func (m *GetClusterLoadSummaryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetClusterLoadSummaryResponse) ProtoMessage()    {}
+func (*GetClusterLoadSummaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{83}
+}
+
+func (m *GetClusterLoadSummaryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetClusterLoadSummaryResponse.Unmarshal(m, b)
+}
+func (m *GetClusterLoadSummaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetClusterLoadSummaryResponse.Marshal(b, m, deterministic)
+}
+func (m *GetClusterLoadSummaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetClusterLoadSummaryResponse.Merge(m, src)
+}
+func (m *GetClusterLoadSummaryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetClusterLoadSummaryResponse.Size(m)
+}
+func (m *GetClusterLoadSummaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetClusterLoadSummaryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetClusterLoadSummaryResponse proto.InternalMessageInfo
+
+func (m *GetClusterLoadSummaryResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetClusterLoadSummaryResponse) GetNumLoadedCollections() int32 {
+	if m != nil {
+		return m.NumLoadedCollections
+	}
+	return 0
+}
+
+func (m *GetClusterLoadSummaryResponse) GetNumReplicas() int32 {
+	if m != nil {
+		return m.NumReplicas
+	}
+	return 0
+}
+
+func (m *GetClusterLoadSummaryResponse) GetNumSegments() int64 {
+	if m != nil {
+		return m.NumSegments
+	}
+	return 0
+}
+
+func (m *GetClusterLoadSummaryResponse) GetMemorySize() int64 {
+	if m != nil {
+		return m.MemorySize
+	}
+	return 0
+}
+
+func (m *GetClusterLoadSummaryResponse) GetNumNodesPerRg() map[string]int32 {
+	if m != nil {
+		return m.NumNodesPerRg
+	}
+	return nil
+}
+
+func (m *GetClusterLoadSummaryResponse) GetNumUnhealthyShards() int32 {
+	if m != nil {
+		return m.NumUnhealthyShards
+	}
+	return 0
+}
+
+type ForceSyncRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// reconcile the distribution of the collection with its current target only if set
+	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForceSyncRequest) Reset()         { *m = ForceSyncRequest{} }
+func (m *ForceSyncRequest) String() string { return proto.CompactTextString(m) }
+func (*ForceSyncRequest) ProtoMessage()    {}
+func (*ForceSyncRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{84}
+}
+
+func (m *ForceSyncRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForceSyncRequest.Unmarshal(m, b)
+}
+func (m *ForceSyncRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForceSyncRequest.Marshal(b, m, deterministic)
+}
+func (m *ForceSyncRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForceSyncRequest.Merge(m, src)
+}
+func (m *ForceSyncRequest) XXX_Size() int {
+	return xxx_messageInfo_ForceSyncRequest.Size(m)
+}
+func (m *ForceSyncRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForceSyncRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForceSyncRequest proto.InternalMessageInfo
+
+func (m *ForceSyncRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ForceSyncRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type NodeSyncResult struct {
+	NodeID               int64    `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	SegmentsAdded        int32    `protobuf:"varint,2,opt,name=segments_added,json=segmentsAdded,proto3" json:"segments_added,omitempty"`
+	SegmentsRemoved      int32    `protobuf:"varint,3,opt,name=segments_removed,json=segmentsRemoved,proto3" json:"segments_removed,omitempty"`
+	ChannelsAdded        int32    `protobuf:"varint,4,opt,name=channels_added,json=channelsAdded,proto3" json:"channels_added,omitempty"`
+	ChannelsRemoved      int32    `protobuf:"varint,5,opt,name=channels_removed,json=channelsRemoved,proto3" json:"channels_removed,omitempty"`
+	LeaderViewsAdded     int32    `protobuf:"varint,6,opt,name=leader_views_added,json=leaderViewsAdded,proto3" json:"leader_views_added,omitempty"`
+	LeaderViewsRemoved   int32    `protobuf:"varint,7,opt,name=leader_views_removed,json=leaderViewsRemoved,proto3" json:"leader_views_removed,omitempty"`
+	Error                string   `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeSyncResult) Reset()         { *m = NodeSyncResult{} }
+func (m *NodeSyncResult) String() string { return proto.CompactTextString(m) }
+func (*NodeSyncResult) ProtoMessage()    {}
+func (*NodeSyncResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{85}
+}
+
+func (m *NodeSyncResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeSyncResult.Unmarshal(m, b)
+}
+func (m *NodeSyncResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeSyncResult.Marshal(b, m, deterministic)
+}
+func (m *NodeSyncResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeSyncResult.Merge(m, src)
+}
+func (m *NodeSyncResult) XXX_Size() int {
+	return xxx_messageInfo_NodeSyncResult.Size(m)
+}
+func (m *NodeSyncResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeSyncResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeSyncResult proto.InternalMessageInfo
+
+func (m *NodeSyncResult) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *NodeSyncResult) GetSegmentsAdded() int32 {
+	if m != nil {
+		return m.SegmentsAdded
+	}
+	return 0
+}
+
+func (m *NodeSyncResult) GetSegmentsRemoved() int32 {
+	if m != nil {
+		return m.SegmentsRemoved
+	}
+	return 0
+}
+
+func (m *NodeSyncResult) GetChannelsAdded() int32 {
+	if m != nil {
+		return m.ChannelsAdded
+	}
+	return 0
+}
+
+func (m *NodeSyncResult) GetChannelsRemoved() int32 {
+	if m != nil {
+		return m.ChannelsRemoved
+	}
+	return 0
+}
+
+func (m *NodeSyncResult) GetLeaderViewsAdded() int32 {
+	if m != nil {
+		return m.LeaderViewsAdded
+	}
+	return 0
+}
+
+func (m *NodeSyncResult) GetLeaderViewsRemoved() int32 {
+	if m != nil {
+		return m.LeaderViewsRemoved
+	}
+	return 0
+}
+
+func (m *NodeSyncResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ForceSyncResponse struct {
+	Status               *commonpb.Status  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	NodeResults          []*NodeSyncResult `protobuf:"bytes,2,rep,name=node_results,json=nodeResults,proto3" json:"node_results,omitempty"`
+	RefreshedCollections []int64           `protobuf:"varint,3,rep,packed,name=refreshed_collections,json=refreshedCollections,proto3" json:"refreshed_collections,omitempty"`
+	// number of load/release actions issued to reconcile the distribution of the given collection
+	ActionNum            int32    `protobuf:"varint,4,opt,name=action_num,json=actionNum,proto3" json:"action_num,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForceSyncResponse) Reset()         { *m = ForceSyncResponse{} }
+func (m *ForceSyncResponse) String() string { return proto.CompactTextString(m) }
+func (*ForceSyncResponse) ProtoMessage()    {}
+func (*ForceSyncResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{86}
+}
+
+func (m *ForceSyncResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForceSyncResponse.Unmarshal(m, b)
+}
+func (m *ForceSyncResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForceSyncResponse.Marshal(b, m, deterministic)
+}
+func (m *ForceSyncResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForceSyncResponse.Merge(m, src)
+}
+func (m *ForceSyncResponse) XXX_Size() int {
+	return xxx_messageInfo_ForceSyncResponse.Size(m)
+}
+func (m *ForceSyncResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForceSyncResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForceSyncResponse proto.InternalMessageInfo
+
+func (m *ForceSyncResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ForceSyncResponse) GetNodeResults() []*NodeSyncResult {
+	if m != nil {
+		return m.NodeResults
+	}
+	return nil
+}
+
+func (m *ForceSyncResponse) GetRefreshedCollections() []int64 {
+	if m != nil {
+		return m.RefreshedCollections
+	}
+	return nil
+}
+
+func (m *ForceSyncResponse) GetActionNum() int32 {
+	if m != nil {
+		return m.ActionNum
+	}
+	return 0
+}
+
+type GetTransferNodeStatusRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// check all resource groups if both are empty
+	SourceResourceGroup  string   `protobuf:"bytes,2,opt,name=source_resource_group,json=sourceResourceGroup,proto3" json:"source_resource_group,omitempty"`
+	TargetResourceGroup  string   `protobuf:"bytes,3,opt,name=target_resource_group,json=targetResourceGroup,proto3" json:"target_resource_group,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTransferNodeStatusRequest) Reset()         { *m = GetTransferNodeStatusRequest{} }
+func (m *GetTransferNodeStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTransferNodeStatusRequest) ProtoMessage()    {}
+func (*GetTransferNodeStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{87}
+}
+
+func (m *GetTransferNodeStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTransferNodeStatusRequest.Unmarshal(m, b)
+}
+func (m *GetTransferNodeStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTransferNodeStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTransferNodeStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTransferNodeStatusRequest.Merge(m, src)
+}
+func (m *GetTransferNodeStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTransferNodeStatusRequest.Size(m)
+}
+func (m *GetTransferNodeStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTransferNodeStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTransferNodeStatusRequest proto.InternalMessageInfo
+
+func (m *GetTransferNodeStatusRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetTransferNodeStatusRequest) GetSourceResourceGroup() string {
+	if m != nil {
+		return m.SourceResourceGroup
+	}
+	return ""
+}
+
+func (m *GetTransferNodeStatusRequest) GetTargetResourceGroup() string {
+	if m != nil {
+		return m.TargetResourceGroup
+	}
+	return ""
+}
+
+type ReplicaRecoveryStatus struct {
+	CollectionID  int64  `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	ReplicaID     int64  `protobuf:"varint,2,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
+	ResourceGroup string `protobuf:"bytes,3,opt,name=resource_group,json=resourceGroup,proto3" json:"resource_group,omitempty"`
+	// nodes transferred out but still holding data of the replica
+	OutboundNodes []int64 `protobuf:"varint,4,rep,packed,name=outbound_nodes,json=outboundNodes,proto3" json:"outbound_nodes,omitempty"`
+	// channels which have no serviceable shard leader in the replica yet
+	UnserviceableChannels []string `protobuf:"bytes,5,rep,name=unserviceable_channels,json=unserviceableChannels,proto3" json:"unserviceable_channels,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *ReplicaRecoveryStatus) Reset()         { *m = ReplicaRecoveryStatus{} }
+func (m *ReplicaRecoveryStatus) String() string { return proto.CompactTextString(m) }
+func (*ReplicaRecoveryStatus) ProtoMessage()    {}
+func (*ReplicaRecoveryStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{88}
+}
+
+func (m *ReplicaRecoveryStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplicaRecoveryStatus.Unmarshal(m, b)
+}
+func (m *ReplicaRecoveryStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplicaRecoveryStatus.Marshal(b, m, deterministic)
+}
+func (m *ReplicaRecoveryStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicaRecoveryStatus.Merge(m, src)
+}
+func (m *ReplicaRecoveryStatus) XXX_Size() int {
+	return xxx_messageInfo_ReplicaRecoveryStatus.Size(m)
+}
+func (m *ReplicaRecoveryStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicaRecoveryStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicaRecoveryStatus proto.InternalMessageInfo
+
+func (m *ReplicaRecoveryStatus) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ReplicaRecoveryStatus) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
+func (m *ReplicaRecoveryStatus) GetResourceGroup() string {
+	if m != nil {
+		return m.ResourceGroup
+	}
+	return ""
+}
+
+func (m *ReplicaRecoveryStatus) GetOutboundNodes() []int64 {
+	if m != nil {
+		return m.OutboundNodes
+	}
+	return nil
+}
+
+func (m *ReplicaRecoveryStatus) GetUnserviceableChannels() []string {
+	if m != nil {
+		return m.UnserviceableChannels
+	}
+	return nil
+}
+
+type GetTransferNodeStatusResponse struct {
+	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Recovering           bool                     `protobuf:"varint,2,opt,name=recovering,proto3" json:"recovering,omitempty"`
+	PendingReplicas      []*ReplicaRecoveryStatus `protobuf:"bytes,3,rep,name=pending_replicas,json=pendingReplicas,proto3" json:"pending_replicas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetTransferNodeStatusResponse) Reset()         { *m = GetTransferNodeStatusResponse{} }
+func (m *GetTransferNodeStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTransferNodeStatusResponse) ProtoMessage()    {}
+func (*GetTransferNodeStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{89}
+}
+
+func (m *GetTransferNodeStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTransferNodeStatusResponse.Unmarshal(m, b)
+}
+func (m *GetTransferNodeStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTransferNodeStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTransferNodeStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTransferNodeStatusResponse.Merge(m, src)
+}
+func (m *GetTransferNodeStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTransferNodeStatusResponse.Size(m)
+}
+func (m *GetTransferNodeStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTransferNodeStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTransferNodeStatusResponse proto.InternalMessageInfo
+
+func (m *GetTransferNodeStatusResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetTransferNodeStatusResponse) GetRecovering() bool {
+	if m != nil {
+		return m.Recovering
+	}
+	return false
+}
+
+func (m *GetTransferNodeStatusResponse) GetPendingReplicas() []*ReplicaRecoveryStatus {
+	if m != nil {
+		return m.PendingReplicas
+	}
+	return nil
+}
+
+type TriggerCheckerRunRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CheckerID    int32             `protobuf:"varint,2,opt,name=checkerID,proto3" json:"checkerID,omitempty"`
+	CollectionID int64             `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// the names of checkers to run besides the checkerID one, all checkers if neither is given
+	CheckerNames []string `protobuf:"bytes,4,rep,name=checker_names,json=checkerNames,proto3" json:"checker_names,omitempty"`
+	// run the balance checker even if the automatic balance is suspended,
+	// otherwise it's rejected if requested and skipped if running all checkers
+	Force                bool     `protobuf:"varint,5,opt,name=force,proto3" json:"force,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TriggerCheckerRunRequest) Reset()         { *m = TriggerCheckerRunRequest{} }
+func (m *TriggerCheckerRunRequest) String() string { return proto.CompactTextString(m) }
+func (*TriggerCheckerRunRequest) ProtoMessage()    {}
+func (*TriggerCheckerRunRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{90}
+}
+
+func (m *TriggerCheckerRunRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriggerCheckerRunRequest.Unmarshal(m, b)
+}
+func (m *TriggerCheckerRunRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriggerCheckerRunRequest.Marshal(b, m, deterministic)
+}
+func (m *TriggerCheckerRunRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerCheckerRunRequest.Merge(m, src)
+}
+func (m *TriggerCheckerRunRequest) XXX_Size() int {
+	return xxx_messageInfo_TriggerCheckerRunRequest.Size(m)
+}
+func (m *TriggerCheckerRunRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerCheckerRunRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerCheckerRunRequest proto.InternalMessageInfo
+
+func (m *TriggerCheckerRunRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *TriggerCheckerRunRequest) GetCheckerID() int32 {
+	if m != nil {
+		return m.CheckerID
+	}
+	return 0
+}
+
+func (m *TriggerCheckerRunRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *TriggerCheckerRunRequest) GetCheckerNames() []string {
+	if m != nil {
+		return m.CheckerNames
+	}
+	return nil
+}
+
+func (m *TriggerCheckerRunRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+type CheckerTaskInfo struct {
+	TaskID               int64    `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	ReplicaID            int64    `protobuf:"varint,3,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
+	Shard                string   `protobuf:"bytes,4,opt,name=shard,proto3" json:"shard,omitempty"`
+	Desc                 string   `protobuf:"bytes,5,opt,name=desc,proto3" json:"desc,omitempty"`
+	Added                bool     `protobuf:"varint,6,opt,name=added,proto3" json:"added,omitempty"`
+	Error                string   `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckerTaskInfo) Reset()         { *m = CheckerTaskInfo{} }
+func (m *CheckerTaskInfo) String() string { return proto.CompactTextString(m) }
+func (*CheckerTaskInfo) ProtoMessage()    {}
+func (*CheckerTaskInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{91}
+}
+
+func (m *CheckerTaskInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckerTaskInfo.Unmarshal(m, b)
+}
+func (m *CheckerTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckerTaskInfo.Marshal(b, m, deterministic)
+}
+func (m *CheckerTaskInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckerTaskInfo.Merge(m, src)
+}
+func (m *CheckerTaskInfo) XXX_Size() int {
+	return xxx_messageInfo_CheckerTaskInfo.Size(m)
+}
+func (m *CheckerTaskInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckerTaskInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckerTaskInfo proto.InternalMessageInfo
+
+func (m *CheckerTaskInfo) GetTaskID() int64 {
+	if m != nil {
+		return m.TaskID
+	}
+	return 0
+}
+
+func (m *CheckerTaskInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *CheckerTaskInfo) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
+func (m *CheckerTaskInfo) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *CheckerTaskInfo) GetDesc() string {
+	if m != nil {
+		return m.Desc
+	}
+	return ""
+}
+
+func (m *CheckerTaskInfo) GetAdded() bool {
+	if m != nil {
+		return m.Added
+	}
+	return false
+}
+
+func (m *CheckerTaskInfo) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type TriggerCheckerRunResponse struct {
+	Status               *commonpb.Status   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Tasks                []*CheckerTaskInfo `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *TriggerCheckerRunResponse) Reset()         { *m = TriggerCheckerRunResponse{} }
+func (m *TriggerCheckerRunResponse) String() string { return proto.CompactTextString(m) }
+func (*TriggerCheckerRunResponse) ProtoMessage()    {}
+func (*TriggerCheckerRunResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{92}
+}
+
+func (m *TriggerCheckerRunResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriggerCheckerRunResponse.Unmarshal(m, b)
+}
+func (m *TriggerCheckerRunResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriggerCheckerRunResponse.Marshal(b, m, deterministic)
+}
+func (m *TriggerCheckerRunResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerCheckerRunResponse.Merge(m, src)
+}
+func (m *TriggerCheckerRunResponse) XXX_Size() int {
+	return xxx_messageInfo_TriggerCheckerRunResponse.Size(m)
+}
+func (m *TriggerCheckerRunResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerCheckerRunResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerCheckerRunResponse proto.InternalMessageInfo
+
+func (m *TriggerCheckerRunResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *TriggerCheckerRunResponse) GetTasks() []*CheckerTaskInfo {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+type GetAvailabilitySLARequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// the recent time window to calculate uptime, use the max retention if not set
+	WindowSeconds        int64    `protobuf:"varint,3,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAvailabilitySLARequest) Reset()         { *m = GetAvailabilitySLARequest{} }
+func (m *GetAvailabilitySLARequest) String() string { return proto.CompactTextString(m) }
+func (*GetAvailabilitySLARequest) ProtoMessage()    {}
+func (*GetAvailabilitySLARequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{93}
+}
+
+func (m *GetAvailabilitySLARequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAvailabilitySLARequest.Unmarshal(m, b)
+}
+func (m *GetAvailabilitySLARequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAvailabilitySLARequest.Marshal(b, m, deterministic)
+}
+func (m *GetAvailabilitySLARequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAvailabilitySLARequest.Merge(m, src)
+}
+func (m *GetAvailabilitySLARequest) XXX_Size() int {
+	return xxx_messageInfo_GetAvailabilitySLARequest.Size(m)
+}
+func (m *GetAvailabilitySLARequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAvailabilitySLARequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAvailabilitySLARequest proto.InternalMessageInfo
+
+func (m *GetAvailabilitySLARequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetAvailabilitySLARequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *GetAvailabilitySLARequest) GetWindowSeconds() int64 {
+	if m != nil {
+		return m.WindowSeconds
+	}
+	return 0
+}
+
+type UnavailablePeriod struct {
+	StartTime            int64    `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              int64    `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnavailablePeriod) Reset()         { *m = UnavailablePeriod{} }
+func (m *UnavailablePeriod) String() string { return proto.CompactTextString(m) }
+func (*UnavailablePeriod) ProtoMessage()    {}
+func (*UnavailablePeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{94}
+}
+
+func (m *UnavailablePeriod) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnavailablePeriod.Unmarshal(m, b)
+}
+func (m *UnavailablePeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnavailablePeriod.Marshal(b, m, deterministic)
+}
+func (m *UnavailablePeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnavailablePeriod.Merge(m, src)
+}
+func (m *UnavailablePeriod) XXX_Size() int {
+	return xxx_messageInfo_UnavailablePeriod.Size(m)
+}
+func (m *UnavailablePeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnavailablePeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnavailablePeriod proto.InternalMessageInfo
+
+func (m *UnavailablePeriod) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *UnavailablePeriod) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+type GetAvailabilitySLAResponse struct {
+	Status             *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	UptimeRatio        float64          `protobuf:"fixed64,2,opt,name=uptime_ratio,json=uptimeRatio,proto3" json:"uptime_ratio,omitempty"`
+	UnavailableSeconds int64            `protobuf:"varint,3,opt,name=unavailable_seconds,json=unavailableSeconds,proto3" json:"unavailable_seconds,omitempty"`
+	// the effective window, may be shorter than requested if the collection is tracked recently
+	WindowSeconds        int64                `protobuf:"varint,4,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	TrackingSince        int64                `protobuf:"varint,5,opt,name=tracking_since,json=trackingSince,proto3" json:"tracking_since,omitempty"`
+	Available            bool                 `protobuf:"varint,6,opt,name=available,proto3" json:"available,omitempty"`
+	UnavailablePeriods   []*UnavailablePeriod `protobuf:"bytes,7,rep,name=unavailable_periods,json=unavailablePeriods,proto3" json:"unavailable_periods,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetAvailabilitySLAResponse) Reset()         { *m = GetAvailabilitySLAResponse{} }
+func (m *GetAvailabilitySLAResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAvailabilitySLAResponse) ProtoMessage()    {}
+func (*GetAvailabilitySLAResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{95}
+}
+
+func (m *GetAvailabilitySLAResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAvailabilitySLAResponse.Unmarshal(m, b)
+}
+func (m *GetAvailabilitySLAResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAvailabilitySLAResponse.Marshal(b, m, deterministic)
+}
+func (m *GetAvailabilitySLAResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAvailabilitySLAResponse.Merge(m, src)
+}
+func (m *GetAvailabilitySLAResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAvailabilitySLAResponse.Size(m)
+}
+func (m *GetAvailabilitySLAResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAvailabilitySLAResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAvailabilitySLAResponse proto.InternalMessageInfo
+
+func (m *GetAvailabilitySLAResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetAvailabilitySLAResponse) GetUptimeRatio() float64 {
+	if m != nil {
+		return m.UptimeRatio
+	}
+	return 0
+}
+
+func (m *GetAvailabilitySLAResponse) GetUnavailableSeconds() int64 {
+	if m != nil {
+		return m.UnavailableSeconds
+	}
+	return 0
+}
+
+func (m *GetAvailabilitySLAResponse) GetWindowSeconds() int64 {
+	if m != nil {
+		return m.WindowSeconds
+	}
+	return 0
+}
+
+func (m *GetAvailabilitySLAResponse) GetTrackingSince() int64 {
+	if m != nil {
+		return m.TrackingSince
+	}
+	return 0
+}
+
+func (m *GetAvailabilitySLAResponse) GetAvailable() bool {
+	if m != nil {
+		return m.Available
+	}
+	return false
+}
+
+func (m *GetAvailabilitySLAResponse) GetUnavailablePeriods() []*UnavailablePeriod {
+	if m != nil {
+		return m.UnavailablePeriods
+	}
+	return nil
+}
+
+type GetReleaseProgressRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetReleaseProgressRequest) Reset()         { *m = GetReleaseProgressRequest{} }
+func (m *GetReleaseProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReleaseProgressRequest) ProtoMessage()    {}
+func (*GetReleaseProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{96}
+}
+
+func (m *GetReleaseProgressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReleaseProgressRequest.Unmarshal(m, b)
+}
+func (m *GetReleaseProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReleaseProgressRequest.Marshal(b, m, deterministic)
+}
+func (m *GetReleaseProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReleaseProgressRequest.Merge(m, src)
+}
+func (m *GetReleaseProgressRequest) XXX_Size() int {
+	return xxx_messageInfo_GetReleaseProgressRequest.Size(m)
+}
+func (m *GetReleaseProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReleaseProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReleaseProgressRequest proto.InternalMessageInfo
+
+func (m *GetReleaseProgressRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetReleaseProgressRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type GetReleaseProgressResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// number of segments/channels on query nodes when release starts
+	TotalSegments     int64 `protobuf:"varint,2,opt,name=total_segments,json=totalSegments,proto3" json:"total_segments,omitempty"`
+	RemainingSegments int64 `protobuf:"varint,3,opt,name=remaining_segments,json=remainingSegments,proto3" json:"remaining_segments,omitempty"`
+	TotalChannels     int64 `protobuf:"varint,4,opt,name=total_channels,json=totalChannels,proto3" json:"total_channels,omitempty"`
+	RemainingChannels int64 `protobuf:"varint,5,opt,name=remaining_channels,json=remainingChannels,proto3" json:"remaining_channels,omitempty"`
+	// percentage of released segments and channels
+	Progress int64 `protobuf:"varint,6,opt,name=progress,proto3" json:"progress,omitempty"`
+	// true if no distribution of the collection remains
+	Complete             bool     `protobuf:"varint,7,opt,name=complete,proto3" json:"complete,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetReleaseProgressResponse) Reset()         { *m = GetReleaseProgressResponse{} }
+func (m *GetReleaseProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReleaseProgressResponse) ProtoMessage()    {}
+func (*GetReleaseProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{97}
+}
+
+func (m *GetReleaseProgressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReleaseProgressResponse.Unmarshal(m, b)
+}
+func (m *GetReleaseProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReleaseProgressResponse.Marshal(b, m, deterministic)
+}
+func (m *GetReleaseProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReleaseProgressResponse.Merge(m, src)
+}
+func (m *GetReleaseProgressResponse) XXX_Size() int {
+	return xxx_messageInfo_GetReleaseProgressResponse.Size(m)
+}
+func (m *GetReleaseProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReleaseProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReleaseProgressResponse proto.InternalMessageInfo
+
+func (m *GetReleaseProgressResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetReleaseProgressResponse) GetTotalSegments() int64 {
+	if m != nil {
+		return m.TotalSegments
+	}
+	return 0
+}
+
+func (m *GetReleaseProgressResponse) GetRemainingSegments() int64 {
+	if m != nil {
+		return m.RemainingSegments
+	}
+	return 0
+}
+
+func (m *GetReleaseProgressResponse) GetTotalChannels() int64 {
+	if m != nil {
+		return m.TotalChannels
+	}
+	return 0
+}
+
+func (m *GetReleaseProgressResponse) GetRemainingChannels() int64 {
+	if m != nil {
+		return m.RemainingChannels
+	}
+	return 0
+}
+
+func (m *GetReleaseProgressResponse) GetProgress() int64 {
+	if m != nil {
+		return m.Progress
+	}
+	return 0
+}
+
+func (m *GetReleaseProgressResponse) GetComplete() bool {
+	if m != nil {
+		return m.Complete
+	}
+	return false
+}
+
+type TenantViolation struct {
+	NodeID               int64    `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Tenants              []string `protobuf:"bytes,2,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	CollectionIDs        []int64  `protobuf:"varint,3,rep,packed,name=collectionIDs,proto3" json:"collectionIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TenantViolation) Reset()         { *m = TenantViolation{} }
+func (m *TenantViolation) String() string { return proto.CompactTextString(m) }
+func (*TenantViolation) ProtoMessage()    {}
+func (*TenantViolation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{98}
+}
+
+func (m *TenantViolation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TenantViolation.Unmarshal(m, b)
+}
+func (m *TenantViolation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TenantViolation.Marshal(b, m, deterministic)
+}
+func (m *TenantViolation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TenantViolation.Merge(m, src)
+}
+func (m *TenantViolation) XXX_Size() int {
+	return xxx_messageInfo_TenantViolation.Size(m)
+}
+func (m *TenantViolation) XXX_DiscardUnknown() {
+	xxx_messageInfo_TenantViolation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TenantViolation proto.InternalMessageInfo
+
+func (m *TenantViolation) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *TenantViolation) GetTenants() []string {
+	if m != nil {
+		return m.Tenants
+	}
+	return nil
+}
+
+func (m *TenantViolation) GetCollectionIDs() []int64 {
+	if m != nil {
+		return m.CollectionIDs
+	}
+	return nil
+}
+
+type GetTenantViolationsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetTenantViolationsRequest) Reset()         { *m = GetTenantViolationsRequest{} }
+func (m *GetTenantViolationsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTenantViolationsRequest) ProtoMessage()    {}
+func (*GetTenantViolationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{99}
+}
+
+func (m *GetTenantViolationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTenantViolationsRequest.Unmarshal(m, b)
+}
+func (m *GetTenantViolationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTenantViolationsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTenantViolationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTenantViolationsRequest.Merge(m, src)
+}
+func (m *GetTenantViolationsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTenantViolationsRequest.Size(m)
+}
+func (m *GetTenantViolationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTenantViolationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTenantViolationsRequest proto.InternalMessageInfo
+
+func (m *GetTenantViolationsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type GetTenantViolationsResponse struct {
+	Status               *commonpb.Status   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Violations           []*TenantViolation `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetTenantViolationsResponse) Reset()         { *m = GetTenantViolationsResponse{} }
+func (m *GetTenantViolationsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTenantViolationsResponse) ProtoMessage()    {}
+func (*GetTenantViolationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{100}
+}
+
+func (m *GetTenantViolationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTenantViolationsResponse.Unmarshal(m, b)
+}
+func (m *GetTenantViolationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTenantViolationsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTenantViolationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTenantViolationsResponse.Merge(m, src)
+}
+func (m *GetTenantViolationsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTenantViolationsResponse.Size(m)
+}
+func (m *GetTenantViolationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTenantViolationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTenantViolationsResponse proto.InternalMessageInfo
+
+func (m *GetTenantViolationsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetTenantViolationsResponse) GetViolations() []*TenantViolation {
+	if m != nil {
+		return m.Violations
+	}
+	return nil
+}
+
+type CaptureBalanceLayoutRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CaptureBalanceLayoutRequest) Reset()         { *m = CaptureBalanceLayoutRequest{} }
+func (m *CaptureBalanceLayoutRequest) String() string { return proto.CompactTextString(m) }
+func (*CaptureBalanceLayoutRequest) ProtoMessage()    {}
+func (*CaptureBalanceLayoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{101}
+}
+
+func (m *CaptureBalanceLayoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CaptureBalanceLayoutRequest.Unmarshal(m, b)
+}
+func (m *CaptureBalanceLayoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CaptureBalanceLayoutRequest.Marshal(b, m, deterministic)
+}
+func (m *CaptureBalanceLayoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CaptureBalanceLayoutRequest.Merge(m, src)
+}
+func (m *CaptureBalanceLayoutRequest) XXX_Size() int {
+	return xxx_messageInfo_CaptureBalanceLayoutRequest.Size(m)
+}
+func (m *CaptureBalanceLayoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CaptureBalanceLayoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CaptureBalanceLayoutRequest proto.InternalMessageInfo
+
+func (m *CaptureBalanceLayoutRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CaptureBalanceLayoutRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type ClearBalanceLayoutRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ClearBalanceLayoutRequest) Reset()         { *m = ClearBalanceLayoutRequest{} }
+func (m *ClearBalanceLayoutRequest) String() string { return proto.CompactTextString(m) }
+func (*ClearBalanceLayoutRequest) ProtoMessage()    {}
+func (*ClearBalanceLayoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{102}
+}
+
+func (m *ClearBalanceLayoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClearBalanceLayoutRequest.Unmarshal(m, b)
+}
+func (m *ClearBalanceLayoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClearBalanceLayoutRequest.Marshal(b, m, deterministic)
+}
+func (m *ClearBalanceLayoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearBalanceLayoutRequest.Merge(m, src)
+}
+func (m *ClearBalanceLayoutRequest) XXX_Size() int {
+	return xxx_messageInfo_ClearBalanceLayoutRequest.Size(m)
+}
+func (m *ClearBalanceLayoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearBalanceLayoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClearBalanceLayoutRequest proto.InternalMessageInfo
+
+func (m *ClearBalanceLayoutRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ClearBalanceLayoutRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type BalanceLayoutStatus struct {
+	CollectionID int64 `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Active       bool  `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	// unix time in milliseconds when the layout is captured
+	CapturedTime         int64    `protobuf:"varint,3,opt,name=captured_time,json=capturedTime,proto3" json:"captured_time,omitempty"`
+	SegmentNum           int64    `protobuf:"varint,4,opt,name=segment_num,json=segmentNum,proto3" json:"segment_num,omitempty"`
+	ChannelNum           int64    `protobuf:"varint,5,opt,name=channel_num,json=channelNum,proto3" json:"channel_num,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BalanceLayoutStatus) Reset()         { *m = BalanceLayoutStatus{} }
+func (m *BalanceLayoutStatus) String() string { return proto.CompactTextString(m) }
+func (*BalanceLayoutStatus) ProtoMessage()    {}
+func (*BalanceLayoutStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{103}
+}
+
+func (m *BalanceLayoutStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BalanceLayoutStatus.Unmarshal(m, b)
+}
+func (m *BalanceLayoutStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BalanceLayoutStatus.Marshal(b, m, deterministic)
+}
+func (m *BalanceLayoutStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceLayoutStatus.Merge(m, src)
+}
+func (m *BalanceLayoutStatus) XXX_Size() int {
+	return xxx_messageInfo_BalanceLayoutStatus.Size(m)
+}
+func (m *BalanceLayoutStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceLayoutStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceLayoutStatus proto.InternalMessageInfo
+
+func (m *BalanceLayoutStatus) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *BalanceLayoutStatus) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *BalanceLayoutStatus) GetCapturedTime() int64 {
+	if m != nil {
+		return m.CapturedTime
+	}
+	return 0
+}
+
+func (m *BalanceLayoutStatus) GetSegmentNum() int64 {
+	if m != nil {
+		return m.SegmentNum
+	}
+	return 0
+}
+
+func (m *BalanceLayoutStatus) GetChannelNum() int64 {
+	if m != nil {
+		return m.ChannelNum
+	}
+	return 0
+}
+
+type GetBalanceLayoutStatusRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// all loaded collections if empty
+	CollectionIDs        []int64  `protobuf:"varint,2,rep,packed,name=collectionIDs,proto3" json:"collectionIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBalanceLayoutStatusRequest) Reset()         { *m = GetBalanceLayoutStatusRequest{} }
+func (m *GetBalanceLayoutStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBalanceLayoutStatusRequest) ProtoMessage()    {}
+func (*GetBalanceLayoutStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{104}
+}
+
+func (m *GetBalanceLayoutStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBalanceLayoutStatusRequest.Unmarshal(m, b)
+}
+func (m *GetBalanceLayoutStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBalanceLayoutStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBalanceLayoutStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBalanceLayoutStatusRequest.Merge(m, src)
+}
+func (m *GetBalanceLayoutStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBalanceLayoutStatusRequest.Size(m)
+}
+func (m *GetBalanceLayoutStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBalanceLayoutStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBalanceLayoutStatusRequest proto.InternalMessageInfo
+
+func (m *GetBalanceLayoutStatusRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetBalanceLayoutStatusRequest) GetCollectionIDs() []int64 {
+	if m != nil {
+		return m.CollectionIDs
+	}
+	return nil
+}
+
+type GetBalanceLayoutStatusResponse struct {
+	Status               *commonpb.Status       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Statuses             []*BalanceLayoutStatus `protobuf:"bytes,2,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GetBalanceLayoutStatusResponse) Reset()         { *m = GetBalanceLayoutStatusResponse{} }
+func (m *GetBalanceLayoutStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBalanceLayoutStatusResponse) ProtoMessage()    {}
+func (*GetBalanceLayoutStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{105}
+}
+
+func (m *GetBalanceLayoutStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBalanceLayoutStatusResponse.Unmarshal(m, b)
+}
+func (m *GetBalanceLayoutStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBalanceLayoutStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *GetBalanceLayoutStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBalanceLayoutStatusResponse.Merge(m, src)
+}
+func (m *GetBalanceLayoutStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBalanceLayoutStatusResponse.Size(m)
+}
+func (m *GetBalanceLayoutStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBalanceLayoutStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBalanceLayoutStatusResponse proto.InternalMessageInfo
+
+func (m *GetBalanceLayoutStatusResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetBalanceLayoutStatusResponse) GetStatuses() []*BalanceLayoutStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+// the node assignment of segments and channels in a replica
+type ReplicaLayout struct {
+	ReplicaID int64 `protobuf:"varint,1,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
+	// segmentID -> nodeID
+	Segments map[int64]int64 `protobuf:"bytes,2,rep,name=segments,proto3" json:"segments,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// channel name -> nodeID
+	Channels             map[string]int64 `protobuf:"bytes,3,rep,name=channels,proto3" json:"channels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReplicaLayout) Reset()         { *m = ReplicaLayout{} }
+func (m *ReplicaLayout) String() string { return proto.CompactTextString(m) }
+func (*ReplicaLayout) ProtoMessage()    {}
+func (*ReplicaLayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{106}
+}
+
+func (m *ReplicaLayout) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplicaLayout.Unmarshal(m, b)
+}
+func (m *ReplicaLayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplicaLayout.Marshal(b, m, deterministic)
+}
+func (m *ReplicaLayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicaLayout.Merge(m, src)
+}
+func (m *ReplicaLayout) XXX_Size() int {
+	return xxx_messageInfo_ReplicaLayout.Size(m)
+}
+func (m *ReplicaLayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicaLayout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicaLayout proto.InternalMessageInfo
+
+func (m *ReplicaLayout) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
+func (m *ReplicaLayout) GetSegments() map[int64]int64 {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *ReplicaLayout) GetChannels() map[string]int64 {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+// the persisted layout captured by CaptureBalanceLayout
+type BalanceLayout struct {
+	CollectionID int64 `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// unix time in milliseconds when the layout is captured
+	CapturedTime         int64            `protobuf:"varint,2,opt,name=captured_time,json=capturedTime,proto3" json:"captured_time,omitempty"`
+	Replicas             []*ReplicaLayout `protobuf:"bytes,3,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *BalanceLayout) Reset()         { *m = BalanceLayout{} }
+func (m *BalanceLayout) String() string { return proto.CompactTextString(m) }
+func (*BalanceLayout) ProtoMessage()    {}
+func (*BalanceLayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{107}
+}
+
+func (m *BalanceLayout) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BalanceLayout.Unmarshal(m, b)
+}
+func (m *BalanceLayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BalanceLayout.Marshal(b, m, deterministic)
+}
+func (m *BalanceLayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceLayout.Merge(m, src)
+}
+func (m *BalanceLayout) XXX_Size() int {
+	return xxx_messageInfo_BalanceLayout.Size(m)
+}
+func (m *BalanceLayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceLayout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceLayout proto.InternalMessageInfo
+
+func (m *BalanceLayout) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *BalanceLayout) GetCapturedTime() int64 {
+	if m != nil {
+		return m.CapturedTime
+	}
+	return 0
+}
+
+func (m *BalanceLayout) GetReplicas() []*ReplicaLayout {
+	if m != nil {
+		return m.Replicas
+	}
+	return nil
+}
+
+// LoadCheckpoint is the compact load state of a collection,
+// which is used to skip recomputing the load plan after restart if the cluster state matches.
+type LoadCheckpoint struct {
+	CollectionID  int64      `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	TargetVersion int64      `protobuf:"varint,2,opt,name=target_version,json=targetVersion,proto3" json:"target_version,omitempty"`
+	Replicas      []*Replica `protobuf:"bytes,3,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	// unix time in milliseconds when the checkpoint is taken
+	Timestamp            int64    `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoadCheckpoint) Reset()         { *m = LoadCheckpoint{} }
+func (m *LoadCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*LoadCheckpoint) ProtoMessage()    {}
+func (*LoadCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{108}
+}
+
+func (m *LoadCheckpoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadCheckpoint.Unmarshal(m, b)
+}
+func (m *LoadCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadCheckpoint.Marshal(b, m, deterministic)
+}
+func (m *LoadCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadCheckpoint.Merge(m, src)
+}
+func (m *LoadCheckpoint) XXX_Size() int {
+	return xxx_messageInfo_LoadCheckpoint.Size(m)
+}
+func (m *LoadCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadCheckpoint proto.InternalMessageInfo
+
+func (m *LoadCheckpoint) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *LoadCheckpoint) GetTargetVersion() int64 {
+	if m != nil {
+		return m.TargetVersion
+	}
+	return 0
+}
+
+func (m *LoadCheckpoint) GetReplicas() []*Replica {
+	if m != nil {
+		return m.Replicas
+	}
+	return nil
+}
+
+func (m *LoadCheckpoint) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type GetCollectionLoadInfoRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetCollectionLoadInfoRequest) Reset()         { *m = GetCollectionLoadInfoRequest{} }
+func (m *GetCollectionLoadInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCollectionLoadInfoRequest) ProtoMessage()    {}
+func (*GetCollectionLoadInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{109}
+}
+
+func (m *GetCollectionLoadInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCollectionLoadInfoRequest.Unmarshal(m, b)
+}
+func (m *GetCollectionLoadInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCollectionLoadInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCollectionLoadInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCollectionLoadInfoRequest.Merge(m, src)
+}
+func (m *GetCollectionLoadInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCollectionLoadInfoRequest.Size(m)
+}
+func (m *GetCollectionLoadInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCollectionLoadInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCollectionLoadInfoRequest proto.InternalMessageInfo
+
+func (m *GetCollectionLoadInfoRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetCollectionLoadInfoRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type GetCollectionLoadInfoResponse struct {
+	Status   *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	LoadInfo *CollectionLoadInfo `protobuf:"bytes,2,opt,name=load_info,json=loadInfo,proto3" json:"load_info,omitempty"`
+	// nil if no checkpoint taken or the checkpoint has been invalidated
+	Checkpoint           *LoadCheckpoint `protobuf:"bytes,3,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	CheckpointAgeMs      int64           `protobuf:"varint,4,opt,name=checkpoint_age_ms,json=checkpointAgeMs,proto3" json:"checkpoint_age_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetCollectionLoadInfoResponse) Reset()         { *m = GetCollectionLoadInfoResponse{} }
+func (m *GetCollectionLoadInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCollectionLoadInfoResponse) ProtoMessage()    {}
+func (*GetCollectionLoadInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{110}
+}
+
+func (m *GetCollectionLoadInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCollectionLoadInfoResponse.Unmarshal(m, b)
+}
+func (m *GetCollectionLoadInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCollectionLoadInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCollectionLoadInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCollectionLoadInfoResponse.Merge(m, src)
+}
+func (m *GetCollectionLoadInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCollectionLoadInfoResponse.Size(m)
+}
+func (m *GetCollectionLoadInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCollectionLoadInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCollectionLoadInfoResponse proto.InternalMessageInfo
+
+func (m *GetCollectionLoadInfoResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetCollectionLoadInfoResponse) GetLoadInfo() *CollectionLoadInfo {
+	if m != nil {
+		return m.LoadInfo
+	}
+	return nil
+}
+
+func (m *GetCollectionLoadInfoResponse) GetCheckpoint() *LoadCheckpoint {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return nil
+}
+
+func (m *GetCollectionLoadInfoResponse) GetCheckpointAgeMs() int64 {
+	if m != nil {
+		return m.CheckpointAgeMs
+	}
+	return 0
+}
+
+type RecommendReplicaCountRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// the utilization of query node capacity each shard is expected to keep under, use the configured one if not set
+	TargetUtilization    float64  `protobuf:"fixed64,3,opt,name=target_utilization,json=targetUtilization,proto3" json:"target_utilization,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RecommendReplicaCountRequest) Reset()         { *m = RecommendReplicaCountRequest{} }
+func (m *RecommendReplicaCountRequest) String() string { return proto.CompactTextString(m) }
+func (*RecommendReplicaCountRequest) ProtoMessage()    {}
+func (*RecommendReplicaCountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{111}
+}
+
+func (m *RecommendReplicaCountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecommendReplicaCountRequest.Unmarshal(m, b)
+}
+func (m *RecommendReplicaCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecommendReplicaCountRequest.Marshal(b, m, deterministic)
+}
+func (m *RecommendReplicaCountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecommendReplicaCountRequest.Merge(m, src)
+}
+func (m *RecommendReplicaCountRequest) XXX_Size() int {
+	return xxx_messageInfo_RecommendReplicaCountRequest.Size(m)
+}
+func (m *RecommendReplicaCountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecommendReplicaCountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecommendReplicaCountRequest proto.InternalMessageInfo
+
+func (m *RecommendReplicaCountRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RecommendReplicaCountRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *RecommendReplicaCountRequest) GetTargetUtilization() float64 {
+	if m != nil {
+		return m.TargetUtilization
+	}
+	return 0
+}
+
+type ShardLoadEstimate struct {
+	ChannelName string `protobuf:"bytes,1,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	// the search nq per second served by all leaders of the shard
+	NqPerSecond           float64  `protobuf:"fixed64,2,opt,name=nq_per_second,json=nqPerSecond,proto3" json:"nq_per_second,omitempty"`
+	RequiredReplicaNumber int32    `protobuf:"varint,3,opt,name=required_replica_number,json=requiredReplicaNumber,proto3" json:"required_replica_number,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *ShardLoadEstimate) Reset()         { *m = ShardLoadEstimate{} }
+func (m *ShardLoadEstimate) String() string { return proto.CompactTextString(m) }
+func (*ShardLoadEstimate) ProtoMessage()    {}
+func (*ShardLoadEstimate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{112}
+}
+
+func (m *ShardLoadEstimate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardLoadEstimate.Unmarshal(m, b)
+}
+func (m *ShardLoadEstimate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardLoadEstimate.Marshal(b, m, deterministic)
+}
+func (m *ShardLoadEstimate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardLoadEstimate.Merge(m, src)
+}
+func (m *ShardLoadEstimate) XXX_Size() int {
+	return xxx_messageInfo_ShardLoadEstimate.Size(m)
+}
+func (m *ShardLoadEstimate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardLoadEstimate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardLoadEstimate proto.InternalMessageInfo
+
+func (m *ShardLoadEstimate) GetChannelName() string {
+	if m != nil {
+		return m.ChannelName
+	}
+	return ""
+}
+
+func (m *ShardLoadEstimate) GetNqPerSecond() float64 {
+	if m != nil {
+		return m.NqPerSecond
+	}
+	return 0
+}
+
+func (m *ShardLoadEstimate) GetRequiredReplicaNumber() int32 {
+	if m != nil {
+		return m.RequiredReplicaNumber
+	}
+	return 0
+}
+
+type RecommendReplicaCountResponse struct {
+	Status                   *commonpb.Status     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CurrentReplicaNumber     int32                `protobuf:"varint,2,opt,name=current_replica_number,json=currentReplicaNumber,proto3" json:"current_replica_number,omitempty"`
+	RecommendedReplicaNumber int32                `protobuf:"varint,3,opt,name=recommended_replica_number,json=recommendedReplicaNumber,proto3" json:"recommended_replica_number,omitempty"`
+	Rationale                string               `protobuf:"bytes,4,opt,name=rationale,proto3" json:"rationale,omitempty"`
+	Shards                   []*ShardLoadEstimate `protobuf:"bytes,5,rep,name=shards,proto3" json:"shards,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{}             `json:"-"`
+	XXX_unrecognized         []byte               `json:"-"`
+	XXX_sizecache            int32                `json:"-"`
+}
+
+func (m *RecommendReplicaCountResponse) Reset()         { *m = RecommendReplicaCountResponse{} }
+func (m *RecommendReplicaCountResponse) String() string { return proto.CompactTextString(m) }
+func (*RecommendReplicaCountResponse) ProtoMessage()    {}
+func (*RecommendReplicaCountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{113}
+}
+
+func (m *RecommendReplicaCountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecommendReplicaCountResponse.Unmarshal(m, b)
+}
+func (m *RecommendReplicaCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecommendReplicaCountResponse.Marshal(b, m, deterministic)
+}
+func (m *RecommendReplicaCountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecommendReplicaCountResponse.Merge(m, src)
+}
+func (m *RecommendReplicaCountResponse) XXX_Size() int {
+	return xxx_messageInfo_RecommendReplicaCountResponse.Size(m)
+}
+func (m *RecommendReplicaCountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecommendReplicaCountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecommendReplicaCountResponse proto.InternalMessageInfo
+
+func (m *RecommendReplicaCountResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *RecommendReplicaCountResponse) GetCurrentReplicaNumber() int32 {
+	if m != nil {
+		return m.CurrentReplicaNumber
+	}
+	return 0
+}
+
+func (m *RecommendReplicaCountResponse) GetRecommendedReplicaNumber() int32 {
+	if m != nil {
+		return m.RecommendedReplicaNumber
+	}
+	return 0
+}
+
+func (m *RecommendReplicaCountResponse) GetRationale() string {
+	if m != nil {
+		return m.Rationale
+	}
+	return ""
+}
+
+func (m *RecommendReplicaCountResponse) GetShards() []*ShardLoadEstimate {
+	if m != nil {
+		return m.Shards
+	}
+	return nil
+}
+
+// persisted cluster-wide state of target updates
+type TargetUpdateState struct {
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	// unix time in milliseconds
+	PausedTime           int64    `protobuf:"varint,2,opt,name=paused_time,json=pausedTime,proto3" json:"paused_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TargetUpdateState) Reset()         { *m = TargetUpdateState{} }
+func (m *TargetUpdateState) String() string { return proto.CompactTextString(m) }
+func (*TargetUpdateState) ProtoMessage()    {}
+func (*TargetUpdateState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{114}
+}
+
+func (m *TargetUpdateState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TargetUpdateState.Unmarshal(m, b)
+}
+func (m *TargetUpdateState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TargetUpdateState.Marshal(b, m, deterministic)
+}
+func (m *TargetUpdateState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TargetUpdateState.Merge(m, src)
+}
+func (m *TargetUpdateState) XXX_Size() int {
+	return xxx_messageInfo_TargetUpdateState.Size(m)
+}
+func (m *TargetUpdateState) XXX_DiscardUnknown() {
+	xxx_messageInfo_TargetUpdateState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TargetUpdateState proto.InternalMessageInfo
+
+func (m *TargetUpdateState) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *TargetUpdateState) GetPausedTime() int64 {
+	if m != nil {
+		return m.PausedTime
+	}
+	return 0
+}
+
+type PauseTargetUpdatesRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *PauseTargetUpdatesRequest) Reset()         { *m = PauseTargetUpdatesRequest{} }
+func (m *PauseTargetUpdatesRequest) String() string { return proto.CompactTextString(m) }
+func (*PauseTargetUpdatesRequest) ProtoMessage()    {}
+func (*PauseTargetUpdatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{115}
+}
+
+func (m *PauseTargetUpdatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PauseTargetUpdatesRequest.Unmarshal(m, b)
+}
+func (m *PauseTargetUpdatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PauseTargetUpdatesRequest.Marshal(b, m, deterministic)
+}
+func (m *PauseTargetUpdatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseTargetUpdatesRequest.Merge(m, src)
+}
+func (m *PauseTargetUpdatesRequest) XXX_Size() int {
+	return xxx_messageInfo_PauseTargetUpdatesRequest.Size(m)
+}
+func (m *PauseTargetUpdatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseTargetUpdatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseTargetUpdatesRequest proto.InternalMessageInfo
+
+func (m *PauseTargetUpdatesRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type ResumeTargetUpdatesRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ResumeTargetUpdatesRequest) Reset()         { *m = ResumeTargetUpdatesRequest{} }
+func (m *ResumeTargetUpdatesRequest) String() string { return proto.CompactTextString(m) }
+func (*ResumeTargetUpdatesRequest) ProtoMessage()    {}
+func (*ResumeTargetUpdatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{116}
+}
+
+func (m *ResumeTargetUpdatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumeTargetUpdatesRequest.Unmarshal(m, b)
+}
+func (m *ResumeTargetUpdatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumeTargetUpdatesRequest.Marshal(b, m, deterministic)
+}
+func (m *ResumeTargetUpdatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeTargetUpdatesRequest.Merge(m, src)
+}
+func (m *ResumeTargetUpdatesRequest) XXX_Size() int {
+	return xxx_messageInfo_ResumeTargetUpdatesRequest.Size(m)
+}
+func (m *ResumeTargetUpdatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeTargetUpdatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeTargetUpdatesRequest proto.InternalMessageInfo
+
+func (m *ResumeTargetUpdatesRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type NodeLoadSample struct {
+	// unix time in milliseconds
+	Timestamp  int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	SegmentNum int64 `protobuf:"varint,2,opt,name=segment_num,json=segmentNum,proto3" json:"segment_num,omitempty"`
+	ChannelNum int64 `protobuf:"varint,3,opt,name=channel_num,json=channelNum,proto3" json:"channel_num,omitempty"`
+	// the total size of the segments on the node, in bytes
+	MemorySize           int64    `protobuf:"varint,4,opt,name=memory_size,json=memorySize,proto3" json:"memory_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeLoadSample) Reset()         { *m = NodeLoadSample{} }
+func (m *NodeLoadSample) String() string { return proto.CompactTextString(m) }
+func (*NodeLoadSample) ProtoMessage()    {}
+func (*NodeLoadSample) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{117}
+}
+
+func (m *NodeLoadSample) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeLoadSample.Unmarshal(m, b)
+}
+func (m *NodeLoadSample) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeLoadSample.Marshal(b, m, deterministic)
+}
+func (m *NodeLoadSample) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeLoadSample.Merge(m, src)
+}
+func (m *NodeLoadSample) XXX_Size() int {
+	return xxx_messageInfo_NodeLoadSample.Size(m)
+}
+func (m *NodeLoadSample) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeLoadSample.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeLoadSample proto.InternalMessageInfo
+
+func (m *NodeLoadSample) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *NodeLoadSample) GetSegmentNum() int64 {
+	if m != nil {
+		return m.SegmentNum
+	}
+	return 0
+}
+
+func (m *NodeLoadSample) GetChannelNum() int64 {
+	if m != nil {
+		return m.ChannelNum
+	}
+	return 0
+}
+
+func (m *NodeLoadSample) GetMemorySize() int64 {
+	if m != nil {
+		return m.MemorySize
+	}
+	return 0
+}
+
+type GetNodeLoadHistoryRequest struct {
+	Base   *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	NodeID int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	// unix time in milliseconds, return all samples within the retention if not set
+	Since                int64    `protobuf:"varint,3,opt,name=since,proto3" json:"since,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetNodeLoadHistoryRequest) Reset()         { *m = GetNodeLoadHistoryRequest{} }
+func (m *GetNodeLoadHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetNodeLoadHistoryRequest) ProtoMessage()    {}
+func (*GetNodeLoadHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{118}
+}
+
+func (m *GetNodeLoadHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNodeLoadHistoryRequest.Unmarshal(m, b)
+}
+func (m *GetNodeLoadHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNodeLoadHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetNodeLoadHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNodeLoadHistoryRequest.Merge(m, src)
+}
+func (m *GetNodeLoadHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetNodeLoadHistoryRequest.Size(m)
+}
+func (m *GetNodeLoadHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNodeLoadHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNodeLoadHistoryRequest proto.InternalMessageInfo
+
+func (m *GetNodeLoadHistoryRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetNodeLoadHistoryRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *GetNodeLoadHistoryRequest) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+type GetNodeLoadHistoryResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// from the oldest to the latest
+	Samples              []*NodeLoadSample `protobuf:"bytes,2,rep,name=samples,proto3" json:"samples,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetNodeLoadHistoryResponse) Reset()         { *m = GetNodeLoadHistoryResponse{} }
+func (m *GetNodeLoadHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetNodeLoadHistoryResponse) ProtoMessage()    {}
+func (*GetNodeLoadHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{119}
+}
+
+func (m *GetNodeLoadHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNodeLoadHistoryResponse.Unmarshal(m, b)
+}
+func (m *GetNodeLoadHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNodeLoadHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *GetNodeLoadHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNodeLoadHistoryResponse.Merge(m, src)
+}
+func (m *GetNodeLoadHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetNodeLoadHistoryResponse.Size(m)
+}
+func (m *GetNodeLoadHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNodeLoadHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNodeLoadHistoryResponse proto.InternalMessageInfo
+
+func (m *GetNodeLoadHistoryResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetNodeLoadHistoryResponse) GetSamples() []*NodeLoadSample {
+	if m != nil {
+		return m.Samples
+	}
+	return nil
+}
+
+// a shard blocked from serving queries by operators
+type ShardBlock struct {
+	CollectionID int64  `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Channel      string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Reason       string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// unix time in milliseconds
+	BlockTime            int64    `protobuf:"varint,4,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShardBlock) Reset()         { *m = ShardBlock{} }
+func (m *ShardBlock) String() string { return proto.CompactTextString(m) }
+func (*ShardBlock) ProtoMessage()    {}
+func (*ShardBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{120}
+}
+
+func (m *ShardBlock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardBlock.Unmarshal(m, b)
+}
+func (m *ShardBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardBlock.Marshal(b, m, deterministic)
+}
+func (m *ShardBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardBlock.Merge(m, src)
+}
+func (m *ShardBlock) XXX_Size() int {
+	return xxx_messageInfo_ShardBlock.Size(m)
+}
+func (m *ShardBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardBlock proto.InternalMessageInfo
+
+func (m *ShardBlock) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ShardBlock) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *ShardBlock) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ShardBlock) GetBlockTime() int64 {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
+type BlockShardRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Channel              string            `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	Reason               string            `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *BlockShardRequest) Reset()         { *m = BlockShardRequest{} }
+func (m *BlockShardRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockShardRequest) ProtoMessage()    {}
+func (*BlockShardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{121}
+}
+
+func (m *BlockShardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockShardRequest.Unmarshal(m, b)
+}
+func (m *BlockShardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockShardRequest.Marshal(b, m, deterministic)
+}
+func (m *BlockShardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockShardRequest.Merge(m, src)
+}
+func (m *BlockShardRequest) XXX_Size() int {
+	return xxx_messageInfo_BlockShardRequest.Size(m)
+}
+func (m *BlockShardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockShardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockShardRequest proto.InternalMessageInfo
+
+func (m *BlockShardRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *BlockShardRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *BlockShardRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *BlockShardRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type UnblockShardRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Channel              string            `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UnblockShardRequest) Reset()         { *m = UnblockShardRequest{} }
+func (m *UnblockShardRequest) String() string { return proto.CompactTextString(m) }
+func (*UnblockShardRequest) ProtoMessage()    {}
+func (*UnblockShardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{122}
+}
+
+func (m *UnblockShardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnblockShardRequest.Unmarshal(m, b)
+}
+func (m *UnblockShardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnblockShardRequest.Marshal(b, m, deterministic)
+}
+func (m *UnblockShardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnblockShardRequest.Merge(m, src)
+}
+func (m *UnblockShardRequest) XXX_Size() int {
+	return xxx_messageInfo_UnblockShardRequest.Size(m)
+}
+func (m *UnblockShardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnblockShardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnblockShardRequest proto.InternalMessageInfo
+
+func (m *UnblockShardRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *UnblockShardRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *UnblockShardRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+type GetResourceGroupDriftRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// empty for all resource groups
+	ResourceGroup        string   `protobuf:"bytes,2,opt,name=resource_group,json=resourceGroup,proto3" json:"resource_group,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetResourceGroupDriftRequest) Reset()         { *m = GetResourceGroupDriftRequest{} }
+func (m *GetResourceGroupDriftRequest) String() string { return proto.CompactTextString(m) }
+func (*GetResourceGroupDriftRequest) ProtoMessage()    {}
+func (*GetResourceGroupDriftRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{123}
+}
+
+func (m *GetResourceGroupDriftRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetResourceGroupDriftRequest.Unmarshal(m, b)
+}
+func (m *GetResourceGroupDriftRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetResourceGroupDriftRequest.Marshal(b, m, deterministic)
+}
+func (m *GetResourceGroupDriftRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetResourceGroupDriftRequest.Merge(m, src)
+}
+func (m *GetResourceGroupDriftRequest) XXX_Size() int {
+	return xxx_messageInfo_GetResourceGroupDriftRequest.Size(m)
+}
+func (m *GetResourceGroupDriftRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetResourceGroupDriftRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetResourceGroupDriftRequest proto.InternalMessageInfo
+
+func (m *GetResourceGroupDriftRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetResourceGroupDriftRequest) GetResourceGroup() string {
+	if m != nil {
+		return m.ResourceGroup
+	}
+	return ""
+}
+
+type ResourceGroupDrift struct {
+	ResourceGroup    string `protobuf:"bytes,1,opt,name=resource_group,json=resourceGroup,proto3" json:"resource_group,omitempty"`
+	RequestedNodeNum int32  `protobuf:"varint,2,opt,name=requested_node_num,json=requestedNodeNum,proto3" json:"requested_node_num,omitempty"`
+	LimitNodeNum     int32  `protobuf:"varint,3,opt,name=limit_node_num,json=limitNodeNum,proto3" json:"limit_node_num,omitempty"`
+	ActualNodeNum    int32  `protobuf:"varint,4,opt,name=actual_node_num,json=actualNodeNum,proto3" json:"actual_node_num,omitempty"`
+	// whether actual_node_num is within [requested_node_num, limit_node_num]
+	WithinConfig         bool     `protobuf:"varint,5,opt,name=within_config,json=withinConfig,proto3" json:"within_config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResourceGroupDrift) Reset()         { *m = ResourceGroupDrift{} }
+func (m *ResourceGroupDrift) String() string { return proto.CompactTextString(m) }
+func (*ResourceGroupDrift) ProtoMessage()    {}
+func (*ResourceGroupDrift) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{124}
+}
+
+func (m *ResourceGroupDrift) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourceGroupDrift.Unmarshal(m, b)
+}
+func (m *ResourceGroupDrift) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourceGroupDrift.Marshal(b, m, deterministic)
+}
+func (m *ResourceGroupDrift) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceGroupDrift.Merge(m, src)
+}
+func (m *ResourceGroupDrift) XXX_Size() int {
+	return xxx_messageInfo_ResourceGroupDrift.Size(m)
+}
+func (m *ResourceGroupDrift) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceGroupDrift.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceGroupDrift proto.InternalMessageInfo
+
+func (m *ResourceGroupDrift) GetResourceGroup() string {
+	if m != nil {
+		return m.ResourceGroup
+	}
+	return ""
+}
+
+func (m *ResourceGroupDrift) GetRequestedNodeNum() int32 {
+	if m != nil {
+		return m.RequestedNodeNum
+	}
+	return 0
+}
+
+func (m *ResourceGroupDrift) GetLimitNodeNum() int32 {
+	if m != nil {
+		return m.LimitNodeNum
+	}
+	return 0
+}
+
+func (m *ResourceGroupDrift) GetActualNodeNum() int32 {
+	if m != nil {
+		return m.ActualNodeNum
+	}
+	return 0
+}
+
+func (m *ResourceGroupDrift) GetWithinConfig() bool {
+	if m != nil {
+		return m.WithinConfig
+	}
+	return false
+}
+
+type GetResourceGroupDriftResponse struct {
+	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Drifts               []*ResourceGroupDrift `protobuf:"bytes,2,rep,name=drifts,proto3" json:"drifts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetResourceGroupDriftResponse) Reset()         { *m = GetResourceGroupDriftResponse{} }
+func (m *GetResourceGroupDriftResponse) String() string { return proto.CompactTextString(m) }
+func (*GetResourceGroupDriftResponse) ProtoMessage()    {}
+func (*GetResourceGroupDriftResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{125}
+}
+
+func (m *GetResourceGroupDriftResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetResourceGroupDriftResponse.Unmarshal(m, b)
+}
+func (m *GetResourceGroupDriftResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetResourceGroupDriftResponse.Marshal(b, m, deterministic)
+}
+func (m *GetResourceGroupDriftResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetResourceGroupDriftResponse.Merge(m, src)
+}
+func (m *GetResourceGroupDriftResponse) XXX_Size() int {
+	return xxx_messageInfo_GetResourceGroupDriftResponse.Size(m)
+}
+func (m *GetResourceGroupDriftResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetResourceGroupDriftResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetResourceGroupDriftResponse proto.InternalMessageInfo
+
+func (m *GetResourceGroupDriftResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetResourceGroupDriftResponse) GetDrifts() []*ResourceGroupDrift {
+	if m != nil {
+		return m.Drifts
+	}
+	return nil
+}
+
+type CanStopNodeRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	NodeID               int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CanStopNodeRequest) Reset()         { *m = CanStopNodeRequest{} }
+func (m *CanStopNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*CanStopNodeRequest) ProtoMessage()    {}
+func (*CanStopNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{126}
+}
+
+func (m *CanStopNodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CanStopNodeRequest.Unmarshal(m, b)
+}
+func (m *CanStopNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CanStopNodeRequest.Marshal(b, m, deterministic)
+}
+func (m *CanStopNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanStopNodeRequest.Merge(m, src)
+}
+func (m *CanStopNodeRequest) XXX_Size() int {
+	return xxx_messageInfo_CanStopNodeRequest.Size(m)
+}
+func (m *CanStopNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanStopNodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CanStopNodeRequest proto.InternalMessageInfo
+
+func (m *CanStopNodeRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CanStopNodeRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+// a shard which has no available leader once the node stopped
+type ShardAtRisk struct {
+	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Channel              string   `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShardAtRisk) Reset()         { *m = ShardAtRisk{} }
+func (m *ShardAtRisk) String() string { return proto.CompactTextString(m) }
+func (*ShardAtRisk) ProtoMessage()    {}
+func (*ShardAtRisk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{127}
+}
+
+func (m *ShardAtRisk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardAtRisk.Unmarshal(m, b)
+}
+func (m *ShardAtRisk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardAtRisk.Marshal(b, m, deterministic)
+}
+func (m *ShardAtRisk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardAtRisk.Merge(m, src)
+}
+func (m *ShardAtRisk) XXX_Size() int {
+	return xxx_messageInfo_ShardAtRisk.Size(m)
+}
+func (m *ShardAtRisk) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardAtRisk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardAtRisk proto.InternalMessageInfo
+
+func (m *ShardAtRisk) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ShardAtRisk) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+type CanStopNodeResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CanStop              bool             `protobuf:"varint,2,opt,name=can_stop,json=canStop,proto3" json:"can_stop,omitempty"`
+	ShardsAtRisk         []*ShardAtRisk   `protobuf:"bytes,3,rep,name=shards_at_risk,json=shardsAtRisk,proto3" json:"shards_at_risk,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CanStopNodeResponse) Reset()         { *m = CanStopNodeResponse{} }
+func (m *CanStopNodeResponse) String() string { return proto.CompactTextString(m) }
+func (*CanStopNodeResponse) ProtoMessage()    {}
+func (*CanStopNodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{128}
+}
+
+func (m *CanStopNodeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CanStopNodeResponse.Unmarshal(m, b)
+}
+func (m *CanStopNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CanStopNodeResponse.Marshal(b, m, deterministic)
+}
+func (m *CanStopNodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanStopNodeResponse.Merge(m, src)
+}
+func (m *CanStopNodeResponse) XXX_Size() int {
+	return xxx_messageInfo_CanStopNodeResponse.Size(m)
+}
+func (m *CanStopNodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanStopNodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CanStopNodeResponse proto.InternalMessageInfo
+
+func (m *CanStopNodeResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *CanStopNodeResponse) GetCanStop() bool {
+	if m != nil {
+		return m.CanStop
+	}
+	return false
+}
+
+func (m *CanStopNodeResponse) GetShardsAtRisk() []*ShardAtRisk {
+	if m != nil {
+		return m.ShardsAtRisk
+	}
+	return nil
+}
+
+type MoveCollectionToResourceGroupRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	TargetResourceGroup  string            `protobuf:"bytes,3,opt,name=target_resource_group,json=targetResourceGroup,proto3" json:"target_resource_group,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *MoveCollectionToResourceGroupRequest) Reset()         { *m = MoveCollectionToResourceGroupRequest{} }
+func (m *MoveCollectionToResourceGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*MoveCollectionToResourceGroupRequest) ProtoMessage()    {}
+func (*MoveCollectionToResourceGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{129}
+}
+
+func (m *MoveCollectionToResourceGroupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoveCollectionToResourceGroupRequest.Unmarshal(m, b)
+}
+func (m *MoveCollectionToResourceGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoveCollectionToResourceGroupRequest.Marshal(b, m, deterministic)
+}
+func (m *MoveCollectionToResourceGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveCollectionToResourceGroupRequest.Merge(m, src)
+}
+func (m *MoveCollectionToResourceGroupRequest) XXX_Size() int {
+	return xxx_messageInfo_MoveCollectionToResourceGroupRequest.Size(m)
+}
+func (m *MoveCollectionToResourceGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveCollectionToResourceGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveCollectionToResourceGroupRequest proto.InternalMessageInfo
+
+func (m *MoveCollectionToResourceGroupRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *MoveCollectionToResourceGroupRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *MoveCollectionToResourceGroupRequest) GetTargetResourceGroup() string {
+	if m != nil {
+		return m.TargetResourceGroup
+	}
+	return ""
+}
+
+type MoveCollectionToResourceGroupResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// the replica placement after the move
+	Replicas             []*milvuspb.ReplicaInfo `protobuf:"bytes,2,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *MoveCollectionToResourceGroupResponse) Reset()         { *m = MoveCollectionToResourceGroupResponse{} }
+func (m *MoveCollectionToResourceGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*MoveCollectionToResourceGroupResponse) ProtoMessage()    {}
+func (*MoveCollectionToResourceGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{130}
+}
+
+func (m *MoveCollectionToResourceGroupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoveCollectionToResourceGroupResponse.Unmarshal(m, b)
+}
+func (m *MoveCollectionToResourceGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoveCollectionToResourceGroupResponse.Marshal(b, m, deterministic)
+}
+func (m *MoveCollectionToResourceGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveCollectionToResourceGroupResponse.Merge(m, src)
+}
+func (m *MoveCollectionToResourceGroupResponse) XXX_Size() int {
+	return xxx_messageInfo_MoveCollectionToResourceGroupResponse.Size(m)
+}
+func (m *MoveCollectionToResourceGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveCollectionToResourceGroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveCollectionToResourceGroupResponse proto.InternalMessageInfo
+
+func (m *MoveCollectionToResourceGroupResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *MoveCollectionToResourceGroupResponse) GetReplicas() []*milvuspb.ReplicaInfo {
+	if m != nil {
+		return m.Replicas
+	}
+	return nil
+}
+
+type GetShardLeadersBatchRequest struct {
+	Base          *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionIDs []int64           `protobuf:"varint,2,rep,packed,name=collectionIDs,proto3" json:"collectionIDs,omitempty"`
+	// only return leaders on nodes of given resource group if set
+	ResourceGroup string `protobuf:"bytes,3,opt,name=resource_group,json=resourceGroup,proto3" json:"resource_group,omitempty"`
+	// prefer leaders on nodes in the given zone, fallback to other zones if none available
+	PreferZone               string   `protobuf:"bytes,4,opt,name=prefer_zone,json=preferZone,proto3" json:"prefer_zone,omitempty"`
+	WithReadableReplicaCount bool     `protobuf:"varint,5,opt,name=with_readable_replica_count,json=withReadableReplicaCount,proto3" json:"with_readable_replica_count,omitempty"`
+	WithLeaderErrors         bool     `protobuf:"varint,6,opt,name=with_leader_errors,json=withLeaderErrors,proto3" json:"with_leader_errors,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
+}
+
+func (m *GetShardLeadersBatchRequest) Reset()         { *m = GetShardLeadersBatchRequest{} }
+func (m *GetShardLeadersBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*GetShardLeadersBatchRequest) ProtoMessage()    {}
+func (*GetShardLeadersBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{131}
+}
+
+func (m *GetShardLeadersBatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetShardLeadersBatchRequest.Unmarshal(m, b)
+}
+func (m *GetShardLeadersBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetShardLeadersBatchRequest.Marshal(b, m, deterministic)
+}
+func (m *GetShardLeadersBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShardLeadersBatchRequest.Merge(m, src)
+}
+func (m *GetShardLeadersBatchRequest) XXX_Size() int {
+	return xxx_messageInfo_GetShardLeadersBatchRequest.Size(m)
+}
+func (m *GetShardLeadersBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShardLeadersBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetShardLeadersBatchRequest proto.InternalMessageInfo
+
+func (m *GetShardLeadersBatchRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetShardLeadersBatchRequest) GetCollectionIDs() []int64 {
+	if m != nil {
+		return m.CollectionIDs
+	}
+	return nil
+}
+
+func (m *GetShardLeadersBatchRequest) GetResourceGroup() string {
+	if m != nil {
+		return m.ResourceGroup
+	}
+	return ""
+}
+
+func (m *GetShardLeadersBatchRequest) GetPreferZone() string {
+	if m != nil {
+		return m.PreferZone
+	}
+	return ""
+}
+
+func (m *GetShardLeadersBatchRequest) GetWithReadableReplicaCount() bool {
+	if m != nil {
+		return m.WithReadableReplicaCount
+	}
+	return false
+}
+
+func (m *GetShardLeadersBatchRequest) GetWithLeaderErrors() bool {
+	if m != nil {
+		return m.WithLeaderErrors
+	}
+	return false
+}
+
+// the shard leaders of a collection, the status reports the failure of the collection
+type CollectionShardLeaders struct {
+	CollectionID         int64                    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Leaders              *GetShardLeadersResponse `protobuf:"bytes,2,opt,name=leaders,proto3" json:"leaders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *CollectionShardLeaders) Reset()         { *m = CollectionShardLeaders{} }
+func (m *CollectionShardLeaders) String() string { return proto.CompactTextString(m) }
+func (*CollectionShardLeaders) ProtoMessage()    {}
+func (*CollectionShardLeaders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{132}
+}
+
+func (m *CollectionShardLeaders) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollectionShardLeaders.Unmarshal(m, b)
+}
+func (m *CollectionShardLeaders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollectionShardLeaders.Marshal(b, m, deterministic)
+}
+func (m *CollectionShardLeaders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionShardLeaders.Merge(m, src)
+}
+func (m *CollectionShardLeaders) XXX_Size() int {
+	return xxx_messageInfo_CollectionShardLeaders.Size(m)
+}
+func (m *CollectionShardLeaders) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionShardLeaders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionShardLeaders proto.InternalMessageInfo
+
+func (m *CollectionShardLeaders) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *CollectionShardLeaders) GetLeaders() *GetShardLeadersResponse {
+	if m != nil {
+		return m.Leaders
+	}
+	return nil
+}
+
+type GetShardLeadersBatchResponse struct {
+	Status               *commonpb.Status          `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Collections          []*CollectionShardLeaders `protobuf:"bytes,2,rep,name=collections,proto3" json:"collections,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *GetShardLeadersBatchResponse) Reset()         { *m = GetShardLeadersBatchResponse{} }
+func (m *GetShardLeadersBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*GetShardLeadersBatchResponse) ProtoMessage()    {}
+func (*GetShardLeadersBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{133}
+}
+
+func (m *GetShardLeadersBatchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetShardLeadersBatchResponse.Unmarshal(m, b)
+}
+func (m *GetShardLeadersBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetShardLeadersBatchResponse.Marshal(b, m, deterministic)
+}
+func (m *GetShardLeadersBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShardLeadersBatchResponse.Merge(m, src)
+}
+func (m *GetShardLeadersBatchResponse) XXX_Size() int {
+	return xxx_messageInfo_GetShardLeadersBatchResponse.Size(m)
+}
+func (m *GetShardLeadersBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShardLeadersBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetShardLeadersBatchResponse proto.InternalMessageInfo
+
+func (m *GetShardLeadersBatchResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetShardLeadersBatchResponse) GetCollections() []*CollectionShardLeaders {
+	if m != nil {
+		return m.Collections
+	}
+	return nil
+}
+
+type GetHandoffLagRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetHandoffLagRequest) Reset()         { *m = GetHandoffLagRequest{} }
+func (m *GetHandoffLagRequest) String() string { return proto.CompactTextString(m) }
+func (*GetHandoffLagRequest) ProtoMessage()    {}
+func (*GetHandoffLagRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{134}
+}
+
+func (m *GetHandoffLagRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetHandoffLagRequest.Unmarshal(m, b)
+}
+func (m *GetHandoffLagRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetHandoffLagRequest.Marshal(b, m, deterministic)
+}
+func (m *GetHandoffLagRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHandoffLagRequest.Merge(m, src)
+}
+func (m *GetHandoffLagRequest) XXX_Size() int {
+	return xxx_messageInfo_GetHandoffLagRequest.Size(m)
+}
+func (m *GetHandoffLagRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHandoffLagRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetHandoffLagRequest proto.InternalMessageInfo
+
+func (m *GetHandoffLagRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetHandoffLagRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+// the segments of the next target which are not served by the shard leader yet
+type ShardHandoffLag struct {
+	Channel           string  `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	LeaderID          int64   `protobuf:"varint,2,opt,name=leaderID,proto3" json:"leaderID,omitempty"`
+	ReplicaID         int64   `protobuf:"varint,3,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
+	PendingSegmentIDs []int64 `protobuf:"varint,4,rep,packed,name=pending_segmentIDs,json=pendingSegmentIDs,proto3" json:"pending_segmentIDs,omitempty"`
+	// the duration in milliseconds since the next target was pulled, 0 if no segment is pending
+	StalenessMs          int64    `protobuf:"varint,5,opt,name=staleness_ms,json=stalenessMs,proto3" json:"staleness_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShardHandoffLag) Reset()         { *m = ShardHandoffLag{} }
+func (m *ShardHandoffLag) String() string { return proto.CompactTextString(m) }
+func (*ShardHandoffLag) ProtoMessage()    {}
+func (*ShardHandoffLag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{135}
+}
+
+func (m *ShardHandoffLag) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardHandoffLag.Unmarshal(m, b)
+}
+func (m *ShardHandoffLag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardHandoffLag.Marshal(b, m, deterministic)
+}
+func (m *ShardHandoffLag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardHandoffLag.Merge(m, src)
+}
+func (m *ShardHandoffLag) XXX_Size() int {
+	return xxx_messageInfo_ShardHandoffLag.Size(m)
+}
+func (m *ShardHandoffLag) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardHandoffLag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardHandoffLag proto.InternalMessageInfo
+
+func (m *ShardHandoffLag) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *ShardHandoffLag) GetLeaderID() int64 {
+	if m != nil {
+		return m.LeaderID
+	}
+	return 0
+}
+
+func (m *ShardHandoffLag) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
+func (m *ShardHandoffLag) GetPendingSegmentIDs() []int64 {
+	if m != nil {
+		return m.PendingSegmentIDs
+	}
+	return nil
+}
+
+func (m *ShardHandoffLag) GetStalenessMs() int64 {
+	if m != nil {
+		return m.StalenessMs
+	}
+	return 0
+}
+
+type GetHandoffLagResponse struct {
+	Status               *commonpb.Status   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Shards               []*ShardHandoffLag `protobuf:"bytes,2,rep,name=shards,proto3" json:"shards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetHandoffLagResponse) Reset()         { *m = GetHandoffLagResponse{} }
+func (m *GetHandoffLagResponse) String() string { return proto.CompactTextString(m) }
+func (*GetHandoffLagResponse) ProtoMessage()    {}
+func (*GetHandoffLagResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{136}
+}
+
+func (m *GetHandoffLagResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetHandoffLagResponse.Unmarshal(m, b)
+}
+func (m *GetHandoffLagResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetHandoffLagResponse.Marshal(b, m, deterministic)
+}
+func (m *GetHandoffLagResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHandoffLagResponse.Merge(m, src)
+}
+func (m *GetHandoffLagResponse) XXX_Size() int {
+	return xxx_messageInfo_GetHandoffLagResponse.Size(m)
+}
+func (m *GetHandoffLagResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHandoffLagResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetHandoffLagResponse proto.InternalMessageInfo
+
+func (m *GetHandoffLagResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetHandoffLagResponse) GetShards() []*ShardHandoffLag {
+	if m != nil {
+		return m.Shards
+	}
+	return nil
+}
+
+type CreateResourceGroupWithAutoFillRequest struct {
+	Base          *commonpb.MsgBase         `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ResourceGroup string                    `protobuf:"bytes,2,opt,name=resource_group,json=resourceGroup,proto3" json:"resource_group,omitempty"`
+	Config        *rgpb.ResourceGroupConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	// transfer the spare nodes of the default resource group to the created one, up to its requested node number
+	AutoFill bool `protobuf:"varint,4,opt,name=auto_fill,json=autoFill,proto3" json:"auto_fill,omitempty"`
+	// only the nodes whose labels match all of the selector can be assigned automatically
+	NodeSelector         map[string]string `protobuf:"bytes,5,rep,name=node_selector,json=nodeSelector,proto3" json:"node_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CreateResourceGroupWithAutoFillRequest) Reset() {
+	*m = CreateResourceGroupWithAutoFillRequest{}
+}
+func (m *CreateResourceGroupWithAutoFillRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateResourceGroupWithAutoFillRequest) ProtoMessage()    {}
+func (*CreateResourceGroupWithAutoFillRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{137}
+}
+
+func (m *CreateResourceGroupWithAutoFillRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateResourceGroupWithAutoFillRequest.Unmarshal(m, b)
+}
+func (m *CreateResourceGroupWithAutoFillRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateResourceGroupWithAutoFillRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateResourceGroupWithAutoFillRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateResourceGroupWithAutoFillRequest.Merge(m, src)
+}
+func (m *CreateResourceGroupWithAutoFillRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateResourceGroupWithAutoFillRequest.Size(m)
+}
+func (m *CreateResourceGroupWithAutoFillRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateResourceGroupWithAutoFillRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateResourceGroupWithAutoFillRequest proto.InternalMessageInfo
+
+func (m *CreateResourceGroupWithAutoFillRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CreateResourceGroupWithAutoFillRequest) GetResourceGroup() string {
+	if m != nil {
+		return m.ResourceGroup
+	}
+	return ""
+}
+
+func (m *CreateResourceGroupWithAutoFillRequest) GetConfig() *rgpb.ResourceGroupConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *CreateResourceGroupWithAutoFillRequest) GetAutoFill() bool {
+	if m != nil {
+		return m.AutoFill
+	}
+	return false
+}
+
+func (m *CreateResourceGroupWithAutoFillRequest) GetNodeSelector() map[string]string {
+	if m != nil {
+		return m.NodeSelector
+	}
+	return nil
+}
+
+type CreateResourceGroupWithAutoFillResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// the number of nodes transferred from the default resource group
+	PlacedNodeNum        int32    `protobuf:"varint,2,opt,name=placed_node_num,json=placedNodeNum,proto3" json:"placed_node_num,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateResourceGroupWithAutoFillResponse) Reset() {
+	*m = CreateResourceGroupWithAutoFillResponse{}
+}
+func (m *CreateResourceGroupWithAutoFillResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateResourceGroupWithAutoFillResponse) ProtoMessage()    {}
+func (*CreateResourceGroupWithAutoFillResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{138}
+}
+
+func (m *CreateResourceGroupWithAutoFillResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateResourceGroupWithAutoFillResponse.Unmarshal(m, b)
+}
+func (m *CreateResourceGroupWithAutoFillResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateResourceGroupWithAutoFillResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateResourceGroupWithAutoFillResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateResourceGroupWithAutoFillResponse.Merge(m, src)
+}
+func (m *CreateResourceGroupWithAutoFillResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateResourceGroupWithAutoFillResponse.Size(m)
+}
+func (m *CreateResourceGroupWithAutoFillResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateResourceGroupWithAutoFillResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateResourceGroupWithAutoFillResponse proto.InternalMessageInfo
+
+func (m *CreateResourceGroupWithAutoFillResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *CreateResourceGroupWithAutoFillResponse) GetPlacedNodeNum() int32 {
+	if m != nil {
+		return m.PlacedNodeNum
+	}
+	return 0
+}
+
+// a segment move planned by load balance
+type SegmentBalancePlan struct {
+	SegmentID  int64 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	SourceNode int64 `protobuf:"varint,2,opt,name=source_node,json=sourceNode,proto3" json:"source_node,omitempty"`
+	TargetNode int64 `protobuf:"varint,3,opt,name=target_node,json=targetNode,proto3" json:"target_node,omitempty"`
+	// the estimated bytes to move
+	Size                 int64    `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentBalancePlan) Reset()         { *m = SegmentBalancePlan{} }
+func (m *SegmentBalancePlan) String() string { return proto.CompactTextString(m) }
+func (*SegmentBalancePlan) ProtoMessage()    {}
+func (*SegmentBalancePlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{139}
+}
+
+func (m *SegmentBalancePlan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentBalancePlan.Unmarshal(m, b)
+}
+func (m *SegmentBalancePlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentBalancePlan.Marshal(b, m, deterministic)
+}
+func (m *SegmentBalancePlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentBalancePlan.Merge(m, src)
+}
+func (m *SegmentBalancePlan) XXX_Size() int {
+	return xxx_messageInfo_SegmentBalancePlan.Size(m)
+}
+func (m *SegmentBalancePlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentBalancePlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentBalancePlan proto.InternalMessageInfo
+
+func (m *SegmentBalancePlan) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *SegmentBalancePlan) GetSourceNode() int64 {
+	if m != nil {
+		return m.SourceNode
+	}
+	return 0
+}
+
+func (m *SegmentBalancePlan) GetTargetNode() int64 {
+	if m != nil {
+		return m.TargetNode
+	}
+	return 0
+}
+
+func (m *SegmentBalancePlan) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type DryRunLoadBalanceResponse struct {
+	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Plans                []*SegmentBalancePlan `protobuf:"bytes,2,rep,name=plans,proto3" json:"plans,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DryRunLoadBalanceResponse) Reset()         { *m = DryRunLoadBalanceResponse{} }
+func (m *DryRunLoadBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*DryRunLoadBalanceResponse) ProtoMessage()    {}
+func (*DryRunLoadBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{140}
+}
+
+func (m *DryRunLoadBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DryRunLoadBalanceResponse.Unmarshal(m, b)
+}
+func (m *DryRunLoadBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DryRunLoadBalanceResponse.Marshal(b, m, deterministic)
+}
+func (m *DryRunLoadBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DryRunLoadBalanceResponse.Merge(m, src)
+}
+func (m *DryRunLoadBalanceResponse) XXX_Size() int {
+	return xxx_messageInfo_DryRunLoadBalanceResponse.Size(m)
+}
+func (m *DryRunLoadBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DryRunLoadBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DryRunLoadBalanceResponse proto.InternalMessageInfo
+
+func (m *DryRunLoadBalanceResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *DryRunLoadBalanceResponse) GetPlans() []*SegmentBalancePlan {
+	if m != nil {
+		return m.Plans
+	}
+	return nil
+}
+
+type BalanceState struct {
+	Suspended bool `protobuf:"varint,1,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	// unix time in milliseconds
+	SuspendedTime        int64    `protobuf:"varint,2,opt,name=suspended_time,json=suspendedTime,proto3" json:"suspended_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BalanceState) Reset()         { *m = BalanceState{} }
+func (m *BalanceState) String() string { return proto.CompactTextString(m) }
+func (*BalanceState) ProtoMessage()    {}
+func (*BalanceState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{141}
+}
+
+func (m *BalanceState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BalanceState.Unmarshal(m, b)
+}
+func (m *BalanceState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BalanceState.Marshal(b, m, deterministic)
+}
+func (m *BalanceState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceState.Merge(m, src)
+}
+func (m *BalanceState) XXX_Size() int {
+	return xxx_messageInfo_BalanceState.Size(m)
+}
+func (m *BalanceState) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceState proto.InternalMessageInfo
+
+func (m *BalanceState) GetSuspended() bool {
+	if m != nil {
+		return m.Suspended
+	}
+	return false
+}
+
+func (m *BalanceState) GetSuspendedTime() int64 {
+	if m != nil {
+		return m.SuspendedTime
+	}
+	return 0
+}
+
+type TransferNodeByFractionRequest struct {
+	Base                *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SourceResourceGroup string            `protobuf:"bytes,2,opt,name=source_resource_group,json=sourceResourceGroup,proto3" json:"source_resource_group,omitempty"`
+	TargetResourceGroup string            `protobuf:"bytes,3,opt,name=target_resource_group,json=targetResourceGroup,proto3" json:"target_resource_group,omitempty"`
+	// the fraction of the source resource group's nodes to transfer, in (0, 1]
+	Fraction             float64  `protobuf:"fixed64,4,opt,name=fraction,proto3" json:"fraction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransferNodeByFractionRequest) Reset()         { *m = TransferNodeByFractionRequest{} }
+func (m *TransferNodeByFractionRequest) String() string { return proto.CompactTextString(m) }
+func (*TransferNodeByFractionRequest) ProtoMessage()    {}
+func (*TransferNodeByFractionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{142}
+}
+
+func (m *TransferNodeByFractionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferNodeByFractionRequest.Unmarshal(m, b)
+}
+func (m *TransferNodeByFractionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferNodeByFractionRequest.Marshal(b, m, deterministic)
+}
+func (m *TransferNodeByFractionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferNodeByFractionRequest.Merge(m, src)
+}
+func (m *TransferNodeByFractionRequest) XXX_Size() int {
+	return xxx_messageInfo_TransferNodeByFractionRequest.Size(m)
+}
+func (m *TransferNodeByFractionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferNodeByFractionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferNodeByFractionRequest proto.InternalMessageInfo
+
+func (m *TransferNodeByFractionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *TransferNodeByFractionRequest) GetSourceResourceGroup() string {
+	if m != nil {
+		return m.SourceResourceGroup
+	}
+	return ""
+}
+
+func (m *TransferNodeByFractionRequest) GetTargetResourceGroup() string {
+	if m != nil {
+		return m.TargetResourceGroup
+	}
+	return ""
+}
+
+func (m *TransferNodeByFractionRequest) GetFraction() float64 {
+	if m != nil {
+		return m.Fraction
+	}
+	return 0
+}
+
+type CheckNodeHealthRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CheckNodeHealthRequest) Reset()         { *m = CheckNodeHealthRequest{} }
+func (m *CheckNodeHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckNodeHealthRequest) ProtoMessage()    {}
+func (*CheckNodeHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{143}
+}
+
+func (m *CheckNodeHealthRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckNodeHealthRequest.Unmarshal(m, b)
+}
+func (m *CheckNodeHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckNodeHealthRequest.Marshal(b, m, deterministic)
+}
+func (m *CheckNodeHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckNodeHealthRequest.Merge(m, src)
+}
+func (m *CheckNodeHealthRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckNodeHealthRequest.Size(m)
+}
+func (m *CheckNodeHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckNodeHealthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckNodeHealthRequest proto.InternalMessageInfo
+
+func (m *CheckNodeHealthRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type NodeHealth struct {
+	NodeID  int64  `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Healthy bool   `protobuf:"varint,3,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// the reason why the node is unhealthy, empty if healthy
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeHealth) Reset()         { *m = NodeHealth{} }
+func (m *NodeHealth) String() string { return proto.CompactTextString(m) }
+func (*NodeHealth) ProtoMessage()    {}
+func (*NodeHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{144}
+}
+
+func (m *NodeHealth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeHealth.Unmarshal(m, b)
+}
+func (m *NodeHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeHealth.Marshal(b, m, deterministic)
+}
+func (m *NodeHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeHealth.Merge(m, src)
+}
+func (m *NodeHealth) XXX_Size() int {
+	return xxx_messageInfo_NodeHealth.Size(m)
+}
+func (m *NodeHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeHealth proto.InternalMessageInfo
+
+func (m *NodeHealth) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *NodeHealth) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *NodeHealth) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
+	}
+	return false
+}
+
+func (m *NodeHealth) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type CheckNodeHealthResponse struct {
+	Status    *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	IsHealthy bool             `protobuf:"varint,2,opt,name=is_healthy,json=isHealthy,proto3" json:"is_healthy,omitempty"`
+	// same as the reasons of CheckHealth
+	Reasons              []string      `protobuf:"bytes,3,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	Nodes                []*NodeHealth `protobuf:"bytes,4,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *CheckNodeHealthResponse) Reset()         { *m = CheckNodeHealthResponse{} }
+func (m *CheckNodeHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckNodeHealthResponse) ProtoMessage()    {}
+func (*CheckNodeHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{145}
+}
+
+func (m *CheckNodeHealthResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckNodeHealthResponse.Unmarshal(m, b)
+}
+func (m *CheckNodeHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckNodeHealthResponse.Marshal(b, m, deterministic)
+}
+func (m *CheckNodeHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckNodeHealthResponse.Merge(m, src)
+}
+func (m *CheckNodeHealthResponse) XXX_Size() int {
+	return xxx_messageInfo_CheckNodeHealthResponse.Size(m)
+}
+func (m *CheckNodeHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckNodeHealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckNodeHealthResponse proto.InternalMessageInfo
+
+func (m *CheckNodeHealthResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *CheckNodeHealthResponse) GetIsHealthy() bool {
+	if m != nil {
+		return m.IsHealthy
+	}
+	return false
+}
+
+func (m *CheckNodeHealthResponse) GetReasons() []string {
+	if m != nil {
+		return m.Reasons
+	}
+	return nil
+}
+
+func (m *CheckNodeHealthResponse) GetNodes() []*NodeHealth {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+type DescribeCheckerRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CheckerID            int32             `protobuf:"varint,2,opt,name=checkerID,proto3" json:"checkerID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DescribeCheckerRequest) Reset()         { *m = DescribeCheckerRequest{} }
+func (m *DescribeCheckerRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeCheckerRequest) ProtoMessage()    {}
+func (*DescribeCheckerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{146}
+}
+
+func (m *DescribeCheckerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeCheckerRequest.Unmarshal(m, b)
+}
+func (m *DescribeCheckerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeCheckerRequest.Marshal(b, m, deterministic)
+}
+func (m *DescribeCheckerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeCheckerRequest.Merge(m, src)
+}
+func (m *DescribeCheckerRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeCheckerRequest.Size(m)
+}
+func (m *DescribeCheckerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeCheckerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeCheckerRequest proto.InternalMessageInfo
+
+func (m *DescribeCheckerRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DescribeCheckerRequest) GetCheckerID() int32 {
+	if m != nil {
+		return m.CheckerID
+	}
+	return 0
+}
+
+type DescribeCheckerResponse struct {
+	Status  *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Checker *CheckerInfo     `protobuf:"bytes,2,opt,name=checker,proto3" json:"checker,omitempty"`
+	// the tasks generated by the checker which are still pending in scheduler
+	PendingTasks         []*CheckerTaskInfo `protobuf:"bytes,3,rep,name=pending_tasks,json=pendingTasks,proto3" json:"pending_tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *DescribeCheckerResponse) Reset()         { *m = DescribeCheckerResponse{} }
+func (m *DescribeCheckerResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeCheckerResponse) ProtoMessage()    {}
+func (*DescribeCheckerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{147}
+}
+
+func (m *DescribeCheckerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeCheckerResponse.Unmarshal(m, b)
+}
+func (m *DescribeCheckerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeCheckerResponse.Marshal(b, m, deterministic)
+}
+func (m *DescribeCheckerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeCheckerResponse.Merge(m, src)
+}
+func (m *DescribeCheckerResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeCheckerResponse.Size(m)
+}
+func (m *DescribeCheckerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeCheckerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeCheckerResponse proto.InternalMessageInfo
+
+func (m *DescribeCheckerResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *DescribeCheckerResponse) GetChecker() *CheckerInfo {
+	if m != nil {
+		return m.Checker
+	}
+	return nil
+}
+
+func (m *DescribeCheckerResponse) GetPendingTasks() []*CheckerTaskInfo {
+	if m != nil {
+		return m.PendingTasks
+	}
+	return nil
+}
+
+type ListReplicasRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID   int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	WithShardNodes bool              `protobuf:"varint,3,opt,name=with_shard_nodes,json=withShardNodes,proto3" json:"with_shard_nodes,omitempty"`
+	// only return the replicas in the given resource group if set
+	ResourceGroup string         `protobuf:"bytes,4,opt,name=resource_group,json=resourceGroup,proto3" json:"resource_group,omitempty"`
+	SortKey       ReplicaSortKey `protobuf:"varint,5,opt,name=sort_key,json=sortKey,proto3,enum=milvus.proto.query.ReplicaSortKey" json:"sort_key,omitempty"`
+	// sort in descending order, ascending by default
+	Descending           bool     `protobuf:"varint,6,opt,name=descending,proto3" json:"descending,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListReplicasRequest) Reset()         { *m = ListReplicasRequest{} }
+func (m *ListReplicasRequest) String() string { return proto.CompactTextString(m) }
+func (*ListReplicasRequest) ProtoMessage()    {}
+func (*ListReplicasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{148}
+}
+
+func (m *ListReplicasRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReplicasRequest.Unmarshal(m, b)
+}
+func (m *ListReplicasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReplicasRequest.Marshal(b, m, deterministic)
+}
+func (m *ListReplicasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReplicasRequest.Merge(m, src)
+}
+func (m *ListReplicasRequest) XXX_Size() int {
+	return xxx_messageInfo_ListReplicasRequest.Size(m)
+}
+func (m *ListReplicasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReplicasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListReplicasRequest proto.InternalMessageInfo
+
+func (m *ListReplicasRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ListReplicasRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ListReplicasRequest) GetWithShardNodes() bool {
+	if m != nil {
+		return m.WithShardNodes
+	}
+	return false
+}
+
+func (m *ListReplicasRequest) GetResourceGroup() string {
+	if m != nil {
+		return m.ResourceGroup
+	}
+	return ""
+}
+
+func (m *ListReplicasRequest) GetSortKey() ReplicaSortKey {
+	if m != nil {
+		return m.SortKey
+	}
+	return ReplicaSortKey_DefaultReplicaOrder
+}
+
+func (m *ListReplicasRequest) GetDescending() bool {
+	if m != nil {
+		return m.Descending
+	}
+	return false
+}
+
+type ListReplicasResponse struct {
+	Status   *commonpb.Status        `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Replicas []*milvuspb.ReplicaInfo `protobuf:"bytes,2,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	// the nodes of the replicas with hostnames, only set if with_shard_nodes is true,
+	// the nodes gone from the cluster are omitted
+	Nodes                []*commonpb.NodeInfo `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListReplicasResponse) Reset()         { *m = ListReplicasResponse{} }
+func (m *ListReplicasResponse) String() string { return proto.CompactTextString(m) }
+func (*ListReplicasResponse) ProtoMessage()    {}
+func (*ListReplicasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{149}
+}
+
+func (m *ListReplicasResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReplicasResponse.Unmarshal(m, b)
+}
+func (m *ListReplicasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReplicasResponse.Marshal(b, m, deterministic)
+}
+func (m *ListReplicasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReplicasResponse.Merge(m, src)
+}
+func (m *ListReplicasResponse) XXX_Size() int {
+	return xxx_messageInfo_ListReplicasResponse.Size(m)
+}
+func (m *ListReplicasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReplicasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListReplicasResponse proto.InternalMessageInfo
+
+func (m *ListReplicasResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListReplicasResponse) GetReplicas() []*milvuspb.ReplicaInfo {
+	if m != nil {
+		return m.Replicas
+	}
+	return nil
+}
+
+func (m *ListReplicasResponse) GetNodes() []*commonpb.NodeInfo {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+type CancelLoadRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CancelLoadRequest) Reset()         { *m = CancelLoadRequest{} }
+func (m *CancelLoadRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelLoadRequest) ProtoMessage()    {}
+func (*CancelLoadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{150}
+}
+
+func (m *CancelLoadRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelLoadRequest.Unmarshal(m, b)
+}
+func (m *CancelLoadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelLoadRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelLoadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelLoadRequest.Merge(m, src)
+}
+func (m *CancelLoadRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelLoadRequest.Size(m)
+}
+func (m *CancelLoadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelLoadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelLoadRequest proto.InternalMessageInfo
+
+func (m *CancelLoadRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CancelLoadRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type GetLoadStateRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetLoadStateRequest) Reset()         { *m = GetLoadStateRequest{} }
+func (m *GetLoadStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLoadStateRequest) ProtoMessage()    {}
+func (*GetLoadStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{151}
+}
+
+func (m *GetLoadStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLoadStateRequest.Unmarshal(m, b)
+}
+func (m *GetLoadStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLoadStateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetLoadStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLoadStateRequest.Merge(m, src)
+}
+func (m *GetLoadStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLoadStateRequest.Size(m)
+}
+func (m *GetLoadStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLoadStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLoadStateRequest proto.InternalMessageInfo
+
+func (m *GetLoadStateRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetLoadStateRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type GetLoadStateResponse struct {
+	Status *commonpb.Status   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	State  commonpb.LoadState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.LoadState" json:"state,omitempty"`
+	// the cached error of the failed load, the state is Loading then
+	LoadError            *commonpb.Status `protobuf:"bytes,3,opt,name=load_error,json=loadError,proto3" json:"load_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetLoadStateResponse) Reset()         { *m = GetLoadStateResponse{} }
+func (m *GetLoadStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLoadStateResponse) ProtoMessage()    {}
+func (*GetLoadStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{152}
+}
+
+func (m *GetLoadStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLoadStateResponse.Unmarshal(m, b)
+}
+func (m *GetLoadStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLoadStateResponse.Marshal(b, m, deterministic)
+}
+func (m *GetLoadStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLoadStateResponse.Merge(m, src)
+}
+func (m *GetLoadStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetLoadStateResponse.Size(m)
+}
+func (m *GetLoadStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLoadStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLoadStateResponse proto.InternalMessageInfo
+
+func (m *GetLoadStateResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetLoadStateResponse) GetState() commonpb.LoadState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.LoadState_LoadStateNotExist
+}
+
+func (m *GetLoadStateResponse) GetLoadError() *commonpb.Status {
+	if m != nil {
+		return m.LoadError
+	}
+	return nil
+}
+
+type PartitionLoadFailure struct {
+	PartitionID          int64            `protobuf:"varint,1,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	Reason               *commonpb.Status `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *PartitionLoadFailure) Reset()         { *m = PartitionLoadFailure{} }
+func (m *PartitionLoadFailure) String() string { return proto.CompactTextString(m) }
+func (*PartitionLoadFailure) ProtoMessage()    {}
+func (*PartitionLoadFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{153}
+}
+
+func (m *PartitionLoadFailure) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PartitionLoadFailure.Unmarshal(m, b)
+}
+func (m *PartitionLoadFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PartitionLoadFailure.Marshal(b, m, deterministic)
+}
+func (m *PartitionLoadFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionLoadFailure.Merge(m, src)
+}
+func (m *PartitionLoadFailure) XXX_Size() int {
+	return xxx_messageInfo_PartitionLoadFailure.Size(m)
+}
+func (m *PartitionLoadFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionLoadFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionLoadFailure proto.InternalMessageInfo
+
+func (m *PartitionLoadFailure) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *PartitionLoadFailure) GetReason() *commonpb.Status {
+	if m != nil {
+		return m.Reason
+	}
+	return nil
+}
+
+type SegmentLoadingProgress struct {
+	SegmentID            int64    `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	Collection           int64    `protobuf:"varint,2,opt,name=collection,proto3" json:"collection,omitempty"`
+	Partition            int64    `protobuf:"varint,3,opt,name=partition,proto3" json:"partition,omitempty"`
+	Channel              string   `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	LoadedBytes          int64    `protobuf:"varint,5,opt,name=loaded_bytes,json=loadedBytes,proto3" json:"loaded_bytes,omitempty"`
+	TotalBytes           int64    `protobuf:"varint,6,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentLoadingProgress) Reset()         { *m = SegmentLoadingProgress{} }
+func (m *SegmentLoadingProgress) String() string { return proto.CompactTextString(m) }
+func (*SegmentLoadingProgress) ProtoMessage()    {}
+func (*SegmentLoadingProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{154}
+}
+
+func (m *SegmentLoadingProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentLoadingProgress.Unmarshal(m, b)
+}
+func (m *SegmentLoadingProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentLoadingProgress.Marshal(b, m, deterministic)
+}
+func (m *SegmentLoadingProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentLoadingProgress.Merge(m, src)
+}
+func (m *SegmentLoadingProgress) XXX_Size() int {
+	return xxx_messageInfo_SegmentLoadingProgress.Size(m)
+}
+func (m *SegmentLoadingProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentLoadingProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentLoadingProgress proto.InternalMessageInfo
+
+func (m *SegmentLoadingProgress) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *SegmentLoadingProgress) GetCollection() int64 {
+	if m != nil {
+		return m.Collection
+	}
+	return 0
+}
+
+func (m *SegmentLoadingProgress) GetPartition() int64 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+func (m *SegmentLoadingProgress) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *SegmentLoadingProgress) GetLoadedBytes() int64 {
+	if m != nil {
+		return m.LoadedBytes
+	}
+	return 0
+}
+
+func (m *SegmentLoadingProgress) GetTotalBytes() int64 {
+	if m != nil {
+		return m.TotalBytes
+	}
+	return 0
+}
+
+type RebalanceCollectionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RebalanceCollectionRequest) Reset()         { *m = RebalanceCollectionRequest{} }
+func (m *RebalanceCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*RebalanceCollectionRequest) ProtoMessage()    {}
+func (*RebalanceCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{155}
+}
+
+func (m *RebalanceCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebalanceCollectionRequest.Unmarshal(m, b)
+}
+func (m *RebalanceCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebalanceCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *RebalanceCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebalanceCollectionRequest.Merge(m, src)
+}
+func (m *RebalanceCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_RebalanceCollectionRequest.Size(m)
+}
+func (m *RebalanceCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebalanceCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebalanceCollectionRequest proto.InternalMessageInfo
+
+func (m *RebalanceCollectionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RebalanceCollectionRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type ChannelBalancePlan struct {
+	Channel              string   `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	SourceNode           int64    `protobuf:"varint,2,opt,name=source_node,json=sourceNode,proto3" json:"source_node,omitempty"`
+	TargetNode           int64    `protobuf:"varint,3,opt,name=target_node,json=targetNode,proto3" json:"target_node,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelBalancePlan) Reset()         { *m = ChannelBalancePlan{} }
+func (m *ChannelBalancePlan) String() string { return proto.CompactTextString(m) }
+func (*ChannelBalancePlan) ProtoMessage()    {}
+func (*ChannelBalancePlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{156}
+}
+
+func (m *ChannelBalancePlan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelBalancePlan.Unmarshal(m, b)
+}
+func (m *ChannelBalancePlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelBalancePlan.Marshal(b, m, deterministic)
+}
+func (m *ChannelBalancePlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelBalancePlan.Merge(m, src)
+}
+func (m *ChannelBalancePlan) XXX_Size() int {
+	return xxx_messageInfo_ChannelBalancePlan.Size(m)
+}
+func (m *ChannelBalancePlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelBalancePlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelBalancePlan proto.InternalMessageInfo
+
+func (m *ChannelBalancePlan) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *ChannelBalancePlan) GetSourceNode() int64 {
+	if m != nil {
+		return m.SourceNode
+	}
+	return 0
+}
+
+func (m *ChannelBalancePlan) GetTargetNode() int64 {
+	if m != nil {
+		return m.TargetNode
+	}
+	return 0
+}
+
+type RebalanceCollectionResponse struct {
+	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	SegmentPlans         []*SegmentBalancePlan `protobuf:"bytes,2,rep,name=segment_plans,json=segmentPlans,proto3" json:"segment_plans,omitempty"`
+	ChannelPlans         []*ChannelBalancePlan `protobuf:"bytes,3,rep,name=channel_plans,json=channelPlans,proto3" json:"channel_plans,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *RebalanceCollectionResponse) Reset()         { *m = RebalanceCollectionResponse{} }
+func (m *RebalanceCollectionResponse) String() string { return proto.CompactTextString(m) }
+func (*RebalanceCollectionResponse) ProtoMessage()    {}
+func (*RebalanceCollectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{157}
+}
+
+func (m *RebalanceCollectionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebalanceCollectionResponse.Unmarshal(m, b)
+}
+func (m *RebalanceCollectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebalanceCollectionResponse.Marshal(b, m, deterministic)
+}
+func (m *RebalanceCollectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebalanceCollectionResponse.Merge(m, src)
+}
+func (m *RebalanceCollectionResponse) XXX_Size() int {
+	return xxx_messageInfo_RebalanceCollectionResponse.Size(m)
+}
+func (m *RebalanceCollectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebalanceCollectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebalanceCollectionResponse proto.InternalMessageInfo
+
+func (m *RebalanceCollectionResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *RebalanceCollectionResponse) GetSegmentPlans() []*SegmentBalancePlan {
+	if m != nil {
+		return m.SegmentPlans
+	}
+	return nil
+}
+
+func (m *RebalanceCollectionResponse) GetChannelPlans() []*ChannelBalancePlan {
+	if m != nil {
+		return m.ChannelPlans
+	}
+	return nil
+}
+
+type GetResourceGroupUtilizationRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// all resource groups if empty
+	ResourceGroups       []string `protobuf:"bytes,2,rep,name=resource_groups,json=resourceGroups,proto3" json:"resource_groups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetResourceGroupUtilizationRequest) Reset()         { *m = GetResourceGroupUtilizationRequest{} }
+func (m *GetResourceGroupUtilizationRequest) String() string { return proto.CompactTextString(m) }
+func (*GetResourceGroupUtilizationRequest) ProtoMessage()    {}
+func (*GetResourceGroupUtilizationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{158}
+}
+
+func (m *GetResourceGroupUtilizationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetResourceGroupUtilizationRequest.Unmarshal(m, b)
+}
+func (m *GetResourceGroupUtilizationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetResourceGroupUtilizationRequest.Marshal(b, m, deterministic)
+}
+func (m *GetResourceGroupUtilizationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetResourceGroupUtilizationRequest.Merge(m, src)
+}
+func (m *GetResourceGroupUtilizationRequest) XXX_Size() int {
+	return xxx_messageInfo_GetResourceGroupUtilizationRequest.Size(m)
+}
+func (m *GetResourceGroupUtilizationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetResourceGroupUtilizationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetResourceGroupUtilizationRequest proto.InternalMessageInfo
+
+func (m *GetResourceGroupUtilizationRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetResourceGroupUtilizationRequest) GetResourceGroups() []string {
+	if m != nil {
+		return m.ResourceGroups
+	}
+	return nil
+}
+
+type ResourceGroupUtilization struct {
+	ResourceGroup string `protobuf:"bytes,1,opt,name=resource_group,json=resourceGroup,proto3" json:"resource_group,omitempty"`
+	// the number of online nodes in the resource group
+	NumNode int32 `protobuf:"varint,2,opt,name=num_node,json=numNode,proto3" json:"num_node,omitempty"`
+	// the memory of the sealed segments hosted by the nodes, in bytes
+	SegmentMemorySize int64 `protobuf:"varint,3,opt,name=segment_memory_size,json=segmentMemorySize,proto3" json:"segment_memory_size,omitempty"`
+	// the memory capacity of the nodes which report it, in bytes
+	MemoryCapacity int64 `protobuf:"varint,4,opt,name=memory_capacity,json=memoryCapacity,proto3" json:"memory_capacity,omitempty"`
+	// segment_memory_size against memory_capacity
+	UsedRatio            float64  `protobuf:"fixed64,5,opt,name=used_ratio,json=usedRatio,proto3" json:"used_ratio,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResourceGroupUtilization) Reset()         { *m = ResourceGroupUtilization{} }
+func (m *ResourceGroupUtilization) String() string { return proto.CompactTextString(m) }
+func (*ResourceGroupUtilization) ProtoMessage()    {}
+func (*ResourceGroupUtilization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{159}
+}
+
+func (m *ResourceGroupUtilization) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourceGroupUtilization.Unmarshal(m, b)
+}
+func (m *ResourceGroupUtilization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourceGroupUtilization.Marshal(b, m, deterministic)
+}
+func (m *ResourceGroupUtilization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceGroupUtilization.Merge(m, src)
+}
+func (m *ResourceGroupUtilization) XXX_Size() int {
+	return xxx_messageInfo_ResourceGroupUtilization.Size(m)
+}
+func (m *ResourceGroupUtilization) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceGroupUtilization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceGroupUtilization proto.InternalMessageInfo
+
+func (m *ResourceGroupUtilization) GetResourceGroup() string {
+	if m != nil {
+		return m.ResourceGroup
+	}
+	return ""
+}
+
+func (m *ResourceGroupUtilization) GetNumNode() int32 {
+	if m != nil {
+		return m.NumNode
+	}
+	return 0
+}
+
+func (m *ResourceGroupUtilization) GetSegmentMemorySize() int64 {
+	if m != nil {
+		return m.SegmentMemorySize
+	}
+	return 0
+}
+
+func (m *ResourceGroupUtilization) GetMemoryCapacity() int64 {
+	if m != nil {
+		return m.MemoryCapacity
+	}
+	return 0
+}
+
+func (m *ResourceGroupUtilization) GetUsedRatio() float64 {
+	if m != nil {
+		return m.UsedRatio
+	}
+	return 0
+}
+
+type GetResourceGroupUtilizationResponse struct {
+	Status               *commonpb.Status            `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Utilizations         []*ResourceGroupUtilization `protobuf:"bytes,2,rep,name=utilizations,proto3" json:"utilizations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *GetResourceGroupUtilizationResponse) Reset()         { *m = GetResourceGroupUtilizationResponse{} }
+func (m *GetResourceGroupUtilizationResponse) String() string { return proto.CompactTextString(m) }
+func (*GetResourceGroupUtilizationResponse) ProtoMessage()    {}
+func (*GetResourceGroupUtilizationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{160}
+}
+
+func (m *GetResourceGroupUtilizationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetResourceGroupUtilizationResponse.Unmarshal(m, b)
+}
+func (m *GetResourceGroupUtilizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetResourceGroupUtilizationResponse.Marshal(b, m, deterministic)
+}
+func (m *GetResourceGroupUtilizationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetResourceGroupUtilizationResponse.Merge(m, src)
+}
+func (m *GetResourceGroupUtilizationResponse) XXX_Size() int {
+	return xxx_messageInfo_GetResourceGroupUtilizationResponse.Size(m)
+}
+func (m *GetResourceGroupUtilizationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetResourceGroupUtilizationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetResourceGroupUtilizationResponse proto.InternalMessageInfo
+
+func (m *GetResourceGroupUtilizationResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetResourceGroupUtilizationResponse) GetUtilizations() []*ResourceGroupUtilization {
+	if m != nil {
+		return m.Utilizations
+	}
+	return nil
+}
+
+type SyncNewCreatedPartitionsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionIDs         []int64           `protobuf:"varint,3,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SyncNewCreatedPartitionsRequest) Reset()         { *m = SyncNewCreatedPartitionsRequest{} }
+func (m *SyncNewCreatedPartitionsRequest) String() string { return proto.CompactTextString(m) }
+func (*SyncNewCreatedPartitionsRequest) ProtoMessage()    {}
+func (*SyncNewCreatedPartitionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{161}
+}
+
+func (m *SyncNewCreatedPartitionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncNewCreatedPartitionsRequest.Unmarshal(m, b)
+}
+func (m *SyncNewCreatedPartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncNewCreatedPartitionsRequest.Marshal(b, m, deterministic)
+}
+func (m *SyncNewCreatedPartitionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncNewCreatedPartitionsRequest.Merge(m, src)
+}
+func (m *SyncNewCreatedPartitionsRequest) XXX_Size() int {
+	return xxx_messageInfo_SyncNewCreatedPartitionsRequest.Size(m)
+}
+func (m *SyncNewCreatedPartitionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncNewCreatedPartitionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncNewCreatedPartitionsRequest proto.InternalMessageInfo
+
+func (m *SyncNewCreatedPartitionsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SyncNewCreatedPartitionsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *SyncNewCreatedPartitionsRequest) GetPartitionIDs() []int64 {
+	if m != nil {
+		return m.PartitionIDs
+	}
+	return nil
+}
+
+type SyncNewCreatedPartitionsResponse struct {
+	Status               *commonpb.Status        `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	SyncedPartitionIDs   []int64                 `protobuf:"varint,2,rep,packed,name=synced_partitionIDs,json=syncedPartitionIDs,proto3" json:"synced_partitionIDs,omitempty"`
+	Failures             []*PartitionLoadFailure `protobuf:"bytes,3,rep,name=failures,proto3" json:"failures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *SyncNewCreatedPartitionsResponse) Reset()         { *m = SyncNewCreatedPartitionsResponse{} }
+func (m *SyncNewCreatedPartitionsResponse) String() string { return proto.CompactTextString(m) }
+func (*SyncNewCreatedPartitionsResponse) ProtoMessage()    {}
+func (*SyncNewCreatedPartitionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{162}
+}
+
+func (m *SyncNewCreatedPartitionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncNewCreatedPartitionsResponse.Unmarshal(m, b)
+}
+func (m *SyncNewCreatedPartitionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncNewCreatedPartitionsResponse.Marshal(b, m, deterministic)
+}
+func (m *SyncNewCreatedPartitionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncNewCreatedPartitionsResponse.Merge(m, src)
+}
+func (m *SyncNewCreatedPartitionsResponse) XXX_Size() int {
+	return xxx_messageInfo_SyncNewCreatedPartitionsResponse.Size(m)
+}
+func (m *SyncNewCreatedPartitionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncNewCreatedPartitionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncNewCreatedPartitionsResponse proto.InternalMessageInfo
+
+func (m *SyncNewCreatedPartitionsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *SyncNewCreatedPartitionsResponse) GetSyncedPartitionIDs() []int64 {
+	if m != nil {
+		return m.SyncedPartitionIDs
+	}
+	return nil
+}
+
+func (m *SyncNewCreatedPartitionsResponse) GetFailures() []*PartitionLoadFailure {
+	if m != nil {
+		return m.Failures
+	}
+	return nil
+}
+
+type WatchCollectionsRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// all loaded collections if empty
+	CollectionIDs        []int64  `protobuf:"varint,2,rep,packed,name=collectionIDs,proto3" json:"collectionIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchCollectionsRequest) Reset()         { *m = WatchCollectionsRequest{} }
+func (m *WatchCollectionsRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchCollectionsRequest) ProtoMessage()    {}
+func (*WatchCollectionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{163}
+}
+
+func (m *WatchCollectionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchCollectionsRequest.Unmarshal(m, b)
+}
+func (m *WatchCollectionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchCollectionsRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchCollectionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchCollectionsRequest.Merge(m, src)
+}
+func (m *WatchCollectionsRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchCollectionsRequest.Size(m)
+}
+func (m *WatchCollectionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchCollectionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchCollectionsRequest proto.InternalMessageInfo
+
+func (m *WatchCollectionsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *WatchCollectionsRequest) GetCollectionIDs() []int64 {
+	if m != nil {
+		return m.CollectionIDs
+	}
+	return nil
+}
+
+type CollectionLoadState struct {
+	CollectionID          int64 `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	LoadPercentage        int64 `protobuf:"varint,2,opt,name=load_percentage,json=loadPercentage,proto3" json:"load_percentage,omitempty"`
+	QueryServiceAvailable bool  `protobuf:"varint,3,opt,name=query_service_available,json=queryServiceAvailable,proto3" json:"query_service_available,omitempty"`
+	// the collection is released, or failed to load
+	Released             bool     `protobuf:"varint,4,opt,name=released,proto3" json:"released,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CollectionLoadState) Reset()         { *m = CollectionLoadState{} }
+func (m *CollectionLoadState) String() string { return proto.CompactTextString(m) }
+func (*CollectionLoadState) ProtoMessage()    {}
+func (*CollectionLoadState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{164}
+}
+
+func (m *CollectionLoadState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollectionLoadState.Unmarshal(m, b)
+}
+func (m *CollectionLoadState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollectionLoadState.Marshal(b, m, deterministic)
+}
+func (m *CollectionLoadState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionLoadState.Merge(m, src)
+}
+func (m *CollectionLoadState) XXX_Size() int {
+	return xxx_messageInfo_CollectionLoadState.Size(m)
+}
+func (m *CollectionLoadState) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionLoadState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionLoadState proto.InternalMessageInfo
+
+func (m *CollectionLoadState) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *CollectionLoadState) GetLoadPercentage() int64 {
+	if m != nil {
+		return m.LoadPercentage
+	}
+	return 0
+}
+
+func (m *CollectionLoadState) GetQueryServiceAvailable() bool {
+	if m != nil {
+		return m.QueryServiceAvailable
+	}
+	return false
+}
+
+func (m *CollectionLoadState) GetReleased() bool {
+	if m != nil {
+		return m.Released
+	}
+	return false
+}
+
+type WatchCollectionsResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// the states of all watched collections if true, otherwise only the changed ones
+	Snapshot             bool                   `protobuf:"varint,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	States               []*CollectionLoadState `protobuf:"bytes,3,rep,name=states,proto3" json:"states,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *WatchCollectionsResponse) Reset()         { *m = WatchCollectionsResponse{} }
+func (m *WatchCollectionsResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchCollectionsResponse) ProtoMessage()    {}
+func (*WatchCollectionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{165}
+}
+
+func (m *WatchCollectionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchCollectionsResponse.Unmarshal(m, b)
+}
+func (m *WatchCollectionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchCollectionsResponse.Marshal(b, m, deterministic)
+}
+func (m *WatchCollectionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchCollectionsResponse.Merge(m, src)
+}
+func (m *WatchCollectionsResponse) XXX_Size() int {
+	return xxx_messageInfo_WatchCollectionsResponse.Size(m)
+}
+func (m *WatchCollectionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchCollectionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchCollectionsResponse proto.InternalMessageInfo
+
+func (m *WatchCollectionsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *WatchCollectionsResponse) GetSnapshot() bool {
+	if m != nil {
+		return m.Snapshot
+	}
+	return false
+}
+
+func (m *WatchCollectionsResponse) GetStates() []*CollectionLoadState {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
+type SetCollectionBalanceModeRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// exclude the collection from auto balance if true
+	BalanceExcluded      bool     `protobuf:"varint,3,opt,name=balance_excluded,json=balanceExcluded,proto3" json:"balance_excluded,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetCollectionBalanceModeRequest) Reset()         { *m = SetCollectionBalanceModeRequest{} }
+func (m *SetCollectionBalanceModeRequest) String() string { return proto.CompactTextString(m) }
+func (*SetCollectionBalanceModeRequest) ProtoMessage()    {}
+func (*SetCollectionBalanceModeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{166}
+}
+
+func (m *SetCollectionBalanceModeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetCollectionBalanceModeRequest.Unmarshal(m, b)
+}
+func (m *SetCollectionBalanceModeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetCollectionBalanceModeRequest.Marshal(b, m, deterministic)
+}
+func (m *SetCollectionBalanceModeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCollectionBalanceModeRequest.Merge(m, src)
+}
+func (m *SetCollectionBalanceModeRequest) XXX_Size() int {
+	return xxx_messageInfo_SetCollectionBalanceModeRequest.Size(m)
+}
+func (m *SetCollectionBalanceModeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCollectionBalanceModeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCollectionBalanceModeRequest proto.InternalMessageInfo
+
+func (m *SetCollectionBalanceModeRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SetCollectionBalanceModeRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *SetCollectionBalanceModeRequest) GetBalanceExcluded() bool {
+	if m != nil {
+		return m.BalanceExcluded
+	}
+	return false
+}
+
+type GetLoadBalanceStatusRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// the balance operation ID returned by LoadBalance
+	OperationID          int64    `protobuf:"varint,2,opt,name=operationID,proto3" json:"operationID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLoadBalanceStatusRequest) Reset()         { *m = GetLoadBalanceStatusRequest{} }
+func (m *GetLoadBalanceStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLoadBalanceStatusRequest) ProtoMessage()    {}
+func (*GetLoadBalanceStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{167}
+}
+
+func (m *GetLoadBalanceStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLoadBalanceStatusRequest.Unmarshal(m, b)
+}
+func (m *GetLoadBalanceStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLoadBalanceStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *GetLoadBalanceStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLoadBalanceStatusRequest.Merge(m, src)
+}
+func (m *GetLoadBalanceStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLoadBalanceStatusRequest.Size(m)
+}
+func (m *GetLoadBalanceStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLoadBalanceStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLoadBalanceStatusRequest proto.InternalMessageInfo
+
+func (m *GetLoadBalanceStatusRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetLoadBalanceStatusRequest) GetOperationID() int64 {
+	if m != nil {
+		return m.OperationID
+	}
+	return 0
+}
+
+type GetLoadBalanceStatusResponse struct {
+	Status      *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	OperationID int64            `protobuf:"varint,2,opt,name=operationID,proto3" json:"operationID,omitempty"`
+	// number of planned segment moves
+	Planned              int32    `protobuf:"varint,3,opt,name=planned,proto3" json:"planned,omitempty"`
+	Completed            int32    `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
+	InFlight             int32    `protobuf:"varint,5,opt,name=in_flight,json=inFlight,proto3" json:"in_flight,omitempty"`
+	Failed               int32    `protobuf:"varint,6,opt,name=failed,proto3" json:"failed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLoadBalanceStatusResponse) Reset()         { *m = GetLoadBalanceStatusResponse{} }
+func (m *GetLoadBalanceStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLoadBalanceStatusResponse) ProtoMessage()    {}
+func (*GetLoadBalanceStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{168}
+}
+
+func (m *GetLoadBalanceStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLoadBalanceStatusResponse.Unmarshal(m, b)
+}
+func (m *GetLoadBalanceStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLoadBalanceStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *GetLoadBalanceStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLoadBalanceStatusResponse.Merge(m, src)
+}
+func (m *GetLoadBalanceStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_GetLoadBalanceStatusResponse.Size(m)
+}
+func (m *GetLoadBalanceStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLoadBalanceStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLoadBalanceStatusResponse proto.InternalMessageInfo
+
+func (m *GetLoadBalanceStatusResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetLoadBalanceStatusResponse) GetOperationID() int64 {
+	if m != nil {
+		return m.OperationID
+	}
+	return 0
+}
+
+func (m *GetLoadBalanceStatusResponse) GetPlanned() int32 {
+	if m != nil {
+		return m.Planned
+	}
+	return 0
+}
+
+func (m *GetLoadBalanceStatusResponse) GetCompleted() int32 {
+	if m != nil {
+		return m.Completed
+	}
+	return 0
+}
+
+func (m *GetLoadBalanceStatusResponse) GetInFlight() int32 {
+	if m != nil {
+		return m.InFlight
+	}
+	return 0
+}
+
+func (m *GetLoadBalanceStatusResponse) GetFailed() int32 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+type SetBalancePolicyRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// name of a registered balancer, reset to the global one if empty
+	BalancePolicy        string   `protobuf:"bytes,3,opt,name=balance_policy,json=balancePolicy,proto3" json:"balance_policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetBalancePolicyRequest) Reset()         { *m = SetBalancePolicyRequest{} }
+func (m *SetBalancePolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*SetBalancePolicyRequest) ProtoMessage()    {}
+func (*SetBalancePolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{169}
+}
+
+func (m *SetBalancePolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBalancePolicyRequest.Unmarshal(m, b)
+}
+func (m *SetBalancePolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBalancePolicyRequest.Marshal(b, m, deterministic)
+}
+func (m *SetBalancePolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBalancePolicyRequest.Merge(m, src)
+}
+func (m *SetBalancePolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_SetBalancePolicyRequest.Size(m)
+}
+func (m *SetBalancePolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBalancePolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBalancePolicyRequest proto.InternalMessageInfo
+
+func (m *SetBalancePolicyRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SetBalancePolicyRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *SetBalancePolicyRequest) GetBalancePolicy() string {
+	if m != nil {
+		return m.BalancePolicy
+	}
+	return ""
+}
+
+type DescribeReplicaRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ReplicaID            int64             `protobuf:"varint,2,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DescribeReplicaRequest) Reset()         { *m = DescribeReplicaRequest{} }
+func (m *DescribeReplicaRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeReplicaRequest) ProtoMessage()    {}
+func (*DescribeReplicaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{170}
+}
+
+func (m *DescribeReplicaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeReplicaRequest.Unmarshal(m, b)
+}
+func (m *DescribeReplicaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeReplicaRequest.Marshal(b, m, deterministic)
+}
+func (m *DescribeReplicaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeReplicaRequest.Merge(m, src)
+}
+func (m *DescribeReplicaRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeReplicaRequest.Size(m)
+}
+func (m *DescribeReplicaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeReplicaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeReplicaRequest proto.InternalMessageInfo
+
+func (m *DescribeReplicaRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DescribeReplicaRequest) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
+type DescribeReplicaResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// the nodes, resource group and shard leaders of the replica
+	Replica *milvuspb.ReplicaInfo `protobuf:"bytes,2,opt,name=replica,proto3" json:"replica,omitempty"`
+	// number of sealed segments of the replica's collection on each node of the replica
+	NodeSegmentNum       map[int64]int32 `protobuf:"bytes,3,rep,name=node_segment_num,json=nodeSegmentNum,proto3" json:"node_segment_num,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *DescribeReplicaResponse) Reset()         { *m = DescribeReplicaResponse{} }
+func (m *DescribeReplicaResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeReplicaResponse) ProtoMessage()    {}
+func (*DescribeReplicaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{171}
+}
+
+func (m *DescribeReplicaResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeReplicaResponse.Unmarshal(m, b)
+}
+func (m *DescribeReplicaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeReplicaResponse.Marshal(b, m, deterministic)
+}
+func (m *DescribeReplicaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeReplicaResponse.Merge(m, src)
+}
+func (m *DescribeReplicaResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeReplicaResponse.Size(m)
+}
+func (m *DescribeReplicaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeReplicaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeReplicaResponse proto.InternalMessageInfo
+
+func (m *DescribeReplicaResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *DescribeReplicaResponse) GetReplica() *milvuspb.ReplicaInfo {
+	if m != nil {
+		return m.Replica
+	}
+	return nil
+}
+
+func (m *DescribeReplicaResponse) GetNodeSegmentNum() map[int64]int32 {
+	if m != nil {
+		return m.NodeSegmentNum
+	}
+	return nil
+}
+
+type GetCollectionTargetsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetCollectionTargetsRequest) Reset()         { *m = GetCollectionTargetsRequest{} }
+func (m *GetCollectionTargetsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCollectionTargetsRequest) ProtoMessage()    {}
+func (*GetCollectionTargetsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{172}
+}
+
+func (m *GetCollectionTargetsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCollectionTargetsRequest.Unmarshal(m, b)
+}
+func (m *GetCollectionTargetsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCollectionTargetsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCollectionTargetsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCollectionTargetsRequest.Merge(m, src)
+}
+func (m *GetCollectionTargetsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCollectionTargetsRequest.Size(m)
+}
+func (m *GetCollectionTargetsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCollectionTargetsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCollectionTargetsRequest proto.InternalMessageInfo
+
+func (m *GetCollectionTargetsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetCollectionTargetsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type TargetSnapshot struct {
+	// 0 if the target doesn't exist
+	Version              int64    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	SealedSegmentIDs     []int64  `protobuf:"varint,2,rep,packed,name=sealed_segmentIDs,json=sealedSegmentIDs,proto3" json:"sealed_segmentIDs,omitempty"`
+	Channels             []string `protobuf:"bytes,3,rep,name=channels,proto3" json:"channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TargetSnapshot) Reset()         { *m = TargetSnapshot{} }
+func (m *TargetSnapshot) String() string { return proto.CompactTextString(m) }
+func (*TargetSnapshot) ProtoMessage()    {}
+func (*TargetSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{173}
+}
+
+func (m *TargetSnapshot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TargetSnapshot.Unmarshal(m, b)
+}
+func (m *TargetSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TargetSnapshot.Marshal(b, m, deterministic)
+}
+func (m *TargetSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TargetSnapshot.Merge(m, src)
+}
+func (m *TargetSnapshot) XXX_Size() int {
+	return xxx_messageInfo_TargetSnapshot.Size(m)
+}
+func (m *TargetSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_TargetSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TargetSnapshot proto.InternalMessageInfo
+
+func (m *TargetSnapshot) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *TargetSnapshot) GetSealedSegmentIDs() []int64 {
+	if m != nil {
+		return m.SealedSegmentIDs
+	}
+	return nil
+}
+
+func (m *TargetSnapshot) GetChannels() []string {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+type GetCollectionTargetsResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CurrentTarget        *TargetSnapshot  `protobuf:"bytes,2,opt,name=current_target,json=currentTarget,proto3" json:"current_target,omitempty"`
+	NextTarget           *TargetSnapshot  `protobuf:"bytes,3,opt,name=next_target,json=nextTarget,proto3" json:"next_target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetCollectionTargetsResponse) Reset()         { *m = GetCollectionTargetsResponse{} }
+func (m *GetCollectionTargetsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCollectionTargetsResponse) ProtoMessage()    {}
+func (*GetCollectionTargetsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{174}
+}
+
+func (m *GetCollectionTargetsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCollectionTargetsResponse.Unmarshal(m, b)
+}
+func (m *GetCollectionTargetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCollectionTargetsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCollectionTargetsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCollectionTargetsResponse.Merge(m, src)
+}
+func (m *GetCollectionTargetsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCollectionTargetsResponse.Size(m)
+}
+func (m *GetCollectionTargetsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCollectionTargetsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCollectionTargetsResponse proto.InternalMessageInfo
+
+func (m *GetCollectionTargetsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetCollectionTargetsResponse) GetCurrentTarget() *TargetSnapshot {
+	if m != nil {
+		return m.CurrentTarget
+	}
+	return nil
+}
+
+func (m *GetCollectionTargetsResponse) GetNextTarget() *TargetSnapshot {
+	if m != nil {
+		return m.NextTarget
+	}
+	return nil
+}
+
+type DecommissionNodeRequest struct {
+	Base   *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	NodeID int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	// wait up to the timeout(in milliseconds) for the node to be drained, return immediately if not positive
+	WaitTimeout          int64    `protobuf:"varint,3,opt,name=wait_timeout,json=waitTimeout,proto3" json:"wait_timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DecommissionNodeRequest) Reset()         { *m = DecommissionNodeRequest{} }
+func (m *DecommissionNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*DecommissionNodeRequest) ProtoMessage()    {}
+func (*DecommissionNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{175}
+}
+
+func (m *DecommissionNodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecommissionNodeRequest.Unmarshal(m, b)
+}
+func (m *DecommissionNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecommissionNodeRequest.Marshal(b, m, deterministic)
+}
+func (m *DecommissionNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecommissionNodeRequest.Merge(m, src)
+}
+func (m *DecommissionNodeRequest) XXX_Size() int {
+	return xxx_messageInfo_DecommissionNodeRequest.Size(m)
+}
+func (m *DecommissionNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecommissionNodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecommissionNodeRequest proto.InternalMessageInfo
+
+func (m *DecommissionNodeRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DecommissionNodeRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *DecommissionNodeRequest) GetWaitTimeout() int64 {
+	if m != nil {
+		return m.WaitTimeout
+	}
+	return 0
+}
+
+type DecommissionProgress struct {
+	NodeID int64             `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	State  DecommissionState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.query.DecommissionState" json:"state,omitempty"`
+	// number of segments/channels on the node when decommission starts
+	TotalSegments        int64    `protobuf:"varint,3,opt,name=total_segments,json=totalSegments,proto3" json:"total_segments,omitempty"`
+	RemainingSegments    int64    `protobuf:"varint,4,opt,name=remaining_segments,json=remainingSegments,proto3" json:"remaining_segments,omitempty"`
+	TotalChannels        int64    `protobuf:"varint,5,opt,name=total_channels,json=totalChannels,proto3" json:"total_channels,omitempty"`
+	RemainingChannels    int64    `protobuf:"varint,6,opt,name=remaining_channels,json=remainingChannels,proto3" json:"remaining_channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DecommissionProgress) Reset()         { *m = DecommissionProgress{} }
+func (m *DecommissionProgress) String() string { return proto.CompactTextString(m) }
+func (*DecommissionProgress) ProtoMessage()    {}
+func (*DecommissionProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{176}
+}
+
+func (m *DecommissionProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecommissionProgress.Unmarshal(m, b)
+}
+func (m *DecommissionProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecommissionProgress.Marshal(b, m, deterministic)
+}
+func (m *DecommissionProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecommissionProgress.Merge(m, src)
+}
+func (m *DecommissionProgress) XXX_Size() int {
+	return xxx_messageInfo_DecommissionProgress.Size(m)
+}
+func (m *DecommissionProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecommissionProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecommissionProgress proto.InternalMessageInfo
+
+func (m *DecommissionProgress) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *DecommissionProgress) GetState() DecommissionState {
+	if m != nil {
+		return m.State
+	}
+	return DecommissionState_DecommissionDraining
+}
+
+func (m *DecommissionProgress) GetTotalSegments() int64 {
+	if m != nil {
+		return m.TotalSegments
+	}
+	return 0
+}
+
+func (m *DecommissionProgress) GetRemainingSegments() int64 {
+	if m != nil {
+		return m.RemainingSegments
+	}
+	return 0
+}
+
+func (m *DecommissionProgress) GetTotalChannels() int64 {
+	if m != nil {
+		return m.TotalChannels
+	}
+	return 0
+}
+
+func (m *DecommissionProgress) GetRemainingChannels() int64 {
+	if m != nil {
+		return m.RemainingChannels
+	}
+	return 0
+}
+
+type DecommissionNodeResponse struct {
+	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Progress             *DecommissionProgress `protobuf:"bytes,2,opt,name=progress,proto3" json:"progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DecommissionNodeResponse) Reset()         { *m = DecommissionNodeResponse{} }
+func (m *DecommissionNodeResponse) String() string { return proto.CompactTextString(m) }
+func (*DecommissionNodeResponse) ProtoMessage()    {}
+func (*DecommissionNodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{177}
+}
+
+func (m *DecommissionNodeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecommissionNodeResponse.Unmarshal(m, b)
+}
+func (m *DecommissionNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecommissionNodeResponse.Marshal(b, m, deterministic)
+}
+func (m *DecommissionNodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecommissionNodeResponse.Merge(m, src)
+}
+func (m *DecommissionNodeResponse) XXX_Size() int {
+	return xxx_messageInfo_DecommissionNodeResponse.Size(m)
+}
+func (m *DecommissionNodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecommissionNodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecommissionNodeResponse proto.InternalMessageInfo
+
+func (m *DecommissionNodeResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *DecommissionNodeResponse) GetProgress() *DecommissionProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+// the persisted decommission started by DecommissionNode, restored after failover
+type NodeDecommission struct {
+	NodeID int64 `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	// number of segments/channels on the node when decommission starts
+	TotalSegments int64 `protobuf:"varint,2,opt,name=total_segments,json=totalSegments,proto3" json:"total_segments,omitempty"`
+	TotalChannels int64 `protobuf:"varint,3,opt,name=total_channels,json=totalChannels,proto3" json:"total_channels,omitempty"`
+	// unix time in milliseconds when decommission starts
+	StartTime            int64    `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeDecommission) Reset()         { *m = NodeDecommission{} }
+func (m *NodeDecommission) String() string { return proto.CompactTextString(m) }
+func (*NodeDecommission) ProtoMessage()    {}
+func (*NodeDecommission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{178}
+}
+
+func (m *NodeDecommission) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeDecommission.Unmarshal(m, b)
+}
+func (m *NodeDecommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeDecommission.Marshal(b, m, deterministic)
+}
+func (m *NodeDecommission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeDecommission.Merge(m, src)
+}
+func (m *NodeDecommission) XXX_Size() int {
+	return xxx_messageInfo_NodeDecommission.Size(m)
+}
+func (m *NodeDecommission) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeDecommission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeDecommission proto.InternalMessageInfo
+
+func (m *NodeDecommission) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *NodeDecommission) GetTotalSegments() int64 {
+	if m != nil {
+		return m.TotalSegments
+	}
+	return 0
+}
+
+func (m *NodeDecommission) GetTotalChannels() int64 {
+	if m != nil {
+		return m.TotalChannels
+	}
+	return 0
+}
+
+func (m *NodeDecommission) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+type GetDecommissionProgressRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	NodeID               int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetDecommissionProgressRequest) Reset()         { *m = GetDecommissionProgressRequest{} }
+func (m *GetDecommissionProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDecommissionProgressRequest) ProtoMessage()    {}
+func (*GetDecommissionProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{179}
+}
+
+func (m *GetDecommissionProgressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDecommissionProgressRequest.Unmarshal(m, b)
+}
+func (m *GetDecommissionProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDecommissionProgressRequest.Marshal(b, m, deterministic)
+}
+func (m *GetDecommissionProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDecommissionProgressRequest.Merge(m, src)
+}
+func (m *GetDecommissionProgressRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDecommissionProgressRequest.Size(m)
+}
+func (m *GetDecommissionProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDecommissionProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDecommissionProgressRequest proto.InternalMessageInfo
+
+func (m *GetDecommissionProgressRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetDecommissionProgressRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+type GetDecommissionProgressResponse struct {
+	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Progress             *DecommissionProgress `protobuf:"bytes,2,opt,name=progress,proto3" json:"progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetDecommissionProgressResponse) Reset()         { *m = GetDecommissionProgressResponse{} }
+func (m *GetDecommissionProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDecommissionProgressResponse) ProtoMessage()    {}
+func (*GetDecommissionProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{180}
+}
+
+func (m *GetDecommissionProgressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDecommissionProgressResponse.Unmarshal(m, b)
+}
+func (m *GetDecommissionProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDecommissionProgressResponse.Marshal(b, m, deterministic)
+}
+func (m *GetDecommissionProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDecommissionProgressResponse.Merge(m, src)
+}
+func (m *GetDecommissionProgressResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDecommissionProgressResponse.Size(m)
+}
+func (m *GetDecommissionProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDecommissionProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDecommissionProgressResponse proto.InternalMessageInfo
+
+func (m *GetDecommissionProgressResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetDecommissionProgressResponse) GetProgress() *DecommissionProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("milvus.proto.query.LoadScope", LoadScope_name, LoadScope_value)
+	proto.RegisterEnum("milvus.proto.query.DataScope", DataScope_name, DataScope_value)
+	proto.RegisterEnum("milvus.proto.query.PartitionState", PartitionState_name, PartitionState_value)
+	proto.RegisterEnum("milvus.proto.query.TriggerCondition", TriggerCondition_name, TriggerCondition_value)
+	proto.RegisterEnum("milvus.proto.query.LoadType", LoadType_name, LoadType_value)
+	proto.RegisterEnum("milvus.proto.query.LoadStatus", LoadStatus_name, LoadStatus_value)
+	proto.RegisterEnum("milvus.proto.query.SyncType", SyncType_name, SyncType_value)
+	proto.RegisterEnum("milvus.proto.query.ReplicaSortKey", ReplicaSortKey_name, ReplicaSortKey_value)
+	proto.RegisterEnum("milvus.proto.query.ZonePlacementPolicy", ZonePlacementPolicy_name, ZonePlacementPolicy_value)
+	proto.RegisterEnum("milvus.proto.query.DecommissionState", DecommissionState_name, DecommissionState_value)
+	proto.RegisterType((*ShowCollectionsRequest)(nil), "milvus.proto.query.ShowCollectionsRequest")
+	proto.RegisterType((*ShowCollectionsResponse)(nil), "milvus.proto.query.ShowCollectionsResponse")
+	proto.RegisterType((*ReplicaLoadPercentages)(nil), "milvus.proto.query.ReplicaLoadPercentages")
+	proto.RegisterMapType((map[int64]int64)(nil), "milvus.proto.query.ReplicaLoadPercentages.PercentagesEntry")
+	proto.RegisterType((*ShowPartitionsRequest)(nil), "milvus.proto.query.ShowPartitionsRequest")
+	proto.RegisterType((*ShowPartitionsResponse)(nil), "milvus.proto.query.ShowPartitionsResponse")
+	proto.RegisterType((*LoadCollectionRequest)(nil), "milvus.proto.query.LoadCollectionRequest")
+	proto.RegisterMapType((map[int64]int64)(nil), "milvus.proto.query.LoadCollectionRequest.FieldIndexIDEntry")
+	proto.RegisterMapType((map[int64]int64)(nil), "milvus.proto.query.LoadCollectionRequest.SegmentNodeHintsEntry")
+	proto.RegisterType((*ReleaseCollectionRequest)(nil), "milvus.proto.query.ReleaseCollectionRequest")
+	proto.RegisterType((*GetStatisticsRequest)(nil), "milvus.proto.query.GetStatisticsRequest")
+	proto.RegisterType((*LoadPartitionsRequest)(nil), "milvus.proto.query.LoadPartitionsRequest")
+	proto.RegisterMapType((map[int64]int64)(nil), "milvus.proto.query.LoadPartitionsRequest.FieldIndexIDEntry")
+	proto.RegisterType((*ReleasePartitionsRequest)(nil), "milvus.proto.query.ReleasePartitionsRequest")
+	proto.RegisterType((*GetPartitionStatesRequest)(nil), "milvus.proto.query.GetPartitionStatesRequest")
+	proto.RegisterType((*GetPartitionStatesResponse)(nil), "milvus.proto.query.GetPartitionStatesResponse")
+	proto.RegisterType((*GetSegmentInfoRequest)(nil), "milvus.proto.query.GetSegmentInfoRequest")
+	proto.RegisterType((*GetSegmentInfoResponse)(nil), "milvus.proto.query.GetSegmentInfoResponse")
+	proto.RegisterType((*GetShardLeadersRequest)(nil), "milvus.proto.query.GetShardLeadersRequest")
+	proto.RegisterType((*GetShardLeadersResponse)(nil), "milvus.proto.query.GetShardLeadersResponse")
+	proto.RegisterType((*UpdateResourceGroupsRequest)(nil), "milvus.proto.query.UpdateResourceGroupsRequest")
+	proto.RegisterMapType((map[string]*rgpb.ResourceGroupConfig)(nil), "milvus.proto.query.UpdateResourceGroupsRequest.ResourceGroupsEntry")
+	proto.RegisterType((*ShardLeadersList)(nil), "milvus.proto.query.ShardLeadersList")
+	proto.RegisterType((*LeaderServingError)(nil), "milvus.proto.query.LeaderServingError")
+	proto.RegisterType((*SyncNewCreatedPartitionRequest)(nil), "milvus.proto.query.SyncNewCreatedPartitionRequest")
+	proto.RegisterType((*LoadMetaInfo)(nil), "milvus.proto.query.LoadMetaInfo")
+	proto.RegisterType((*WatchDmChannelsRequest)(nil), "milvus.proto.query.WatchDmChannelsRequest")
+	proto.RegisterMapType((map[int64]*datapb.SegmentInfo)(nil), "milvus.proto.query.WatchDmChannelsRequest.SegmentInfosEntry")
+	proto.RegisterType((*UnsubDmChannelRequest)(nil), "milvus.proto.query.UnsubDmChannelRequest")
+	proto.RegisterType((*SegmentLoadInfo)(nil), "milvus.proto.query.SegmentLoadInfo")
+	proto.RegisterType((*FieldIndexInfo)(nil), "milvus.proto.query.FieldIndexInfo")
+	proto.RegisterType((*LoadSegmentsRequest)(nil), "milvus.proto.query.LoadSegmentsRequest")
+	proto.RegisterType((*ReleaseSegmentsRequest)(nil), "milvus.proto.query.ReleaseSegmentsRequest")
+	proto.RegisterType((*SearchRequest)(nil), "milvus.proto.query.SearchRequest")
+	proto.RegisterType((*QueryRequest)(nil), "milvus.proto.query.QueryRequest")
+	proto.RegisterType((*SyncReplicaSegmentsRequest)(nil), "milvus.proto.query.SyncReplicaSegmentsRequest")
+	proto.RegisterType((*ReplicaSegmentsInfo)(nil), "milvus.proto.query.ReplicaSegmentsInfo")
+	proto.RegisterType((*GetLoadInfoRequest)(nil), "milvus.proto.query.GetLoadInfoRequest")
+	proto.RegisterType((*GetLoadInfoResponse)(nil), "milvus.proto.query.GetLoadInfoResponse")
+	proto.RegisterType((*HandoffSegmentsRequest)(nil), "milvus.proto.query.HandoffSegmentsRequest")
+	proto.RegisterType((*LoadBalanceRequest)(nil), "milvus.proto.query.LoadBalanceRequest")
+	proto.RegisterType((*DmChannelWatchInfo)(nil), "milvus.proto.query.DmChannelWatchInfo")
+	proto.RegisterType((*QueryChannelInfo)(nil), "milvus.proto.query.QueryChannelInfo")
+	proto.RegisterType((*PartitionStates)(nil), "milvus.proto.query.PartitionStates")
+	proto.RegisterType((*SegmentInfo)(nil), "milvus.proto.query.SegmentInfo")
+	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.query.CollectionInfo")
+	proto.RegisterType((*UnsubscribeChannels)(nil), "milvus.proto.query.UnsubscribeChannels")
+	proto.RegisterType((*UnsubscribeChannelInfo)(nil), "milvus.proto.query.UnsubscribeChannelInfo")
+	proto.RegisterType((*SegmentChangeInfo)(nil), "milvus.proto.query.SegmentChangeInfo")
+	proto.RegisterType((*SealedSegmentsChangeInfo)(nil), "milvus.proto.query.SealedSegmentsChangeInfo")
+	proto.RegisterType((*GetDataDistributionRequest)(nil), "milvus.proto.query.GetDataDistributionRequest")
+	proto.RegisterMapType((map[string]*msgpb.MsgPosition)(nil), "milvus.proto.query.GetDataDistributionRequest.CheckpointsEntry")
+	proto.RegisterType((*GetDataDistributionResponse)(nil), "milvus.proto.query.GetDataDistributionResponse")
+	proto.RegisterType((*LeaderView)(nil), "milvus.proto.query.LeaderView")
+	proto.RegisterMapType((map[int64]*msgpb.MsgPosition)(nil), "milvus.proto.query.LeaderView.GrowingSegmentsEntry")
+	proto.RegisterMapType((map[int64]*SegmentDist)(nil), "milvus.proto.query.LeaderView.SegmentDistEntry")
+	proto.RegisterType((*SegmentDist)(nil), "milvus.proto.query.SegmentDist")
+	proto.RegisterType((*SegmentVersionInfo)(nil), "milvus.proto.query.SegmentVersionInfo")
+	proto.RegisterMapType((map[int64]*FieldIndexInfo)(nil), "milvus.proto.query.SegmentVersionInfo.IndexInfoEntry")
+	proto.RegisterType((*ChannelVersionInfo)(nil), "milvus.proto.query.ChannelVersionInfo")
+	proto.RegisterType((*CollectionLoadInfo)(nil), "milvus.proto.query.CollectionLoadInfo")
+	proto.RegisterMapType((map[int64]int64)(nil), "milvus.proto.query.CollectionLoadInfo.FieldIndexIDEntry")
+	proto.RegisterMapType((map[int64]int64)(nil), "milvus.proto.query.CollectionLoadInfo.SegmentNodeHintsEntry")
+	proto.RegisterType((*PartitionLoadInfo)(nil), "milvus.proto.query.PartitionLoadInfo")
+	proto.RegisterMapType((map[int64]int64)(nil), "milvus.proto.query.PartitionLoadInfo.FieldIndexIDEntry")
+	proto.RegisterType((*Replica)(nil), "milvus.proto.query.Replica")
+	proto.RegisterType((*SyncAction)(nil), "milvus.proto.query.SyncAction")
+	proto.RegisterType((*SyncDistributionRequest)(nil), "milvus.proto.query.SyncDistributionRequest")
+	proto.RegisterType((*ResourceGroup)(nil), "milvus.proto.query.ResourceGroup")
+	proto.RegisterMapType((map[string]string)(nil), "milvus.proto.query.ResourceGroup.NodeSelectorEntry")
+	proto.RegisterType((*TransferReplicaRequest)(nil), "milvus.proto.query.TransferReplicaRequest")
+	proto.RegisterType((*DescribeResourceGroupRequest)(nil), "milvus.proto.query.DescribeResourceGroupRequest")
+	proto.RegisterType((*DescribeResourceGroupResponse)(nil), "milvus.proto.query.DescribeResourceGroupResponse")
+	proto.RegisterType((*ResourceGroupInfo)(nil), "milvus.proto.query.ResourceGroupInfo")
+	proto.RegisterMapType((map[string]string)(nil), "milvus.proto.query.ResourceGroupInfo.NodeSelectorEntry")
+	proto.RegisterMapType((map[int64]int32)(nil), "milvus.proto.query.ResourceGroupInfo.NumIncomingNodeEntry")
+	proto.RegisterMapType((map[int64]int32)(nil), "milvus.proto.query.ResourceGroupInfo.NumLoadedReplicaEntry")
+	proto.RegisterMapType((map[int64]int32)(nil), "milvus.proto.query.ResourceGroupInfo.NumOutgoingNodeEntry")
+	proto.RegisterType((*DeleteRequest)(nil), "milvus.proto.query.DeleteRequest")
+	proto.RegisterType((*ActivateCheckerRequest)(nil), "milvus.proto.query.ActivateCheckerRequest")
+	proto.RegisterType((*DeactivateCheckerRequest)(nil), "milvus.proto.query.DeactivateCheckerRequest")
+	proto.RegisterType((*ListCheckersRequest)(nil), "milvus.proto.query.ListCheckersRequest")
+	proto.RegisterType((*ListCheckersResponse)(nil), "milvus.proto.query.ListCheckersResponse")
+	proto.RegisterType((*CheckerInfo)(nil), "milvus.proto.query.CheckerInfo")
+	proto.RegisterType((*SegmentTarget)(nil), "milvus.proto.query.SegmentTarget")
+	proto.RegisterType((*PartitionTarget)(nil), "milvus.proto.query.PartitionTarget")
+	proto.RegisterType((*ChannelTarget)(nil), "milvus.proto.query.ChannelTarget")
+	proto.RegisterType((*CollectionTarget)(nil), "milvus.proto.query.CollectionTarget")
+	proto.RegisterType((*NodeInfo)(nil), "milvus.proto.query.NodeInfo")
+	proto.RegisterMapType((map[string]string)(nil), "milvus.proto.query.NodeInfo.LabelsEntry")
+	proto.RegisterType((*ListQueryNodeRequest)(nil), "milvus.proto.query.ListQueryNodeRequest")
+	proto.RegisterType((*ListQueryNodeResponse)(nil), "milvus.proto.query.ListQueryNodeResponse")
+	proto.RegisterType((*GetQueryNodeDistributionRequest)(nil), "milvus.proto.query.GetQueryNodeDistributionRequest")
+	proto.RegisterType((*GetQueryNodeDistributionResponse)(nil), "milvus.proto.query.GetQueryNodeDistributionResponse")
+	proto.RegisterType((*SuspendBalanceRequest)(nil), "milvus.proto.query.SuspendBalanceRequest")
+	proto.RegisterType((*ResumeBalanceRequest)(nil), "milvus.proto.query.ResumeBalanceRequest")
+	proto.RegisterType((*SuspendNodeRequest)(nil), "milvus.proto.query.SuspendNodeRequest")
+	proto.RegisterType((*ResumeNodeRequest)(nil), "milvus.proto.query.ResumeNodeRequest")
+	proto.RegisterType((*TransferSegmentRequest)(nil), "milvus.proto.query.TransferSegmentRequest")
+	proto.RegisterType((*TransferChannelRequest)(nil), "milvus.proto.query.TransferChannelRequest")
+	proto.RegisterType((*CheckQueryNodeDistributionRequest)(nil), "milvus.proto.query.CheckQueryNodeDistributionRequest")
+	proto.RegisterType((*GetClusterLoadSummaryRequest)(nil), "milvus.proto.query.GetClusterLoadSummaryRequest")
+	proto.RegisterType((*GetClusterLoadSummaryResponse)(nil), "milvus.proto.query.GetClusterLoadSummaryResponse")
+	proto.RegisterMapType((map[string]int32)(nil), "milvus.proto.query.GetClusterLoadSummaryResponse.NumNodesPerRgEntry")
+	proto.RegisterType((*ForceSyncRequest)(nil), "milvus.proto.query.ForceSyncRequest")
+	proto.RegisterType((*NodeSyncResult)(nil), "milvus.proto.query.NodeSyncResult")
+	proto.RegisterType((*ForceSyncResponse)(nil), "milvus.proto.query.ForceSyncResponse")
+	proto.RegisterType((*GetTransferNodeStatusRequest)(nil), "milvus.proto.query.GetTransferNodeStatusRequest")
+	proto.RegisterType((*ReplicaRecoveryStatus)(nil), "milvus.proto.query.ReplicaRecoveryStatus")
+	proto.RegisterType((*GetTransferNodeStatusResponse)(nil), "milvus.proto.query.GetTransferNodeStatusResponse")
+	proto.RegisterType((*TriggerCheckerRunRequest)(nil), "milvus.proto.query.TriggerCheckerRunRequest")
+	proto.RegisterType((*CheckerTaskInfo)(nil), "milvus.proto.query.CheckerTaskInfo")
+	proto.RegisterType((*TriggerCheckerRunResponse)(nil), "milvus.proto.query.TriggerCheckerRunResponse")
+	proto.RegisterType((*GetAvailabilitySLARequest)(nil), "milvus.proto.query.GetAvailabilitySLARequest")
+	proto.RegisterType((*UnavailablePeriod)(nil), "milvus.proto.query.UnavailablePeriod")
+	proto.RegisterType((*GetAvailabilitySLAResponse)(nil), "milvus.proto.query.GetAvailabilitySLAResponse")
+	proto.RegisterType((*GetReleaseProgressRequest)(nil), "milvus.proto.query.GetReleaseProgressRequest")
+	proto.RegisterType((*GetReleaseProgressResponse)(nil), "milvus.proto.query.GetReleaseProgressResponse")
+	proto.RegisterType((*TenantViolation)(nil), "milvus.proto.query.TenantViolation")
+	proto.RegisterType((*GetTenantViolationsRequest)(nil), "milvus.proto.query.GetTenantViolationsRequest")
+	proto.RegisterType((*GetTenantViolationsResponse)(nil), "milvus.proto.query.GetTenantViolationsResponse")
+	proto.RegisterType((*CaptureBalanceLayoutRequest)(nil), "milvus.proto.query.CaptureBalanceLayoutRequest")
+	proto.RegisterType((*ClearBalanceLayoutRequest)(nil), "milvus.proto.query.ClearBalanceLayoutRequest")
+	proto.RegisterType((*BalanceLayoutStatus)(nil), "milvus.proto.query.BalanceLayoutStatus")
+	proto.RegisterType((*GetBalanceLayoutStatusRequest)(nil), "milvus.proto.query.GetBalanceLayoutStatusRequest")
+	proto.RegisterType((*GetBalanceLayoutStatusResponse)(nil), "milvus.proto.query.GetBalanceLayoutStatusResponse")
+	proto.RegisterType((*ReplicaLayout)(nil), "milvus.proto.query.ReplicaLayout")
+	proto.RegisterMapType((map[string]int64)(nil), "milvus.proto.query.ReplicaLayout.ChannelsEntry")
+	proto.RegisterMapType((map[int64]int64)(nil), "milvus.proto.query.ReplicaLayout.SegmentsEntry")
+	proto.RegisterType((*BalanceLayout)(nil), "milvus.proto.query.BalanceLayout")
+	proto.RegisterType((*LoadCheckpoint)(nil), "milvus.proto.query.LoadCheckpoint")
+	proto.RegisterType((*GetCollectionLoadInfoRequest)(nil), "milvus.proto.query.GetCollectionLoadInfoRequest")
+	proto.RegisterType((*GetCollectionLoadInfoResponse)(nil), "milvus.proto.query.GetCollectionLoadInfoResponse")
+	proto.RegisterType((*RecommendReplicaCountRequest)(nil), "milvus.proto.query.RecommendReplicaCountRequest")
+	proto.RegisterType((*ShardLoadEstimate)(nil), "milvus.proto.query.ShardLoadEstimate")
+	proto.RegisterType((*RecommendReplicaCountResponse)(nil), "milvus.proto.query.RecommendReplicaCountResponse")
+	proto.RegisterType((*TargetUpdateState)(nil), "milvus.proto.query.TargetUpdateState")
+	proto.RegisterType((*PauseTargetUpdatesRequest)(nil), "milvus.proto.query.PauseTargetUpdatesRequest")
+	proto.RegisterType((*ResumeTargetUpdatesRequest)(nil), "milvus.proto.query.ResumeTargetUpdatesRequest")
+	proto.RegisterType((*NodeLoadSample)(nil), "milvus.proto.query.NodeLoadSample")
+	proto.RegisterType((*GetNodeLoadHistoryRequest)(nil), "milvus.proto.query.GetNodeLoadHistoryRequest")
+	proto.RegisterType((*GetNodeLoadHistoryResponse)(nil), "milvus.proto.query.GetNodeLoadHistoryResponse")
+	proto.RegisterType((*ShardBlock)(nil), "milvus.proto.query.ShardBlock")
+	proto.RegisterType((*BlockShardRequest)(nil), "milvus.proto.query.BlockShardRequest")
+	proto.RegisterType((*UnblockShardRequest)(nil), "milvus.proto.query.UnblockShardRequest")
+	proto.RegisterType((*GetResourceGroupDriftRequest)(nil), "milvus.proto.query.GetResourceGroupDriftRequest")
+	proto.RegisterType((*ResourceGroupDrift)(nil), "milvus.proto.query.ResourceGroupDrift")
+	proto.RegisterType((*GetResourceGroupDriftResponse)(nil), "milvus.proto.query.GetResourceGroupDriftResponse")
+	proto.RegisterType((*CanStopNodeRequest)(nil), "milvus.proto.query.CanStopNodeRequest")
+	proto.RegisterType((*ShardAtRisk)(nil), "milvus.proto.query.ShardAtRisk")
+	proto.RegisterType((*CanStopNodeResponse)(nil), "milvus.proto.query.CanStopNodeResponse")
+	proto.RegisterType((*MoveCollectionToResourceGroupRequest)(nil), "milvus.proto.query.MoveCollectionToResourceGroupRequest")
+	proto.RegisterType((*MoveCollectionToResourceGroupResponse)(nil), "milvus.proto.query.MoveCollectionToResourceGroupResponse")
+	proto.RegisterType((*GetShardLeadersBatchRequest)(nil), "milvus.proto.query.GetShardLeadersBatchRequest")
+	proto.RegisterType((*CollectionShardLeaders)(nil), "milvus.proto.query.CollectionShardLeaders")
+	proto.RegisterType((*GetShardLeadersBatchResponse)(nil), "milvus.proto.query.GetShardLeadersBatchResponse")
+	proto.RegisterType((*GetHandoffLagRequest)(nil), "milvus.proto.query.GetHandoffLagRequest")
+	proto.RegisterType((*ShardHandoffLag)(nil), "milvus.proto.query.ShardHandoffLag")
+	proto.RegisterType((*GetHandoffLagResponse)(nil), "milvus.proto.query.GetHandoffLagResponse")
+	proto.RegisterType((*CreateResourceGroupWithAutoFillRequest)(nil), "milvus.proto.query.CreateResourceGroupWithAutoFillRequest")
+	proto.RegisterMapType((map[string]string)(nil), "milvus.proto.query.CreateResourceGroupWithAutoFillRequest.NodeSelectorEntry")
+	proto.RegisterType((*CreateResourceGroupWithAutoFillResponse)(nil), "milvus.proto.query.CreateResourceGroupWithAutoFillResponse")
+	proto.RegisterType((*SegmentBalancePlan)(nil), "milvus.proto.query.SegmentBalancePlan")
+	proto.RegisterType((*DryRunLoadBalanceResponse)(nil), "milvus.proto.query.DryRunLoadBalanceResponse")
+	proto.RegisterType((*BalanceState)(nil), "milvus.proto.query.BalanceState")
+	proto.RegisterType((*TransferNodeByFractionRequest)(nil), "milvus.proto.query.TransferNodeByFractionRequest")
+	proto.RegisterType((*CheckNodeHealthRequest)(nil), "milvus.proto.query.CheckNodeHealthRequest")
+	proto.RegisterType((*NodeHealth)(nil), "milvus.proto.query.NodeHealth")
+	proto.RegisterType((*CheckNodeHealthResponse)(nil), "milvus.proto.query.CheckNodeHealthResponse")
+	proto.RegisterType((*DescribeCheckerRequest)(nil), "milvus.proto.query.DescribeCheckerRequest")
+	proto.RegisterType((*DescribeCheckerResponse)(nil), "milvus.proto.query.DescribeCheckerResponse")
+	proto.RegisterType((*ListReplicasRequest)(nil), "milvus.proto.query.ListReplicasRequest")
+	proto.RegisterType((*ListReplicasResponse)(nil), "milvus.proto.query.ListReplicasResponse")
+	proto.RegisterType((*CancelLoadRequest)(nil), "milvus.proto.query.CancelLoadRequest")
+	proto.RegisterType((*GetLoadStateRequest)(nil), "milvus.proto.query.GetLoadStateRequest")
+	proto.RegisterType((*GetLoadStateResponse)(nil), "milvus.proto.query.GetLoadStateResponse")
+	proto.RegisterType((*PartitionLoadFailure)(nil), "milvus.proto.query.PartitionLoadFailure")
+	proto.RegisterType((*SegmentLoadingProgress)(nil), "milvus.proto.query.SegmentLoadingProgress")
+	proto.RegisterType((*RebalanceCollectionRequest)(nil), "milvus.proto.query.RebalanceCollectionRequest")
+	proto.RegisterType((*ChannelBalancePlan)(nil), "milvus.proto.query.ChannelBalancePlan")
+	proto.RegisterType((*RebalanceCollectionResponse)(nil), "milvus.proto.query.RebalanceCollectionResponse")
+	proto.RegisterType((*GetResourceGroupUtilizationRequest)(nil), "milvus.proto.query.GetResourceGroupUtilizationRequest")
+	proto.RegisterType((*ResourceGroupUtilization)(nil), "milvus.proto.query.ResourceGroupUtilization")
+	proto.RegisterType((*GetResourceGroupUtilizationResponse)(nil), "milvus.proto.query.GetResourceGroupUtilizationResponse")
+	proto.RegisterType((*SyncNewCreatedPartitionsRequest)(nil), "milvus.proto.query.SyncNewCreatedPartitionsRequest")
+	proto.RegisterType((*SyncNewCreatedPartitionsResponse)(nil), "milvus.proto.query.SyncNewCreatedPartitionsResponse")
+	proto.RegisterType((*WatchCollectionsRequest)(nil), "milvus.proto.query.WatchCollectionsRequest")
+	proto.RegisterType((*CollectionLoadState)(nil), "milvus.proto.query.CollectionLoadState")
+	proto.RegisterType((*WatchCollectionsResponse)(nil), "milvus.proto.query.WatchCollectionsResponse")
+	proto.RegisterType((*SetCollectionBalanceModeRequest)(nil), "milvus.proto.query.SetCollectionBalanceModeRequest")
+	proto.RegisterType((*GetLoadBalanceStatusRequest)(nil), "milvus.proto.query.GetLoadBalanceStatusRequest")
+	proto.RegisterType((*GetLoadBalanceStatusResponse)(nil), "milvus.proto.query.GetLoadBalanceStatusResponse")
+	proto.RegisterType((*SetBalancePolicyRequest)(nil), "milvus.proto.query.SetBalancePolicyRequest")
+	proto.RegisterType((*DescribeReplicaRequest)(nil), "milvus.proto.query.DescribeReplicaRequest")
+	proto.RegisterType((*DescribeReplicaResponse)(nil), "milvus.proto.query.DescribeReplicaResponse")
+	proto.RegisterMapType((map[int64]int32)(nil), "milvus.proto.query.DescribeReplicaResponse.NodeSegmentNumEntry")
+	proto.RegisterType((*GetCollectionTargetsRequest)(nil), "milvus.proto.query.GetCollectionTargetsRequest")
+	proto.RegisterType((*TargetSnapshot)(nil), "milvus.proto.query.TargetSnapshot")
+	proto.RegisterType((*GetCollectionTargetsResponse)(nil), "milvus.proto.query.GetCollectionTargetsResponse")
+	proto.RegisterType((*DecommissionNodeRequest)(nil), "milvus.proto.query.DecommissionNodeRequest")
+	proto.RegisterType((*DecommissionProgress)(nil), "milvus.proto.query.DecommissionProgress")
+	proto.RegisterType((*DecommissionNodeResponse)(nil), "milvus.proto.query.DecommissionNodeResponse")
+	proto.RegisterType((*NodeDecommission)(nil), "milvus.proto.query.NodeDecommission")
+	proto.RegisterType((*GetDecommissionProgressRequest)(nil), "milvus.proto.query.GetDecommissionProgressRequest")
+	proto.RegisterType((*GetDecommissionProgressResponse)(nil), "milvus.proto.query.GetDecommissionProgressResponse")
+}
+
+func init() { proto.RegisterFile("query_coord.proto", fileDescriptor_aab7cc9a69ed26e8) }
+
+var fileDescriptor_aab7cc9a69ed26e8 = []byte{
+	// 11194 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5b, 0x8c, 0x1c, 0xd9,
+	0x75, 0x18, 0xab, 0x7b, 0x7a, 0xa6, 0xfb, 0x74, 0xf7, 0x4c, 0x4f, 0xcd, 0x83, 0xbd, 0xcd, 0xe7,
+	0x16, 0x97, 0x8f, 0xe5, 0xee, 0x0e, 0xb9, 0xdc, 0x5d, 0x69, 0xb5, 0xab, 0xb5, 0x44, 0xce, 0x90,
+	0x5c, 0xee, 0x92, 0xd4, 0xa4, 0x86, 0x5c, 0x09, 0xd2, 0x4a, 0xad, 0x9a, 0xee, 0x3b, 0x33, 0x25,
+	0x56, 0x57, 0x35, 0xab, 0xaa, 0xc9, 0x9d, 0x15, 0xe0, 0x44, 0x88, 0xf2, 0x70, 0x1c, 0xc5, 0x72,
+	0xe0, 0xc8, 0x8e, 0x6d, 0x38, 0xef, 0xc4, 0x09, 0x12, 0x24, 0x30, 0x12, 0x5b, 0x1f, 0x71, 0x60,
+	0x1b, 0x08, 0x84, 0xf8, 0x23, 0x48, 0x22, 0xeb, 0x2f, 0x0f, 0x20, 0xfe, 0x0a, 0x90, 0x8f, 0xe4,
+	0x23, 0x09, 0x02, 0xe4, 0x23, 0xb8, 0xcf, 0xba, 0xb7, 0xea, 0x56, 0x77, 0xcd, 0x34, 0x47, 0x2b,
+	0x05, 0xf9, 0xab, 0x3a, 0xf7, 0xdc, 0xf7, 0xbd, 0xe7, 0x9e, 0x7b, 0x5e, 0x17, 0x16, 0x1f, 0x8f,
+	0x50, 0xb8, 0xdf, 0xed, 0x05, 0x41, 0xd8, 0x5f, 0x1b, 0x86, 0x41, 0x1c, 0x98, 0xe6, 0xc0, 0xf5,
+	0x9e, 0x8c, 0x22, 0xfa, 0xb7, 0x46, 0xd2, 0x3b, 0x8d, 0x5e, 0x30, 0x18, 0x04, 0x3e, 0x85, 0x75,
+	0x1a, 0x32, 0x46, 0xa7, 0x1a, 0xee, 0xb2, 0xaf, 0x79, 0xd7, 0x8f, 0x51, 0xe8, 0x3b, 0x1e, 0xc7,
+	0x8b, 0x7a, 0x7b, 0x68, 0xe0, 0xb0, 0xbf, 0xda, 0x20, 0xe2, 0x88, 0xad, 0xbe, 0x13, 0x3b, 0x72,
+	0xa5, 0x9d, 0x45, 0xd7, 0xef, 0xa3, 0x8f, 0x64, 0x90, 0xf5, 0xdf, 0x0c, 0x58, 0xdd, 0xda, 0x0b,
+	0x9e, 0xae, 0x07, 0x9e, 0x87, 0x7a, 0xb1, 0x1b, 0xf8, 0x91, 0x8d, 0x1e, 0x8f, 0x50, 0x14, 0x9b,
+	0x57, 0x61, 0x66, 0xdb, 0x89, 0x50, 0xdb, 0x38, 0x6b, 0x5c, 0xaa, 0x5f, 0x3b, 0xb9, 0xa6, 0xb4,
+	0x98, 0x35, 0xf5, 0x5e, 0xb4, 0x7b, 0xc3, 0x89, 0x90, 0x4d, 0x30, 0x4d, 0x13, 0x66, 0xfa, 0xdb,
+	0x77, 0x36, 0xda, 0xa5, 0xb3, 0xc6, 0xa5, 0xb2, 0x4d, 0xbe, 0xcd, 0x17, 0xa0, 0xd9, 0x13, 0x65,
+	0xdf, 0xd9, 0x88, 0xda, 0xe5, 0xb3, 0xe5, 0x4b, 0x65, 0x5b, 0x05, 0x9a, 0x27, 0xa0, 0x36, 0x74,
+	0x76, 0x51, 0x37, 0x72, 0x3f, 0x46, 0xed, 0x19, 0x92, 0xbd, 0x8a, 0x01, 0x5b, 0xee, 0xc7, 0xc8,
+	0x3c, 0x05, 0x40, 0x12, 0xe3, 0xe0, 0x11, 0xf2, 0xdb, 0x95, 0xb3, 0xc6, 0xa5, 0x9a, 0x4d, 0xd0,
+	0x1f, 0x60, 0x80, 0xb9, 0x06, 0x4b, 0x4f, 0xdd, 0x78, 0xaf, 0x1b, 0xa2, 0xa1, 0xe7, 0xf6, 0x9c,
+	0x6e, 0x1f, 0xc5, 0x8e, 0xeb, 0xb5, 0x67, 0xcf, 0x1a, 0x97, 0xaa, 0xf6, 0x22, 0x4e, 0xb2, 0x69,
+	0xca, 0x06, 0x49, 0xb0, 0xfe, 0x65, 0x19, 0x8e, 0x67, 0xba, 0x1c, 0x0d, 0x03, 0x3f, 0x42, 0xe6,
+	0x6b, 0x30, 0x1b, 0xc5, 0x4e, 0x3c, 0x8a, 0x58, 0xaf, 0x4f, 0x68, 0x7b, 0xbd, 0x45, 0x50, 0x6c,
+	0x86, 0x9a, 0xed, 0x62, 0x49, 0xd7, 0xc5, 0x57, 0x61, 0xd9, 0xf5, 0xef, 0xa1, 0x41, 0x10, 0xee,
+	0x77, 0x87, 0x28, 0xec, 0x21, 0x3f, 0x76, 0x76, 0x11, 0x1f, 0x8f, 0x25, 0x9e, 0xb6, 0x99, 0x24,
+	0x99, 0x9f, 0x82, 0xe3, 0x74, 0xe5, 0x44, 0x28, 0x7c, 0xe2, 0xf6, 0x50, 0xd7, 0x79, 0xe2, 0xb8,
+	0x9e, 0xb3, 0xed, 0xe1, 0x31, 0x2a, 0x5f, 0xaa, 0xda, 0x2b, 0x24, 0x79, 0x8b, 0xa6, 0x5e, 0xe7,
+	0x89, 0xe6, 0x8b, 0xd0, 0x0a, 0xd1, 0x4e, 0x88, 0xa2, 0xbd, 0xee, 0x30, 0x0c, 0x76, 0x43, 0x14,
+	0x45, 0xed, 0x0a, 0xa9, 0x66, 0x81, 0xc1, 0x37, 0x19, 0xd8, 0xbc, 0x00, 0x0b, 0x3e, 0xfa, 0x28,
+	0xee, 0x4a, 0x03, 0x3c, 0x4b, 0x06, 0xb8, 0x89, 0xc1, 0x9b, 0x62, 0x90, 0xbf, 0x02, 0x4b, 0x7c,
+	0x7c, 0xe5, 0xc6, 0xcf, 0x9d, 0x2d, 0x5f, 0xaa, 0x5f, 0xbb, 0xbc, 0x96, 0x5d, 0xcd, 0x6b, 0x6c,
+	0xd0, 0xef, 0x06, 0x4e, 0x5f, 0xea, 0x93, 0x6d, 0xb2, 0x62, 0xe4, 0x7e, 0xbe, 0x0e, 0xab, 0x28,
+	0x8a, 0xdd, 0x81, 0x13, 0xa3, 0x7e, 0x37, 0x44, 0x03, 0xc7, 0xf5, 0x5d, 0x7f, 0xb7, 0x3b, 0x88,
+	0xda, 0x55, 0xd2, 0xea, 0x65, 0x91, 0x6a, 0xf3, 0xc4, 0x7b, 0x91, 0xf5, 0x3b, 0x06, 0xac, 0xea,
+	0x2b, 0x31, 0xbf, 0x0a, 0x75, 0xb9, 0x95, 0x06, 0x69, 0xe5, 0xdb, 0xc5, 0x5b, 0xb9, 0x26, 0x7d,
+	0xdf, 0xf4, 0xe3, 0x70, 0xdf, 0x96, 0xcb, 0xeb, 0xfc, 0x0c, 0xb4, 0xd2, 0x08, 0x66, 0x0b, 0xca,
+	0x8f, 0xd0, 0x3e, 0x59, 0x36, 0x65, 0x1b, 0x7f, 0x9a, 0xcb, 0x50, 0x79, 0xe2, 0x78, 0x23, 0xc4,
+	0xb6, 0x03, 0xfd, 0x79, 0xab, 0xf4, 0xa6, 0x61, 0xfd, 0x7c, 0x09, 0x56, 0xf0, 0x0a, 0xdc, 0x74,
+	0xc2, 0xd8, 0x3d, 0x82, 0x3d, 0x67, 0x41, 0x43, 0x5e, 0x7b, 0xed, 0x32, 0x49, 0x53, 0x60, 0x18,
+	0x67, 0xc8, 0xab, 0xc7, 0x6b, 0x76, 0x86, 0x8c, 0xb4, 0x02, 0x33, 0xaf, 0xc2, 0x32, 0xd9, 0x59,
+	0x3b, 0x8e, 0xeb, 0x8d, 0x42, 0xd4, 0x0d, 0x91, 0x13, 0x05, 0x7e, 0x44, 0xb6, 0x60, 0xd5, 0x36,
+	0x71, 0xda, 0x2d, 0x9a, 0x64, 0xd3, 0x14, 0xf3, 0x1a, 0xac, 0x90, 0x1c, 0xa2, 0x98, 0x2e, 0xdb,
+	0x4e, 0x74, 0x37, 0x92, 0x8d, 0x2a, 0x7a, 0x4d, 0xb7, 0x91, 0xf5, 0x1f, 0x4a, 0x94, 0x04, 0xc9,
+	0xa3, 0x31, 0xcd, 0x76, 0x4c, 0xf7, 0xac, 0xa4, 0xe9, 0xd9, 0x21, 0x36, 0xa3, 0x6e, 0x53, 0xcd,
+	0xe8, 0x37, 0xd5, 0x06, 0x54, 0xd9, 0x90, 0xd1, 0x7d, 0x57, 0xbf, 0x76, 0x49, 0xb7, 0xf6, 0x44,
+	0x87, 0xf1, 0xea, 0xe3, 0x03, 0x29, 0x72, 0x9a, 0xb7, 0xa0, 0xa5, 0x19, 0xc6, 0xf2, 0xa4, 0x61,
+	0x58, 0x18, 0xa6, 0xc6, 0xf7, 0x5b, 0x55, 0x58, 0xc1, 0x35, 0x24, 0xf4, 0xee, 0xc7, 0xbf, 0xda,
+	0xde, 0x81, 0x59, 0x7a, 0x4c, 0x11, 0xe2, 0x5e, 0xbf, 0x76, 0x5e, 0xad, 0x8b, 0xa6, 0xad, 0x25,
+	0x2d, 0xdc, 0x22, 0x00, 0x9b, 0x65, 0x32, 0xcf, 0xc3, 0x3c, 0xa7, 0x3e, 0xfe, 0x68, 0xb0, 0x8d,
+	0x42, 0xb2, 0x04, 0x2b, 0x76, 0x93, 0x41, 0xef, 0x13, 0xa0, 0xf9, 0x75, 0x68, 0xee, 0xb8, 0xc8,
+	0xeb, 0x77, 0xc9, 0x39, 0x77, 0x67, 0xa3, 0x3d, 0x9b, 0xbf, 0xf1, 0xb5, 0x23, 0xb2, 0x76, 0x0b,
+	0x67, 0xbf, 0x43, 0x73, 0xd3, 0x8d, 0xdf, 0xd8, 0x91, 0x40, 0x66, 0x1b, 0xe6, 0xd8, 0x64, 0xb7,
+	0xe7, 0xc8, 0x8a, 0xe6, 0xbf, 0xe6, 0x45, 0x58, 0x08, 0x51, 0x14, 0x8c, 0xc2, 0x1e, 0xea, 0xee,
+	0x86, 0xc1, 0x68, 0x48, 0x89, 0x57, 0xcd, 0x9e, 0xe7, 0xe0, 0xdb, 0x04, 0x6a, 0x9e, 0x81, 0xfa,
+	0x36, 0x8a, 0xe2, 0x2e, 0xda, 0xd9, 0x09, 0xc2, 0xb8, 0x5d, 0x23, 0xc5, 0x00, 0x06, 0xdd, 0x24,
+	0x10, 0x4c, 0x0d, 0xa3, 0xd8, 0xf1, 0xfb, 0xdb, 0xfb, 0xdd, 0x54, 0xa7, 0x81, 0x74, 0x7a, 0x99,
+	0xa5, 0xda, 0x4a, 0xdf, 0x3b, 0x50, 0x1d, 0x86, 0x6e, 0x10, 0xba, 0xf1, 0x7e, 0xbb, 0x4e, 0xf0,
+	0xc4, 0xbf, 0xb9, 0x0a, 0xb3, 0x31, 0xf2, 0x1d, 0x3f, 0x6e, 0x37, 0x09, 0x6d, 0x67, 0x7f, 0xf8,
+	0x60, 0x75, 0x46, 0x71, 0xd0, 0x0d, 0x51, 0x1c, 0xee, 0xb7, 0xe7, 0x49, 0x4b, 0x6a, 0x18, 0x62,
+	0x63, 0x80, 0xf9, 0x3c, 0x34, 0x9e, 0x3a, 0x6e, 0xdc, 0xe5, 0x3d, 0x5e, 0x20, 0x08, 0x75, 0x0c,
+	0xb3, 0x59, 0xaf, 0xef, 0xc3, 0xfc, 0xc7, 0x81, 0x8f, 0xba, 0x43, 0xcf, 0xe9, 0xa1, 0x01, 0xf2,
+	0xe3, 0x76, 0xeb, 0xac, 0x71, 0x69, 0xfe, 0xda, 0x45, 0xdd, 0x90, 0x7f, 0x39, 0xf0, 0xd1, 0x26,
+	0x47, 0xdc, 0x0c, 0x3c, 0xb7, 0xb7, 0x6f, 0x37, 0x3f, 0x96, 0x81, 0x78, 0xa2, 0xb7, 0x1d, 0xcf,
+	0xf1, 0x7b, 0xa8, 0x3b, 0x24, 0x08, 0xed, 0x45, 0x7a, 0x1a, 0x31, 0x28, 0xcd, 0x65, 0x0e, 0xc0,
+	0x8c, 0xd0, 0x2e, 0xce, 0xd1, 0xf5, 0x83, 0x3e, 0xea, 0xee, 0xb9, 0x7e, 0x1c, 0xb5, 0x4d, 0x32,
+	0xdb, 0x9f, 0x2b, 0x3e, 0xdb, 0x5b, 0xb4, 0x8c, 0xfb, 0x41, 0x1f, 0xbd, 0x8b, 0x4b, 0xa0, 0x33,
+	0xde, 0x8a, 0x52, 0x60, 0x32, 0x23, 0xc3, 0x10, 0x39, 0x7d, 0x3e, 0x21, 0x51, 0x17, 0x3d, 0x41,
+	0xbe, 0xb7, 0xdf, 0x5e, 0x22, 0x43, 0xb2, 0x4c, 0x53, 0xd9, 0x84, 0x44, 0x37, 0x49, 0x5a, 0xe7,
+	0x73, 0xb0, 0x98, 0x59, 0x4e, 0x07, 0x39, 0x26, 0x3a, 0xeb, 0xb0, 0xa2, 0x6d, 0xe1, 0x41, 0x0a,
+	0x79, 0x6f, 0xa6, 0xda, 0x68, 0x35, 0xad, 0x1f, 0x19, 0xd0, 0xb6, 0x91, 0x87, 0x9c, 0x08, 0x7d,
+	0x92, 0x64, 0x60, 0x15, 0x66, 0xf1, 0x7c, 0xdd, 0xd9, 0x60, 0x3c, 0x1e, 0xfb, 0x33, 0x3f, 0x0d,
+	0xed, 0x9d, 0x00, 0xef, 0x9c, 0x90, 0xb6, 0xb1, 0x1b, 0xbb, 0x03, 0x14, 0x8c, 0x62, 0xcc, 0x02,
+	0x54, 0x08, 0xe6, 0x0a, 0x49, 0x67, 0x5d, 0x78, 0x40, 0x53, 0xef, 0x45, 0xd6, 0xff, 0x36, 0x60,
+	0xf9, 0x36, 0x8a, 0x31, 0xa5, 0x73, 0xa3, 0xd8, 0xed, 0x89, 0x83, 0xf4, 0x1d, 0x28, 0x87, 0xe8,
+	0x31, 0xeb, 0xd2, 0x4b, 0x6a, 0x97, 0x04, 0x03, 0xad, 0xcb, 0x69, 0xe3, 0x7c, 0x78, 0xe9, 0xf7,
+	0x07, 0x5e, 0xb7, 0xb7, 0xe7, 0xf8, 0x3e, 0xf2, 0xe8, 0x19, 0x52, 0xb3, 0xeb, 0xfd, 0x81, 0xb7,
+	0xce, 0x40, 0xe6, 0x69, 0x00, 0xb6, 0x50, 0x12, 0xae, 0x56, 0x82, 0x98, 0x97, 0x61, 0x71, 0x27,
+	0x0c, 0x06, 0xdd, 0x68, 0xcf, 0x09, 0xfb, 0x5d, 0x0f, 0x39, 0x7d, 0x14, 0x92, 0x6e, 0x57, 0xed,
+	0x05, 0x9c, 0xb0, 0x85, 0xe1, 0x77, 0x09, 0xd8, 0x7c, 0x0d, 0x2a, 0x51, 0x2f, 0x18, 0x22, 0xd2,
+	0xd9, 0xf9, 0x6b, 0xa7, 0x74, 0x4b, 0x78, 0xc3, 0x89, 0x9d, 0x2d, 0x8c, 0x64, 0x53, 0x5c, 0xeb,
+	0x7b, 0x15, 0x4a, 0xd7, 0x7f, 0xd2, 0xb9, 0x88, 0x84, 0xf6, 0x57, 0x9e, 0x0d, 0xed, 0x9f, 0x2d,
+	0x44, 0xfb, 0xe7, 0xc6, 0xd3, 0xfe, 0xcc, 0xa8, 0x1d, 0x84, 0xf6, 0x57, 0x27, 0xd2, 0xfe, 0x9a,
+	0x96, 0xf6, 0xdf, 0x84, 0x05, 0x7a, 0x05, 0x73, 0xfd, 0x9d, 0xa0, 0xeb, 0xb9, 0x51, 0xdc, 0x06,
+	0xd2, 0xcc, 0x53, 0xe9, 0x15, 0xda, 0x47, 0x1f, 0xad, 0xd1, 0x8a, 0xfd, 0x9d, 0xc0, 0x6e, 0xba,
+	0xfc, 0xf3, 0xae, 0x1b, 0xa5, 0xe9, 0x76, 0x7d, 0x12, 0xdd, 0x6e, 0x64, 0xe9, 0xb6, 0x7c, 0x5a,
+	0x34, 0xd5, 0xd3, 0x62, 0x6a, 0xba, 0x65, 0xfd, 0x5e, 0x42, 0x6c, 0x7e, 0xd2, 0xd7, 0x66, 0x42,
+	0x90, 0x2a, 0x32, 0x41, 0xb2, 0xfe, 0xbe, 0x01, 0xcf, 0xdd, 0x46, 0xb1, 0xc2, 0xaa, 0xa2, 0x9f,
+	0xcc, 0x3e, 0x58, 0xff, 0xc8, 0x80, 0x8e, 0xae, 0xad, 0xd3, 0xf0, 0xd0, 0x5f, 0x86, 0xd5, 0x84,
+	0xf7, 0xec, 0xa3, 0xa8, 0x17, 0xba, 0x43, 0xfc, 0x4d, 0x29, 0x61, 0xfd, 0xda, 0xb9, 0xb1, 0xfc,
+	0x2c, 0x6b, 0xc1, 0x8a, 0x28, 0x62, 0x43, 0x2a, 0xc1, 0xfa, 0x7b, 0x06, 0xac, 0x60, 0xca, 0xcb,
+	0x48, 0x25, 0x5e, 0xdf, 0x87, 0x1e, 0x57, 0x95, 0x08, 0x97, 0x32, 0x44, 0xb8, 0xc8, 0x18, 0xb7,
+	0x61, 0x8e, 0xd1, 0x79, 0x42, 0x9e, 0x6b, 0x36, 0xff, 0xb5, 0xbe, 0x6d, 0xc0, 0x6a, 0xba, 0xa5,
+	0xd3, 0x8c, 0xea, 0x1b, 0x50, 0xc1, 0x1b, 0x9f, 0x0f, 0xe2, 0x19, 0xdd, 0x20, 0xca, 0x95, 0x51,
+	0x6c, 0xeb, 0xdf, 0x94, 0x69, 0x33, 0x92, 0x03, 0x63, 0x8a, 0x95, 0x98, 0x1e, 0x91, 0x92, 0x66,
+	0x44, 0xce, 0x83, 0x20, 0x5c, 0x94, 0x9e, 0x91, 0x71, 0xab, 0xd9, 0x4d, 0x0e, 0x25, 0xe4, 0x0c,
+	0x73, 0xb2, 0xc3, 0x10, 0xed, 0xa0, 0xb0, 0x8b, 0x99, 0x38, 0x36, 0x78, 0x40, 0x41, 0x98, 0xd7,
+	0x13, 0x84, 0x88, 0x9d, 0xe6, 0x6c, 0x8f, 0x11, 0x42, 0xc4, 0x8e, 0x70, 0xcc, 0x5a, 0x91, 0x0b,
+	0xe3, 0x6e, 0x18, 0x3c, 0xc5, 0x77, 0x7e, 0x42, 0x9e, 0x7c, 0x7c, 0xb7, 0xa2, 0x37, 0x46, 0x72,
+	0x01, 0xbd, 0x4d, 0x13, 0x6f, 0xf1, 0x34, 0xf3, 0x1d, 0x38, 0xc1, 0x44, 0x3e, 0x4e, 0x1f, 0x4b,
+	0x3c, 0x04, 0xa3, 0xdc, 0x0b, 0x46, 0x7e, 0xcc, 0x58, 0xf3, 0x36, 0x15, 0xfd, 0x50, 0x0c, 0xc6,
+	0x9b, 0xad, 0xe3, 0x74, 0xf3, 0x65, 0x20, 0x77, 0x57, 0x76, 0x28, 0x77, 0x51, 0x18, 0x06, 0x61,
+	0xc4, 0x88, 0x7a, 0x0b, 0xa7, 0xd0, 0x51, 0xbe, 0x49, 0xe0, 0xe6, 0x49, 0xa8, 0xb1, 0xe2, 0xef,
+	0x6c, 0x10, 0x76, 0xbd, 0x6c, 0x27, 0x00, 0xdc, 0x01, 0xc7, 0xf3, 0x82, 0xa7, 0xdd, 0x6d, 0x2f,
+	0xe8, 0x3d, 0x42, 0xfd, 0x84, 0x67, 0x00, 0xda, 0x01, 0x92, 0x7a, 0x83, 0x26, 0x72, 0xe6, 0xc1,
+	0xfa, 0x51, 0x09, 0x8e, 0x67, 0xa6, 0x74, 0x9a, 0xa5, 0xf5, 0x59, 0x98, 0x25, 0x8c, 0x06, 0x5f,
+	0x5b, 0x2f, 0x68, 0xd7, 0x96, 0x54, 0x1d, 0x3e, 0x48, 0x6c, 0x96, 0x07, 0x5f, 0x87, 0x47, 0xbe,
+	0x10, 0x2e, 0x25, 0x5d, 0x98, 0x21, 0xa7, 0xd8, 0x92, 0x94, 0x26, 0xd8, 0x9f, 0x57, 0xc0, 0x0c,
+	0x83, 0x51, 0x8c, 0xe7, 0x6c, 0x17, 0xf9, 0x28, 0x74, 0xf0, 0xda, 0x61, 0x33, 0xbc, 0xc8, 0x52,
+	0x6e, 0x8b, 0x04, 0x7c, 0x7b, 0xce, 0x0c, 0xd0, 0x2c, 0x29, 0x7d, 0x61, 0x5b, 0x1d, 0x1b, 0x3c,
+	0xa2, 0x63, 0xe6, 0xb5, 0x62, 0x2f, 0x87, 0x9a, 0x39, 0x7d, 0x6f, 0xa6, 0x5a, 0x6e, 0xcd, 0x58,
+	0x7f, 0xb7, 0x04, 0x27, 0x1e, 0x0e, 0xfb, 0x4e, 0x8c, 0x6c, 0xe5, 0xe4, 0x3d, 0xfc, 0x7e, 0xf1,
+	0xb2, 0x67, 0x3b, 0x1d, 0xe1, 0x75, 0xdd, 0x08, 0x8f, 0xa9, 0x7b, 0x4d, 0x85, 0x52, 0x0e, 0x23,
+	0xc5, 0x20, 0x74, 0x76, 0x61, 0x49, 0x83, 0x26, 0x9f, 0xbe, 0x35, 0x7a, 0xfa, 0xbe, 0x25, 0x9f,
+	0xbe, 0x99, 0xe9, 0x0e, 0x77, 0xd5, 0xda, 0xd6, 0x03, 0x7f, 0xc7, 0xdd, 0x95, 0xcf, 0xe8, 0xbf,
+	0x5e, 0x86, 0x56, 0x7a, 0x39, 0xe0, 0xfd, 0xca, 0x26, 0xa7, 0xeb, 0x3b, 0x03, 0xc4, 0xea, 0xab,
+	0x33, 0xd8, 0x7d, 0x67, 0x80, 0xcc, 0xe7, 0xa0, 0x4a, 0x6e, 0x5c, 0x6e, 0x9f, 0x93, 0xdb, 0x39,
+	0xfc, 0x7f, 0xa7, 0x1f, 0x61, 0xae, 0x84, 0x24, 0x39, 0xfd, 0x7e, 0x48, 0x19, 0xe2, 0x9a, 0x5d,
+	0xc3, 0x90, 0xeb, 0x18, 0x60, 0x9e, 0x03, 0x72, 0xd7, 0xeb, 0xee, 0x38, 0x9e, 0xb7, 0xed, 0xf4,
+	0x1e, 0x31, 0x5e, 0xb8, 0x81, 0x81, 0xb7, 0x18, 0xcc, 0xbc, 0x04, 0x2d, 0x4e, 0x09, 0xc2, 0xe0,
+	0x29, 0x66, 0xf8, 0xb8, 0xe4, 0x72, 0x9e, 0xc1, 0xed, 0xe0, 0xe9, 0xfd, 0xd1, 0x80, 0xac, 0x3f,
+	0x8e, 0x89, 0xc9, 0x4b, 0x14, 0x3b, 0x83, 0x21, 0x5d, 0x52, 0x33, 0xf6, 0x22, 0x4b, 0x79, 0x20,
+	0x12, 0x0e, 0xb7, 0xa8, 0xcc, 0xf7, 0xa1, 0x99, 0xa6, 0x11, 0x78, 0xea, 0x2f, 0x68, 0x99, 0x4a,
+	0x82, 0x48, 0x64, 0xb1, 0xfe, 0x2e, 0x21, 0x1d, 0x76, 0xc3, 0x93, 0xe9, 0xc8, 0x1a, 0x2c, 0xf1,
+	0x4a, 0x38, 0xe5, 0xf1, 0x47, 0x03, 0x42, 0x51, 0x2a, 0xf6, 0x22, 0x4f, 0xa2, 0xc5, 0xdc, 0x1f,
+	0x0d, 0xac, 0x6d, 0x30, 0xb3, 0x65, 0x4a, 0x1c, 0x8b, 0xa1, 0x5c, 0xa1, 0x56, 0x61, 0x96, 0x8a,
+	0xe7, 0xc8, 0x8a, 0xa8, 0xd9, 0xec, 0x0f, 0x53, 0x2f, 0x31, 0x3e, 0xec, 0xf8, 0x4b, 0x00, 0xd6,
+	0xaf, 0x18, 0x70, 0x7a, 0x6b, 0xdf, 0xef, 0xdd, 0x47, 0x4f, 0xd7, 0x43, 0x84, 0x25, 0xac, 0xe2,
+	0x10, 0x3f, 0xda, 0x23, 0xe6, 0x2c, 0xd4, 0x25, 0x26, 0x86, 0x35, 0x4c, 0x06, 0x59, 0xff, 0xd3,
+	0x80, 0x06, 0x66, 0xd4, 0xef, 0xa1, 0xd8, 0xc1, 0xa7, 0xa1, 0xf9, 0x19, 0xa8, 0x79, 0x81, 0xd3,
+	0xef, 0xc6, 0xfb, 0x43, 0xda, 0x9a, 0xf9, 0x6b, 0x27, 0xb5, 0x13, 0x11, 0x38, 0xfd, 0x07, 0xfb,
+	0x43, 0x64, 0x57, 0x3d, 0xf6, 0x55, 0xa8, 0x45, 0x69, 0x56, 0xab, 0xac, 0x61, 0x17, 0xcf, 0x41,
+	0x7d, 0x80, 0xe2, 0xd0, 0xed, 0xd1, 0x46, 0x90, 0x13, 0xef, 0x46, 0xa9, 0x6d, 0xd8, 0x40, 0xc1,
+	0xa4, 0xb2, 0xe3, 0x30, 0xd7, 0xdf, 0xa6, 0x1b, 0x88, 0xea, 0x2a, 0x66, 0xfb, 0xdb, 0x64, 0xef,
+	0x64, 0x8f, 0xd5, 0x59, 0xcd, 0xb1, 0x6a, 0x7d, 0x67, 0x16, 0x56, 0xbf, 0xe8, 0xc4, 0xbd, 0xbd,
+	0x8d, 0x01, 0xa7, 0x89, 0x87, 0x9f, 0x8b, 0x64, 0xb9, 0x94, 0x94, 0xe5, 0xf2, 0xac, 0x18, 0x68,
+	0xc1, 0xd2, 0x54, 0x74, 0x2c, 0x0d, 0xd6, 0x40, 0xad, 0x7d, 0xc0, 0xe8, 0x87, 0xc4, 0xd2, 0x48,
+	0x77, 0xc2, 0xd9, 0xc3, 0xdc, 0x09, 0xd7, 0xa1, 0x89, 0x3e, 0xea, 0x79, 0x23, 0x4c, 0x88, 0x48,
+	0xed, 0xf4, 0xb2, 0x77, 0x5a, 0x53, 0xbb, 0xcc, 0x4f, 0x35, 0x58, 0xa6, 0x3b, 0xac, 0x0d, 0x74,
+	0x3d, 0x0d, 0x50, 0xec, 0x90, 0xc3, 0xbf, 0x7e, 0xed, 0x6c, 0xde, 0x7a, 0xe2, 0x8b, 0x90, 0xae,
+	0x29, 0xfc, 0x37, 0x81, 0x2d, 0x70, 0xa0, 0xc9, 0x25, 0x54, 0xb4, 0x85, 0xf4, 0x9e, 0xf7, 0x59,
+	0x5d, 0x05, 0xfa, 0xc9, 0x96, 0x5b, 0xce, 0x4e, 0x8b, 0x46, 0x24, 0x81, 0xb0, 0xda, 0x29, 0xd8,
+	0xd9, 0xf1, 0x5c, 0x1f, 0xdd, 0xa7, 0x33, 0x5c, 0x27, 0x8d, 0x50, 0x81, 0x98, 0xbb, 0x7d, 0x82,
+	0xc2, 0x08, 0x1f, 0xce, 0x0d, 0x92, 0xce, 0x7f, 0x75, 0x97, 0xd1, 0xe6, 0xc1, 0x2f, 0xa3, 0x9d,
+	0x2e, 0x2c, 0x66, 0x5a, 0xaa, 0xb9, 0x2e, 0xbe, 0xae, 0x1e, 0x58, 0x93, 0xa6, 0x4a, 0x3a, 0xaa,
+	0x7e, 0xd3, 0x80, 0x95, 0x87, 0x7e, 0x34, 0xda, 0x16, 0x43, 0xf4, 0xc9, 0x6c, 0x87, 0xf4, 0xe9,
+	0x38, 0x93, 0x39, 0x1d, 0xad, 0x1f, 0xce, 0xc2, 0x02, 0xeb, 0x05, 0x5e, 0x35, 0x84, 0x6c, 0x9d,
+	0x84, 0x9a, 0xb8, 0x90, 0xb0, 0x01, 0x49, 0x00, 0x69, 0x3a, 0x58, 0xca, 0xd0, 0xc1, 0x42, 0x4d,
+	0xe3, 0xd7, 0xcb, 0x19, 0xe9, 0x7a, 0x79, 0x0a, 0x60, 0xc7, 0x1b, 0x45, 0x7b, 0xe4, 0x78, 0x64,
+	0x8c, 0x59, 0x8d, 0x40, 0xf0, 0xb1, 0x68, 0x5e, 0x87, 0xc6, 0xb6, 0xeb, 0x7b, 0xc1, 0x6e, 0x77,
+	0xe8, 0xc4, 0x7b, 0x5c, 0xb3, 0xa0, 0x9b, 0x16, 0x22, 0x0c, 0xb8, 0x41, 0x70, 0xed, 0x3a, 0xcd,
+	0xb3, 0x89, 0xb3, 0x98, 0xa7, 0xa1, 0xee, 0x8f, 0x06, 0xdd, 0x60, 0x07, 0x9f, 0xd5, 0x11, 0x39,
+	0x48, 0xcb, 0x76, 0xcd, 0x1f, 0x0d, 0xbe, 0xb0, 0x63, 0x07, 0x4f, 0x31, 0x4f, 0x5a, 0x8b, 0x62,
+	0x27, 0x8e, 0xbc, 0x60, 0x97, 0x9f, 0x9c, 0x93, 0xca, 0x4f, 0x32, 0xe0, 0xdc, 0x7d, 0xe4, 0xc5,
+	0x0e, 0xc9, 0x5d, 0x2b, 0x96, 0x5b, 0x64, 0x30, 0x2f, 0xc0, 0x7c, 0x2f, 0x18, 0x0c, 0x1d, 0x32,
+	0x42, 0xb7, 0xc2, 0x60, 0x40, 0x36, 0x60, 0xd9, 0x4e, 0x41, 0xcd, 0x75, 0xa8, 0x27, 0x9b, 0x20,
+	0x6a, 0xd7, 0x49, 0x3d, 0x96, 0x6e, 0x97, 0x4a, 0x32, 0x11, 0xbc, 0x40, 0x41, 0xec, 0x82, 0x08,
+	0xaf, 0x0c, 0xbe, 0xd9, 0x89, 0x02, 0x9b, 0x6e, 0xb4, 0x3a, 0x83, 0x11, 0x1d, 0xf6, 0x79, 0x98,
+	0x77, 0xfd, 0x08, 0x85, 0x31, 0x67, 0x7f, 0x99, 0x28, 0xbe, 0x49, 0xa1, 0x6c, 0x61, 0x9b, 0x1b,
+	0x30, 0x1f, 0xc5, 0x4e, 0x18, 0x77, 0x87, 0x41, 0x44, 0x16, 0x00, 0x91, 0xca, 0x67, 0xb6, 0x24,
+	0x56, 0xf2, 0xdf, 0x8b, 0x76, 0x37, 0x19, 0x92, 0xdd, 0x24, 0x99, 0xf8, 0x2f, 0x2e, 0x85, 0x8c,
+	0x44, 0x52, 0xca, 0x42, 0xa1, 0x52, 0x48, 0x26, 0x51, 0xca, 0x25, 0x58, 0xe0, 0x4c, 0xc9, 0x07,
+	0x8c, 0x82, 0xb4, 0x48, 0xc7, 0xd2, 0x60, 0x7c, 0x08, 0x78, 0xe8, 0x09, 0xf2, 0x88, 0xb0, 0x7e,
+	0x5e, 0x7b, 0x08, 0xf0, 0x5d, 0x81, 0xd1, 0x6c, 0x8a, 0x8d, 0xe7, 0x28, 0x8a, 0x83, 0xd0, 0xd9,
+	0x15, 0xe5, 0x9b, 0xa4, 0xfc, 0x14, 0xd4, 0xfa, 0x61, 0x19, 0xe6, 0xd5, 0xd1, 0xc7, 0x54, 0x8d,
+	0xca, 0xe6, 0xf8, 0x96, 0xe2, 0xbf, 0x78, 0x2e, 0x90, 0x4f, 0x78, 0x2c, 0x32, 0x41, 0x64, 0x47,
+	0x55, 0xed, 0x3a, 0x85, 0x91, 0x02, 0xf0, 0xce, 0xa0, 0x73, 0x4e, 0xb6, 0x31, 0xbd, 0xda, 0xd6,
+	0x08, 0x84, 0x1c, 0xd3, 0x6d, 0x98, 0xe3, 0x32, 0x44, 0xba, 0x9f, 0xf8, 0x2f, 0x4e, 0xd9, 0x1e,
+	0xb9, 0xa4, 0x56, 0xba, 0x9f, 0xf8, 0xaf, 0xb9, 0x01, 0x0d, 0x5a, 0xe4, 0xd0, 0x09, 0x9d, 0x01,
+	0xdf, 0x4d, 0xcf, 0x6b, 0x29, 0xd2, 0xfb, 0x68, 0xff, 0x03, 0x4c, 0xdc, 0x36, 0x1d, 0x37, 0xb4,
+	0xe9, 0xea, 0xdb, 0x24, 0xb9, 0x30, 0xf7, 0x4b, 0x4b, 0xd9, 0x71, 0x3d, 0xc4, 0xf6, 0xe5, 0x1c,
+	0x15, 0x24, 0x12, 0xf8, 0x2d, 0xd7, 0x43, 0x74, 0xeb, 0x89, 0x2e, 0x90, 0xf5, 0x56, 0xa5, 0x3b,
+	0x8f, 0x40, 0xc8, 0x6a, 0x3b, 0x07, 0x94, 0x48, 0x77, 0x39, 0xe9, 0xa7, 0xe7, 0x13, 0x6d, 0x23,
+	0x9f, 0x35, 0xcc, 0xca, 0x8f, 0x06, 0x74, 0xef, 0x02, 0xed, 0x8e, 0x3f, 0x1a, 0x90, 0x9d, 0x7b,
+	0x0d, 0x56, 0x7a, 0xa3, 0x30, 0xa4, 0xa7, 0x97, 0x5c, 0x0e, 0xd5, 0x2c, 0x2d, 0xb1, 0xc4, 0x3b,
+	0x72, 0x71, 0x6b, 0xb0, 0xc4, 0x9a, 0x14, 0x07, 0x21, 0xea, 0xaa, 0x87, 0x0e, 0xb5, 0x3c, 0xd9,
+	0xc2, 0x29, 0x7c, 0x56, 0xff, 0x71, 0x05, 0x96, 0x30, 0x91, 0x64, 0x2b, 0x63, 0x0a, 0x1e, 0xe7,
+	0x14, 0x40, 0x3f, 0xa2, 0x9a, 0x20, 0x41, 0x42, 0x6b, 0xfd, 0x28, 0x66, 0x27, 0xe0, 0x67, 0x38,
+	0x8b, 0x52, 0xce, 0x17, 0x5d, 0xa5, 0x88, 0x76, 0x96, 0x4d, 0x39, 0x94, 0xda, 0xf2, 0x1c, 0x34,
+	0x19, 0xbb, 0xa7, 0x08, 0x19, 0x1b, 0x14, 0x78, 0x5f, 0x7f, 0xf4, 0xcc, 0x6a, 0xd5, 0xa7, 0x12,
+	0xab, 0x32, 0x37, 0x1d, 0xab, 0x52, 0x4d, 0xb3, 0x2a, 0xb7, 0x60, 0x41, 0xa5, 0x16, 0x9c, 0xdc,
+	0x4e, 0x20, 0x17, 0xf3, 0x0a, 0xb9, 0x88, 0x64, 0x4e, 0x03, 0x54, 0x4e, 0xe3, 0x1c, 0x34, 0x7d,
+	0x84, 0xfa, 0xdd, 0x38, 0x74, 0xfc, 0x68, 0x07, 0x85, 0x4c, 0x64, 0xdd, 0xc0, 0xc0, 0x07, 0x0c,
+	0x66, 0x7e, 0x16, 0x80, 0xf4, 0x91, 0x2a, 0x42, 0x1a, 0xf9, 0x8a, 0x10, 0xb2, 0x68, 0x30, 0x92,
+	0x5d, 0xf3, 0xf8, 0xe7, 0x33, 0x62, 0x66, 0xb0, 0x1d, 0x92, 0xe7, 0x7c, 0xbc, 0xdf, 0xc5, 0x05,
+	0x33, 0x85, 0x68, 0x15, 0x03, 0x70, 0x9d, 0xd6, 0x77, 0xca, 0xb0, 0xca, 0xe4, 0xda, 0xd3, 0x2f,
+	0xda, 0x3c, 0x4e, 0x84, 0x1f, 0xe5, 0xe5, 0x31, 0x92, 0xe2, 0x99, 0x02, 0xcc, 0x7a, 0x45, 0xc3,
+	0xac, 0xab, 0xd2, 0xd2, 0xd9, 0x8c, 0xb4, 0x54, 0xa8, 0xa1, 0xe6, 0x8a, 0xab, 0xa1, 0xb0, 0x1e,
+	0x80, 0x48, 0x91, 0xc8, 0xc2, 0xaa, 0xd9, 0xf4, 0xa7, 0xd8, 0x94, 0xbf, 0x03, 0xd0, 0xdb, 0x43,
+	0xbd, 0x47, 0xc3, 0xc0, 0xf5, 0x63, 0x32, 0xe5, 0x13, 0x17, 0x9d, 0x94, 0xc1, 0xfa, 0xe5, 0x12,
+	0x34, 0xb7, 0x90, 0x13, 0xf6, 0xf6, 0xf8, 0x34, 0x7c, 0x4a, 0xd6, 0xfa, 0xbd, 0x90, 0xa3, 0xf5,
+	0x53, 0xb2, 0xfc, 0xd4, 0xa8, 0xfb, 0x70, 0x05, 0x71, 0x10, 0x3b, 0xa2, 0x95, 0x44, 0x78, 0x40,
+	0x55, 0x61, 0x0b, 0x24, 0x81, 0x35, 0x15, 0x8b, 0x0e, 0xfe, 0xab, 0x01, 0x8d, 0x3f, 0x81, 0x8b,
+	0xe1, 0x03, 0xf3, 0xa6, 0x3c, 0x30, 0x17, 0x72, 0x06, 0xc6, 0xc6, 0x77, 0x58, 0xf4, 0x04, 0xfd,
+	0xd4, 0x69, 0x42, 0x7f, 0x60, 0x40, 0x07, 0x4b, 0x31, 0x98, 0xec, 0x66, 0xfa, 0xcd, 0x79, 0x0e,
+	0x9a, 0x4f, 0x14, 0x5e, 0x9f, 0xca, 0x54, 0x1a, 0x4f, 0x64, 0x51, 0x98, 0x8d, 0x0d, 0x82, 0xa8,
+	0x24, 0x89, 0x75, 0x96, 0x1f, 0x31, 0x17, 0xc7, 0x58, 0x9a, 0xf1, 0xc6, 0x11, 0xea, 0xb3, 0x10,
+	0xaa, 0x40, 0xeb, 0x2f, 0x19, 0x58, 0x00, 0x98, 0x41, 0xc4, 0x32, 0x05, 0x26, 0x76, 0x53, 0xc4,
+	0x3e, 0x7d, 0x3c, 0x3d, 0x89, 0xa2, 0xc6, 0xed, 0x67, 0x2f, 0x10, 0x7d, 0x2c, 0xa6, 0x17, 0x57,
+	0xd1, 0x7e, 0x66, 0x7e, 0xfa, 0x11, 0x56, 0x06, 0x32, 0x4a, 0xcd, 0xef, 0xf8, 0xe2, 0xdf, 0x7a,
+	0x04, 0xe6, 0x6d, 0x94, 0x9c, 0x8b, 0xd3, 0x8c, 0x68, 0x42, 0xae, 0x92, 0x86, 0xca, 0x34, 0xac,
+	0x6f, 0xfd, 0xed, 0x32, 0x2c, 0x29, 0xb5, 0x4d, 0x23, 0x11, 0x4f, 0xce, 0xee, 0xd2, 0x61, 0xce,
+	0x6e, 0x45, 0xda, 0x54, 0x3e, 0x90, 0xb4, 0xe9, 0x34, 0x80, 0x18, 0x7f, 0x3e, 0xa2, 0x12, 0x04,
+	0xab, 0x8b, 0x49, 0xd1, 0x89, 0xe1, 0x19, 0x33, 0x67, 0x9a, 0xf7, 0x14, 0x33, 0xc4, 0xa2, 0xaa,
+	0x6f, 0x8d, 0xfa, 0x79, 0x4e, 0xab, 0x7e, 0xd6, 0x99, 0xb0, 0x55, 0x39, 0x4b, 0xaf, 0x9a, 0xb0,
+	0x75, 0xa0, 0xca, 0xb9, 0x7c, 0x66, 0xa2, 0x24, 0xfe, 0xad, 0x7f, 0x6e, 0xc0, 0xea, 0xbb, 0x8e,
+	0xdf, 0x0f, 0x76, 0x76, 0xa6, 0xdf, 0x6a, 0xeb, 0xa0, 0x48, 0x35, 0x8a, 0xaa, 0xc6, 0x94, 0x4c,
+	0xe6, 0x4b, 0xb0, 0xc8, 0x2c, 0x47, 0xfa, 0xea, 0x5e, 0x2c, 0xdb, 0x2d, 0x9e, 0x20, 0xf6, 0xd8,
+	0x2f, 0x94, 0xc1, 0xc4, 0xb3, 0x76, 0x83, 0x9a, 0x14, 0x1d, 0xbe, 0xe9, 0xe7, 0x61, 0x5e, 0x61,
+	0xef, 0x84, 0xe1, 0xaf, 0xcc, 0xdf, 0x45, 0xe6, 0xfb, 0x89, 0x4d, 0x13, 0x93, 0xd0, 0xd2, 0xe5,
+	0xa4, 0x55, 0xd1, 0x3c, 0x08, 0xdd, 0xdd, 0x5d, 0x14, 0xae, 0x07, 0x7e, 0x9f, 0x5d, 0xca, 0xb6,
+	0x79, 0x33, 0x71, 0x56, 0xbc, 0x99, 0x13, 0x5e, 0x57, 0x2c, 0x2e, 0xc1, 0xec, 0x92, 0xa1, 0x88,
+	0x90, 0xe3, 0x25, 0x03, 0x91, 0x30, 0x03, 0x2d, 0x9a, 0xb0, 0x95, 0xaf, 0x1e, 0xd5, 0xf1, 0x9e,
+	0x58, 0x73, 0xc3, 0x9a, 0x2f, 0x0e, 0x01, 0xaa, 0x60, 0x5b, 0x60, 0x70, 0x71, 0x10, 0xa4, 0x84,
+	0x19, 0xd5, 0xac, 0x30, 0x63, 0x19, 0x2a, 0x4e, 0xb4, 0xef, 0xf7, 0xd8, 0x9a, 0xa2, 0x3f, 0xd6,
+	0x3f, 0x35, 0xc0, 0x14, 0xc2, 0x1d, 0x22, 0x0d, 0x23, 0x44, 0x2f, 0xdd, 0x3a, 0x43, 0xd3, 0xba,
+	0x93, 0x50, 0xeb, 0xf3, 0x9c, 0x8c, 0x4a, 0x27, 0x00, 0xc2, 0x85, 0x90, 0x71, 0x21, 0x0c, 0x1d,
+	0xea, 0x73, 0xe1, 0x09, 0x05, 0xde, 0x25, 0x30, 0x95, 0x3b, 0x9e, 0x49, 0x73, 0xc7, 0xb2, 0xc2,
+	0xa3, 0xa2, 0x28, 0x3c, 0xac, 0xdf, 0x2c, 0x41, 0x8b, 0x9c, 0xb2, 0xeb, 0x89, 0x80, 0xb3, 0x50,
+	0xa3, 0xcf, 0x41, 0x93, 0x79, 0x04, 0x28, 0x0d, 0x6f, 0x3c, 0x96, 0x0a, 0xc3, 0xc6, 0xb7, 0x14,
+	0x29, 0x44, 0xd1, 0xc8, 0x4b, 0xe4, 0x06, 0xf4, 0xbe, 0x6a, 0x3e, 0xa6, 0xc7, 0x3b, 0x4e, 0xe2,
+	0x39, 0x1e, 0xc2, 0xea, 0xae, 0x17, 0x6c, 0x3b, 0x5e, 0x57, 0x5d, 0x01, 0x74, 0x99, 0x14, 0xd8,
+	0x54, 0xcb, 0x34, 0xfb, 0x96, 0xbc, 0x4c, 0x22, 0xf3, 0x06, 0x16, 0x65, 0xa2, 0x47, 0x89, 0x30,
+	0xa1, 0x52, 0x84, 0x51, 0x6b, 0xe0, 0x3c, 0xfc, 0xcf, 0xfa, 0x0d, 0x03, 0x16, 0x52, 0xe6, 0x01,
+	0xe9, 0xd5, 0x62, 0x64, 0x57, 0xcb, 0x9b, 0x50, 0xc1, 0xc4, 0x9c, 0x1e, 0xbf, 0xf3, 0x7a, 0xb1,
+	0x8c, 0x5a, 0xaa, 0x4d, 0x33, 0x98, 0x57, 0x60, 0x49, 0x63, 0xdf, 0xcb, 0xa6, 0xdf, 0xcc, 0x9a,
+	0xf7, 0x5a, 0xbf, 0x51, 0x81, 0xba, 0x34, 0x14, 0x13, 0xa4, 0x76, 0xcf, 0x44, 0xc3, 0x91, 0x6b,
+	0x0d, 0xf7, 0x1c, 0x54, 0x07, 0x68, 0x40, 0xaf, 0xf6, 0x4c, 0xce, 0x30, 0x40, 0x03, 0x72, 0xb1,
+	0x97, 0xef, 0xec, 0xb3, 0xea, 0x9d, 0x5d, 0x95, 0x6a, 0xcc, 0x8d, 0x91, 0x6a, 0x54, 0x55, 0xa9,
+	0x86, 0xb2, 0x85, 0x6a, 0xe9, 0x2d, 0x54, 0x54, 0x90, 0x76, 0x15, 0x96, 0x7a, 0x54, 0x83, 0x74,
+	0x63, 0x7f, 0x5d, 0x24, 0x31, 0xb6, 0x5f, 0x97, 0x64, 0xde, 0x4a, 0x44, 0xe4, 0x74, 0x96, 0xe9,
+	0x9d, 0x4f, 0x2f, 0x34, 0x61, 0x73, 0x43, 0x27, 0xb9, 0x11, 0x49, 0x7f, 0x69, 0x11, 0x5e, 0xf3,
+	0x50, 0x22, 0xbc, 0x33, 0x50, 0xe7, 0x47, 0x2d, 0xde, 0xe9, 0xf3, 0x94, 0xae, 0x32, 0x10, 0x66,
+	0x92, 0x64, 0x3a, 0xb0, 0xa0, 0x2a, 0x3e, 0xd3, 0x22, 0xa7, 0x56, 0x56, 0xe4, 0x74, 0x1c, 0xe6,
+	0xdc, 0xa8, 0xbb, 0xe3, 0x3c, 0x42, 0x44, 0x46, 0x56, 0xb5, 0x67, 0xdd, 0xe8, 0x96, 0xf3, 0x08,
+	0xe9, 0x78, 0x01, 0x26, 0x04, 0x53, 0x79, 0x01, 0x6c, 0x04, 0x32, 0x9f, 0x30, 0x2b, 0x85, 0x49,
+	0x4d, 0x11, 0x63, 0xf8, 0xfb, 0x69, 0x43, 0x73, 0x34, 0x56, 0x56, 0x92, 0x36, 0xf3, 0x51, 0x0d,
+	0xce, 0x51, 0xa4, 0xb2, 0x4e, 0x33, 0x07, 0x62, 0x9d, 0xa6, 0xb4, 0x15, 0x7c, 0x0d, 0x56, 0x04,
+	0x1f, 0xa0, 0x74, 0x9b, 0xde, 0x75, 0x97, 0x79, 0xe2, 0xa6, 0xdc, 0xfd, 0x1c, 0x5a, 0x31, 0x97,
+	0x47, 0x2b, 0xd2, 0x6b, 0xa5, 0x9a, 0x59, 0x2b, 0x59, 0xbe, 0xad, 0xa6, 0xe1, 0xdb, 0xac, 0x87,
+	0xb0, 0x44, 0xf4, 0x1a, 0x51, 0x2f, 0x74, 0xb7, 0x93, 0x53, 0xb4, 0xc8, 0xb4, 0x76, 0xa0, 0x9a,
+	0xba, 0x91, 0x89, 0x7f, 0xeb, 0x2f, 0x18, 0xb0, 0x9a, 0x2d, 0x97, 0xac, 0x98, 0x3c, 0xe5, 0xf1,
+	0x97, 0x60, 0x49, 0xe2, 0xce, 0x95, 0x92, 0x73, 0x6e, 0x33, 0x9a, 0x86, 0xdb, 0x66, 0x52, 0x06,
+	0x87, 0x59, 0xff, 0xc3, 0x10, 0xea, 0x21, 0x0c, 0xdb, 0x25, 0xba, 0x37, 0x7c, 0x00, 0x06, 0xbe,
+	0xe7, 0xfa, 0xa8, 0xab, 0x34, 0xa7, 0x41, 0x81, 0x4c, 0x30, 0xf6, 0x2e, 0x2c, 0x30, 0x24, 0x71,
+	0x8e, 0x15, 0x64, 0x0e, 0xe7, 0x69, 0x3e, 0x71, 0x82, 0x9d, 0x87, 0x79, 0xa6, 0x14, 0xe3, 0xf5,
+	0x95, 0x75, 0xaa, 0xb2, 0xf7, 0xa0, 0xc5, 0xd1, 0x0e, 0x7a, 0x72, 0x2e, 0xb0, 0x8c, 0x82, 0xc9,
+	0xfc, 0x39, 0x03, 0xda, 0xea, 0x39, 0x2a, 0x75, 0xff, 0xe0, 0xac, 0xe6, 0xdb, 0xaa, 0xe5, 0xd8,
+	0xf9, 0x31, 0xed, 0x49, 0xea, 0xe1, 0xf6, 0x63, 0xdf, 0x2d, 0x11, 0x03, 0x41, 0x7c, 0x6d, 0xde,
+	0x70, 0xa3, 0x38, 0x74, 0xb7, 0x47, 0xd3, 0x29, 0xf8, 0x1d, 0xa8, 0x27, 0x62, 0x18, 0xde, 0x26,
+	0xad, 0xdd, 0x7d, 0x7e, 0xb5, 0x6b, 0xeb, 0x49, 0x09, 0xcc, 0xc5, 0x4a, 0x2a, 0xb3, 0xf3, 0x55,
+	0x68, 0xa5, 0x11, 0x34, 0x56, 0x30, 0xaf, 0xa9, 0x4a, 0xc5, 0x09, 0x2c, 0x89, 0xa4, 0x53, 0xfc,
+	0x8b, 0x65, 0x38, 0xa1, 0x6d, 0xdb, 0x34, 0x37, 0xce, 0x3c, 0x91, 0xde, 0x0d, 0xa8, 0xa6, 0x04,
+	0x04, 0x17, 0xc6, 0xcc, 0x1f, 0x93, 0x8f, 0x53, 0x11, 0x6e, 0x94, 0x30, 0x61, 0x55, 0xc5, 0x2a,
+	0x2b, 0xa7, 0x0c, 0xb6, 0xef, 0x94, 0x32, 0x78, 0x3e, 0xac, 0xf2, 0x63, 0x76, 0x27, 0x4f, 0x5c,
+	0xf4, 0x94, 0xab, 0xec, 0x4f, 0xe7, 0x1b, 0xb3, 0x7c, 0xe0, 0xa2, 0xa7, 0x76, 0xdd, 0x13, 0xdf,
+	0x91, 0xf9, 0x10, 0x5a, 0x98, 0x56, 0x63, 0xab, 0x1b, 0xd1, 0xa5, 0xd9, 0x7c, 0x1f, 0x40, 0x49,
+	0xac, 0xee, 0xfa, 0xbb, 0xfc, 0x72, 0x69, 0x2f, 0xb0, 0x32, 0xc4, 0x6e, 0xf9, 0x83, 0x19, 0x80,
+	0xa4, 0x4a, 0x7c, 0x81, 0x4e, 0x48, 0x09, 0xa3, 0x0d, 0x12, 0x44, 0xb6, 0xd8, 0x2c, 0x29, 0x16,
+	0x9b, 0xa6, 0x9d, 0x68, 0xe2, 0xfa, 0x58, 0x06, 0x4c, 0x87, 0xfb, 0xca, 0xf8, 0x2e, 0xf2, 0x66,
+	0xe2, 0x95, 0xc0, 0x96, 0x62, 0x94, 0x40, 0x64, 0x4b, 0x23, 0xe9, 0x4a, 0x45, 0x6f, 0x5e, 0xdc,
+	0xd2, 0x48, 0xba, 0x53, 0x7d, 0x0d, 0x5a, 0x29, 0x74, 0x3e, 0xd2, 0xaf, 0x4d, 0x68, 0xc6, 0x6d,
+	0xa5, 0x2c, 0xb6, 0x2b, 0x16, 0xd4, 0x1a, 0x88, 0xda, 0xff, 0x81, 0x13, 0xee, 0x22, 0xbe, 0x50,
+	0x18, 0x1f, 0xa8, 0x02, 0xcd, 0x57, 0x60, 0x89, 0xe9, 0x66, 0x25, 0x7b, 0x2a, 0xae, 0xa3, 0x6d,
+	0x11, 0x1d, 0xed, 0x6d, 0x61, 0x50, 0x15, 0x75, 0xba, 0xd0, 0x4a, 0x0f, 0x82, 0x46, 0x87, 0xff,
+	0x86, 0xba, 0xdd, 0xc6, 0x51, 0x45, 0x5c, 0x8c, 0xec, 0xcb, 0xe2, 0xc0, 0xb2, 0xae, 0x7b, 0x9a,
+	0x4a, 0x0e, 0xbd, 0xa7, 0x3f, 0x07, 0x75, 0xa9, 0xf2, 0xdc, 0xb3, 0x4e, 0x52, 0x53, 0x94, 0x14,
+	0x35, 0x85, 0xf5, 0xa7, 0xca, 0x60, 0x66, 0x37, 0xa1, 0x39, 0x0f, 0x25, 0x51, 0x48, 0xe9, 0xce,
+	0x46, 0x6a, 0x75, 0x96, 0x32, 0xab, 0xf3, 0x24, 0xf6, 0x65, 0x66, 0xfc, 0x05, 0xb7, 0xb8, 0x12,
+	0x80, 0x7c, 0x6b, 0x63, 0xb9, 0x61, 0x15, 0x55, 0x7f, 0x72, 0x15, 0x96, 0x3d, 0x27, 0x8a, 0xbb,
+	0x54, 0x4d, 0x93, 0x98, 0x73, 0xe1, 0x99, 0x9f, 0xb1, 0x4d, 0x9c, 0xb6, 0x81, 0x93, 0x84, 0xbd,
+	0x9b, 0xf9, 0x80, 0x5f, 0x06, 0xf0, 0x09, 0xc0, 0xac, 0x63, 0xde, 0x28, 0x46, 0x74, 0x12, 0xe5,
+	0x08, 0x5d, 0x80, 0x35, 0xc1, 0x25, 0x77, 0xbe, 0x0e, 0xf3, 0x6a, 0xa2, 0x66, 0xfa, 0xde, 0x54,
+	0xa7, 0xaf, 0x08, 0x1f, 0x2e, 0xcd, 0xe1, 0x1e, 0x98, 0x59, 0x12, 0x26, 0x8f, 0x99, 0xa1, 0x8e,
+	0xd9, 0xa4, 0xb9, 0x90, 0xc6, 0xb4, 0xac, 0x4e, 0xf6, 0x8f, 0xe6, 0xc0, 0x4c, 0xf8, 0x48, 0x61,
+	0xad, 0x51, 0x84, 0xf9, 0xba, 0x02, 0x4b, 0x9c, 0x91, 0xec, 0x4a, 0x82, 0x3e, 0xca, 0x5a, 0x9b,
+	0x19, 0x1e, 0x53, 0xc7, 0x0f, 0x96, 0x75, 0x72, 0xbc, 0x4f, 0x89, 0x43, 0x87, 0x32, 0xcd, 0xa7,
+	0x73, 0xb5, 0x5f, 0xea, 0xb9, 0xf3, 0xd5, 0xb4, 0xeb, 0x0b, 0x25, 0x37, 0x6f, 0x6a, 0x0f, 0x88,
+	0x4c, 0x97, 0x27, 0xfa, 0xbd, 0x28, 0xec, 0xfc, 0xec, 0x81, 0xd8, 0xf9, 0x73, 0xd0, 0x0c, 0x51,
+	0x2f, 0x78, 0x82, 0x42, 0xba, 0x6a, 0x99, 0xb1, 0x65, 0x83, 0x01, 0xc9, 0x7a, 0x4d, 0x3b, 0x44,
+	0x56, 0x33, 0x0e, 0x91, 0x85, 0xdd, 0x6b, 0x64, 0xaf, 0x16, 0xc8, 0xf5, 0x81, 0x6c, 0x28, 0x3e,
+	0x90, 0x92, 0x78, 0x8b, 0x59, 0x87, 0xf5, 0xdb, 0x4d, 0x45, 0xbc, 0x75, 0x93, 0x81, 0x35, 0xce,
+	0x8e, 0xf3, 0xcf, 0xd8, 0xd9, 0x71, 0x41, 0xe7, 0xec, 0xf8, 0x0d, 0xad, 0xb3, 0x63, 0x2b, 0xdf,
+	0x9e, 0x4c, 0x33, 0xc7, 0x07, 0xf1, 0x74, 0xd4, 0xfb, 0x9e, 0x2e, 0xe6, 0xfb, 0x9e, 0xfe, 0xc4,
+	0x78, 0x3a, 0xd6, 0x5b, 0x0d, 0xeb, 0xff, 0x94, 0x60, 0x51, 0x71, 0xac, 0x2e, 0xbc, 0xad, 0x27,
+	0x9b, 0x62, 0x1d, 0xf1, 0x3e, 0xfe, 0x50, 0xbf, 0x8f, 0x3f, 0x3d, 0xd1, 0x77, 0xbc, 0xd0, 0x36,
+	0x2e, 0xb2, 0x17, 0xa7, 0xf7, 0xfd, 0xfa, 0x81, 0x01, 0x73, 0x6c, 0x71, 0x64, 0x0e, 0xce, 0x22,
+	0x52, 0xb3, 0x65, 0xa8, 0xe0, 0x45, 0xce, 0xa5, 0xf7, 0xf4, 0x47, 0x63, 0x39, 0x3b, 0xa3, 0x73,
+	0x48, 0x79, 0x0e, 0xaa, 0x61, 0xd0, 0xa5, 0xf9, 0x99, 0xac, 0x36, 0x0c, 0xee, 0x93, 0x12, 0xda,
+	0x30, 0xc7, 0x96, 0x2e, 0x73, 0x2c, 0xe1, 0xbf, 0x12, 0x61, 0x98, 0x93, 0x09, 0x83, 0xf5, 0x87,
+	0x65, 0x00, 0xac, 0x54, 0xbc, 0x4e, 0x4f, 0x92, 0xab, 0x30, 0x33, 0xc9, 0xf0, 0x18, 0x63, 0x13,
+	0x02, 0x48, 0x30, 0x0b, 0xac, 0x27, 0x45, 0xc8, 0x58, 0x4e, 0x0b, 0x19, 0xf3, 0xc4, 0x83, 0xf9,
+	0x7c, 0xc2, 0xa7, 0x61, 0x86, 0x9c, 0xf7, 0xd4, 0xa6, 0xb6, 0x90, 0xa1, 0x0b, 0xc9, 0x80, 0x4d,
+	0xbd, 0x18, 0x9b, 0x78, 0xc7, 0xa7, 0x7c, 0x24, 0xe1, 0x19, 0xca, 0x76, 0x1a, 0x4c, 0x6c, 0xb6,
+	0xc8, 0xb5, 0x56, 0x20, 0x52, 0xf1, 0x47, 0x0a, 0x9a, 0xe5, 0x52, 0x6b, 0x3a, 0x2e, 0xf5, 0x12,
+	0x2c, 0xf4, 0xc3, 0x60, 0x38, 0x94, 0x8a, 0xa3, 0xd2, 0xc5, 0x34, 0x38, 0x65, 0x2a, 0x50, 0x3f,
+	0xa8, 0xa9, 0xc0, 0xef, 0xe1, 0x98, 0x2f, 0xfb, 0x7e, 0xef, 0xd9, 0xdc, 0x7f, 0x8b, 0x2c, 0x64,
+	0x89, 0x67, 0x29, 0xab, 0x3c, 0xcb, 0x9b, 0x30, 0x47, 0x25, 0xa0, 0xfc, 0x26, 0x77, 0x3a, 0x6f,
+	0x31, 0xd1, 0xa5, 0x67, 0x73, 0xf4, 0x69, 0xa5, 0x63, 0x8a, 0x15, 0xd1, 0xec, 0x74, 0x56, 0x44,
+	0x73, 0x69, 0x3d, 0x89, 0xb4, 0x2a, 0xab, 0x13, 0xed, 0x8c, 0x6b, 0x07, 0x37, 0xcd, 0xb1, 0x7e,
+	0xab, 0x04, 0x4d, 0xc5, 0xa9, 0x05, 0x9b, 0xca, 0x48, 0x6e, 0x2a, 0xe4, 0xdb, 0x3c, 0x0d, 0xd5,
+	0x9e, 0x33, 0x74, 0x7a, 0x98, 0x05, 0xc0, 0xd3, 0x52, 0x21, 0xe6, 0xf9, 0x02, 0x96, 0x43, 0x5f,
+	0x3e, 0x0b, 0xb3, 0x3d, 0xe2, 0x22, 0xc3, 0xec, 0xbc, 0x8a, 0xb9, 0xd3, 0xb0, 0x3c, 0xe6, 0x97,
+	0xa8, 0x96, 0xa9, 0x1b, 0x21, 0x3c, 0xee, 0x41, 0x38, 0xee, 0xba, 0xa7, 0x94, 0xb3, 0x86, 0x69,
+	0xd3, 0x16, 0xcb, 0xc5, 0x68, 0xb6, 0x2f, 0x81, 0x30, 0x39, 0xce, 0xa0, 0x68, 0xc4, 0x20, 0x0a,
+	0x39, 0xae, 0xc9, 0xe4, 0xf8, 0x3b, 0x25, 0x58, 0xe5, 0xe6, 0x36, 0x8c, 0x2c, 0x1f, 0x7e, 0xd9,
+	0x5f, 0x83, 0x15, 0x46, 0x83, 0x53, 0xc4, 0x98, 0x56, 0xbb, 0x44, 0x61, 0xea, 0x1c, 0x5d, 0x83,
+	0x95, 0x98, 0xec, 0xe0, 0xae, 0xd6, 0xa3, 0x70, 0x89, 0x26, 0xaa, 0x79, 0x8a, 0x98, 0x3b, 0x9d,
+	0xa1, 0xb6, 0xc7, 0x6c, 0xfd, 0x31, 0x42, 0x08, 0x58, 0x17, 0x42, 0x21, 0x78, 0x4c, 0x48, 0xc8,
+	0x00, 0x46, 0xee, 0xe9, 0x8f, 0xf5, 0x8b, 0x06, 0x9c, 0xa4, 0xce, 0xa8, 0xdb, 0x6a, 0x43, 0xa7,
+	0xd2, 0x02, 0x6b, 0x87, 0x23, 0x75, 0x36, 0xd1, 0xfd, 0xb1, 0x1d, 0x44, 0x54, 0x0b, 0x55, 0xb5,
+	0xf9, 0xaf, 0xf5, 0x37, 0x0d, 0x38, 0x95, 0xd3, 0xa6, 0x69, 0xa4, 0x51, 0x77, 0xb5, 0xed, 0xca,
+	0x91, 0x1d, 0x2a, 0xf5, 0xd2, 0xdd, 0xa7, 0x3a, 0xa5, 0xfc, 0xf7, 0x2a, 0x2c, 0x66, 0x90, 0x0e,
+	0xb5, 0x03, 0x5f, 0x06, 0x13, 0xcf, 0x5c, 0xe2, 0x6d, 0x88, 0x57, 0x3c, 0x63, 0xa4, 0xb0, 0x60,
+	0x42, 0x84, 0xb1, 0xc2, 0x2b, 0xdf, 0x74, 0x29, 0x36, 0x55, 0xdf, 0x8a, 0xe9, 0x9e, 0x19, 0x17,
+	0xd0, 0x29, 0xd5, 0xc8, 0xb5, 0xfb, 0xa3, 0x01, 0xd5, 0xf4, 0xb2, 0xa5, 0xc1, 0x78, 0x5f, 0x3f,
+	0x05, 0x36, 0x77, 0x60, 0x11, 0x57, 0x15, 0x8c, 0xe2, 0xdd, 0x00, 0x0b, 0x4c, 0x48, 0xbb, 0xe8,
+	0x56, 0x7e, 0xab, 0x70, 0x4d, 0x5f, 0x60, 0xb9, 0x71, 0xe3, 0x99, 0x00, 0xc7, 0x57, 0xa1, 0xbc,
+	0x1e, 0xd7, 0xef, 0x05, 0x03, 0x51, 0xcf, 0xec, 0x01, 0xeb, 0xb9, 0xc3, 0x72, 0xab, 0xf5, 0xc8,
+	0x50, 0x89, 0xa8, 0xcd, 0x1d, 0x82, 0xa8, 0xbd, 0xc6, 0x09, 0x65, 0x55, 0x47, 0xab, 0xd9, 0x92,
+	0xc3, 0xf5, 0xd0, 0x2b, 0x3c, 0xa5, 0xa3, 0x17, 0x61, 0x21, 0x1a, 0x45, 0x43, 0xe4, 0xe3, 0xc9,
+	0xa2, 0xd9, 0x6b, 0x8c, 0x3d, 0xe0, 0x60, 0xca, 0x8e, 0x7d, 0x98, 0x26, 0x99, 0x90, 0xcf, 0xea,
+	0x6a, 0xfa, 0x3f, 0x9e, 0x6c, 0x72, 0x2d, 0x29, 0x19, 0x58, 0x6a, 0xb1, 0x8c, 0xb5, 0xa4, 0x64,
+	0x50, 0x2e, 0x01, 0x9e, 0xf8, 0xee, 0xc0, 0x8d, 0x22, 0x31, 0xf6, 0x0d, 0x82, 0x32, 0xef, 0x8f,
+	0x06, 0xf7, 0x28, 0x98, 0x60, 0xb2, 0x75, 0x1a, 0xa2, 0xfe, 0xc8, 0xef, 0x3b, 0xec, 0xf2, 0xc5,
+	0x82, 0x25, 0xb4, 0x08, 0xa1, 0x61, 0x09, 0x04, 0xfb, 0x34, 0x08, 0x05, 0xd0, 0x46, 0x46, 0x7d,
+	0xb8, 0xa1, 0x89, 0x11, 0xb7, 0xa0, 0x89, 0x11, 0x87, 0xef, 0x41, 0xda, 0xd5, 0x3a, 0x89, 0x05,
+	0xaf, 0xc8, 0x97, 0xa9, 0x1b, 0xb0, 0xac, 0x5b, 0x88, 0x87, 0x28, 0x23, 0xb3, 0xc8, 0x0e, 0x54,
+	0xc6, 0xd4, 0x87, 0xd7, 0x7f, 0x2a, 0x41, 0x73, 0x03, 0x79, 0x28, 0x46, 0x47, 0x6b, 0x77, 0x96,
+	0x31, 0xa2, 0x2b, 0x67, 0x8d, 0xe8, 0x32, 0x16, 0x81, 0x33, 0x1a, 0x8b, 0xc0, 0x53, 0xc2, 0x10,
+	0x12, 0x97, 0x52, 0x51, 0x19, 0xfa, 0xbe, 0xf9, 0x36, 0x34, 0x86, 0xa1, 0x3b, 0x70, 0xc2, 0xfd,
+	0xee, 0x23, 0xb4, 0x1f, 0x31, 0x16, 0xac, 0xad, 0x65, 0xe2, 0xee, 0x6c, 0x44, 0x76, 0x9d, 0x61,
+	0xbf, 0x8f, 0xf6, 0x89, 0x91, 0xa5, 0xe4, 0xe7, 0x3a, 0x47, 0xfc, 0x5c, 0x25, 0x48, 0x62, 0x38,
+	0x59, 0x3d, 0x80, 0xe1, 0xe4, 0x1e, 0xac, 0x62, 0x1e, 0xf3, 0x89, 0x13, 0x23, 0xa2, 0x6d, 0x41,
+	0xe1, 0xe1, 0x47, 0xfa, 0x24, 0xd4, 0x7a, 0xb4, 0x0c, 0xc6, 0x11, 0x57, 0xec, 0x04, 0x60, 0x7d,
+	0x03, 0xda, 0x1b, 0xc8, 0xf9, 0xf1, 0xd4, 0xb5, 0x0b, 0x4b, 0x98, 0x63, 0x64, 0xb5, 0x44, 0x53,
+	0x45, 0x97, 0x10, 0xa5, 0x52, 0xf9, 0x5e, 0xc5, 0x96, 0x20, 0xd6, 0x77, 0x0d, 0x58, 0x56, 0x6b,
+	0x9a, 0xe6, 0xc0, 0x5e, 0xc7, 0xfe, 0x65, 0xb4, 0xec, 0x49, 0x96, 0x70, 0xeb, 0x09, 0x9e, 0xad,
+	0x64, 0xb2, 0xfe, 0x97, 0x01, 0x75, 0x29, 0x15, 0xdf, 0xc1, 0x99, 0xcd, 0x68, 0xc5, 0x2e, 0xb9,
+	0x7d, 0x62, 0x5e, 0x8e, 0xa2, 0x1e, 0xdb, 0x6c, 0xe4, 0x1b, 0x8f, 0x26, 0x9f, 0x99, 0x3e, 0x63,
+	0x4e, 0x12, 0x00, 0x65, 0xa4, 0x46, 0x7e, 0x9f, 0x59, 0xec, 0xd2, 0x1f, 0xd3, 0x82, 0x26, 0x11,
+	0x49, 0x87, 0x23, 0x5f, 0x76, 0x30, 0xab, 0x63, 0xa0, 0x3d, 0xf2, 0x89, 0x8b, 0xd9, 0x1b, 0x70,
+	0x9c, 0xe0, 0xb0, 0xf8, 0x00, 0xd8, 0x1a, 0xdc, 0x89, 0x1e, 0x49, 0x76, 0xcb, 0x44, 0xaa, 0x7d,
+	0x9b, 0xa7, 0x3e, 0x70, 0xa2, 0x47, 0xf7, 0x47, 0x03, 0x91, 0x2d, 0x1a, 0x6d, 0x0f, 0xdc, 0x58,
+	0xc9, 0x36, 0x97, 0x64, 0xdb, 0xe2, 0xa9, 0x2c, 0x9b, 0xf5, 0x01, 0x36, 0x06, 0x27, 0x5b, 0x8d,
+	0x5d, 0x19, 0xd3, 0xe2, 0x07, 0xe1, 0xa5, 0x54, 0x3a, 0x88, 0x97, 0x92, 0x15, 0x4a, 0x96, 0x4b,
+	0xac, 0xe4, 0xc9, 0x96, 0x4b, 0xef, 0x48, 0x2a, 0xbf, 0x92, 0xce, 0x17, 0x48, 0xb9, 0x8d, 0xd3,
+	0x62, 0x13, 0x6d, 0x9f, 0xf5, 0x77, 0x4a, 0xd0, 0x64, 0x72, 0xf0, 0xa4, 0x4a, 0x89, 0xd2, 0xe8,
+	0x3c, 0xf3, 0x5f, 0x01, 0x93, 0x5d, 0x9a, 0xbb, 0x99, 0x90, 0x28, 0x8b, 0x2c, 0x45, 0x52, 0x53,
+	0xe9, 0xb5, 0x5a, 0xe5, 0x3c, 0xad, 0xd6, 0x26, 0x2c, 0x26, 0x24, 0x92, 0x32, 0xed, 0xfc, 0xfa,
+	0x3a, 0xde, 0x48, 0x84, 0xf5, 0xad, 0x35, 0x54, 0x01, 0xcf, 0xc6, 0xac, 0xec, 0xd7, 0x0d, 0x68,
+	0x25, 0xd7, 0x5d, 0x36, 0x54, 0x45, 0x64, 0x7d, 0xef, 0xc1, 0x02, 0x1b, 0x5f, 0xd1, 0x99, 0x31,
+	0xd3, 0xa4, 0x4c, 0x85, 0x3d, 0xaf, 0xfc, 0x46, 0x63, 0x74, 0x0c, 0x3f, 0x30, 0xa0, 0xca, 0x59,
+	0x24, 0xb6, 0x1c, 0x4b, 0x62, 0x39, 0xb6, 0x61, 0x0e, 0x47, 0x4a, 0x40, 0x51, 0xc4, 0x05, 0x04,
+	0xec, 0x17, 0xef, 0x38, 0x6a, 0x10, 0x35, 0xc3, 0x3c, 0x2a, 0xf0, 0x8f, 0xf9, 0x79, 0x98, 0xf5,
+	0x9c, 0x6d, 0xac, 0xff, 0x1d, 0x13, 0x52, 0x92, 0xd7, 0xb6, 0x76, 0x97, 0xa0, 0x52, 0xe6, 0x88,
+	0xe5, 0xeb, 0x7c, 0x06, 0xea, 0x12, 0xf8, 0x40, 0x47, 0xf1, 0xbb, 0x94, 0xd0, 0x11, 0x6b, 0x47,
+	0x5c, 0xc7, 0xa1, 0x69, 0xaa, 0xf5, 0xe7, 0x0d, 0x58, 0x49, 0x15, 0x35, 0x0d, 0xd1, 0x7c, 0x0b,
+	0x6a, 0x3e, 0xeb, 0x33, 0x9f, 0xc2, 0x93, 0xe3, 0x06, 0xc6, 0x4e, 0xd0, 0xad, 0x47, 0x70, 0xe6,
+	0x36, 0x4a, 0x1a, 0xf2, 0x6c, 0x64, 0x43, 0x39, 0x46, 0x00, 0xd6, 0xb7, 0xcb, 0x70, 0x36, 0xbf,
+	0xb6, 0x69, 0x86, 0x20, 0xbd, 0xb0, 0x30, 0xcb, 0x23, 0x71, 0x2a, 0x3c, 0x14, 0x47, 0x43, 0x22,
+	0x16, 0x39, 0x66, 0xc2, 0x33, 0x39, 0x66, 0xc2, 0xb2, 0x01, 0x43, 0xe5, 0x19, 0x18, 0x30, 0xcc,
+	0x3e, 0x23, 0x03, 0x86, 0xb9, 0x03, 0x1b, 0x30, 0x58, 0x77, 0x60, 0x65, 0x8b, 0x5e, 0x45, 0xa6,
+	0x35, 0xff, 0xc6, 0x7b, 0xc2, 0x46, 0xd1, 0x68, 0x80, 0xa6, 0x2e, 0xe9, 0x6b, 0x60, 0xb2, 0x46,
+	0x4d, 0xb5, 0xb7, 0x72, 0xd7, 0xde, 0x57, 0xc9, 0xdd, 0x7d, 0x34, 0x40, 0x47, 0x53, 0xfc, 0x2f,
+	0x49, 0x42, 0x26, 0xb6, 0x06, 0xa6, 0x62, 0xed, 0x12, 0x99, 0x78, 0x29, 0x2d, 0x13, 0xcf, 0x78,
+	0x54, 0x96, 0x35, 0x1e, 0x95, 0xe7, 0xa0, 0xc9, 0x64, 0x4e, 0x8a, 0xfc, 0xbc, 0x41, 0x81, 0x0c,
+	0xe9, 0x79, 0x68, 0x70, 0xdf, 0xb4, 0xae, 0xe3, 0x79, 0x2c, 0xa6, 0x71, 0x9d, 0xc3, 0xae, 0x7b,
+	0x9e, 0x79, 0x16, 0x1a, 0x71, 0x80, 0x13, 0xd9, 0x55, 0x96, 0x4a, 0x92, 0x20, 0x0e, 0xae, 0x7b,
+	0x1e, 0xbd, 0xc6, 0x9e, 0x80, 0x5a, 0x2f, 0x18, 0xee, 0x77, 0x07, 0xf8, 0x6a, 0x48, 0x8d, 0xe2,
+	0xab, 0x18, 0x70, 0x2f, 0xe8, 0x23, 0xeb, 0xaf, 0x4a, 0xc3, 0x32, 0x75, 0xe0, 0x82, 0x74, 0xf0,
+	0x81, 0x52, 0x96, 0x01, 0xf8, 0x69, 0x1a, 0x9b, 0xbf, 0x66, 0xc0, 0xf3, 0x84, 0x4d, 0x7d, 0xc6,
+	0xd4, 0xf7, 0x99, 0x8d, 0x81, 0xb5, 0x09, 0x27, 0x6f, 0xa3, 0x78, 0xdd, 0x1b, 0x45, 0x31, 0x0a,
+	0x89, 0xb2, 0x6e, 0x34, 0xc0, 0x97, 0xb1, 0xc3, 0xef, 0xf2, 0x3f, 0x2a, 0xc3, 0xa9, 0x9c, 0x22,
+	0xa7, 0x21, 0xff, 0xaf, 0xc3, 0xaa, 0x24, 0x21, 0x4b, 0xb8, 0x9c, 0x88, 0x5d, 0x8c, 0x96, 0x85,
+	0xa0, 0x2b, 0xe1, 0x94, 0x88, 0xcd, 0xb2, 0x24, 0x3f, 0x8d, 0x98, 0xfc, 0xad, 0x9e, 0x08, 0x50,
+	0x05, 0x8a, 0x64, 0x0a, 0x49, 0xd8, 0x5c, 0x7f, 0x34, 0x10, 0xb6, 0x48, 0x67, 0x70, 0x3c, 0x1c,
+	0x62, 0x38, 0x2b, 0x19, 0xab, 0x03, 0x05, 0x11, 0x7b, 0xf5, 0x01, 0x15, 0xb7, 0x90, 0x35, 0x82,
+	0x8d, 0x6b, 0xbb, 0xe1, 0x2e, 0xa3, 0xfe, 0x1b, 0x39, 0xe6, 0x82, 0xf9, 0xc3, 0x83, 0xc5, 0x5e,
+	0x64, 0x69, 0x6d, 0xa2, 0xd0, 0xde, 0xa5, 0xac, 0x4d, 0xd3, 0x97, 0x61, 0xd8, 0x50, 0x06, 0x57,
+	0x37, 0xf2, 0xf7, 0x90, 0xe3, 0xc5, 0x7b, 0xfb, 0x5d, 0x16, 0x13, 0x8d, 0xde, 0x1b, 0xb0, 0x3c,
+	0xe7, 0x21, 0x4f, 0x22, 0x4e, 0x87, 0x51, 0xe7, 0xf3, 0x60, 0x66, 0x8b, 0x9d, 0xc4, 0x1a, 0x55,
+	0x54, 0x93, 0x95, 0xd6, 0xad, 0x20, 0xec, 0x21, 0xea, 0x80, 0x78, 0x84, 0x2a, 0x25, 0xeb, 0x0f,
+	0x4a, 0x30, 0x4f, 0x24, 0x2a, 0xa4, 0xa6, 0x68, 0xe4, 0xe5, 0x1b, 0x39, 0x61, 0xd7, 0x24, 0x36,
+	0x49, 0x38, 0x1e, 0x17, 0xea, 0xb3, 0x76, 0x73, 0x8b, 0xfb, 0xe8, 0x3a, 0x06, 0x62, 0xe3, 0x07,
+	0x81, 0x16, 0xa2, 0x41, 0xf0, 0x84, 0x5d, 0x00, 0x2b, 0xf6, 0x02, 0x87, 0xdb, 0x14, 0x8c, 0x4b,
+	0xe4, 0xe7, 0x30, 0x2b, 0x71, 0x86, 0x96, 0xc8, 0xa1, 0xa2, 0x44, 0x81, 0xc6, 0x4b, 0xa4, 0xce,
+	0x6d, 0x0b, 0x1c, 0xce, 0x4b, 0x7c, 0x19, 0x4c, 0xf9, 0x34, 0x67, 0xa5, 0xd2, 0x9b, 0x61, 0x4b,
+	0x3a, 0xb3, 0x69, 0xc1, 0xd8, 0x06, 0x4a, 0xc6, 0xe6, 0x85, 0xb3, 0xa9, 0x95, 0xf0, 0x79, 0xf9,
+	0xcb, 0x50, 0x21, 0x51, 0xbb, 0xb8, 0x63, 0x32, 0xf9, 0xb1, 0xfe, 0xd8, 0x80, 0x45, 0x69, 0xbe,
+	0xa6, 0xd9, 0x79, 0x37, 0x81, 0x88, 0x1d, 0x99, 0x83, 0x0e, 0x67, 0x3f, 0xad, 0x3c, 0xf6, 0x33,
+	0x99, 0x36, 0xbb, 0xee, 0x53, 0xc6, 0x17, 0x67, 0xa3, 0x46, 0xeb, 0xc4, 0xfb, 0x2e, 0xb5, 0x7f,
+	0xcb, 0xdc, 0x68, 0x9d, 0x25, 0xca, 0xfb, 0x17, 0x87, 0x80, 0x25, 0x9f, 0xe4, 0x5e, 0x4c, 0xa7,
+	0xa2, 0x46, 0x21, 0xf8, 0x32, 0xfc, 0x7d, 0x83, 0x90, 0x2f, 0x7e, 0xfc, 0x90, 0xea, 0x69, 0xe3,
+	0x7f, 0xd2, 0xb5, 0x3f, 0xd6, 0x7f, 0x34, 0x60, 0x45, 0xa8, 0xaa, 0x88, 0x69, 0xc2, 0xfe, 0x96,
+	0x08, 0xec, 0x5f, 0xc4, 0x1f, 0x2c, 0x51, 0x52, 0x96, 0xd2, 0x4a, 0xca, 0x82, 0x81, 0x2d, 0xb1,
+	0xbd, 0xf8, 0x28, 0xde, 0xc6, 0x82, 0x0e, 0x76, 0xbc, 0x51, 0xce, 0xb8, 0xc9, 0xa1, 0xf4, 0x84,
+	0x7b, 0x03, 0x56, 0x47, 0x3e, 0x7b, 0x9a, 0x43, 0x8d, 0x9b, 0x58, 0x21, 0x1c, 0xf7, 0x8a, 0x92,
+	0x2a, 0x4c, 0xe2, 0xff, 0xd0, 0x80, 0x53, 0x39, 0x73, 0x33, 0xcd, 0x6a, 0x24, 0x12, 0x68, 0x32,
+	0x5e, 0xae, 0xbf, 0xcb, 0xc2, 0x9e, 0x48, 0x10, 0xf3, 0x01, 0xb4, 0x30, 0x87, 0x49, 0x4c, 0x41,
+	0x13, 0xaa, 0x8f, 0x57, 0xec, 0x8b, 0x63, 0xdc, 0x95, 0xd5, 0x29, 0xb0, 0x17, 0x58, 0x11, 0x2c,
+	0x35, 0xb2, 0xfe, 0x95, 0x01, 0x6d, 0xee, 0xb3, 0xc8, 0xa4, 0x7a, 0x23, 0xff, 0x88, 0x04, 0x7b,
+	0x85, 0x42, 0x21, 0x91, 0xdb, 0x0f, 0xc9, 0xc0, 0x6e, 0x3f, 0x33, 0xfc, 0xf6, 0x43, 0x80, 0xf4,
+	0xf6, 0x23, 0x94, 0x83, 0x15, 0x59, 0x39, 0xf8, 0xfb, 0x06, 0x96, 0x0a, 0x10, 0x34, 0x2c, 0x54,
+	0xe2, 0x1e, 0x13, 0x58, 0xfa, 0x94, 0x10, 0x58, 0xfa, 0x57, 0xc8, 0x04, 0x40, 0x59, 0x8b, 0xe5,
+	0xf4, 0x5a, 0x14, 0x71, 0x13, 0x66, 0xe4, 0xb8, 0x09, 0x5c, 0x3e, 0x57, 0x91, 0xe4, 0x73, 0xd8,
+	0x69, 0x52, 0xd0, 0xc6, 0xaa, 0x4d, 0x7f, 0x12, 0xf2, 0x36, 0x27, 0x93, 0xb7, 0x9f, 0x37, 0xe0,
+	0x39, 0xcd, 0x7c, 0x4c, 0xb3, 0xb0, 0x3e, 0x03, 0x15, 0xdc, 0xe9, 0xb1, 0xa1, 0x7f, 0x53, 0xc3,
+	0x66, 0xd3, 0x1c, 0xd6, 0xaf, 0xd0, 0x30, 0xca, 0x4c, 0xa5, 0xe7, 0x7a, 0x6e, 0xbc, 0xbf, 0x75,
+	0xf7, 0xfa, 0x91, 0x07, 0xaf, 0x7d, 0xea, 0xfa, 0xfd, 0xe0, 0x69, 0x37, 0x42, 0xbd, 0xc0, 0xef,
+	0x47, 0xdc, 0xd9, 0x83, 0x42, 0xb7, 0x28, 0xd0, 0xba, 0x07, 0x8b, 0x0f, 0x93, 0xb0, 0xa6, 0x9b,
+	0x28, 0x74, 0x83, 0x3e, 0x11, 0xe0, 0x93, 0xf0, 0x4b, 0x44, 0xa4, 0xc9, 0xdd, 0xfe, 0x30, 0x84,
+	0x08, 0x34, 0x9f, 0x83, 0x2a, 0xf2, 0xfb, 0x34, 0x91, 0xd9, 0x0e, 0x23, 0xbf, 0x8f, 0x93, 0xac,
+	0xff, 0x42, 0x7d, 0x2c, 0x32, 0x3d, 0x9d, 0x66, 0xe0, 0x9f, 0x87, 0xc6, 0x68, 0x88, 0x2b, 0xeb,
+	0x92, 0x20, 0xaa, 0xa4, 0x4a, 0xc3, 0xae, 0x53, 0x98, 0x8d, 0x41, 0xd8, 0x14, 0x55, 0x0e, 0xdc,
+	0xaa, 0xf6, 0xd8, 0x94, 0x92, 0x58, 0xb7, 0x35, 0xa3, 0x33, 0xa3, 0x19, 0x1d, 0x8c, 0x16, 0x87,
+	0x4e, 0xef, 0x11, 0x11, 0x0f, 0xba, 0x7e, 0x8f, 0xf3, 0x76, 0x4d, 0x0e, 0xdd, 0xc2, 0x40, 0x22,
+	0x39, 0xe6, 0x35, 0xb0, 0xd5, 0x99, 0x00, 0xcc, 0x0f, 0xd4, 0xc6, 0x0d, 0xc9, 0x18, 0xf3, 0x5b,
+	0xfb, 0x79, 0xbd, 0x57, 0x51, 0x6a, 0x46, 0x94, 0x3e, 0x50, 0x50, 0x64, 0x3d, 0x26, 0x8b, 0x8a,
+	0x47, 0x18, 0xe7, 0x4e, 0x05, 0x47, 0xca, 0x7a, 0xfd, 0x33, 0x3a, 0xbd, 0x99, 0x3a, 0xa7, 0x99,
+	0x5e, 0x3c, 0xc6, 0x24, 0xa0, 0x87, 0x24, 0x29, 0xa6, 0x63, 0x8c, 0xa1, 0x82, 0xc7, 0xc6, 0x81,
+	0x76, 0xc5, 0x93, 0x48, 0x92, 0x1f, 0x09, 0x0d, 0xb4, 0xcb, 0x53, 0x64, 0x5f, 0x27, 0x25, 0x4c,
+	0x88, 0x98, 0x60, 0x39, 0x46, 0x48, 0xaa, 0x54, 0xe9, 0xdc, 0x52, 0x4b, 0x15, 0xe8, 0xc4, 0xb2,
+	0x96, 0x76, 0x9a, 0xf9, 0x1b, 0x88, 0x7f, 0x9c, 0x86, 0x7d, 0x41, 0x3d, 0x14, 0x4b, 0xf7, 0x3c,
+	0xfa, 0x6f, 0xb9, 0xb0, 0xf0, 0x80, 0x98, 0xd3, 0x7d, 0xe0, 0x06, 0x1e, 0x8d, 0x04, 0x3c, 0xc6,
+	0x2e, 0x9f, 0x5a, 0xde, 0x71, 0x8f, 0x36, 0xfe, 0x5b, 0xec, 0x09, 0x31, 0xeb, 0x3e, 0x99, 0xa1,
+	0x54, 0x6d, 0x87, 0x5f, 0x16, 0xd6, 0x2f, 0x1b, 0x70, 0x42, 0x5b, 0xe0, 0x74, 0x3a, 0x1e, 0x78,
+	0x22, 0x8a, 0x1a, 0x47, 0x50, 0x53, 0xd5, 0xda, 0x52, 0x36, 0x2b, 0x82, 0x13, 0xeb, 0xce, 0x30,
+	0x1e, 0x85, 0x5c, 0xf2, 0x74, 0xd7, 0xd9, 0x0f, 0x46, 0xf1, 0xd1, 0xee, 0x80, 0xc7, 0xf0, 0xdc,
+	0xba, 0x87, 0x9c, 0xf0, 0xc7, 0x58, 0xe5, 0xf7, 0x0d, 0x58, 0x52, 0xaa, 0x3b, 0x00, 0x1f, 0xb8,
+	0x0a, 0xb3, 0x44, 0x85, 0x85, 0x18, 0x27, 0xc4, 0xfe, 0x08, 0x7b, 0x40, 0xc7, 0x8e, 0xd1, 0x71,
+	0xce, 0x43, 0x30, 0x20, 0xa1, 0xf3, 0x52, 0xc4, 0x14, 0xce, 0x5d, 0x27, 0x11, 0x53, 0xb0, 0x8a,
+	0xea, 0x8c, 0xd0, 0xc6, 0x10, 0x04, 0x76, 0xef, 0xed, 0x25, 0x01, 0x78, 0x9e, 0x12, 0x16, 0x4f,
+	0xd3, 0xf8, 0xc3, 0x8f, 0x58, 0xa1, 0x57, 0xe6, 0xac, 0x5f, 0x35, 0xe0, 0x74, 0x5e, 0xcd, 0xd3,
+	0x2d, 0xdc, 0x2a, 0xfd, 0x42, 0x63, 0xdd, 0x42, 0x75, 0xf5, 0x8a, 0x8c, 0xd6, 0xef, 0x13, 0x13,
+	0x3e, 0xfa, 0xe0, 0x1a, 0xc1, 0x50, 0x59, 0x24, 0x23, 0xcd, 0x22, 0xbd, 0x9f, 0xd1, 0xa2, 0x5d,
+	0x19, 0xf7, 0x86, 0x1b, 0x29, 0x72, 0x4d, 0x75, 0x9f, 0x12, 0x05, 0xe0, 0xc2, 0x04, 0x9d, 0x2b,
+	0x17, 0x2d, 0x8c, 0x13, 0x40, 0x56, 0x18, 0x2f, 0xa0, 0xf3, 0xb6, 0x50, 0x36, 0x1e, 0xdc, 0x50,
+	0x1d, 0x67, 0x56, 0xca, 0x9d, 0x24, 0x6e, 0x90, 0x33, 0x63, 0xb2, 0xd4, 0x54, 0x46, 0xb9, 0x68,
+	0xc4, 0x09, 0x75, 0xd9, 0x97, 0x34, 0xcb, 0xfe, 0x1d, 0x1c, 0xf3, 0x45, 0xb9, 0x19, 0x3c, 0x3f,
+	0x71, 0x84, 0x6c, 0x91, 0xc5, 0xfa, 0x2d, 0x03, 0xe6, 0xc9, 0x3b, 0x4b, 0xc2, 0xec, 0xb6, 0x50,
+	0xd3, 0xf0, 0x81, 0x45, 0xef, 0x88, 0xaa, 0x5b, 0x16, 0x93, 0xd1, 0x7d, 0x20, 0x6c, 0x9b, 0xd3,
+	0x8d, 0x3b, 0x31, 0xee, 0xda, 0x22, 0x90, 0xd5, 0x00, 0xd8, 0x33, 0xe9, 0x00, 0xd8, 0x31, 0x15,
+	0xf3, 0x65, 0x3c, 0x26, 0x8e, 0x96, 0xb2, 0xfd, 0x5c, 0x89, 0x8a, 0x02, 0x35, 0xd5, 0x4e, 0xb7,
+	0x49, 0xa9, 0x81, 0x2f, 0x31, 0x02, 0x2f, 0xe9, 0x62, 0x7d, 0xe5, 0x39, 0x88, 0x50, 0x33, 0x5f,
+	0xfc, 0x65, 0xde, 0x50, 0x2c, 0xad, 0xcb, 0xf9, 0x5e, 0x5c, 0xea, 0x5c, 0xcb, 0xe6, 0xd6, 0x38,
+	0xe2, 0x57, 0xf2, 0xd7, 0xc5, 0x4f, 0x4b, 0x0e, 0x38, 0x1f, 0xb2, 0x90, 0x24, 0x5c, 0xdf, 0x45,
+	0xf7, 0x22, 0xeb, 0x6f, 0x19, 0x70, 0x12, 0xdf, 0x32, 0x07, 0x03, 0xe4, 0xf7, 0xe5, 0xe8, 0xeb,
+	0x47, 0x7b, 0x4d, 0x78, 0x05, 0x4c, 0xb6, 0xec, 0x46, 0xb1, 0xeb, 0xb9, 0x1f, 0x3b, 0xc2, 0x5d,
+	0xcf, 0xb0, 0x17, 0x69, 0xca, 0xc3, 0x24, 0xc1, 0xfa, 0xcb, 0xd8, 0x8f, 0x9d, 0xc4, 0x29, 0x0b,
+	0x9c, 0xfe, 0x4d, 0xf6, 0x1c, 0x65, 0x91, 0x80, 0xf9, 0x16, 0x34, 0xfd, 0xc7, 0x44, 0xf4, 0x49,
+	0x19, 0x6e, 0xce, 0xc5, 0xfb, 0x8f, 0x37, 0xb1, 0xb6, 0x04, 0x83, 0xf0, 0x3b, 0x9f, 0x21, 0x7a,
+	0x3c, 0x72, 0xc3, 0xc4, 0xc4, 0x51, 0x75, 0x30, 0x59, 0xe1, 0xc9, 0x8a, 0xdf, 0x0d, 0x36, 0x13,
+	0x38, 0x95, 0x33, 0x74, 0x53, 0x4a, 0x94, 0x79, 0xf4, 0xcf, 0x54, 0x6b, 0x98, 0x44, 0x99, 0xa5,
+	0x2a, 0x8d, 0x31, 0x3f, 0x0b, 0x9d, 0x90, 0xb7, 0x25, 0xaf, 0x1f, 0x6d, 0x09, 0x43, 0xcd, 0x8d,
+	0x0f, 0x02, 0x32, 0xd2, 0x8e, 0xc7, 0xf5, 0xde, 0x09, 0x80, 0x18, 0xbe, 0x53, 0x49, 0x6e, 0x65,
+	0x8c, 0xff, 0x7b, 0x7a, 0x7a, 0xf8, 0xf3, 0x16, 0xd6, 0x5d, 0x58, 0xa4, 0xca, 0x7a, 0xfa, 0x3c,
+	0x03, 0x0d, 0x1b, 0xb2, 0x0a, 0xb3, 0x43, 0x67, 0x14, 0x21, 0x6a, 0x1d, 0x53, 0xb5, 0xd9, 0x1f,
+	0x79, 0xd5, 0x84, 0x7c, 0xc9, 0x84, 0x12, 0x28, 0x88, 0x5c, 0xf5, 0xee, 0xc1, 0x73, 0x9b, 0xf8,
+	0x4f, 0x2e, 0x72, 0x0a, 0x3e, 0xf3, 0x3e, 0x74, 0xa8, 0x72, 0xee, 0x19, 0x95, 0xf7, 0x8b, 0x06,
+	0x95, 0x12, 0x13, 0x09, 0xba, 0x83, 0xf9, 0x70, 0x95, 0x04, 0x1a, 0x29, 0x12, 0x98, 0xe6, 0x76,
+	0x4a, 0x93, 0xb8, 0x9d, 0x72, 0x9a, 0xdb, 0x49, 0xab, 0x01, 0x66, 0xd2, 0x6a, 0x00, 0xeb, 0x9b,
+	0xe4, 0xc6, 0xc6, 0x5b, 0xf5, 0xae, 0x1b, 0xc5, 0xc1, 0x14, 0x9a, 0x94, 0x5c, 0x47, 0x7b, 0x2c,
+	0x52, 0x21, 0x97, 0x55, 0xda, 0x44, 0xfa, 0x63, 0xfd, 0x02, 0x7d, 0x1f, 0x29, 0x53, 0xfb, 0x74,
+	0xcf, 0xad, 0xcc, 0x45, 0x64, 0x6c, 0x27, 0x4a, 0x7d, 0x93, 0x69, 0xb0, 0x79, 0x16, 0xeb, 0x5b,
+	0x06, 0x00, 0x59, 0xad, 0xe4, 0x59, 0x98, 0x42, 0xa7, 0x64, 0xbe, 0xcb, 0x7b, 0xf2, 0xf0, 0x43,
+	0x59, 0x79, 0xf8, 0xe1, 0x14, 0x00, 0x79, 0x59, 0x85, 0x2e, 0x63, 0x76, 0xf0, 0x11, 0x08, 0x59,
+	0xc5, 0xbf, 0x66, 0xc0, 0x22, 0xa9, 0x9e, 0x34, 0xe4, 0x93, 0xf2, 0x85, 0x49, 0x1a, 0x3f, 0x23,
+	0x37, 0xde, 0xfa, 0x33, 0x06, 0x8e, 0x8d, 0xb2, 0xfd, 0x49, 0xb7, 0xcf, 0x7a, 0x4a, 0xd8, 0x03,
+	0x45, 0x40, 0xbd, 0x11, 0xba, 0x3b, 0xf1, 0x51, 0xbb, 0x0b, 0x58, 0xff, 0xde, 0x00, 0x33, 0x5b,
+	0xad, 0x26, 0xb7, 0xa1, 0xc9, 0x8d, 0x55, 0x2b, 0x21, 0x6d, 0x21, 0xb3, 0xc3, 0x16, 0x3b, 0xbb,
+	0x62, 0xb7, 0x44, 0x0a, 0x5e, 0x9e, 0x78, 0xfb, 0xbe, 0x00, 0xf3, 0x9e, 0x3b, 0x70, 0xe3, 0x04,
+	0x93, 0x52, 0xeb, 0x06, 0x81, 0x72, 0xac, 0x0b, 0xb0, 0xe0, 0xf4, 0xe2, 0x91, 0xe3, 0x25, 0x68,
+	0x4c, 0x03, 0x44, 0xc1, 0x1c, 0xef, 0x1c, 0x34, 0xf1, 0x13, 0x4a, 0xae, 0xdf, 0x65, 0xd6, 0xe7,
+	0x54, 0xc6, 0xda, 0xa0, 0x40, 0x6a, 0x65, 0x6e, 0xfd, 0x12, 0x95, 0x81, 0xeb, 0x06, 0x76, 0x9a,
+	0x6d, 0xf9, 0x33, 0x30, 0xdb, 0xc7, 0xa5, 0xf0, 0x5d, 0x79, 0x61, 0xa2, 0x3d, 0x39, 0xad, 0x94,
+	0xe5, 0xc2, 0x86, 0x18, 0xeb, 0x8e, 0xbf, 0x15, 0x07, 0xc3, 0xa3, 0xb1, 0x94, 0x78, 0x1f, 0xea,
+	0x64, 0x39, 0x5f, 0x8f, 0x6d, 0x37, 0x9a, 0x72, 0xe3, 0x5b, 0xff, 0xd0, 0x80, 0x25, 0xa5, 0xb5,
+	0xd3, 0x8c, 0xdc, 0x73, 0xd8, 0x6b, 0xc3, 0xef, 0x46, 0x71, 0x30, 0x64, 0x37, 0xe6, 0xb9, 0x1e,
+	0x2d, 0xdb, 0xbc, 0x09, 0xf3, 0xf4, 0x1c, 0xed, 0x3a, 0x71, 0x37, 0x74, 0xa3, 0x47, 0x8c, 0xff,
+	0x3e, 0x93, 0x7b, 0x08, 0xd3, 0xee, 0xd9, 0x0d, 0x9a, 0x8d, 0xfe, 0x59, 0xff, 0xc4, 0x80, 0x17,
+	0xee, 0x05, 0x4f, 0xa4, 0xf7, 0x47, 0x1f, 0x04, 0xcf, 0xc8, 0x05, 0xa7, 0xc8, 0x1e, 0x3f, 0x8c,
+	0x2a, 0xea, 0x57, 0x0d, 0x38, 0x3f, 0xa1, 0xc9, 0xd3, 0x1d, 0x22, 0xc9, 0x95, 0x86, 0xae, 0xd7,
+	0x94, 0x3b, 0x1e, 0xfb, 0x61, 0x9c, 0x12, 0xe5, 0xd3, 0xc5, 0x75, 0xeb, 0x1f, 0x94, 0x88, 0x7c,
+	0x4a, 0x7e, 0xc4, 0xe9, 0x06, 0x0e, 0x9d, 0x78, 0xc4, 0x12, 0x86, 0x67, 0xf6, 0x38, 0xdc, 0x84,
+	0x37, 0xdc, 0x2a, 0x87, 0x7a, 0xc3, 0x6d, 0x56, 0xff, 0x86, 0x9b, 0xf5, 0xa7, 0x0d, 0x58, 0x95,
+	0xfc, 0x22, 0xa5, 0x31, 0x2b, 0xb4, 0x09, 0x6f, 0xc2, 0x1c, 0xad, 0x27, 0x6a, 0x97, 0x74, 0x2f,
+	0xca, 0x0a, 0xeb, 0x05, 0xdd, 0x83, 0x6e, 0x36, 0xcf, 0x6b, 0xfd, 0x0d, 0xaa, 0x95, 0xd5, 0x4c,
+	0xd9, 0x74, 0x8e, 0x5e, 0x75, 0xd5, 0xea, 0x23, 0x37, 0x1c, 0x8f, 0x7e, 0x04, 0x6c, 0x39, 0xbb,
+	0xe5, 0x91, 0x07, 0x75, 0x59, 0x78, 0xd7, 0xbb, 0xce, 0xee, 0xd1, 0x5e, 0x84, 0x7f, 0xc7, 0x80,
+	0x05, 0xd2, 0x96, 0xa4, 0xc2, 0x31, 0xd1, 0x3e, 0x3a, 0x50, 0xa5, 0x43, 0x29, 0x4a, 0x13, 0xff,
+	0x13, 0x94, 0x6d, 0xaf, 0x80, 0xc9, 0x95, 0x9f, 0xd9, 0x18, 0x3e, 0x2c, 0x45, 0x32, 0x78, 0xc4,
+	0x0f, 0x7a, 0xc4, 0x8e, 0x87, 0x7c, 0x14, 0x45, 0xc9, 0x1b, 0xc4, 0x75, 0x01, 0xbb, 0x47, 0x02,
+	0x7c, 0xad, 0xa4, 0x06, 0x6a, 0x9a, 0x49, 0x7c, 0x3b, 0xf5, 0x7e, 0xdf, 0xb9, 0x5c, 0xe2, 0x2a,
+	0xd5, 0xc8, 0xef, 0x37, 0xdf, 0x2d, 0xc3, 0x05, 0xfa, 0x7c, 0x97, 0x42, 0x9d, 0xbe, 0xe8, 0xc6,
+	0x7b, 0xd7, 0x47, 0x71, 0x70, 0xcb, 0xf5, 0xbc, 0x23, 0xf7, 0x6f, 0x4c, 0xbc, 0xcd, 0xca, 0x87,
+	0xf0, 0x36, 0x3b, 0x01, 0xe4, 0xfd, 0x5a, 0xfc, 0xf0, 0x85, 0xc7, 0x1c, 0x0d, 0xaa, 0x0e, 0x6b,
+	0xba, 0xf9, 0x58, 0xef, 0x5f, 0x7b, 0x57, 0xbb, 0xc4, 0x0b, 0x0d, 0xc3, 0xd1, 0x3b, 0xde, 0xfe,
+	0x59, 0x03, 0x2e, 0x4e, 0x6c, 0xcb, 0x34, 0x0b, 0xe6, 0x02, 0x2c, 0x90, 0x38, 0x24, 0x19, 0xfe,
+	0xae, 0x49, 0xc1, 0x8c, 0x1d, 0xc3, 0xf6, 0xd6, 0x3c, 0xa8, 0x11, 0x13, 0x1b, 0x6e, 0x7a, 0x8e,
+	0x3f, 0x21, 0xbe, 0x29, 0xbe, 0x12, 0x26, 0x66, 0x74, 0xe2, 0x4a, 0x28, 0x8c, 0xe8, 0x30, 0x82,
+	0x64, 0x42, 0xc7, 0xaf, 0x84, 0x89, 0x01, 0x1d, 0xd6, 0x63, 0x4b, 0x77, 0x41, 0xf2, 0x8d, 0x83,
+	0x9b, 0x3f, 0xb7, 0x11, 0xee, 0xdb, 0x23, 0x5f, 0x09, 0xbf, 0x3c, 0xdd, 0x11, 0x5a, 0x19, 0x7a,
+	0x8e, 0x3f, 0x96, 0xdf, 0xcb, 0xf6, 0xde, 0xa6, 0x99, 0xac, 0x2d, 0x68, 0x30, 0x28, 0x15, 0x09,
+	0xe0, 0x41, 0xe1, 0x7e, 0x8a, 0x4c, 0x2a, 0x90, 0x00, 0xf0, 0x46, 0x10, 0x3f, 0xb2, 0x6c, 0xa0,
+	0x29, 0xa0, 0xe4, 0x62, 0xf5, 0x23, 0x03, 0x4e, 0xc9, 0xb6, 0x1d, 0x37, 0xf6, 0x6f, 0x85, 0xce,
+	0x94, 0xef, 0xad, 0xff, 0xb8, 0x3c, 0xaf, 0x3b, 0x50, 0xdd, 0x61, 0x8d, 0x25, 0x33, 0x67, 0xd8,
+	0xe2, 0xdf, 0x7a, 0x0f, 0x56, 0x89, 0xb4, 0x8f, 0x44, 0x68, 0x21, 0x36, 0x74, 0x87, 0x97, 0x51,
+	0x0c, 0x01, 0x92, 0x62, 0xc6, 0x69, 0x04, 0xb9, 0x87, 0x44, 0x49, 0xf5, 0x90, 0x68, 0xc3, 0x1c,
+	0x33, 0xe3, 0xe3, 0xce, 0xd4, 0xec, 0x37, 0xf7, 0x42, 0xf9, 0xbb, 0x06, 0x1c, 0xcf, 0x34, 0x7f,
+	0x9a, 0x95, 0x87, 0xc3, 0xed, 0x46, 0x5d, 0xde, 0x0a, 0xca, 0x32, 0xd7, 0xdc, 0xe8, 0x5d, 0xd6,
+	0x0e, 0xf2, 0x58, 0x38, 0xae, 0x99, 0x9b, 0xdf, 0xf3, 0x5f, 0xfc, 0x10, 0x5a, 0x62, 0x53, 0x94,
+	0x63, 0xbd, 0x2e, 0x35, 0x92, 0x22, 0x63, 0x4f, 0x3d, 0xee, 0x23, 0x7e, 0xc4, 0xde, 0x73, 0x3f,
+	0x34, 0xe0, 0x78, 0xa6, 0xaa, 0xe9, 0xec, 0x47, 0xe6, 0x58, 0xe9, 0xe3, 0xe2, 0xc6, 0xc9, 0x2e,
+	0x6d, 0x1c, 0xdf, 0x7c, 0x17, 0x9a, 0xfc, 0xd8, 0xa6, 0x26, 0x28, 0xe5, 0xe2, 0x26, 0x28, 0x0d,
+	0x96, 0x13, 0x03, 0x22, 0xeb, 0xd7, 0x4a, 0xd4, 0x29, 0x90, 0x1b, 0x2e, 0x1d, 0xed, 0x65, 0xe3,
+	0x12, 0x10, 0x16, 0x94, 0xbd, 0x78, 0xc1, 0x03, 0x4e, 0xe0, 0x25, 0x32, 0x8f, 0xe1, 0xe4, 0x1c,
+	0xbf, 0x7f, 0x90, 0xc8, 0x36, 0xd8, 0x9f, 0x2c, 0x08, 0x63, 0xec, 0x37, 0xca, 0x5e, 0xc6, 0xb0,
+	0xc6, 0xbd, 0x31, 0x11, 0x84, 0xf1, 0xfb, 0x68, 0xdf, 0x9e, 0x8b, 0xe8, 0x07, 0xb6, 0x0d, 0xeb,
+	0xa3, 0xa8, 0x47, 0x07, 0x84, 0xdb, 0x6a, 0x27, 0x10, 0xeb, 0x5f, 0x30, 0x47, 0xc6, 0x64, 0x74,
+	0x3e, 0xb1, 0x7b, 0x4d, 0xe2, 0x78, 0x5e, 0x2e, 0xee, 0x78, 0x6e, 0xb9, 0xb0, 0xb8, 0x8e, 0xe9,
+	0xb8, 0x87, 0x4f, 0x96, 0xa3, 0x65, 0x59, 0x1f, 0x89, 0x37, 0x2a, 0x68, 0x30, 0xea, 0x23, 0xad,
+	0xec, 0x77, 0x0d, 0x58, 0x56, 0x6b, 0x9b, 0x4e, 0xb0, 0xaf, 0xc4, 0x53, 0x3f, 0xad, 0xcd, 0x93,
+	0xd4, 0x45, 0x91, 0xcd, 0xb7, 0xd8, 0xc3, 0x4c, 0xd4, 0xda, 0xac, 0x3c, 0xb9, 0x3a, 0xa2, 0x84,
+	0x22, 0x17, 0x2f, 0x6b, 0x00, 0xcb, 0x4a, 0xa4, 0xaa, 0x5b, 0x8e, 0xeb, 0x8d, 0x42, 0x54, 0xc0,
+	0x83, 0xf2, 0x35, 0xe5, 0x3d, 0xdb, 0x49, 0x1d, 0x64, 0x54, 0xfe, 0xdf, 0x19, 0xb0, 0xaa, 0x8f,
+	0x39, 0x3a, 0x81, 0xe1, 0x39, 0xaa, 0x98, 0x8e, 0xcf, 0x43, 0x83, 0x19, 0xe6, 0x6f, 0xef, 0xc7,
+	0x48, 0x5c, 0x24, 0x28, 0xec, 0x06, 0x06, 0x11, 0x56, 0x8a, 0x18, 0xec, 0x50, 0x0c, 0x6a, 0x5d,
+	0x03, 0x04, 0x44, 0x10, 0xac, 0x8f, 0xb0, 0x82, 0x80, 0x3f, 0xb5, 0x20, 0x9a, 0x74, 0xa4, 0xeb,
+	0x8e, 0xbd, 0xa6, 0x3d, 0x14, 0xd1, 0x18, 0x65, 0xd6, 0x31, 0xff, 0x7e, 0x36, 0x35, 0xdb, 0x88,
+	0x1f, 0x2e, 0x3a, 0xa1, 0xed, 0xec, 0x34, 0xcb, 0xfe, 0xfd, 0x24, 0xd0, 0xfc, 0x61, 0x98, 0x45,
+	0x1e, 0x51, 0x16, 0xff, 0x90, 0xc2, 0xb8, 0x32, 0x84, 0x16, 0x56, 0x9e, 0xe8, 0xcc, 0xa6, 0x14,
+	0xc6, 0x32, 0x93, 0xc2, 0xac, 0x3f, 0x09, 0x56, 0x5a, 0x0a, 0x2a, 0x29, 0x1d, 0x0f, 0x3f, 0xc5,
+	0x17, 0xf5, 0x8f, 0x96, 0x67, 0x22, 0x26, 0x5a, 0x7f, 0x64, 0x40, 0x3b, 0xaf, 0xfa, 0xa2, 0xc2,
+	0x66, 0x39, 0xda, 0x46, 0x49, 0x8d, 0xb6, 0xb1, 0x06, 0x4b, 0x7c, 0xe4, 0x65, 0x05, 0x11, 0x33,
+	0x5e, 0x63, 0x49, 0xf7, 0x12, 0x77, 0x91, 0x8b, 0xb0, 0xc0, 0xf0, 0x44, 0x08, 0x19, 0x7a, 0x81,
+	0x98, 0xa7, 0xe0, 0x75, 0x06, 0xc5, 0xdc, 0x17, 0x51, 0xd1, 0x51, 0xc3, 0xc8, 0x0a, 0x61, 0x55,
+	0x6b, 0x18, 0x42, 0xcc, 0x22, 0xb1, 0x68, 0xf4, 0xdc, 0xd8, 0x81, 0x9d, 0x66, 0x39, 0x6d, 0x42,
+	0x43, 0x52, 0x19, 0xf3, 0xd5, 0xf4, 0xf2, 0x44, 0x51, 0xb3, 0xdc, 0x00, 0xa5, 0x04, 0xac, 0x8b,
+	0x39, 0x93, 0xf3, 0x0a, 0xf7, 0x11, 0x33, 0x2a, 0x05, 0x1e, 0xbd, 0xb6, 0xfe, 0xb5, 0x01, 0x67,
+	0xf3, 0x5b, 0x37, 0xcd, 0x48, 0x5e, 0x81, 0xa5, 0x68, 0xdf, 0xef, 0xa5, 0x83, 0xf5, 0xb3, 0x40,
+	0xaa, 0x34, 0x49, 0x09, 0xd5, 0xbf, 0x01, 0xd5, 0x1d, 0x7a, 0x82, 0xf0, 0x7d, 0x77, 0x69, 0x62,
+	0x70, 0x44, 0x76, 0xe4, 0xd8, 0x22, 0xa7, 0xf5, 0x18, 0x8e, 0x93, 0x47, 0x66, 0x12, 0xfa, 0x72,
+	0xe4, 0x66, 0x59, 0xbf, 0x8d, 0x65, 0xf5, 0x8a, 0xd5, 0x05, 0xbd, 0x71, 0x16, 0x11, 0x3e, 0x6a,
+	0x9e, 0x88, 0x28, 0xe9, 0x9e, 0x88, 0xc0, 0x66, 0x04, 0xf4, 0xc5, 0x18, 0xe6, 0x75, 0x90, 0xc4,
+	0x58, 0x62, 0xcc, 0xe7, 0x0a, 0x49, 0xde, 0xa2, 0xa9, 0x22, 0xce, 0x12, 0x7d, 0xeb, 0x89, 0x06,
+	0xad, 0xe5, 0xb2, 0x17, 0xfe, 0x8f, 0x77, 0x52, 0x3b, 0x3b, 0x58, 0xd3, 0x4c, 0x7a, 0x07, 0xaa,
+	0x91, 0xef, 0x0c, 0xa3, 0xbd, 0x20, 0x66, 0xd7, 0x26, 0xf1, 0x6f, 0x7e, 0x8e, 0x16, 0x88, 0xc6,
+	0x3e, 0xa4, 0xa6, 0x19, 0x47, 0x9b, 0x65, 0xc3, 0xb1, 0xb4, 0xce, 0x6c, 0xc9, 0x86, 0x35, 0x8c,
+	0xf6, 0xde, 0x9b, 0x4a, 0x9d, 0x53, 0x64, 0x27, 0xe9, 0xe2, 0xc8, 0x96, 0xb5, 0x71, 0x64, 0xad,
+	0xc7, 0x44, 0x70, 0x2f, 0xc9, 0x40, 0xa6, 0x35, 0x0d, 0x3c, 0x0b, 0xf5, 0x60, 0x88, 0x42, 0x47,
+	0x69, 0x9e, 0x0c, 0xb2, 0xfe, 0x33, 0x95, 0x3c, 0x6b, 0xea, 0x9c, 0x66, 0x2a, 0x27, 0xd6, 0x8b,
+	0x79, 0x05, 0x7c, 0x4a, 0xfa, 0xc2, 0xaf, 0x8c, 0xff, 0x92, 0x4b, 0x28, 0xb3, 0x12, 0xe6, 0xae,
+	0x64, 0x09, 0x00, 0xcb, 0x03, 0x5d, 0xbf, 0xbb, 0xe3, 0xb9, 0xbb, 0x7b, 0x31, 0xf3, 0x1f, 0xab,
+	0xba, 0xfe, 0x2d, 0xf2, 0x8f, 0xef, 0xf8, 0x78, 0x2f, 0x0b, 0x67, 0x31, 0xf6, 0x67, 0x7d, 0xcf,
+	0x80, 0xe3, 0x5b, 0xc2, 0xf6, 0x91, 0xc5, 0xdc, 0x3d, 0x6a, 0x5f, 0x83, 0x54, 0x04, 0xdf, 0xb2,
+	0x26, 0x82, 0xaf, 0x7c, 0x79, 0x9f, 0x3a, 0x08, 0xdf, 0x58, 0x07, 0x27, 0xeb, 0xfb, 0x25, 0x38,
+	0x9e, 0xa9, 0x6a, 0xba, 0xf8, 0x0a, 0x73, 0xac, 0x74, 0xc6, 0x87, 0x4f, 0xbe, 0xca, 0xf1, 0x0c,
+	0xa6, 0x0b, 0x2d, 0x26, 0xb7, 0x4d, 0xac, 0x4b, 0xca, 0xf9, 0x6f, 0x45, 0xe4, 0xb4, 0x9b, 0xc9,
+	0x6a, 0xb9, 0x35, 0x0a, 0x95, 0xd6, 0xce, 0xfb, 0x0a, 0xb0, 0x73, 0x1d, 0x96, 0x34, 0x68, 0x07,
+	0x09, 0x57, 0x85, 0xad, 0xaa, 0x15, 0x93, 0x3c, 0x16, 0xde, 0xe3, 0x68, 0xef, 0x77, 0x11, 0xcc,
+	0xd3, 0x7a, 0xb6, 0x38, 0x09, 0x94, 0xa2, 0x89, 0x18, 0x6a, 0x1c, 0x4d, 0x6d, 0xc8, 0x86, 0x52,
+	0x4e, 0xc8, 0x86, 0x4e, 0xca, 0xda, 0x55, 0x7e, 0x20, 0xe6, 0x8f, 0x8d, 0x94, 0xd1, 0xa3, 0xe8,
+	0xea, 0x34, 0x2b, 0xe5, 0x0e, 0xcc, 0x73, 0xab, 0x31, 0xca, 0xd0, 0x8f, 0x8b, 0x00, 0xaf, 0x76,
+	0xda, 0x6e, 0xb2, 0x9c, 0x14, 0x8c, 0x5f, 0x74, 0xf2, 0xd1, 0x47, 0xa2, 0x9c, 0x72, 0xe1, 0x72,
+	0x00, 0x67, 0xa3, 0x30, 0x2c, 0x81, 0x3f, 0xbe, 0x41, 0xcc, 0xcd, 0xdc, 0x08, 0x8f, 0xdf, 0x91,
+	0x68, 0xf4, 0xf1, 0x05, 0xef, 0xa9, 0xe3, 0x52, 0x87, 0xa1, 0x60, 0x14, 0xf3, 0xd0, 0x61, 0x18,
+	0xf6, 0x80, 0x82, 0xf0, 0x33, 0xb5, 0xcb, 0x72, 0x43, 0xc4, 0x95, 0x34, 0x4f, 0xee, 0xf9, 0xb6,
+	0x7a, 0x4d, 0x3f, 0xaf, 0xdf, 0x2c, 0x49, 0x81, 0xca, 0x6d, 0x3d, 0xeb, 0x55, 0x52, 0x2e, 0xee,
+	0x55, 0x32, 0x53, 0xdc, 0xab, 0xa4, 0x52, 0xdc, 0xab, 0x64, 0x36, 0xc7, 0xab, 0xc4, 0xfa, 0x2b,
+	0x06, 0xb4, 0xe5, 0x8e, 0x4c, 0x6f, 0xc6, 0xb0, 0x21, 0xf9, 0xa9, 0xd0, 0xe5, 0x77, 0x69, 0xd2,
+	0xe8, 0xf1, 0xe9, 0x48, 0x3c, 0x5a, 0xb0, 0x8d, 0x75, 0x0b, 0xb7, 0x45, 0x46, 0x1b, 0xe7, 0x6a,
+	0x5d, 0xc4, 0x8d, 0x27, 0x3b, 0x82, 0x65, 0xdd, 0x08, 0xaa, 0x1e, 0x68, 0x33, 0x29, 0x0f, 0x34,
+	0xeb, 0x1b, 0xc4, 0xba, 0x5f, 0xdb, 0xfc, 0x67, 0x6e, 0xac, 0xf2, 0xeb, 0x06, 0x9c, 0xc9, 0xad,
+	0xec, 0x13, 0x9f, 0xa4, 0xcb, 0x2f, 0x41, 0x4d, 0xbc, 0x04, 0x6e, 0x56, 0x61, 0xe6, 0xd6, 0xc8,
+	0xf3, 0x5a, 0xc7, 0xcc, 0x1a, 0x54, 0xc8, 0x5b, 0x18, 0x2d, 0x03, 0x7f, 0x92, 0x68, 0xc2, 0xad,
+	0xd2, 0xe5, 0xcf, 0x43, 0x4d, 0x04, 0xbf, 0x33, 0xeb, 0x30, 0xf7, 0xd0, 0x7f, 0xdf, 0x0f, 0x9e,
+	0xfa, 0xad, 0x63, 0xe6, 0x1c, 0x94, 0xaf, 0x7b, 0x5e, 0xcb, 0x30, 0x9b, 0x50, 0xdb, 0x8a, 0x43,
+	0xe4, 0x0c, 0x5c, 0x7f, 0xb7, 0x55, 0x32, 0xe7, 0x01, 0xa8, 0xa5, 0xa0, 0xdb, 0x73, 0xbc, 0x56,
+	0xf9, 0xf2, 0xc7, 0x30, 0xaf, 0x3e, 0x7c, 0x66, 0x36, 0x70, 0x70, 0xa7, 0xf8, 0xe6, 0x47, 0x6e,
+	0x14, 0xb7, 0x8e, 0x61, 0xfc, 0xfb, 0x41, 0xbc, 0x19, 0xa2, 0x08, 0xf9, 0x71, 0xcb, 0x30, 0x01,
+	0x66, 0xbf, 0xe0, 0x6f, 0xb8, 0xd1, 0xa3, 0x56, 0xc9, 0x5c, 0x62, 0x21, 0xc4, 0x1c, 0xef, 0x0e,
+	0x7b, 0x4d, 0xac, 0x55, 0xc6, 0xd9, 0xc5, 0xdf, 0x8c, 0xd9, 0x82, 0x86, 0x40, 0xb9, 0xbd, 0xf9,
+	0xb0, 0x55, 0xa1, 0xad, 0xc7, 0x9f, 0xb3, 0x97, 0xfb, 0xd0, 0x4a, 0xbf, 0x0b, 0x8a, 0xcb, 0xa4,
+	0x9d, 0x10, 0xa0, 0xd6, 0x31, 0xdc, 0x33, 0xa6, 0x1e, 0x6e, 0x19, 0xe6, 0x02, 0xd4, 0x25, 0x7e,
+	0xaf, 0x55, 0xc2, 0x80, 0xdb, 0xe1, 0x90, 0x07, 0x29, 0xa0, 0x4d, 0x20, 0xcb, 0x1c, 0x8f, 0xc4,
+	0xcc, 0xe5, 0x1b, 0x50, 0xe5, 0x4f, 0x38, 0x60, 0x54, 0x36, 0x44, 0xf8, 0xb7, 0x75, 0xcc, 0x5c,
+	0x84, 0x26, 0x4e, 0x14, 0x43, 0xd0, 0x32, 0x4c, 0x93, 0x99, 0xfb, 0x8b, 0x63, 0xa4, 0x55, 0xba,
+	0x7c, 0x0d, 0x20, 0x09, 0x6c, 0x8f, 0x9b, 0x73, 0xc7, 0x7f, 0xe2, 0x78, 0x6e, 0x9f, 0xb6, 0x8d,
+	0xc9, 0xe4, 0xe8, 0xe8, 0xdc, 0x25, 0x32, 0xb0, 0x56, 0xe9, 0xf2, 0x3b, 0x50, 0xe5, 0x91, 0xd3,
+	0x31, 0x9c, 0xba, 0xef, 0xd3, 0x99, 0xd9, 0x42, 0x31, 0x9d, 0xc7, 0xeb, 0x03, 0xe4, 0xf7, 0x5b,
+	0x25, 0xdc, 0x0c, 0x6a, 0x20, 0xcb, 0xdc, 0x02, 0x5a, 0xe5, 0xcb, 0x5f, 0x82, 0x79, 0x55, 0xec,
+	0x6d, 0x1e, 0x87, 0xa5, 0x0d, 0xb4, 0xe3, 0x8c, 0x3c, 0x2e, 0xcf, 0xfe, 0x42, 0xd8, 0x47, 0x61,
+	0xeb, 0x18, 0x6e, 0x31, 0x83, 0x30, 0xed, 0x68, 0xcb, 0x30, 0x9f, 0x13, 0xde, 0xe6, 0x77, 0x95,
+	0xdb, 0x54, 0xab, 0x74, 0xf9, 0x43, 0x58, 0xd2, 0xbc, 0xe2, 0x60, 0xae, 0xc0, 0xa2, 0x02, 0xbe,
+	0x1f, 0xf8, 0xb8, 0xb9, 0xc7, 0x53, 0xd8, 0x5b, 0x43, 0x6c, 0xd9, 0xd2, 0x32, 0x32, 0xf8, 0x9b,
+	0x4e, 0xef, 0x51, 0xab, 0x74, 0xd9, 0x81, 0xc5, 0x0c, 0x11, 0x37, 0xdb, 0xea, 0x51, 0xb1, 0x11,
+	0x52, 0x8a, 0xd9, 0x3a, 0x86, 0xdb, 0x29, 0xa7, 0xac, 0x73, 0x56, 0xb9, 0x65, 0xd0, 0xfe, 0x26,
+	0x49, 0xd7, 0xb7, 0x83, 0x10, 0x27, 0x94, 0xae, 0xfd, 0xf6, 0x4d, 0x00, 0xfa, 0x40, 0x69, 0x10,
+	0x84, 0x7d, 0xd3, 0x23, 0x6f, 0x39, 0xe3, 0x9c, 0x81, 0xcf, 0x5f, 0x4f, 0x8c, 0xcc, 0x35, 0x2d,
+	0x43, 0x97, 0x45, 0x64, 0xcb, 0xa6, 0xf3, 0x82, 0x16, 0x3f, 0x85, 0x6c, 0x1d, 0x33, 0x07, 0xa4,
+	0x36, 0x4c, 0xb5, 0x1e, 0xb8, 0xbd, 0x47, 0xe2, 0x55, 0xd3, 0x9c, 0xb7, 0xc7, 0xb3, 0xa8, 0xbc,
+	0xbe, 0x73, 0xda, 0xfa, 0xb6, 0xe2, 0x90, 0xb8, 0xa1, 0x53, 0x3a, 0x64, 0x1d, 0x33, 0x1f, 0x13,
+	0x41, 0x39, 0xae, 0xdd, 0x8d, 0x62, 0xb7, 0x17, 0xf1, 0x0a, 0xaf, 0xe5, 0x57, 0x98, 0x41, 0x3e,
+	0x60, 0x95, 0x1e, 0xb6, 0x5d, 0x09, 0x9e, 0x26, 0x1b, 0x20, 0x32, 0xf5, 0xaf, 0x60, 0xa9, 0x48,
+	0xbc, 0x96, 0x97, 0x0a, 0xe1, 0x8a, 0xda, 0x5c, 0x98, 0xc7, 0x89, 0xd2, 0xb3, 0x32, 0x2f, 0xe6,
+	0x15, 0x90, 0x91, 0x1e, 0x75, 0x2e, 0x17, 0x41, 0x15, 0x55, 0x7d, 0x99, 0xee, 0xec, 0x49, 0x55,
+	0xa9, 0x38, 0xbc, 0xaa, 0x71, 0x47, 0x80, 0x75, 0xcc, 0xfc, 0x3a, 0x8e, 0x45, 0x45, 0xbd, 0x68,
+	0x93, 0xe2, 0x73, 0x84, 0x67, 0x29, 0xb4, 0x82, 0x35, 0x7c, 0x39, 0x4d, 0x97, 0xf2, 0x5b, 0x9f,
+	0x11, 0xa7, 0x17, 0x6f, 0xbd, 0x54, 0xfc, 0xb8, 0xd6, 0x1f, 0xb8, 0x06, 0x0f, 0x8e, 0xe7, 0x08,
+	0xdb, 0xcc, 0x6b, 0xba, 0x7a, 0x72, 0x90, 0x0b, 0xd6, 0x36, 0x22, 0x9b, 0x34, 0xfd, 0x32, 0xef,
+	0x2b, 0x39, 0xd6, 0x6d, 0x29, 0x3c, 0x5e, 0xc7, 0x5a, 0x51, 0x74, 0x79, 0x2d, 0xe3, 0xfd, 0x27,
+	0xbd, 0xb7, 0xfb, 0x62, 0x4e, 0x19, 0x12, 0xce, 0xd8, 0xb5, 0x9c, 0x46, 0x15, 0x55, 0x3d, 0x50,
+	0x4e, 0x41, 0xf3, 0x42, 0xde, 0x52, 0x50, 0x83, 0xb8, 0x4d, 0x1a, 0xb7, 0x6f, 0x82, 0x49, 0x77,
+	0x2a, 0xb6, 0x5e, 0x1a, 0x51, 0x71, 0x47, 0x94, 0x4b, 0xdc, 0xb2, 0xa8, 0xbc, 0x9a, 0x57, 0x0f,
+	0x90, 0x43, 0x74, 0xa9, 0x0b, 0x70, 0x1b, 0xc5, 0xf7, 0x50, 0x1c, 0xba, 0xbd, 0x28, 0xdd, 0xa3,
+	0x84, 0x7e, 0x33, 0x04, 0x5e, 0xd5, 0xc5, 0x89, 0x78, 0xa2, 0x82, 0x6d, 0xa8, 0x13, 0xe9, 0x39,
+	0xd3, 0xc8, 0xe6, 0xe6, 0x4c, 0x29, 0xd3, 0x3b, 0x97, 0x26, 0x23, 0xca, 0xc4, 0x33, 0x65, 0x0a,
+	0x69, 0x5e, 0x2e, 0x64, 0x54, 0x39, 0x86, 0x78, 0xe6, 0x18, 0x60, 0xd2, 0x1e, 0x11, 0xfb, 0x00,
+	0x66, 0x71, 0xa2, 0xef, 0x91, 0x84, 0x31, 0xbe, 0x47, 0x0a, 0xa2, 0xa8, 0x03, 0xc1, 0x92, 0xc6,
+	0xe2, 0xcb, 0xbc, 0xa2, 0x2f, 0x22, 0x8b, 0x59, 0x70, 0xe9, 0xed, 0xc0, 0x32, 0x65, 0x81, 0x6c,
+	0xf5, 0xf1, 0x2b, 0xad, 0x37, 0xab, 0x0e, 0xb3, 0x60, 0x3d, 0x98, 0x3f, 0x09, 0x83, 0xa1, 0xda,
+	0x99, 0x57, 0xb4, 0x9d, 0xc9, 0xe0, 0x15, 0xac, 0xe2, 0x8b, 0xd0, 0x90, 0x2d, 0xa5, 0x4c, 0xfd,
+	0x68, 0xcb, 0x28, 0x05, 0x0b, 0xfe, 0x10, 0x16, 0x52, 0xaf, 0x5e, 0xe8, 0x17, 0x97, 0xfe, 0x69,
+	0x8c, 0x49, 0xa5, 0x3f, 0x05, 0x93, 0x1a, 0x4b, 0x28, 0xe3, 0xaf, 0xe7, 0xa3, 0xb2, 0x88, 0xbc,
+	0x92, 0x2b, 0x85, 0xf1, 0xc5, 0x0a, 0xfb, 0x59, 0x58, 0x49, 0x84, 0x64, 0xf2, 0xb4, 0x5c, 0x1d,
+	0x2f, 0x4f, 0xd3, 0xcc, 0xcc, 0xab, 0x07, 0xc8, 0x21, 0xea, 0xef, 0x41, 0x43, 0x0e, 0x77, 0x6d,
+	0x6a, 0xc5, 0xf3, 0x9a, 0xd0, 0xdb, 0x9d, 0x4b, 0x93, 0x11, 0x45, 0x25, 0x1f, 0xc2, 0x42, 0x2a,
+	0x26, 0xb9, 0x7e, 0xee, 0xf4, 0x81, 0xcb, 0x0b, 0x1c, 0xe0, 0x99, 0x38, 0xe4, 0xfa, 0x03, 0x3c,
+	0x2f, 0x5c, 0xf9, 0xe4, 0xfd, 0xd9, 0x54, 0xe2, 0xdb, 0x9a, 0xb9, 0x9d, 0x4f, 0x47, 0xd3, 0xed,
+	0xbc, 0x58, 0x00, 0x53, 0x8c, 0xd3, 0x9f, 0x33, 0xa0, 0x9d, 0x17, 0x50, 0xd6, 0x7c, 0x2d, 0x87,
+	0x3c, 0x8e, 0x0b, 0xb7, 0xd8, 0x79, 0xfd, 0x60, 0x99, 0x64, 0x76, 0x51, 0x8d, 0xa9, 0x9a, 0xc3,
+	0x99, 0xea, 0xe2, 0xae, 0x4e, 0x1a, 0xcd, 0x2f, 0x41, 0x53, 0x09, 0xb2, 0xaa, 0x1f, 0x4d, 0x5d,
+	0x1c, 0xd6, 0x49, 0x25, 0x3f, 0x80, 0xba, 0x14, 0x74, 0x55, 0xcf, 0x18, 0x64, 0xa3, 0xb2, 0x4e,
+	0x2a, 0xd5, 0x06, 0x48, 0x42, 0xad, 0x9a, 0xe7, 0xf3, 0x1b, 0x7b, 0x38, 0x6a, 0xc6, 0x78, 0x9c,
+	0xf1, 0xd4, 0x4c, 0x8d, 0xc1, 0x7a, 0x80, 0xd2, 0xf9, 0x9d, 0x69, 0x6c, 0xe9, 0xa9, 0xbb, 0xd2,
+	0x84, 0xd2, 0x43, 0xe8, 0xe4, 0xc7, 0xf9, 0x34, 0xdf, 0xc8, 0x35, 0xe4, 0x1b, 0xbb, 0x50, 0x27,
+	0xd4, 0xf9, 0xb3, 0xb0, 0xa2, 0x0d, 0x24, 0xa9, 0x27, 0x93, 0xe3, 0xa2, 0x7c, 0x76, 0x5e, 0x3d,
+	0x40, 0x0e, 0x69, 0x3f, 0xd4, 0x44, 0x84, 0x41, 0xf3, 0x05, 0xed, 0x03, 0xa8, 0xa9, 0x80, 0x91,
+	0x9d, 0xf3, 0x13, 0xb0, 0xe4, 0x23, 0x40, 0x1b, 0x3b, 0x2e, 0xb7, 0x6f, 0xb9, 0x21, 0x00, 0x3b,
+	0xaf, 0x1e, 0x20, 0x87, 0xa8, 0x3f, 0x84, 0xc5, 0x4c, 0x78, 0x31, 0x3d, 0xfd, 0xcc, 0x8b, 0x0a,
+	0xd7, 0x79, 0xa5, 0x20, 0xb6, 0xa8, 0x93, 0x5e, 0x52, 0x52, 0xa1, 0xb5, 0x72, 0x2f, 0x29, 0xfa,
+	0x60, 0x63, 0x9d, 0xb5, 0xa2, 0xe8, 0xa9, 0x6a, 0x53, 0x21, 0x9f, 0x72, 0xab, 0xd5, 0x87, 0xa3,
+	0xea, 0xac, 0x15, 0x45, 0x17, 0xd5, 0x7e, 0x44, 0xec, 0x0b, 0xd3, 0x61, 0x87, 0xcc, 0xbc, 0x82,
+	0x72, 0x02, 0x1e, 0x75, 0xae, 0x14, 0xc6, 0x17, 0x35, 0xef, 0xc0, 0xb2, 0x2e, 0xae, 0x90, 0x9e,
+	0xb3, 0x1c, 0x13, 0x81, 0x68, 0xd2, 0xfe, 0xdc, 0x06, 0x33, 0x1b, 0x4a, 0x48, 0x3f, 0xb0, 0xb9,
+	0x21, 0x87, 0x26, 0xd5, 0xf1, 0x2d, 0x03, 0x56, 0xf5, 0x71, 0x70, 0xcc, 0xbc, 0x75, 0x9f, 0x1f,
+	0xad, 0xa7, 0x73, 0xed, 0x20, 0x59, 0x52, 0x7b, 0x55, 0xf3, 0xc8, 0x70, 0x2e, 0x1d, 0xca, 0x0b,
+	0x43, 0xd2, 0x79, 0xf5, 0x00, 0x39, 0xe4, 0xfa, 0xb5, 0xd1, 0x21, 0xf4, 0xf5, 0x8f, 0x8b, 0xc1,
+	0xd1, 0x79, 0xf5, 0x00, 0x39, 0xa4, 0x4b, 0x97, 0x99, 0x0d, 0x94, 0xa0, 0x9f, 0xe7, 0xdc, 0x80,
+	0x0a, 0x93, 0xe6, 0xb9, 0x0f, 0x4b, 0x9a, 0xe8, 0x09, 0xfa, 0xdd, 0x92, 0x1f, 0x66, 0xa1, 0x98,
+	0x98, 0x24, 0x15, 0x41, 0x20, 0x97, 0x14, 0xe8, 0xe3, 0x1c, 0x74, 0xd6, 0x8a, 0xa2, 0x8b, 0x01,
+	0xb4, 0x01, 0x12, 0x17, 0x7d, 0x3d, 0x33, 0x91, 0x71, 0xe1, 0x9f, 0xd4, 0x95, 0x0f, 0xa0, 0x21,
+	0x3b, 0xd6, 0xeb, 0x79, 0x78, 0x8d, 0xeb, 0x7d, 0xb1, 0x43, 0x57, 0xe3, 0xb2, 0x7e, 0x35, 0x97,
+	0x02, 0xe6, 0x38, 0xd5, 0x77, 0x5e, 0x3d, 0x40, 0x0e, 0x31, 0x56, 0x5f, 0x87, 0xba, 0xe4, 0x0c,
+	0xad, 0x67, 0xe7, 0xb2, 0xbe, 0xdd, 0x9d, 0x8b, 0x13, 0xf1, 0x44, 0x0d, 0xdf, 0x33, 0xe0, 0xd4,
+	0x58, 0x6f, 0x60, 0x53, 0xfb, 0xe2, 0x76, 0x11, 0x9f, 0xe7, 0xce, 0x67, 0x0e, 0x91, 0x53, 0x34,
+	0xec, 0x9b, 0x54, 0xf4, 0x9d, 0xf6, 0x2a, 0x35, 0xaf, 0x14, 0x90, 0x91, 0xc8, 0x2e, 0xc3, 0x9d,
+	0xab, 0xc5, 0x33, 0x48, 0x87, 0x46, 0x53, 0x71, 0x83, 0xd4, 0x33, 0xe8, 0x3a, 0x97, 0xd2, 0xce,
+	0x8b, 0x05, 0x30, 0x45, 0x3d, 0x58, 0x1b, 0x39, 0xc1, 0xa1, 0xce, 0x7c, 0xeb, 0xf0, 0x1e, 0x81,
+	0x9d, 0xb7, 0x0f, 0x95, 0x57, 0x5e, 0x7e, 0xcc, 0xbe, 0x8a, 0x50, 0xf8, 0x0b, 0x39, 0x5d, 0x4b,
+	0xd3, 0xf5, 0x8b, 0x13, 0xf1, 0xe4, 0x7b, 0x31, 0x63, 0x1a, 0x84, 0x4e, 0xf9, 0xf2, 0x18, 0xc1,
+	0x33, 0x47, 0x2a, 0x2c, 0x76, 0x5e, 0xcc, 0xb8, 0xe6, 0x15, 0x16, 0x96, 0x6a, 0x09, 0x61, 0xae,
+	0xa7, 0x9f, 0x75, 0xcc, 0xfc, 0x46, 0xf2, 0x32, 0x82, 0xea, 0x22, 0xa7, 0x3f, 0x9c, 0xc7, 0xba,
+	0xd3, 0x4d, 0xee, 0xd9, 0x42, 0xca, 0xf1, 0x4b, 0x3f, 0x6e, 0x7a, 0xe7, 0xb6, 0xce, 0x4b, 0x85,
+	0x70, 0x65, 0xb1, 0x66, 0xca, 0x79, 0x4a, 0x5f, 0x9b, 0xde, 0x99, 0xab, 0xf3, 0x52, 0x21, 0xdc,
+	0xb4, 0x40, 0x26, 0x4f, 0x52, 0x9b, 0x08, 0x10, 0x26, 0x48, 0x6a, 0x75, 0x88, 0xf2, 0x29, 0x94,
+	0xf8, 0xd6, 0xe8, 0x4f, 0xa1, 0x8c, 0xef, 0xcd, 0xa4, 0x49, 0xe9, 0x41, 0x43, 0x76, 0x6b, 0x31,
+	0xc7, 0xed, 0x03, 0xd9, 0xcd, 0xa6, 0x73, 0x69, 0x32, 0xa2, 0xcc, 0x49, 0x6b, 0x7c, 0x09, 0xf2,
+	0x78, 0x83, 0x3c, 0x0f, 0x8b, 0xce, 0x95, 0xc2, 0xf8, 0x51, 0xc2, 0x79, 0x31, 0x47, 0xd7, 0x4f,
+	0xa8, 0xfe, 0xef, 0xd2, 0xd8, 0xa5, 0xb9, 0x96, 0xfd, 0x9f, 0x2a, 0x72, 0xc2, 0x66, 0x3d, 0x11,
+	0x3a, 0x9f, 0x3e, 0x70, 0x3e, 0x45, 0x5c, 0x95, 0x67, 0x45, 0xae, 0x17, 0x57, 0x4d, 0xb0, 0x88,
+	0xef, 0xbc, 0x7e, 0xb0, 0x4c, 0x92, 0xa6, 0xb8, 0x95, 0xb6, 0x68, 0x36, 0xb5, 0xfb, 0x2e, 0xc7,
+	0x48, 0xbc, 0xf3, 0x72, 0x31, 0x64, 0x5e, 0xe1, 0x55, 0xc3, 0xf4, 0xa1, 0x9d, 0x67, 0x95, 0x9c,
+	0xd3, 0xf7, 0xf1, 0x36, 0xcc, 0x93, 0xd5, 0x53, 0xcb, 0x3a, 0x6b, 0xdf, 0x5c, 0x8e, 0x20, 0xcf,
+	0x16, 0xb9, 0x73, 0xb5, 0x78, 0x06, 0x31, 0xbe, 0x5f, 0x83, 0x56, 0xda, 0x0a, 0x57, 0x3f, 0xbe,
+	0x39, 0xb6, 0xba, 0x05, 0x08, 0x7a, 0xca, 0x54, 0x74, 0x3c, 0x89, 0x4d, 0x09, 0xf7, 0x5f, 0x3a,
+	0x80, 0xed, 0xa9, 0x18, 0xca, 0x8c, 0xad, 0x64, 0xee, 0x50, 0xe6, 0x19, 0x90, 0x76, 0xae, 0x16,
+	0xcf, 0x20, 0x2a, 0x0f, 0xa0, 0x95, 0xb6, 0x8f, 0x33, 0x5f, 0x9a, 0x64, 0x2b, 0x25, 0xb3, 0xb7,
+	0x2f, 0x17, 0x43, 0x16, 0x15, 0x7e, 0xdb, 0x80, 0xe3, 0x39, 0x36, 0x5f, 0x66, 0xde, 0x25, 0x78,
+	0x8c, 0x35, 0x5a, 0xe7, 0xb5, 0x03, 0xe5, 0xe1, 0xcd, 0xb8, 0xf6, 0x6f, 0x4d, 0xa8, 0x25, 0x02,
+	0xf4, 0xff, 0x6f, 0xb7, 0xf2, 0x6c, 0xed, 0x56, 0x3e, 0x84, 0x05, 0x42, 0xad, 0x36, 0x06, 0xc2,
+	0xec, 0xf0, 0x72, 0x2e, 0x49, 0x4b, 0x90, 0x8a, 0x9b, 0x5f, 0x3c, 0xf4, 0xa3, 0xd1, 0xb6, 0xc8,
+	0xa8, 0xd7, 0x06, 0xa8, 0x38, 0xc5, 0x2f, 0xaf, 0xe4, 0xa0, 0xe7, 0x0c, 0xf0, 0xc5, 0x3c, 0x06,
+	0xf5, 0x80, 0xdc, 0xef, 0xd1, 0x9b, 0x75, 0xfc, 0x74, 0x9b, 0xd4, 0x1c, 0xed, 0xdd, 0xe3, 0xc7,
+	0x68, 0x0d, 0xd2, 0x87, 0x25, 0x2a, 0x50, 0xa7, 0x06, 0x83, 0xbc, 0x33, 0x6b, 0x79, 0xac, 0x44,
+	0x0a, 0xb1, 0x70, 0x87, 0x9a, 0xca, 0x36, 0xcd, 0xbd, 0x13, 0x27, 0x28, 0x39, 0x04, 0x5b, 0xbf,
+	0xed, 0xa5, 0x0e, 0x6d, 0xc1, 0xec, 0x16, 0x72, 0xc2, 0xde, 0x9e, 0x99, 0xf3, 0xc0, 0x2c, 0x4e,
+	0xcb, 0x21, 0x81, 0xa2, 0x70, 0x8e, 0x45, 0xde, 0x23, 0xb2, 0x8e, 0x99, 0x5f, 0x81, 0x79, 0x0a,
+	0x12, 0x03, 0xf4, 0x0c, 0x0b, 0xdf, 0x82, 0x0a, 0x21, 0xed, 0xe6, 0x59, 0x5d, 0x99, 0x24, 0x89,
+	0x17, 0x79, 0x21, 0xa7, 0x48, 0x1b, 0xc5, 0xa1, 0x8b, 0x9e, 0x20, 0xb9, 0xc5, 0x75, 0x92, 0x93,
+	0x5a, 0xf0, 0x3e, 0xcb, 0xa2, 0xaf, 0x1a, 0xe6, 0x57, 0xa0, 0x49, 0x0b, 0xe7, 0xa3, 0xf1, 0x2c,
+	0x5b, 0xde, 0x83, 0x25, 0xa9, 0xe5, 0x47, 0x51, 0xc5, 0x55, 0xe3, 0xff, 0x71, 0x73, 0x25, 0xaa,
+	0x31, 0xc1, 0xf6, 0xdd, 0x8a, 0x72, 0x31, 0x4f, 0xde, 0x9a, 0x46, 0x9c, 0xa4, 0x31, 0xc9, 0xe2,
+	0x2b, 0xac, 0xee, 0xbe, 0xdf, 0x53, 0xaa, 0x7d, 0x29, 0x8f, 0x96, 0x1c, 0x42, 0x93, 0xf9, 0x1e,
+	0xcc, 0xd2, 0x07, 0xf0, 0xf5, 0x1b, 0x50, 0x79, 0x1c, 0x7f, 0x42, 0x59, 0x37, 0x5e, 0xff, 0xf2,
+	0xb5, 0x5d, 0x37, 0xde, 0x1b, 0x6d, 0xe3, 0x94, 0x2b, 0x14, 0xf5, 0x15, 0x37, 0x60, 0x5f, 0x57,
+	0xf8, 0x5c, 0x5e, 0x21, 0xb9, 0xaf, 0x90, 0x0a, 0x86, 0xdb, 0xdb, 0xb3, 0xe4, 0xf7, 0xb5, 0xff,
+	0x3b, 0x00, 0xe1, 0x75, 0x9b, 0x89, 0xc3, 0xd0, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryCoordClient is the client API for QueryCoord service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryCoordClient interface {
+	GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error)
+	GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	ShowCollections(ctx context.Context, in *ShowCollectionsRequest, opts ...grpc.CallOption) (*ShowCollectionsResponse, error)
+	ShowPartitions(ctx context.Context, in *ShowPartitionsRequest, opts ...grpc.CallOption) (*ShowPartitionsResponse, error)
+	LoadPartitions(ctx context.Context, in *LoadPartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ReleasePartitions(ctx context.Context, in *ReleasePartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	LoadCollection(ctx context.Context, in *LoadCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ReleaseCollection(ctx context.Context, in *ReleaseCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	SyncNewCreatedPartition(ctx context.Context, in *SyncNewCreatedPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetPartitionStates(ctx context.Context, in *GetPartitionStatesRequest, opts ...grpc.CallOption) (*GetPartitionStatesResponse, error)
+	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
+	LoadBalance(ctx context.Context, in *LoadBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
+	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+23+--+Multiple+memory+replication+design
+	GetReplicas(ctx context.Context, in *milvuspb.GetReplicasRequest, opts ...grpc.CallOption) (*milvuspb.GetReplicasResponse, error)
+	GetShardLeaders(ctx context.Context, in *GetShardLeadersRequest, opts ...grpc.CallOption) (*GetShardLeadersResponse, error)
+	CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error)
+	CreateResourceGroup(ctx context.Context, in *milvuspb.CreateResourceGroupRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	UpdateResourceGroups(ctx context.Context, in *UpdateResourceGroupsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropResourceGroup(ctx context.Context, in *milvuspb.DropResourceGroupRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	TransferNode(ctx context.Context, in *milvuspb.TransferNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	TransferReplica(ctx context.Context, in *TransferReplicaRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListResourceGroups(ctx context.Context, in *milvuspb.ListResourceGroupsRequest, opts ...grpc.CallOption) (*milvuspb.ListResourceGroupsResponse, error)
+	DescribeResourceGroup(ctx context.Context, in *DescribeResourceGroupRequest, opts ...grpc.CallOption) (*DescribeResourceGroupResponse, error)
+	// ops interfaces
+	ListCheckers(ctx context.Context, in *ListCheckersRequest, opts ...grpc.CallOption) (*ListCheckersResponse, error)
+	ActivateChecker(ctx context.Context, in *ActivateCheckerRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DeactivateChecker(ctx context.Context, in *DeactivateCheckerRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListQueryNode(ctx context.Context, in *ListQueryNodeRequest, opts ...grpc.CallOption) (*ListQueryNodeResponse, error)
+	GetQueryNodeDistribution(ctx context.Context, in *GetQueryNodeDistributionRequest, opts ...grpc.CallOption) (*GetQueryNodeDistributionResponse, error)
+	SuspendBalance(ctx context.Context, in *SuspendBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ResumeBalance(ctx context.Context, in *ResumeBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	SuspendNode(ctx context.Context, in *SuspendNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ResumeNode(ctx context.Context, in *ResumeNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	TransferSegment(ctx context.Context, in *TransferSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	TransferChannel(ctx context.Context, in *TransferChannelRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CheckQueryNodeDistribution(ctx context.Context, in *CheckQueryNodeDistributionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetClusterLoadSummary(ctx context.Context, in *GetClusterLoadSummaryRequest, opts ...grpc.CallOption) (*GetClusterLoadSummaryResponse, error)
+	ForceSync(ctx context.Context, in *ForceSyncRequest, opts ...grpc.CallOption) (*ForceSyncResponse, error)
+	GetTransferNodeStatus(ctx context.Context, in *GetTransferNodeStatusRequest, opts ...grpc.CallOption) (*GetTransferNodeStatusResponse, error)
+	TriggerCheckerRun(ctx context.Context, in *TriggerCheckerRunRequest, opts ...grpc.CallOption) (*TriggerCheckerRunResponse, error)
+	GetAvailabilitySLA(ctx context.Context, in *GetAvailabilitySLARequest, opts ...grpc.CallOption) (*GetAvailabilitySLAResponse, error)
+	GetReleaseProgress(ctx context.Context, in *GetReleaseProgressRequest, opts ...grpc.CallOption) (*GetReleaseProgressResponse, error)
+	GetTenantViolations(ctx context.Context, in *GetTenantViolationsRequest, opts ...grpc.CallOption) (*GetTenantViolationsResponse, error)
+	CaptureBalanceLayout(ctx context.Context, in *CaptureBalanceLayoutRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ClearBalanceLayout(ctx context.Context, in *ClearBalanceLayoutRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetBalanceLayoutStatus(ctx context.Context, in *GetBalanceLayoutStatusRequest, opts ...grpc.CallOption) (*GetBalanceLayoutStatusResponse, error)
+	GetCollectionLoadInfo(ctx context.Context, in *GetCollectionLoadInfoRequest, opts ...grpc.CallOption) (*GetCollectionLoadInfoResponse, error)
+	RecommendReplicaCount(ctx context.Context, in *RecommendReplicaCountRequest, opts ...grpc.CallOption) (*RecommendReplicaCountResponse, error)
+	PauseTargetUpdates(ctx context.Context, in *PauseTargetUpdatesRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ResumeTargetUpdates(ctx context.Context, in *ResumeTargetUpdatesRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetNodeLoadHistory(ctx context.Context, in *GetNodeLoadHistoryRequest, opts ...grpc.CallOption) (*GetNodeLoadHistoryResponse, error)
+	BlockShard(ctx context.Context, in *BlockShardRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	UnblockShard(ctx context.Context, in *UnblockShardRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetResourceGroupDrift(ctx context.Context, in *GetResourceGroupDriftRequest, opts ...grpc.CallOption) (*GetResourceGroupDriftResponse, error)
+	CanStopNode(ctx context.Context, in *CanStopNodeRequest, opts ...grpc.CallOption) (*CanStopNodeResponse, error)
+	MoveCollectionToResourceGroup(ctx context.Context, in *MoveCollectionToResourceGroupRequest, opts ...grpc.CallOption) (*MoveCollectionToResourceGroupResponse, error)
+	GetShardLeadersBatch(ctx context.Context, in *GetShardLeadersBatchRequest, opts ...grpc.CallOption) (*GetShardLeadersBatchResponse, error)
+	GetHandoffLag(ctx context.Context, in *GetHandoffLagRequest, opts ...grpc.CallOption) (*GetHandoffLagResponse, error)
+	CreateResourceGroupWithAutoFill(ctx context.Context, in *CreateResourceGroupWithAutoFillRequest, opts ...grpc.CallOption) (*CreateResourceGroupWithAutoFillResponse, error)
+	GetLoadInfo(ctx context.Context, in *GetLoadInfoRequest, opts ...grpc.CallOption) (*GetLoadInfoResponse, error)
+	ReleaseSegments(ctx context.Context, in *ReleaseSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DryRunLoadBalance(ctx context.Context, in *LoadBalanceRequest, opts ...grpc.CallOption) (*DryRunLoadBalanceResponse, error)
+	TransferNodeByFraction(ctx context.Context, in *TransferNodeByFractionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CheckNodeHealth(ctx context.Context, in *CheckNodeHealthRequest, opts ...grpc.CallOption) (*CheckNodeHealthResponse, error)
+	DescribeChecker(ctx context.Context, in *DescribeCheckerRequest, opts ...grpc.CallOption) (*DescribeCheckerResponse, error)
+	ListReplicas(ctx context.Context, in *ListReplicasRequest, opts ...grpc.CallOption) (*ListReplicasResponse, error)
+	CancelLoad(ctx context.Context, in *CancelLoadRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetLoadState(ctx context.Context, in *GetLoadStateRequest, opts ...grpc.CallOption) (*GetLoadStateResponse, error)
+	RebalanceCollection(ctx context.Context, in *RebalanceCollectionRequest, opts ...grpc.CallOption) (*RebalanceCollectionResponse, error)
+	DryRunRebalanceCollection(ctx context.Context, in *RebalanceCollectionRequest, opts ...grpc.CallOption) (*RebalanceCollectionResponse, error)
+	GetResourceGroupUtilization(ctx context.Context, in *GetResourceGroupUtilizationRequest, opts ...grpc.CallOption) (*GetResourceGroupUtilizationResponse, error)
+	SyncNewCreatedPartitions(ctx context.Context, in *SyncNewCreatedPartitionsRequest, opts ...grpc.CallOption) (*SyncNewCreatedPartitionsResponse, error)
+	WatchCollections(ctx context.Context, in *WatchCollectionsRequest, opts ...grpc.CallOption) (QueryCoord_WatchCollectionsClient, error)
+	SetCollectionBalanceMode(ctx context.Context, in *SetCollectionBalanceModeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetLoadBalanceStatus(ctx context.Context, in *GetLoadBalanceStatusRequest, opts ...grpc.CallOption) (*GetLoadBalanceStatusResponse, error)
+	SetBalancePolicy(ctx context.Context, in *SetBalancePolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DescribeReplica(ctx context.Context, in *DescribeReplicaRequest, opts ...grpc.CallOption) (*DescribeReplicaResponse, error)
+	GetCollectionTargets(ctx context.Context, in *GetCollectionTargetsRequest, opts ...grpc.CallOption) (*GetCollectionTargetsResponse, error)
+	DecommissionNode(ctx context.Context, in *DecommissionNodeRequest, opts ...grpc.CallOption) (*DecommissionNodeResponse, error)
+	GetDecommissionProgress(ctx context.Context, in *GetDecommissionProgressRequest, opts ...grpc.CallOption) (*GetDecommissionProgressResponse, error)
+}
+
+type queryCoordClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewQueryCoordClient(cc *grpc.ClientConn) QueryCoordClient {
+	return &queryCoordClient{cc}
+}
+
+func (c *queryCoordClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
+	out := new(milvuspb.ComponentStates)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetComponentStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	out := new(milvuspb.StringResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetTimeTickChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	out := new(milvuspb.StringResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetStatisticsChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) ShowCollections(ctx context.Context, in *ShowCollectionsRequest, opts ...grpc.CallOption) (*ShowCollectionsResponse, error) {
+	out := new(ShowCollectionsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/ShowCollections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) ShowPartitions(ctx context.Context, in *ShowPartitionsRequest, opts ...grpc.CallOption) (*ShowPartitionsResponse, error) {
+	out := new(ShowPartitionsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/ShowPartitions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) LoadPartitions(ctx context.Context, in *LoadPartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/LoadPartitions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) ReleasePartitions(ctx context.Context, in *ReleasePartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/ReleasePartitions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) LoadCollection(ctx context.Context, in *LoadCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/LoadCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) ReleaseCollection(ctx context.Context, in *ReleaseCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/ReleaseCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) SyncNewCreatedPartition(ctx context.Context, in *SyncNewCreatedPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/SyncNewCreatedPartition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetPartitionStates(ctx context.Context, in *GetPartitionStatesRequest, opts ...grpc.CallOption) (*GetPartitionStatesResponse, error) {
+	out := new(GetPartitionStatesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetPartitionStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error) {
+	out := new(GetSegmentInfoResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetSegmentInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) LoadBalance(ctx context.Context, in *LoadBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/LoadBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error) {
+	out := new(internalpb.ShowConfigurationsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/ShowConfigurations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
+	out := new(milvuspb.GetMetricsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetReplicas(ctx context.Context, in *milvuspb.GetReplicasRequest, opts ...grpc.CallOption) (*milvuspb.GetReplicasResponse, error) {
+	out := new(milvuspb.GetReplicasResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetReplicas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetShardLeaders(ctx context.Context, in *GetShardLeadersRequest, opts ...grpc.CallOption) (*GetShardLeadersResponse, error) {
+	out := new(GetShardLeadersResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetShardLeaders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
+	out := new(milvuspb.CheckHealthResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/CheckHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) CreateResourceGroup(ctx context.Context, in *milvuspb.CreateResourceGroupRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/CreateResourceGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) UpdateResourceGroups(ctx context.Context, in *UpdateResourceGroupsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/UpdateResourceGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) DropResourceGroup(ctx context.Context, in *milvuspb.DropResourceGroupRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/DropResourceGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) TransferNode(ctx context.Context, in *milvuspb.TransferNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/TransferNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) TransferReplica(ctx context.Context, in *TransferReplicaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/TransferReplica", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) ListResourceGroups(ctx context.Context, in *milvuspb.ListResourceGroupsRequest, opts ...grpc.CallOption) (*milvuspb.ListResourceGroupsResponse, error) {
+	out := new(milvuspb.ListResourceGroupsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/ListResourceGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) DescribeResourceGroup(ctx context.Context, in *DescribeResourceGroupRequest, opts ...grpc.CallOption) (*DescribeResourceGroupResponse, error) {
+	out := new(DescribeResourceGroupResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/DescribeResourceGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) ListCheckers(ctx context.Context, in *ListCheckersRequest, opts ...grpc.CallOption) (*ListCheckersResponse, error) {
+	out := new(ListCheckersResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/ListCheckers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) ActivateChecker(ctx context.Context, in *ActivateCheckerRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/ActivateChecker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) DeactivateChecker(ctx context.Context, in *DeactivateCheckerRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/DeactivateChecker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) ListQueryNode(ctx context.Context, in *ListQueryNodeRequest, opts ...grpc.CallOption) (*ListQueryNodeResponse, error) {
+	out := new(ListQueryNodeResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/ListQueryNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetQueryNodeDistribution(ctx context.Context, in *GetQueryNodeDistributionRequest, opts ...grpc.CallOption) (*GetQueryNodeDistributionResponse, error) {
+	out := new(GetQueryNodeDistributionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetQueryNodeDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) SuspendBalance(ctx context.Context, in *SuspendBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/SuspendBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) ResumeBalance(ctx context.Context, in *ResumeBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/ResumeBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) SuspendNode(ctx context.Context, in *SuspendNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/SuspendNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) ResumeNode(ctx context.Context, in *ResumeNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/ResumeNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) TransferSegment(ctx context.Context, in *TransferSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/TransferSegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) TransferChannel(ctx context.Context, in *TransferChannelRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/TransferChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) CheckQueryNodeDistribution(ctx context.Context, in *CheckQueryNodeDistributionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/CheckQueryNodeDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetClusterLoadSummary(ctx context.Context, in *GetClusterLoadSummaryRequest, opts ...grpc.CallOption) (*GetClusterLoadSummaryResponse, error) {
+	out := new(GetClusterLoadSummaryResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetClusterLoadSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) ForceSync(ctx context.Context, in *ForceSyncRequest, opts ...grpc.CallOption) (*ForceSyncResponse, error) {
+	out := new(ForceSyncResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/ForceSync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetTransferNodeStatus(ctx context.Context, in *GetTransferNodeStatusRequest, opts ...grpc.CallOption) (*GetTransferNodeStatusResponse, error) {
+	out := new(GetTransferNodeStatusResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetTransferNodeStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) TriggerCheckerRun(ctx context.Context, in *TriggerCheckerRunRequest, opts ...grpc.CallOption) (*TriggerCheckerRunResponse, error) {
+	out := new(TriggerCheckerRunResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/TriggerCheckerRun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetAvailabilitySLA(ctx context.Context, in *GetAvailabilitySLARequest, opts ...grpc.CallOption) (*GetAvailabilitySLAResponse, error) {
+	out := new(GetAvailabilitySLAResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetAvailabilitySLA", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetReleaseProgress(ctx context.Context, in *GetReleaseProgressRequest, opts ...grpc.CallOption) (*GetReleaseProgressResponse, error) {
+	out := new(GetReleaseProgressResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetReleaseProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetTenantViolations(ctx context.Context, in *GetTenantViolationsRequest, opts ...grpc.CallOption) (*GetTenantViolationsResponse, error) {
+	out := new(GetTenantViolationsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetTenantViolations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) CaptureBalanceLayout(ctx context.Context, in *CaptureBalanceLayoutRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/CaptureBalanceLayout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) ClearBalanceLayout(ctx context.Context, in *ClearBalanceLayoutRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/ClearBalanceLayout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetBalanceLayoutStatus(ctx context.Context, in *GetBalanceLayoutStatusRequest, opts ...grpc.CallOption) (*GetBalanceLayoutStatusResponse, error) {
+	out := new(GetBalanceLayoutStatusResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetBalanceLayoutStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetCollectionLoadInfo(ctx context.Context, in *GetCollectionLoadInfoRequest, opts ...grpc.CallOption) (*GetCollectionLoadInfoResponse, error) {
+	out := new(GetCollectionLoadInfoResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetCollectionLoadInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) RecommendReplicaCount(ctx context.Context, in *RecommendReplicaCountRequest, opts ...grpc.CallOption) (*RecommendReplicaCountResponse, error) {
+	out := new(RecommendReplicaCountResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/RecommendReplicaCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) PauseTargetUpdates(ctx context.Context, in *PauseTargetUpdatesRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/PauseTargetUpdates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) ResumeTargetUpdates(ctx context.Context, in *ResumeTargetUpdatesRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/ResumeTargetUpdates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetNodeLoadHistory(ctx context.Context, in *GetNodeLoadHistoryRequest, opts ...grpc.CallOption) (*GetNodeLoadHistoryResponse, error) {
+	out := new(GetNodeLoadHistoryResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetNodeLoadHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) BlockShard(ctx context.Context, in *BlockShardRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/BlockShard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) UnblockShard(ctx context.Context, in *UnblockShardRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/UnblockShard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetResourceGroupDrift(ctx context.Context, in *GetResourceGroupDriftRequest, opts ...grpc.CallOption) (*GetResourceGroupDriftResponse, error) {
+	out := new(GetResourceGroupDriftResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetResourceGroupDrift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) CanStopNode(ctx context.Context, in *CanStopNodeRequest, opts ...grpc.CallOption) (*CanStopNodeResponse, error) {
+	out := new(CanStopNodeResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/CanStopNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) MoveCollectionToResourceGroup(ctx context.Context, in *MoveCollectionToResourceGroupRequest, opts ...grpc.CallOption) (*MoveCollectionToResourceGroupResponse, error) {
+	out := new(MoveCollectionToResourceGroupResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/MoveCollectionToResourceGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetShardLeadersBatch(ctx context.Context, in *GetShardLeadersBatchRequest, opts ...grpc.CallOption) (*GetShardLeadersBatchResponse, error) {
+	out := new(GetShardLeadersBatchResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetShardLeadersBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetHandoffLag(ctx context.Context, in *GetHandoffLagRequest, opts ...grpc.CallOption) (*GetHandoffLagResponse, error) {
+	out := new(GetHandoffLagResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetHandoffLag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) CreateResourceGroupWithAutoFill(ctx context.Context, in *CreateResourceGroupWithAutoFillRequest, opts ...grpc.CallOption) (*CreateResourceGroupWithAutoFillResponse, error) {
+	out := new(CreateResourceGroupWithAutoFillResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/CreateResourceGroupWithAutoFill", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetLoadInfo(ctx context.Context, in *GetLoadInfoRequest, opts ...grpc.CallOption) (*GetLoadInfoResponse, error) {
+	out := new(GetLoadInfoResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetLoadInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) ReleaseSegments(ctx context.Context, in *ReleaseSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/ReleaseSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) DryRunLoadBalance(ctx context.Context, in *LoadBalanceRequest, opts ...grpc.CallOption) (*DryRunLoadBalanceResponse, error) {
+	out := new(DryRunLoadBalanceResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/DryRunLoadBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) TransferNodeByFraction(ctx context.Context, in *TransferNodeByFractionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/TransferNodeByFraction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) CheckNodeHealth(ctx context.Context, in *CheckNodeHealthRequest, opts ...grpc.CallOption) (*CheckNodeHealthResponse, error) {
+	out := new(CheckNodeHealthResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/CheckNodeHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) DescribeChecker(ctx context.Context, in *DescribeCheckerRequest, opts ...grpc.CallOption) (*DescribeCheckerResponse, error) {
+	out := new(DescribeCheckerResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/DescribeChecker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) ListReplicas(ctx context.Context, in *ListReplicasRequest, opts ...grpc.CallOption) (*ListReplicasResponse, error) {
+	out := new(ListReplicasResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/ListReplicas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) CancelLoad(ctx context.Context, in *CancelLoadRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/CancelLoad", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetLoadState(ctx context.Context, in *GetLoadStateRequest, opts ...grpc.CallOption) (*GetLoadStateResponse, error) {
+	out := new(GetLoadStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetLoadState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) RebalanceCollection(ctx context.Context, in *RebalanceCollectionRequest, opts ...grpc.CallOption) (*RebalanceCollectionResponse, error) {
+	out := new(RebalanceCollectionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/RebalanceCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) DryRunRebalanceCollection(ctx context.Context, in *RebalanceCollectionRequest, opts ...grpc.CallOption) (*RebalanceCollectionResponse, error) {
+	out := new(RebalanceCollectionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/DryRunRebalanceCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetResourceGroupUtilization(ctx context.Context, in *GetResourceGroupUtilizationRequest, opts ...grpc.CallOption) (*GetResourceGroupUtilizationResponse, error) {
+	out := new(GetResourceGroupUtilizationResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetResourceGroupUtilization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) SyncNewCreatedPartitions(ctx context.Context, in *SyncNewCreatedPartitionsRequest, opts ...grpc.CallOption) (*SyncNewCreatedPartitionsResponse, error) {
+	out := new(SyncNewCreatedPartitionsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/SyncNewCreatedPartitions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) WatchCollections(ctx context.Context, in *WatchCollectionsRequest, opts ...grpc.CallOption) (QueryCoord_WatchCollectionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_QueryCoord_serviceDesc.Streams[0], "/milvus.proto.query.QueryCoord/WatchCollections", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryCoordWatchCollectionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type QueryCoord_WatchCollectionsClient interface {
+	Recv() (*WatchCollectionsResponse, error)
+	grpc.ClientStream
+}
+
+type queryCoordWatchCollectionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryCoordWatchCollectionsClient) Recv() (*WatchCollectionsResponse, error) {
+	m := new(WatchCollectionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *queryCoordClient) SetCollectionBalanceMode(ctx context.Context, in *SetCollectionBalanceModeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/SetCollectionBalanceMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetLoadBalanceStatus(ctx context.Context, in *GetLoadBalanceStatusRequest, opts ...grpc.CallOption) (*GetLoadBalanceStatusResponse, error) {
+	out := new(GetLoadBalanceStatusResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetLoadBalanceStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) SetBalancePolicy(ctx context.Context, in *SetBalancePolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/SetBalancePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) DescribeReplica(ctx context.Context, in *DescribeReplicaRequest, opts ...grpc.CallOption) (*DescribeReplicaResponse, error) {
+	out := new(DescribeReplicaResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/DescribeReplica", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetCollectionTargets(ctx context.Context, in *GetCollectionTargetsRequest, opts ...grpc.CallOption) (*GetCollectionTargetsResponse, error) {
+	out := new(GetCollectionTargetsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetCollectionTargets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) DecommissionNode(ctx context.Context, in *DecommissionNodeRequest, opts ...grpc.CallOption) (*DecommissionNodeResponse, error) {
+	out := new(DecommissionNodeResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/DecommissionNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetDecommissionProgress(ctx context.Context, in *GetDecommissionProgressRequest, opts ...grpc.CallOption) (*GetDecommissionProgressResponse, error) {
+	out := new(GetDecommissionProgressResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetDecommissionProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryCoordServer is the server API for QueryCoord service.
+type QueryCoordServer interface {
+	GetComponentStates(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)
+	GetTimeTickChannel(context.Context, *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error)
+	GetStatisticsChannel(context.Context, *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error)
+	ShowCollections(context.Context, *ShowCollectionsRequest) (*ShowCollectionsResponse, error)
+	ShowPartitions(context.Context, *ShowPartitionsRequest) (*ShowPartitionsResponse, error)
+	LoadPartitions(context.Context, *LoadPartitionsRequest) (*commonpb.Status, error)
+	ReleasePartitions(context.Context, *ReleasePartitionsRequest) (*commonpb.Status, error)
+	LoadCollection(context.Context, *LoadCollectionRequest) (*commonpb.Status, error)
+	ReleaseCollection(context.Context, *ReleaseCollectionRequest) (*commonpb.Status, error)
+	SyncNewCreatedPartition(context.Context, *SyncNewCreatedPartitionRequest) (*commonpb.Status, error)
+	GetPartitionStates(context.Context, *GetPartitionStatesRequest) (*GetPartitionStatesResponse, error)
+	GetSegmentInfo(context.Context, *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error)
+	LoadBalance(context.Context, *LoadBalanceRequest) (*commonpb.Status, error)
+	ShowConfigurations(context.Context, *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
+	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+23+--+Multiple+memory+replication+design
+	GetReplicas(context.Context, *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error)
+	GetShardLeaders(context.Context, *GetShardLeadersRequest) (*GetShardLeadersResponse, error)
+	CheckHealth(context.Context, *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)
+	CreateResourceGroup(context.Context, *milvuspb.CreateResourceGroupRequest) (*commonpb.Status, error)
+	UpdateResourceGroups(context.Context, *UpdateResourceGroupsRequest) (*commonpb.Status, error)
+	DropResourceGroup(context.Context, *milvuspb.DropResourceGroupRequest) (*commonpb.Status, error)
+	TransferNode(context.Context, *milvuspb.TransferNodeRequest) (*commonpb.Status, error)
+	TransferReplica(context.Context, *TransferReplicaRequest) (*commonpb.Status, error)
+	ListResourceGroups(context.Context, *milvuspb.ListResourceGroupsRequest) (*milvuspb.ListResourceGroupsResponse, error)
+	DescribeResourceGroup(context.Context, *DescribeResourceGroupRequest) (*DescribeResourceGroupResponse, error)
+	// ops interfaces
+	ListCheckers(context.Context, *ListCheckersRequest) (*ListCheckersResponse, error)
+	ActivateChecker(context.Context, *ActivateCheckerRequest) (*commonpb.Status, error)
+	DeactivateChecker(context.Context, *DeactivateCheckerRequest) (*commonpb.Status, error)
+	ListQueryNode(context.Context, *ListQueryNodeRequest) (*ListQueryNodeResponse, error)
+	GetQueryNodeDistribution(context.Context, *GetQueryNodeDistributionRequest) (*GetQueryNodeDistributionResponse, error)
+	SuspendBalance(context.Context, *SuspendBalanceRequest) (*commonpb.Status, error)
+	ResumeBalance(context.Context, *ResumeBalanceRequest) (*commonpb.Status, error)
+	SuspendNode(context.Context, *SuspendNodeRequest) (*commonpb.Status, error)
+	ResumeNode(context.Context, *ResumeNodeRequest) (*commonpb.Status, error)
+	TransferSegment(context.Context, *TransferSegmentRequest) (*commonpb.Status, error)
+	TransferChannel(context.Context, *TransferChannelRequest) (*commonpb.Status, error)
+	CheckQueryNodeDistribution(context.Context, *CheckQueryNodeDistributionRequest) (*commonpb.Status, error)
+	GetClusterLoadSummary(context.Context, *GetClusterLoadSummaryRequest) (*GetClusterLoadSummaryResponse, error)
+	ForceSync(context.Context, *ForceSyncRequest) (*ForceSyncResponse, error)
+	GetTransferNodeStatus(context.Context, *GetTransferNodeStatusRequest) (*GetTransferNodeStatusResponse, error)
+	TriggerCheckerRun(context.Context, *TriggerCheckerRunRequest) (*TriggerCheckerRunResponse, error)
+	GetAvailabilitySLA(context.Context, *GetAvailabilitySLARequest) (*GetAvailabilitySLAResponse, error)
+	GetReleaseProgress(context.Context, *GetReleaseProgressRequest) (*GetReleaseProgressResponse, error)
+	GetTenantViolations(context.Context, *GetTenantViolationsRequest) (*GetTenantViolationsResponse, error)
+	CaptureBalanceLayout(context.Context, *CaptureBalanceLayoutRequest) (*commonpb.Status, error)
+	ClearBalanceLayout(context.Context, *ClearBalanceLayoutRequest) (*commonpb.Status, error)
+	GetBalanceLayoutStatus(context.Context, *GetBalanceLayoutStatusRequest) (*GetBalanceLayoutStatusResponse, error)
+	GetCollectionLoadInfo(context.Context, *GetCollectionLoadInfoRequest) (*GetCollectionLoadInfoResponse, error)
+	RecommendReplicaCount(context.Context, *RecommendReplicaCountRequest) (*RecommendReplicaCountResponse, error)
+	PauseTargetUpdates(context.Context, *PauseTargetUpdatesRequest) (*commonpb.Status, error)
+	ResumeTargetUpdates(context.Context, *ResumeTargetUpdatesRequest) (*commonpb.Status, error)
+	GetNodeLoadHistory(context.Context, *GetNodeLoadHistoryRequest) (*GetNodeLoadHistoryResponse, error)
+	BlockShard(context.Context, *BlockShardRequest) (*commonpb.Status, error)
+	UnblockShard(context.Context, *UnblockShardRequest) (*commonpb.Status, error)
+	GetResourceGroupDrift(context.Context, *GetResourceGroupDriftRequest) (*GetResourceGroupDriftResponse, error)
+	CanStopNode(context.Context, *CanStopNodeRequest) (*CanStopNodeResponse, error)
+	MoveCollectionToResourceGroup(context.Context, *MoveCollectionToResourceGroupRequest) (*MoveCollectionToResourceGroupResponse, error)
+	GetShardLeadersBatch(context.Context, *GetShardLeadersBatchRequest) (*GetShardLeadersBatchResponse, error)
+	GetHandoffLag(context.Context, *GetHandoffLagRequest) (*GetHandoffLagResponse, error)
+	CreateResourceGroupWithAutoFill(context.Context, *CreateResourceGroupWithAutoFillRequest) (*CreateResourceGroupWithAutoFillResponse, error)
+	GetLoadInfo(context.Context, *GetLoadInfoRequest) (*GetLoadInfoResponse, error)
+	ReleaseSegments(context.Context, *ReleaseSegmentsRequest) (*commonpb.Status, error)
+	DryRunLoadBalance(context.Context, *LoadBalanceRequest) (*DryRunLoadBalanceResponse, error)
+	TransferNodeByFraction(context.Context, *TransferNodeByFractionRequest) (*commonpb.Status, error)
+	CheckNodeHealth(context.Context, *CheckNodeHealthRequest) (*CheckNodeHealthResponse, error)
+	DescribeChecker(context.Context, *DescribeCheckerRequest) (*DescribeCheckerResponse, error)
+	ListReplicas(context.Context, *ListReplicasRequest) (*ListReplicasResponse, error)
+	CancelLoad(context.Context, *CancelLoadRequest) (*commonpb.Status, error)
+	GetLoadState(context.Context, *GetLoadStateRequest) (*GetLoadStateResponse, error)
+	RebalanceCollection(context.Context, *RebalanceCollectionRequest) (*RebalanceCollectionResponse, error)
+	DryRunRebalanceCollection(context.Context, *RebalanceCollectionRequest) (*RebalanceCollectionResponse, error)
+	GetResourceGroupUtilization(context.Context, *GetResourceGroupUtilizationRequest) (*GetResourceGroupUtilizationResponse, error)
+	SyncNewCreatedPartitions(context.Context, *SyncNewCreatedPartitionsRequest) (*SyncNewCreatedPartitionsResponse, error)
+	WatchCollections(*WatchCollectionsRequest, QueryCoord_WatchCollectionsServer) error
+	SetCollectionBalanceMode(context.Context, *SetCollectionBalanceModeRequest) (*commonpb.Status, error)
+	GetLoadBalanceStatus(context.Context, *GetLoadBalanceStatusRequest) (*GetLoadBalanceStatusResponse, error)
+	SetBalancePolicy(context.Context, *SetBalancePolicyRequest) (*commonpb.Status, error)
+	DescribeReplica(context.Context, *DescribeReplicaRequest) (*DescribeReplicaResponse, error)
+	GetCollectionTargets(context.Context, *GetCollectionTargetsRequest) (*GetCollectionTargetsResponse, error)
+	DecommissionNode(context.Context, *DecommissionNodeRequest) (*DecommissionNodeResponse, error)
+	GetDecommissionProgress(context.Context, *GetDecommissionProgressRequest) (*GetDecommissionProgressResponse, error)
+}
+
+// UnimplementedQueryCoordServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryCoordServer struct {
+}
+
+func (*UnimplementedQueryCoordServer) GetComponentStates(ctx context.Context, req *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComponentStates not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetTimeTickChannel(ctx context.Context, req *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTimeTickChannel not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetStatisticsChannel(ctx context.Context, req *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatisticsChannel not implemented")
+}
+func (*UnimplementedQueryCoordServer) ShowCollections(ctx context.Context, req *ShowCollectionsRequest) (*ShowCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShowCollections not implemented")
+}
+func (*UnimplementedQueryCoordServer) ShowPartitions(ctx context.Context, req *ShowPartitionsRequest) (*ShowPartitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShowPartitions not implemented")
+}
+func (*UnimplementedQueryCoordServer) LoadPartitions(ctx context.Context, req *LoadPartitionsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadPartitions not implemented")
+}
+func (*UnimplementedQueryCoordServer) ReleasePartitions(ctx context.Context, req *ReleasePartitionsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleasePartitions not implemented")
+}
+func (*UnimplementedQueryCoordServer) LoadCollection(ctx context.Context, req *LoadCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadCollection not implemented")
+}
+func (*UnimplementedQueryCoordServer) ReleaseCollection(ctx context.Context, req *ReleaseCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseCollection not implemented")
+}
+func (*UnimplementedQueryCoordServer) SyncNewCreatedPartition(ctx context.Context, req *SyncNewCreatedPartitionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncNewCreatedPartition not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetPartitionStates(ctx context.Context, req *GetPartitionStatesRequest) (*GetPartitionStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPartitionStates not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetSegmentInfo(ctx context.Context, req *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentInfo not implemented")
+}
+func (*UnimplementedQueryCoordServer) LoadBalance(ctx context.Context, req *LoadBalanceRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadBalance not implemented")
+}
+func (*UnimplementedQueryCoordServer) ShowConfigurations(ctx context.Context, req *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShowConfigurations not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetReplicas(ctx context.Context, req *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReplicas not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetShardLeaders(ctx context.Context, req *GetShardLeadersRequest) (*GetShardLeadersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShardLeaders not implemented")
+}
+func (*UnimplementedQueryCoordServer) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckHealth not implemented")
+}
+func (*UnimplementedQueryCoordServer) CreateResourceGroup(ctx context.Context, req *milvuspb.CreateResourceGroupRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateResourceGroup not implemented")
+}
+func (*UnimplementedQueryCoordServer) UpdateResourceGroups(ctx context.Context, req *UpdateResourceGroupsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateResourceGroups not implemented")
+}
+func (*UnimplementedQueryCoordServer) DropResourceGroup(ctx context.Context, req *milvuspb.DropResourceGroupRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropResourceGroup not implemented")
+}
+func (*UnimplementedQueryCoordServer) TransferNode(ctx context.Context, req *milvuspb.TransferNodeRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferNode not implemented")
+}
+func (*UnimplementedQueryCoordServer) TransferReplica(ctx context.Context, req *TransferReplicaRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferReplica not implemented")
+}
+func (*UnimplementedQueryCoordServer) ListResourceGroups(ctx context.Context, req *milvuspb.ListResourceGroupsRequest) (*milvuspb.ListResourceGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResourceGroups not implemented")
+}
+func (*UnimplementedQueryCoordServer) DescribeResourceGroup(ctx context.Context, req *DescribeResourceGroupRequest) (*DescribeResourceGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeResourceGroup not implemented")
+}
+func (*UnimplementedQueryCoordServer) ListCheckers(ctx context.Context, req *ListCheckersRequest) (*ListCheckersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCheckers not implemented")
+}
+func (*UnimplementedQueryCoordServer) ActivateChecker(ctx context.Context, req *ActivateCheckerRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateChecker not implemented")
+}
+func (*UnimplementedQueryCoordServer) DeactivateChecker(ctx context.Context, req *DeactivateCheckerRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateChecker not implemented")
+}
+func (*UnimplementedQueryCoordServer) ListQueryNode(ctx context.Context, req *ListQueryNodeRequest) (*ListQueryNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQueryNode not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetQueryNodeDistribution(ctx context.Context, req *GetQueryNodeDistributionRequest) (*GetQueryNodeDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueryNodeDistribution not implemented")
+}
+func (*UnimplementedQueryCoordServer) SuspendBalance(ctx context.Context, req *SuspendBalanceRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendBalance not implemented")
+}
+func (*UnimplementedQueryCoordServer) ResumeBalance(ctx context.Context, req *ResumeBalanceRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeBalance not implemented")
+}
+func (*UnimplementedQueryCoordServer) SuspendNode(ctx context.Context, req *SuspendNodeRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendNode not implemented")
+}
+func (*UnimplementedQueryCoordServer) ResumeNode(ctx context.Context, req *ResumeNodeRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeNode not implemented")
+}
+func (*UnimplementedQueryCoordServer) TransferSegment(ctx context.Context, req *TransferSegmentRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferSegment not implemented")
+}
+func (*UnimplementedQueryCoordServer) TransferChannel(ctx context.Context, req *TransferChannelRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferChannel not implemented")
+}
+func (*UnimplementedQueryCoordServer) CheckQueryNodeDistribution(ctx context.Context, req *CheckQueryNodeDistributionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckQueryNodeDistribution not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetClusterLoadSummary(ctx context.Context, req *GetClusterLoadSummaryRequest) (*GetClusterLoadSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClusterLoadSummary not implemented")
+}
+func (*UnimplementedQueryCoordServer) ForceSync(ctx context.Context, req *ForceSyncRequest) (*ForceSyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceSync not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetTransferNodeStatus(ctx context.Context, req *GetTransferNodeStatusRequest) (*GetTransferNodeStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransferNodeStatus not implemented")
+}
+func (*UnimplementedQueryCoordServer) TriggerCheckerRun(ctx context.Context, req *TriggerCheckerRunRequest) (*TriggerCheckerRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerCheckerRun not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetAvailabilitySLA(ctx context.Context, req *GetAvailabilitySLARequest) (*GetAvailabilitySLAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailabilitySLA not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetReleaseProgress(ctx context.Context, req *GetReleaseProgressRequest) (*GetReleaseProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReleaseProgress not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetTenantViolations(ctx context.Context, req *GetTenantViolationsRequest) (*GetTenantViolationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenantViolations not implemented")
+}
+func (*UnimplementedQueryCoordServer) CaptureBalanceLayout(ctx context.Context, req *CaptureBalanceLayoutRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CaptureBalanceLayout not implemented")
+}
+func (*UnimplementedQueryCoordServer) ClearBalanceLayout(ctx context.Context, req *ClearBalanceLayoutRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearBalanceLayout not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetBalanceLayoutStatus(ctx context.Context, req *GetBalanceLayoutStatusRequest) (*GetBalanceLayoutStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalanceLayoutStatus not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetCollectionLoadInfo(ctx context.Context, req *GetCollectionLoadInfoRequest) (*GetCollectionLoadInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionLoadInfo not implemented")
+}
+func (*UnimplementedQueryCoordServer) RecommendReplicaCount(ctx context.Context, req *RecommendReplicaCountRequest) (*RecommendReplicaCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecommendReplicaCount not implemented")
+}
+func (*UnimplementedQueryCoordServer) PauseTargetUpdates(ctx context.Context, req *PauseTargetUpdatesRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseTargetUpdates not implemented")
+}
+func (*UnimplementedQueryCoordServer) ResumeTargetUpdates(ctx context.Context, req *ResumeTargetUpdatesRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeTargetUpdates not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetNodeLoadHistory(ctx context.Context, req *GetNodeLoadHistoryRequest) (*GetNodeLoadHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodeLoadHistory not implemented")
+}
+func (*UnimplementedQueryCoordServer) BlockShard(ctx context.Context, req *BlockShardRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockShard not implemented")
+}
+func (*UnimplementedQueryCoordServer) UnblockShard(ctx context.Context, req *UnblockShardRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockShard not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetResourceGroupDrift(ctx context.Context, req *GetResourceGroupDriftRequest) (*GetResourceGroupDriftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourceGroupDrift not implemented")
+}
+func (*UnimplementedQueryCoordServer) CanStopNode(ctx context.Context, req *CanStopNodeRequest) (*CanStopNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanStopNode not implemented")
+}
+func (*UnimplementedQueryCoordServer) MoveCollectionToResourceGroup(ctx context.Context, req *MoveCollectionToResourceGroupRequest) (*MoveCollectionToResourceGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCollectionToResourceGroup not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetShardLeadersBatch(ctx context.Context, req *GetShardLeadersBatchRequest) (*GetShardLeadersBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShardLeadersBatch not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetHandoffLag(ctx context.Context, req *GetHandoffLagRequest) (*GetHandoffLagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHandoffLag not implemented")
+}
+func (*UnimplementedQueryCoordServer) CreateResourceGroupWithAutoFill(ctx context.Context, req *CreateResourceGroupWithAutoFillRequest) (*CreateResourceGroupWithAutoFillResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateResourceGroupWithAutoFill not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetLoadInfo(ctx context.Context, req *GetLoadInfoRequest) (*GetLoadInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoadInfo not implemented")
+}
+func (*UnimplementedQueryCoordServer) ReleaseSegments(ctx context.Context, req *ReleaseSegmentsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseSegments not implemented")
+}
+func (*UnimplementedQueryCoordServer) DryRunLoadBalance(ctx context.Context, req *LoadBalanceRequest) (*DryRunLoadBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunLoadBalance not implemented")
+}
+func (*UnimplementedQueryCoordServer) TransferNodeByFraction(ctx context.Context, req *TransferNodeByFractionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferNodeByFraction not implemented")
+}
+func (*UnimplementedQueryCoordServer) CheckNodeHealth(ctx context.Context, req *CheckNodeHealthRequest) (*CheckNodeHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckNodeHealth not implemented")
+}
+func (*UnimplementedQueryCoordServer) DescribeChecker(ctx context.Context, req *DescribeCheckerRequest) (*DescribeCheckerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeChecker not implemented")
+}
+func (*UnimplementedQueryCoordServer) ListReplicas(ctx context.Context, req *ListReplicasRequest) (*ListReplicasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReplicas not implemented")
+}
+func (*UnimplementedQueryCoordServer) CancelLoad(ctx context.Context, req *CancelLoadRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelLoad not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetLoadState(ctx context.Context, req *GetLoadStateRequest) (*GetLoadStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoadState not implemented")
+}
+func (*UnimplementedQueryCoordServer) RebalanceCollection(ctx context.Context, req *RebalanceCollectionRequest) (*RebalanceCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebalanceCollection not implemented")
+}
+func (*UnimplementedQueryCoordServer) DryRunRebalanceCollection(ctx context.Context, req *RebalanceCollectionRequest) (*RebalanceCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunRebalanceCollection not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetResourceGroupUtilization(ctx context.Context, req *GetResourceGroupUtilizationRequest) (*GetResourceGroupUtilizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourceGroupUtilization not implemented")
+}
+func (*UnimplementedQueryCoordServer) SyncNewCreatedPartitions(ctx context.Context, req *SyncNewCreatedPartitionsRequest) (*SyncNewCreatedPartitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncNewCreatedPartitions not implemented")
+}
+func (*UnimplementedQueryCoordServer) WatchCollections(req *WatchCollectionsRequest, srv QueryCoord_WatchCollectionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCollections not implemented")
+}
+func (*UnimplementedQueryCoordServer) SetCollectionBalanceMode(ctx context.Context, req *SetCollectionBalanceModeRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCollectionBalanceMode not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetLoadBalanceStatus(ctx context.Context, req *GetLoadBalanceStatusRequest) (*GetLoadBalanceStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoadBalanceStatus not implemented")
+}
+func (*UnimplementedQueryCoordServer) SetBalancePolicy(ctx context.Context, req *SetBalancePolicyRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBalancePolicy not implemented")
+}
+func (*UnimplementedQueryCoordServer) DescribeReplica(ctx context.Context, req *DescribeReplicaRequest) (*DescribeReplicaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeReplica not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetCollectionTargets(ctx context.Context, req *GetCollectionTargetsRequest) (*GetCollectionTargetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionTargets not implemented")
+}
+func (*UnimplementedQueryCoordServer) DecommissionNode(ctx context.Context, req *DecommissionNodeRequest) (*DecommissionNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecommissionNode not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetDecommissionProgress(ctx context.Context, req *GetDecommissionProgressRequest) (*GetDecommissionProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDecommissionProgress not implemented")
+}
+
+func RegisterQueryCoordServer(s *grpc.Server, srv QueryCoordServer) {
+	s.RegisterService(&_QueryCoord_serviceDesc, srv)
+}
+
+func _QueryCoord_GetComponentStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetComponentStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetComponentStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetComponentStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetComponentStates(ctx, req.(*milvuspb.GetComponentStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetTimeTickChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.GetTimeTickChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetTimeTickChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetTimeTickChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetTimeTickChannel(ctx, req.(*internalpb.GetTimeTickChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetStatisticsChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.GetStatisticsChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetStatisticsChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetStatisticsChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetStatisticsChannel(ctx, req.(*internalpb.GetStatisticsChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_ShowCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShowCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).ShowCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/ShowCollections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).ShowCollections(ctx, req.(*ShowCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_ShowPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShowPartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).ShowPartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/ShowPartitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).ShowPartitions(ctx, req.(*ShowPartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_LoadPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadPartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).LoadPartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/LoadPartitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).LoadPartitions(ctx, req.(*LoadPartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_ReleasePartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleasePartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).ReleasePartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/ReleasePartitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).ReleasePartitions(ctx, req.(*ReleasePartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_LoadCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).LoadCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/LoadCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).LoadCollection(ctx, req.(*LoadCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_ReleaseCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).ReleaseCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/ReleaseCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).ReleaseCollection(ctx, req.(*ReleaseCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_SyncNewCreatedPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncNewCreatedPartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).SyncNewCreatedPartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/SyncNewCreatedPartition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).SyncNewCreatedPartition(ctx, req.(*SyncNewCreatedPartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetPartitionStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPartitionStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetPartitionStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetPartitionStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetPartitionStates(ctx, req.(*GetPartitionStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetSegmentInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetSegmentInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetSegmentInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetSegmentInfo(ctx, req.(*GetSegmentInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_LoadBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).LoadBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/LoadBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).LoadBalance(ctx, req.(*LoadBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_ShowConfigurations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.ShowConfigurationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).ShowConfigurations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/ShowConfigurations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).ShowConfigurations(ctx, req.(*internalpb.ShowConfigurationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetMetrics(ctx, req.(*milvuspb.GetMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetReplicas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetReplicasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetReplicas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetReplicas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetReplicas(ctx, req.(*milvuspb.GetReplicasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetShardLeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShardLeadersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetShardLeaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetShardLeaders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetShardLeaders(ctx, req.(*GetShardLeadersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_CheckHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CheckHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).CheckHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/CheckHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).CheckHealth(ctx, req.(*milvuspb.CheckHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_CreateResourceGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CreateResourceGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).CreateResourceGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/CreateResourceGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).CreateResourceGroup(ctx, req.(*milvuspb.CreateResourceGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_UpdateResourceGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateResourceGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).UpdateResourceGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/UpdateResourceGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).UpdateResourceGroups(ctx, req.(*UpdateResourceGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_DropResourceGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.DropResourceGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).DropResourceGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/DropResourceGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).DropResourceGroup(ctx, req.(*milvuspb.DropResourceGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_TransferNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.TransferNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).TransferNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/TransferNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).TransferNode(ctx, req.(*milvuspb.TransferNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_TransferReplica_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferReplicaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).TransferReplica(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/TransferReplica",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).TransferReplica(ctx, req.(*TransferReplicaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_ListResourceGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ListResourceGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).ListResourceGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/ListResourceGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).ListResourceGroups(ctx, req.(*milvuspb.ListResourceGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_DescribeResourceGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeResourceGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).DescribeResourceGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/DescribeResourceGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).DescribeResourceGroup(ctx, req.(*DescribeResourceGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_ListCheckers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCheckersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).ListCheckers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/ListCheckers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).ListCheckers(ctx, req.(*ListCheckersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_ActivateChecker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateCheckerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).ActivateChecker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/ActivateChecker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).ActivateChecker(ctx, req.(*ActivateCheckerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_DeactivateChecker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateCheckerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).DeactivateChecker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/DeactivateChecker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).DeactivateChecker(ctx, req.(*DeactivateCheckerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_ListQueryNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueryNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).ListQueryNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/ListQueryNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).ListQueryNode(ctx, req.(*ListQueryNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetQueryNodeDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueryNodeDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetQueryNodeDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetQueryNodeDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetQueryNodeDistribution(ctx, req.(*GetQueryNodeDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_SuspendBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).SuspendBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/SuspendBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).SuspendBalance(ctx, req.(*SuspendBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_ResumeBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).ResumeBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/ResumeBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).ResumeBalance(ctx, req.(*ResumeBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_SuspendNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).SuspendNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/SuspendNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).SuspendNode(ctx, req.(*SuspendNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_ResumeNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).ResumeNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/ResumeNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).ResumeNode(ctx, req.(*ResumeNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_TransferSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).TransferSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/TransferSegment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).TransferSegment(ctx, req.(*TransferSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_TransferChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).TransferChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/TransferChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).TransferChannel(ctx, req.(*TransferChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_CheckQueryNodeDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckQueryNodeDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).CheckQueryNodeDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/CheckQueryNodeDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).CheckQueryNodeDistribution(ctx, req.(*CheckQueryNodeDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetClusterLoadSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClusterLoadSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetClusterLoadSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetClusterLoadSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetClusterLoadSummary(ctx, req.(*GetClusterLoadSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_ForceSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).ForceSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/ForceSync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).ForceSync(ctx, req.(*ForceSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetTransferNodeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransferNodeStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetTransferNodeStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetTransferNodeStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetTransferNodeStatus(ctx, req.(*GetTransferNodeStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_TriggerCheckerRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerCheckerRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).TriggerCheckerRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/TriggerCheckerRun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).TriggerCheckerRun(ctx, req.(*TriggerCheckerRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetAvailabilitySLA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailabilitySLARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetAvailabilitySLA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetAvailabilitySLA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetAvailabilitySLA(ctx, req.(*GetAvailabilitySLARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetReleaseProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleaseProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetReleaseProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetReleaseProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetReleaseProgress(ctx, req.(*GetReleaseProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetTenantViolations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantViolationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetTenantViolations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetTenantViolations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetTenantViolations(ctx, req.(*GetTenantViolationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_CaptureBalanceLayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptureBalanceLayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).CaptureBalanceLayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/CaptureBalanceLayout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).CaptureBalanceLayout(ctx, req.(*CaptureBalanceLayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_ClearBalanceLayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearBalanceLayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).ClearBalanceLayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/ClearBalanceLayout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).ClearBalanceLayout(ctx, req.(*ClearBalanceLayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetBalanceLayoutStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceLayoutStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetBalanceLayoutStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetBalanceLayoutStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetBalanceLayoutStatus(ctx, req.(*GetBalanceLayoutStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetCollectionLoadInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionLoadInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetCollectionLoadInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetCollectionLoadInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetCollectionLoadInfo(ctx, req.(*GetCollectionLoadInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_RecommendReplicaCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecommendReplicaCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).RecommendReplicaCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/RecommendReplicaCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).RecommendReplicaCount(ctx, req.(*RecommendReplicaCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_PauseTargetUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseTargetUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).PauseTargetUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/PauseTargetUpdates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).PauseTargetUpdates(ctx, req.(*PauseTargetUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_ResumeTargetUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeTargetUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).ResumeTargetUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/ResumeTargetUpdates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).ResumeTargetUpdates(ctx, req.(*ResumeTargetUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetNodeLoadHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeLoadHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetNodeLoadHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetNodeLoadHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetNodeLoadHistory(ctx, req.(*GetNodeLoadHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_BlockShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).BlockShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/BlockShard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).BlockShard(ctx, req.(*BlockShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_UnblockShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).UnblockShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/UnblockShard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).UnblockShard(ctx, req.(*UnblockShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetResourceGroupDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceGroupDriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetResourceGroupDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetResourceGroupDrift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetResourceGroupDrift(ctx, req.(*GetResourceGroupDriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_CanStopNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CanStopNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).CanStopNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/CanStopNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).CanStopNode(ctx, req.(*CanStopNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_MoveCollectionToResourceGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCollectionToResourceGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).MoveCollectionToResourceGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/MoveCollectionToResourceGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).MoveCollectionToResourceGroup(ctx, req.(*MoveCollectionToResourceGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetShardLeadersBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShardLeadersBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetShardLeadersBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetShardLeadersBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetShardLeadersBatch(ctx, req.(*GetShardLeadersBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetHandoffLag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHandoffLagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetHandoffLag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetHandoffLag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetHandoffLag(ctx, req.(*GetHandoffLagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_CreateResourceGroupWithAutoFill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateResourceGroupWithAutoFillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).CreateResourceGroupWithAutoFill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/CreateResourceGroupWithAutoFill",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).CreateResourceGroupWithAutoFill(ctx, req.(*CreateResourceGroupWithAutoFillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetLoadInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoadInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetLoadInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetLoadInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetLoadInfo(ctx, req.(*GetLoadInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_ReleaseSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).ReleaseSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/ReleaseSegments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).ReleaseSegments(ctx, req.(*ReleaseSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_DryRunLoadBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).DryRunLoadBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/DryRunLoadBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).DryRunLoadBalance(ctx, req.(*LoadBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_TransferNodeByFraction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferNodeByFractionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).TransferNodeByFraction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/TransferNodeByFraction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).TransferNodeByFraction(ctx, req.(*TransferNodeByFractionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_CheckNodeHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckNodeHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).CheckNodeHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/CheckNodeHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).CheckNodeHealth(ctx, req.(*CheckNodeHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_DescribeChecker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeCheckerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).DescribeChecker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/DescribeChecker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).DescribeChecker(ctx, req.(*DescribeCheckerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_ListReplicas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReplicasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).ListReplicas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/ListReplicas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).ListReplicas(ctx, req.(*ListReplicasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_CancelLoad_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelLoadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).CancelLoad(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/CancelLoad",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).CancelLoad(ctx, req.(*CancelLoadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetLoadState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoadStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetLoadState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetLoadState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetLoadState(ctx, req.(*GetLoadStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_RebalanceCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebalanceCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).RebalanceCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/RebalanceCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).RebalanceCollection(ctx, req.(*RebalanceCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_DryRunRebalanceCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebalanceCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).DryRunRebalanceCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/DryRunRebalanceCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).DryRunRebalanceCollection(ctx, req.(*RebalanceCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetResourceGroupUtilization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceGroupUtilizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetResourceGroupUtilization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetResourceGroupUtilization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetResourceGroupUtilization(ctx, req.(*GetResourceGroupUtilizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_SyncNewCreatedPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncNewCreatedPartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).SyncNewCreatedPartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/SyncNewCreatedPartitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).SyncNewCreatedPartitions(ctx, req.(*SyncNewCreatedPartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_WatchCollections_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchCollectionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryCoordServer).WatchCollections(m, &queryCoordWatchCollectionsServer{stream})
+}
+
+type QueryCoord_WatchCollectionsServer interface {
+	Send(*WatchCollectionsResponse) error
+	grpc.ServerStream
+}
+
+type queryCoordWatchCollectionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryCoordWatchCollectionsServer) Send(m *WatchCollectionsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _QueryCoord_SetCollectionBalanceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCollectionBalanceModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).SetCollectionBalanceMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/SetCollectionBalanceMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).SetCollectionBalanceMode(ctx, req.(*SetCollectionBalanceModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetLoadBalanceStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoadBalanceStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetLoadBalanceStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetLoadBalanceStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetLoadBalanceStatus(ctx, req.(*GetLoadBalanceStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_SetBalancePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBalancePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).SetBalancePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/SetBalancePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).SetBalancePolicy(ctx, req.(*SetBalancePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_DescribeReplica_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeReplicaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).DescribeReplica(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/DescribeReplica",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).DescribeReplica(ctx, req.(*DescribeReplicaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetCollectionTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionTargetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetCollectionTargets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetCollectionTargets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetCollectionTargets(ctx, req.(*GetCollectionTargetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_DecommissionNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecommissionNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).DecommissionNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/DecommissionNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).DecommissionNode(ctx, req.(*DecommissionNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetDecommissionProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDecommissionProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetDecommissionProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetDecommissionProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetDecommissionProgress(ctx, req.(*GetDecommissionProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _QueryCoord_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "milvus.proto.query.QueryCoord",
+	HandlerType: (*QueryCoordServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetComponentStates",
+			Handler:    _QueryCoord_GetComponentStates_Handler,
+		},
+		{
+			MethodName: "GetTimeTickChannel",
+			Handler:    _QueryCoord_GetTimeTickChannel_Handler,
+		},
+		{
+			MethodName: "GetStatisticsChannel",
+			Handler:    _QueryCoord_GetStatisticsChannel_Handler,
+		},
+		{
+			MethodName: "ShowCollections",
+			Handler:    _QueryCoord_ShowCollections_Handler,
+		},
+		{
+			MethodName: "ShowPartitions",
+			Handler:    _QueryCoord_ShowPartitions_Handler,
+		},
+		{
+			MethodName: "LoadPartitions",
+			Handler:    _QueryCoord_LoadPartitions_Handler,
+		},
+		{
+			MethodName: "ReleasePartitions",
+			Handler:    _QueryCoord_ReleasePartitions_Handler,
+		},
+		{
+			MethodName: "LoadCollection",
+			Handler:    _QueryCoord_LoadCollection_Handler,
+		},
+		{
+			MethodName: "ReleaseCollection",
+			Handler:    _QueryCoord_ReleaseCollection_Handler,
+		},
+		{
+			MethodName: "SyncNewCreatedPartition",
+			Handler:    _QueryCoord_SyncNewCreatedPartition_Handler,
+		},
+		{
+			MethodName: "GetPartitionStates",
+			Handler:    _QueryCoord_GetPartitionStates_Handler,
+		},
+		{
+			MethodName: "GetSegmentInfo",
+			Handler:    _QueryCoord_GetSegmentInfo_Handler,
+		},
+		{
+			MethodName: "LoadBalance",
+			Handler:    _QueryCoord_LoadBalance_Handler,
+		},
+		{
+			MethodName: "ShowConfigurations",
+			Handler:    _QueryCoord_ShowConfigurations_Handler,
+		},
+		{
+			MethodName: "GetMetrics",
+			Handler:    _QueryCoord_GetMetrics_Handler,
+		},
+		{
+			MethodName: "GetReplicas",
+			Handler:    _QueryCoord_GetReplicas_Handler,
+		},
+		{
+			MethodName: "GetShardLeaders",
+			Handler:    _QueryCoord_GetShardLeaders_Handler,
+		},
+		{
+			MethodName: "CheckHealth",
+			Handler:    _QueryCoord_CheckHealth_Handler,
+		},
+		{
+			MethodName: "CreateResourceGroup",
+			Handler:    _QueryCoord_CreateResourceGroup_Handler,
+		},
+		{
+			MethodName: "UpdateResourceGroups",
+			Handler:    _QueryCoord_UpdateResourceGroups_Handler,
+		},
+		{
+			MethodName: "DropResourceGroup",
+			Handler:    _QueryCoord_DropResourceGroup_Handler,
+		},
+		{
+			MethodName: "TransferNode",
+			Handler:    _QueryCoord_TransferNode_Handler,
+		},
+		{
+			MethodName: "TransferReplica",
+			Handler:    _QueryCoord_TransferReplica_Handler,
+		},
+		{
+			MethodName: "ListResourceGroups",
+			Handler:    _QueryCoord_ListResourceGroups_Handler,
+		},
+		{
+			MethodName: "DescribeResourceGroup",
+			Handler:    _QueryCoord_DescribeResourceGroup_Handler,
+		},
+		{
+			MethodName: "ListCheckers",
+			Handler:    _QueryCoord_ListCheckers_Handler,
+		},
+		{
+			MethodName: "ActivateChecker",
+			Handler:    _QueryCoord_ActivateChecker_Handler,
+		},
+		{
+			MethodName: "DeactivateChecker",
+			Handler:    _QueryCoord_DeactivateChecker_Handler,
+		},
+		{
+			MethodName: "ListQueryNode",
+			Handler:    _QueryCoord_ListQueryNode_Handler,
+		},
+		{
+			MethodName: "GetQueryNodeDistribution",
+			Handler:    _QueryCoord_GetQueryNodeDistribution_Handler,
+		},
+		{
+			MethodName: "SuspendBalance",
+			Handler:    _QueryCoord_SuspendBalance_Handler,
+		},
+		{
+			MethodName: "ResumeBalance",
+			Handler:    _QueryCoord_ResumeBalance_Handler,
+		},
+		{
+			MethodName: "SuspendNode",
+			Handler:    _QueryCoord_SuspendNode_Handler,
+		},
+		{
+			MethodName: "ResumeNode",
+			Handler:    _QueryCoord_ResumeNode_Handler,
+		},
+		{
+			MethodName: "TransferSegment",
+			Handler:    _QueryCoord_TransferSegment_Handler,
+		},
+		{
+			MethodName: "TransferChannel",
+			Handler:    _QueryCoord_TransferChannel_Handler,
+		},
+		{
+			MethodName: "CheckQueryNodeDistribution",
+			Handler:    _QueryCoord_CheckQueryNodeDistribution_Handler,
+		},
+		{
+			MethodName: "GetClusterLoadSummary",
+			Handler:    _QueryCoord_GetClusterLoadSummary_Handler,
+		},
+		{
+			MethodName: "ForceSync",
+			Handler:    _QueryCoord_ForceSync_Handler,
+		},
+		{
+			MethodName: "GetTransferNodeStatus",
+			Handler:    _QueryCoord_GetTransferNodeStatus_Handler,
+		},
+		{
+			MethodName: "TriggerCheckerRun",
+			Handler:    _QueryCoord_TriggerCheckerRun_Handler,
+		},
+		{
+			MethodName: "GetAvailabilitySLA",
+			Handler:    _QueryCoord_GetAvailabilitySLA_Handler,
+		},
+		{
+			MethodName: "GetReleaseProgress",
+			Handler:    _QueryCoord_GetReleaseProgress_Handler,
+		},
+		{
+			MethodName: "GetTenantViolations",
+			Handler:    _QueryCoord_GetTenantViolations_Handler,
+		},
+		{
+			MethodName: "CaptureBalanceLayout",
+			Handler:    _QueryCoord_CaptureBalanceLayout_Handler,
+		},
+		{
+			MethodName: "ClearBalanceLayout",
+			Handler:    _QueryCoord_ClearBalanceLayout_Handler,
+		},
+		{
+			MethodName: "GetBalanceLayoutStatus",
+			Handler:    _QueryCoord_GetBalanceLayoutStatus_Handler,
+		},
+		{
+			MethodName: "GetCollectionLoadInfo",
+			Handler:    _QueryCoord_GetCollectionLoadInfo_Handler,
+		},
+		{
+			MethodName: "RecommendReplicaCount",
+			Handler:    _QueryCoord_RecommendReplicaCount_Handler,
+		},
+		{
+			MethodName: "PauseTargetUpdates",
+			Handler:    _QueryCoord_PauseTargetUpdates_Handler,
+		},
+		{
+			MethodName: "ResumeTargetUpdates",
+			Handler:    _QueryCoord_ResumeTargetUpdates_Handler,
+		},
+		{
+			MethodName: "GetNodeLoadHistory",
+			Handler:    _QueryCoord_GetNodeLoadHistory_Handler,
+		},
+		{
+			MethodName: "BlockShard",
+			Handler:    _QueryCoord_BlockShard_Handler,
+		},
+		{
+			MethodName: "UnblockShard",
+			Handler:    _QueryCoord_UnblockShard_Handler,
+		},
+		{
+			MethodName: "GetResourceGroupDrift",
+			Handler:    _QueryCoord_GetResourceGroupDrift_Handler,
+		},
+		{
+			MethodName: "CanStopNode",
+			Handler:    _QueryCoord_CanStopNode_Handler,
+		},
+		{
+			MethodName: "MoveCollectionToResourceGroup",
+			Handler:    _QueryCoord_MoveCollectionToResourceGroup_Handler,
+		},
+		{
+			MethodName: "GetShardLeadersBatch",
+			Handler:    _QueryCoord_GetShardLeadersBatch_Handler,
+		},
+		{
+			MethodName: "GetHandoffLag",
+			Handler:    _QueryCoord_GetHandoffLag_Handler,
+		},
+		{
+			MethodName: "CreateResourceGroupWithAutoFill",
+			Handler:    _QueryCoord_CreateResourceGroupWithAutoFill_Handler,
+		},
+		{
+			MethodName: "GetLoadInfo",
+			Handler:    _QueryCoord_GetLoadInfo_Handler,
+		},
+		{
+			MethodName: "ReleaseSegments",
+			Handler:    _QueryCoord_ReleaseSegments_Handler,
+		},
+		{
+			MethodName: "DryRunLoadBalance",
+			Handler:    _QueryCoord_DryRunLoadBalance_Handler,
+		},
+		{
+			MethodName: "TransferNodeByFraction",
+			Handler:    _QueryCoord_TransferNodeByFraction_Handler,
+		},
+		{
+			MethodName: "CheckNodeHealth",
+			Handler:    _QueryCoord_CheckNodeHealth_Handler,
+		},
+		{
+			MethodName: "DescribeChecker",
+			Handler:    _QueryCoord_DescribeChecker_Handler,
+		},
+		{
+			MethodName: "ListReplicas",
+			Handler:    _QueryCoord_ListReplicas_Handler,
+		},
+		{
+			MethodName: "CancelLoad",
+			Handler:    _QueryCoord_CancelLoad_Handler,
+		},
+		{
+			MethodName: "GetLoadState",
+			Handler:    _QueryCoord_GetLoadState_Handler,
+		},
+		{
+			MethodName: "RebalanceCollection",
+			Handler:    _QueryCoord_RebalanceCollection_Handler,
+		},
+		{
+			MethodName: "DryRunRebalanceCollection",
+			Handler:    _QueryCoord_DryRunRebalanceCollection_Handler,
+		},
+		{
+			MethodName: "GetResourceGroupUtilization",
+			Handler:    _QueryCoord_GetResourceGroupUtilization_Handler,
+		},
+		{
+			MethodName: "SyncNewCreatedPartitions",
+			Handler:    _QueryCoord_SyncNewCreatedPartitions_Handler,
+		},
+		{
+			MethodName: "SetCollectionBalanceMode",
+			Handler:    _QueryCoord_SetCollectionBalanceMode_Handler,
+		},
+		{
+			MethodName: "GetLoadBalanceStatus",
+			Handler:    _QueryCoord_GetLoadBalanceStatus_Handler,
+		},
+		{
+			MethodName: "SetBalancePolicy",
+			Handler:    _QueryCoord_SetBalancePolicy_Handler,
+		},
+		{
+			MethodName: "DescribeReplica",
+			Handler:    _QueryCoord_DescribeReplica_Handler,
+		},
+		{
+			MethodName: "GetCollectionTargets",
+			Handler:    _QueryCoord_GetCollectionTargets_Handler,
+		},
+		{
+			MethodName: "DecommissionNode",
+			Handler:    _QueryCoord_DecommissionNode_Handler,
+		},
+		{
+			MethodName: "GetDecommissionProgress",
+			Handler:    _QueryCoord_GetDecommissionProgress_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchCollections",
+			Handler:       _QueryCoord_WatchCollections_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "query_coord.proto",
+}
+
+// QueryNodeClient is the client API for QueryNode service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryNodeClient interface {
+	GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error)
+	GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	WatchDmChannels(ctx context.Context, in *WatchDmChannelsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	UnsubDmChannel(ctx context.Context, in *UnsubDmChannelRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	LoadSegments(ctx context.Context, in *LoadSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ReleaseCollection(ctx context.Context, in *ReleaseCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	LoadPartitions(ctx context.Context, in *LoadPartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ReleasePartitions(ctx context.Context, in *ReleasePartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ReleaseSegments(ctx context.Context, in *ReleaseSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
+	SyncReplicaSegments(ctx context.Context, in *SyncReplicaSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetStatistics(ctx context.Context, in *GetStatisticsRequest, opts ...grpc.CallOption) (*internalpb.GetStatisticsResponse, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*internalpb.SearchResults, error)
+	SearchSegments(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*internalpb.SearchResults, error)
+	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*internalpb.RetrieveResults, error)
+	QueryStream(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (QueryNode_QueryStreamClient, error)
+	QuerySegments(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*internalpb.RetrieveResults, error)
+	QueryStreamSegments(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (QueryNode_QueryStreamSegmentsClient, error)
+	ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
+	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
+	GetDataDistribution(ctx context.Context, in *GetDataDistributionRequest, opts ...grpc.CallOption) (*GetDataDistributionResponse, error)
+	SyncDistribution(ctx context.Context, in *SyncDistributionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+}
+
+type queryNodeClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewQueryNodeClient(cc *grpc.ClientConn) QueryNodeClient {
+	return &queryNodeClient{cc}
+}
+
+func (c *queryNodeClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
+	out := new(milvuspb.ComponentStates)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/GetComponentStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryNodeClient) GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	out := new(milvuspb.StringResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/GetTimeTickChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryNodeClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	out := new(milvuspb.StringResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/GetStatisticsChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryNodeClient) WatchDmChannels(ctx context.Context, in *WatchDmChannelsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/WatchDmChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryNodeClient) UnsubDmChannel(ctx context.Context, in *UnsubDmChannelRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/UnsubDmChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryNodeClient) LoadSegments(ctx context.Context, in *LoadSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/LoadSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryNodeClient) ReleaseCollection(ctx context.Context, in *ReleaseCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/ReleaseCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryNodeClient) LoadPartitions(ctx context.Context, in *LoadPartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/LoadPartitions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryNodeClient) ReleasePartitions(ctx context.Context, in *ReleasePartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/ReleasePartitions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryNodeClient) ReleaseSegments(ctx context.Context, in *ReleaseSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/ReleaseSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryNodeClient) GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error) {
+	out := new(GetSegmentInfoResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/GetSegmentInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryNodeClient) SyncReplicaSegments(ctx context.Context, in *SyncReplicaSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/SyncReplicaSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryNodeClient) GetStatistics(ctx context.Context, in *GetStatisticsRequest, opts ...grpc.CallOption) (*internalpb.GetStatisticsResponse, error) {
+	out := new(internalpb.GetStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/GetStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryNodeClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*internalpb.SearchResults, error) {
+	out := new(internalpb.SearchResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryNodeClient) SearchSegments(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*internalpb.SearchResults, error) {
+	out := new(internalpb.SearchResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/SearchSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryNodeClient) Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*internalpb.RetrieveResults, error) {
+	out := new(internalpb.RetrieveResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/Query", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryNodeClient) QueryStream(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (QueryNode_QueryStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_QueryNode_serviceDesc.Streams[0], "/milvus.proto.query.QueryNode/QueryStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryNodeQueryStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type QueryNode_QueryStreamClient interface {
+	Recv() (*internalpb.RetrieveResults, error)
+	grpc.ClientStream
+}
+
+type queryNodeQueryStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryNodeQueryStreamClient) Recv() (*internalpb.RetrieveResults, error) {
+	m := new(internalpb.RetrieveResults)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *queryNodeClient) QuerySegments(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*internalpb.RetrieveResults, error) {
+	out := new(internalpb.RetrieveResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/QuerySegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryNodeClient) QueryStreamSegments(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (QueryNode_QueryStreamSegmentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_QueryNode_serviceDesc.Streams[1], "/milvus.proto.query.QueryNode/QueryStreamSegments", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryNodeQueryStreamSegmentsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type QueryNode_QueryStreamSegmentsClient interface {
+	Recv() (*internalpb.RetrieveResults, error)
+	grpc.ClientStream
+}
+
+type queryNodeQueryStreamSegmentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryNodeQueryStreamSegmentsClient) Recv() (*internalpb.RetrieveResults, error) {
+	m := new(internalpb.RetrieveResults)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *queryNodeClient) ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error) {
+	out := new(internalpb.ShowConfigurationsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/ShowConfigurations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryNodeClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
+	out := new(milvuspb.GetMetricsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/GetMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryNodeClient) GetDataDistribution(ctx context.Context, in *GetDataDistributionRequest, opts ...grpc.CallOption) (*GetDataDistributionResponse, error) {
+	out := new(GetDataDistributionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/GetDataDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryNodeClient) SyncDistribution(ctx context.Context, in *SyncDistributionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/SyncDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryNodeClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryNodeServer is the server API for QueryNode service.
+type QueryNodeServer interface {
+	GetComponentStates(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)
+	GetTimeTickChannel(context.Context, *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error)
+	GetStatisticsChannel(context.Context, *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error)
+	WatchDmChannels(context.Context, *WatchDmChannelsRequest) (*commonpb.Status, error)
+	UnsubDmChannel(context.Context, *UnsubDmChannelRequest) (*commonpb.Status, error)
+	LoadSegments(context.Context, *LoadSegmentsRequest) (*commonpb.Status, error)
+	ReleaseCollection(context.Context, *ReleaseCollectionRequest) (*commonpb.Status, error)
+	LoadPartitions(context.Context, *LoadPartitionsRequest) (*commonpb.Status, error)
+	ReleasePartitions(context.Context, *ReleasePartitionsRequest) (*commonpb.Status, error)
+	ReleaseSegments(context.Context, *ReleaseSegmentsRequest) (*commonpb.Status, error)
+	GetSegmentInfo(context.Context, *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error)
+	SyncReplicaSegments(context.Context, *SyncReplicaSegmentsRequest) (*commonpb.Status, error)
+	GetStatistics(context.Context, *GetStatisticsRequest) (*internalpb.GetStatisticsResponse, error)
+	Search(context.Context, *SearchRequest) (*internalpb.SearchResults, error)
+	SearchSegments(context.Context, *SearchRequest) (*internalpb.SearchResults, error)
+	Query(context.Context, *QueryRequest) (*internalpb.RetrieveResults, error)
+	QueryStream(*QueryRequest, QueryNode_QueryStreamServer) error
+	QuerySegments(context.Context, *QueryRequest) (*internalpb.RetrieveResults, error)
+	QueryStreamSegments(*QueryRequest, QueryNode_QueryStreamSegmentsServer) error
+	ShowConfigurations(context.Context, *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
+	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+	GetDataDistribution(context.Context, *GetDataDistributionRequest) (*GetDataDistributionResponse, error)
+	SyncDistribution(context.Context, *SyncDistributionRequest) (*commonpb.Status, error)
+	Delete(context.Context, *DeleteRequest) (*commonpb.Status, error)
+}
+
+// UnimplementedQueryNodeServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryNodeServer struct {
+}
+
+func (*UnimplementedQueryNodeServer) GetComponentStates(ctx context.Context, req *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComponentStates not implemented")
+}
+func (*UnimplementedQueryNodeServer) GetTimeTickChannel(ctx context.Context, req *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTimeTickChannel not implemented")
+}
+func (*UnimplementedQueryNodeServer) GetStatisticsChannel(ctx context.Context, req *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatisticsChannel not implemented")
+}
+func (*UnimplementedQueryNodeServer) WatchDmChannels(ctx context.Context, req *WatchDmChannelsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WatchDmChannels not implemented")
+}
+func (*UnimplementedQueryNodeServer) UnsubDmChannel(ctx context.Context, req *UnsubDmChannelRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubDmChannel not implemented")
+}
+func (*UnimplementedQueryNodeServer) LoadSegments(ctx context.Context, req *LoadSegmentsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadSegments not implemented")
+}
+func (*UnimplementedQueryNodeServer) ReleaseCollection(ctx context.Context, req *ReleaseCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseCollection not implemented")
+}
+func (*UnimplementedQueryNodeServer) LoadPartitions(ctx context.Context, req *LoadPartitionsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadPartitions not implemented")
+}
+func (*UnimplementedQueryNodeServer) ReleasePartitions(ctx context.Context, req *ReleasePartitionsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleasePartitions not implemented")
+}
+func (*UnimplementedQueryNodeServer) ReleaseSegments(ctx context.Context, req *ReleaseSegmentsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseSegments not implemented")
+}
+func (*UnimplementedQueryNodeServer) GetSegmentInfo(ctx context.Context, req *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentInfo not implemented")
+}
+func (*UnimplementedQueryNodeServer) SyncReplicaSegments(ctx context.Context, req *SyncReplicaSegmentsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncReplicaSegments not implemented")
+}
+func (*UnimplementedQueryNodeServer) GetStatistics(ctx context.Context, req *GetStatisticsRequest) (*internalpb.GetStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatistics not implemented")
+}
+func (*UnimplementedQueryNodeServer) Search(ctx context.Context, req *SearchRequest) (*internalpb.SearchResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (*UnimplementedQueryNodeServer) SearchSegments(ctx context.Context, req *SearchRequest) (*internalpb.SearchResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchSegments not implemented")
+}
+func (*UnimplementedQueryNodeServer) Query(ctx context.Context, req *QueryRequest) (*internalpb.RetrieveResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
+}
+func (*UnimplementedQueryNodeServer) QueryStream(req *QueryRequest, srv QueryNode_QueryStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method QueryStream not implemented")
+}
+func (*UnimplementedQueryNodeServer) QuerySegments(ctx context.Context, req *QueryRequest) (*internalpb.RetrieveResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySegments not implemented")
+}
+func (*UnimplementedQueryNodeServer) QueryStreamSegments(req *QueryRequest, srv QueryNode_QueryStreamSegmentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method QueryStreamSegments not implemented")
+}
+func (*UnimplementedQueryNodeServer) ShowConfigurations(ctx context.Context, req *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShowConfigurations not implemented")
+}
+func (*UnimplementedQueryNodeServer) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
+}
+func (*UnimplementedQueryNodeServer) GetDataDistribution(ctx context.Context, req *GetDataDistributionRequest) (*GetDataDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataDistribution not implemented")
+}
+func (*UnimplementedQueryNodeServer) SyncDistribution(ctx context.Context, req *SyncDistributionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncDistribution not implemented")
+}
+func (*UnimplementedQueryNodeServer) Delete(ctx context.Context, req *DeleteRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+
+func RegisterQueryNodeServer(s *grpc.Server, srv QueryNodeServer) {
+	s.RegisterService(&_QueryNode_serviceDesc, srv)
+}
+
+func _QueryNode_GetComponentStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetComponentStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).GetComponentStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/GetComponentStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).GetComponentStates(ctx, req.(*milvuspb.GetComponentStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryNode_GetTimeTickChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.GetTimeTickChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).GetTimeTickChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/GetTimeTickChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).GetTimeTickChannel(ctx, req.(*internalpb.GetTimeTickChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryNode_GetStatisticsChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.GetStatisticsChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).GetStatisticsChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/GetStatisticsChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).GetStatisticsChannel(ctx, req.(*internalpb.GetStatisticsChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryNode_WatchDmChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchDmChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).WatchDmChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/WatchDmChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).WatchDmChannels(ctx, req.(*WatchDmChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryNode_UnsubDmChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubDmChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).UnsubDmChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/UnsubDmChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).UnsubDmChannel(ctx, req.(*UnsubDmChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryNode_LoadSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).LoadSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/LoadSegments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).LoadSegments(ctx, req.(*LoadSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryNode_ReleaseCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).ReleaseCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/ReleaseCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).ReleaseCollection(ctx, req.(*ReleaseCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryNode_LoadPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadPartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).LoadPartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/LoadPartitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).LoadPartitions(ctx, req.(*LoadPartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryNode_ReleasePartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleasePartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).ReleasePartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/ReleasePartitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).ReleasePartitions(ctx, req.(*ReleasePartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryNode_ReleaseSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).ReleaseSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/ReleaseSegments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).ReleaseSegments(ctx, req.(*ReleaseSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryNode_GetSegmentInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).GetSegmentInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/GetSegmentInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).GetSegmentInfo(ctx, req.(*GetSegmentInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryNode_SyncReplicaSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncReplicaSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).SyncReplicaSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/SyncReplicaSegments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).SyncReplicaSegments(ctx, req.(*SyncReplicaSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryNode_GetStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).GetStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/GetStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).GetStatistics(ctx, req.(*GetStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryNode_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryNode_SearchSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).SearchSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/SearchSegments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).SearchSegments(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryNode_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).Query(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/Query",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).Query(ctx, req.(*QueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryNode_QueryStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryNodeServer).QueryStream(m, &queryNodeQueryStreamServer{stream})
+}
+
+type QueryNode_QueryStreamServer interface {
+	Send(*internalpb.RetrieveResults) error
+	grpc.ServerStream
+}
+
+type queryNodeQueryStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryNodeQueryStreamServer) Send(m *internalpb.RetrieveResults) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _QueryNode_QuerySegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).QuerySegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/QuerySegments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).QuerySegments(ctx, req.(*QueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryNode_QueryStreamSegments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryNodeServer).QueryStreamSegments(m, &queryNodeQueryStreamSegmentsServer{stream})
+}
+
+type QueryNode_QueryStreamSegmentsServer interface {
+	Send(*internalpb.RetrieveResults) error
+	grpc.ServerStream
+}
+
+type queryNodeQueryStreamSegmentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryNodeQueryStreamSegmentsServer) Send(m *internalpb.RetrieveResults) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _QueryNode_ShowConfigurations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.ShowConfigurationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).ShowConfigurations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/ShowConfigurations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).ShowConfigurations(ctx, req.(*internalpb.ShowConfigurationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryNode_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).GetMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/GetMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).GetMetrics(ctx, req.(*milvuspb.GetMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryNode_GetDataDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).GetDataDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/GetDataDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).GetDataDistribution(ctx, req.(*GetDataDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryNode_SyncDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).SyncDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/SyncDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).SyncDistribution(ctx, req.(*SyncDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryNode_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _QueryNode_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "milvus.proto.query.QueryNode",
+	HandlerType: (*QueryNodeServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetComponentStates",
+			Handler:    _QueryNode_GetComponentStates_Handler,
+		},
+		{
+			MethodName: "GetTimeTickChannel",
+			Handler:    _QueryNode_GetTimeTickChannel_Handler,
+		},
+		{
+			MethodName: "GetStatisticsChannel",
+			Handler:    _QueryNode_GetStatisticsChannel_Handler,
+		},
+		{
+			MethodName: "WatchDmChannels",
+			Handler:    _QueryNode_WatchDmChannels_Handler,
+		},
+		{
+			MethodName: "UnsubDmChannel",
+			Handler:    _QueryNode_UnsubDmChannel_Handler,
+		},
+		{
+			MethodName: "LoadSegments",
+			Handler:    _QueryNode_LoadSegments_Handler,
+		},
+		{
+			MethodName: "ReleaseCollection",
+			Handler:    _QueryNode_ReleaseCollection_Handler,
+		},
+		{
+			MethodName: "LoadPartitions",
+			Handler:    _QueryNode_LoadPartitions_Handler,
+		},
+		{
+			MethodName: "ReleasePartitions",
+			Handler:    _QueryNode_ReleasePartitions_Handler,
+		},
+		{
+			MethodName: "ReleaseSegments",
+			Handler:    _QueryNode_ReleaseSegments_Handler,
+		},
+		{
+			MethodName: "GetSegmentInfo",
+			Handler:    _QueryNode_GetSegmentInfo_Handler,
+		},
+		{
+			MethodName: "SyncReplicaSegments",
+			Handler:    _QueryNode_SyncReplicaSegments_Handler,
+		},
+		{
+			MethodName: "GetStatistics",
+			Handler:    _QueryNode_GetStatistics_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _QueryNode_Search_Handler,
+		},
+		{
+			MethodName: "SearchSegments",
+			Handler:    _QueryNode_SearchSegments_Handler,
+		},
+		{
+			MethodName: "Query",
+			Handler:    _QueryNode_Query_Handler,
+		},
+		{
+			MethodName: "QuerySegments",
+			Handler:    _QueryNode_QuerySegments_Handler,
+		},
+		{
+			MethodName: "ShowConfigurations",
+			Handler:    _QueryNode_ShowConfigurations_Handler,
+		},
+		{
+			MethodName: "GetMetrics",
+			Handler:    _QueryNode_GetMetrics_Handler,
+		},
+		{
+			MethodName: "GetDataDistribution",
+			Handler:    _QueryNode_GetDataDistribution_Handler,
+		},
+		{
+			MethodName: "SyncDistribution",
+			Handler:    _QueryNode_SyncDistribution_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _QueryNode_Delete_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "QueryStream",
+			Handler:       _QueryNode_QueryStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "QueryStreamSegments",
+			Handler:       _QueryNode_QueryStreamSegments_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "query_coord.proto",
+}
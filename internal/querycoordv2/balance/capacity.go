@@ -0,0 +1,163 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package balance
+
+import (
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/task"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// nodeCapacity tracks the sealed segment number and estimated memory size of nodes during assignment,
+// to keep them under the caps of queryCoord.nodeMaxSegmentNum and queryCoord.nodeMaxMemorySize.
+// The memory size of segment is estimated by its binlog size.
+type nodeCapacity struct {
+	maxSegmentNum int
+	maxMemorySize int64
+	segmentNum    map[int64]int
+	memorySize    map[int64]int64
+}
+
+func newNodeCapacity(dist *meta.DistributionManager, scheduler task.Scheduler, nodes []int64) *nodeCapacity {
+	capacity := &nodeCapacity{
+		maxSegmentNum: params.Params.QueryCoordCfg.NodeMaxSegmentNum.GetAsInt(),
+		maxMemorySize: params.Params.QueryCoordCfg.NodeMaxMemorySize.GetAsInt64() * 1024 * 1024,
+		segmentNum:    make(map[int64]int),
+		memorySize:    make(map[int64]int64),
+	}
+	metrics.QueryCoordNodeCapacityLimit.WithLabelValues(metrics.SegmentNumCapacityLabel).Set(float64(capacity.maxSegmentNum))
+	metrics.QueryCoordNodeCapacityLimit.WithLabelValues(metrics.MemorySizeCapacityLabel).Set(float64(capacity.maxMemorySize))
+	if !capacity.limited() {
+		return capacity
+	}
+
+	for _, node := range nodes {
+		segments := dist.SegmentDistManager.GetByFilter(meta.WithNodeID(node))
+		// the segments being loaded count too
+		capacity.segmentNum[node] = len(segments) + scheduler.GetNodeSegmentDelta(node)
+		capacity.memorySize[node] = scheduler.GetNodeSegmentSizeDelta(node)
+		for _, segment := range segments {
+			capacity.memorySize[node] += utils.GetSegmentSize(segment.SegmentInfo)
+		}
+	}
+	return capacity
+}
+
+func (c *nodeCapacity) limited() bool {
+	return c.maxSegmentNum > 0 || c.maxMemorySize > 0
+}
+
+// fit checks whether the node could take the segment without exceeding the caps.
+func (c *nodeCapacity) fit(node int64, segment *meta.Segment) bool {
+	if c.maxSegmentNum > 0 && c.segmentNum[node]+1 > c.maxSegmentNum {
+		return false
+	}
+	if c.maxMemorySize > 0 && c.memorySize[node]+utils.GetSegmentSize(segment.SegmentInfo) > c.maxMemorySize {
+		return false
+	}
+	return true
+}
+
+// full checks whether the node could take no more segment.
+func (c *nodeCapacity) full(node int64) bool {
+	return (c.maxSegmentNum > 0 && c.segmentNum[node] >= c.maxSegmentNum) ||
+		(c.maxMemorySize > 0 && c.memorySize[node] >= c.maxMemorySize)
+}
+
+func (c *nodeCapacity) take(node int64, segment *meta.Segment) {
+	if !c.limited() {
+		return
+	}
+	c.segmentNum[node]++
+	c.memorySize[node] += utils.GetSegmentSize(segment.SegmentInfo)
+}
+
+// pick pops the node with the highest priority which could take the segment,
+// returns nil if all nodes are at capacity.
+func (c *nodeCapacity) pick(queue *priorityQueue, segment *meta.Segment) *nodeItem {
+	skipped := make([]*nodeItem, 0)
+	defer func() {
+		for _, item := range skipped {
+			queue.push(item)
+		}
+	}()
+	for queue.Len() > 0 {
+		item := queue.pop().(*nodeItem)
+		if c.fit(item.nodeID, segment) {
+			return item
+		}
+		skipped = append(skipped, item)
+	}
+	return nil
+}
+
+// filter drops the plans which make the destination node exceed the caps.
+func (c *nodeCapacity) filter(plans []SegmentAssignPlan) []SegmentAssignPlan {
+	if !c.limited() {
+		return plans
+	}
+	return lo.Filter(plans, func(plan SegmentAssignPlan, _ int) bool {
+		if !c.fit(plan.To, plan.Segment) {
+			return false
+		}
+		c.take(plan.To, plan.Segment)
+		return true
+	})
+}
+
+// FilterPlansWithCapacity drops the plans which make the destination node exceed the caps,
+// the plans are taken in order, so the former ones win if the node can't take them all.
+func FilterPlansWithCapacity(dist *meta.DistributionManager, scheduler task.Scheduler, plans []SegmentAssignPlan) []SegmentAssignPlan {
+	nodes := lo.Uniq(lo.Map(plans, func(plan SegmentAssignPlan, _ int) int64 {
+		return plan.To
+	}))
+	return newNodeCapacity(dist, scheduler, nodes).filter(plans)
+}
+
+// FilterNodesWithCapacity returns the nodes which could take more segments without exceeding the caps.
+func FilterNodesWithCapacity(dist *meta.DistributionManager, scheduler task.Scheduler, nodes []int64) []int64 {
+	capacity := newNodeCapacity(dist, scheduler, nodes)
+	return lo.Filter(nodes, func(node int64, _ int) bool {
+		return !capacity.full(node)
+	})
+}
+
+// CheckNodesCapacity returns an ErrServiceQuotaExceeded error if some of the segments can't be assigned to
+// any of the nodes without exceeding the caps, nil otherwise.
+func CheckNodesCapacity(dist *meta.DistributionManager, scheduler task.Scheduler, nodes []int64, segments []*meta.Segment) error {
+	capacity := newNodeCapacity(dist, scheduler, nodes)
+	if !capacity.limited() {
+		return nil
+	}
+	overflow := lo.FilterMap(segments, func(segment *meta.Segment, _ int) (int64, bool) {
+		return segment.GetID(), !lo.ContainsBy(nodes, func(node int64) bool {
+			return capacity.fit(node, segment)
+		})
+	})
+	if len(overflow) == 0 {
+		return nil
+	}
+	return merr.WrapErrServiceQuotaExceeded(
+		fmt.Sprintf("segments %v can't be assigned, all nodes %v reach the cap of segment number or memory size", overflow, nodes))
+}
@@ -64,16 +64,23 @@ func (b *RowCountBasedBalancer) AssignSegment(collectionID int64, segments []*me
 		return segments[i].GetNumOfRows() > segments[j].GetNumOfRows()
 	})
 
+	capacity := newNodeCapacity(b.dist, b.scheduler, nodes)
 	plans := make([]SegmentAssignPlan, 0, len(segments))
 	for _, s := range segments {
 		// pick the node with the least row count and allocate to it.
-		ni := queue.pop().(*nodeItem)
+		ni := capacity.pick(&queue, s)
+		if ni == nil {
+			log.Warn("all nodes are at capacity, skip assigning segment",
+				zap.Int64("collectionID", collectionID), zap.Int64("segmentID", s.GetID()))
+			continue
+		}
 		plan := SegmentAssignPlan{
 			From:    -1,
 			To:      ni.nodeID,
 			Segment: s,
 		}
 		plans = append(plans, plan)
+		capacity.take(ni.nodeID, s)
 		// change node's priority and push back
 		p := ni.getPriority()
 		ni.setPriority(p + int(s.GetNumOfRows()))
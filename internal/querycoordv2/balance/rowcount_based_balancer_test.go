@@ -952,6 +952,70 @@ func (suite *RowCountBasedBalancerTestSuite) TestAssignSegmentWithGrowing() {
 	}
 }
 
+func (suite *RowCountBasedBalancerTestSuite) TestAssignSegmentWithCapacity() {
+	suite.SetupSuite()
+	defer suite.TearDownTest()
+	balancer := suite.balancer
+	suite.mockScheduler.EXPECT().GetNodeSegmentDelta(mock.Anything).Return(0)
+	suite.mockScheduler.EXPECT().GetNodeSegmentSizeDelta(int64(1)).Return(0)
+	suite.mockScheduler.EXPECT().GetNodeSegmentSizeDelta(int64(2)).Return(0).Twice()
+
+	binlogs := func(size int64) []*datapb.FieldBinlog {
+		return []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogSize: size}}}}
+	}
+	balancer.dist.SegmentDistManager.Update(1, &meta.Segment{
+		SegmentInfo: &datapb.SegmentInfo{ID: 1, NumOfRows: 10, CollectionID: 1, Binlogs: binlogs(1024 * 1024)},
+		Node:        1,
+	})
+	for _, node := range []int64{1, 2} {
+		nodeInfo := session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   node,
+			Address:  "127.0.0.1:0",
+			Hostname: "localhost",
+		})
+		nodeInfo.SetState(session.NodeStateNormal)
+		suite.balancer.nodeManager.Add(nodeInfo)
+	}
+
+	toAssign := func() []*meta.Segment {
+		return []*meta.Segment{
+			{SegmentInfo: &datapb.SegmentInfo{ID: 2, NumOfRows: 20, CollectionID: 1, Binlogs: binlogs(1)}},
+			{SegmentInfo: &datapb.SegmentInfo{ID: 3, NumOfRows: 5, CollectionID: 1, Binlogs: binlogs(1)}},
+			{SegmentInfo: &datapb.SegmentInfo{ID: 4, NumOfRows: 5, CollectionID: 1, Binlogs: binlogs(1)}},
+			{SegmentInfo: &datapb.SegmentInfo{ID: 5, NumOfRows: 1, CollectionID: 1, Binlogs: binlogs(1)}},
+		}
+	}
+
+	// each node holds 2 segments at most, the last segment is skipped
+	paramtable.Get().Save(Params.QueryCoordCfg.NodeMaxSegmentNum.Key, "2")
+	plans := balancer.AssignSegment(1, toAssign(), []int64{1, 2}, false)
+	paramtable.Get().Reset(Params.QueryCoordCfg.NodeMaxSegmentNum.Key)
+	suite.Len(plans, 3)
+	segmentNum := make(map[int64]int)
+	for _, plan := range plans {
+		segmentNum[plan.To]++
+	}
+	suite.Equal(1, segmentNum[1])
+	suite.Equal(2, segmentNum[2])
+	suite.NotContains(lo.Map(plans, func(plan SegmentAssignPlan, _ int) int64 { return plan.Segment.GetID() }), int64(5))
+
+	// node 1 is full of memory
+	paramtable.Get().Save(Params.QueryCoordCfg.NodeMaxMemorySize.Key, "1")
+	plans = balancer.AssignSegment(1, toAssign(), []int64{1, 2}, false)
+	paramtable.Get().Reset(Params.QueryCoordCfg.NodeMaxMemorySize.Key)
+	suite.Len(plans, 4)
+	for _, plan := range plans {
+		suite.EqualValues(2, plan.To)
+	}
+
+	// the segments being loaded to node 2 count too
+	suite.mockScheduler.EXPECT().GetNodeSegmentSizeDelta(int64(2)).Return(1024 * 1024)
+	paramtable.Get().Save(Params.QueryCoordCfg.NodeMaxMemorySize.Key, "1")
+	plans = balancer.AssignSegment(1, toAssign(), []int64{1, 2}, false)
+	paramtable.Get().Reset(Params.QueryCoordCfg.NodeMaxMemorySize.Key)
+	suite.Len(plans, 0)
+}
+
 func (suite *RowCountBasedBalancerTestSuite) TestDisableBalanceChannel() {
 	cases := []struct {
 		name                 string
@@ -83,11 +83,17 @@ func (b *ScoreBasedBalancer) AssignSegment(collectionID int64, segments []*meta.
 		return segments[i].GetNumOfRows() > segments[j].GetNumOfRows()
 	})
 
+	capacity := newNodeCapacity(b.dist, b.scheduler, nodes)
 	plans := make([]SegmentAssignPlan, 0, len(segments))
 	for _, s := range segments {
 		func(s *meta.Segment) {
 			// for each segment, pick the node with the least score
-			targetNode := queue.pop().(*nodeItem)
+			targetNode := capacity.pick(&queue, s)
+			if targetNode == nil {
+				log.Warn("all nodes are at capacity, skip assigning segment",
+					zap.Int64("collectionID", collectionID), zap.Int64("segmentID", s.GetID()))
+				return
+			}
 			// make sure candidate is always push back
 			defer queue.push(targetNode)
 			priorityChange := b.calculateSegmentScore(s)
@@ -106,6 +112,7 @@ func (b *ScoreBasedBalancer) AssignSegment(collectionID int64, segments []*meta.
 				Segment: s,
 			}
 			plans = append(plans, plan)
+			capacity.take(targetNode.nodeID, s)
 
 			// update the targetNode's score
 			if sourceNode != nil {
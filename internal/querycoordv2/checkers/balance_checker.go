@@ -41,13 +41,18 @@ type BalanceChecker struct {
 	*checkerActivation
 	balance.Balance
 	meta                                 *meta.Meta
+	dist                                 *meta.DistributionManager
 	nodeManager                          *session.NodeManager
 	normalBalanceCollectionsCurrentRound typeutil.UniqueSet
 	scheduler                            task.Scheduler
 	targetMgr                            *meta.TargetManager
+	// collections being balanced manually, skipped by auto balance
+	manualBalancingCollections *typeutil.ConcurrentSet[int64]
+	*balancerSelector
 }
 
 func NewBalanceChecker(meta *meta.Meta,
+	dist *meta.DistributionManager,
 	targetMgr *meta.TargetManager,
 	balancer balance.Balance,
 	nodeMgr *session.NodeManager,
@@ -57,10 +62,13 @@ func NewBalanceChecker(meta *meta.Meta,
 		checkerActivation:                    newCheckerActivation(),
 		Balance:                              balancer,
 		meta:                                 meta,
+		dist:                                 dist,
 		targetMgr:                            targetMgr,
 		nodeManager:                          nodeMgr,
 		normalBalanceCollectionsCurrentRound: typeutil.NewUniqueSet(),
 		scheduler:                            scheduler,
+		manualBalancingCollections:           typeutil.NewConcurrentSet[int64](),
+		balancerSelector:                     newBalancerSelector(meta, balancer),
 	}
 }
 
@@ -72,6 +80,16 @@ func (b *BalanceChecker) Description() string {
 	return "BalanceChecker checks the cluster distribution and generates balance tasks"
 }
 
+// StartManualBalance excludes the collection from auto balance until FinishManualBalance called,
+// returns false if the collection is being balanced manually already.
+func (b *BalanceChecker) StartManualBalance(collectionID int64) bool {
+	return b.manualBalancingCollections.Insert(collectionID)
+}
+
+func (b *BalanceChecker) FinishManualBalance(collectionID int64) {
+	b.manualBalancingCollections.Remove(collectionID)
+}
+
 func (b *BalanceChecker) readyToCheck(collectionID int64) bool {
 	metaExist := (b.meta.GetCollection(collectionID) != nil)
 	targetExist := b.targetMgr.IsNextTargetExist(collectionID) || b.targetMgr.IsCurrentTargetExist(collectionID)
@@ -85,7 +103,8 @@ func (b *BalanceChecker) replicasToBalance() []int64 {
 	// all replicas belonging to loading collection will be skipped
 	loadedCollections := lo.Filter(ids, func(cid int64, _ int) bool {
 		collection := b.meta.GetCollection(cid)
-		return collection != nil && collection.GetStatus() == querypb.LoadStatus_Loaded
+		return collection != nil && collection.GetStatus() == querypb.LoadStatus_Loaded &&
+			!b.manualBalancingCollections.Contain(cid)
 	})
 	sort.Slice(loadedCollections, func(i, j int) bool {
 		return loadedCollections[i] < loadedCollections[j]
@@ -129,6 +148,10 @@ func (b *BalanceChecker) replicasToBalance() []int64 {
 	normalReplicasToBalance := make([]int64, 0)
 	hasUnbalancedCollection := false
 	for _, cid := range loadedCollections {
+		// balance excluded collections are only balanced for stopping nodes or manually
+		if collection := b.meta.GetCollection(cid); collection == nil || collection.GetBalanceExcluded() {
+			continue
+		}
 		if b.normalBalanceCollectionsCurrentRound.Contain(cid) {
 			log.Debug("ScoreBasedBalancer has balanced collection, skip balancing in this round",
 				zap.Int64("collectionID", cid))
@@ -157,7 +180,11 @@ func (b *BalanceChecker) balanceReplicas(replicaIDs []int64) ([]balance.SegmentA
 		if replica == nil {
 			continue
 		}
-		sPlans, cPlans := b.Balance.BalanceReplica(replica)
+		sPlans, cPlans := b.getBalancer(replica.GetCollectionID()).BalanceReplica(replica)
+		sPlans = b.filterPinnedSegments(replica, sPlans)
+		if layout := b.meta.BalanceLayoutManager.GetLayout(replica.GetCollectionID()); layout != nil && !b.hasOfflineNode(replica) {
+			sPlans, cPlans = b.restoreLayout(replica, layout, sPlans, cPlans)
+		}
 		segmentPlans = append(segmentPlans, sPlans...)
 		channelPlans = append(channelPlans, cPlans...)
 		if len(segmentPlans) != 0 || len(channelPlans) != 0 {
@@ -167,6 +194,94 @@ func (b *BalanceChecker) balanceReplicas(replicaIDs []int64) ([]balance.SegmentA
 	return segmentPlans, channelPlans
 }
 
+// filterPinnedSegments drops the plans moving segments away from the nodes they are pinned to by load hints,
+// unless the nodes are being drained.
+func (b *BalanceChecker) filterPinnedSegments(replica *meta.Replica, plans []balance.SegmentAssignPlan) []balance.SegmentAssignPlan {
+	collection := b.meta.GetCollection(replica.GetCollectionID())
+	if collection == nil || len(collection.GetSegmentNodeHints()) == 0 {
+		return plans
+	}
+	hints := collection.GetSegmentNodeHints()
+	return lo.Filter(plans, func(plan balance.SegmentAssignPlan, _ int) bool {
+		node, ok := hints[plan.Segment.GetID()]
+		if !ok || node != plan.From {
+			return true
+		}
+		info := b.nodeManager.Get(node)
+		return replica.ContainRONode(node) || info == nil || info.GetState() != session.NodeStateNormal
+	})
+}
+
+// hasOfflineNode checks whether the replica has read only or stopping nodes,
+// which should be drained by balancer no matter whether layout is captured.
+func (b *BalanceChecker) hasOfflineNode(replica *meta.Replica) bool {
+	if replica.RONodesCount() > 0 {
+		return true
+	}
+	for _, node := range replica.GetNodes() {
+		if isStopping, err := b.nodeManager.IsStoppingNode(node); err != nil || isStopping {
+			return true
+		}
+	}
+	return false
+}
+
+// restoreLayout generates plans to move the drifted segments and channels back to the nodes in captured layout,
+// the given balance plans of segments and channels in layout are replaced by them,
+// so only the segments and channels which are not in layout are balanced freely.
+func (b *BalanceChecker) restoreLayout(replica *meta.Replica, layout *meta.BalanceLayout,
+	balanceSegmentPlans []balance.SegmentAssignPlan, balanceChannelPlans []balance.ChannelAssignPlan,
+) ([]balance.SegmentAssignPlan, []balance.ChannelAssignPlan) {
+	replicaLayout, ok := layout.Replicas[replica.GetID()]
+	if !ok {
+		return balanceSegmentPlans, balanceChannelPlans
+	}
+	available := func(node int64) bool {
+		info := b.nodeManager.Get(node)
+		return replica.Contains(node) && info != nil && info.GetState() == session.NodeStateNormal
+	}
+
+	segmentPlans := make([]balance.SegmentAssignPlan, 0)
+	for _, segment := range b.dist.SegmentDistManager.GetByFilter(meta.WithReplica(replica)) {
+		node, ok := replicaLayout.Segments[segment.GetID()]
+		if !ok || node == segment.Node || !available(node) {
+			continue
+		}
+		segmentPlans = append(segmentPlans, balance.SegmentAssignPlan{
+			Segment: segment,
+			Replica: replica,
+			From:    segment.Node,
+			To:      node,
+		})
+	}
+
+	channelPlans := make([]balance.ChannelAssignPlan, 0)
+	for _, channel := range b.dist.ChannelDistManager.GetByFilter(meta.WithReplica2Channel(replica)) {
+		node, ok := replicaLayout.Channels[channel.GetChannelName()]
+		if !ok || node == channel.Node || !available(node) {
+			continue
+		}
+		channelPlans = append(channelPlans, balance.ChannelAssignPlan{
+			Channel: channel,
+			Replica: replica,
+			From:    channel.Node,
+			To:      node,
+		})
+	}
+
+	for _, plan := range balanceSegmentPlans {
+		if _, ok := replicaLayout.Segments[plan.Segment.GetID()]; !ok {
+			segmentPlans = append(segmentPlans, plan)
+		}
+	}
+	for _, plan := range balanceChannelPlans {
+		if _, ok := replicaLayout.Channels[plan.Channel.GetChannelName()]; !ok {
+			channelPlans = append(channelPlans, plan)
+		}
+	}
+	return segmentPlans, channelPlans
+}
+
 func (b *BalanceChecker) Check(ctx context.Context) []task.Task {
 	if !b.IsActive() {
 		return nil
@@ -20,6 +20,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
@@ -44,6 +45,7 @@ type BalanceCheckerTestSuite struct {
 	checker   *BalanceChecker
 	balancer  *balance.MockBalancer
 	meta      *meta.Meta
+	dist      *meta.DistributionManager
 	broker    *meta.MockBroker
 	nodeMgr   *session.NodeManager
 	scheduler *task.MockScheduler
@@ -76,9 +78,10 @@ func (suite *BalanceCheckerTestSuite) SetupTest() {
 	suite.broker = meta.NewMockBroker(suite.T())
 	suite.scheduler = task.NewMockScheduler(suite.T())
 	suite.targetMgr = meta.NewTargetManager(suite.broker, suite.meta)
+	suite.dist = meta.NewDistributionManager()
 
 	suite.balancer = balance.NewMockBalancer(suite.T())
-	suite.checker = NewBalanceChecker(suite.meta, suite.targetMgr, suite.balancer, suite.nodeMgr, suite.scheduler)
+	suite.checker = NewBalanceChecker(suite.meta, suite.dist, suite.targetMgr, suite.balancer, suite.nodeMgr, suite.scheduler)
 }
 
 func (suite *BalanceCheckerTestSuite) TearDownTest() {
@@ -162,6 +165,123 @@ func (suite *BalanceCheckerTestSuite) TestAutoBalanceConf() {
 	suite.Empty(replicasToBalance)
 }
 
+func (suite *BalanceCheckerTestSuite) TestManualBalance() {
+	nodeID1, nodeID2 := int64(1), int64(2)
+	suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   nodeID1,
+		Address:  "localhost",
+		Hostname: "localhost",
+	}))
+	suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   nodeID2,
+		Address:  "localhost",
+		Hostname: "localhost",
+	}))
+	suite.checker.meta.ResourceManager.HandleNodeUp(nodeID1)
+	suite.checker.meta.ResourceManager.HandleNodeUp(nodeID2)
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, mock.Anything).Return(nil, nil, nil)
+
+	for _, cid := range []int64{1, 2} {
+		collection := utils.CreateTestCollection(cid, 1)
+		collection.Status = querypb.LoadStatus_Loaded
+		suite.checker.meta.CollectionManager.PutCollection(collection, utils.CreateTestPartition(cid, cid))
+		suite.checker.meta.ReplicaManager.Put(utils.CreateTestReplica(cid, cid, []int64{nodeID1, nodeID2}))
+		suite.targetMgr.UpdateCollectionNextTarget(cid)
+		suite.targetMgr.UpdateCollectionCurrentTarget(cid)
+	}
+
+	paramtable.Get().Save(Params.QueryCoordCfg.AutoBalance.Key, "true")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.AutoBalance.Key)
+	suite.scheduler.EXPECT().GetSegmentTaskNum().Maybe().Return(0)
+
+	// collection 1 is skipped by auto balance during manual balance
+	suite.True(suite.checker.StartManualBalance(1))
+	suite.False(suite.checker.StartManualBalance(1))
+	suite.ElementsMatch([]int64{2}, suite.checker.replicasToBalance())
+	suite.Empty(suite.checker.replicasToBalance())
+
+	suite.checker.FinishManualBalance(1)
+	suite.ElementsMatch([]int64{1}, suite.checker.replicasToBalance())
+}
+
+func (suite *BalanceCheckerTestSuite) TestBalanceExcluded() {
+	nodeID1, nodeID2 := int64(1), int64(2)
+	suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   nodeID1,
+		Address:  "localhost",
+		Hostname: "localhost",
+	}))
+	suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   nodeID2,
+		Address:  "localhost",
+		Hostname: "localhost",
+	}))
+	suite.checker.meta.ResourceManager.HandleNodeUp(nodeID1)
+	suite.checker.meta.ResourceManager.HandleNodeUp(nodeID2)
+	channels := []*datapb.VchannelInfo{
+		{
+			CollectionID: 1,
+			ChannelName:  "test-insert-channel",
+		},
+	}
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, mock.Anything).Return(channels, nil, nil)
+
+	for _, cid := range []int64{1, 2} {
+		collection := utils.CreateTestCollection(cid, 1)
+		collection.Status = querypb.LoadStatus_Loaded
+		suite.checker.meta.CollectionManager.PutCollection(collection, utils.CreateTestPartition(cid, cid))
+		suite.checker.meta.ReplicaManager.Put(utils.CreateTestReplica(cid, cid, []int64{nodeID1, nodeID2}))
+		suite.targetMgr.UpdateCollectionNextTarget(cid)
+		suite.targetMgr.UpdateCollectionCurrentTarget(cid)
+	}
+
+	paramtable.Get().Save(Params.QueryCoordCfg.AutoBalance.Key, "true")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.AutoBalance.Key)
+	suite.scheduler.EXPECT().GetSegmentTaskNum().Maybe().Return(0)
+
+	// collection 1 is never auto balanced while excluded
+	suite.NoError(suite.checker.meta.CollectionManager.SetBalanceExcluded(1, true))
+	suite.ElementsMatch([]int64{2}, suite.checker.replicasToBalance())
+	suite.Empty(suite.checker.replicasToBalance())
+	suite.ElementsMatch([]int64{2}, suite.checker.replicasToBalance())
+
+	// stopping nodes are still drained
+	suite.nodeMgr.Stopping(nodeID2)
+	suite.ElementsMatch([]int64{1, 2}, suite.checker.replicasToBalance())
+	suite.nodeMgr.Get(nodeID2).SetState(session.NodeStateNormal)
+
+	suite.NoError(suite.checker.meta.CollectionManager.SetBalanceExcluded(1, false))
+	suite.ElementsMatch([]int64{1}, suite.checker.replicasToBalance())
+}
+
+func (suite *BalanceCheckerTestSuite) TestBalancePolicy() {
+	for _, cid := range []int64{1, 2} {
+		collection := utils.CreateTestCollection(cid, 1)
+		collection.Status = querypb.LoadStatus_Loaded
+		suite.checker.meta.CollectionManager.PutCollection(collection, utils.CreateTestPartition(cid, cid))
+		suite.checker.meta.ReplicaManager.Put(utils.CreateTestReplica(cid, cid, []int64{1, 2}))
+	}
+	scoreBalancer := balance.NewMockBalancer(suite.T())
+	suite.checker.RegisterBalancer(balance.ScoreBasedBalancerName, scoreBalancer)
+
+	// collection 1 is balanced by the selected balancer, collection 2 by the global one
+	suite.NoError(suite.checker.meta.CollectionManager.SetBalancePolicy(1, balance.ScoreBasedBalancerName))
+	scoreBalancer.EXPECT().BalanceReplica(mock.Anything).RunAndReturn(func(replica *meta.Replica) ([]balance.SegmentAssignPlan, []balance.ChannelAssignPlan) {
+		suite.Equal(int64(1), replica.GetCollectionID())
+		return nil, nil
+	}).Once()
+	suite.balancer.EXPECT().BalanceReplica(mock.Anything).RunAndReturn(func(replica *meta.Replica) ([]balance.SegmentAssignPlan, []balance.ChannelAssignPlan) {
+		suite.Equal(int64(2), replica.GetCollectionID())
+		return nil, nil
+	}).Once()
+	suite.checker.balanceReplicas([]int64{1, 2})
+
+	// unknown policy falls back to the global balancer
+	suite.NoError(suite.checker.meta.CollectionManager.SetBalancePolicy(1, "unknown"))
+	suite.balancer.EXPECT().BalanceReplica(mock.Anything).Return(nil, nil).Once()
+	suite.checker.balanceReplicas([]int64{1})
+}
+
 func (suite *BalanceCheckerTestSuite) TestBusyScheduler() {
 	// set up nodes info
 	nodeID1, nodeID2 := 1, 2
@@ -297,6 +417,97 @@ func (suite *BalanceCheckerTestSuite) TestStoppingBalance() {
 	suite.Len(tasks, 2)
 }
 
+func (suite *BalanceCheckerTestSuite) TestRestoreLayout() {
+	for _, node := range []int64{1, 2} {
+		suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   node,
+			Address:  "localhost",
+			Hostname: "localhost",
+		}))
+		suite.checker.meta.ResourceManager.HandleNodeUp(node)
+	}
+
+	collection := utils.CreateTestCollection(1, 1)
+	collection.Status = querypb.LoadStatus_Loaded
+	replica := utils.CreateTestReplica(1, 1, []int64{1, 2})
+	suite.checker.meta.CollectionManager.PutCollection(collection, utils.CreateTestPartition(1, 1))
+	suite.checker.meta.ReplicaManager.Put(replica)
+
+	suite.dist.SegmentDistManager.Update(1, utils.CreateTestSegment(1, 1, 1, 1, 1, "test-insert-channel"))
+	suite.dist.SegmentDistManager.Update(2, utils.CreateTestSegment(1, 1, 2, 2, 1, "test-insert-channel"))
+	suite.dist.ChannelDistManager.Update(1, utils.CreateTestChannel(1, 1, 1, "test-insert-channel"))
+	suite.NoError(suite.checker.meta.BalanceLayoutManager.PutLayout(meta.CaptureBalanceLayout(suite.checker.meta, suite.dist, 1)))
+
+	// nothing drifted
+	suite.balancer.EXPECT().BalanceReplica(mock.Anything).Return(nil, nil).Once()
+	segPlans, chanPlans := suite.checker.balanceReplicas([]int64{1})
+	suite.Empty(segPlans)
+	suite.Empty(chanPlans)
+
+	// move the drifted segment and channel back, segment 3 is not in layout
+	suite.dist.SegmentDistManager.Update(1)
+	suite.dist.SegmentDistManager.Update(2,
+		utils.CreateTestSegment(1, 1, 1, 2, 1, "test-insert-channel"),
+		utils.CreateTestSegment(1, 1, 2, 2, 1, "test-insert-channel"),
+		utils.CreateTestSegment(1, 1, 3, 2, 1, "test-insert-channel"))
+	suite.dist.ChannelDistManager.Update(1)
+	suite.dist.ChannelDistManager.Update(2, utils.CreateTestChannel(1, 2, 1, "test-insert-channel"))
+	suite.balancer.EXPECT().BalanceReplica(mock.Anything).Return(nil, nil).Once()
+	segPlans, chanPlans = suite.checker.balanceReplicas([]int64{1})
+	suite.Len(segPlans, 1)
+	suite.Equal(int64(1), segPlans[0].Segment.GetID())
+	suite.Equal(int64(2), segPlans[0].From)
+	suite.Equal(int64(1), segPlans[0].To)
+	suite.Len(chanPlans, 1)
+	suite.Equal(int64(2), chanPlans[0].From)
+	suite.Equal(int64(1), chanPlans[0].To)
+
+	// the segment not in layout is balanced freely, the balance plans of segments and channels in layout are dropped
+	suite.balancer.EXPECT().BalanceReplica(mock.Anything).Return([]balance.SegmentAssignPlan{
+		{
+			Segment: utils.CreateTestSegment(1, 1, 2, 2, 1, "test-insert-channel"),
+			Replica: replica,
+			From:    2,
+			To:      1,
+		},
+		{
+			Segment: utils.CreateTestSegment(1, 1, 3, 2, 1, "test-insert-channel"),
+			Replica: replica,
+			From:    2,
+			To:      1,
+		},
+	}, []balance.ChannelAssignPlan{
+		{
+			Channel: utils.CreateTestChannel(1, 2, 1, "test-insert-channel"),
+			Replica: replica,
+			From:    2,
+			To:      1,
+		},
+	}).Once()
+	segPlans, chanPlans = suite.checker.balanceReplicas([]int64{1})
+	suite.ElementsMatch([]int64{1, 3}, lo.Map(segPlans, func(plan balance.SegmentAssignPlan, _ int) int64 {
+		return plan.Segment.GetID()
+	}))
+	suite.Len(chanPlans, 1)
+
+	// stopping node should be drained by balancer
+	suite.nodeMgr.Stopping(1)
+	suite.balancer.EXPECT().BalanceReplica(mock.Anything).Return(nil, nil).Once()
+	segPlans, chanPlans = suite.checker.balanceReplicas([]int64{1})
+	suite.Empty(segPlans)
+	suite.Empty(chanPlans)
+
+	// balance freely after layout cleared
+	suite.checker.meta.BalanceLayoutManager.RemoveLayout(1)
+	suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   1,
+		Address:  "localhost",
+		Hostname: "localhost",
+	}))
+	suite.balancer.EXPECT().BalanceReplica(mock.Anything).Return(nil, nil).Once()
+	suite.checker.balanceReplicas([]int64{1})
+}
+
 func (suite *BalanceCheckerTestSuite) TestTargetNotReady() {
 	// set up nodes info, stopping node1
 	nodeID1, nodeID2 := int64(1), int64(2)
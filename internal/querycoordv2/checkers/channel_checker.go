@@ -40,7 +40,7 @@ type ChannelChecker struct {
 	dist      *meta.DistributionManager
 	targetMgr *meta.TargetManager
 	nodeMgr   *session.NodeManager
-	balancer  balance.Balance
+	*balancerSelector
 }
 
 func NewChannelChecker(
@@ -55,7 +55,7 @@ func NewChannelChecker(
 		meta:              meta,
 		dist:              dist,
 		targetMgr:         targetMgr,
-		balancer:          balancer,
+		balancerSelector:  newBalancerSelector(meta, balancer),
 		nodeMgr:           nodeMgr,
 	}
 }
@@ -82,12 +82,7 @@ func (c *ChannelChecker) Check(ctx context.Context) []task.Task {
 	collectionIDs := c.meta.CollectionManager.GetAll()
 	tasks := make([]task.Task, 0)
 	for _, cid := range collectionIDs {
-		if c.readyToCheck(cid) {
-			replicas := c.meta.ReplicaManager.GetByCollection(cid)
-			for _, r := range replicas {
-				tasks = append(tasks, c.checkReplica(ctx, r)...)
-			}
-		}
+		tasks = append(tasks, c.CheckCollection(ctx, cid)...)
 	}
 
 	channels := c.dist.ChannelDistManager.GetByFilter()
@@ -98,6 +93,19 @@ func (c *ChannelChecker) Check(ctx context.Context) []task.Task {
 	return tasks
 }
 
+// CheckCollection diffs the channel distribution of the collection against its target,
+// returns the tasks to reconcile them. It works even if the checker is inactive.
+func (c *ChannelChecker) CheckCollection(ctx context.Context, collectionID int64) []task.Task {
+	if !c.readyToCheck(collectionID) {
+		return nil
+	}
+	tasks := make([]task.Task, 0)
+	for _, r := range c.meta.ReplicaManager.GetByCollection(collectionID) {
+		tasks = append(tasks, c.checkReplica(ctx, r)...)
+	}
+	return tasks
+}
+
 func (c *ChannelChecker) checkReplica(ctx context.Context, replica *meta.Replica) []task.Task {
 	ret := make([]task.Task, 0)
 
@@ -217,7 +225,7 @@ func (c *ChannelChecker) findRepeatedChannels(ctx context.Context, replicaID int
 }
 
 func (c *ChannelChecker) createChannelLoadTask(ctx context.Context, channels []*meta.DmChannel, replica *meta.Replica) []task.Task {
-	plans := c.balancer.AssignChannel(channels, replica.GetNodes(), false)
+	plans := c.getBalancer(replica.GetCollectionID()).AssignChannel(channels, replica.GetNodes(), false)
 	for i := range plans {
 		plans[i].Replica = replica
 	}
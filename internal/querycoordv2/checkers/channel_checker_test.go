@@ -150,6 +150,49 @@ func (suite *ChannelCheckerTestSuite) TestLoadChannel() {
 	suite.EqualValues("test-insert-channel", action.ChannelName())
 }
 
+func (suite *ChannelCheckerTestSuite) TestLoadChannelWithBalancePolicy() {
+	checker := suite.checker
+	checker.meta.CollectionManager.PutCollection(utils.CreateTestCollection(1, 1))
+	suite.meta.CollectionManager.PutPartition(utils.CreateTestPartition(1, 1))
+	checker.meta.ReplicaManager.Put(utils.CreateTestReplica(1, 1, []int64{1, 2}))
+	for _, node := range []int64{1, 2} {
+		suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   node,
+			Address:  "localhost",
+			Hostname: "localhost",
+		}))
+		checker.meta.ResourceManager.HandleNodeUp(node)
+	}
+
+	// the channels are assigned by the balancer selected by the balance policy of the collection
+	scoreBalancer := balance.NewMockBalancer(suite.T())
+	scoreBalancer.EXPECT().AssignChannel(mock.Anything, mock.Anything, false).RunAndReturn(
+		func(channels []*meta.DmChannel, nodes []int64, _ bool) []balance.ChannelAssignPlan {
+			plans := make([]balance.ChannelAssignPlan, 0, len(channels))
+			for _, c := range channels {
+				plans = append(plans, balance.ChannelAssignPlan{Channel: c, From: -1, To: 2})
+			}
+			return plans
+		}).Once()
+	checker.RegisterBalancer(balance.ScoreBasedBalancerName, scoreBalancer)
+	suite.NoError(checker.meta.CollectionManager.SetBalancePolicy(1, balance.ScoreBasedBalancerName))
+
+	channels := []*datapb.VchannelInfo{
+		{
+			CollectionID: 1,
+			ChannelName:  "test-insert-channel",
+		},
+	}
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, int64(1)).Return(
+		channels, nil, nil)
+	checker.targetMgr.UpdateCollectionNextTarget(int64(1))
+
+	tasks := checker.Check(context.TODO())
+	suite.Len(tasks, 1)
+	suite.Len(tasks[0].Actions(), 1)
+	suite.EqualValues(2, tasks[0].Actions()[0].Node())
+}
+
 func (suite *ChannelCheckerTestSuite) TestReduceChannel() {
 	checker := suite.checker
 	checker.meta.CollectionManager.PutCollection(utils.CreateTestCollection(1, 1))
@@ -20,8 +20,13 @@ import (
 	"context"
 	"sync/atomic"
 
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/querycoordv2/balance"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/log"
 )
 
 type Checker interface {
@@ -54,3 +59,40 @@ func newCheckerActivation() *checkerActivation {
 	c.Activate()
 	return c
 }
+
+// balancerSelector selects the balancer by the balance policy of the collection,
+// the balancers are registered before the checkers start.
+type balancerSelector struct {
+	meta      *meta.Meta
+	balancer  balance.Balance
+	balancers map[string]balance.Balance
+}
+
+func newBalancerSelector(meta *meta.Meta, balancer balance.Balance) *balancerSelector {
+	return &balancerSelector{
+		meta:      meta,
+		balancer:  balancer,
+		balancers: make(map[string]balance.Balance),
+	}
+}
+
+func (s *balancerSelector) RegisterBalancer(name string, balancer balance.Balance) {
+	s.balancers[name] = balancer
+}
+
+// getBalancer returns the balancer selected by the balance policy of the collection,
+// falls back to the global one if the policy is empty or unknown.
+func (s *balancerSelector) getBalancer(collectionID int64) balance.Balance {
+	collection := s.meta.GetCollection(collectionID)
+	if collection == nil || collection.GetBalancePolicy() == "" {
+		return s.balancer
+	}
+	balancer, ok := s.balancers[collection.GetBalancePolicy()]
+	if !ok {
+		log.RatedWarn(10, "unknown balance policy, use the global balancer",
+			zap.Int64("collectionID", collectionID),
+			zap.String("balancePolicy", collection.GetBalancePolicy()))
+		return s.balancer
+	}
+	return balancer
+}
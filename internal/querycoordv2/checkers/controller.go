@@ -35,6 +35,13 @@ import (
 
 var errTypeNotFound = errors.New("checker type not found")
 
+// CheckerRunInfo is the outcome of the latest run of a checker.
+type CheckerRunInfo struct {
+	LastRunTime      time.Time
+	GeneratedTaskNum int
+	SubmittedTaskNum int
+}
+
 type CheckerController struct {
 	cancel         context.CancelFunc
 	manualCheckChs map[utils.CheckerType]chan struct{}
@@ -47,6 +54,11 @@ type CheckerController struct {
 
 	scheduler task.Scheduler
 	checkers  map[utils.CheckerType]Checker
+	// serializes the runs of each checker, the triggered runs shouldn't race with the check loop
+	checkMus map[utils.CheckerType]*sync.Mutex
+
+	runInfoMu sync.RWMutex
+	runInfos  map[utils.CheckerType]CheckerRunInfo
 
 	stopOnce sync.Once
 }
@@ -64,8 +76,8 @@ func NewCheckerController(
 	// the former checker has higher priority
 	checkers := map[utils.CheckerType]Checker{
 		utils.ChannelChecker: NewChannelChecker(meta, dist, targetMgr, balancer, nodeMgr),
-		utils.SegmentChecker: NewSegmentChecker(meta, dist, targetMgr, balancer, nodeMgr),
-		utils.BalanceChecker: NewBalanceChecker(meta, targetMgr, balancer, nodeMgr, scheduler),
+		utils.SegmentChecker: NewSegmentChecker(meta, dist, targetMgr, balancer, nodeMgr, scheduler),
+		utils.BalanceChecker: NewBalanceChecker(meta, dist, targetMgr, balancer, nodeMgr, scheduler),
 		utils.IndexChecker:   NewIndexChecker(meta, dist, broker, nodeMgr),
 		utils.LeaderChecker:  NewLeaderChecker(meta, dist, targetMgr, nodeMgr),
 	}
@@ -76,6 +88,11 @@ func NewCheckerController(
 		utils.BalanceChecker: make(chan struct{}, 1),
 	}
 
+	checkMus := make(map[utils.CheckerType]*sync.Mutex)
+	for typ := range checkers {
+		checkMus[typ] = &sync.Mutex{}
+	}
+
 	return &CheckerController{
 		manualCheckChs: manualCheckChs,
 		meta:           meta,
@@ -83,7 +100,9 @@ func NewCheckerController(
 		targetMgr:      targetMgr,
 		scheduler:      scheduler,
 		checkers:       checkers,
+		checkMus:       checkMus,
 		broker:         broker,
+		runInfos:       make(map[utils.CheckerType]CheckerRunInfo),
 	}
 }
 
@@ -155,18 +174,114 @@ func (controller *CheckerController) Check() {
 
 // check is the real implementation of Check
 func (controller *CheckerController) check(ctx context.Context, checkType utils.CheckerType) {
+	controller.checkMus[checkType].Lock()
+	defer controller.checkMus[checkType].Unlock()
+
 	checker := controller.checkers[checkType]
 	tasks := checker.Check(ctx)
 
+	submitted := 0
 	for _, task := range tasks {
 		err := controller.scheduler.Add(task)
 		if err != nil {
 			task.Cancel(err)
 			continue
 		}
+		submitted++
+	}
+	controller.recordRun(checkType, len(tasks), submitted)
+}
+
+func (controller *CheckerController) recordRun(typ utils.CheckerType, generated int, submitted int) {
+	controller.runInfoMu.Lock()
+	defer controller.runInfoMu.Unlock()
+	controller.runInfos[typ] = CheckerRunInfo{
+		LastRunTime:      time.Now(),
+		GeneratedTaskNum: generated,
+		SubmittedTaskNum: submitted,
 	}
 }
 
+// GetRunInfo returns the outcome of the latest run of the given checker, false if it has never run.
+func (controller *CheckerController) GetRunInfo(typ utils.CheckerType) (CheckerRunInfo, bool) {
+	controller.runInfoMu.RLock()
+	defer controller.runInfoMu.RUnlock()
+	info, ok := controller.runInfos[typ]
+	return info, ok
+}
+
+// GetPendingTasks returns the tasks generated by the given checker which are still pending in scheduler.
+func (controller *CheckerController) GetPendingTasks(typ utils.CheckerType) ([]task.Task, error) {
+	if _, ok := controller.checkers[typ]; !ok {
+		return nil, errTypeNotFound
+	}
+	return controller.scheduler.GetTasksBySource(typ), nil
+}
+
+// RunOnce runs one iteration of the given checker immediately, and submits the generated tasks to scheduler.
+// Only tasks of the given collection are submitted if collectionID > 0,
+// returns all submitted tasks and the tasks which failed to be submitted with the reason.
+func (controller *CheckerController) RunOnce(ctx context.Context, typ utils.CheckerType, collectionID int64) ([]task.Task, map[task.Task]error, error) {
+	checker, ok := controller.checkers[typ]
+	if !ok {
+		return nil, nil, errTypeNotFound
+	}
+	controller.checkMus[typ].Lock()
+	defer controller.checkMus[typ].Unlock()
+
+	added := make([]task.Task, 0)
+	failed := make(map[task.Task]error)
+	tasks := checker.Check(ctx)
+	for _, t := range tasks {
+		if collectionID > 0 && t.CollectionID() != collectionID {
+			t.Cancel(errors.New("filtered out by single-run checker trigger"))
+			continue
+		}
+		if err := controller.scheduler.Add(t); err != nil {
+			t.Cancel(err)
+			failed[t] = err
+			continue
+		}
+		added = append(added, t)
+	}
+	controller.recordRun(typ, len(tasks), len(added))
+	return added, failed, nil
+}
+
+// ForceSync diffs the distribution of the collection against its target with the channel and segment checkers immediately,
+// and submits the generated tasks to scheduler, returns the number of submitted tasks.
+func (controller *CheckerController) ForceSync(ctx context.Context, collectionID int64) int {
+	tasks := controller.checkers[utils.ChannelChecker].(*ChannelChecker).CheckCollection(ctx, collectionID)
+	tasks = append(tasks, controller.checkers[utils.SegmentChecker].(*SegmentChecker).CheckCollection(ctx, collectionID)...)
+	added := 0
+	for _, t := range tasks {
+		if err := controller.scheduler.Add(t); err != nil {
+			t.Cancel(err)
+			continue
+		}
+		added++
+	}
+	return added
+}
+
+// StartManualBalance stops auto balance of the collection during the manual balance,
+// returns false if the collection is being balanced manually already.
+func (controller *CheckerController) StartManualBalance(collectionID int64) bool {
+	return controller.checkers[utils.BalanceChecker].(*BalanceChecker).StartManualBalance(collectionID)
+}
+
+func (controller *CheckerController) FinishManualBalance(collectionID int64) {
+	controller.checkers[utils.BalanceChecker].(*BalanceChecker).FinishManualBalance(collectionID)
+}
+
+// RegisterBalancer makes the balancer selectable by the balance policy of collections,
+// for the checkers assigning or balancing segments and channels.
+func (controller *CheckerController) RegisterBalancer(name string, balancer balance.Balance) {
+	controller.checkers[utils.ChannelChecker].(*ChannelChecker).RegisterBalancer(name, balancer)
+	controller.checkers[utils.SegmentChecker].(*SegmentChecker).RegisterBalancer(name, balancer)
+	controller.checkers[utils.BalanceChecker].(*BalanceChecker).RegisterBalancer(name, balancer)
+}
+
 func (controller *CheckerController) Deactivate(typ utils.CheckerType) error {
 	for _, checker := range controller.checkers {
 		if checker.ID() == typ {
@@ -17,9 +17,11 @@
 package checkers
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/atomic"
@@ -162,6 +164,135 @@ func (suite *CheckerControllerSuite) TestBasic() {
 	}, 3*time.Second, 1*time.Millisecond)
 }
 
+func (suite *CheckerControllerSuite) TestRunOnce() {
+	suite.meta.CollectionManager.PutCollection(utils.CreateTestCollection(1, 1))
+	suite.meta.CollectionManager.PutPartition(utils.CreateTestPartition(1, 1))
+	suite.meta.ReplicaManager.Put(utils.CreateTestReplica(1, 1, []int64{1}))
+	suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   1,
+		Address:  "localhost",
+		Hostname: "localhost",
+	}))
+	suite.meta.ResourceManager.HandleNodeUp(1)
+
+	channels := []*datapb.VchannelInfo{
+		{
+			CollectionID: 1,
+			ChannelName:  "test-insert-channel",
+		},
+	}
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, int64(1)).Return(channels, nil, nil)
+	suite.targetManager.UpdateCollectionNextTarget(int64(1))
+
+	suite.balancer.EXPECT().AssignChannel(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(dc []*meta.DmChannel, nodes []int64, _ bool) []balance.ChannelAssignPlan {
+		plans := make([]balance.ChannelAssignPlan, 0, len(dc))
+		for _, c := range dc {
+			plans = append(plans, balance.ChannelAssignPlan{Channel: c, To: nodes[0]})
+		}
+		return plans
+	})
+
+	// never run
+	_, ok := suite.controller.GetRunInfo(utils.ChannelChecker)
+	suite.False(ok)
+
+	// unknown checker
+	_, _, err := suite.controller.RunOnce(context.Background(), utils.CheckerType(100), 0)
+	suite.ErrorIs(err, errTypeNotFound)
+
+	// tasks of other collections are filtered out
+	added, failed, err := suite.controller.RunOnce(context.Background(), utils.ChannelChecker, 2)
+	suite.NoError(err)
+	suite.Empty(added)
+	suite.Empty(failed)
+
+	suite.scheduler.EXPECT().Add(mock.Anything).Return(nil).Once()
+	added, failed, err = suite.controller.RunOnce(context.Background(), utils.ChannelChecker, 1)
+	suite.NoError(err)
+	suite.Len(added, 1)
+	suite.Empty(failed)
+	suite.Equal(int64(1), added[0].CollectionID())
+	suite.Equal("test-insert-channel", added[0].Shard())
+	info, ok := suite.controller.GetRunInfo(utils.ChannelChecker)
+	suite.True(ok)
+	suite.Equal(1, info.GeneratedTaskNum)
+	suite.Equal(1, info.SubmittedTaskNum)
+	suite.False(info.LastRunTime.IsZero())
+
+	suite.scheduler.EXPECT().GetTasksBySource(utils.ChannelChecker).Return(added).Once()
+	pending, err := suite.controller.GetPendingTasks(utils.ChannelChecker)
+	suite.NoError(err)
+	suite.Equal(added, pending)
+	_, err = suite.controller.GetPendingTasks(utils.CheckerType(100))
+	suite.ErrorIs(err, errTypeNotFound)
+
+	suite.scheduler.EXPECT().Add(mock.Anything).Return(errors.New("mock error")).Once()
+	added, failed, err = suite.controller.RunOnce(context.Background(), utils.ChannelChecker, 0)
+	suite.NoError(err)
+	suite.Empty(added)
+	suite.Len(failed, 1)
+	info, ok = suite.controller.GetRunInfo(utils.ChannelChecker)
+	suite.True(ok)
+	suite.Equal(1, info.GeneratedTaskNum)
+	suite.Zero(info.SubmittedTaskNum)
+
+	// the triggered run waits for the running check of the same checker
+	suite.controller.checkMus[utils.ChannelChecker].Lock()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		suite.controller.RunOnce(context.Background(), utils.ChannelChecker, 2)
+	}()
+	select {
+	case <-done:
+		suite.Fail("triggered run should wait for the running check")
+	case <-time.After(100 * time.Millisecond):
+	}
+	suite.controller.checkMus[utils.ChannelChecker].Unlock()
+	<-done
+}
+
+func (suite *CheckerControllerSuite) TestForceSync() {
+	suite.meta.CollectionManager.PutCollection(utils.CreateTestCollection(1, 1))
+	suite.meta.CollectionManager.PutPartition(utils.CreateTestPartition(1, 1))
+	suite.meta.ReplicaManager.Put(utils.CreateTestReplica(1, 1, []int64{1}))
+	suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   1,
+		Address:  "localhost",
+		Hostname: "localhost",
+	}))
+	suite.meta.ResourceManager.HandleNodeUp(1)
+
+	channels := []*datapb.VchannelInfo{
+		{
+			CollectionID: 1,
+			ChannelName:  "test-insert-channel",
+		},
+	}
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, int64(1)).Return(channels, nil, nil)
+	suite.targetManager.UpdateCollectionNextTarget(int64(1))
+
+	suite.balancer.EXPECT().AssignChannel(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(dc []*meta.DmChannel, nodes []int64, _ bool) []balance.ChannelAssignPlan {
+		plans := make([]balance.ChannelAssignPlan, 0, len(dc))
+		for _, c := range dc {
+			plans = append(plans, balance.ChannelAssignPlan{Channel: c, To: nodes[0]})
+		}
+		return plans
+	})
+
+	// collection not loaded
+	suite.Zero(suite.controller.ForceSync(context.Background(), 2))
+
+	// works even if the checkers are inactive
+	suite.NoError(suite.controller.Deactivate(utils.ChannelChecker))
+	suite.scheduler.EXPECT().Add(mock.Anything).Return(nil).Once()
+	suite.Equal(1, suite.controller.ForceSync(context.Background(), 1))
+
+	// the tasks failed to be submitted are not counted
+	suite.scheduler.EXPECT().Add(mock.Anything).Return(errors.New("mock error")).Once()
+	suite.Zero(suite.controller.ForceSync(context.Background(), 1))
+}
+
 func TestCheckControllerSuite(t *testing.T) {
 	suite.Run(t, new(CheckerControllerSuite))
 }
@@ -18,6 +18,7 @@ package checkers
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"time"
 
@@ -35,6 +36,8 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 const initialTargetVersion = int64(0)
@@ -44,8 +47,9 @@ type SegmentChecker struct {
 	meta      *meta.Meta
 	dist      *meta.DistributionManager
 	targetMgr *meta.TargetManager
-	balancer  balance.Balance
 	nodeMgr   *session.NodeManager
+	scheduler task.Scheduler
+	*balancerSelector
 }
 
 func NewSegmentChecker(
@@ -54,14 +58,16 @@ func NewSegmentChecker(
 	targetMgr *meta.TargetManager,
 	balancer balance.Balance,
 	nodeMgr *session.NodeManager,
+	scheduler task.Scheduler,
 ) *SegmentChecker {
 	return &SegmentChecker{
 		checkerActivation: newCheckerActivation(),
 		meta:              meta,
 		dist:              dist,
 		targetMgr:         targetMgr,
-		balancer:          balancer,
 		nodeMgr:           nodeMgr,
+		scheduler:         scheduler,
+		balancerSelector:  newBalancerSelector(meta, balancer),
 	}
 }
 
@@ -87,12 +93,7 @@ func (c *SegmentChecker) Check(ctx context.Context) []task.Task {
 	collectionIDs := c.meta.CollectionManager.GetAll()
 	results := make([]task.Task, 0)
 	for _, cid := range collectionIDs {
-		if c.readyToCheck(cid) {
-			replicas := c.meta.ReplicaManager.GetByCollection(cid)
-			for _, r := range replicas {
-				results = append(results, c.checkReplica(ctx, r)...)
-			}
-		}
+		results = append(results, c.CheckCollection(ctx, cid)...)
 	}
 
 	// find already released segments which are not contained in target
@@ -105,6 +106,20 @@ func (c *SegmentChecker) Check(ctx context.Context) []task.Task {
 	return results
 }
 
+// CheckCollection diffs the segment distribution of the collection against its target,
+// returns the tasks to reconcile them. It works even if the checker is inactive.
+func (c *SegmentChecker) CheckCollection(ctx context.Context, collectionID int64) []task.Task {
+	if !c.readyToCheck(collectionID) {
+		return nil
+	}
+	results := make([]task.Task, 0)
+	for _, r := range c.meta.ReplicaManager.GetByCollection(collectionID) {
+		results = append(results, c.checkReplica(ctx, r)...)
+	}
+	task.SetPriority(task.TaskPriorityNormal, results...)
+	return results
+}
+
 func (c *SegmentChecker) checkReplica(ctx context.Context, replica *meta.Replica) []task.Task {
 	ret := make([]task.Task, 0)
 
@@ -397,11 +412,16 @@ func (c *SegmentChecker) createSegmentLoadTasks(ctx context.Context, segments []
 	}
 
 	isLevel0 := segments[0].GetLevel() == datapb.SegmentLevel_L0
+	plans := make([]balance.SegmentAssignPlan, 0)
+	var pinnedPlans []balance.SegmentAssignPlan
+	if !isLevel0 {
+		pinnedPlans, segments = c.assignPinnedSegments(replica, segments, availableNodes)
+		plans = append(plans, pinnedPlans...)
+	}
 	shardSegments := lo.GroupBy(segments, func(s *datapb.SegmentInfo) string {
 		return s.GetInsertChannel()
 	})
 
-	plans := make([]balance.SegmentAssignPlan, 0)
 	for shard, segments := range shardSegments {
 		// if channel is not subscribed yet, skip load segments
 		leader := c.dist.LeaderViewManager.GetLatestShardLeaderByFilter(meta.WithReplica2LeaderView(replica), meta.WithChannelName2LeaderView(shard))
@@ -419,16 +439,106 @@ func (c *SegmentChecker) createSegmentLoadTasks(ctx context.Context, segments []
 				SegmentInfo: s,
 			}
 		})
-		shardPlans := c.balancer.AssignSegment(replica.GetCollectionID(), segmentInfos, availableNodes, false)
+		shardPlans := c.getBalancer(replica.GetCollectionID()).AssignSegment(replica.GetCollectionID(), segmentInfos, availableNodes, false)
 		for i := range shardPlans {
 			shardPlans[i].Replica = replica
 		}
 		plans = append(plans, shardPlans...)
+		if len(shardPlans) < len(segmentInfos) {
+			c.checkCapacity(replica, segmentInfos, shardPlans, availableNodes)
+		}
+	}
+	if len(pinnedPlans) > 0 {
+		// the pinned plans bypass the balancer, check the caps with all plans together, the pinned ones go first
+		plans = balance.FilterPlansWithCapacity(c.dist, c.scheduler, plans)
+		c.checkPinnedCapacity(replica, pinnedPlans, plans)
 	}
 
 	return balance.CreateSegmentTasksFromPlans(ctx, c.ID(), Params.QueryCoordCfg.SegmentTaskTimeout.GetAsDuration(time.Millisecond), plans)
 }
 
+// checkCapacity reports the segments skipped since the nodes reach the caps of segment number or memory size
+// as the load failure of the collection and their partitions, so the load state shows why the loading is stuck.
+func (c *SegmentChecker) checkCapacity(replica *meta.Replica, segments []*meta.Segment, plans []balance.SegmentAssignPlan, nodes []int64) {
+	assigned := typeutil.NewUniqueSet(lo.Map(plans, func(plan balance.SegmentAssignPlan, _ int) int64 {
+		return plan.Segment.GetID()
+	})...)
+	skipped := lo.Filter(segments, func(segment *meta.Segment, _ int) bool {
+		return !assigned.Contain(segment.GetID())
+	})
+	err := balance.CheckNodesCapacity(c.dist, c.scheduler, nodes, skipped)
+	if err == nil {
+		return
+	}
+	c.reportCapacityError(replica, skipped, err)
+}
+
+// checkPinnedCapacity reports the pinned segments dropped since the hinted nodes reach the caps,
+// they can't be loaded to other nodes, so they wait until the hinted nodes have room.
+func (c *SegmentChecker) checkPinnedCapacity(replica *meta.Replica, pinnedPlans []balance.SegmentAssignPlan, plans []balance.SegmentAssignPlan) {
+	assigned := typeutil.NewUniqueSet(lo.Map(plans, func(plan balance.SegmentAssignPlan, _ int) int64 {
+		return plan.Segment.GetID()
+	})...)
+	dropped := lo.FilterMap(pinnedPlans, func(plan balance.SegmentAssignPlan, _ int) (*meta.Segment, bool) {
+		return plan.Segment, !assigned.Contain(plan.Segment.GetID())
+	})
+	if len(dropped) == 0 {
+		return
+	}
+	nodes := lo.Uniq(lo.FilterMap(pinnedPlans, func(plan balance.SegmentAssignPlan, _ int) (int64, bool) {
+		return plan.To, !assigned.Contain(plan.Segment.GetID())
+	}))
+	segmentIDs := lo.Map(dropped, func(segment *meta.Segment, _ int) int64 {
+		return segment.GetID()
+	})
+	err := merr.WrapErrServiceQuotaExceeded(
+		fmt.Sprintf("pinned segments %v can't be assigned, the hinted nodes %v reach the cap of segment number or memory size", segmentIDs, nodes))
+	c.reportCapacityError(replica, dropped, err)
+}
+
+// reportCapacityError records the capacity error as the load failure of the collection and the partitions of the segments.
+func (c *SegmentChecker) reportCapacityError(replica *meta.Replica, segments []*meta.Segment, err error) {
+	log.RatedWarn(10, "segments are skipped, since the nodes are at capacity",
+		zap.Int64("collectionID", replica.GetCollectionID()),
+		zap.Int64("replicaID", replica.GetID()),
+		zap.Error(err))
+	meta.GlobalFailedLoadCache.Put(replica.GetCollectionID(), err)
+	for _, segment := range segments {
+		meta.GlobalFailedLoadCache.PutPartition(replica.GetCollectionID(), segment.GetPartitionID(), err)
+	}
+}
+
+// assignPinnedSegments assigns the segments pinned to the nodes of the replica by the load hints to the hinted nodes,
+// the pinned segments are never assigned to other nodes, so they wait if the hinted nodes are not available.
+// It returns the plans and the segments left to the balancer.
+func (c *SegmentChecker) assignPinnedSegments(replica *meta.Replica, segments []*datapb.SegmentInfo, availableNodes []int64) ([]balance.SegmentAssignPlan, []*datapb.SegmentInfo) {
+	collection := c.meta.CollectionManager.GetCollection(replica.GetCollectionID())
+	if collection == nil || len(collection.GetSegmentNodeHints()) == 0 {
+		return nil, segments
+	}
+	hints := collection.GetSegmentNodeHints()
+
+	plans := make([]balance.SegmentAssignPlan, 0)
+	unpinned := lo.Filter(segments, func(segment *datapb.SegmentInfo, _ int) bool {
+		node, ok := hints[segment.GetID()]
+		if !ok || !replica.Contains(node) {
+			return true
+		}
+		leader := c.dist.LeaderViewManager.GetLatestShardLeaderByFilter(meta.WithReplica2LeaderView(replica),
+			meta.WithChannelName2LeaderView(segment.GetInsertChannel()))
+		if leader != nil && lo.Contains(availableNodes, node) {
+			plans = append(plans, balance.SegmentAssignPlan{
+				Segment: &meta.Segment{SegmentInfo: segment},
+				Replica: replica,
+				From:    -1,
+				To:      node,
+			})
+		}
+		return false
+	})
+	return plans, unpinned
+}
+
 func (c *SegmentChecker) createSegmentReduceTasks(ctx context.Context, segments []*meta.Segment, replica *meta.Replica, scope querypb.DataScope) []task.Task {
 	ret := make([]task.Task, 0, len(segments))
 	for _, s := range segments {
@@ -21,6 +21,7 @@ import (
 	"sort"
 	"testing"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
@@ -37,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
@@ -77,7 +79,7 @@ func (suite *SegmentCheckerTestSuite) SetupTest() {
 	targetManager := meta.NewTargetManager(suite.broker, suite.meta)
 
 	balancer := suite.createMockBalancer()
-	suite.checker = NewSegmentChecker(suite.meta, distManager, targetManager, balancer, suite.nodeMgr)
+	suite.checker = NewSegmentChecker(suite.meta, distManager, targetManager, balancer, suite.nodeMgr, task.NewMockScheduler(suite.T()))
 
 	suite.broker.EXPECT().GetPartitions(mock.Anything, int64(1)).Return([]int64{1}, nil).Maybe()
 }
@@ -169,6 +171,240 @@ func (suite *SegmentCheckerTestSuite) TestLoadSegments() {
 	suite.Len(tasks, 1)
 }
 
+func (suite *SegmentCheckerTestSuite) TestLoadSegmentsWithBalancePolicy() {
+	checker := suite.checker
+	// set meta
+	checker.meta.CollectionManager.PutCollection(utils.CreateTestCollection(1, 1))
+	checker.meta.CollectionManager.PutPartition(utils.CreateTestPartition(1, 1))
+	checker.meta.ReplicaManager.Put(utils.CreateTestReplica(1, 1, []int64{1, 2}))
+	for _, node := range []int64{1, 2} {
+		suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   node,
+			Address:  "localhost",
+			Hostname: "localhost",
+		}))
+		checker.meta.ResourceManager.HandleNodeUp(node)
+	}
+
+	// the segments are assigned by the balancer selected by the balance policy of the collection
+	scoreBalancer := balance.NewMockBalancer(suite.T())
+	scoreBalancer.EXPECT().AssignSegment(int64(1), mock.Anything, mock.Anything, false).RunAndReturn(
+		func(collectionID int64, segments []*meta.Segment, nodes []int64, _ bool) []balance.SegmentAssignPlan {
+			return lo.Map(segments, func(s *meta.Segment, _ int) balance.SegmentAssignPlan {
+				return balance.SegmentAssignPlan{Segment: s, From: -1, To: 2}
+			})
+		}).Once()
+	checker.RegisterBalancer(balance.ScoreBasedBalancerName, scoreBalancer)
+	suite.NoError(checker.meta.CollectionManager.SetBalancePolicy(1, balance.ScoreBasedBalancerName))
+
+	// set target
+	segments := []*datapb.SegmentInfo{
+		{
+			ID:            1,
+			PartitionID:   1,
+			InsertChannel: "test-insert-channel",
+		},
+	}
+	channels := []*datapb.VchannelInfo{
+		{
+			CollectionID: 1,
+			ChannelName:  "test-insert-channel",
+		},
+	}
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, int64(1)).Return(
+		channels, segments, nil)
+	checker.targetMgr.UpdateCollectionNextTarget(int64(1))
+
+	// set dist
+	checker.dist.ChannelDistManager.Update(2, utils.CreateTestChannel(1, 2, 1, "test-insert-channel"))
+	checker.dist.LeaderViewManager.Update(2, utils.CreateTestLeaderView(2, 1, "test-insert-channel", map[int64]int64{}, map[int64]*meta.Segment{}))
+
+	tasks := checker.Check(context.TODO())
+	suite.Len(tasks, 1)
+	suite.Len(tasks[0].Actions(), 1)
+	suite.EqualValues(2, tasks[0].Actions()[0].Node())
+}
+
+func (suite *SegmentCheckerTestSuite) TestLoadSegmentsAtCapacity() {
+	checker := suite.checker
+	meta.GlobalFailedLoadCache = meta.NewFailedLoadCache()
+	paramtable.Get().Save(Params.QueryCoordCfg.NodeMaxSegmentNum.Key, "1")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.NodeMaxSegmentNum.Key)
+
+	// the balancer skips the segment, since all nodes are at capacity
+	balancer := balance.NewMockBalancer(suite.T())
+	balancer.EXPECT().AssignSegment(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	checker.balancerSelector = newBalancerSelector(checker.meta, balancer)
+	scheduler := task.NewMockScheduler(suite.T())
+	scheduler.EXPECT().GetNodeSegmentDelta(mock.Anything).Return(0)
+	scheduler.EXPECT().GetNodeSegmentSizeDelta(mock.Anything).Return(int64(0))
+	checker.scheduler = scheduler
+
+	// set meta
+	checker.meta.CollectionManager.PutCollection(utils.CreateTestCollection(1, 1))
+	checker.meta.CollectionManager.PutPartition(utils.CreateTestPartition(1, 1))
+	checker.meta.ReplicaManager.Put(utils.CreateTestReplica(1, 1, []int64{1, 2}))
+	for _, node := range []int64{1, 2} {
+		suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   node,
+			Address:  "localhost",
+			Hostname: "localhost",
+		}))
+		checker.meta.ResourceManager.HandleNodeUp(node)
+	}
+
+	// set target
+	segments := []*datapb.SegmentInfo{
+		{
+			ID:            1,
+			PartitionID:   1,
+			InsertChannel: "test-insert-channel",
+		},
+	}
+	channels := []*datapb.VchannelInfo{
+		{
+			CollectionID: 1,
+			ChannelName:  "test-insert-channel",
+		},
+	}
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, int64(1)).Return(
+		channels, segments, nil)
+	checker.targetMgr.UpdateCollectionNextTarget(int64(1))
+
+	// set dist, each node holds a segment of other collection
+	checker.dist.ChannelDistManager.Update(2, utils.CreateTestChannel(1, 2, 1, "test-insert-channel"))
+	checker.dist.LeaderViewManager.Update(2, utils.CreateTestLeaderView(2, 1, "test-insert-channel", map[int64]int64{}, map[int64]*meta.Segment{}))
+	checker.dist.SegmentDistManager.Update(1, utils.CreateTestSegment(2, 2, 100, 1, 1, "other-channel"))
+	checker.dist.SegmentDistManager.Update(2, utils.CreateTestSegment(2, 2, 101, 2, 1, "other-channel"))
+
+	// no segment of the collection is loaded
+	tasks := lo.Filter(checker.Check(context.TODO()), func(t task.Task, _ int) bool {
+		return t.CollectionID() == 1
+	})
+	suite.Len(tasks, 0)
+	// the capacity shortfall is reported as the load failure
+	suite.ErrorIs(meta.GlobalFailedLoadCache.Get(1), merr.ErrServiceQuotaExceeded)
+	suite.ErrorIs(meta.GlobalFailedLoadCache.GetPartition(1, 1), merr.ErrServiceQuotaExceeded)
+}
+
+func (suite *SegmentCheckerTestSuite) TestLoadPinnedSegments() {
+	checker := suite.checker
+	// set meta
+	collection := utils.CreateTestCollection(1, 1)
+	collection.SegmentNodeHints = map[int64]int64{1: 1}
+	checker.meta.CollectionManager.PutCollection(collection)
+	checker.meta.CollectionManager.PutPartition(utils.CreateTestPartition(1, 1))
+	checker.meta.ReplicaManager.Put(utils.CreateTestReplica(1, 1, []int64{1, 2}))
+	suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   1,
+		Address:  "localhost",
+		Hostname: "localhost",
+	}))
+	suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   2,
+		Address:  "localhost",
+		Hostname: "localhost",
+	}))
+	checker.meta.ResourceManager.HandleNodeUp(1)
+	checker.meta.ResourceManager.HandleNodeUp(2)
+
+	// set target
+	segments := []*datapb.SegmentInfo{
+		{
+			ID:            1,
+			PartitionID:   1,
+			InsertChannel: "test-insert-channel",
+		},
+	}
+
+	channels := []*datapb.VchannelInfo{
+		{
+			CollectionID: 1,
+			ChannelName:  "test-insert-channel",
+		},
+	}
+
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, int64(1)).Return(
+		channels, segments, nil)
+	checker.targetMgr.UpdateCollectionNextTarget(int64(1))
+
+	// set dist
+	checker.dist.ChannelDistManager.Update(2, utils.CreateTestChannel(1, 2, 1, "test-insert-channel"))
+	checker.dist.LeaderViewManager.Update(2, utils.CreateTestLeaderView(2, 1, "test-insert-channel", map[int64]int64{}, map[int64]*meta.Segment{}))
+
+	// the pinned segment goes to the hinted node
+	tasks := checker.Check(context.TODO())
+	suite.Len(tasks, 1)
+	suite.Len(tasks[0].Actions(), 1)
+	action, ok := tasks[0].Actions()[0].(*task.SegmentAction)
+	suite.True(ok)
+	suite.Equal(task.ActionTypeGrow, action.Type())
+	suite.EqualValues(1, action.SegmentID())
+	suite.EqualValues(1, action.Node())
+
+	// the pinned segment waits for the hinted node
+	suite.nodeMgr.Stopping(1)
+	tasks = checker.Check(context.TODO())
+	suite.Len(tasks, 0)
+}
+
+func (suite *SegmentCheckerTestSuite) TestLoadPinnedSegmentsAtCapacity() {
+	checker := suite.checker
+	meta.GlobalFailedLoadCache = meta.NewFailedLoadCache()
+	paramtable.Get().Save(Params.QueryCoordCfg.NodeMaxSegmentNum.Key, "1")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.NodeMaxSegmentNum.Key)
+	scheduler := task.NewMockScheduler(suite.T())
+	scheduler.EXPECT().GetNodeSegmentDelta(mock.Anything).Return(0)
+	scheduler.EXPECT().GetNodeSegmentSizeDelta(mock.Anything).Return(int64(0))
+	checker.scheduler = scheduler
+
+	// set meta
+	collection := utils.CreateTestCollection(1, 1)
+	collection.SegmentNodeHints = map[int64]int64{1: 1}
+	checker.meta.CollectionManager.PutCollection(collection)
+	checker.meta.CollectionManager.PutPartition(utils.CreateTestPartition(1, 1))
+	checker.meta.ReplicaManager.Put(utils.CreateTestReplica(1, 1, []int64{1, 2}))
+	for _, node := range []int64{1, 2} {
+		suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   node,
+			Address:  "localhost",
+			Hostname: "localhost",
+		}))
+		checker.meta.ResourceManager.HandleNodeUp(node)
+	}
+
+	// set target
+	segments := []*datapb.SegmentInfo{
+		{
+			ID:            1,
+			PartitionID:   1,
+			InsertChannel: "test-insert-channel",
+		},
+	}
+	channels := []*datapb.VchannelInfo{
+		{
+			CollectionID: 1,
+			ChannelName:  "test-insert-channel",
+		},
+	}
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, int64(1)).Return(
+		channels, segments, nil)
+	checker.targetMgr.UpdateCollectionNextTarget(int64(1))
+
+	// set dist, the hinted node holds a segment of other collection
+	checker.dist.ChannelDistManager.Update(2, utils.CreateTestChannel(1, 2, 1, "test-insert-channel"))
+	checker.dist.LeaderViewManager.Update(2, utils.CreateTestLeaderView(2, 1, "test-insert-channel", map[int64]int64{}, map[int64]*meta.Segment{}))
+	checker.dist.SegmentDistManager.Update(1, utils.CreateTestSegment(2, 2, 100, 1, 1, "other-channel"))
+
+	// the pinned segment isn't loaded to the hinted node, nor to other nodes with room
+	tasks := lo.Filter(checker.Check(context.TODO()), func(t task.Task, _ int) bool {
+		return t.CollectionID() == 1
+	})
+	suite.Len(tasks, 0)
+	suite.ErrorIs(meta.GlobalFailedLoadCache.Get(1), merr.ErrServiceQuotaExceeded)
+	suite.ErrorIs(meta.GlobalFailedLoadCache.GetPartition(1, 1), merr.ErrServiceQuotaExceeded)
+}
+
 func (suite *SegmentCheckerTestSuite) TestLoadL0Segments() {
 	checker := suite.checker
 	// set meta
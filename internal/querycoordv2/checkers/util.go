@@ -27,6 +27,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
 func CheckNodeAvailable(nodeID int64, info *session.NodeInfo) error {
@@ -36,12 +37,42 @@ func CheckNodeAvailable(nodeID int64, info *session.NodeInfo) error {
 	return nil
 }
 
+// LeaderAvailabilityTolerance is the number or ratio of target segments
+// a shard leader may miss while still regarded as available, the zero value is the strictest.
+type LeaderAvailabilityTolerance struct {
+	MaxMissingSegmentNum   int
+	MaxMissingSegmentRatio float64
+}
+
+// NewLeaderAvailabilityTolerance returns the tolerance configured for routing queries.
+func NewLeaderAvailabilityTolerance() LeaderAvailabilityTolerance {
+	return LeaderAvailabilityTolerance{
+		MaxMissingSegmentNum:   paramtable.Get().QueryCoordCfg.LeaderMaxMissingSegmentNum.GetAsInt(),
+		MaxMissingSegmentRatio: paramtable.Get().QueryCoordCfg.LeaderMaxMissingSegmentRatio.GetAsFloat(),
+	}
+}
+
+func (t LeaderAvailabilityTolerance) tolerate(missing, total int) bool {
+	if missing == 0 {
+		return true
+	}
+	return missing <= t.MaxMissingSegmentNum || float64(missing)/float64(total) <= t.MaxMissingSegmentRatio
+}
+
 // In a replica, a shard is available, if and only if:
 // 1. The leader is online
 // 2. All QueryNodes in the distribution are online
 // 3. The last heartbeat response time is within HeartbeatAvailableInterval for all QueryNodes(include leader) in the distribution
 // 4. All segments of the shard in target should be in the distribution
 func CheckLeaderAvailable(nodeMgr *session.NodeManager, leader *meta.LeaderView, currentTargets map[int64]*datapb.SegmentInfo) error {
+	return CheckLeaderAvailableWithTolerance(nodeMgr, leader, currentTargets, LeaderAvailabilityTolerance{})
+}
+
+// CheckLeaderAvailableWithTolerance is the same as CheckLeaderAvailable,
+// except that the segments of the shard in target may be missing in the distribution within the tolerance.
+func CheckLeaderAvailableWithTolerance(nodeMgr *session.NodeManager, leader *meta.LeaderView,
+	currentTargets map[int64]*datapb.SegmentInfo, tolerance LeaderAvailabilityTolerance,
+) error {
 	log := log.Ctx(context.TODO()).
 		WithRateGroup("checkers.CheckLeaderAvailable", 1, 60).
 		With(zap.Int64("leaderID", leader.ID))
@@ -66,16 +97,22 @@ func CheckLeaderAvailable(nodeMgr *session.NodeManager, leader *meta.LeaderView,
 	}
 
 	// Check whether segments are fully loaded
+	missing := make([]int64, 0)
+	total := 0
 	for segmentID, info := range currentTargets {
 		if info.GetInsertChannel() != leader.Channel {
 			continue
 		}
 
+		total++
 		_, exist := leader.Segments[segmentID]
 		if !exist {
-			log.RatedInfo(10, "leader is not available due to lack of segment", zap.Int64("segmentID", segmentID))
-			return merr.WrapErrSegmentLack(segmentID)
+			missing = append(missing, segmentID)
 		}
 	}
+	if !tolerance.tolerate(len(missing), total) {
+		log.RatedInfo(10, "leader is not available due to lack of segment", zap.Int64s("segmentIDs", missing))
+		return merr.WrapErrSegmentLack(missing[0])
+	}
 	return nil
 }
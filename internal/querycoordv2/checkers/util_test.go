@@ -26,6 +26,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 type UtilTestSuite struct {
@@ -66,6 +67,43 @@ func (suite *UtilTestSuite) TestCheckLeaderAvaliable() {
 	suite.NoError(err)
 }
 
+func (suite *UtilTestSuite) TestCheckLeaderAvaliableWithTolerance() {
+	leadview := &meta.LeaderView{
+		ID:       1,
+		Channel:  "test",
+		Segments: map[int64]*querypb.SegmentDist{2: {NodeID: 2}, 3: {NodeID: 2}},
+	}
+	targets := map[int64]*datapb.SegmentInfo{
+		1: {ID: 1, InsertChannel: "test"},
+		2: {ID: 2, InsertChannel: "test"},
+		3: {ID: 3, InsertChannel: "test"},
+		4: {ID: 4, InsertChannel: "test"},
+	}
+	suite.setNodeAvailable(1, 2)
+
+	// strict by default
+	err := CheckLeaderAvailableWithTolerance(suite.nodeMgr, leadview, targets, LeaderAvailabilityTolerance{})
+	suite.ErrorIs(err, merr.ErrSegmentLack)
+
+	// tolerate by number
+	err = CheckLeaderAvailableWithTolerance(suite.nodeMgr, leadview, targets, LeaderAvailabilityTolerance{MaxMissingSegmentNum: 1})
+	suite.ErrorIs(err, merr.ErrSegmentLack)
+	err = CheckLeaderAvailableWithTolerance(suite.nodeMgr, leadview, targets, LeaderAvailabilityTolerance{MaxMissingSegmentNum: 2})
+	suite.NoError(err)
+
+	// tolerate by ratio
+	err = CheckLeaderAvailableWithTolerance(suite.nodeMgr, leadview, targets, LeaderAvailabilityTolerance{MaxMissingSegmentRatio: 0.25})
+	suite.ErrorIs(err, merr.ErrSegmentLack)
+	err = CheckLeaderAvailableWithTolerance(suite.nodeMgr, leadview, targets, LeaderAvailabilityTolerance{MaxMissingSegmentRatio: 0.5})
+	suite.NoError(err)
+
+	// offline worker is never tolerated
+	suite.nodeMgr.Remove(2)
+	err = CheckLeaderAvailableWithTolerance(suite.nodeMgr, leadview, targets, LeaderAvailabilityTolerance{MaxMissingSegmentNum: 4})
+	suite.Error(err)
+	suite.nodeMgr = session.NewNodeManager()
+}
+
 func (suite *UtilTestSuite) TestCheckLeaderAvaliableFailed() {
 	suite.Run("leader not available", func() {
 		leadview := &meta.LeaderView{
@@ -26,12 +26,14 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 type Controller interface {
 	StartDistInstance(ctx context.Context, nodeID int64)
 	Remove(nodeID int64)
 	SyncAll(ctx context.Context)
+	SyncNode(ctx context.Context, nodeID int64) error
 	Stop()
 }
 
@@ -85,6 +87,31 @@ func (dc *ControllerImpl) SyncAll(ctx context.Context) {
 	wg.Wait()
 }
 
+// SyncNode pulls the data distribution from the given node and applies it to dist meta synchronously.
+func (dc *ControllerImpl) SyncNode(ctx context.Context, nodeID int64) error {
+	dc.mu.RLock()
+	handler, ok := dc.handlers[nodeID]
+	dc.mu.RUnlock()
+	if !ok {
+		return merr.WrapErrNodeNotFound(nodeID, "dist handler not started")
+	}
+
+	// don't hold the lock while pulling, a slow node shouldn't block starting or removing the other handlers
+	resp, err := handler.getDistribution(ctx)
+	if err != nil {
+		return err
+	}
+
+	dc.mu.RLock()
+	defer dc.mu.RUnlock()
+	// the handler removed during pulling has cleared the dist of the node, don't bring it back
+	if dc.handlers[nodeID] != handler {
+		return merr.WrapErrNodeNotFound(nodeID, "dist handler removed during sync")
+	}
+	handler.handleDistResp(resp)
+	return nil
+}
+
 func (dc *ControllerImpl) Stop() {
 	dc.mu.Lock()
 	defer dc.mu.Unlock()
@@ -167,6 +167,127 @@ func (suite *DistControllerTestSuite) TestSyncAll() {
 	)
 }
 
+func (suite *DistControllerTestSuite) TestSyncNode() {
+	suite.controller.StartDistInstance(context.TODO(), 1)
+
+	suite.mockCluster.EXPECT().GetDataDistribution(mock.Anything, int64(1), mock.Anything).Return(
+		&querypb.GetDataDistributionResponse{
+			Status: merr.Success(),
+			NodeID: 1,
+			Segments: []*querypb.SegmentVersionInfo{
+				{ID: 100, Collection: 10, Channel: "dmc0"},
+			},
+		},
+		nil,
+	)
+	suite.mockScheduler.EXPECT().Dispatch(int64(1))
+
+	// stop inner loop
+	suite.controller.handlers[1].stop()
+
+	err := suite.controller.SyncNode(context.TODO(), 1)
+	suite.NoError(err)
+	suite.Len(suite.controller.dist.SegmentDistManager.GetByFilter(meta.WithNodeID(1)), 1)
+
+	// node without dist handler
+	err = suite.controller.SyncNode(context.TODO(), 2)
+	suite.ErrorIs(err, merr.ErrNodeNotFound)
+}
+
+func (suite *DistControllerTestSuite) TestDropForceReleased() {
+	suite.controller.StartDistInstance(context.TODO(), 1)
+
+	// the node stuck in releasing collection 10 keeps reporting it after the forced release
+	suite.mockCluster.EXPECT().GetDataDistribution(mock.Anything, int64(1), mock.Anything).Return(
+		&querypb.GetDataDistributionResponse{
+			Status: merr.Success(),
+			NodeID: 1,
+			Segments: []*querypb.SegmentVersionInfo{
+				{ID: 100, Collection: 10, Channel: "dmc0"},
+				{ID: 101, Collection: 11, Channel: "dmc1"},
+			},
+			Channels: []*querypb.ChannelVersionInfo{
+				{Channel: "dmc0", Collection: 10},
+				{Channel: "dmc1", Collection: 11},
+			},
+			LeaderViews: []*querypb.LeaderView{
+				{Collection: 10, Channel: "dmc0"},
+				{Collection: 11, Channel: "dmc1"},
+			},
+		},
+		nil,
+	)
+	suite.mockScheduler.EXPECT().Dispatch(int64(1))
+
+	// stop inner loop
+	suite.controller.handlers[1].stop()
+
+	dist := suite.controller.dist
+	dist.MarkForceReleased(10)
+	suite.NoError(suite.controller.SyncNode(context.TODO(), 1))
+	suite.Empty(dist.SegmentDistManager.GetByFilter(meta.WithCollectionID(10)))
+	suite.Empty(dist.ChannelDistManager.GetByCollectionAndFilter(10))
+	suite.Empty(dist.LeaderViewManager.GetByFilter(meta.WithCollectionID2LeaderView(10)))
+	suite.Len(dist.SegmentDistManager.GetByFilter(meta.WithCollectionID(11)), 1)
+	suite.Len(dist.ChannelDistManager.GetByCollectionAndFilter(11), 1)
+	suite.Len(dist.LeaderViewManager.GetByFilter(meta.WithCollectionID2LeaderView(11)), 1)
+
+	// the collection loaded again is reported as usual
+	dist.UnmarkForceReleased(10)
+	suite.NoError(suite.controller.SyncNode(context.TODO(), 1))
+	suite.Len(dist.SegmentDistManager.GetByFilter(meta.WithCollectionID(10)), 1)
+	suite.Len(dist.ChannelDistManager.GetByCollectionAndFilter(10), 1)
+}
+
+func (suite *DistControllerTestSuite) TestSyncNodeNotBlockRemove() {
+	suite.controller.StartDistInstance(context.TODO(), 1)
+
+	pulling := make(chan struct{})
+	block := make(chan struct{})
+	suite.mockCluster.EXPECT().GetDataDistribution(mock.Anything, int64(1), mock.Anything).Return(
+		&querypb.GetDataDistributionResponse{
+			Status: merr.Success(),
+			NodeID: 1,
+			Segments: []*querypb.SegmentVersionInfo{
+				{ID: 100, Collection: 10, Channel: "dmc0"},
+			},
+		},
+		nil,
+	).Run(func(ctx context.Context, nodeID int64, req *querypb.GetDataDistributionRequest) {
+		close(pulling)
+		<-block
+	}).Once()
+
+	// stop inner loop
+	suite.controller.handlers[1].stop()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- suite.controller.SyncNode(context.TODO(), 1)
+	}()
+	<-pulling
+
+	// the slow node doesn't block removing its handler
+	removed := make(chan struct{})
+	go func() {
+		suite.controller.Remove(1)
+		close(removed)
+	}()
+	suite.Eventually(func() bool {
+		select {
+		case <-removed:
+			return true
+		default:
+			return false
+		}
+	}, 5*time.Second, 100*time.Millisecond)
+
+	// the dist pulled from the removed node is dropped
+	close(block)
+	suite.ErrorIs(<-errCh, merr.ErrNodeNotFound)
+	suite.Empty(suite.controller.dist.SegmentDistManager.GetByFilter(meta.WithNodeID(1)))
+}
+
 func TestDistControllerSuite(t *testing.T) {
 	suite.Run(t, new(DistControllerTestSuite))
 }
@@ -108,6 +108,10 @@ func (dh *distHandler) handleDistResp(resp *querypb.GetDataDistributionResponse)
 func (dh *distHandler) updateSegmentsDistribution(resp *querypb.GetDataDistributionResponse) {
 	updates := make([]*meta.Segment, 0, len(resp.GetSegments()))
 	for _, s := range resp.GetSegments() {
+		// the node stuck in releasing keeps reporting the collection released forcibly, don't bring it back
+		if dh.dist.IsForceReleased(s.GetCollection()) {
+			continue
+		}
 		// for collection which is already loaded
 		segmentInfo := dh.target.GetSealedSegment(s.GetCollection(), s.GetID(), meta.CurrentTarget)
 		if segmentInfo == nil {
@@ -141,11 +145,15 @@ func (dh *distHandler) updateSegmentsDistribution(resp *querypb.GetDataDistribut
 	}
 
 	dh.dist.SegmentDistManager.Update(resp.GetNodeID(), updates...)
+	dh.dist.SegmentDistManager.UpdateLoading(resp.GetNodeID(), resp.GetLoadingSegments()...)
 }
 
 func (dh *distHandler) updateChannelsDistribution(resp *querypb.GetDataDistributionResponse) {
 	updates := make([]*meta.DmChannel, 0, len(resp.GetChannels()))
 	for _, ch := range resp.GetChannels() {
+		if dh.dist.IsForceReleased(ch.GetCollection()) {
+			continue
+		}
 		channelInfo := dh.target.GetDmChannel(ch.GetCollection(), ch.GetChannel(), meta.CurrentTarget)
 		var channel *meta.DmChannel
 		if channelInfo == nil {
@@ -169,6 +177,9 @@ func (dh *distHandler) updateChannelsDistribution(resp *querypb.GetDataDistribut
 func (dh *distHandler) updateLeaderView(resp *querypb.GetDataDistributionResponse) {
 	updates := make([]*meta.LeaderView, 0, len(resp.GetLeaderViews()))
 	for _, lview := range resp.GetLeaderViews() {
+		if dh.dist.IsForceReleased(lview.GetCollection()) {
+			continue
+		}
 		segments := make(map[int64]*meta.Segment)
 
 		for ID, position := range lview.GrowingSegments {
@@ -246,6 +257,7 @@ func (dh *distHandler) stop() {
 		// clear dist
 		dh.dist.ChannelDistManager.Update(dh.nodeID)
 		dh.dist.SegmentDistManager.Update(dh.nodeID)
+		dh.dist.SegmentDistManager.UpdateLoading(dh.nodeID)
 	})
 }
 
@@ -153,6 +153,49 @@ func (_c *MockController_SyncAll_Call) RunAndReturn(run func(context.Context)) *
 	return _c
 }
 
+// SyncNode provides a mock function with given fields: ctx, nodeID
+func (_m *MockController) SyncNode(ctx context.Context, nodeID int64) error {
+	ret := _m.Called(ctx, nodeID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, nodeID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockController_SyncNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncNode'
+type MockController_SyncNode_Call struct {
+	*mock.Call
+}
+
+// SyncNode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nodeID int64
+func (_e *MockController_Expecter) SyncNode(ctx interface{}, nodeID interface{}) *MockController_SyncNode_Call {
+	return &MockController_SyncNode_Call{Call: _e.mock.On("SyncNode", ctx, nodeID)}
+}
+
+func (_c *MockController_SyncNode_Call) Run(run func(ctx context.Context, nodeID int64)) *MockController_SyncNode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockController_SyncNode_Call) Return(_a0 error) *MockController_SyncNode_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockController_SyncNode_Call) RunAndReturn(run func(context.Context, int64) error) *MockController_SyncNode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockController creates a new instance of MockController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockController(t interface {
@@ -18,22 +18,33 @@ package querycoordv2
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
+	"go.uber.org/atomic"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/balance"
+	"github.com/milvus-io/milvus/internal/querycoordv2/checkers"
+	"github.com/milvus-io/milvus/internal/querycoordv2/job"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/hardware"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -82,26 +93,104 @@ func (s *Server) getCollectionSegmentInfo(collection int64) []*querypb.SegmentIn
 		}
 		utils.MergeMetaSegmentIntoSegmentInfo(info, segment)
 	}
+	// the segments in target but not loaded yet
+	for segmentID := range currentTargetSegmentsMap {
+		if _, ok := infos[segmentID]; ok {
+			continue
+		}
+		if info := s.getLoadingSegmentInfo(collection, segmentID); info != nil {
+			infos[segmentID] = info
+		}
+	}
 
 	return lo.Values(infos)
 }
 
+// getChannelSegmentInfo returns the sealed segments of the channel in distribution, sorted by segment ID
+func (s *Server) getChannelSegmentInfo(collection int64, channel string) []*querypb.SegmentInfo {
+	filters := []meta.SegmentDistFilter{meta.WithChannel(channel)}
+	if collection > 0 {
+		filters = append(filters, meta.WithCollectionID(collection))
+	}
+	segments := lo.GroupBy(s.dist.SegmentDistManager.GetByFilter(filters...), func(segment *meta.Segment) int64 {
+		return segment.GetID()
+	})
+
+	infos := make([]*querypb.SegmentInfo, 0, len(segments))
+	for _, replicas := range segments {
+		info := &querypb.SegmentInfo{}
+		utils.MergeMetaSegmentIntoSegmentInfo(info, replicas...)
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].GetSegmentID() < infos[j].GetSegmentID()
+	})
+	return infos
+}
+
+// getLoadingSegmentInfo returns the info of the segment not in distribution,
+// with the load percentage computed from the loaded bytes reported by query nodes,
+// returns nil if the segment is neither in target nor being loaded
+func (s *Server) getLoadingSegmentInfo(collection int64, segmentID int64) *querypb.SegmentInfo {
+	progresses := s.dist.SegmentDistManager.GetLoadingProgress(segmentID)
+	segment := s.targetMgr.GetSealedSegment(collection, segmentID, meta.NextTargetFirst)
+	if segment == nil && len(progresses) == 0 {
+		return nil
+	}
+
+	info := &querypb.SegmentInfo{
+		NodeID:       paramtable.GetNodeID(),
+		SegmentID:    segmentID,
+		NodeIds:      make([]int64, 0),
+		SegmentState: commonpb.SegmentState_Sealed,
+		IndexInfos:   make([]*querypb.FieldIndexInfo, 0),
+	}
+	if segment != nil {
+		info.CollectionID = segment.GetCollectionID()
+		info.PartitionID = segment.GetPartitionID()
+		info.NumRows = segment.GetNumOfRows()
+		info.DmChannel = segment.GetInsertChannel()
+	} else {
+		info.CollectionID = progresses[0].GetCollection()
+		info.PartitionID = progresses[0].GetPartition()
+		info.DmChannel = progresses[0].GetChannel()
+	}
+	// report the most progressed one if loading on multiple nodes
+	for _, progress := range progresses {
+		if progress.GetTotalBytes() <= 0 {
+			continue
+		}
+		percentage := progress.GetLoadedBytes() * 100 / progress.GetTotalBytes()
+		if percentage > 100 {
+			percentage = 100
+		}
+		if percentage > info.LoadPercentage {
+			info.LoadPercentage = percentage
+		}
+	}
+	return info
+}
+
 // generate balance segment task and submit to scheduler
 // if sync is true, this func call will wait task to finish, until reach the segment task timeout
 // if copyMode is true, this func call will generate a load segment task, instead a balance segment task
 func (s *Server) balanceSegments(ctx context.Context,
 	collectionID int64,
 	replica *meta.Replica,
-	srcNode int64,
 	dstNodes []int64,
 	segments []*meta.Segment,
 	sync bool,
 	copyMode bool,
-) error {
-	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID), zap.Int64("srcNode", srcNode))
-	plans := s.balancer.AssignSegment(collectionID, segments, dstNodes, true)
+) (int64, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID))
+	plans, err := s.assignBalanceSegments(collectionID, segments, dstNodes)
+	if err != nil {
+		log.Warn("failed to assign segments to balance", zap.Error(err))
+		return 0, err
+	}
 	for i := range plans {
-		plans[i].From = srcNode
+		// the segments may come from multiple source nodes
+		plans[i].From = plans[i].Segment.Node
 		plans[i].Replica = replica
 	}
 	tasks := make([]task.Task, 0, len(plans))
@@ -144,20 +233,165 @@ func (s *Server) balanceSegments(ctx context.Context,
 		err = s.taskScheduler.Add(task)
 		if err != nil {
 			task.Cancel(err)
-			return err
+			return 0, err
 		}
 		tasks = append(tasks, task)
 	}
+	if len(tasks) == 0 {
+		return 0, nil
+	}
+	operationID := s.taskScheduler.TrackBalance(tasks)
+	log.Info("balance operation started", zap.Int64("operationID", operationID), zap.Int("taskNum", len(tasks)))
 
 	if sync {
 		err := task.Wait(ctx, Params.QueryCoordCfg.SegmentTaskTimeout.GetAsDuration(time.Millisecond), tasks...)
 		if err != nil {
 			msg := "failed to wait all balance task finished"
 			log.Warn(msg, zap.Error(err))
-			return errors.Wrap(err, msg)
+			return operationID, errors.Wrap(err, msg)
+		}
+	}
+
+	return operationID, nil
+}
+
+// assignBalanceSegments assigns the segments to balance to the destination nodes,
+// it fails rather than moving part of the segments if some destination nodes reach the caps.
+func (s *Server) assignBalanceSegments(collectionID int64, segments []*meta.Segment, dstNodes []int64) ([]balance.SegmentAssignPlan, error) {
+	plans := s.balancer.AssignSegment(collectionID, segments, dstNodes, true)
+	if len(plans) < len(segments) {
+		return nil, merr.WrapErrServiceQuotaExceeded(
+			fmt.Sprintf("only %d of %d segments can be assigned, destination nodes %v reach the cap of segment number or memory size",
+				len(plans), len(segments), dstNodes))
+	}
+	return plans, nil
+}
+
+// planCollectionBalance generates balance plans for all replicas of the collection,
+// plans moving to stopping nodes are dropped.
+func (s *Server) planCollectionBalance(collectionID int64) ([]balance.SegmentAssignPlan, []balance.ChannelAssignPlan) {
+	segmentPlans, channelPlans := make([]balance.SegmentAssignPlan, 0), make([]balance.ChannelAssignPlan, 0)
+	for _, replica := range s.meta.ReplicaManager.GetByCollection(collectionID) {
+		sPlans, cPlans := s.balancer.BalanceReplica(replica)
+		segmentPlans = append(segmentPlans, lo.Filter(sPlans, func(plan balance.SegmentAssignPlan, _ int) bool {
+			return s.isStoppingNode(plan.To) == nil
+		})...)
+		channelPlans = append(channelPlans, lo.Filter(cPlans, func(plan balance.ChannelAssignPlan, _ int) bool {
+			return s.isStoppingNode(plan.To) == nil
+		})...)
+	}
+	sort.Slice(segmentPlans, func(i, j int) bool {
+		return segmentPlans[i].Segment.GetID() < segmentPlans[j].Segment.GetID()
+	})
+	sort.Slice(channelPlans, func(i, j int) bool {
+		return channelPlans[i].Channel.GetChannelName() < channelPlans[j].Channel.GetChannelName()
+	})
+	return segmentPlans, channelPlans
+}
+
+// balanceResourceGroups balances the loaded collections having replicas in the given resource groups in background,
+// to make use of the transferred nodes without waiting for auto balance. Nothing is done if balance is suspended,
+// and the collections excluded from auto balance or being balanced are skipped.
+func (s *Server) balanceResourceGroups(rgNames ...string) {
+	if active, err := s.checkerController.IsActive(utils.BalanceChecker); err != nil || !active {
+		log.Info("skip balancing resource groups since balance is suspended", zap.Strings("resourceGroups", rgNames))
+		return
+	}
+
+	collections := typeutil.NewUniqueSet()
+	for _, rgName := range rgNames {
+		for _, replica := range s.meta.ReplicaManager.GetByResourceGroup(rgName) {
+			collections.Insert(replica.GetCollectionID())
+		}
+	}
+	for _, collectionID := range collections.Collect() {
+		collection := s.meta.CollectionManager.GetCollection(collectionID)
+		if collection == nil || collection.GetBalanceExcluded() ||
+			s.meta.CollectionManager.CalculateLoadPercentage(collectionID) < 100 {
+			continue
+		}
+		if !s.checkerController.StartManualBalance(collectionID) {
+			continue
+		}
+		segmentPlans, channelPlans := s.planCollectionBalance(collectionID)
+		go func(collectionID int64) {
+			defer s.checkerController.FinishManualBalance(collectionID)
+			if err := s.executeBalancePlans(s.ctx, segmentPlans, channelPlans); err != nil {
+				log.Warn("failed to balance collection after transferring nodes", zap.Int64("collectionID", collectionID), zap.Error(err))
+			}
+		}(collectionID)
+	}
+}
+
+// executeBalancePlans submits tasks for the balance plans and waits them finished,
+// channels are moved after all segments moved.
+func (s *Server) executeBalancePlans(ctx context.Context, segmentPlans []balance.SegmentAssignPlan, channelPlans []balance.ChannelAssignPlan) error {
+	segmentTaskTimeout := Params.QueryCoordCfg.SegmentTaskTimeout.GetAsDuration(time.Millisecond)
+	segmentTasks := balance.CreateSegmentTasksFromPlans(s.ctx, utils.ManualBalance, segmentTaskTimeout, segmentPlans)
+	task.SetReason("manual balance", segmentTasks...)
+	if err := s.submitTasks(segmentTasks); err != nil {
+		return err
+	}
+	if err := task.Wait(ctx, segmentTaskTimeout, segmentTasks...); err != nil {
+		return errors.Wrap(err, "failed to wait all segment balance tasks finished")
+	}
+
+	channelTaskTimeout := Params.QueryCoordCfg.ChannelTaskTimeout.GetAsDuration(time.Millisecond)
+	channelTasks := balance.CreateChannelTasksFromPlans(s.ctx, utils.ManualBalance, channelTaskTimeout, channelPlans)
+	task.SetReason("manual balance", channelTasks...)
+	if err := s.submitTasks(channelTasks); err != nil {
+		return err
+	}
+	if err := task.Wait(ctx, channelTaskTimeout, channelTasks...); err != nil {
+		return errors.Wrap(err, "failed to wait all channel balance tasks finished")
+	}
+	return nil
+}
+
+func (s *Server) submitTasks(tasks []task.Task) error {
+	for _, t := range tasks {
+		if err := s.taskScheduler.Add(t); err != nil {
+			t.Cancel(err)
+			return err
 		}
 	}
+	return nil
+}
 
+// releaseSegments generates release segment tasks for the segments on the nodes hosting them,
+// and submits them to scheduler without waiting.
+func (s *Server) releaseSegments(ctx context.Context, collectionID int64, segments []*meta.Segment) error {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID))
+	for _, segment := range segments {
+		replica := s.meta.ReplicaManager.GetByCollectionAndNode(collectionID, segment.Node)
+		if replica == nil {
+			log.Warn("node doesn't belong to any replica, skip releasing segment",
+				zap.Int64("node", segment.Node),
+				zap.Int64("segmentID", segment.GetID()))
+			continue
+		}
+		log.Info("manually release segment...",
+			zap.Int64("replica", replica.GetID()),
+			zap.String("channel", segment.GetInsertChannel()),
+			zap.Int64("node", segment.Node),
+			zap.Int64("segmentID", segment.GetID()),
+		)
+		task, err := task.NewSegmentTask(s.ctx,
+			Params.QueryCoordCfg.SegmentTaskTimeout.GetAsDuration(time.Millisecond),
+			utils.ManualRelease,
+			collectionID,
+			replica,
+			task.NewSegmentActionWithScope(segment.Node, task.ActionTypeReduce, segment.GetInsertChannel(), segment.GetID(), querypb.DataScope_Historical),
+		)
+		if err != nil {
+			return err
+		}
+		task.SetReason("manual release")
+		if err := s.taskScheduler.Add(task); err != nil {
+			task.Cancel(err)
+			return err
+		}
+	}
 	return nil
 }
 
@@ -167,7 +401,6 @@ func (s *Server) balanceSegments(ctx context.Context,
 func (s *Server) balanceChannels(ctx context.Context,
 	collectionID int64,
 	replica *meta.Replica,
-	srcNode int64,
 	dstNodes []int64,
 	channels []*meta.DmChannel,
 	sync bool,
@@ -177,7 +410,7 @@ func (s *Server) balanceChannels(ctx context.Context,
 
 	plans := s.balancer.AssignChannel(channels, dstNodes, true)
 	for i := range plans {
-		plans[i].From = srcNode
+		plans[i].From = plans[i].Channel.Node
 		plans[i].Replica = replica
 	}
 
@@ -261,6 +494,7 @@ func (s *Server) getSystemInfoMetrics(
 				ID:          paramtable.GetNodeID(),
 			},
 			SystemConfigurations: metricsinfo.QueryCoordConfiguration{},
+			TargetLag:            s.getTargetLagMetrics(),
 		},
 		ConnectedNodes: make([]metricsinfo.QueryNodeInfos, 0),
 	}
@@ -285,6 +519,101 @@ func (s *Server) getSystemInfoMetrics(
 	return resp, nil
 }
 
+// getTargetLagMetrics returns how far behind current target is from next target for every loaded collection.
+func (s *Server) getTargetLagMetrics() *metricsinfo.QueryCoordTargetLagMetrics {
+	lagMetrics := &metricsinfo.QueryCoordTargetLagMetrics{
+		Collections: make(map[int64]*metricsinfo.QueryCoordTargetLag),
+	}
+	for _, collectionID := range s.meta.CollectionManager.GetAll() {
+		segmentNum, size := utils.GetTargetLag(s.targetMgr, collectionID)
+		lagMetrics.Collections[collectionID] = &metricsinfo.QueryCoordTargetLag{
+			SegmentNum: segmentNum,
+			Size:       size,
+		}
+		lagMetrics.Total.SegmentNum += segmentNum
+		lagMetrics.Total.Size += size
+	}
+	return lagMetrics
+}
+
+// getCachedMetrics returns the metrics of the given type from cache if it's updated within queryCoord.metricsCacheTTL,
+// and refreshes the cache in background once half of the TTL passed, so frequent scrapes don't wait for the fanout.
+func (s *Server) getCachedMetrics(ctx context.Context, metricType string, get func(ctx context.Context) (string, error)) (string, error) {
+	ttl := Params.QueryCoordCfg.MetricsCacheTTL.GetAsDuration(time.Millisecond)
+	if ttl <= 0 {
+		return get(ctx)
+	}
+	if cached, updatedTime, ok := s.metricsCacheManager.GetMetrics(metricType); ok {
+		if age := time.Since(updatedTime); age < ttl {
+			if age >= ttl/2 {
+				s.refreshMetricsInBackground(metricType, ttl, get)
+			}
+			return cached.GetResponse(), nil
+		}
+	}
+	return s.refreshMetrics(ctx, metricType, get)
+}
+
+func (s *Server) refreshMetrics(ctx context.Context, metricType string, get func(ctx context.Context) (string, error)) (string, error) {
+	response, err := get(ctx)
+	if err != nil {
+		return "", err
+	}
+	s.metricsCacheManager.UpdateMetrics(metricType, &milvuspb.GetMetricsResponse{
+		Status:   merr.Success(),
+		Response: response,
+	})
+	return response, nil
+}
+
+// refreshMetricsInBackground refreshes the cached metrics of the given type,
+// at most one refresh runs for each metric type, and it gives up once the cache expires.
+func (s *Server) refreshMetricsInBackground(metricType string, timeout time.Duration, get func(ctx context.Context) (string, error)) {
+	if _, loaded := s.refreshingMetrics.GetOrInsert(metricType, struct{}{}); loaded {
+		return
+	}
+	go func() {
+		defer s.refreshingMetrics.Remove(metricType)
+		ctx, cancel := context.WithTimeout(s.ctx, timeout)
+		defer cancel()
+		if _, err := s.refreshMetrics(ctx, metricType, get); err != nil {
+			log.Warn("failed to refresh metrics cache", zap.String("metricType", metricType), zap.Error(err))
+		}
+	}()
+}
+
+// getCollectionDistributionMetrics returns the segment number per node, the readability of replicas
+// and the target lag of every loaded collection.
+func (s *Server) getCollectionDistributionMetrics() (string, error) {
+	distMetrics := metricsinfo.QueryCoordCollectionDistributionMetrics{
+		Collections: make(map[int64]*metricsinfo.QueryCoordCollectionMetrics),
+	}
+	for _, collectionID := range s.meta.CollectionManager.GetAll() {
+		collectionMetrics := &metricsinfo.QueryCoordCollectionMetrics{
+			NodeSegmentNum: make(map[int64]int),
+			Replicas:       make([]*metricsinfo.QueryCoordReplicaMetrics, 0),
+		}
+		for _, segment := range s.dist.SegmentDistManager.GetByFilter(meta.WithCollectionID(collectionID)) {
+			collectionMetrics.NodeSegmentNum[segment.Node]++
+		}
+
+		channels := s.targetMgr.GetDmChannelsByCollection(collectionID, meta.CurrentTarget)
+		currentTargets := s.targetMgr.GetSealedSegmentsByCollection(collectionID, meta.CurrentTarget)
+		for _, replica := range s.meta.ReplicaManager.GetByCollection(collectionID) {
+			collectionMetrics.Replicas = append(collectionMetrics.Replicas, &metricsinfo.QueryCoordReplicaMetrics{
+				ReplicaID: replica.GetID(),
+				Nodes:     replica.GetNodes(),
+				// replica is not readable before the current target is ready
+				Readable: len(channels) > 0 && s.isReplicaReadable(replica, channels, currentTargets),
+			})
+		}
+
+		collectionMetrics.TargetLag, _ = utils.GetTargetLag(s.targetMgr, collectionID)
+		distMetrics.Collections[collectionID] = collectionMetrics
+	}
+	return metricsinfo.MarshalComponentInfos(distMetrics)
+}
+
 func (s *Server) fillMetricsWithNodes(topo *metricsinfo.QueryClusterTopology, nodeMetrics []*metricResp) {
 	for _, metric := range nodeMetrics {
 		if metric.err != nil {
@@ -427,6 +756,29 @@ func (s *Server) fillReplicaInfo(replica *meta.Replica, withShardNodes bool) *mi
 	return info
 }
 
+// fillReplicaNodes returns the nodes of the replicas with hostnames, sorted by node id,
+// the nodes not in nodeMgr any more are omitted.
+func (s *Server) fillReplicaNodes(replicas []*meta.Replica) []*commonpb.NodeInfo {
+	nodeIDs := typeutil.NewUniqueSet()
+	for _, replica := range replicas {
+		nodeIDs.Insert(replica.GetNodes()...)
+	}
+	nodes := make([]*commonpb.NodeInfo, 0, nodeIDs.Len())
+	for _, nodeID := range nodeIDs.Collect() {
+		info := s.nodeMgr.Get(nodeID)
+		if info == nil {
+			continue
+		}
+		nodes = append(nodes, &commonpb.NodeInfo{
+			NodeId:   info.ID(),
+			Address:  info.Addr(),
+			Hostname: info.Hostname(),
+		})
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].GetNodeId() < nodes[j].GetNodeId() })
+	return nodes
+}
+
 func filterDupLeaders(replicaManager *meta.ReplicaManager, leaders map[int64]*meta.LeaderView) map[int64]*meta.LeaderView {
 	type leaderID struct {
 		ReplicaID int64
@@ -454,3 +806,1636 @@ func filterDupLeaders(replicaManager *meta.ReplicaManager, leaders map[int64]*me
 	}
 	return result
 }
+
+// countReplicas returns the number of distinct replicas which the given leaders belong to.
+func countReplicas(replicaManager *meta.ReplicaManager, leaders map[int64]*meta.LeaderView) int {
+	replicas := typeutil.NewUniqueSet()
+	for _, view := range leaders {
+		if replica := replicaManager.GetByCollectionAndNode(view.CollectionID, view.ID); replica != nil {
+			replicas.Insert(replica.GetID())
+		}
+	}
+	return replicas.Len()
+}
+
+// getClusterLoadSummary aggregates load status of the whole query cluster,
+// a shard of replica is regarded as unhealthy if its leader is missing or not readable.
+func (s *Server) getClusterLoadSummary() *querypb.GetClusterLoadSummaryResponse {
+	resp := &querypb.GetClusterLoadSummaryResponse{
+		NumNodesPerRg: make(map[string]int32),
+	}
+
+	for _, rgName := range s.meta.ResourceManager.ListResourceGroups() {
+		nodes, err := s.meta.ResourceManager.GetNodes(rgName)
+		if err != nil {
+			// resource group may be dropped concurrently
+			continue
+		}
+		resp.NumNodesPerRg[rgName] = int32(len(nodes))
+	}
+
+	for _, collectionID := range s.meta.CollectionManager.GetAll() {
+		resp.NumLoadedCollections++
+		replicas := s.meta.ReplicaManager.GetByCollection(collectionID)
+		resp.NumReplicas += int32(len(replicas))
+
+		channels := s.targetMgr.GetDmChannelsByCollection(collectionID, meta.CurrentTarget)
+		currentTargets := s.targetMgr.GetSealedSegmentsByCollection(collectionID, meta.CurrentTarget)
+		for _, replica := range replicas {
+			for _, channel := range channels {
+				leader := s.dist.LeaderViewManager.GetLatestShardLeaderByFilter(
+					meta.WithReplica2LeaderView(replica),
+					meta.WithChannelName2LeaderView(channel.GetChannelName()),
+				)
+				if leader == nil || checkers.CheckLeaderAvailable(s.nodeMgr, leader, currentTargets) != nil {
+					resp.NumUnhealthyShards++
+				}
+			}
+		}
+	}
+
+	for _, segment := range s.dist.SegmentDistManager.GetByFilter() {
+		resp.NumSegments++
+		resp.MemorySize += utils.GetSegmentSize(segment.SegmentInfo)
+	}
+	return resp
+}
+
+// forceSync pulls data distribution from the given nodes one by one, reconciles dist meta with it,
+// and returns what has been corrected for each node.
+func (s *Server) forceSync(ctx context.Context, nodes []int64) []*querypb.NodeSyncResult {
+	results := make([]*querypb.NodeSyncResult, 0, len(nodes))
+	for _, nodeID := range nodes {
+		segmentsBefore, channelsBefore, viewsBefore := s.getNodeDistSnapshot(nodeID)
+		result := &querypb.NodeSyncResult{NodeID: nodeID}
+		if err := s.distController.SyncNode(ctx, nodeID); err != nil {
+			log.Ctx(ctx).Warn("failed to sync data distribution from node", zap.Int64("nodeID", nodeID), zap.Error(err))
+			result.Error = err.Error()
+			results = append(results, result)
+			continue
+		}
+		segmentsAfter, channelsAfter, viewsAfter := s.getNodeDistSnapshot(nodeID)
+
+		removedSegments, addedSegments := lo.Difference(segmentsBefore, segmentsAfter)
+		removedChannels, addedChannels := lo.Difference(channelsBefore, channelsAfter)
+		removedViews, addedViews := lo.Difference(viewsBefore, viewsAfter)
+		result.SegmentsAdded = int32(len(addedSegments))
+		result.SegmentsRemoved = int32(len(removedSegments))
+		result.ChannelsAdded = int32(len(addedChannels))
+		result.ChannelsRemoved = int32(len(removedChannels))
+		result.LeaderViewsAdded = int32(len(addedViews))
+		result.LeaderViewsRemoved = int32(len(removedViews))
+		results = append(results, result)
+	}
+	return results
+}
+
+// forceSyncCollection diffs the distribution of the collection against its current target immediately,
+// and dispatches the load/release actions to reconcile them.
+func (s *Server) forceSyncCollection(ctx context.Context, collectionID int64) *querypb.ForceSyncResponse {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID))
+	if !s.meta.CollectionManager.Exist(collectionID) {
+		err := merr.WrapErrCollectionNotLoaded(collectionID)
+		log.Warn("failed to force sync collection", zap.Error(err))
+		return &querypb.ForceSyncResponse{
+			Status: merr.Status(err),
+		}
+	}
+
+	actionNum := s.checkerController.ForceSync(ctx, collectionID)
+	log.Info("force sync collection done", zap.Int("actionNum", actionNum))
+	return &querypb.ForceSyncResponse{
+		Status:    merr.Success(),
+		ActionNum: int32(actionNum),
+	}
+}
+
+func (s *Server) getNodeDistSnapshot(nodeID int64) (segments []int64, channels []string, leaderViews []string) {
+	segments = lo.Map(s.dist.SegmentDistManager.GetByFilter(meta.WithNodeID(nodeID)), func(segment *meta.Segment, _ int) int64 {
+		return segment.GetID()
+	})
+	channels = lo.Map(s.dist.ChannelDistManager.GetByFilter(meta.WithNodeID2Channel(nodeID)), func(channel *meta.DmChannel, _ int) string {
+		return channel.GetChannelName()
+	})
+	leaderViews = lo.Map(s.dist.LeaderViewManager.GetByFilter(meta.WithNodeID2LeaderView(nodeID)), func(view *meta.LeaderView, _ int) string {
+		return view.Channel
+	})
+	return segments, channels, leaderViews
+}
+
+// getReplicaRecoveryStatus returns the replicas in the given resource groups which haven't settled after node transfer,
+// a replica is settled once its outbound nodes are drained and every channel has a serviceable shard leader.
+func (s *Server) getReplicaRecoveryStatus(rgs typeutil.Set[string]) []*querypb.ReplicaRecoveryStatus {
+	ret := make([]*querypb.ReplicaRecoveryStatus, 0)
+	for _, collectionID := range s.meta.CollectionManager.GetAll() {
+		channels := s.targetMgr.GetDmChannelsByCollection(collectionID, meta.CurrentTarget)
+		currentTargets := s.targetMgr.GetSealedSegmentsByCollection(collectionID, meta.CurrentTarget)
+		for _, replica := range s.meta.ReplicaManager.GetByCollection(collectionID) {
+			if len(rgs) > 0 && !rgs.Contain(replica.GetResourceGroup()) {
+				continue
+			}
+
+			unserviceable := make([]string, 0)
+			for _, channel := range channels {
+				leader := s.dist.LeaderViewManager.GetLatestShardLeaderByFilter(
+					meta.WithReplica2LeaderView(replica),
+					meta.WithChannelName2LeaderView(channel.GetChannelName()),
+				)
+				if leader == nil || checkers.CheckLeaderAvailable(s.nodeMgr, leader, currentTargets) != nil {
+					unserviceable = append(unserviceable, channel.GetChannelName())
+				}
+			}
+
+			if replica.RONodesCount() == 0 && len(unserviceable) == 0 {
+				continue
+			}
+			ret = append(ret, &querypb.ReplicaRecoveryStatus{
+				CollectionID:          collectionID,
+				ReplicaID:             replica.GetID(),
+				ResourceGroup:         replica.GetResourceGroup(),
+				OutboundNodes:         replica.GetRONodes(),
+				UnserviceableChannels: unserviceable,
+			})
+		}
+	}
+	return ret
+}
+
+// releaseBaseline is the distribution of a collection when its release starts.
+type releaseBaseline struct {
+	segmentNum int
+	channelNum int
+}
+
+// nodeDecommission is the distribution of a node when its decommission starts.
+type nodeDecommission struct {
+	segmentNum int
+	channelNum int
+}
+
+// getCheckerInfo returns the activation and the latest run of the checker.
+func (s *Server) getCheckerInfo(checker checkers.Checker) *querypb.CheckerInfo {
+	info := &querypb.CheckerInfo{
+		Id:        int32(checker.ID()),
+		Activated: checker.IsActive(),
+		Desc:      checker.ID().String(),
+		Found:     true,
+	}
+	if run, ok := s.checkerController.GetRunInfo(checker.ID()); ok {
+		info.LastRunTime = run.LastRunTime.UnixMilli()
+		info.LastGeneratedTaskNum = int32(run.GeneratedTaskNum)
+		info.LastSubmittedTaskNum = int32(run.SubmittedTaskNum)
+	}
+	return info
+}
+
+// selectCheckers returns the checkers with the given names ordered by priority, all checkers if no name given.
+func (s *Server) selectCheckers(names []string) ([]checkers.Checker, error) {
+	all := s.checkerController.Checkers()
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].ID() < all[j].ID()
+	})
+	if len(names) == 0 {
+		return all, nil
+	}
+
+	nameSet := typeutil.NewSet(names...)
+	selected := lo.Filter(all, func(checker checkers.Checker, _ int) bool {
+		return nameSet.Contain(checker.ID().String())
+	})
+	if len(selected) != nameSet.Len() {
+		unknown := nameSet.Complement(typeutil.NewSet(lo.Map(selected, func(checker checkers.Checker, _ int) string {
+			return checker.ID().String()
+		})...))
+		return nil, merr.WrapErrParameterInvalidMsg("unknown checkers %v", unknown.Collect())
+	}
+	return selected, nil
+}
+
+func newCheckerTaskInfo(t task.Task) *querypb.CheckerTaskInfo {
+	return &querypb.CheckerTaskInfo{
+		TaskID:       t.ID(),
+		CollectionID: t.CollectionID(),
+		ReplicaID:    t.ReplicaID(),
+		Shard:        t.Shard(),
+		Desc:         t.String(),
+	}
+}
+
+// nodeServingError is the error reported by a query node in health check.
+type nodeServingError struct {
+	reason    string
+	timestamp time.Time
+}
+
+// getReplicaLoadPercentages returns the percentage of target segments loaded on the nodes of each replica,
+// the replica without any available node is regarded as not loaded.
+// The collection percentage is used if there is no segment in target.
+func (s *Server) getReplicaLoadPercentages(collectionID int64, collectionPercentage int64) map[int64]int64 {
+	targets := s.targetMgr.GetSealedSegmentsByCollection(collectionID, meta.NextTargetFirst)
+	ret := make(map[int64]int64)
+	for _, replica := range s.meta.ReplicaManager.GetByCollection(collectionID) {
+		available := lo.ContainsBy(replica.GetNodes(), func(node int64) bool {
+			return s.nodeMgr.Get(node) != nil
+		})
+		if !available {
+			ret[replica.GetID()] = 0
+			continue
+		}
+		if len(targets) == 0 {
+			ret[replica.GetID()] = collectionPercentage
+			continue
+		}
+
+		loaded := typeutil.NewUniqueSet()
+		for _, segment := range s.dist.SegmentDistManager.GetByFilter(meta.WithReplica(replica)) {
+			if _, ok := targets[segment.GetID()]; ok {
+				loaded.Insert(segment.GetID())
+			}
+		}
+		ret[replica.GetID()] = int64(loaded.Len() * 100 / len(targets))
+	}
+	return ret
+}
+
+// getPartitionLoadFailure returns the recorded load failure of the partition or its collection,
+// nil if none recorded.
+func (s *Server) getPartitionLoadFailure(collectionID, partitionID int64) error {
+	err := meta.GlobalFailedLoadCache.GetPartition(collectionID, partitionID)
+	if err == nil {
+		err = meta.GlobalFailedLoadCache.Get(collectionID)
+	}
+	return err
+}
+
+// getRefreshProgress returns the percentage of the segments newly added to the next target that are loaded
+// in all replicas, only the segments of the given partitions are counted if any.
+// It reports 100 if no refresh is in progress, and at most 99 until the current target is updated.
+func (s *Server) getRefreshProgress(collection *meta.Collection, partitionIDs ...int64) int64 {
+	if collection == nil || collection.IsRefreshed() {
+		return 100
+	}
+
+	collectionID := collection.GetCollectionID()
+	partitionSet := typeutil.NewUniqueSet(partitionIDs...)
+	current := s.targetMgr.GetSealedSegmentsByCollection(collectionID, meta.CurrentTarget)
+	toLoad := make(map[int64]struct{})
+	for segmentID, segment := range s.targetMgr.GetSealedSegmentsByCollection(collectionID, meta.NextTarget) {
+		if _, ok := current[segmentID]; ok {
+			continue
+		}
+		if partitionSet.Len() > 0 && !partitionSet.Contain(segment.GetPartitionID()) {
+			continue
+		}
+		toLoad[segmentID] = struct{}{}
+	}
+
+	// the replicas may be removed as the collection is being released
+	replicas := s.meta.ReplicaManager.GetByCollection(collectionID)
+	if len(toLoad) == 0 || len(replicas) == 0 {
+		return 99
+	}
+
+	loaded := 0
+	for _, replica := range replicas {
+		// a segment may be on two nodes of the replica during balance
+		loadedInReplica := typeutil.NewUniqueSet()
+		for _, segment := range s.dist.SegmentDistManager.GetByFilter(meta.WithReplica(replica)) {
+			if _, ok := toLoad[segment.GetID()]; ok {
+				loadedInReplica.Insert(segment.GetID())
+			}
+		}
+		loaded += loadedInReplica.Len()
+	}
+	return lo.Min([]int64{int64(loaded * 100 / (len(toLoad) * len(replicas))), 99})
+}
+
+// getCollectionLoadStates returns the load states of the given collections, or all collections if none is given,
+// the collections not loaded are absent.
+func (s *Server) getCollectionLoadStates(collectionIDs []int64) map[int64]*querypb.CollectionLoadState {
+	if len(collectionIDs) == 0 {
+		collectionIDs = s.meta.CollectionManager.GetAll()
+	}
+
+	states := make(map[int64]*querypb.CollectionLoadState)
+	for _, collectionID := range collectionIDs {
+		percentage := s.meta.CollectionManager.CalculateLoadPercentage(collectionID)
+		if percentage < 0 {
+			continue
+		}
+		states[collectionID] = &querypb.CollectionLoadState{
+			CollectionID:          collectionID,
+			LoadPercentage:        int64(percentage),
+			QueryServiceAvailable: s.checkAnyReplicaAvailable(collectionID),
+		}
+	}
+	return states
+}
+
+// sortReplicas sorts the replicas of the collection by the given key, replicas with the same key are ordered by ID.
+func (s *Server) sortReplicas(collectionID int64, replicas []*meta.Replica, key querypb.ReplicaSortKey, descending bool) {
+	var keyOf func(replica *meta.Replica) int64
+	switch key {
+	case querypb.ReplicaSortKey_ReplicaNodeNum:
+		keyOf = func(replica *meta.Replica) int64 {
+			return int64(replica.NodesCount())
+		}
+	case querypb.ReplicaSortKey_ReplicaLoadPercentage:
+		percentages := s.getReplicaLoadPercentages(collectionID, int64(s.meta.CollectionManager.CalculateLoadPercentage(collectionID)))
+		keyOf = func(replica *meta.Replica) int64 {
+			return percentages[replica.GetID()]
+		}
+	default:
+		keyOf = func(replica *meta.Replica) int64 {
+			return 0
+		}
+	}
+
+	sort.Slice(replicas, func(i, j int) bool {
+		ki, kj := keyOf(replicas[i]), keyOf(replicas[j])
+		if ki == kj {
+			return replicas[i].GetID() < replicas[j].GetID()
+		}
+		if descending {
+			return ki > kj
+		}
+		return ki < kj
+	})
+}
+
+func (s *Server) getCollectionDistNum(collectionID int64) (segmentNum int, channelNum int) {
+	segments := s.dist.SegmentDistManager.GetByFilter(meta.WithCollectionID(collectionID))
+	channels := s.dist.ChannelDistManager.GetByCollectionAndFilter(collectionID)
+	return len(segments), len(channels)
+}
+
+func (s *Server) recordReleaseBaseline(collectionID int64) {
+	segmentNum, channelNum := s.getCollectionDistNum(collectionID)
+	s.releaseBaselines.Insert(collectionID, &releaseBaseline{
+		segmentNum: segmentNum,
+		channelNum: channelNum,
+	})
+}
+
+// getNodeNQRate returns the search nq per second served by the given query node.
+func (s *Server) getNodeNQRate(ctx context.Context, nodeID int64) (float64, error) {
+	infos, err := utils.GetQueryNodeInfos(ctx, s.cluster, nodeID)
+	if err != nil {
+		return 0, err
+	}
+	if infos.QuotaMetrics == nil {
+		return 0, nil
+	}
+	for _, rm := range infos.QuotaMetrics.Rms {
+		if rm.Label == metricsinfo.NQPerSecond {
+			return rm.Rate, nil
+		}
+	}
+	return 0, nil
+}
+
+// estimateShardLoads estimates the search nq per second served by each shard of the given collection,
+// the nq rate of a node is shared evenly by all the shard leaders on it.
+// Returns the nodes whose metrics are unavailable as well.
+func (s *Server) estimateShardLoads(ctx context.Context, collectionID int64) ([]*querypb.ShardLoadEstimate, []int64) {
+	channels := s.targetMgr.GetDmChannelsByCollection(collectionID, meta.CurrentTarget)
+	leaders := s.dist.LeaderViewManager.GetByFilter(meta.WithCollectionID2LeaderView(collectionID))
+
+	nodeRates := make(map[int64]float64)
+	unavailable := make([]int64, 0)
+	for _, leader := range leaders {
+		if _, ok := nodeRates[leader.ID]; ok || lo.Contains(unavailable, leader.ID) {
+			continue
+		}
+		rate, err := s.getNodeNQRate(ctx, leader.ID)
+		if err != nil {
+			log.Ctx(ctx).Warn("failed to get nq rate of node", zap.Int64("nodeID", leader.ID), zap.Error(err))
+			unavailable = append(unavailable, leader.ID)
+			continue
+		}
+		nodeRates[leader.ID] = rate
+	}
+
+	leaderNum := make(map[int64]int)
+	for nodeID := range nodeRates {
+		leaderNum[nodeID] = len(s.dist.LeaderViewManager.GetByFilter(meta.WithNodeID2LeaderView(nodeID)))
+	}
+
+	shardRates := make(map[string]float64, len(channels))
+	for _, leader := range leaders {
+		rate, ok := nodeRates[leader.ID]
+		if !ok || leaderNum[leader.ID] == 0 {
+			continue
+		}
+		shardRates[leader.Channel] += rate / float64(leaderNum[leader.ID])
+	}
+
+	shards := make([]*querypb.ShardLoadEstimate, 0, len(channels))
+	for channel := range channels {
+		shards = append(shards, &querypb.ShardLoadEstimate{
+			ChannelName: channel,
+			NqPerSecond: shardRates[channel],
+		})
+	}
+	sort.Slice(shards, func(i, j int) bool { return shards[i].GetChannelName() < shards[j].GetChannelName() })
+	return shards, unavailable
+}
+
+// prepareLoadBalance validates the load balance request,
+// and returns the replica, source nodes, destination nodes and segments to balance.
+// With multiple source nodes, the stopping ones are skipped rather than failing the request.
+func (s *Server) prepareLoadBalance(ctx context.Context, req *querypb.LoadBalanceRequest) (*meta.Replica, []int64, []int64, []*meta.Segment, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+	)
+
+	// Verify request
+	if len(req.GetSourceNodeIDs()) == 0 {
+		err := merr.WrapErrParameterInvalid("at least 1 source node", "0 source nodes")
+		msg := "source nodes can't be empty"
+		log.Warn(msg)
+		return nil, nil, nil, nil, err
+	}
+	if s.meta.CollectionManager.CalculateLoadPercentage(req.GetCollectionID()) < 100 {
+		err := merr.WrapErrCollectionNotFullyLoaded(req.GetCollectionID())
+		msg := "can't balance segments of not fully loaded collection"
+		log.Warn(msg)
+		return nil, nil, nil, nil, err
+	}
+	if req.GetPartitionID() > 0 {
+		if err := s.checkPartitionToBalance(req); err != nil {
+			log.Warn("can't balance segments of the partition", zap.Int64("partitionID", req.GetPartitionID()), zap.Error(err))
+			return nil, nil, nil, nil, err
+		}
+	}
+	var replica *meta.Replica
+	for _, srcNode := range req.GetSourceNodeIDs() {
+		srcReplica := s.meta.ReplicaManager.GetByCollectionAndNode(req.GetCollectionID(), srcNode)
+		if srcReplica == nil {
+			err := merr.WrapErrNodeNotFound(srcNode, fmt.Sprintf("source node not found in any replica of collection %d", req.GetCollectionID()))
+			msg := "source node not found in any replica"
+			log.Warn(msg)
+			return nil, nil, nil, nil, err
+		}
+		if replica == nil {
+			replica = srcReplica
+		} else if replica.GetID() != srcReplica.GetID() {
+			err := merr.WrapErrParameterInvalid("source nodes in the same replica",
+				fmt.Sprintf("source nodes in replica %d and %d", replica.GetID(), srcReplica.GetID()))
+			log.Warn("source nodes belong to different replicas", zap.Error(err))
+			return nil, nil, nil, nil, err
+		}
+	}
+	srcNodes := make([]int64, 0, len(req.GetSourceNodeIDs()))
+	for _, srcNode := range req.GetSourceNodeIDs() {
+		if err := s.isStoppingNode(srcNode); err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("can't balance, because the source node[%d] is invalid", srcNode))
+			if len(req.GetSourceNodeIDs()) == 1 {
+				return nil, nil, nil, nil, err
+			}
+			log.Warn("skip the invalid source node", zap.Int64("srcNode", srcNode), zap.Error(err))
+			continue
+		}
+		srcNodes = append(srcNodes, srcNode)
+	}
+	if len(srcNodes) == 0 {
+		err := merr.WrapErrParameterInvalid("at least 1 valid source node", "all source nodes are invalid")
+		log.Warn("no valid source node to balance", zap.Error(err))
+		return nil, nil, nil, nil, err
+	}
+
+	// when no dst node specified, default to use all other nodes in same
+	dstNodeSet := typeutil.NewUniqueSet()
+	if len(req.GetDstNodeIDs()) == 0 {
+		dstNodeSet.Insert(replica.GetNodes()...)
+		if len(req.GetSourceNodeIDs()) > 1 {
+			// don't move segments between the source nodes being drained
+			dstNodeSet.Remove(req.GetSourceNodeIDs()...)
+		}
+	} else {
+		for _, dstNode := range req.GetDstNodeIDs() {
+			if !replica.Contains(dstNode) {
+				err := merr.WrapErrNodeNotFound(dstNode, "destination node not found in the same replica")
+				log.Warn("failed to balance to the destination node", zap.Error(err))
+				return nil, nil, nil, nil, err
+			}
+			dstNodeSet.Insert(dstNode)
+		}
+	}
+	// suspended nodes receive no new assignments
+	for dstNode := range dstNodeSet {
+		if info := s.nodeMgr.Get(dstNode); info != nil && info.GetState() == session.NodeStateSuspend {
+			log.Info("skip the suspended destination node", zap.Int64("dstNode", dstNode))
+			dstNodeSet.Remove(dstNode)
+		}
+	}
+	if dstNodeSet.Len() == 0 {
+		err := merr.WrapErrParameterInvalid("at least 1 available destination node", "all destination nodes are suspended")
+		log.Warn("no destination node to balance", zap.Error(err))
+		return nil, nil, nil, nil, err
+	}
+
+	// check whether dstNode is healthy
+	for dstNode := range dstNodeSet {
+		if err := s.isStoppingNode(dstNode); err != nil {
+			return nil, nil, nil, nil, errors.Wrap(err,
+				fmt.Sprintf("can't balance, because the destination node[%d] is invalid", dstNode))
+		}
+	}
+
+	// check sealed segment list
+	segments := make([]*meta.Segment, 0)
+	for _, srcNode := range srcNodes {
+		segments = append(segments, s.dist.SegmentDistManager.GetByFilter(meta.WithCollectionID(req.GetCollectionID()), meta.WithNodeID(srcNode))...)
+	}
+	if req.GetPartitionID() > 0 {
+		segments = lo.Filter(segments, func(segment *meta.Segment, _ int) bool {
+			return segment.GetPartitionID() == req.GetPartitionID()
+		})
+	}
+	segmentsMap := lo.SliceToMap(segments, func(s *meta.Segment) (int64, *meta.Segment) {
+		return s.GetID(), s
+	})
+
+	toBalance := typeutil.NewSet[*meta.Segment]()
+	if len(req.GetSealedSegmentIDs()) == 0 {
+		toBalance.Insert(segments...)
+	} else {
+		// check whether sealed segment exist
+		for _, segmentID := range req.GetSealedSegmentIDs() {
+			segment, ok := segmentsMap[segmentID]
+			if !ok {
+				msg := "segment not found in source nodes"
+				if req.GetPartitionID() > 0 {
+					msg = fmt.Sprintf("segment of partition %d not found in source nodes", req.GetPartitionID())
+				}
+				err := merr.WrapErrSegmentNotFound(segmentID, msg)
+				return nil, nil, nil, nil, err
+			}
+
+			// Only balance segments in targets
+			existInTarget := s.targetMgr.GetSealedSegment(segment.GetCollectionID(), segment.GetID(), meta.CurrentTarget) != nil
+			if !existInTarget {
+				log.Info("segment doesn't exist in current target, skip it", zap.Int64("segmentID", segmentID))
+				continue
+			}
+			toBalance.Insert(segment)
+		}
+	}
+
+	// destination nodes reaching the caps of segment number or memory size receive no more segments
+	dstNodes := balance.FilterNodesWithCapacity(s.dist, s.taskScheduler, dstNodeSet.Collect())
+	if len(dstNodes) == 0 && toBalance.Len() > 0 {
+		err := merr.WrapErrServiceQuotaExceeded(
+			fmt.Sprintf("all destination nodes %v reach the cap of segment number or memory size", dstNodeSet.Collect()))
+		log.Warn("no destination node to balance", zap.Error(err))
+		return nil, nil, nil, nil, err
+	}
+
+	if err := s.checkBalanceCapacity(ctx, dstNodes, toBalance.Collect()); err != nil {
+		msg := "destination nodes can't hold the segments to balance"
+		log.Warn(msg, zap.Error(err))
+		return nil, nil, nil, nil, errors.Wrap(err, msg)
+	}
+
+	return replica, srcNodes, dstNodes, toBalance.Collect(), nil
+}
+
+// checkPartitionToBalance checks the partition to balance is fully loaded and present in the current target,
+// channels are shared by all partitions so they can't be balanced with the partition filter.
+func (s *Server) checkPartitionToBalance(req *querypb.LoadBalanceRequest) error {
+	partitionID := req.GetPartitionID()
+	if req.GetBalanceChannels() {
+		return merr.WrapErrParameterInvalid("balance_channels false", "balance_channels true", "channels can't be balanced by partition")
+	}
+	partition := s.meta.CollectionManager.GetPartition(partitionID)
+	if partition == nil || partition.GetCollectionID() != req.GetCollectionID() ||
+		s.meta.CollectionManager.GetPartitionLoadPercentage(partitionID) < 100 {
+		return merr.WrapErrPartitionNotLoaded(partitionID)
+	}
+	if len(s.targetMgr.GetSealedSegmentsByPartition(req.GetCollectionID(), partitionID, meta.CurrentTarget)) == 0 {
+		return merr.WrapErrPartitionNotLoaded(partitionID, "partition not found in current target")
+	}
+	return nil
+}
+
+// getChannelsToBalance returns the channels subscribed by the source nodes in the replica,
+// which exist in the current target and whose shard leader is available.
+func (s *Server) getChannelsToBalance(ctx context.Context, replica *meta.Replica, srcNodes []int64) []*meta.DmChannel {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", replica.GetCollectionID()),
+		zap.Int64("replicaID", replica.GetID()),
+	)
+
+	currentTargets := s.targetMgr.GetSealedSegmentsByCollection(replica.GetCollectionID(), meta.CurrentTarget)
+	ret := make([]*meta.DmChannel, 0)
+	for _, srcNode := range srcNodes {
+		channels := s.dist.ChannelDistManager.GetByCollectionAndFilter(replica.GetCollectionID(), meta.WithNodeID2Channel(srcNode))
+		for _, channel := range channels {
+			if s.targetMgr.GetDmChannel(channel.GetCollectionID(), channel.GetChannelName(), meta.CurrentTarget) == nil {
+				log.Info("channel doesn't exist in current target, skip it", zap.String("channelName", channel.GetChannelName()))
+				continue
+			}
+			leader := s.dist.LeaderViewManager.GetLeaderShardView(srcNode, channel.GetChannelName())
+			if leader == nil {
+				log.Info("shard leader not found, skip it", zap.String("channelName", channel.GetChannelName()), zap.Int64("node", srcNode))
+				continue
+			}
+			if err := checkers.CheckLeaderAvailable(s.nodeMgr, leader, currentTargets); err != nil {
+				log.Info("shard leader is unavailable, skip it", zap.String("channelName", channel.GetChannelName()), zap.Int64("node", srcNode), zap.Error(err))
+				continue
+			}
+			ret = append(ret, channel)
+		}
+	}
+	return ret
+}
+
+// estimateLoadTime estimates the duration to load the rest segments of the collection
+// by the historical load throughput, and records it for ShowCollections.
+// Returns 0 if the collection is loaded or there is no load history.
+func (s *Server) estimateLoadTime(collectionID int64) time.Duration {
+	percentage := s.meta.CollectionManager.CalculateLoadPercentage(collectionID)
+	if percentage < 0 || percentage >= 100 {
+		return 0
+	}
+	segmentNum := len(s.targetMgr.GetSealedSegmentsByCollection(collectionID, meta.NextTarget))
+	segmentNum = segmentNum * int(100-percentage) / 100
+	estimate := meta.GlobalLoadThroughputRecorder.Estimate(collectionID, segmentNum)
+	meta.GlobalLoadThroughputRecorder.SetEstimate(collectionID, estimate)
+	return estimate
+}
+
+// resolveReplicaNumber resolves the zero replica number of the load request,
+// which means the replica number of the collection if it's loaded as a whole,
+// otherwise one replica per given resource group, or the default replica number if no resource group given.
+func (s *Server) resolveReplicaNumber(req *querypb.LoadCollectionRequest) error {
+	if req.GetReplicaNumber() < 0 {
+		return merr.WrapErrParameterInvalidMsg("replica number %d should be non-negative", req.GetReplicaNumber())
+	}
+	if req.GetReplicaNumber() > 0 {
+		return nil
+	}
+
+	collection := s.meta.CollectionManager.GetCollection(req.GetCollectionID())
+	if collection != nil && collection.GetLoadType() == querypb.LoadType_LoadCollection {
+		req.ReplicaNumber = collection.GetReplicaNumber()
+		return nil
+	}
+
+	replicaNumber, err := s.deriveReplicaNumber(req.GetResourceGroups(), req.GetStandbyReplicaNumber())
+	if err != nil {
+		return err
+	}
+	req.ReplicaNumber = replicaNumber
+	return nil
+}
+
+// resolvePartitionReplicaNumber resolves the zero replica number of the load partitions request,
+// the partitions of a loaded collection share its replicas,
+// otherwise it's derived the same as loading a collection.
+func (s *Server) resolvePartitionReplicaNumber(req *querypb.LoadPartitionsRequest) error {
+	if req.GetReplicaNumber() < 0 {
+		return merr.WrapErrParameterInvalidMsg("replica number %d should be non-negative", req.GetReplicaNumber())
+	}
+	if req.GetReplicaNumber() > 0 {
+		return nil
+	}
+
+	collection := s.meta.CollectionManager.GetCollection(req.GetCollectionID())
+	if collection != nil {
+		req.ReplicaNumber = collection.GetReplicaNumber()
+		return nil
+	}
+
+	replicaNumber, err := s.deriveReplicaNumber(req.GetResourceGroups(), 0)
+	if err != nil {
+		return err
+	}
+	req.ReplicaNumber = replicaNumber
+	return nil
+}
+
+// deriveReplicaNumber derives the replica number from the given resource groups,
+// or returns the default replica number if no resource group given.
+func (s *Server) deriveReplicaNumber(resourceGroups []string, standbyReplicaNumber int32) (int32, error) {
+	if len(resourceGroups) == 0 {
+		replicaNumber := Params.QueryCoordCfg.DefaultReplicaNumber.GetAsInt32()
+		if replicaNumber <= 0 {
+			return 0, merr.WrapErrParameterInvalidMsg("replica number not specified and the default replica number %d is invalid", replicaNumber)
+		}
+		return replicaNumber, nil
+	}
+
+	for _, rgName := range resourceGroups {
+		if !s.meta.ResourceManager.ContainResourceGroup(rgName) {
+			return 0, merr.WrapErrResourceGroupNotFound(rgName)
+		}
+	}
+	if len(lo.FindDuplicates(resourceGroups)) > 0 {
+		return 0, merr.WrapErrParameterInvalidMsg("can't derive replica number from duplicated resource groups %v", resourceGroups)
+	}
+	replicaNumber := int32(len(resourceGroups))
+	if standbyReplicaNumber >= replicaNumber {
+		return 0, merr.WrapErrParameterInvalidMsg("standby replica number %d leaves no primary replica, the replica number derived from resource groups is %d",
+			standbyReplicaNumber, replicaNumber)
+	}
+	return replicaNumber, nil
+}
+
+// checkBalanceCapacity checks whether the destination nodes have enough memory headroom to hold the segments,
+// each segment is projected onto the node with the most headroom, from the largest segment to the smallest one.
+// The check is skipped if the memory of any destination node is unknown.
+func (s *Server) checkBalanceCapacity(ctx context.Context, dstNodes []int64, segments []*meta.Segment) error {
+	if len(dstNodes) == 0 || len(segments) == 0 {
+		return nil
+	}
+
+	log := log.Ctx(ctx)
+	threshold := Params.QueryCoordCfg.OverloadedMemoryThresholdPercentage.GetAsFloat() / 100
+
+	headroom := make(map[int64]int64, len(dstNodes))
+	for _, node := range dstNodes {
+		memory, usage, err := utils.GetNodeMemory(ctx, s.cluster, node)
+		if err != nil || memory == 0 {
+			log.Warn("failed to get memory of destination node, skip capacity check",
+				zap.Int64("nodeID", node),
+				zap.Error(err))
+			return nil
+		}
+		headroom[node] = int64(float64(memory)*threshold) - int64(usage)
+	}
+
+	sizes := lo.Map(segments, func(segment *meta.Segment, _ int) int64 {
+		return utils.GetSegmentSize(segment.SegmentInfo)
+	})
+	sort.Slice(sizes, func(i, j int) bool { return sizes[i] > sizes[j] })
+	for _, size := range sizes {
+		target := dstNodes[0]
+		for _, node := range dstNodes {
+			if headroom[node] > headroom[target] {
+				target = node
+			}
+		}
+		if headroom[target] < size {
+			return merr.WrapErrServiceMemoryLimitExceeded(float32(size), float32(headroom[target]),
+				fmt.Sprintf("no destination node in %v has enough memory headroom for the segment to balance", dstNodes))
+		}
+		headroom[target] -= size
+	}
+	return nil
+}
+
+// checkTransferReplicaCapacity checks whether the available nodes of the target resource group
+// have enough memory headroom to host the segments of the replicas to transfer.
+// The check is skipped if the memory of any available node is unknown.
+func (s *Server) checkTransferReplicaCapacity(ctx context.Context, collectionID int64, rgName string, replicaNum int64) error {
+	footprint := int64(0)
+	for _, segment := range s.targetMgr.GetSealedSegmentsByCollection(collectionID, meta.CurrentTarget) {
+		footprint += utils.GetSegmentSize(segment)
+	}
+	footprint *= replicaNum
+	if footprint <= 0 {
+		return nil
+	}
+
+	nodes, err := s.meta.ResourceManager.GetNodes(rgName)
+	if err != nil {
+		return err
+	}
+	threshold := Params.QueryCoordCfg.OverloadedMemoryThresholdPercentage.GetAsFloat() / 100
+	headroom := int64(0)
+	for _, node := range nodes {
+		info := s.nodeMgr.Get(node)
+		if info == nil || info.IsStoppingState() {
+			continue
+		}
+		memory, usage, err := utils.GetNodeMemory(ctx, s.cluster, node)
+		if err != nil || memory == 0 {
+			log.Ctx(ctx).Warn("failed to get memory of node in target resource group, skip capacity check",
+				zap.Int64("nodeID", node),
+				zap.Error(err))
+			return nil
+		}
+		if room := int64(float64(memory)*threshold) - int64(usage); room > 0 {
+			headroom += room
+		}
+	}
+
+	if headroom < footprint {
+		return merr.WrapErrParameterInvalid(
+			fmt.Sprintf("%d bytes memory available in resource group %s", footprint, rgName),
+			fmt.Sprintf("%d bytes", headroom),
+			fmt.Sprintf("short of %d bytes to host %d replicas of collection %d, set force to transfer anyway",
+				footprint-headroom, replicaNum, collectionID))
+	}
+	return nil
+}
+
+// getResourceGroupUtilization sums up the segment memory hosted by the online nodes of the resource group,
+// the segment memory is measured as GetSegmentInfo does, the nodes which fail to report memory are not counted in capacity.
+func (s *Server) getResourceGroupUtilization(ctx context.Context, rgName string) (*querypb.ResourceGroupUtilization, error) {
+	nodes, err := s.meta.ResourceManager.GetNodes(rgName)
+	if err != nil {
+		return nil, err
+	}
+
+	utilization := &querypb.ResourceGroupUtilization{
+		ResourceGroup: rgName,
+	}
+	for _, node := range nodes {
+		if s.nodeMgr.Get(node) == nil {
+			continue
+		}
+		utilization.NumNode++
+		for _, segment := range s.dist.SegmentDistManager.GetByFilter(meta.WithNodeID(node)) {
+			utilization.SegmentMemorySize += utils.GetSegmentSize(segment.SegmentInfo)
+		}
+		memory, _, err := utils.GetNodeMemory(ctx, s.cluster, node)
+		if err != nil {
+			log.Ctx(ctx).Warn("failed to get memory of query node", zap.Int64("nodeID", node), zap.Error(err))
+			continue
+		}
+		utilization.MemoryCapacity += int64(memory)
+	}
+	if utilization.GetMemoryCapacity() > 0 {
+		utilization.UsedRatio = float64(utilization.GetSegmentMemorySize()) / float64(utilization.GetMemoryCapacity())
+	}
+	return utilization, nil
+}
+
+// getShardLeaders returns the shard leaders of the collection,
+// the failure is reported in the status of the response.
+func (s *Server) getShardLeaders(ctx context.Context, req *querypb.GetShardLeadersRequest) *querypb.GetShardLeadersResponse {
+	log := log.Ctx(ctx).WithRateGroup("qcv2.GetShardLeaders", 1, 60).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("resourceGroup", req.GetResourceGroup()),
+		zap.String("preferZone", req.GetPreferZone()),
+	)
+
+	resp := &querypb.GetShardLeadersResponse{
+		Status: merr.Success(),
+	}
+
+	percentage := s.meta.CollectionManager.CalculateLoadPercentage(req.GetCollectionID())
+	if percentage < 0 {
+		err := merr.WrapErrCollectionNotLoaded(req.GetCollectionID())
+		log.Warn("failed to GetShardLeaders", zap.Error(err))
+		resp.Status = merr.Status(err)
+		return resp
+	}
+	collection := s.meta.CollectionManager.GetCollection(req.GetCollectionID())
+	if collection != nil && collection.GetStatus() == querypb.LoadStatus_Loaded {
+		// when collection is loaded, regard collection as readable, set percentage == 100
+		percentage = 100
+	}
+	if percentage < 100 {
+		err := merr.WrapErrCollectionNotFullyLoaded(req.GetCollectionID())
+		msg := fmt.Sprintf("collection %v is not fully loaded", req.GetCollectionID())
+		log.Warn(msg)
+		resp.Status = merr.Status(err)
+		return resp
+	}
+
+	if req.GetResourceGroup() != "" && !s.meta.ResourceManager.ContainResourceGroup(req.GetResourceGroup()) {
+		err := merr.WrapErrResourceGroupNotFound(req.GetResourceGroup())
+		log.Warn("failed to GetShardLeaders", zap.Error(err))
+		resp.Status = merr.Status(err)
+		return resp
+	}
+
+	if req.GetReplicaID() > 0 {
+		replica := s.meta.ReplicaManager.Get(req.GetReplicaID())
+		if replica == nil || replica.GetCollectionID() != req.GetCollectionID() {
+			err := merr.WrapErrReplicaNotFound(req.GetReplicaID(), fmt.Sprintf("not found in collection %d", req.GetCollectionID()))
+			log.Warn("failed to GetShardLeaders", zap.Error(err))
+			resp.Status = merr.Status(err)
+			return resp
+		}
+	}
+
+	channels := s.targetMgr.GetDmChannelsByCollection(req.GetCollectionID(), meta.CurrentTarget)
+	if len(channels) == 0 {
+		err := merr.WrapErrCollectionOnRecovering(req.GetCollectionID(),
+			"loaded collection do not found any channel in target, may be in recovery")
+		log.Warn("failed to get channels", zap.Error(err))
+		resp.Status = merr.Status(err)
+		return resp
+	}
+
+	// the channels blocked by operators are omitted only if the caller routes around them,
+	// otherwise the shards required by queries are missing
+	if blocks := s.meta.ShardBlockManager.GetShardBlocks(req.GetCollectionID()); len(blocks) > 0 {
+		serving := make(map[string]*meta.DmChannel, len(channels))
+		for name, channel := range channels {
+			if s.meta.ShardBlockManager.IsShardBlocked(req.GetCollectionID(), name) {
+				resp.BlockedChannels = append(resp.BlockedChannels, name)
+				continue
+			}
+			serving[name] = channel
+		}
+		sort.Strings(resp.BlockedChannels)
+		if len(resp.GetBlockedChannels()) > 0 && (!req.GetAllowBlockedChannels() || len(serving) == 0) {
+			err := merr.WrapErrChannelNotAvailable(resp.GetBlockedChannels()[0], "shard blocked by operators")
+			log.Warn("failed to GetShardLeaders", zap.Strings("blockedChannels", resp.GetBlockedChannels()), zap.Error(err))
+			resp.Status = merr.Status(err)
+			return resp
+		}
+		channels = serving
+	}
+
+	// wait until all channels have readable leaders if wait timeout is positive,
+	// fallback to the configured one if the request sets none, the negative one opts out of waiting
+	waitTimeout := req.GetWaitTimeout()
+	if waitTimeout == 0 {
+		waitTimeout = Params.QueryCoordCfg.ShardLeaderWaitTimeout.GetAsInt64()
+	}
+	if waitTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(waitTimeout)*time.Millisecond)
+		defer cancel()
+	}
+	if req.GetWithReadableReplicaCount() {
+		defer func() {
+			resp.ReadableReplicaCount = s.countReadableReplicas(req.GetCollectionID(), channels)
+		}()
+	}
+	for {
+		// watch before checking, to avoid missing the updates during checking
+		updated := s.dist.LeaderViewManager.Watch()
+		resp.RoutingGeneration = s.dist.LeaderViewManager.GetRoutingGeneration()
+		viewGeneration := s.dist.LeaderViewManager.GetViewGeneration()
+		if shards, ok := s.getCachedShardLeaderList(req, channels, resp.GetRoutingGeneration()); ok {
+			resp.Shards = shards
+			return resp
+		}
+		shards, unavailable, err := s.getShardLeaderList(ctx, req, channels)
+		if err == nil {
+			s.cacheShardLeaderList(req, channels, resp.GetRoutingGeneration(), viewGeneration, shards)
+			resp.Shards = shards
+			return resp
+		}
+		if waitTimeout <= 0 {
+			resp.Status = merr.Status(err)
+			resp.UnavailableChannels = unavailable
+			return resp
+		}
+
+		select {
+		case <-updated:
+		case <-ctx.Done():
+			log.Warn("wait for shard leaders timeout", zap.Strings("unavailableChannels", unavailable))
+			resp.Status = merr.Status(err)
+			resp.Shards = shards
+			resp.UnavailableChannels = unavailable
+			return resp
+		}
+	}
+}
+
+// countReadableReplicas returns the number of replicas of the collection,
+// in which every one of the given channels has an available leader.
+func (s *Server) countReadableReplicas(collectionID int64, channels map[string]*meta.DmChannel) int32 {
+	currentTargets := s.targetMgr.GetSealedSegmentsByCollection(collectionID, meta.CurrentTarget)
+	count := int32(0)
+	for _, replica := range s.meta.ReplicaManager.GetByCollection(collectionID) {
+		if s.isReplicaReadable(replica, channels, currentTargets) {
+			count++
+		}
+	}
+	return count
+}
+
+// isReplicaReadable checks whether every one of the given channels has an available leader in the replica,
+// the missing segments are tolerated the same as routing queries.
+func (s *Server) isReplicaReadable(replica *meta.Replica, channels map[string]*meta.DmChannel, currentTargets map[int64]*datapb.SegmentInfo) bool {
+	tolerance := checkers.NewLeaderAvailabilityTolerance()
+	for name := range channels {
+		leaders := s.dist.LeaderViewManager.GetByFilter(meta.WithChannelName2LeaderView(name), meta.WithReplica2LeaderView(replica))
+		available := lo.ContainsBy(leaders, func(leader *meta.LeaderView) bool {
+			return checkers.CheckLeaderAvailableWithTolerance(s.nodeMgr, leader, currentTargets, tolerance) == nil
+		})
+		if !available {
+			return false
+		}
+	}
+	return true
+}
+
+// getShardLeaderList returns the readable shard leaders of the given channels,
+// and the channels without any readable leader, the error is for the first unavailable channel.
+func (s *Server) getShardLeaderList(ctx context.Context, req *querypb.GetShardLeadersRequest, channels map[string]*meta.DmChannel) ([]*querypb.ShardLeadersList, []string, error) {
+	log := log.Ctx(ctx).WithRateGroup("qcv2.GetShardLeaders", 1, 60).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+	)
+
+	var (
+		firstErr    error
+		shards      = make([]*querypb.ShardLeadersList, 0, len(channels))
+		unavailable = make([]string, 0)
+	)
+	currentTargets := s.targetMgr.GetSealedSegmentsByCollection(req.GetCollectionID(), meta.CurrentTarget)
+	tolerance := checkers.NewLeaderAvailabilityTolerance()
+	var pinnedReplica *meta.Replica
+	if req.GetReplicaID() > 0 {
+		pinnedReplica = s.meta.ReplicaManager.Get(req.GetReplicaID())
+	}
+	for _, channel := range channels {
+		log := log.With(zap.String("channel", channel.GetChannelName()))
+
+		leaders := s.dist.LeaderViewManager.GetByFilter(meta.WithChannelName2LeaderView(channel.GetChannelName()))
+
+		readableLeaders := make(map[int64]*meta.LeaderView)
+		standbyLeaders := make(map[int64]*meta.LeaderView)
+
+		var channelErr error
+		if len(leaders) == 0 {
+			channelErr = merr.WrapErrChannelLack(channel.GetChannelName(), "channel not subscribed")
+		}
+
+		for _, leader := range leaders {
+			if req.GetResourceGroup() != "" && !s.meta.ResourceManager.ContainsNode(req.GetResourceGroup(), leader.ID) {
+				multierr.AppendInto(&channelErr, fmt.Errorf("leader %d is not in resource group %s", leader.ID, req.GetResourceGroup()))
+				continue
+			}
+			if req.GetReplicaID() > 0 && (pinnedReplica == nil || !pinnedReplica.Contains(leader.ID)) {
+				multierr.AppendInto(&channelErr, fmt.Errorf("leader %d is not in replica %d", leader.ID, req.GetReplicaID()))
+				continue
+			}
+			if err := checkers.CheckLeaderAvailableWithTolerance(s.nodeMgr, leader, currentTargets, tolerance); err != nil {
+				multierr.AppendInto(&channelErr, err)
+				continue
+			}
+
+			if replica := s.meta.ReplicaManager.GetByCollectionAndNode(req.GetCollectionID(), leader.ID); replica != nil && replica.IsStandby() {
+				standbyLeaders[leader.ID] = leader
+				continue
+			}
+			readableLeaders[leader.ID] = leader
+		}
+
+		// standby replica only serves when all primary replicas are unavailable
+		if len(readableLeaders) == 0 {
+			readableLeaders = standbyLeaders
+		}
+
+		// count the replicas before the duplicated leaders of a replica are collapsed
+		readableReplicaCount := 0
+		if req.GetWithReadableReplicaCount() {
+			readableReplicaCount = countReplicas(s.meta.ReplicaManager, readableLeaders)
+		}
+		readableLeaderNum := len(readableLeaders)
+		readableLeaders = filterDupLeaders(s.meta.ReplicaManager, readableLeaders)
+		infos := make([]*session.NodeInfo, 0, len(readableLeaders))
+		for _, leader := range readableLeaders {
+			info := s.nodeMgr.Get(leader.ID)
+			if info != nil {
+				infos = append(infos, info)
+			}
+		}
+
+		// to avoid node down during GetShardLeaders
+		if len(infos) == 0 {
+			if channelErr == nil {
+				channelErr = fmt.Errorf("leaders %v are offline", lo.Keys(readableLeaders))
+			}
+			if req.GetReplicaID() > 0 {
+				channelErr = errors.Wrapf(channelErr, "no available leader in replica %d", req.GetReplicaID())
+			}
+			msg := fmt.Sprintf("channel %s is not available in any replica", channel.GetChannelName())
+			log.Warn(msg, zap.Error(channelErr))
+			if firstErr == nil {
+				firstErr = errors.Wrap(merr.WrapErrChannelNotAvailable(channel.GetChannelName()), channelErr.Error())
+			}
+			unavailable = append(unavailable, channel.GetChannelName())
+			continue
+		}
+
+		// only return the leaders in preferred zone, fallback to all zones if none available
+		zoneFallback := false
+		if req.GetPreferZone() != "" {
+			local := lo.Filter(infos, func(info *session.NodeInfo, _ int) bool {
+				return info.Zone() == req.GetPreferZone()
+			})
+			if len(local) > 0 {
+				infos = local
+			} else {
+				zoneFallback = true
+				log.RatedInfo(10, "no leader available in preferred zone, fallback to other zones",
+					zap.String("preferZone", req.GetPreferZone()))
+			}
+		}
+
+		ids := make([]int64, 0, len(infos))
+		addrs := make([]string, 0, len(infos))
+		for _, info := range infos {
+			ids = append(ids, info.ID())
+			addrs = append(addrs, info.Addr())
+		}
+
+		shard := &querypb.ShardLeadersList{
+			ChannelName:       channel.GetChannelName(),
+			NodeIds:           ids,
+			NodeAddrs:         addrs,
+			ZoneFallback:      zoneFallback,
+			ReadableLeaderNum: int32(readableLeaderNum),
+		}
+		if req.GetWithReadableReplicaCount() {
+			shard.ReadableReplicaCount = int32(readableReplicaCount)
+		}
+		if req.GetWithLeaderErrors() {
+			for _, id := range ids {
+				if e, ok := s.nodeServingErrors.Get(id); ok {
+					shard.LeaderErrors = append(shard.LeaderErrors, &querypb.LeaderServingError{
+						NodeID:    id,
+						Reason:    e.reason,
+						Timestamp: e.timestamp.UnixMilli(),
+					})
+				}
+			}
+		}
+		if req.GetWithGrowingFreshness() {
+			shard.GrowingRowNums = make([]int64, 0, len(ids))
+			shard.GrowingTimestamps = make([]uint64, 0, len(ids))
+			for _, id := range ids {
+				rowNum, ts := getGrowingFreshness(readableLeaders[id])
+				shard.GrowingRowNums = append(shard.GrowingRowNums, rowNum)
+				shard.GrowingTimestamps = append(shard.GrowingTimestamps, ts)
+			}
+		}
+		shards = append(shards, shard)
+	}
+	return shards, unavailable, firstErr
+}
+
+// shardLeaderCacheKey identifies the shard leaders computed for the same request.
+type shardLeaderCacheKey struct {
+	collectionID             int64
+	resourceGroup            string
+	preferZone               string
+	withReadableReplicaCount bool
+	replicaID                int64
+	// the sorted names of the serving channels
+	channels string
+}
+
+// shardLeaderCacheEntry is the shard leaders computed with the given routing generation, leader view generation and target version.
+type shardLeaderCacheEntry struct {
+	shards         []*querypb.ShardLeadersList
+	generation     int64
+	viewGeneration int64
+	targetVersion  int64
+	cachedAt       time.Time
+	refreshing     *atomic.Bool
+}
+
+// newShardLeaderCacheKey returns the cache key of the request, false if the request is not cacheable,
+// the leader errors and growing freshness change too frequently to be cached.
+func newShardLeaderCacheKey(req *querypb.GetShardLeadersRequest, channels map[string]*meta.DmChannel) (shardLeaderCacheKey, bool) {
+	if paramtable.Get().QueryCoordCfg.ShardLeaderCacheTTL.GetAsInt() <= 0 ||
+		req.GetWithLeaderErrors() || req.GetWithGrowingFreshness() {
+		return shardLeaderCacheKey{}, false
+	}
+	names := lo.Keys(channels)
+	sort.Strings(names)
+	return shardLeaderCacheKey{
+		collectionID:             req.GetCollectionID(),
+		resourceGroup:            req.GetResourceGroup(),
+		preferZone:               req.GetPreferZone(),
+		withReadableReplicaCount: req.GetWithReadableReplicaCount(),
+		replicaID:                req.GetReplicaID(),
+		channels:                 strings.Join(names, ","),
+	}, true
+}
+
+// getCachedShardLeaderList returns the cached shard leaders if they are still valid.
+// The cache is invalidated once the routing generation, the leader view generation or the current target changes,
+// or any of the cached leaders goes offline or stopping, so the client won't be routed to a dead node.
+// The entry cached longer than the TTL is still served, but refreshed asynchronously.
+func (s *Server) getCachedShardLeaderList(req *querypb.GetShardLeadersRequest, channels map[string]*meta.DmChannel, generation int64) ([]*querypb.ShardLeadersList, bool) {
+	key, ok := newShardLeaderCacheKey(req, channels)
+	if !ok {
+		return nil, false
+	}
+	entry, ok := s.shardLeaderCache.Get(key)
+	if !ok {
+		return nil, false
+	}
+	if entry.generation != generation ||
+		entry.viewGeneration != s.dist.LeaderViewManager.GetViewGeneration() ||
+		entry.targetVersion != s.targetMgr.GetCollectionTargetVersion(req.GetCollectionID(), meta.CurrentTarget) ||
+		!s.isShardLeadersServing(entry.shards) {
+		s.shardLeaderCache.Remove(key)
+		return nil, false
+	}
+
+	ttl := paramtable.Get().QueryCoordCfg.ShardLeaderCacheTTL.GetAsDuration(time.Millisecond)
+	if time.Since(entry.cachedAt) > ttl && entry.refreshing.CompareAndSwap(false, true) {
+		go func() {
+			generation := s.dist.LeaderViewManager.GetRoutingGeneration()
+			viewGeneration := s.dist.LeaderViewManager.GetViewGeneration()
+			shards, _, err := s.getShardLeaderList(s.ctx, req, channels)
+			if err != nil {
+				// the next request will compute the shard leaders again
+				s.shardLeaderCache.Remove(key)
+				return
+			}
+			s.cacheShardLeaderList(req, channels, generation, viewGeneration, shards)
+		}()
+	}
+	return entry.shards, true
+}
+
+// cacheShardLeaderList caches the shard leaders computed with the given routing generation and leader view generation.
+func (s *Server) cacheShardLeaderList(req *querypb.GetShardLeadersRequest, channels map[string]*meta.DmChannel, generation int64, viewGeneration int64, shards []*querypb.ShardLeadersList) {
+	key, ok := newShardLeaderCacheKey(req, channels)
+	if !ok {
+		return
+	}
+	s.shardLeaderCache.Insert(key, &shardLeaderCacheEntry{
+		shards:         shards,
+		generation:     generation,
+		viewGeneration: viewGeneration,
+		targetVersion:  s.targetMgr.GetCollectionTargetVersion(req.GetCollectionID(), meta.CurrentTarget),
+		cachedAt:       time.Now(),
+		refreshing:     atomic.NewBool(false),
+	})
+}
+
+// invalidateShardLeaderCache removes all the cached shard leaders of the collection.
+func (s *Server) invalidateShardLeaderCache(collectionID int64) {
+	s.shardLeaderCache.Range(func(key shardLeaderCacheKey, _ *shardLeaderCacheEntry) bool {
+		if key.collectionID == collectionID {
+			s.shardLeaderCache.Remove(key)
+		}
+		return true
+	})
+}
+
+// isShardLeadersServing checks whether all the given shard leaders are still online and not stopping,
+// the node state changes without changing the leader views.
+func (s *Server) isShardLeadersServing(shards []*querypb.ShardLeadersList) bool {
+	for _, shard := range shards {
+		for _, id := range shard.GetNodeIds() {
+			info := s.nodeMgr.Get(id)
+			if info == nil || info.IsStoppingState() {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// getGrowingFreshness returns the growing row number of the leader,
+// and the latest start timestamp of its growing segments, which is zero if there is no growing segment.
+func getGrowingFreshness(leader *meta.LeaderView) (int64, uint64) {
+	var ts uint64
+	for _, segment := range leader.GrowingSegments {
+		if segment.GetStartPosition().GetTimestamp() > ts {
+			ts = segment.GetStartPosition().GetTimestamp()
+		}
+	}
+	return leader.NumOfGrowingRows, ts
+}
+
+// getShardsAtRisk returns the shards which will have no available leader once the given node stopped,
+// the node may serve a shard as the leader or as a worker holding its sealed segments.
+// The shards which are already unavailable are not counted.
+func (s *Server) getShardsAtRisk(nodeID int64) []*querypb.ShardAtRisk {
+	type shard struct {
+		collectionID int64
+		channel      string
+	}
+	servedBy := func(leader *meta.LeaderView, nodeID int64) bool {
+		return leader.ID == nodeID || lo.SomeBy(lo.Values(leader.Segments), func(dist *querypb.SegmentDist) bool {
+			return dist.GetNodeID() == nodeID
+		})
+	}
+
+	affected := typeutil.NewSet[shard]()
+	for _, leader := range s.dist.LeaderViewManager.GetByFilter() {
+		if servedBy(leader, nodeID) {
+			affected.Insert(shard{leader.CollectionID, leader.Channel})
+		}
+	}
+
+	ret := make([]*querypb.ShardAtRisk, 0)
+	for shard := range affected {
+		currentTargets := s.targetMgr.GetSealedSegmentsByCollection(shard.collectionID, meta.CurrentTarget)
+		available, remaining := 0, 0
+		for _, leader := range s.dist.LeaderViewManager.GetByFilter(meta.WithChannelName2LeaderView(shard.channel)) {
+			if checkers.CheckLeaderAvailable(s.nodeMgr, leader, currentTargets) != nil {
+				continue
+			}
+			available++
+			if !servedBy(leader, nodeID) {
+				remaining++
+			}
+		}
+		if available > 0 && remaining == 0 {
+			ret = append(ret, &querypb.ShardAtRisk{
+				CollectionID: shard.collectionID,
+				Channel:      shard.channel,
+			})
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].GetCollectionID() != ret[j].GetCollectionID() {
+			return ret[i].GetCollectionID() < ret[j].GetCollectionID()
+		}
+		return ret[i].GetChannel() < ret[j].GetChannel()
+	})
+	return ret
+}
+
+// getHandoffLag returns the sealed segments of the next target not served by each shard leader yet,
+// which are usually produced by compaction and handed off to query nodes.
+func (s *Server) getHandoffLag(collectionID int64) []*querypb.ShardHandoffLag {
+	nextTargetVersion := s.targetMgr.GetCollectionTargetVersion(collectionID, meta.NextTarget)
+	leaders := s.dist.LeaderViewManager.GetByFilter(meta.WithCollectionID2LeaderView(collectionID))
+	ret := make([]*querypb.ShardHandoffLag, 0, len(leaders))
+	for _, leader := range leaders {
+		lag := &querypb.ShardHandoffLag{
+			Channel:           leader.Channel,
+			LeaderID:          leader.ID,
+			PendingSegmentIDs: make([]int64, 0),
+		}
+		if replica := s.meta.ReplicaManager.GetByCollectionAndNode(collectionID, leader.ID); replica != nil {
+			lag.ReplicaID = replica.GetID()
+		}
+		for segmentID := range s.targetMgr.GetSealedSegmentsByChannel(collectionID, leader.Channel, meta.NextTarget) {
+			if _, ok := leader.Segments[segmentID]; !ok {
+				lag.PendingSegmentIDs = append(lag.PendingSegmentIDs, segmentID)
+			}
+		}
+		// the target version is the time when the next target was pulled
+		if len(lag.PendingSegmentIDs) > 0 && nextTargetVersion > 0 {
+			lag.StalenessMs = time.Since(time.Unix(0, nextTargetVersion)).Milliseconds()
+		}
+		sort.Slice(lag.PendingSegmentIDs, func(i, j int) bool {
+			return lag.PendingSegmentIDs[i] < lag.PendingSegmentIDs[j]
+		})
+		ret = append(ret, lag)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].GetChannel() != ret[j].GetChannel() {
+			return ret[i].GetChannel() < ret[j].GetChannel()
+		}
+		return ret[i].GetLeaderID() < ret[j].GetLeaderID()
+	})
+	return ret
+}
+
+// getTargetSnapshot returns the version, sealed segments and channels of the collection target in the given scope.
+func (s *Server) getTargetSnapshot(collectionID int64, scope meta.TargetScope) *querypb.TargetSnapshot {
+	segmentIDs := lo.Keys(s.targetMgr.GetSealedSegmentsByCollection(collectionID, scope))
+	sort.Slice(segmentIDs, func(i, j int) bool {
+		return segmentIDs[i] < segmentIDs[j]
+	})
+	channels := lo.Keys(s.targetMgr.GetDmChannelsByCollection(collectionID, scope))
+	sort.Strings(channels)
+	return &querypb.TargetSnapshot{
+		Version:          s.targetMgr.GetCollectionTargetVersion(collectionID, scope),
+		SealedSegmentIDs: segmentIDs,
+		Channels:         channels,
+	}
+}
+
+// estimateLoadMemory estimates the memory of the sealed segments to load, in bytes.
+func (s *Server) estimateLoadMemory(ctx context.Context, collectionID int64, partitionIDs []int64, replicaNumber int32) (uint64, error) {
+	_, segments, err := s.broker.GetRecoveryInfoV2(ctx, collectionID, partitionIDs...)
+	if err != nil {
+		return 0, err
+	}
+	size := int64(0)
+	for _, segment := range segments {
+		size += utils.GetSegmentSize(segment)
+	}
+	if replicaNumber <= 0 {
+		replicaNumber = 1
+	}
+	return uint64(size) * uint64(replicaNumber), nil
+}
+
+// checkLoadMemoryAdmission checks whether the cluster keeps enough memory headroom after the load,
+// the nodes which fail to report memory are not counted, and the load is admitted if no node reports memory.
+func (s *Server) checkLoadMemoryAdmission(ctx context.Context, collectionID int64, partitionIDs []int64, replicaNumber int32) error {
+	ratio := paramtable.Get().QueryCoordCfg.LoadMemoryHeadroomRatio.GetAsFloat()
+	if ratio <= 0 {
+		return nil
+	}
+
+	estimated, err := s.estimateLoadMemory(ctx, collectionID, partitionIDs, replicaNumber)
+	if err != nil {
+		return err
+	}
+
+	var total, used uint64
+	for _, node := range s.nodeMgr.GetAll() {
+		if node.IsStoppingState() {
+			continue
+		}
+		capacity, usage, err := utils.GetNodeMemory(ctx, s.cluster, node.ID())
+		if err != nil {
+			log.Ctx(ctx).Warn("failed to get memory of query node", zap.Int64("nodeID", node.ID()), zap.Error(err))
+			continue
+		}
+		total += capacity
+		used += usage
+	}
+	if total == 0 {
+		log.Ctx(ctx).Warn("no query node reports memory, skip load memory admission", zap.Int64("collectionID", collectionID))
+		return nil
+	}
+
+	limit := float64(total) * (1 - ratio)
+	if predict := float64(used + estimated); predict > limit {
+		return merr.WrapErrServiceMemoryLimitExceeded(float32(predict), float32(limit),
+			fmt.Sprintf("loading collection %d needs %d bytes, which exceeds the memory headroom of the cluster", collectionID, estimated))
+	}
+	return nil
+}
+
+// loadMemoryAdmission returns the admission of the load job,
+// the job waits in the job scheduler until the memory headroom is enough if auto retry is set.
+func (s *Server) loadMemoryAdmission(collectionID int64, partitionIDs []int64, replicaNumber int32, autoRetry bool) job.Admission {
+	return func(ctx context.Context) (time.Duration, error) {
+		err := s.checkLoadMemoryAdmission(ctx, collectionID, partitionIDs, replicaNumber)
+		if err != nil && autoRetry && errors.Is(err, merr.ErrServiceMemoryLimitExceeded) {
+			return paramtable.Get().QueryCoordCfg.LoadAdmissionRetryInterval.GetAsDuration(time.Second), err
+		}
+		return 0, err
+	}
+}
+
+func (s *Server) getNodeDistNum(nodeID int64) (segmentNum int, channelNum int) {
+	segments := s.dist.SegmentDistManager.GetByFilter(meta.WithNodeID(nodeID))
+	channels := s.dist.ChannelDistManager.GetByFilter(meta.WithNodeID2Channel(nodeID))
+	return len(segments), len(channels)
+}
+
+// startDecommission marks the node stopping, then the segments and channels on it are moved to the other nodes
+// of the same replicas by stopping balance. The decommission in progress is kept as it is.
+// The decommission is persisted, so it goes on after failover.
+func (s *Server) startDecommission(nodeID int64) (*nodeDecommission, error) {
+	if decommission, ok := s.decommissions.Get(nodeID); ok {
+		if stopping, _ := s.nodeMgr.IsStoppingNode(nodeID); stopping {
+			return decommission, nil
+		}
+	}
+
+	segmentNum, channelNum := s.getNodeDistNum(nodeID)
+	err := s.store.SaveNodeDecommission(&querypb.NodeDecommission{
+		NodeID:        nodeID,
+		TotalSegments: int64(segmentNum),
+		TotalChannels: int64(channelNum),
+		StartTime:     time.Now().UnixMilli(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	decommission := &nodeDecommission{
+		segmentNum: segmentNum,
+		channelNum: channelNum,
+	}
+	s.decommissions.Insert(nodeID, decommission)
+	s.nodeMgr.Stopping(nodeID)
+	s.checkerController.Check()
+	return decommission, nil
+}
+
+// recoverDecommissions restores the decommissions persisted before failover, the registered nodes are marked
+// stopping again to go on draining, the decommissions of the nodes gone are dropped.
+func (s *Server) recoverDecommissions() error {
+	decommissions, err := s.store.GetNodeDecommissions()
+	if err != nil {
+		return err
+	}
+	for _, decommission := range decommissions {
+		nodeID := decommission.GetNodeID()
+		if s.nodeMgr.Get(nodeID) == nil {
+			s.removeDecommission(nodeID)
+			continue
+		}
+		s.decommissions.Insert(nodeID, &nodeDecommission{
+			segmentNum: int(decommission.GetTotalSegments()),
+			channelNum: int(decommission.GetTotalChannels()),
+		})
+		s.nodeMgr.Stopping(nodeID)
+		log.Info("recover node decommission", zap.Int64("nodeID", nodeID),
+			zap.Time("startTime", time.UnixMilli(decommission.GetStartTime())))
+	}
+	return nil
+}
+
+// removeDecommission drops the decommission of the node gone.
+func (s *Server) removeDecommission(nodeID int64) {
+	s.decommissions.Remove(nodeID)
+	if err := s.store.RemoveNodeDecommission(nodeID); err != nil {
+		log.Warn("failed to remove node decommission", zap.Int64("nodeID", nodeID), zap.Error(err))
+	}
+}
+
+// getDecommissionProgress returns the progress of the node decommission,
+// the decommission is aborted if the node comes back healthy, e.g. it registers again.
+func (s *Server) getDecommissionProgress(nodeID int64, decommission *nodeDecommission) *querypb.DecommissionProgress {
+	segmentNum, channelNum := s.getNodeDistNum(nodeID)
+	progress := &querypb.DecommissionProgress{
+		NodeID:            nodeID,
+		State:             querypb.DecommissionState_DecommissionDraining,
+		TotalSegments:     int64(decommission.segmentNum),
+		RemainingSegments: int64(segmentNum),
+		TotalChannels:     int64(decommission.channelNum),
+		RemainingChannels: int64(channelNum),
+	}
+	if node := s.nodeMgr.Get(nodeID); node != nil && !node.IsStoppingState() {
+		progress.State = querypb.DecommissionState_DecommissionAborted
+	} else if segmentNum+channelNum == 0 {
+		progress.State = querypb.DecommissionState_DecommissionCompleted
+	}
+	return progress
+}
+
+// waitDecommission waits until the node is drained or the decommission is aborted,
+// returns the latest progress if the context is done.
+func (s *Server) waitDecommission(ctx context.Context, nodeID int64, decommission *nodeDecommission) *querypb.DecommissionProgress {
+	ticker := time.NewTicker(Params.QueryCoordCfg.DistPullInterval.GetAsDuration(time.Millisecond))
+	defer ticker.Stop()
+	for {
+		progress := s.getDecommissionProgress(nodeID, decommission)
+		if progress.GetState() != querypb.DecommissionState_DecommissionDraining {
+			return progress
+		}
+		select {
+		case <-ctx.Done():
+			return progress
+		case <-ticker.C:
+		}
+	}
+}
+
+// getQueryNodeDistribution collects the distribution of the node from dist managers,
+// the segments, channels and leader views are sorted for stable output.
+func (s *Server) getQueryNodeDistribution(nodeID int64) *querypb.GetQueryNodeDistributionResponse {
+	segments := s.dist.SegmentDistManager.GetByFilter(meta.WithNodeID(nodeID))
+	sort.Slice(segments, func(i, j int) bool { return segments[i].GetID() < segments[j].GetID() })
+	channels := s.dist.ChannelDistManager.GetByFilter(meta.WithNodeID2Channel(nodeID))
+	sort.Slice(channels, func(i, j int) bool { return channels[i].GetChannelName() < channels[j].GetChannelName() })
+	views := s.dist.LeaderViewManager.GetByFilter(meta.WithNodeID2LeaderView(nodeID))
+	sort.Slice(views, func(i, j int) bool { return views[i].Channel < views[j].Channel })
+
+	return &querypb.GetQueryNodeDistributionResponse{
+		Status:           merr.Success(),
+		ID:               nodeID,
+		ChannelNames:     lo.Map(channels, func(c *meta.DmChannel, _ int) string { return c.GetChannelName() }),
+		SealedSegmentIDs: lo.Map(segments, func(s *meta.Segment, _ int) int64 { return s.GetID() }),
+		Segments: lo.Map(segments, func(segment *meta.Segment, _ int) *querypb.SegmentVersionInfo {
+			return &querypb.SegmentVersionInfo{
+				ID:                 segment.GetID(),
+				Collection:         segment.GetCollectionID(),
+				Partition:          segment.GetPartitionID(),
+				Channel:            segment.GetInsertChannel(),
+				Version:            segment.Version,
+				LastDeltaTimestamp: segment.LastDeltaTimestamp,
+				IndexInfo:          segment.IndexInfo,
+			}
+		}),
+		Channels: lo.Map(channels, func(channel *meta.DmChannel, _ int) *querypb.ChannelVersionInfo {
+			return &querypb.ChannelVersionInfo{
+				Channel:    channel.GetChannelName(),
+				Collection: channel.GetCollectionID(),
+				Version:    channel.Version,
+			}
+		}),
+		LeaderViews: lo.Map(views, func(view *meta.LeaderView, _ int) *querypb.LeaderView {
+			growingSegmentIDs := lo.Keys(view.GrowingSegments)
+			sort.Slice(growingSegmentIDs, func(i, j int) bool { return growingSegmentIDs[i] < growingSegmentIDs[j] })
+			growingSegments := make(map[int64]*msgpb.MsgPosition, len(view.GrowingSegments))
+			for id, segment := range view.GrowingSegments {
+				growingSegments[id] = segment.GetStartPosition()
+			}
+			return &querypb.LeaderView{
+				Collection:        view.CollectionID,
+				Channel:           view.Channel,
+				SegmentDist:       view.Segments,
+				GrowingSegmentIDs: growingSegmentIDs,
+				GrowingSegments:   growingSegments,
+				TargetVersion:     view.TargetVersion,
+				NumOfGrowingRows:  view.NumOfGrowingRows,
+			}
+		}),
+	}
+}
+
+// newPartitionStates returns the state of the partition with its load percentage.
+func newPartitionStates(partitionID int64, loadPercentage int32) *querypb.PartitionStates {
+	state := querypb.PartitionState_PartialInMemory
+	if loadPercentage >= 100 {
+		state = querypb.PartitionState_InMemory
+	}
+	return &querypb.PartitionStates{
+		PartitionID:        partitionID,
+		State:              state,
+		InMemoryPercentage: int64(loadPercentage),
+	}
+}
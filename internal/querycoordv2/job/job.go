@@ -18,6 +18,10 @@ package job
 
 import (
 	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/atomic"
 )
 
 // Job is request of loading/releasing collection/partitions,
@@ -39,19 +43,37 @@ type Job interface {
 	SetError(err error)
 	Done()
 	Wait() error
+	// Cancel signals the job to abort with the given reason,
+	// the job not started yet will be skipped
+	Cancel(err error)
+	// CancelErr returns the reason if the job is canceled, nil otherwise
+	CancelErr() error
+	// Admit checks whether the job could be executed now, it's called before PreExecute(),
+	// the job goes back to the wait queue and is checked again after the returned delay if the delay is positive,
+	// the error is the reason to wait then, or the job fails with the returned error otherwise
+	Admit() (time.Duration, error)
 }
 
+// Admission checks whether the job could be executed now, see Job.Admit()
+type Admission func(ctx context.Context) (time.Duration, error)
+
 type BaseJob struct {
 	ctx          context.Context
+	cancel       context.CancelCauseFunc
+	cancelErr    *atomic.Error
 	msgID        int64
 	collectionID int64
 	err          error
 	doneCh       chan struct{}
+	admission    Admission
 }
 
 func NewBaseJob(ctx context.Context, msgID, collectionID int64) *BaseJob {
+	ctx, cancel := context.WithCancelCause(ctx)
 	return &BaseJob{
 		ctx:          ctx,
+		cancel:       cancel,
+		cancelErr:    atomic.NewError(nil),
 		msgID:        msgID,
 		collectionID: collectionID,
 		doneCh:       make(chan struct{}),
@@ -82,11 +104,44 @@ func (job *BaseJob) Done() {
 	close(job.doneCh)
 }
 
+// Wait waits for the job done, returns the cancel reason if the job is aborted by Cancel
 func (job *BaseJob) Wait() error {
 	<-job.doneCh
+	if job.err != nil {
+		if cancelErr := job.CancelErr(); cancelErr != nil {
+			return cancelErr
+		}
+	}
 	return job.err
 }
 
+func (job *BaseJob) Cancel(err error) {
+	if job.cancelErr.CompareAndSwap(nil, err) {
+		job.cancel(err)
+	}
+}
+
+func (job *BaseJob) CancelErr() error {
+	return job.cancelErr.Load()
+}
+
+// SetAdmission sets the admission of the job, the job is admitted at once if not set
+func (job *BaseJob) SetAdmission(admission Admission) {
+	job.admission = admission
+}
+
+// Admit checks the admission of the job, the job stops waiting for admission once the context is done
+func (job *BaseJob) Admit() (time.Duration, error) {
+	if job.admission == nil {
+		return 0, nil
+	}
+	delay, err := job.admission(job.ctx)
+	if delay > 0 && job.ctx.Err() != nil {
+		return 0, errors.Wrap(err, context.Cause(job.ctx).Error())
+	}
+	return delay, err
+}
+
 func (job *BaseJob) PreExecute() error {
 	return nil
 }
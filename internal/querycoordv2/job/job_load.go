@@ -85,10 +85,14 @@ func (job *LoadCollectionJob) PreExecute() error {
 	req := job.req
 	log := log.Ctx(job.ctx).With(zap.Int64("collectionID", req.GetCollectionID()))
 
+	// the zero replica number is resolved by the server before the job created
 	if req.GetReplicaNumber() <= 0 {
-		log.Info("request doesn't indicate the number of replicas, set it to 1",
-			zap.Int32("replicaNumber", req.GetReplicaNumber()))
-		req.ReplicaNumber = 1
+		return merr.WrapErrParameterInvalidMsg("replica number %d should be positive", req.GetReplicaNumber())
+	}
+
+	if req.GetStandbyReplicaNumber() < 0 || req.GetStandbyReplicaNumber() >= req.GetReplicaNumber() {
+		return merr.WrapErrParameterInvalidRange(0, req.GetReplicaNumber()-1, req.GetStandbyReplicaNumber(),
+			"at least one replica should be primary replica")
 	}
 
 	collection := job.meta.GetCollection(req.GetCollectionID())
@@ -96,26 +100,96 @@ func (job *LoadCollectionJob) PreExecute() error {
 		return nil
 	}
 
-	if collection.GetReplicaNumber() != req.GetReplicaNumber() {
+	if collection.GetReplicaNumber() > req.GetReplicaNumber() {
+		msg := fmt.Sprintf("collection with more replicas %d existed, release this collection first before decreasing its replica number",
+			collection.GetReplicaNumber(),
+		)
+		log.Warn(msg)
+		return merr.WrapErrParameterInvalid(collection.GetReplicaNumber(), req.GetReplicaNumber(), "can't decrease the replica number for loaded collection, release it first")
+	} else if collection.GetReplicaNumber() < req.GetReplicaNumber() && collection.GetLoadType() != querypb.LoadType_LoadCollection {
 		msg := fmt.Sprintf("collection with different replica number %d existed, release this collection first before changing its replica number",
-			job.meta.GetReplicaNumber(req.GetCollectionID()),
+			collection.GetReplicaNumber(),
 		)
 		log.Warn(msg)
 		return merr.WrapErrParameterInvalid(collection.GetReplicaNumber(), req.GetReplicaNumber(), "can't change the replica number for loaded collection")
-	} else if !typeutil.MapEqual(collection.GetFieldIndexID(), req.GetFieldIndexID()) {
+	}
+
+	return checkLoadConfig(job.ctx, collection, req)
+}
+
+// checkLoadConfig checks whether the load config of the request is the same as the loaded collection's.
+func checkLoadConfig(ctx context.Context, collection *meta.Collection, req *querypb.LoadCollectionRequest) error {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", req.GetCollectionID()))
+
+	if !typeutil.MapEqual(collection.GetFieldIndexID(), req.GetFieldIndexID()) {
 		msg := fmt.Sprintf("collection with different index %v existed, release this collection first before changing its index",
 			collection.GetFieldIndexID())
 		log.Warn(msg)
 		return merr.WrapErrParameterInvalid(collection.GetFieldIndexID(), req.GetFieldIndexID(), "can't change the index for loaded collection")
+	} else if collection.GetTenant() != req.GetTenant() {
+		msg := fmt.Sprintf("collection with different tenant %s existed, release this collection first before changing its tenant",
+			collection.GetTenant())
+		log.Warn(msg)
+		return merr.WrapErrParameterInvalid(collection.GetTenant(), req.GetTenant(), "can't change the tenant for loaded collection")
+	} else if collection.GetZonePlacement() != req.GetZonePlacement() {
+		msg := fmt.Sprintf("collection with different zone placement %s existed, release this collection first before changing its zone placement",
+			collection.GetZonePlacement())
+		log.Warn(msg)
+		return merr.WrapErrParameterInvalid(collection.GetZonePlacement(), req.GetZonePlacement(), "can't change the zone placement for loaded collection")
+	} else if collection.GetBestEffort() != req.GetBestEffort() {
+		msg := fmt.Sprintf("collection with different best effort %t existed, release this collection first before changing its best effort",
+			collection.GetBestEffort())
+		log.Warn(msg)
+		return merr.WrapErrParameterInvalid(collection.GetBestEffort(), req.GetBestEffort(), "can't change the best effort for loaded collection")
+	} else if collection.GetPriority() != req.GetPriority() {
+		msg := fmt.Sprintf("collection with different priority %d existed, release this collection first before changing its priority",
+			collection.GetPriority())
+		log.Warn(msg)
+		return merr.WrapErrParameterInvalid(collection.GetPriority(), req.GetPriority(), "can't change the priority for loaded collection")
+	} else if req.GetBalancePolicy() != "" && collection.GetBalancePolicy() != req.GetBalancePolicy() {
+		msg := fmt.Sprintf("collection with different balance policy %s existed, use SetBalancePolicy to change its balance policy",
+			collection.GetBalancePolicy())
+		log.Warn(msg)
+		return merr.WrapErrParameterInvalid(collection.GetBalancePolicy(), req.GetBalancePolicy(), "can't change the balance policy by loading collection")
 	}
 
 	return nil
 }
 
+// IsCollectionLoadUnchanged returns true if all partitions of the collection have been loaded
+// by a collection load with the same replica number and load config as the request,
+// the load job would do nothing then. The error is returned if the request changes the load config.
+func IsCollectionLoadUnchanged(ctx context.Context, m *meta.Meta, broker meta.Broker, req *querypb.LoadCollectionRequest) (bool, error) {
+	collection := m.CollectionManager.GetCollection(req.GetCollectionID())
+	if collection == nil ||
+		collection.GetLoadType() != querypb.LoadType_LoadCollection ||
+		collection.GetReplicaNumber() != req.GetReplicaNumber() {
+		return false, nil
+	}
+	if err := checkLoadConfig(ctx, collection, req); err != nil {
+		return false, err
+	}
+	if len(req.GetSegmentNodeHints()) > 0 && !typeutil.MapEqual(collection.GetSegmentNodeHints(), req.GetSegmentNodeHints()) {
+		return false, nil
+	}
+
+	partitionIDs, err := broker.GetPartitions(ctx, req.GetCollectionID())
+	if err != nil {
+		return false, err
+	}
+	for _, partitionID := range partitionIDs {
+		if m.CollectionManager.GetPartition(partitionID) == nil {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 func (job *LoadCollectionJob) Execute() error {
 	req := job.req
 	log := log.Ctx(job.ctx).With(zap.Int64("collectionID", req.GetCollectionID()))
 	meta.GlobalFailedLoadCache.Remove(req.GetCollectionID())
+	job.dist.UnmarkForceReleased(req.GetCollectionID())
 
 	// 1. Fetch target partitions
 	partitionIDs, err := job.broker.GetPartitions(job.ctx, req.GetCollectionID())
@@ -131,14 +205,32 @@ func (job *LoadCollectionJob) Execute() error {
 	lackPartitionIDs := lo.FilterMap(partitionIDs, func(partID int64, _ int) (int64, bool) {
 		return partID, !lo.Contains(loadedPartitionIDs, partID)
 	})
+	oldCollection := job.meta.CollectionManager.GetCollection(req.GetCollectionID())
+	if oldCollection != nil && oldCollection.GetReplicaNumber() < req.GetReplicaNumber() {
+		if err := job.increaseReplicas(oldCollection); err != nil {
+			return err
+		}
+		oldCollection = job.meta.CollectionManager.GetCollection(req.GetCollectionID())
+	}
+	if oldCollection != nil && len(req.GetSegmentNodeHints()) > 0 &&
+		!typeutil.MapEqual(oldCollection.GetSegmentNodeHints(), req.GetSegmentNodeHints()) {
+		if err := job.updateSegmentNodeHints(oldCollection); err != nil {
+			return err
+		}
+		oldCollection = job.meta.CollectionManager.GetCollection(req.GetCollectionID())
+	}
 	if len(lackPartitionIDs) == 0 {
+		// all partitions have been loaded by partition loads, the collection load supersedes them
+		if oldCollection != nil && oldCollection.GetLoadType() == querypb.LoadType_LoadPartition {
+			return job.supersedePartitionLoad(oldCollection)
+		}
 		return nil
 	}
 	job.undo.CollectionID = req.GetCollectionID()
 	job.undo.LackPartitions = lackPartitionIDs
 	log.Info("find partitions to load", zap.Int64s("partitions", lackPartitionIDs))
 
-	colExisted := job.meta.CollectionManager.Exist(req.GetCollectionID())
+	colExisted := oldCollection != nil
 	if !colExisted {
 		// Clear stale replicas, https://github.com/milvus-io/milvus/issues/20444
 		err = job.meta.ReplicaManager.RemoveCollection(req.GetCollectionID())
@@ -154,7 +246,17 @@ func (job *LoadCollectionJob) Execute() error {
 	if len(replicas) == 0 {
 		// API of LoadCollection is wired, we should use map[resourceGroupNames]replicaNumber as input, to keep consistency with `TransferReplica` API.
 		// Then we can implement dynamic replica changed in different resource group independently.
-		replicas, err = utils.SpawnReplicasWithRG(job.meta, req.GetCollectionID(), req.GetResourceGroups(), req.GetReplicaNumber())
+		if req.GetBestEffort() {
+			replicas, err = utils.SpawnReplicasWithRGBestEffort(job.meta, req.GetCollectionID(), req.GetTenant(), req.GetResourceGroups(), req.GetReplicaNumber())
+			if err == nil && len(replicas) == 0 {
+				err = meta.ErrNodeNotEnough
+			}
+		} else if req.GetTenant() != "" {
+			// the replicas of other tenants can't take the nodes between the isolation check and the spawn
+			replicas, err = utils.SpawnTenantReplicasWithRG(job.meta, req.GetCollectionID(), req.GetTenant(), req.GetResourceGroups(), req.GetReplicaNumber())
+		} else {
+			replicas, err = utils.SpawnReplicasWithRG(job.meta, req.GetCollectionID(), req.GetResourceGroups(), req.GetReplicaNumber())
+		}
 		if err != nil {
 			msg := "failed to spawn replica for collection"
 			log.Warn(msg, zap.Error(err))
@@ -164,7 +266,22 @@ func (job *LoadCollectionJob) Execute() error {
 			log.Info("replica created", zap.Int64("replicaID", replica.GetID()),
 				zap.Int64s("nodes", replica.GetNodes()), zap.String("resourceGroup", replica.GetResourceGroup()))
 		}
+		if len(replicas) < int(req.GetReplicaNumber()) {
+			log.Warn("resource group can't hold all replicas, collection is loaded as degraded",
+				zap.Int("spawnedReplicaNum", len(replicas)),
+				zap.Int32("replicaNumber", req.GetReplicaNumber()))
+		}
 		job.undo.IsReplicaCreated = true
+
+		if req.GetStandbyReplicaNumber() > 0 {
+			standbyIDs, err := utils.MarkStandbyReplicas(job.meta, req.GetCollectionID(), req.GetStandbyReplicaNumber())
+			if err != nil {
+				msg := "failed to mark standby replicas"
+				log.Warn(msg, zap.Error(err))
+				return errors.Wrap(err, msg)
+			}
+			log.Info("standby replicas marked", zap.Int64s("replicaIDs", standbyIDs))
+		}
 	}
 
 	// 3. loadPartitions on QueryNodes
@@ -172,6 +289,11 @@ func (job *LoadCollectionJob) Execute() error {
 	if err != nil {
 		return err
 	}
+	// the load may be canceled during loading partitions, don't persist meta then, let rollback clean up
+	if err := job.CancelErr(); err != nil {
+		log.Info("load canceled", zap.Error(err))
+		return err
+	}
 
 	// 4. put collection/partitions meta
 	partitions := lo.Map(lackPartitionIDs, func(partID int64, _ int) *meta.Partition {
@@ -190,22 +312,59 @@ func (job *LoadCollectionJob) Execute() error {
 	ctx, sp := otel.Tracer(typeutil.QueryCoordRole).Start(job.ctx, "LoadCollection", trace.WithNewRoot())
 	collection := &meta.Collection{
 		CollectionLoadInfo: &querypb.CollectionLoadInfo{
-			CollectionID:  req.GetCollectionID(),
-			ReplicaNumber: req.GetReplicaNumber(),
-			Status:        querypb.LoadStatus_Loading,
-			FieldIndexID:  req.GetFieldIndexID(),
-			LoadType:      querypb.LoadType_LoadCollection,
+			CollectionID:         req.GetCollectionID(),
+			ReplicaNumber:        req.GetReplicaNumber(),
+			Status:               querypb.LoadStatus_Loading,
+			FieldIndexID:         req.GetFieldIndexID(),
+			LoadType:             querypb.LoadType_LoadCollection,
+			BestEffort:           req.GetBestEffort(),
+			ResourceGroups:       req.GetResourceGroups(),
+			Priority:             req.GetPriority(),
+			Tenant:               req.GetTenant(),
+			ZonePlacement:        req.GetZonePlacement(),
+			BalancePolicy:        req.GetBalancePolicy(),
+			SegmentNodeHints:     req.GetSegmentNodeHints(),
+			StandbyReplicaNumber: req.GetStandbyReplicaNumber(),
 		},
 		CreatedAt: time.Now(),
 		LoadSpan:  sp,
 	}
-	job.undo.IsNewCollection = true
+	if colExisted {
+		// the collection loaded by partition loads is superseded, keep the loaded partitions on rollback
+		log.Info("collection load supersedes the partition loads", zap.Int64s("loadedPartitions", loadedPartitionIDs))
+		if job.undo.OldCollection == nil {
+			job.undo.OldCollection = oldCollection
+		}
+		collection.BalanceExcluded = oldCollection.GetBalanceExcluded()
+		if len(collection.GetSegmentNodeHints()) == 0 {
+			collection.SegmentNodeHints = oldCollection.GetSegmentNodeHints()
+		}
+		if collection.GetBalancePolicy() == "" {
+			collection.BalancePolicy = oldCollection.GetBalancePolicy()
+		}
+	} else {
+		job.undo.IsNewCollection = true
+	}
 	err = job.meta.CollectionManager.PutCollection(collection, partitions...)
 	if err != nil {
 		msg := "failed to store collection and partitions"
 		log.Warn(msg, zap.Error(err))
 		return errors.Wrap(err, msg)
 	}
+	if req.GetZonePlacement() != querypb.ZonePlacementPolicy_ZonePlacementNone && job.undo.IsReplicaCreated {
+		// replicas are spawned before the zone placement is known by meta,
+		// new replicas have loaded nothing yet, release their nodes to place them again by zones
+		for _, replica := range job.meta.ReplicaManager.GetByCollection(req.GetCollectionID()) {
+			if err := job.meta.ReplicaManager.RemoveNode(replica.GetID(), replica.GetNodes()...); err != nil {
+				log.Warn("failed to release nodes of replica", zap.Int64("replicaID", replica.GetID()), zap.Error(err))
+			}
+		}
+	}
+	if req.GetTenant() != "" || req.GetZonePlacement() != querypb.ZonePlacementPolicy_ZonePlacementNone {
+		// replicas are spawned before the tenant and zone placement are known by meta,
+		// move out nodes occupied by other tenants and place nodes by zones
+		utils.RecoverReplicaOfCollection(job.meta, req.GetCollectionID())
+	}
 	eventlog.Record(eventlog.NewRawEvt(eventlog.Level_Info, fmt.Sprintf("Start load collection %d", collection.CollectionID)))
 	metrics.QueryCoordNumPartitions.WithLabelValues().Add(float64(len(partitions)))
 
@@ -223,6 +382,86 @@ func (job *LoadCollectionJob) Execute() error {
 	return nil
 }
 
+// increaseReplicas spawns more replicas for the loaded collection to reach the requested replica number,
+// the new replicas are loaded by the checkers then.
+func (job *LoadCollectionJob) increaseReplicas(collection *meta.Collection) error {
+	req := job.req
+	log := log.Ctx(job.ctx).With(zap.Int64("collectionID", req.GetCollectionID()))
+
+	resourceGroups := req.GetResourceGroups()
+	if len(resourceGroups) == 0 {
+		resourceGroups = collection.GetResourceGroups()
+	}
+	// the new replicas are isolated from other tenants as the spawned ones
+	replicas, err := utils.SpawnMoreReplicasWithRG(job.meta, req.GetCollectionID(), resourceGroups, req.GetReplicaNumber())
+	if err != nil {
+		msg := "failed to spawn more replicas for collection"
+		log.Warn(msg, zap.Int32("replicaNumber", req.GetReplicaNumber()), zap.Error(err))
+		return errors.Wrap(err, msg)
+	}
+	// the spawned replicas and the replica number are restored if the load fails later
+	job.undo.CollectionID = req.GetCollectionID()
+	job.undo.OldCollection = collection
+	job.undo.SpawnedReplicas = lo.Map(replicas, func(replica *meta.Replica, _ int) int64 { return replica.GetID() })
+
+	newCollection := collection.Clone()
+	newCollection.ReplicaNumber = req.GetReplicaNumber()
+	newCollection.ResourceGroups = resourceGroups
+	partitions := lo.Map(job.meta.CollectionManager.GetPartitionsByCollection(req.GetCollectionID()),
+		func(partition *meta.Partition, _ int) *meta.Partition {
+			partition = partition.Clone()
+			partition.ReplicaNumber = req.GetReplicaNumber()
+			return partition
+		})
+	if err := job.meta.CollectionManager.PutCollection(newCollection, partitions...); err != nil {
+		msg := "failed to update replica number of collection"
+		log.Warn(msg, zap.Error(err))
+		return errors.Wrap(err, msg)
+	}
+	log.Info("increase replicas of loaded collection",
+		zap.Int32("oldReplicaNumber", collection.GetReplicaNumber()),
+		zap.Int32("replicaNumber", req.GetReplicaNumber()),
+		zap.Int64s("newReplicas", job.undo.SpawnedReplicas))
+	return nil
+}
+
+// updateSegmentNodeHints replaces the segment node hints of the loaded collection with the requested ones,
+// the segments loaded already stay until the balancer moves them.
+func (job *LoadCollectionJob) updateSegmentNodeHints(collection *meta.Collection) error {
+	req := job.req
+	log := log.Ctx(job.ctx).With(zap.Int64("collectionID", req.GetCollectionID()))
+
+	// the hints are restored if the load fails later
+	job.undo.CollectionID = req.GetCollectionID()
+	if job.undo.OldCollection == nil {
+		job.undo.OldCollection = collection
+	}
+	newCollection := collection.Clone()
+	newCollection.SegmentNodeHints = req.GetSegmentNodeHints()
+	if err := job.meta.CollectionManager.PutCollection(newCollection); err != nil {
+		msg := "failed to update segment node hints of collection"
+		log.Warn(msg, zap.Error(err))
+		return errors.Wrap(err, msg)
+	}
+	log.Info("update segment node hints of loaded collection", zap.Int("hintNum", len(req.GetSegmentNodeHints())))
+	return nil
+}
+
+// supersedePartitionLoad turns the collection loaded by partition loads into loaded by collection load,
+// the conflicting load config has been rejected by PreExecute.
+func (job *LoadCollectionJob) supersedePartitionLoad(old *meta.Collection) error {
+	req := job.req
+	collection := old.Clone()
+	collection.LoadType = querypb.LoadType_LoadCollection
+	if err := job.meta.CollectionManager.PutCollection(collection); err != nil {
+		msg := "failed to store collection"
+		log.Ctx(job.ctx).Warn(msg, zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
+		return errors.Wrap(err, msg)
+	}
+	log.Ctx(job.ctx).Info("collection load supersedes the partition loads", zap.Int64("collectionID", req.GetCollectionID()))
+	return nil
+}
+
 func (job *LoadCollectionJob) PostExecute() {
 	if job.Error() != nil {
 		job.undo.RollBack()
@@ -275,10 +514,9 @@ func (job *LoadPartitionJob) PreExecute() error {
 	req := job.req
 	log := log.Ctx(job.ctx).With(zap.Int64("collectionID", req.GetCollectionID()))
 
+	// the zero replica number is resolved by the server before the job created
 	if req.GetReplicaNumber() <= 0 {
-		log.Info("request doesn't indicate the number of replicas, set it to 1",
-			zap.Int32("replicaNumber", req.GetReplicaNumber()))
-		req.ReplicaNumber = 1
+		return merr.WrapErrParameterInvalidMsg("replica number %d should be positive", req.GetReplicaNumber())
 	}
 
 	collection := job.meta.GetCollection(req.GetCollectionID())
@@ -295,6 +533,11 @@ func (job *LoadPartitionJob) PreExecute() error {
 			job.meta.GetFieldIndex(req.GetCollectionID()))
 		log.Warn(msg)
 		return merr.WrapErrParameterInvalid(collection.GetFieldIndexID(), req.GetFieldIndexID(), "can't change the index for loaded partitions")
+	} else if req.GetPriority() != DefaultJobPriority && collection.GetPriority() != req.GetPriority() {
+		msg := fmt.Sprintf("collection with different priority %d existed, release this collection first before changing its priority",
+			collection.GetPriority())
+		log.Warn(msg)
+		return merr.WrapErrParameterInvalid(collection.GetPriority(), req.GetPriority(), "can't change the priority for loaded partitions")
 	}
 
 	return nil
@@ -307,6 +550,7 @@ func (job *LoadPartitionJob) Execute() error {
 		zap.Int64s("partitionIDs", req.GetPartitionIDs()),
 	)
 	meta.GlobalFailedLoadCache.Remove(req.GetCollectionID())
+	job.dist.UnmarkForceReleased(req.GetCollectionID())
 
 	// 1. Fetch target partitions
 	loadedPartitionIDs := lo.Map(job.meta.CollectionManager.GetPartitionsByCollection(req.GetCollectionID()),
@@ -355,6 +599,11 @@ func (job *LoadPartitionJob) Execute() error {
 	if err != nil {
 		return err
 	}
+	// the load may be canceled during loading partitions, don't persist meta then, let rollback clean up
+	if err := job.CancelErr(); err != nil {
+		log.Info("load canceled", zap.Error(err))
+		return err
+	}
 
 	// 4. put collection/partitions meta
 	partitions := lo.Map(lackPartitionIDs, func(partID int64, _ int) *meta.Partition {
@@ -380,6 +629,7 @@ func (job *LoadPartitionJob) Execute() error {
 				Status:        querypb.LoadStatus_Loading,
 				FieldIndexID:  req.GetFieldIndexID(),
 				LoadType:      querypb.LoadType_LoadPartition,
+				Priority:      req.GetPriority(),
 			},
 			CreatedAt: time.Now(),
 			LoadSpan:  sp,
@@ -18,6 +18,7 @@ package job
 
 import (
 	"context"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
@@ -28,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	"github.com/milvus-io/milvus/internal/querycoordv2/observers"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 )
@@ -76,6 +78,11 @@ func (job *ReleaseCollectionJob) Execute() error {
 		return nil
 	}
 
+	var deadline time.Time
+	if timeout := req.GetForceReleaseTimeoutMs(); timeout > 0 {
+		deadline = time.Now().Add(time.Duration(timeout) * time.Millisecond)
+	}
+
 	loadedPartitions := job.meta.CollectionManager.GetPartitionsByCollection(req.GetCollectionID())
 	toRelease := lo.Map(loadedPartitions, func(partition *meta.Partition, _ int) int64 {
 		return partition.GetPartitionID()
@@ -94,14 +101,30 @@ func (job *ReleaseCollectionJob) Execute() error {
 		msg := "failed to remove replicas"
 		log.Warn(msg, zap.Error(err))
 	}
+	err = job.meta.BalanceLayoutManager.RemoveLayout(req.GetCollectionID())
+	if err != nil {
+		log.Warn("failed to remove balance layout", zap.Error(err))
+	}
+	err = job.meta.LoadCheckpointManager.RemoveLoadCheckpoint(req.GetCollectionID())
+	if err != nil {
+		log.Warn("failed to remove load checkpoint", zap.Error(err))
+	}
+	err = job.meta.ShardBlockManager.UnblockCollectionShards(req.GetCollectionID())
+	if err != nil {
+		log.Warn("failed to remove shard blocks", zap.Error(err))
+	}
 
 	job.targetMgr.RemoveCollection(req.GetCollectionID())
 	job.targetObserver.ReleaseCollection(req.GetCollectionID())
-	waitCollectionReleased(job.dist, job.checkerController, req.GetCollectionID())
+	meta.GlobalLoadThroughputRecorder.Remove(req.GetCollectionID())
+	if !waitCollectionReleasedUntil(job.dist, job.checkerController, deadline, req.GetCollectionID()) {
+		// some nodes haven't released the collection before the deadline, don't let them block the release forever
+		forceCollectionReleased(job.ctx, job.dist, req.GetCollectionID())
+	}
 	metrics.QueryCoordNumCollections.WithLabelValues().Dec()
 	metrics.QueryCoordNumPartitions.WithLabelValues().Sub(float64(len(toRelease)))
-	metrics.QueryCoordReleaseCount.WithLabelValues(metrics.TotalLabel).Inc()
-	metrics.QueryCoordReleaseCount.WithLabelValues(metrics.SuccessLabel).Inc()
+	metrics.QueryCoordReleaseCount.WithLabelValues(metrics.TotalLabel, utils.CollectionMetricsLabel(req.GetCollectionID())).Inc()
+	metrics.QueryCoordReleaseCount.WithLabelValues(metrics.SuccessLabel, utils.CollectionMetricsLabel(req.GetCollectionID())).Inc()
 	return nil
 }
 
@@ -178,8 +201,21 @@ func (job *ReleasePartitionJob) Execute() error {
 		if err != nil {
 			log.Warn("failed to remove replicas", zap.Error(err))
 		}
+		err = job.meta.BalanceLayoutManager.RemoveLayout(req.GetCollectionID())
+		if err != nil {
+			log.Warn("failed to remove balance layout", zap.Error(err))
+		}
+		err = job.meta.LoadCheckpointManager.RemoveLoadCheckpoint(req.GetCollectionID())
+		if err != nil {
+			log.Warn("failed to remove load checkpoint", zap.Error(err))
+		}
+		err = job.meta.ShardBlockManager.UnblockCollectionShards(req.GetCollectionID())
+		if err != nil {
+			log.Warn("failed to remove shard blocks", zap.Error(err))
+		}
 		job.targetMgr.RemoveCollection(req.GetCollectionID())
 		job.targetObserver.ReleaseCollection(req.GetCollectionID())
+		meta.GlobalLoadThroughputRecorder.Remove(req.GetCollectionID())
 		metrics.QueryCoordNumCollections.WithLabelValues().Dec()
 		waitCollectionReleased(job.dist, job.checkerController, req.GetCollectionID())
 	} else {
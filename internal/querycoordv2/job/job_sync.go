@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/proto/querypb"
@@ -29,12 +30,18 @@ import (
 	"github.com/milvus-io/milvus/pkg/log"
 )
 
+// SyncNewCreatedPartitionJob loads the new created partitions of a collection loaded by LoadCollection,
+// the partitions are synced one by one if loading them at once fails, so the failed ones could be told.
 type SyncNewCreatedPartitionJob struct {
 	*BaseJob
-	req     *querypb.SyncNewCreatedPartitionRequest
-	meta    *meta.Meta
-	cluster session.Cluster
-	broker  meta.Broker
+	collectionID int64
+	partitionIDs []int64
+	meta         *meta.Meta
+	cluster      session.Cluster
+	broker       meta.Broker
+
+	synced   []int64
+	failures map[int64]error
 }
 
 func NewSyncNewCreatedPartitionJob(
@@ -45,11 +52,31 @@ func NewSyncNewCreatedPartitionJob(
 	broker meta.Broker,
 ) *SyncNewCreatedPartitionJob {
 	return &SyncNewCreatedPartitionJob{
-		BaseJob: NewBaseJob(ctx, req.Base.GetMsgID(), req.GetCollectionID()),
-		req:     req,
-		meta:    meta,
-		cluster: cluster,
-		broker:  broker,
+		BaseJob:      NewBaseJob(ctx, req.Base.GetMsgID(), req.GetCollectionID()),
+		collectionID: req.GetCollectionID(),
+		partitionIDs: []int64{req.GetPartitionID()},
+		meta:         meta,
+		cluster:      cluster,
+		broker:       broker,
+		failures:     make(map[int64]error),
+	}
+}
+
+func NewSyncNewCreatedPartitionsJob(
+	ctx context.Context,
+	req *querypb.SyncNewCreatedPartitionsRequest,
+	meta *meta.Meta,
+	cluster session.Cluster,
+	broker meta.Broker,
+) *SyncNewCreatedPartitionJob {
+	return &SyncNewCreatedPartitionJob{
+		BaseJob:      NewBaseJob(ctx, req.Base.GetMsgID(), req.GetCollectionID()),
+		collectionID: req.GetCollectionID(),
+		partitionIDs: lo.Uniq(req.GetPartitionIDs()),
+		meta:         meta,
+		cluster:      cluster,
+		broker:       broker,
+		failures:     make(map[int64]error),
 	}
 }
 
@@ -57,44 +84,90 @@ func (job *SyncNewCreatedPartitionJob) PreExecute() error {
 	return nil
 }
 
+// Execute fails only if none of the partitions is synced,
+// use SyncedPartitions and FailedPartitions to get the result of each partition.
 func (job *SyncNewCreatedPartitionJob) Execute() error {
-	req := job.req
 	log := log.Ctx(job.ctx).With(
-		zap.Int64("collectionID", req.GetCollectionID()),
-		zap.Int64("partitionID", req.GetPartitionID()),
+		zap.Int64("collectionID", job.collectionID),
+		zap.Int64s("partitionIDs", job.partitionIDs),
 	)
 
 	// check if collection not load or loadType is loadPartition
-	collection := job.meta.GetCollection(job.req.GetCollectionID())
+	collection := job.meta.GetCollection(job.collectionID)
 	if collection == nil || collection.GetLoadType() == querypb.LoadType_LoadPartition {
+		job.synced = job.partitionIDs
 		return nil
 	}
 
 	// check if partition already existed
-	if partition := job.meta.GetPartition(job.req.GetPartitionID()); partition != nil {
+	toSync := make([]int64, 0, len(job.partitionIDs))
+	for _, partitionID := range job.partitionIDs {
+		if partition := job.meta.GetPartition(partitionID); partition != nil {
+			job.synced = append(job.synced, partitionID)
+			continue
+		}
+		toSync = append(toSync, partitionID)
+	}
+	if len(toSync) == 0 {
 		return nil
 	}
 
-	err := loadPartitions(job.ctx, job.meta, job.cluster, job.broker, false, req.GetCollectionID(), req.GetPartitionID())
-	if err != nil {
-		return err
+	err := loadPartitions(job.ctx, job.meta, job.cluster, job.broker, false, job.collectionID, toSync...)
+	if err != nil && len(toSync) > 1 {
+		log.Warn("failed to sync partitions at once, sync them one by one", zap.Error(err))
+		for _, partitionID := range toSync {
+			job.syncPartition(partitionID, loadPartitions(job.ctx, job.meta, job.cluster, job.broker, false, job.collectionID, partitionID))
+		}
+	} else {
+		for _, partitionID := range toSync {
+			job.syncPartition(partitionID, err)
+		}
+	}
+
+	if len(job.synced) == 0 {
+		return job.failures[toSync[0]]
+	}
+	return nil
+}
+
+// syncPartition stores the partition if it's loaded without error, otherwise records the failure.
+func (job *SyncNewCreatedPartitionJob) syncPartition(partitionID int64, loadErr error) {
+	log := log.Ctx(job.ctx).With(
+		zap.Int64("collectionID", job.collectionID),
+		zap.Int64("partitionID", partitionID),
+	)
+
+	if loadErr != nil {
+		log.Warn("failed to sync new created partition", zap.Error(loadErr))
+		job.failures[partitionID] = loadErr
+		return
 	}
 
 	partition := &meta.Partition{
 		PartitionLoadInfo: &querypb.PartitionLoadInfo{
-			CollectionID: req.GetCollectionID(),
-			PartitionID:  req.GetPartitionID(),
+			CollectionID: job.collectionID,
+			PartitionID:  partitionID,
 			Status:       querypb.LoadStatus_Loaded,
 		},
 		LoadPercentage: 100,
 		CreatedAt:      time.Now(),
 	}
-	err = job.meta.CollectionManager.PutPartition(partition)
+	err := job.meta.CollectionManager.PutPartition(partition)
 	if err != nil {
 		msg := "failed to store partitions"
 		log.Warn(msg, zap.Error(err))
-		return errors.Wrap(err, msg)
+		job.failures[partitionID] = errors.Wrap(err, msg)
+		return
 	}
+	job.synced = append(job.synced, partitionID)
+}
 
-	return nil
+// SyncedPartitions returns the partitions synced or no need to sync, valid after the job is done.
+func (job *SyncNewCreatedPartitionJob) SyncedPartitions() []int64 {
+	return job.synced
+}
+
+// FailedPartitions returns the partitions failed to sync with the errors, valid after the job is done.
+func (job *SyncNewCreatedPartitionJob) FailedPartitions() map[int64]error {
+	return job.failures
 }
@@ -19,12 +19,15 @@ package job
 import (
 	"context"
 	"fmt"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"go.uber.org/atomic"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/rgpb"
 	"github.com/milvus-io/milvus/internal/kv"
@@ -42,6 +45,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/etcd"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 const (
@@ -226,9 +230,8 @@ func (suite *JobSuite) TestLoadCollection() {
 		}
 		// Load with 1 replica
 		req := &querypb.LoadCollectionRequest{
-			CollectionID: collection,
-			// It will be set to 1
-			// ReplicaNumber: 1,
+			CollectionID:  collection,
+			ReplicaNumber: 1,
 		}
 		job := NewLoadCollectionJob(
 			ctx,
@@ -250,7 +253,7 @@ func (suite *JobSuite) TestLoadCollection() {
 		suite.assertCollectionLoaded(collection)
 	}
 
-	// Test load again
+	// Test load with unresolved replica number
 	for _, collection := range suite.collections {
 		if suite.loadTypes[collection] != querypb.LoadType_LoadCollection {
 			continue
@@ -272,17 +275,74 @@ func (suite *JobSuite) TestLoadCollection() {
 		)
 		suite.scheduler.Add(job)
 		err := job.Wait()
+		suite.ErrorIs(err, merr.ErrParameterInvalid)
+	}
+
+	// Test load again
+	for _, collection := range suite.collections {
+		if suite.loadTypes[collection] != querypb.LoadType_LoadCollection {
+			continue
+		}
+		req := &querypb.LoadCollectionRequest{
+			ReplicaNumber: 1,
+			CollectionID:  collection,
+		}
+		job := NewLoadCollectionJob(
+			ctx,
+			req,
+			suite.dist,
+			suite.meta,
+			suite.broker,
+			suite.cluster,
+			suite.targetMgr,
+			suite.targetObserver,
+			suite.collectionObserver,
+			suite.nodeMgr,
+		)
+		suite.scheduler.Add(job)
+		err := job.Wait()
 		suite.NoError(err)
 	}
 
-	// Test load existed collection with different replica number
+	// Test load existed collection with more replicas
 	for _, collection := range suite.collections {
 		if suite.loadTypes[collection] != querypb.LoadType_LoadCollection {
 			continue
 		}
 		req := &querypb.LoadCollectionRequest{
 			CollectionID:  collection,
-			ReplicaNumber: 3,
+			ReplicaNumber: 2,
+		}
+		job := NewLoadCollectionJob(
+			ctx,
+			req,
+			suite.dist,
+			suite.meta,
+			suite.broker,
+			suite.cluster,
+			suite.targetMgr,
+			suite.targetObserver,
+			suite.collectionObserver,
+			suite.nodeMgr,
+		)
+		suite.scheduler.Add(job)
+		err := job.Wait()
+		suite.NoError(err)
+		suite.EqualValues(2, suite.meta.GetReplicaNumber(collection))
+		suite.Len(suite.meta.ReplicaManager.GetByCollection(collection), 2)
+		for _, partition := range suite.meta.GetPartitionsByCollection(collection) {
+			suite.EqualValues(2, partition.GetReplicaNumber())
+		}
+	}
+
+	// Test load existed collection with less replicas
+	for _, collection := range suite.collections {
+		if suite.loadTypes[collection] != querypb.LoadType_LoadCollection {
+			continue
+		}
+		req := &querypb.LoadCollectionRequest{
+			CollectionID:  collection,
+			ReplicaNumber: 1,
 		}
 		job := NewLoadCollectionJob(
 			ctx,
@@ -299,6 +359,7 @@ func (suite *JobSuite) TestLoadCollection() {
 		suite.scheduler.Add(job)
 		err := job.Wait()
 		suite.ErrorIs(err, merr.ErrParameterInvalid)
+		suite.Len(suite.meta.ReplicaManager.GetByCollection(collection), 2)
 	}
 
 	// Test load partition while collection exists
@@ -306,11 +367,10 @@ func (suite *JobSuite) TestLoadCollection() {
 		if suite.loadTypes[collection] != querypb.LoadType_LoadCollection {
 			continue
 		}
-		// Load with 1 replica
 		req := &querypb.LoadPartitionsRequest{
 			CollectionID:  collection,
 			PartitionIDs:  suite.partitions[collection],
-			ReplicaNumber: 1,
+			ReplicaNumber: 2,
 		}
 		job := NewLoadPartitionJob(
 			ctx,
@@ -418,6 +478,236 @@ func (suite *JobSuite) TestLoadCollectionWithReplicas() {
 	}
 }
 
+func (suite *JobSuite) TestLoadCollectionWithSegmentNodeHints() {
+	ctx := context.Background()
+	suite.loadAll()
+
+	newJob := func(req *querypb.LoadCollectionRequest) *LoadCollectionJob {
+		return NewLoadCollectionJob(
+			ctx,
+			req,
+			suite.dist,
+			suite.meta,
+			suite.broker,
+			suite.cluster,
+			suite.targetMgr,
+			suite.targetObserver,
+			suite.collectionObserver,
+			suite.nodeMgr,
+		)
+	}
+	for _, collection := range suite.collections {
+		if suite.loadTypes[collection] != querypb.LoadType_LoadCollection {
+			continue
+		}
+		// reload with hints replaces the hints of the loaded collection
+		hints := map[int64]int64{suite.segments[collection][suite.partitions[collection][0]][0]: 1}
+		req := &querypb.LoadCollectionRequest{
+			CollectionID:     collection,
+			ReplicaNumber:    1,
+			SegmentNodeHints: hints,
+		}
+		unchanged, err := IsCollectionLoadUnchanged(ctx, suite.meta, suite.broker, req)
+		suite.NoError(err)
+		suite.False(unchanged)
+		job := newJob(req)
+		suite.scheduler.Add(job)
+		suite.NoError(job.Wait())
+		suite.Equal(hints, suite.meta.GetCollection(collection).GetSegmentNodeHints())
+
+		// reload without hints keeps them
+		job = newJob(&querypb.LoadCollectionRequest{
+			CollectionID:  collection,
+			ReplicaNumber: 1,
+		})
+		suite.scheduler.Add(job)
+		suite.NoError(job.Wait())
+		suite.Equal(hints, suite.meta.GetCollection(collection).GetSegmentNodeHints())
+	}
+}
+
+func (suite *JobSuite) TestLoadCollectionBestEffort() {
+	ctx := context.Background()
+
+	for _, collection := range suite.collections {
+		if suite.loadTypes[collection] != querypb.LoadType_LoadCollection {
+			continue
+		}
+		// Load with 5 replica, but only 3 nodes available
+		req := &querypb.LoadCollectionRequest{
+			CollectionID:  collection,
+			ReplicaNumber: 5,
+			BestEffort:    true,
+		}
+		job := NewLoadCollectionJob(
+			ctx,
+			req,
+			suite.dist,
+			suite.meta,
+			suite.broker,
+			suite.cluster,
+			suite.targetMgr,
+			suite.targetObserver,
+			suite.collectionObserver,
+			suite.nodeMgr,
+		)
+		suite.scheduler.Add(job)
+		err := job.Wait()
+		suite.NoError(err)
+		suite.EqualValues(5, suite.meta.GetReplicaNumber(collection))
+		suite.Len(suite.meta.ReplicaManager.GetByCollection(collection), 3)
+		suite.True(suite.meta.GetCollection(collection).GetBestEffort())
+	}
+}
+
+func (suite *JobSuite) TestLoadCollectionWithTenant() {
+	ctx := context.Background()
+	newJob := func(collection int64, replicaNumber int32, tenant string) *LoadCollectionJob {
+		return NewLoadCollectionJob(
+			ctx,
+			&querypb.LoadCollectionRequest{
+				CollectionID:  collection,
+				ReplicaNumber: replicaNumber,
+				Tenant:        tenant,
+			},
+			suite.dist,
+			suite.meta,
+			suite.broker,
+			suite.cluster,
+			suite.targetMgr,
+			suite.targetObserver,
+			suite.collectionObserver,
+			suite.nodeMgr,
+		)
+	}
+
+	// the only replica of tenant a holds all 3 nodes
+	job := newJob(1000, 1, "a")
+	suite.scheduler.Add(job)
+	suite.NoError(job.Wait())
+	suite.Equal("a", suite.meta.GetCollection(1000).GetTenant())
+
+	// can't change the tenant of loaded collection
+	job = newJob(1000, 1, "b")
+	suite.scheduler.Add(job)
+	suite.ErrorIs(job.Wait(), merr.ErrParameterInvalid)
+
+	// the replica of tenant a reserves one node only
+	job = newJob(1001, 3, "b")
+	suite.scheduler.Add(job)
+	suite.ErrorContains(job.Wait(), meta.ErrNodeNotEnough.Error())
+	suite.Nil(suite.meta.GetCollection(1001))
+
+	// tenant b shares the resource group with tenant a
+	job = newJob(1001, 2, "b")
+	suite.scheduler.Add(job)
+	suite.NoError(job.Wait())
+	for _, replica := range suite.meta.ReplicaManager.GetByCollection(1001) {
+		suite.Equal("b", replica.GetTenant())
+	}
+}
+
+func (suite *JobSuite) TestLoadCollectionWithZonePlacement() {
+	ctx := context.Background()
+	newJob := func(collection int64, replicaNumber int32, placement querypb.ZonePlacementPolicy) *LoadCollectionJob {
+		return NewLoadCollectionJob(
+			ctx,
+			&querypb.LoadCollectionRequest{
+				CollectionID:  collection,
+				ReplicaNumber: replicaNumber,
+				ZonePlacement: placement,
+			},
+			suite.dist,
+			suite.meta,
+			suite.broker,
+			suite.cluster,
+			suite.targetMgr,
+			suite.targetObserver,
+			suite.collectionObserver,
+			suite.nodeMgr,
+		)
+	}
+
+	zones := map[int64]string{1000: "az1", 2000: "az1", 3000: "az2"}
+	for node, zone := range zones {
+		suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   node,
+			Address:  "localhost",
+			Hostname: "localhost",
+			Labels:   map[string]string{session.ZoneLabel: zone},
+		}))
+	}
+
+	job := newJob(1000, 2, querypb.ZonePlacementPolicy_ZonePlacementSpread)
+	suite.scheduler.Add(job)
+	suite.NoError(job.Wait())
+	suite.Equal(querypb.ZonePlacementPolicy_ZonePlacementSpread, suite.meta.GetCollection(1000).GetZonePlacement())
+	replicas := suite.meta.ReplicaManager.GetByCollection(1000)
+	suite.Len(replicas, 2)
+	for _, replica := range replicas {
+		// nodes of each replica are in different zones
+		nodeZones := lo.Map(replica.GetNodes(), func(node int64, _ int) string { return zones[node] })
+		suite.Len(lo.Uniq(nodeZones), len(nodeZones))
+	}
+
+	// can't change the zone placement of loaded collection
+	job = newJob(1000, 2, querypb.ZonePlacementPolicy_ZonePlacementPack)
+	suite.scheduler.Add(job)
+	suite.ErrorIs(job.Wait(), merr.ErrParameterInvalid)
+}
+
+func (suite *JobSuite) TestCancelLoad() {
+	ctx := context.Background()
+	newJob := func(collection int64) *LoadCollectionJob {
+		return NewLoadCollectionJob(
+			ctx,
+			&querypb.LoadCollectionRequest{
+				CollectionID:  collection,
+				ReplicaNumber: 1,
+			},
+			suite.dist,
+			suite.meta,
+			suite.broker,
+			suite.cluster,
+			suite.targetMgr,
+			suite.targetObserver,
+			suite.collectionObserver,
+			suite.nodeMgr,
+		)
+	}
+
+	// the canceled job is skipped
+	job := newJob(1000)
+	job.Cancel(merr.WrapErrCollectionLoadCanceled(1000))
+	suite.scheduler.Add(job)
+	suite.ErrorIs(job.Wait(), merr.ErrCollectionLoadCanceled)
+	suite.False(suite.meta.Exist(1000))
+
+	// cancel the waiting load jobs only
+	scheduler := NewScheduler()
+	loadJob := newJob(1000)
+	releaseJob := NewReleaseCollectionJob(
+		ctx,
+		&querypb.ReleaseCollectionRequest{CollectionID: 1000},
+		suite.dist,
+		suite.meta,
+		suite.broker,
+		suite.cluster,
+		suite.targetMgr,
+		suite.targetObserver,
+		suite.checkerController,
+	)
+	scheduler.Add(loadJob)
+	scheduler.Add(releaseJob)
+	suite.Equal(1, scheduler.CancelLoad(1000, merr.WrapErrCollectionLoadCanceled(1000)))
+	suite.ErrorIs(loadJob.CancelErr(), merr.ErrCollectionLoadCanceled)
+	suite.ErrorIs(loadJob.Context().Err(), context.Canceled)
+	suite.NoError(releaseJob.CancelErr())
+	// canceled already
+	suite.Equal(0, scheduler.CancelLoad(1000, merr.WrapErrCollectionLoadCanceled(1000)))
+	suite.Equal(0, scheduler.CancelLoad(1001, merr.WrapErrCollectionLoadCanceled(1001)))
+}
+
 func (suite *JobSuite) TestLoadCollectionWithDiffIndex() {
 	ctx := context.Background()
 
@@ -428,7 +718,8 @@ func (suite *JobSuite) TestLoadCollectionWithDiffIndex() {
 		}
 		// Load with 1 replica
 		req := &querypb.LoadCollectionRequest{
-			CollectionID: collection,
+			ReplicaNumber: 1,
+			CollectionID:  collection,
 			FieldIndexID: map[int64]int64{
 				defaultVecFieldID: defaultIndexID,
 			},
@@ -459,7 +750,8 @@ func (suite *JobSuite) TestLoadCollectionWithDiffIndex() {
 			continue
 		}
 		req := &querypb.LoadCollectionRequest{
-			CollectionID: collection,
+			ReplicaNumber: 1,
+			CollectionID:  collection,
 			FieldIndexID: map[int64]int64{
 				defaultVecFieldID: -defaultIndexID,
 			},
@@ -523,9 +815,9 @@ func (suite *JobSuite) TestLoadPartition() {
 		}
 		// Load with 1 replica
 		req := &querypb.LoadPartitionsRequest{
-			CollectionID: collection,
-			PartitionIDs: suite.partitions[collection],
-			// ReplicaNumber: 1,
+			CollectionID:  collection,
+			PartitionIDs:  suite.partitions[collection],
+			ReplicaNumber: 1,
 		}
 		job := NewLoadPartitionJob(
 			ctx,
@@ -572,6 +864,35 @@ func (suite *JobSuite) TestLoadPartition() {
 		suite.ErrorIs(err, merr.ErrParameterInvalid)
 	}
 
+	// Test load partition with different priority
+	for _, collection := range suite.collections {
+		if suite.loadTypes[collection] != querypb.LoadType_LoadPartition {
+			continue
+		}
+
+		req := &querypb.LoadPartitionsRequest{
+			CollectionID:  collection,
+			PartitionIDs:  suite.partitions[collection],
+			ReplicaNumber: 1,
+			Priority:      1,
+		}
+		job := NewLoadPartitionJob(
+			ctx,
+			req,
+			suite.dist,
+			suite.meta,
+			suite.broker,
+			suite.cluster,
+			suite.targetMgr,
+			suite.targetObserver,
+			suite.collectionObserver,
+			suite.nodeMgr,
+		)
+		suite.scheduler.AddWithPriority(job, req.GetPriority())
+		err := job.Wait()
+		suite.ErrorIs(err, merr.ErrParameterInvalid)
+	}
+
 	// Test load partition with more partition
 	for _, collection := range suite.collections {
 		if suite.loadTypes[collection] != querypb.LoadType_LoadPartition {
@@ -800,6 +1121,152 @@ func (suite *JobSuite) TestDynamicLoad() {
 	suite.NoError(err)
 	suite.targetMgr.UpdateCollectionCurrentTarget(collection)
 	suite.assertPartitionLoaded(collection, p2)
+	suite.Equal(querypb.LoadType_LoadCollection, suite.meta.GetLoadType(collection))
+
+	// loaded: col
+	// action: load p0
+	// expect: do nothing, col loaded
+	job = newLoadPartJob(p0)
+	suite.scheduler.Add(job)
+	err = job.Wait()
+	suite.NoError(err)
+	suite.Equal(querypb.LoadType_LoadCollection, suite.meta.GetLoadType(collection))
+
+	// loaded: p0, p1, p2
+	// action: load col
+	// expect: col loaded, the partition loads are superseded
+	suite.releaseAll()
+	job = newLoadPartJob(p0, p1, p2)
+	suite.scheduler.Add(job)
+	err = job.Wait()
+	suite.NoError(err)
+	suite.targetMgr.UpdateCollectionCurrentTarget(collection)
+	suite.Equal(querypb.LoadType_LoadPartition, suite.meta.GetLoadType(collection))
+	// the conflicting load config is rejected
+	for _, modify := range []func(req *querypb.LoadCollectionRequest){
+		func(req *querypb.LoadCollectionRequest) { req.Priority = 1 },
+		func(req *querypb.LoadCollectionRequest) { req.BestEffort = true },
+		func(req *querypb.LoadCollectionRequest) { req.Tenant = "tenant" },
+	} {
+		colJob = newLoadColJob()
+		modify(colJob.req)
+		suite.scheduler.Add(colJob)
+		suite.ErrorIs(colJob.Wait(), merr.ErrParameterInvalid)
+		suite.Equal(querypb.LoadType_LoadPartition, suite.meta.GetLoadType(collection))
+	}
+	colJob = newLoadColJob()
+	suite.scheduler.Add(colJob)
+	err = colJob.Wait()
+	suite.NoError(err)
+	suite.assertPartitionLoaded(collection, p0, p1, p2)
+	suite.Equal(querypb.LoadType_LoadCollection, suite.meta.GetLoadType(collection))
+}
+
+func (suite *JobSuite) TestRollbackSupersededPartitionLoad() {
+	ctx := context.Background()
+
+	collection := suite.collections[0]
+	p0, p1, p2 := suite.partitions[collection][0], suite.partitions[collection][1], suite.partitions[collection][2]
+	req := &querypb.LoadPartitionsRequest{
+		CollectionID:  collection,
+		PartitionIDs:  []int64{p0, p1},
+		ReplicaNumber: 1,
+	}
+	job := NewLoadPartitionJob(
+		ctx,
+		req,
+		suite.dist,
+		suite.meta,
+		suite.broker,
+		suite.cluster,
+		suite.targetMgr,
+		suite.targetObserver,
+		suite.collectionObserver,
+		suite.nodeMgr,
+	)
+	suite.scheduler.Add(job)
+	suite.NoError(job.Wait())
+	oldCollection := suite.meta.GetCollection(collection)
+
+	// the collection load overwrites the collection meta and adds p2, then fails
+	undo := NewUndoList(ctx, suite.meta, suite.cluster, suite.targetMgr, suite.targetObserver)
+	undo.CollectionID = collection
+	undo.LackPartitions = []int64{p2}
+	undo.OldCollection = oldCollection
+	newCollection := oldCollection.Clone()
+	newCollection.LoadType = querypb.LoadType_LoadCollection
+	err := suite.meta.PutCollection(newCollection, &meta.Partition{
+		PartitionLoadInfo: &querypb.PartitionLoadInfo{
+			CollectionID:  collection,
+			PartitionID:   p2,
+			ReplicaNumber: 1,
+		},
+	})
+	suite.NoError(err)
+	suite.Equal(querypb.LoadType_LoadCollection, suite.meta.GetLoadType(collection))
+
+	undo.RollBack()
+	suite.Equal(querypb.LoadType_LoadPartition, suite.meta.GetLoadType(collection))
+	suite.NotNil(suite.meta.GetPartition(p0))
+	suite.NotNil(suite.meta.GetPartition(p1))
+	suite.Nil(suite.meta.GetPartition(p2))
+	suite.releaseAll()
+}
+
+func (suite *JobSuite) TestRollbackIncreasedReplicas() {
+	ctx := context.Background()
+
+	collection := suite.collections[0]
+	req := &querypb.LoadCollectionRequest{
+		CollectionID:  collection,
+		ReplicaNumber: 1,
+	}
+	job := NewLoadCollectionJob(
+		ctx,
+		req,
+		suite.dist,
+		suite.meta,
+		suite.broker,
+		suite.cluster,
+		suite.targetMgr,
+		suite.targetObserver,
+		suite.collectionObserver,
+		suite.nodeMgr,
+	)
+	suite.scheduler.Add(job)
+	suite.NoError(job.Wait())
+	oldCollection := suite.meta.GetCollection(collection)
+	oldReplica := suite.meta.ReplicaManager.GetByCollection(collection)[0].GetID()
+
+	// the collection load increases the replicas, then fails
+	job = NewLoadCollectionJob(
+		ctx,
+		&querypb.LoadCollectionRequest{
+			CollectionID:  collection,
+			ReplicaNumber: 2,
+		},
+		suite.dist,
+		suite.meta,
+		suite.broker,
+		suite.cluster,
+		suite.targetMgr,
+		suite.targetObserver,
+		suite.collectionObserver,
+		suite.nodeMgr,
+	)
+	suite.NoError(job.increaseReplicas(oldCollection))
+	suite.Len(suite.meta.ReplicaManager.GetByCollection(collection), 2)
+	suite.EqualValues(2, suite.meta.GetCollection(collection).GetReplicaNumber())
+
+	job.undo.RollBack()
+	replicas := suite.meta.ReplicaManager.GetByCollection(collection)
+	suite.Len(replicas, 1)
+	suite.Equal(oldReplica, replicas[0].GetID())
+	suite.EqualValues(1, suite.meta.GetCollection(collection).GetReplicaNumber())
+	for _, partition := range suite.meta.GetPartitionsByCollection(collection) {
+		suite.EqualValues(1, partition.GetReplicaNumber())
+	}
+	suite.releaseAll()
 }
 
 func (suite *JobSuite) TestLoadPartitionWithReplicas() {
@@ -844,8 +1311,9 @@ func (suite *JobSuite) TestLoadPartitionWithDiffIndex() {
 		}
 		// Load with 1 replica
 		req := &querypb.LoadPartitionsRequest{
-			CollectionID: collection,
-			PartitionIDs: suite.partitions[collection],
+			ReplicaNumber: 1,
+			CollectionID:  collection,
+			PartitionIDs:  suite.partitions[collection],
 			FieldIndexID: map[int64]int64{
 				defaultVecFieldID: defaultIndexID,
 			},
@@ -877,8 +1345,9 @@ func (suite *JobSuite) TestLoadPartitionWithDiffIndex() {
 		}
 		// Load with 1 replica
 		req := &querypb.LoadPartitionsRequest{
-			CollectionID: collection,
-			PartitionIDs: suite.partitions[collection],
+			ReplicaNumber: 1,
+			CollectionID:  collection,
+			PartitionIDs:  suite.partitions[collection],
 			FieldIndexID: map[int64]int64{
 				defaultVecFieldID: -defaultIndexID,
 			},
@@ -908,6 +1377,7 @@ func (suite *JobSuite) TestReleaseCollection() {
 
 	// Test release collection and partition
 	for _, collection := range suite.collections {
+		meta.GlobalLoadThroughputRecorder.Record(collection, 10, time.Second)
 		req := &querypb.ReleaseCollectionRequest{
 			CollectionID: collection,
 		}
@@ -927,6 +1397,7 @@ func (suite *JobSuite) TestReleaseCollection() {
 		err := job.Wait()
 		suite.NoError(err)
 		suite.assertCollectionReleased(collection)
+		suite.Zero(meta.GlobalLoadThroughputRecorder.Estimate(collection, 10))
 	}
 
 	// Test release again
@@ -952,6 +1423,56 @@ func (suite *JobSuite) TestReleaseCollection() {
 	}
 }
 
+func (suite *JobSuite) TestReleaseCollectionForcibly() {
+	ctx := context.Background()
+
+	suite.loadAll()
+
+	// the node never releases the segment and channel
+	collection := suite.collections[0]
+	channel := suite.channels[collection][0]
+	suite.dist.SegmentDistManager.Update(1000, &meta.Segment{
+		SegmentInfo: &datapb.SegmentInfo{
+			ID:            1,
+			CollectionID:  collection,
+			PartitionID:   suite.partitions[collection][0],
+			InsertChannel: channel,
+		},
+	})
+	suite.dist.ChannelDistManager.Update(1000, meta.DmChannelFromVChannel(&datapb.VchannelInfo{
+		CollectionID: collection,
+		ChannelName:  channel,
+	}))
+	suite.dist.LeaderViewManager.Update(1000, &meta.LeaderView{
+		ID:           1000,
+		CollectionID: collection,
+		Channel:      channel,
+	})
+
+	req := &querypb.ReleaseCollectionRequest{
+		CollectionID:          collection,
+		ForceReleaseTimeoutMs: 100,
+	}
+	job := NewReleaseCollectionJob(
+		ctx,
+		req,
+		suite.dist,
+		suite.meta,
+		suite.broker,
+		suite.cluster,
+		suite.targetMgr,
+		suite.targetObserver,
+		suite.checkerController,
+	)
+	suite.scheduler.Add(job)
+	suite.NoError(job.Wait())
+	suite.assertCollectionReleased(collection)
+	suite.Empty(suite.dist.SegmentDistManager.GetByFilter(meta.WithCollectionID(collection)))
+	suite.Empty(suite.dist.ChannelDistManager.GetByCollectionAndFilter(collection))
+	suite.Empty(suite.dist.LeaderViewManager.GetByFilter(meta.WithCollectionID2LeaderView(collection)))
+	suite.True(suite.dist.IsForceReleased(collection))
+}
+
 func (suite *JobSuite) TestReleasePartition() {
 	ctx := context.Background()
 
@@ -1152,7 +1673,8 @@ func (suite *JobSuite) TestLoadCollectionStoreFailed() {
 		store.EXPECT().ReleaseReplicas(collection).Return(nil)
 
 		req := &querypb.LoadCollectionRequest{
-			CollectionID: collection,
+			ReplicaNumber: 1,
+			CollectionID:  collection,
 		}
 		job := NewLoadCollectionJob(
 			context.Background(),
@@ -1193,8 +1715,9 @@ func (suite *JobSuite) TestLoadPartitionStoreFailed() {
 		store.EXPECT().ReleaseReplicas(collection).Return(nil)
 
 		req := &querypb.LoadPartitionsRequest{
-			CollectionID: collection,
-			PartitionIDs: suite.partitions[collection],
+			ReplicaNumber: 1,
+			CollectionID:  collection,
+			PartitionIDs:  suite.partitions[collection],
 		}
 		job := NewLoadPartitionJob(
 			context.Background(),
@@ -1222,7 +1745,8 @@ func (suite *JobSuite) TestLoadCreateReplicaFailed() {
 			GetPartitions(mock.Anything, collection).
 			Return(suite.partitions[collection], nil)
 		req := &querypb.LoadCollectionRequest{
-			CollectionID: collection,
+			ReplicaNumber: 1,
+			CollectionID:  collection,
 		}
 		job := NewLoadCollectionJob(
 			context.Background(),
@@ -1251,7 +1775,8 @@ func (suite *JobSuite) TestCallLoadPartitionFailed() {
 	for _, collection := range suite.collections {
 		suite.broker.EXPECT().ListIndexes(mock.Anything, collection).Return(nil, getIndexErr)
 		loadCollectionReq := &querypb.LoadCollectionRequest{
-			CollectionID: collection,
+			ReplicaNumber: 1,
+			CollectionID:  collection,
 		}
 		loadCollectionJob := NewLoadCollectionJob(
 			context.Background(),
@@ -1271,8 +1796,9 @@ func (suite *JobSuite) TestCallLoadPartitionFailed() {
 		suite.ErrorIs(err, getIndexErr)
 
 		loadPartitionReq := &querypb.LoadPartitionsRequest{
-			CollectionID: collection,
-			PartitionIDs: suite.partitions[collection],
+			ReplicaNumber: 1,
+			CollectionID:  collection,
+			PartitionIDs:  suite.partitions[collection],
 		}
 		loadPartitionJob := NewLoadPartitionJob(
 			context.Background(),
@@ -1299,7 +1825,8 @@ func (suite *JobSuite) TestCallLoadPartitionFailed() {
 	for _, collection := range suite.collections {
 		suite.broker.EXPECT().DescribeCollection(mock.Anything, collection).Return(nil, getSchemaErr)
 		loadCollectionReq := &querypb.LoadCollectionRequest{
-			CollectionID: collection,
+			ReplicaNumber: 1,
+			CollectionID:  collection,
 		}
 		loadCollectionJob := NewLoadCollectionJob(
 			context.Background(),
@@ -1318,8 +1845,9 @@ func (suite *JobSuite) TestCallLoadPartitionFailed() {
 		suite.ErrorIs(err, getSchemaErr)
 
 		loadPartitionReq := &querypb.LoadPartitionsRequest{
-			CollectionID: collection,
-			PartitionIDs: suite.partitions[collection],
+			ReplicaNumber: 1,
+			CollectionID:  collection,
+			PartitionIDs:  suite.partitions[collection],
 		}
 		loadPartitionJob := NewLoadPartitionJob(
 			context.Background(),
@@ -1457,12 +1985,70 @@ func (suite *JobSuite) TestSyncNewCreatedPartition() {
 	suite.NoError(err)
 }
 
+func (suite *JobSuite) TestSyncNewCreatedPartitions() {
+	suite.loadAll()
+	collection := suite.collections[0]
+	loaded := suite.partitions[collection][0]
+	failed := int64(998)
+
+	loadErr := fmt.Errorf("mock load partitions error")
+	suite.cluster.ExpectedCalls = lo.Filter(suite.cluster.ExpectedCalls, func(call *mock.Call, _ int) bool {
+		return call.Method != "LoadPartitions"
+	})
+	suite.cluster.EXPECT().LoadPartitions(mock.Anything, mock.Anything,
+		mock.MatchedBy(func(req *querypb.LoadPartitionsRequest) bool {
+			return lo.Contains(req.GetPartitionIDs(), failed)
+		})).Return(nil, loadErr)
+	suite.cluster.EXPECT().LoadPartitions(mock.Anything, mock.Anything, mock.Anything).Return(merr.Success(), nil)
+	defer func() {
+		suite.cluster.ExpectedCalls = lo.Filter(suite.cluster.ExpectedCalls, func(call *mock.Call, _ int) bool {
+			return call.Method != "LoadPartitions"
+		})
+		suite.cluster.EXPECT().LoadPartitions(mock.Anything, mock.Anything, mock.Anything).Return(merr.Success(), nil)
+	}()
+
+	// partial failure
+	job := NewSyncNewCreatedPartitionsJob(
+		context.Background(),
+		&querypb.SyncNewCreatedPartitionsRequest{
+			CollectionID: collection,
+			PartitionIDs: []int64{loaded, 997, failed},
+		},
+		suite.meta,
+		suite.cluster,
+		suite.broker,
+	)
+	suite.scheduler.Add(job)
+	suite.NoError(job.Wait())
+	suite.ElementsMatch([]int64{loaded, 997}, job.SyncedPartitions())
+	suite.Len(job.FailedPartitions(), 1)
+	suite.ErrorIs(job.FailedPartitions()[failed], loadErr)
+	suite.NotNil(suite.meta.CollectionManager.GetPartition(997))
+	suite.Nil(suite.meta.CollectionManager.GetPartition(failed))
+
+	// all failed
+	job = NewSyncNewCreatedPartitionsJob(
+		context.Background(),
+		&querypb.SyncNewCreatedPartitionsRequest{
+			CollectionID: collection,
+			PartitionIDs: []int64{failed},
+		},
+		suite.meta,
+		suite.cluster,
+		suite.broker,
+	)
+	suite.scheduler.Add(job)
+	suite.ErrorIs(job.Wait(), loadErr)
+	suite.Empty(job.SyncedPartitions())
+}
+
 func (suite *JobSuite) loadAll() {
 	ctx := context.Background()
 	for _, collection := range suite.collections {
 		if suite.loadTypes[collection] == querypb.LoadType_LoadCollection {
 			req := &querypb.LoadCollectionRequest{
-				CollectionID: collection,
+				ReplicaNumber: 1,
+				CollectionID:  collection,
 			}
 			job := NewLoadCollectionJob(
 				ctx,
@@ -1486,8 +2072,9 @@ func (suite *JobSuite) loadAll() {
 			suite.targetMgr.UpdateCollectionCurrentTarget(collection)
 		} else {
 			req := &querypb.LoadPartitionsRequest{
-				CollectionID: collection,
-				PartitionIDs: suite.partitions[collection],
+				ReplicaNumber: 1,
+				CollectionID:  collection,
+				PartitionIDs:  suite.partitions[collection],
 			}
 			job := NewLoadPartitionJob(
 				ctx,
@@ -1590,6 +2177,192 @@ func (suite *JobSuite) assertPartitionReleased(collection int64, partitionIDs ..
 	}
 }
 
+func (suite *JobSuite) TestSchedulePriority() {
+	ctx := context.Background()
+	now := time.Now()
+	queue := newWaitQueue(waitQueueCap)
+	queue.nowFunc = func() time.Time { return now }
+
+	queue.push(newNopJob(ctx, 1, 1), DefaultJobPriority)
+	queue.push(newNopJob(ctx, 2, 2), 1)
+	queue.push(newNopJob(ctx, 3, 3), DefaultJobPriority)
+	queue.push(newNopJob(ctx, 4, 4), 1)
+	suite.Equal(4, queue.len())
+
+	// higher priority first, FIFO within the same priority
+	msgIDs := make([]int64, 0)
+	running := typeutil.NewSet[int64]()
+	for item := queue.pop(running); item != nil; item = queue.pop(running) {
+		msgIDs = append(msgIDs, item.job.MsgID())
+	}
+	suite.Equal([]int64{2, 4, 1, 3}, msgIDs)
+	suite.Equal(0, queue.len())
+
+	// the waiting job with lower priority ages, not starved by later jobs with higher priority
+	queue.push(newNopJob(ctx, 5, 5), DefaultJobPriority)
+	now = now.Add(jobPriorityAgingInterval / 2)
+	queue.push(newNopJob(ctx, 6, 6), 1)
+	now = now.Add(jobPriorityAgingInterval)
+	queue.push(newNopJob(ctx, 7, 7), 1)
+	msgIDs = msgIDs[:0]
+	for item := queue.pop(running); item != nil; item = queue.pop(running) {
+		msgIDs = append(msgIDs, item.job.MsgID())
+	}
+	suite.Equal([]int64{6, 5, 7}, msgIDs)
+
+	// the jobs of the running collection are skipped, the jobs of the same collection keep FIFO
+	queue.push(newNopJob(ctx, 8, 8), DefaultJobPriority)
+	queue.push(newNopJob(ctx, 9, 9), DefaultJobPriority)
+	queue.push(newNopJob(ctx, 10, 9), 1)
+	running.Insert(8)
+	suite.EqualValues(9, queue.pop(running).job.MsgID())
+	running.Insert(9)
+	suite.Nil(queue.pop(running))
+	running.Clear()
+	suite.EqualValues(10, queue.pop(running).job.MsgID())
+	suite.EqualValues(8, queue.pop(running).job.MsgID())
+
+	// the job not admitted yet keeps its order, and holds the later jobs of the same collection
+	queue.push(newNopJob(ctx, 11, 11), DefaultJobPriority)
+	queue.push(newNopJob(ctx, 12, 11), 1)
+	queue.push(newNopJob(ctx, 13, 13), DefaultJobPriority)
+	item := queue.pop(running)
+	suite.EqualValues(11, item.job.MsgID())
+	queue.requeue(item, time.Second)
+	suite.EqualValues(13, queue.pop(running).job.MsgID())
+	suite.Nil(queue.pop(running))
+	now = now.Add(time.Second)
+	suite.EqualValues(11, queue.pop(running).job.MsgID())
+	suite.EqualValues(12, queue.pop(running).job.MsgID())
+}
+
+type blockingJob struct {
+	*BaseJob
+	started chan struct{}
+	block   chan struct{}
+	order   *[]int64
+	mu      *sync.Mutex
+}
+
+func newBlockingJob(msgID, collectionID int64, order *[]int64, mu *sync.Mutex) *blockingJob {
+	return &blockingJob{
+		BaseJob: NewBaseJob(context.Background(), msgID, collectionID),
+		started: make(chan struct{}),
+		block:   make(chan struct{}),
+		order:   order,
+		mu:      mu,
+	}
+}
+
+func (job *blockingJob) Execute() error {
+	job.mu.Lock()
+	*job.order = append(*job.order, job.MsgID())
+	job.mu.Unlock()
+	close(job.started)
+	<-job.block
+	return nil
+}
+
+func (suite *JobSuite) TestScheduleByPriorityWhenBusy() {
+	scheduler := NewScheduler()
+	scheduler.maxRunning = 1
+	scheduler.Start()
+	defer scheduler.Stop()
+
+	order := make([]int64, 0)
+	mu := &sync.Mutex{}
+	running := newBlockingJob(1, 1, &order, mu)
+	low := newBlockingJob(2, 2, &order, mu)
+	high := newBlockingJob(3, 3, &order, mu)
+	close(low.block)
+	close(high.block)
+
+	scheduler.Add(running)
+	<-running.started
+	// the low priority job waits for the running one, then the high priority job overtakes it
+	scheduler.AddWithPriority(low, DefaultJobPriority)
+	scheduler.AddWithPriority(high, 1)
+	close(running.block)
+
+	suite.NoError(low.Wait())
+	suite.NoError(high.Wait())
+	mu.Lock()
+	defer mu.Unlock()
+	suite.Equal([]int64{1, 3, 2}, order)
+}
+
+func (suite *JobSuite) TestScheduleWithAdmission() {
+	scheduler := NewScheduler()
+	scheduler.maxRunning = 1
+	scheduler.Start()
+	defer scheduler.Stop()
+
+	order := make([]int64, 0)
+	mu := &sync.Mutex{}
+	admitted := atomic.NewBool(false)
+	waiting := newBlockingJob(1, 1, &order, mu)
+	waiting.SetAdmission(func(ctx context.Context) (time.Duration, error) {
+		if !admitted.Load() {
+			return 10 * time.Millisecond, merr.ErrServiceMemoryLimitExceeded
+		}
+		return 0, nil
+	})
+	other := newBlockingJob(2, 2, &order, mu)
+	close(waiting.block)
+	close(other.block)
+
+	// the job not admitted yet doesn't occupy the running slot
+	scheduler.Add(waiting)
+	scheduler.Add(other)
+	suite.NoError(other.Wait())
+	admitted.Store(true)
+	suite.NoError(waiting.Wait())
+	mu.Lock()
+	suite.Equal([]int64{2, 1}, order)
+	mu.Unlock()
+
+	// not admitted
+	rejected := newBlockingJob(3, 1, &order, mu)
+	rejected.SetAdmission(func(ctx context.Context) (time.Duration, error) {
+		return 0, merr.ErrServiceMemoryLimitExceeded
+	})
+	scheduler.Add(rejected)
+	suite.ErrorIs(rejected.Wait(), merr.ErrServiceMemoryLimitExceeded)
+
+	// stop waiting once the context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	canceled := &blockingJob{
+		BaseJob: NewBaseJob(ctx, 4, 1),
+		started: make(chan struct{}),
+		block:   make(chan struct{}),
+		order:   &order,
+		mu:      mu,
+	}
+	canceled.SetAdmission(func(ctx context.Context) (time.Duration, error) {
+		return 10 * time.Millisecond, merr.ErrServiceMemoryLimitExceeded
+	})
+	scheduler.Add(canceled)
+	cancel()
+	err := canceled.Wait()
+	suite.ErrorIs(err, merr.ErrServiceMemoryLimitExceeded)
+	suite.ErrorContains(err, context.Canceled.Error())
+	mu.Lock()
+	suite.Equal([]int64{2, 1}, order)
+	mu.Unlock()
+}
+
 func TestJob(t *testing.T) {
 	suite.Run(t, new(JobSuite))
 }
+
+type nopJob struct {
+	*BaseJob
+}
+
+func newNopJob(ctx context.Context, msgID, collectionID int64) *nopJob {
+	return &nopJob{BaseJob: NewBaseJob(ctx, msgID, collectionID)}
+}
+
+func (job *nopJob) Execute() error {
+	return nil
+}
@@ -17,41 +17,186 @@
 package job
 
 import (
+	"container/heap"
 	"context"
 	"sync"
 	"time"
 
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 // JobScheduler schedules jobs,
 // all jobs within the same collection will run sequentially
 const (
-	collectionQueueCap = 64
-	waitQueueCap       = 512
+	waitQueueCap = 512
+
+	DefaultJobPriority int32 = 0
+	// a waiting job gains one priority level per aging interval,
+	// so jobs with lower priority can't be starved by a stream of jobs with higher priority
+	jobPriorityAgingInterval = 10 * time.Second
 )
 
-type jobQueue chan Job
+type pendingJob struct {
+	job Job
+	seq int64
+	// score is the enqueue time minus the waiting time the priority is worth,
+	// the job with smaller score is scheduled first
+	score int64
+	// the job isn't scheduled before this time, set if the job is not admitted yet
+	notBefore time.Time
+}
+
+// pendingJobHeap orders the waiting jobs by priority, then the submission order,
+// aging is applied by the score, which is fixed after the job enqueued
+// as all waiting jobs age at the same rate
+type pendingJobHeap []*pendingJob
+
+func (h pendingJobHeap) Len() int { return len(h) }
+
+func (h pendingJobHeap) Less(i, j int) bool {
+	if h[i].score != h[j].score {
+		return h[i].score < h[j].score
+	}
+	return h[i].seq < h[j].seq
+}
+
+func (h pendingJobHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *pendingJobHeap) Push(x any) { *h = append(*h, x.(*pendingJob)) }
+
+func (h *pendingJobHeap) Pop() any {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return item
+}
+
+type waitQueue struct {
+	mu      sync.Mutex
+	start   time.Time
+	seq     int64
+	jobs    pendingJobHeap
+	slots   chan struct{} // bounds the waiting jobs
+	notify  chan struct{}
+	nowFunc func() time.Time
+}
+
+func newWaitQueue(capacity int) *waitQueue {
+	return &waitQueue{
+		start:   time.Now(),
+		slots:   make(chan struct{}, capacity),
+		notify:  make(chan struct{}, 1),
+		nowFunc: time.Now,
+	}
+}
+
+// push adds the job into the queue, blocks if the queue is full
+func (queue *waitQueue) push(job Job, priority int32) {
+	queue.slots <- struct{}{}
+
+	queue.mu.Lock()
+	elapsed := queue.nowFunc().Sub(queue.start).Milliseconds()
+	heap.Push(&queue.jobs, &pendingJob{
+		job:   job,
+		seq:   queue.seq,
+		score: elapsed - int64(priority)*jobPriorityAgingInterval.Milliseconds(),
+	})
+	queue.seq++
+	queue.mu.Unlock()
+
+	select {
+	case queue.notify <- struct{}{}:
+	default:
+	}
+}
+
+// requeue puts the job not admitted yet back to the queue, which keeps its order,
+// the job and the later ones of the same collection are not scheduled until the delay passes
+func (queue *waitQueue) requeue(item *pendingJob, delay time.Duration) {
+	queue.slots <- struct{}{}
+
+	queue.mu.Lock()
+	item.notBefore = queue.nowFunc().Add(delay)
+	heap.Push(&queue.jobs, item)
+	queue.mu.Unlock()
+
+	time.AfterFunc(delay, func() {
+		select {
+		case queue.notify <- struct{}{}:
+		default:
+		}
+	})
+}
+
+// pop returns the job to schedule next, nil if no job could be scheduled,
+// the jobs of the running collections are skipped,
+// and the jobs of the same collection are scheduled in the submission order
+func (queue *waitQueue) pop(running typeutil.Set[int64]) *pendingJob {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	// the earliest waiting job of each collection
+	heads := make(map[int64]int)
+	for i, item := range queue.jobs {
+		collectionID := item.job.CollectionID()
+		if head, ok := heads[collectionID]; !ok || item.seq < queue.jobs[head].seq {
+			heads[collectionID] = i
+		}
+	}
+
+	now := queue.nowFunc()
+	next := -1
+	for collectionID, i := range heads {
+		if running.Contain(collectionID) || queue.jobs[i].notBefore.After(now) {
+			continue
+		}
+		if next < 0 || queue.jobs.Less(i, next) {
+			next = i
+		}
+	}
+	if next < 0 {
+		return nil
+	}
+	item := heap.Remove(&queue.jobs, next).(*pendingJob)
+	<-queue.slots
+	return item
+}
+
+func (queue *waitQueue) len() int {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+	return queue.jobs.Len()
+}
 
 type Scheduler struct {
 	cancel context.CancelFunc
 	wg     sync.WaitGroup
 
-	processors *typeutil.ConcurrentSet[int64] // Collections of having processor
-	queues     map[int64]jobQueue             // CollectionID -> Queue
-	waitQueue  jobQueue
+	waitQueue   *waitQueue
+	maxRunning  int                 // the number of jobs running at the same time, the other jobs wait to be scheduled by priority
+	running     typeutil.Set[int64] // Collections of having running job, accessed by the schedule loop only
+	finishedJob chan Job
+
+	jobsMu sync.Mutex
+	jobs   map[int64][]Job // CollectionID -> Jobs not finished
 
 	stopOnce sync.Once
 }
 
 func NewScheduler() *Scheduler {
 	return &Scheduler{
-		processors: typeutil.NewConcurrentSet[int64](),
-		queues:     make(map[int64]jobQueue),
-		waitQueue:  make(jobQueue, waitQueueCap),
+		waitQueue:   newWaitQueue(waitQueueCap),
+		maxRunning:  paramtable.Get().QueryCoordCfg.MaxRunningJobs.GetAsInt(),
+		running:     typeutil.NewSet[int64](),
+		finishedJob: make(chan Job),
+		jobs:        make(map[int64][]Job),
 	}
 }
 
@@ -76,77 +221,121 @@ func (scheduler *Scheduler) Stop() {
 }
 
 func (scheduler *Scheduler) schedule(ctx context.Context) {
-	ticker := time.NewTicker(500 * time.Millisecond)
-	defer ticker.Stop()
 	for {
 		select {
 		case <-ctx.Done():
 			log.Info("JobManager stopped")
-			for _, queue := range scheduler.queues {
-				close(queue)
-			}
 			return
 
-		case job := <-scheduler.waitQueue:
-			queue, ok := scheduler.queues[job.CollectionID()]
-			if !ok {
-				queue = make(jobQueue, collectionQueueCap)
-				scheduler.queues[job.CollectionID()] = queue
+		case <-scheduler.waitQueue.notify:
+
+		case job := <-scheduler.finishedJob:
+			scheduler.running.Remove(job.CollectionID())
+		}
+
+		// dispatch the waiting jobs only if there are free slots,
+		// so the jobs with higher priority submitted later could overtake the waiting ones
+		for scheduler.running.Len() < scheduler.maxRunning {
+			item := scheduler.waitQueue.pop(scheduler.running)
+			if item == nil {
+				break
 			}
-			queue <- job
-			scheduler.startProcessor(job.CollectionID(), queue)
-
-		case <-ticker.C:
-			for collection, queue := range scheduler.queues {
-				if len(queue) > 0 {
-					scheduler.startProcessor(collection, queue)
-				} else {
-					// Release resource if no job for the collection
-					close(queue)
-					delete(scheduler.queues, collection)
+			scheduler.running.Insert(item.job.CollectionID())
+			scheduler.wg.Add(1)
+			go func() {
+				defer scheduler.wg.Done()
+				scheduler.process(item)
+				select {
+				case scheduler.finishedJob <- item.job:
+				case <-ctx.Done():
 				}
-			}
+			}()
 		}
 	}
 }
 
 func (scheduler *Scheduler) Add(job Job) {
-	scheduler.waitQueue <- job
+	scheduler.AddWithPriority(job, DefaultJobPriority)
 }
 
-func (scheduler *Scheduler) startProcessor(collection int64, queue jobQueue) {
-	if !scheduler.processors.Insert(collection) {
-		return
-	}
+// AddWithPriority adds the job with the given priority,
+// the waiting jobs are scheduled by priority, then the submission order
+func (scheduler *Scheduler) AddWithPriority(job Job, priority int32) {
+	scheduler.jobsMu.Lock()
+	scheduler.jobs[job.CollectionID()] = append(scheduler.jobs[job.CollectionID()], job)
+	scheduler.jobsMu.Unlock()
 
-	scheduler.wg.Add(1)
-	go scheduler.processQueue(collection, queue)
+	scheduler.waitQueue.push(job, priority)
+}
+
+// CancelLoad cancels the waiting and running load jobs of the given collection,
+// returns the number of canceled jobs
+func (scheduler *Scheduler) CancelLoad(collectionID int64, err error) int {
+	scheduler.jobsMu.Lock()
+	defer scheduler.jobsMu.Unlock()
+
+	canceled := 0
+	for _, job := range scheduler.jobs[collectionID] {
+		switch job.(type) {
+		case *LoadCollectionJob, *LoadPartitionJob:
+			if job.CancelErr() == nil {
+				job.Cancel(err)
+				canceled++
+			}
+		}
+	}
+	return canceled
 }
 
-// processQueue processes jobs in the given queue,
-// it only processes jobs with the number of the length of queue at the time,
-// to avoid leaking goroutines
-func (scheduler *Scheduler) processQueue(collection int64, queue jobQueue) {
-	defer scheduler.wg.Done()
-	defer scheduler.processors.Remove(collection)
+func (scheduler *Scheduler) removeJob(job Job) {
+	scheduler.jobsMu.Lock()
+	defer scheduler.jobsMu.Unlock()
 
-	len := len(queue)
-	for i := 0; i < len; i++ {
-		scheduler.process(<-queue)
+	jobs := lo.Without(scheduler.jobs[job.CollectionID()], job)
+	if len(jobs) == 0 {
+		delete(scheduler.jobs, job.CollectionID())
+		return
 	}
+	scheduler.jobs[job.CollectionID()] = jobs
 }
 
-func (scheduler *Scheduler) process(job Job) {
+func (scheduler *Scheduler) process(item *pendingJob) {
+	job := item.job
 	log := log.Ctx(job.Context()).With(
 		zap.Int64("collectionID", job.CollectionID()))
 
+	// the job not admitted yet waits in the queue instead of occupying a running slot
+	var admitErr error
+	if job.CancelErr() == nil {
+		var delay time.Duration
+		delay, admitErr = job.Admit()
+		if delay > 0 {
+			log.Info("job is not admitted yet, wait in queue", zap.Duration("delay", delay), zap.Error(admitErr))
+			scheduler.waitQueue.requeue(item, delay)
+			return
+		}
+	}
+
 	defer func() {
 		log.Info("start to post-execute job")
 		job.PostExecute()
 		log.Info("job finished")
+		scheduler.removeJob(job)
 		job.Done()
 	}()
 
+	if err := job.CancelErr(); err != nil {
+		log.Info("job canceled before execution", zap.Error(err))
+		job.SetError(err)
+		return
+	}
+
+	if admitErr != nil {
+		log.Warn("job is not admitted", zap.Error(admitErr))
+		job.SetError(admitErr)
+		return
+	}
+
 	log.Info("start to pre-execute job")
 	err := job.PreExecute()
 	if err != nil {
@@ -19,6 +19,7 @@ package job
 import (
 	"context"
 
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
@@ -32,8 +33,10 @@ type UndoList struct {
 	IsReplicaCreated bool // indicates if created new replicas during loading
 	IsNewCollection  bool // indicates if created new collection during loading
 
-	CollectionID   int64
-	LackPartitions []int64
+	CollectionID    int64
+	LackPartitions  []int64
+	OldCollection   *meta.Collection // the collection meta overwritten during loading, restored on rollback
+	SpawnedReplicas []int64          // the replicas spawned for the loaded collection, removed on rollback
 
 	ctx            context.Context
 	meta           *meta.Meta
@@ -75,6 +78,23 @@ func (u *UndoList) RollBack() {
 	if err != nil {
 		log.Warn("failed to rollback collection from meta", zap.Error(err))
 	}
+	if !u.IsNewCollection && !u.IsReplicaCreated && u.OldCollection != nil {
+		// the replica number of the partitions may be increased during loading too
+		partitions := lo.Map(u.meta.CollectionManager.GetPartitionsByCollection(u.CollectionID),
+			func(partition *meta.Partition, _ int) *meta.Partition {
+				partition = partition.Clone()
+				partition.ReplicaNumber = u.OldCollection.GetReplicaNumber()
+				return partition
+			})
+		if err := u.meta.CollectionManager.PutCollection(u.OldCollection, partitions...); err != nil {
+			log.Warn("failed to restore collection meta", zap.Error(err))
+		}
+	}
+	if len(u.SpawnedReplicas) > 0 {
+		if err := u.meta.ReplicaManager.RemoveReplicas(u.CollectionID, u.SpawnedReplicas...); err != nil {
+			log.Warn("failed to remove spawned replicas", zap.Int64s("replicaIDs", u.SpawnedReplicas), zap.Error(err))
+		}
+	}
 
 	if u.IsTargetUpdated {
 		if u.IsNewCollection {
@@ -38,6 +38,12 @@ import (
 // all channels and segments of given collection(partitions) are released,
 // empty partition list means wait for collection released
 func waitCollectionReleased(dist *meta.DistributionManager, checkerController *checkers.CheckerController, collection int64, partitions ...int64) {
+	waitCollectionReleasedUntil(dist, checkerController, time.Time{}, collection, partitions...)
+}
+
+// waitCollectionReleasedUntil works like waitCollectionReleased,
+// but gives up at the deadline if it's not zero, returns whether all channels and segments are released.
+func waitCollectionReleasedUntil(dist *meta.DistributionManager, checkerController *checkers.CheckerController, deadline time.Time, collection int64, partitions ...int64) bool {
 	partitionSet := typeutil.NewUniqueSet(partitions...)
 	for {
 		var (
@@ -53,7 +59,9 @@ func waitCollectionReleased(dist *meta.DistributionManager, checkerController *c
 		}
 
 		if len(channels)+len(segments) == 0 {
-			break
+			return true
+		} else if !deadline.IsZero() && time.Now().After(deadline) {
+			return false
 		} else {
 			log.Info("wait for release done", zap.Int64("collection", collection),
 				zap.Int64s("partitions", partitions),
@@ -68,6 +76,31 @@ func waitCollectionReleased(dist *meta.DistributionManager, checkerController *c
 	}
 }
 
+// forceCollectionReleased removes the collection from distribution even if some nodes haven't released it,
+// the orphaned segments are logged for later GC.
+// The collection is marked as released forcibly, so the stuck nodes reporting it again won't bring it back.
+func forceCollectionReleased(ctx context.Context, dist *meta.DistributionManager, collection int64) {
+	log := log.Ctx(ctx).With(zap.Int64("collection", collection))
+	dist.MarkForceReleased(collection)
+	segments := dist.SegmentDistManager.RemoveCollection(collection)
+	channels := dist.ChannelDistManager.RemoveCollection(collection)
+	dist.LeaderViewManager.RemoveCollection(collection)
+	for _, segment := range segments {
+		log.Warn("orphaned segment left by forced release",
+			zap.Int64("segmentID", segment.GetID()),
+			zap.Int64("partitionID", segment.GetPartitionID()),
+			zap.Int64("nodeID", segment.Node))
+	}
+	for _, channel := range channels {
+		log.Warn("orphaned channel left by forced release",
+			zap.String("channel", channel.GetChannelName()),
+			zap.Int64("nodeID", channel.Node))
+	}
+	log.Warn("collection released forcibly",
+		zap.Int("orphanedSegmentNum", len(segments)),
+		zap.Int("orphanedChannelNum", len(channels)))
+}
+
 func loadPartitions(ctx context.Context,
 	meta *meta.Meta,
 	cluster session.Cluster,
@@ -0,0 +1,190 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// ReplicaLayout is the node assignment of segments and channels in a replica.
+type ReplicaLayout struct {
+	Segments map[int64]int64  // segmentID -> nodeID
+	Channels map[string]int64 // channel name -> nodeID
+}
+
+// BalanceLayout is the captured node assignment of a collection,
+// the balancer tries to preserve it instead of balancing the collection freely.
+type BalanceLayout struct {
+	CollectionID int64
+	CapturedAt   time.Time
+	Replicas     map[int64]*ReplicaLayout // replicaID -> layout
+}
+
+// SegmentNum returns the number of segment assignments in the layout.
+func (l *BalanceLayout) SegmentNum() int {
+	num := 0
+	for _, replica := range l.Replicas {
+		num += len(replica.Segments)
+	}
+	return num
+}
+
+// ChannelNum returns the number of channel assignments in the layout.
+func (l *BalanceLayout) ChannelNum() int {
+	num := 0
+	for _, replica := range l.Replicas {
+		num += len(replica.Channels)
+	}
+	return num
+}
+
+// CaptureBalanceLayout records the current distribution of the given collection as its layout.
+func CaptureBalanceLayout(m *Meta, dist *DistributionManager, collectionID int64) *BalanceLayout {
+	layout := &BalanceLayout{
+		CollectionID: collectionID,
+		CapturedAt:   time.Now(),
+		Replicas:     make(map[int64]*ReplicaLayout),
+	}
+	for _, replica := range m.ReplicaManager.GetByCollection(collectionID) {
+		replicaLayout := &ReplicaLayout{
+			Segments: make(map[int64]int64),
+			Channels: make(map[string]int64),
+		}
+		for _, segment := range dist.SegmentDistManager.GetByFilter(WithReplica(replica)) {
+			replicaLayout.Segments[segment.GetID()] = segment.Node
+		}
+		for _, channel := range dist.ChannelDistManager.GetByFilter(WithReplica2Channel(replica)) {
+			replicaLayout.Channels[channel.GetChannelName()] = channel.Node
+		}
+		layout.Replicas[replica.GetID()] = replicaLayout
+	}
+	return layout
+}
+
+func (l *BalanceLayout) toProto() *querypb.BalanceLayout {
+	layout := &querypb.BalanceLayout{
+		CollectionID: l.CollectionID,
+		CapturedTime: l.CapturedAt.UnixMilli(),
+		Replicas:     make([]*querypb.ReplicaLayout, 0, len(l.Replicas)),
+	}
+	for replicaID, replica := range l.Replicas {
+		layout.Replicas = append(layout.Replicas, &querypb.ReplicaLayout{
+			ReplicaID: replicaID,
+			Segments:  replica.Segments,
+			Channels:  replica.Channels,
+		})
+	}
+	return layout
+}
+
+func fromBalanceLayoutProto(layout *querypb.BalanceLayout) *BalanceLayout {
+	ret := &BalanceLayout{
+		CollectionID: layout.GetCollectionID(),
+		CapturedAt:   time.UnixMilli(layout.GetCapturedTime()),
+		Replicas:     make(map[int64]*ReplicaLayout, len(layout.GetReplicas())),
+	}
+	for _, replica := range layout.GetReplicas() {
+		replicaLayout := &ReplicaLayout{
+			Segments: replica.GetSegments(),
+			Channels: replica.GetChannels(),
+		}
+		if replicaLayout.Segments == nil {
+			replicaLayout.Segments = make(map[int64]int64)
+		}
+		if replicaLayout.Channels == nil {
+			replicaLayout.Channels = make(map[string]int64)
+		}
+		ret.Replicas[replica.GetReplicaID()] = replicaLayout
+	}
+	return ret
+}
+
+// BalanceLayoutManager keeps the captured balance layouts of collections,
+// the layouts are persisted so they survive the restart of QueryCoord.
+type BalanceLayoutManager struct {
+	rwmutex sync.RWMutex
+	catalog metastore.QueryCoordCatalog
+	layouts map[int64]*BalanceLayout // collectionID -> layout
+}
+
+func NewBalanceLayoutManager(catalog metastore.QueryCoordCatalog) *BalanceLayoutManager {
+	return &BalanceLayoutManager{
+		catalog: catalog,
+		layouts: make(map[int64]*BalanceLayout),
+	}
+}
+
+// RecoverBalanceLayouts loads the captured layouts from meta store.
+func (m *BalanceLayoutManager) RecoverBalanceLayouts() error {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	layouts, err := m.catalog.GetBalanceLayouts()
+	if err != nil {
+		return err
+	}
+	for _, layout := range layouts {
+		m.layouts[layout.GetCollectionID()] = fromBalanceLayoutProto(layout)
+	}
+	log.Info("recover balance layouts", zap.Int64s("collections", lo.Keys(m.layouts)))
+	return nil
+}
+
+func (m *BalanceLayoutManager) PutLayout(layout *BalanceLayout) error {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	if err := m.catalog.SaveBalanceLayout(layout.toProto()); err != nil {
+		return err
+	}
+	m.layouts[layout.CollectionID] = layout
+	return nil
+}
+
+// GetLayout returns the captured layout of the given collection, nil if no layout captured.
+func (m *BalanceLayoutManager) GetLayout(collectionID int64) *BalanceLayout {
+	m.rwmutex.RLock()
+	defer m.rwmutex.RUnlock()
+
+	return m.layouts[collectionID]
+}
+
+func (m *BalanceLayoutManager) HasLayout(collectionID int64) bool {
+	return m.GetLayout(collectionID) != nil
+}
+
+func (m *BalanceLayoutManager) RemoveLayout(collectionID int64) error {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	if _, ok := m.layouts[collectionID]; !ok {
+		return nil
+	}
+	if err := m.catalog.RemoveBalanceLayout(collectionID); err != nil {
+		return err
+	}
+	delete(m.layouts, collectionID)
+	return nil
+}
@@ -0,0 +1,79 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+func TestBalanceLayoutManager(t *testing.T) {
+	catalog := mocks.NewQueryCoordCatalog(t)
+	catalog.EXPECT().GetBalanceLayouts().Return([]*querypb.BalanceLayout{
+		{CollectionID: 2, CapturedTime: 1000, Replicas: []*querypb.ReplicaLayout{
+			{ReplicaID: 3, Segments: map[int64]int64{1: 1}},
+		}},
+	}, nil)
+	var saved *querypb.BalanceLayout
+	catalog.EXPECT().SaveBalanceLayout(mock.Anything).RunAndReturn(func(layout *querypb.BalanceLayout) error {
+		saved = layout
+		return nil
+	}).Once()
+	catalog.EXPECT().RemoveBalanceLayout(int64(1)).Return(nil).Once()
+
+	m := NewBalanceLayoutManager(catalog)
+	assert.False(t, m.HasLayout(1))
+	assert.Nil(t, m.GetLayout(1))
+
+	// the captured layouts survive the restart
+	assert.NoError(t, m.RecoverBalanceLayouts())
+	assert.True(t, m.HasLayout(2))
+	assert.EqualValues(t, 1000, m.GetLayout(2).CapturedAt.UnixMilli())
+	assert.Equal(t, 1, m.GetLayout(2).SegmentNum())
+	assert.Equal(t, 0, m.GetLayout(2).ChannelNum())
+	assert.NotNil(t, m.GetLayout(2).Replicas[3].Channels)
+
+	layout := &BalanceLayout{
+		CollectionID: 1,
+		Replicas: map[int64]*ReplicaLayout{
+			1: {
+				Segments: map[int64]int64{1: 1, 2: 2},
+				Channels: map[string]int64{"dmc0": 1},
+			},
+			2: {
+				Segments: map[int64]int64{1: 3},
+				Channels: map[string]int64{"dmc0": 3},
+			},
+		},
+	}
+	assert.NoError(t, m.PutLayout(layout))
+	assert.True(t, m.HasLayout(1))
+	assert.EqualValues(t, 1, saved.GetCollectionID())
+	assert.Len(t, saved.GetReplicas(), 2)
+	assert.Equal(t, 3, m.GetLayout(1).SegmentNum())
+	assert.Equal(t, 2, m.GetLayout(1).ChannelNum())
+
+	assert.NoError(t, m.RemoveLayout(1))
+	assert.False(t, m.HasLayout(1))
+	// nothing to remove
+	assert.NoError(t, m.RemoveLayout(1))
+}
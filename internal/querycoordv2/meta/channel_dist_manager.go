@@ -276,6 +276,25 @@ func (m *ChannelDistManager) Update(nodeID typeutil.UniqueID, channels ...*DmCha
 	m.updateCollectionIndex()
 }
 
+// RemoveCollection removes all channels of the collection from all nodes, returns the removed channels.
+func (m *ChannelDistManager) RemoveCollection(collectionID typeutil.UniqueID) []*DmChannel {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	removed := make([]*DmChannel, 0)
+	for nodeID, channels := range m.channels {
+		if len(channels.collChannels[collectionID]) == 0 {
+			continue
+		}
+		removed = append(removed, channels.collChannels[collectionID]...)
+		m.channels[nodeID] = composeNodeChannels(lo.Filter(channels.channels, func(channel *DmChannel, _ int) bool {
+			return channel.GetCollectionID() != collectionID
+		})...)
+	}
+	m.updateCollectionIndex()
+	return removed
+}
+
 // update secondary index for channel distribution
 func (m *ChannelDistManager) updateCollectionIndex() {
 	m.collectionIndex = make(map[int64][]*DmChannel)
@@ -35,6 +35,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/syncutil"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -106,6 +107,9 @@ type CollectionManager struct {
 
 	collectionPartitions map[typeutil.UniqueID]typeutil.Set[typeutil.UniqueID]
 	catalog              metastore.QueryCoordCatalog
+
+	// notified whenever the collections or partitions are changed
+	notifier *syncutil.VersionedNotifier
 }
 
 func NewCollectionManager(catalog metastore.QueryCoordCatalog) *CollectionManager {
@@ -114,9 +118,15 @@ func NewCollectionManager(catalog metastore.QueryCoordCatalog) *CollectionManage
 		partitions:           make(map[int64]*Partition),
 		collectionPartitions: make(map[int64]typeutil.Set[typeutil.UniqueID]),
 		catalog:              catalog,
+		notifier:             syncutil.NewVersionedNotifier(),
 	}
 }
 
+// Listen returns a listener notified whenever the collections or partitions are changed after now.
+func (m *CollectionManager) Listen() *syncutil.VersionedListener {
+	return m.notifier.Listen(syncutil.VersionedListenAtLatest)
+}
+
 // Recover recovers collections from kv store,
 // panics if failed
 func (m *CollectionManager) Recover(broker Broker) error {
@@ -438,6 +448,7 @@ func (m *CollectionManager) putCollection(withSave bool, collection *Collection,
 	}
 	collection.UpdatedAt = time.Now()
 	m.collections[collection.CollectionID] = collection
+	m.notifier.NotifyAll()
 
 	return nil
 }
@@ -478,6 +489,7 @@ func (m *CollectionManager) putPartition(partitions []*Partition, withSave bool)
 		}
 		partitions.Insert(partition.GetPartitionID())
 	}
+	m.notifier.NotifyAll()
 	return nil
 }
 
@@ -538,6 +550,42 @@ func (m *CollectionManager) UpdateLoadPercent(partitionID int64, loadPercent int
 	return collectionPercent, m.putCollection(saveCollection, newCollection)
 }
 
+// SetBalanceExcluded marks whether the collection is excluded from auto balance,
+// the flag is persisted in collection load info.
+func (m *CollectionManager) SetBalanceExcluded(collectionID typeutil.UniqueID, excluded bool) error {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	oldCollection, ok := m.collections[collectionID]
+	if !ok {
+		return merr.WrapErrCollectionNotLoaded(collectionID)
+	}
+	if oldCollection.GetBalanceExcluded() == excluded {
+		return nil
+	}
+	newCollection := oldCollection.Clone()
+	newCollection.BalanceExcluded = excluded
+	return m.putCollection(true, newCollection)
+}
+
+// SetBalancePolicy sets the name of the balancer used to balance the collection,
+// the global balancer is used if the policy is empty.
+func (m *CollectionManager) SetBalancePolicy(collectionID typeutil.UniqueID, policy string) error {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	oldCollection, ok := m.collections[collectionID]
+	if !ok {
+		return merr.WrapErrCollectionNotLoaded(collectionID)
+	}
+	if oldCollection.GetBalancePolicy() == policy {
+		return nil
+	}
+	newCollection := oldCollection.Clone()
+	newCollection.BalancePolicy = policy
+	return m.putCollection(true, newCollection)
+}
+
 // RemoveCollection removes collection and its partitions.
 func (m *CollectionManager) RemoveCollection(collectionID typeutil.UniqueID) error {
 	m.rwmutex.Lock()
@@ -554,6 +602,7 @@ func (m *CollectionManager) RemoveCollection(collectionID typeutil.UniqueID) err
 			delete(m.partitions, partition)
 		}
 		delete(m.collectionPartitions, collectionID)
+		m.notifier.NotifyAll()
 	}
 	return nil
 }
@@ -579,6 +628,7 @@ func (m *CollectionManager) removePartition(collectionID typeutil.UniqueID, part
 		delete(m.partitions, id)
 		delete(partitions, id)
 	}
+	m.notifier.NotifyAll()
 
 	return nil
 }
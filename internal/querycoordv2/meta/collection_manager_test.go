@@ -318,6 +318,46 @@ func (suite *CollectionManagerSuite) TestRecover_normal() {
 	}
 }
 
+func (suite *CollectionManagerSuite) TestSetBalanceExcluded() {
+	mgr := suite.mgr
+	collectionID := suite.collections[0]
+
+	suite.Error(mgr.SetBalanceExcluded(999, true))
+	suite.NoError(mgr.SetBalanceExcluded(collectionID, true))
+	suite.True(mgr.GetCollection(collectionID).GetBalanceExcluded())
+	suite.False(mgr.GetCollection(suite.collections[1]).GetBalanceExcluded())
+
+	// the flag survives restart
+	suite.clearMemory()
+	suite.NoError(mgr.Recover(suite.broker))
+	suite.True(mgr.GetCollection(collectionID).GetBalanceExcluded())
+
+	suite.NoError(mgr.SetBalanceExcluded(collectionID, false))
+	suite.clearMemory()
+	suite.NoError(mgr.Recover(suite.broker))
+	suite.False(mgr.GetCollection(collectionID).GetBalanceExcluded())
+}
+
+func (suite *CollectionManagerSuite) TestSetBalancePolicy() {
+	mgr := suite.mgr
+	collectionID := suite.collections[0]
+
+	suite.Error(mgr.SetBalancePolicy(999, "ScoreBasedBalancer"))
+	suite.NoError(mgr.SetBalancePolicy(collectionID, "ScoreBasedBalancer"))
+	suite.Equal("ScoreBasedBalancer", mgr.GetCollection(collectionID).GetBalancePolicy())
+	suite.Empty(mgr.GetCollection(suite.collections[1]).GetBalancePolicy())
+
+	// the policy survives restart
+	suite.clearMemory()
+	suite.NoError(mgr.Recover(suite.broker))
+	suite.Equal("ScoreBasedBalancer", mgr.GetCollection(collectionID).GetBalancePolicy())
+
+	suite.NoError(mgr.SetBalancePolicy(collectionID, ""))
+	suite.clearMemory()
+	suite.NoError(mgr.Recover(suite.broker))
+	suite.Empty(mgr.GetCollection(collectionID).GetBalancePolicy())
+}
+
 func (suite *CollectionManagerSuite) TestRecoverLoadingCollection() {
 	mgr := suite.mgr
 	suite.releaseAll()
@@ -16,10 +16,15 @@
 
 package meta
 
+import "github.com/milvus-io/milvus/pkg/util/typeutil"
+
 type DistributionManager struct {
 	*SegmentDistManager
 	*ChannelDistManager
 	*LeaderViewManager
+
+	// collections released forcibly, until they are loaded again
+	forceReleased *typeutil.ConcurrentSet[int64]
 }
 
 func NewDistributionManager() *DistributionManager {
@@ -27,5 +32,21 @@ func NewDistributionManager() *DistributionManager {
 		SegmentDistManager: NewSegmentDistManager(),
 		ChannelDistManager: NewChannelDistManager(),
 		LeaderViewManager:  NewLeaderViewManager(),
+		forceReleased:      typeutil.NewConcurrentSet[int64](),
 	}
 }
+
+// MarkForceReleased records the collection released forcibly,
+// the distribution of it reported by the nodes stuck in releasing is dropped since then.
+func (m *DistributionManager) MarkForceReleased(collectionID int64) {
+	m.forceReleased.Insert(collectionID)
+}
+
+// UnmarkForceReleased clears the record of the collection as it's loaded again.
+func (m *DistributionManager) UnmarkForceReleased(collectionID int64) {
+	m.forceReleased.Remove(collectionID)
+}
+
+func (m *DistributionManager) IsForceReleased(collectionID int64) bool {
+	return m.forceReleased.Contain(collectionID)
+}
@@ -40,11 +40,14 @@ type FailedLoadCache struct {
 	mu sync.RWMutex
 	// CollectionID, ErrorCode -> error
 	records map[int64]map[int32]*failInfo
+	// CollectionID, PartitionID -> the last error
+	partitionRecords map[int64]map[int64]*failInfo
 }
 
 func NewFailedLoadCache() *FailedLoadCache {
 	return &FailedLoadCache{
-		records: make(map[int64]map[int32]*failInfo),
+		records:          make(map[int64]map[int32]*failInfo),
+		partitionRecords: make(map[int64]map[int64]*failInfo),
 	}
 }
 
@@ -100,10 +103,41 @@ func (l *FailedLoadCache) Put(collectionID int64, err error) {
 	)
 }
 
+// GetPartition returns the last load error of the partition, nil if no failure recorded
+func (l *FailedLoadCache) GetPartition(collectionID, partitionID int64) error {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	info, ok := l.partitionRecords[collectionID][partitionID]
+	if !ok {
+		return nil
+	}
+	return info.err
+}
+
+func (l *FailedLoadCache) PutPartition(collectionID, partitionID int64, err error) {
+	if err == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.partitionRecords[collectionID]; !ok {
+		l.partitionRecords[collectionID] = make(map[int64]*failInfo)
+	}
+	if _, ok := l.partitionRecords[collectionID][partitionID]; !ok {
+		l.partitionRecords[collectionID][partitionID] = &failInfo{}
+	}
+	l.partitionRecords[collectionID][partitionID].count++
+	l.partitionRecords[collectionID][partitionID].err = err
+	l.partitionRecords[collectionID][partitionID].lastTime = time.Now()
+}
+
 func (l *FailedLoadCache) Remove(collectionID int64) {
 	l.mu.Lock()
 	defer l.mu.Unlock()
 	delete(l.records, collectionID)
+	delete(l.partitionRecords, collectionID)
 	log.Info("FailedLoadCache removes cache", zap.Int64("collectionID", collectionID))
 }
 
@@ -121,4 +155,14 @@ func (l *FailedLoadCache) TryExpire() {
 			log.Info("FailedLoadCache expires cache", zap.Int64("collectionID", col))
 		}
 	}
+	for col, infos := range l.partitionRecords {
+		for partition, info := range infos {
+			if time.Since(info.lastTime) > expireTime {
+				delete(l.partitionRecords[col], partition)
+			}
+		}
+		if len(l.partitionRecords[col]) == 0 {
+			delete(l.partitionRecords, col)
+		}
+	}
 }
@@ -52,3 +52,27 @@ func TestFailedLoadCache(t *testing.T) {
 	err = GlobalFailedLoadCache.Get(colID)
 	assert.Equal(t, commonpb.ErrorCode_Success, merr.Status(err).ErrorCode)
 }
+
+func TestFailedLoadCachePartition(t *testing.T) {
+	GlobalFailedLoadCache = NewFailedLoadCache()
+
+	colID := int64(0)
+	mockErr := merr.WrapErrServiceMemoryLimitExceeded(0, 0)
+
+	GlobalFailedLoadCache.PutPartition(colID, 1, nil)
+	assert.NoError(t, GlobalFailedLoadCache.GetPartition(colID, 1))
+
+	GlobalFailedLoadCache.PutPartition(colID, 1, mockErr)
+	assert.ErrorIs(t, GlobalFailedLoadCache.GetPartition(colID, 1), merr.ErrServiceMemoryLimitExceeded)
+	assert.NoError(t, GlobalFailedLoadCache.GetPartition(colID, 2))
+
+	GlobalFailedLoadCache.Remove(colID)
+	assert.NoError(t, GlobalFailedLoadCache.GetPartition(colID, 1))
+
+	GlobalFailedLoadCache.PutPartition(colID, 1, mockErr)
+	GlobalFailedLoadCache.mu.Lock()
+	GlobalFailedLoadCache.partitionRecords[colID][1].lastTime = time.Now().Add(-expireTime * 2)
+	GlobalFailedLoadCache.mu.Unlock()
+	GlobalFailedLoadCache.TryExpire()
+	assert.NoError(t, GlobalFailedLoadCache.GetPartition(colID, 1))
+}
@@ -18,6 +18,7 @@ package meta
 
 import (
 	"sync"
+	"time"
 
 	"github.com/samber/lo"
 
@@ -192,14 +193,52 @@ func composeNodeViews(views ...*LeaderView) nodeViews {
 	}
 }
 
+// sameRouting checks whether the two node views serve the same shards with the same leader and target version.
+func sameRouting(prev, curr nodeViews) bool {
+	if len(prev.channelView) != len(curr.channelView) {
+		return false
+	}
+	for channel, view := range curr.channelView {
+		prevView, ok := prev.channelView[channel]
+		if !ok || prevView.CollectionID != view.CollectionID ||
+			prevView.Version != view.Version || prevView.TargetVersion != view.TargetVersion {
+			return false
+		}
+	}
+	return true
+}
+
+// sameSegments checks whether the two node views with the same routing serve the same sealed segments.
+func sameSegments(prev, curr nodeViews) bool {
+	for channel, view := range curr.channelView {
+		prevView := prev.channelView[channel]
+		if len(prevView.Segments) != len(view.Segments) {
+			return false
+		}
+		for segmentID, dist := range view.Segments {
+			prevDist, ok := prevView.Segments[segmentID]
+			if !ok || prevDist.GetNodeID() != dist.GetNodeID() || prevDist.GetVersion() != dist.GetVersion() {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 type LeaderViewManager struct {
-	rwmutex sync.RWMutex
-	views   map[int64]nodeViews // LeaderID -> Views (one per shard)
+	rwmutex        sync.RWMutex
+	views          map[int64]nodeViews // LeaderID -> Views (one per shard)
+	notifier       chan struct{}       // closed and renewed on every update
+	generation     int64               // bumped when any shard leader or its target version changes
+	viewGeneration int64               // bumped when the routing or the sealed segments served by any shard leader change
 }
 
 func NewLeaderViewManager() *LeaderViewManager {
 	return &LeaderViewManager{
-		views: make(map[int64]nodeViews),
+		views:    make(map[int64]nodeViews),
+		notifier: make(chan struct{}),
+		// start from current time, to keep the generation increasing after coordinator restarts
+		generation: time.Now().UnixMilli(),
 	}
 }
 
@@ -207,7 +246,62 @@ func NewLeaderViewManager() *LeaderViewManager {
 func (mgr *LeaderViewManager) Update(leaderID int64, views ...*LeaderView) {
 	mgr.rwmutex.Lock()
 	defer mgr.rwmutex.Unlock()
-	mgr.views[leaderID] = composeNodeViews(views...)
+	newViews := composeNodeViews(views...)
+	if !sameRouting(mgr.views[leaderID], newViews) {
+		mgr.generation++
+		mgr.viewGeneration++
+	} else if !sameSegments(mgr.views[leaderID], newViews) {
+		mgr.viewGeneration++
+	}
+	mgr.views[leaderID] = newViews
+	close(mgr.notifier)
+	mgr.notifier = make(chan struct{})
+}
+
+// RemoveCollection removes all leader views of the collection from all leaders.
+func (mgr *LeaderViewManager) RemoveCollection(collectionID int64) {
+	mgr.rwmutex.Lock()
+	defer mgr.rwmutex.Unlock()
+
+	removed := false
+	for leaderID, views := range mgr.views {
+		if len(views.collectionViews[collectionID]) == 0 {
+			continue
+		}
+		mgr.views[leaderID] = composeNodeViews(lo.Filter(views.views, func(view *LeaderView, _ int) bool {
+			return view.CollectionID != collectionID
+		})...)
+		removed = true
+	}
+	if removed {
+		mgr.generation++
+		mgr.viewGeneration++
+		close(mgr.notifier)
+		mgr.notifier = make(chan struct{})
+	}
+}
+
+// GetRoutingGeneration returns the routing generation,
+// shard leaders returned with the same generation are consistent.
+func (mgr *LeaderViewManager) GetRoutingGeneration() int64 {
+	mgr.rwmutex.RLock()
+	defer mgr.rwmutex.RUnlock()
+	return mgr.generation
+}
+
+// GetViewGeneration returns the leader view generation,
+// the leaders serve the same sealed segments with the same generation.
+func (mgr *LeaderViewManager) GetViewGeneration() int64 {
+	mgr.rwmutex.RLock()
+	defer mgr.rwmutex.RUnlock()
+	return mgr.viewGeneration
+}
+
+// Watch returns a channel which will be closed when the leader views are updated next time
+func (mgr *LeaderViewManager) Watch() <-chan struct{} {
+	mgr.rwmutex.RLock()
+	defer mgr.rwmutex.RUnlock()
+	return mgr.notifier
 }
 
 func (mgr *LeaderViewManager) GetLeaderShardView(id int64, shard string) *LeaderView {
@@ -208,6 +208,80 @@ func (suite *LeaderViewManagerSuite) TestClone() {
 	}
 }
 
+func (suite *LeaderViewManagerSuite) TestWatch() {
+	mgr := suite.mgr
+
+	ch := mgr.Watch()
+	select {
+	case <-ch:
+		suite.FailNow("should not be notified before update")
+	default:
+	}
+
+	mgr.Update(1, &LeaderView{ID: 1, CollectionID: 100, Channel: "test-channel"})
+	select {
+	case <-ch:
+	default:
+		suite.FailNow("should be notified after update")
+	}
+
+	// a new watch channel is not notified by previous updates
+	select {
+	case <-mgr.Watch():
+		suite.FailNow("should not be notified before update")
+	default:
+	}
+}
+
+func (suite *LeaderViewManagerSuite) TestRoutingGeneration() {
+	mgr := NewLeaderViewManager()
+	generation := mgr.GetRoutingGeneration()
+
+	mgr.Update(1, &LeaderView{ID: 1, CollectionID: 100, Channel: "test-channel", Version: 1})
+	suite.Greater(mgr.GetRoutingGeneration(), generation)
+	generation = mgr.GetRoutingGeneration()
+
+	// segments changed only
+	mgr.Update(1, &LeaderView{ID: 1, CollectionID: 100, Channel: "test-channel", Version: 1, Segments: map[int64]*querypb.SegmentDist{1: {NodeID: 1}}})
+	suite.Equal(generation, mgr.GetRoutingGeneration())
+
+	// target changed
+	mgr.Update(1, &LeaderView{ID: 1, CollectionID: 100, Channel: "test-channel", Version: 1, TargetVersion: 2})
+	suite.Greater(mgr.GetRoutingGeneration(), generation)
+	generation = mgr.GetRoutingGeneration()
+
+	// leader changed
+	mgr.Update(1)
+	suite.Greater(mgr.GetRoutingGeneration(), generation)
+}
+
+func (suite *LeaderViewManagerSuite) TestViewGeneration() {
+	mgr := NewLeaderViewManager()
+	generation := mgr.GetViewGeneration()
+
+	mgr.Update(1, &LeaderView{ID: 1, CollectionID: 100, Channel: "test-channel", Version: 1})
+	suite.Greater(mgr.GetViewGeneration(), generation)
+	generation = mgr.GetViewGeneration()
+
+	// nothing changed
+	mgr.Update(1, &LeaderView{ID: 1, CollectionID: 100, Channel: "test-channel", Version: 1, NumOfGrowingRows: 10})
+	suite.Equal(generation, mgr.GetViewGeneration())
+
+	// segments changed only
+	mgr.Update(1, &LeaderView{ID: 1, CollectionID: 100, Channel: "test-channel", Version: 1, Segments: map[int64]*querypb.SegmentDist{1: {NodeID: 1}}})
+	suite.Greater(mgr.GetViewGeneration(), generation)
+	generation = mgr.GetViewGeneration()
+
+	// segment moved to another node
+	mgr.Update(1, &LeaderView{ID: 1, CollectionID: 100, Channel: "test-channel", Version: 1, Segments: map[int64]*querypb.SegmentDist{1: {NodeID: 2}}})
+	suite.Greater(mgr.GetViewGeneration(), generation)
+	generation = mgr.GetViewGeneration()
+
+	// collection removed
+	mgr.RemoveCollection(100)
+	suite.Greater(mgr.GetViewGeneration(), generation)
+}
+
 func TestLeaderViewManager(t *testing.T) {
 	suite.Run(t, new(LeaderViewManagerSuite))
 }
@@ -0,0 +1,182 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// LoadCheckpointManager keeps the load checkpoint of collections,
+// a checkpoint records the replica layout and the current target version once the current target is updated,
+// it's valid after restart only if the recovered state matches.
+type LoadCheckpointManager struct {
+	rwmutex     sync.RWMutex
+	catalog     metastore.QueryCoordCatalog
+	checkpoints map[int64]*querypb.LoadCheckpoint // CollectionID -> checkpoint
+}
+
+func NewLoadCheckpointManager(catalog metastore.QueryCoordCatalog) *LoadCheckpointManager {
+	return &LoadCheckpointManager{
+		catalog:     catalog,
+		checkpoints: make(map[int64]*querypb.LoadCheckpoint),
+	}
+}
+
+// RecoverLoadCheckpoints loads the checkpoints from meta store.
+func (m *LoadCheckpointManager) RecoverLoadCheckpoints() error {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	checkpoints, err := m.catalog.GetLoadCheckpoints()
+	if err != nil {
+		return err
+	}
+	m.checkpoints = checkpoints
+	log.Info("recover load checkpoints", zap.Int64s("collections", lo.Keys(checkpoints)))
+	return nil
+}
+
+func toReplicaLayout(replicas []*Replica) []*querypb.Replica {
+	layout := lo.Map(replicas, func(replica *Replica, _ int) *querypb.Replica {
+		return &querypb.Replica{
+			ID:            replica.GetID(),
+			CollectionID:  replica.GetCollectionID(),
+			ResourceGroup: replica.GetResourceGroup(),
+			Nodes:         replica.GetNodes(),
+			RoNodes:       replica.GetRONodes(),
+		}
+	})
+	sort.Slice(layout, func(i, j int) bool { return layout[i].GetID() < layout[j].GetID() })
+	return layout
+}
+
+func sameReplicaLayout(checkpoint *querypb.LoadCheckpoint, layout []*querypb.Replica) bool {
+	if len(checkpoint.GetReplicas()) != len(layout) {
+		return false
+	}
+	for i, replica := range checkpoint.GetReplicas() {
+		if replica.GetID() != layout[i].GetID() ||
+			!sameNodes(replica.GetNodes(), layout[i].GetNodes()) ||
+			!sameNodes(replica.GetRoNodes(), layout[i].GetRoNodes()) {
+			return false
+		}
+	}
+	return true
+}
+
+func sameNodes(nodes1, nodes2 []int64) bool {
+	set1 := typeutil.NewUniqueSet(nodes1...)
+	set2 := typeutil.NewUniqueSet(nodes2...)
+	return set1.Len() == set2.Len() && set1.Contain(nodes2...)
+}
+
+// SaveLoadCheckpoint takes the checkpoint of given collection and persists it,
+// does nothing if the load state doesn't change since last checkpoint.
+func (m *LoadCheckpointManager) SaveLoadCheckpoint(collectionID int64, targetVersion int64, replicas []*Replica) error {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	layout := toReplicaLayout(replicas)
+	if old, ok := m.checkpoints[collectionID]; ok && old.GetTargetVersion() == targetVersion && sameReplicaLayout(old, layout) {
+		return nil
+	}
+
+	checkpoint := &querypb.LoadCheckpoint{
+		CollectionID:  collectionID,
+		TargetVersion: targetVersion,
+		Replicas:      layout,
+		Timestamp:     time.Now().UnixMilli(),
+	}
+	if err := m.catalog.SaveLoadCheckpoints(checkpoint); err != nil {
+		return err
+	}
+	m.checkpoints[collectionID] = checkpoint
+	return nil
+}
+
+// GetLoadCheckpoint returns the checkpoint of given collection, nil if not exist.
+func (m *LoadCheckpointManager) GetLoadCheckpoint(collectionID int64) *querypb.LoadCheckpoint {
+	m.rwmutex.RLock()
+	defer m.rwmutex.RUnlock()
+
+	return m.checkpoints[collectionID]
+}
+
+// MatchLoadCheckpoint checks whether the given load state matches the checkpoint of collection.
+func (m *LoadCheckpointManager) MatchLoadCheckpoint(collectionID int64, targetVersion int64, replicas []*Replica) bool {
+	checkpoint := m.GetLoadCheckpoint(collectionID)
+	if checkpoint == nil || checkpoint.GetTargetVersion() != targetVersion {
+		return false
+	}
+	return sameReplicaLayout(checkpoint, toReplicaLayout(replicas))
+}
+
+func (m *LoadCheckpointManager) RemoveLoadCheckpoint(collectionID int64) error {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	if _, ok := m.checkpoints[collectionID]; !ok {
+		return nil
+	}
+	if err := m.catalog.RemoveLoadCheckpoints(collectionID); err != nil {
+		return err
+	}
+	delete(m.checkpoints, collectionID)
+	return nil
+}
+
+// InvalidateLoadCheckpoints drops all checkpoints, should be called once the node membership changed.
+// It's called on every node event, so the checkpoints are removed from meta store in batches asynchronously,
+// a checkpoint taken again before the removal is kept.
+func (m *LoadCheckpointManager) InvalidateLoadCheckpoints() {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	if len(m.checkpoints) == 0 {
+		return
+	}
+	invalidated := m.checkpoints
+	m.checkpoints = make(map[int64]*querypb.LoadCheckpoint)
+
+	go m.removeInvalidated(invalidated)
+}
+
+func (m *LoadCheckpointManager) removeInvalidated(invalidated map[int64]*querypb.LoadCheckpoint) {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	collectionIDs := make([]int64, 0, len(invalidated))
+	for collectionID := range invalidated {
+		if _, ok := m.checkpoints[collectionID]; !ok {
+			collectionIDs = append(collectionIDs, collectionID)
+		}
+	}
+	if err := m.catalog.RemoveLoadCheckpoints(collectionIDs...); err != nil {
+		// the persisted checkpoints won't match the replica layout after the node changes anyway
+		log.Warn("failed to remove load checkpoints", zap.Int64s("collectionIDs", collectionIDs), zap.Error(err))
+	}
+}
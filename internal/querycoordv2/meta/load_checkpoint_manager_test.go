@@ -0,0 +1,94 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+func TestLoadCheckpointManager(t *testing.T) {
+	catalog := mocks.NewQueryCoordCatalog(t)
+	catalog.EXPECT().GetLoadCheckpoints().Return(map[int64]*querypb.LoadCheckpoint{
+		1: {CollectionID: 1, TargetVersion: 10, Replicas: []*querypb.Replica{{ID: 1, CollectionID: 1, Nodes: []int64{1, 2}}}},
+	}, nil)
+	catalog.EXPECT().SaveLoadCheckpoints(mock.Anything).Return(nil).Once()
+	catalog.EXPECT().RemoveLoadCheckpoints(mock.Anything).Return(nil)
+
+	m := NewLoadCheckpointManager(catalog)
+	assert.NoError(t, m.RecoverLoadCheckpoints())
+	assert.NotNil(t, m.GetLoadCheckpoint(1))
+
+	replicas := []*Replica{
+		newReplica(&querypb.Replica{ID: 1, CollectionID: 1, Nodes: []int64{2, 1}}),
+	}
+	assert.True(t, m.MatchLoadCheckpoint(1, 10, replicas))
+	assert.False(t, m.MatchLoadCheckpoint(1, 11, replicas))
+	assert.False(t, m.MatchLoadCheckpoint(1, 10, []*Replica{
+		newReplica(&querypb.Replica{ID: 1, CollectionID: 1, Nodes: []int64{1, 3}}),
+	}))
+	assert.False(t, m.MatchLoadCheckpoint(2, 10, replicas))
+
+	// unchanged checkpoint won't be persisted again
+	assert.NoError(t, m.SaveLoadCheckpoint(1, 10, replicas))
+	assert.NoError(t, m.SaveLoadCheckpoint(1, 11, replicas))
+	assert.EqualValues(t, 11, m.GetLoadCheckpoint(1).GetTargetVersion())
+
+	assert.NoError(t, m.RemoveLoadCheckpoint(1))
+	assert.Nil(t, m.GetLoadCheckpoint(1))
+	assert.NoError(t, m.RemoveLoadCheckpoint(1))
+}
+
+func TestInvalidateLoadCheckpoints(t *testing.T) {
+	catalog := mocks.NewQueryCoordCatalog(t)
+	catalog.EXPECT().GetLoadCheckpoints().Return(map[int64]*querypb.LoadCheckpoint{
+		1: {CollectionID: 1, TargetVersion: 10},
+		2: {CollectionID: 2, TargetVersion: 10},
+	}, nil)
+	catalog.EXPECT().SaveLoadCheckpoints(mock.Anything).Return(nil)
+	removed := make(chan []int64, 2)
+	catalog.EXPECT().RemoveLoadCheckpoints(mock.Anything, mock.Anything).RunAndReturn(func(collectionIDs ...int64) error {
+		removed <- collectionIDs
+		return nil
+	}).Once()
+	catalog.EXPECT().RemoveLoadCheckpoints(mock.Anything).RunAndReturn(func(collectionIDs ...int64) error {
+		removed <- collectionIDs
+		return nil
+	}).Once()
+
+	m := NewLoadCheckpointManager(catalog)
+	assert.NoError(t, m.RecoverLoadCheckpoints())
+
+	// the checkpoints are dropped at once and removed from meta store in one batch
+	m.InvalidateLoadCheckpoints()
+	assert.Nil(t, m.GetLoadCheckpoint(1))
+	assert.Nil(t, m.GetLoadCheckpoint(2))
+	assert.ElementsMatch(t, []int64{1, 2}, <-removed)
+	// nothing to invalidate
+	m.InvalidateLoadCheckpoints()
+
+	// the checkpoint taken again before the removal is kept
+	assert.NoError(t, m.SaveLoadCheckpoint(3, 10, nil))
+	m.removeInvalidated(map[int64]*querypb.LoadCheckpoint{3: {CollectionID: 3}, 4: {CollectionID: 4}})
+	assert.Equal(t, []int64{4}, <-removed)
+	assert.NotNil(t, m.GetLoadCheckpoint(3))
+}
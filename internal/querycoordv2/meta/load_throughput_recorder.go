@@ -0,0 +1,122 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"sync"
+	"time"
+)
+
+// the number of recent loads used to calculate the throughput of a collection
+const throughputWindow = 5
+
+var GlobalLoadThroughputRecorder = NewLoadThroughputRecorder()
+
+type loadSample struct {
+	segmentNum int
+	elapsed    time.Duration
+}
+
+// LoadThroughputRecorder records the throughput of the recent loads of each collection,
+// and estimates how long the ongoing loads will take.
+type LoadThroughputRecorder struct {
+	mu sync.RWMutex
+	// CollectionID -> recent load samples
+	samples map[int64][]loadSample
+	// CollectionID -> estimated finish time of the ongoing load
+	deadlines map[int64]time.Time
+}
+
+func NewLoadThroughputRecorder() *LoadThroughputRecorder {
+	return &LoadThroughputRecorder{
+		samples:   make(map[int64][]loadSample),
+		deadlines: make(map[int64]time.Time),
+	}
+}
+
+// Record records a finished load of the collection, and clears its estimated finish time.
+func (r *LoadThroughputRecorder) Record(collectionID int64, segmentNum int, elapsed time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.deadlines, collectionID)
+	if segmentNum <= 0 || elapsed <= 0 {
+		return
+	}
+	samples := append(r.samples[collectionID], loadSample{segmentNum: segmentNum, elapsed: elapsed})
+	if len(samples) > throughputWindow {
+		samples = samples[len(samples)-throughputWindow:]
+	}
+	r.samples[collectionID] = samples
+}
+
+// Estimate returns the estimated duration to load the given number of segments of the collection,
+// returns 0 if there is no load history of the collection.
+func (r *LoadThroughputRecorder) Estimate(collectionID int64, segmentNum int) time.Duration {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var (
+		totalSegment int
+		totalElapsed time.Duration
+	)
+	for _, sample := range r.samples[collectionID] {
+		totalSegment += sample.segmentNum
+		totalElapsed += sample.elapsed
+	}
+	if segmentNum <= 0 || totalSegment == 0 {
+		return 0
+	}
+	return time.Duration(float64(totalElapsed) / float64(totalSegment) * float64(segmentNum))
+}
+
+// SetEstimate sets the estimated duration of the ongoing load of the collection.
+func (r *LoadThroughputRecorder) SetEstimate(collectionID int64, estimate time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if estimate <= 0 {
+		delete(r.deadlines, collectionID)
+		return
+	}
+	r.deadlines[collectionID] = time.Now().Add(estimate)
+}
+
+// Remaining returns the estimated remaining duration of the ongoing load of the collection,
+// returns 0 if no estimate or the estimated finish time has passed.
+func (r *LoadThroughputRecorder) Remaining(collectionID int64) time.Duration {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	deadline, ok := r.deadlines[collectionID]
+	if !ok {
+		return 0
+	}
+	if remaining := time.Until(deadline); remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
+// Remove removes the load history and the estimate of the collection, should be called once the collection is released.
+func (r *LoadThroughputRecorder) Remove(collectionID int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.samples, collectionID)
+	delete(r.deadlines, collectionID)
+}
@@ -0,0 +1,68 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadThroughputRecorder(t *testing.T) {
+	recorder := NewLoadThroughputRecorder()
+	colID := int64(1)
+
+	// no history
+	assert.Equal(t, time.Duration(0), recorder.Estimate(colID, 10))
+	recorder.SetEstimate(colID, recorder.Estimate(colID, 10))
+	assert.Equal(t, time.Duration(0), recorder.Remaining(colID))
+
+	// invalid samples are ignored
+	recorder.Record(colID, 0, time.Second)
+	recorder.Record(colID, 10, 0)
+	assert.Equal(t, time.Duration(0), recorder.Estimate(colID, 10))
+
+	recorder.Record(colID, 10, 10*time.Second)
+	recorder.Record(colID, 30, 10*time.Second)
+	assert.Equal(t, 5*time.Second, recorder.Estimate(colID, 10))
+	assert.Equal(t, time.Duration(0), recorder.Estimate(colID, 0))
+	assert.Equal(t, time.Duration(0), recorder.Estimate(colID+1, 10))
+
+	// only recent loads count
+	for i := 0; i < throughputWindow; i++ {
+		recorder.Record(colID, 10, time.Second)
+	}
+	assert.Equal(t, time.Second, recorder.Estimate(colID, 10))
+
+	recorder.SetEstimate(colID, time.Hour)
+	remaining := recorder.Remaining(colID)
+	assert.Greater(t, remaining, 59*time.Minute)
+	assert.LessOrEqual(t, remaining, time.Hour)
+
+	// finished load clears the estimate
+	recorder.Record(colID, 10, time.Second)
+	assert.Equal(t, time.Duration(0), recorder.Remaining(colID))
+
+	// released collection leaves nothing behind
+	recorder.SetEstimate(colID, time.Hour)
+	recorder.Remove(colID)
+	assert.Equal(t, time.Duration(0), recorder.Estimate(colID, 10))
+	assert.Equal(t, time.Duration(0), recorder.Remaining(colID))
+	assert.Empty(t, recorder.samples)
+	assert.Empty(t, recorder.deadlines)
+}
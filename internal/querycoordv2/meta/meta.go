@@ -17,14 +17,20 @@
 package meta
 
 import (
+	"fmt"
+
 	"github.com/milvus-io/milvus/internal/metastore"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 type Meta struct {
 	*CollectionManager
 	*ReplicaManager
 	*ResourceManager
+	*BalanceLayoutManager
+	*LoadCheckpointManager
+	*ShardBlockManager
 }
 
 func NewMeta(
@@ -36,5 +42,58 @@ func NewMeta(
 		NewCollectionManager(catalog),
 		NewReplicaManager(idAllocator, catalog),
 		NewResourceManager(catalog, nodeMgr),
+		NewBalanceLayoutManager(catalog),
+		NewLoadCheckpointManager(catalog),
+		NewShardBlockManager(catalog),
+	}
+}
+
+// TransferReplica transfers replicas of the collection between resource groups atomically,
+// the resource groups can't be removed until the transfer is done.
+// Lock order: ResourceManager before ReplicaManager.
+func (m *Meta) TransferReplica(collectionID int64, srcRGName string, dstRGName string, replicaNum int) error {
+	m.ResourceManager.rwmutex.RLock()
+	defer m.ResourceManager.rwmutex.RUnlock()
+
+	for _, rgName := range []string{srcRGName, dstRGName} {
+		if m.ResourceManager.groups[rgName] == nil {
+			return merr.WrapErrResourceGroupNotFound(rgName)
+		}
+	}
+	return m.ReplicaManager.TransferReplica(collectionID, srcRGName, dstRGName, replicaNum)
+}
+
+// MoveCollectionReplicas moves all replicas of the collection into the resource group atomically,
+// the resource group must have enough nodes excluding the reserved ones to hold all replicas,
+// and can't be removed until the move is done.
+// Lock order: ResourceManager before ReplicaManager.
+func (m *Meta) MoveCollectionReplicas(collectionID int64, dstRGName string, reservedNodeNum int) error {
+	m.ResourceManager.rwmutex.RLock()
+	defer m.ResourceManager.rwmutex.RUnlock()
+
+	rg := m.ResourceManager.groups[dstRGName]
+	if rg == nil {
+		return merr.WrapErrResourceGroupNotFound(dstRGName)
+	}
+	nodeNum := len(rg.GetNodes()) - reservedNodeNum
+	replicaNum := len(m.ReplicaManager.GetByCollection(collectionID))
+	if replicaNum > nodeNum {
+		return merr.WrapErrResourceGroupNodeNotEnough(dstRGName, nodeNum, replicaNum)
+	}
+	return m.ReplicaManager.MoveCollectionReplicas(collectionID, dstRGName)
+}
+
+// RemoveResourceGroup removes the resource group if there is no replica in it,
+// replicas can't be transferred into the resource group during the removal.
+// Lock order: ResourceManager before ReplicaManager.
+func (m *Meta) RemoveResourceGroup(rgName string) error {
+	m.ResourceManager.rwmutex.Lock()
+	defer m.ResourceManager.rwmutex.Unlock()
+
+	if replicas := m.ReplicaManager.GetByResourceGroup(rgName); len(replicas) > 0 {
+		return merr.WrapErrParameterInvalid("empty resource group",
+			fmt.Sprintf("resource group %s has collection %d loaded", rgName, replicas[0].GetCollectionID()),
+			"some replicas still loaded in resource group, release it first")
 	}
+	return m.ResourceManager.removeResourceGroup(rgName)
 }
@@ -0,0 +1,110 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func TestMetaTransferReplica(t *testing.T) {
+	paramtable.Init()
+	catalog := mocks.NewQueryCoordCatalog(t)
+	catalog.EXPECT().SaveReplica(mock.Anything).Return(nil)
+	catalog.EXPECT().SaveReplica(mock.Anything, mock.Anything).Return(nil)
+	catalog.EXPECT().SaveResourceGroup(mock.Anything).Return(nil)
+	catalog.EXPECT().RemoveResourceGroup(mock.Anything).Return(nil)
+
+	m := NewMeta(params.RandomIncrementIDAllocator(), catalog, session.NewNodeManager())
+	assert.NoError(t, m.ResourceManager.AddResourceGroup("rg1", newResourceGroupConfig(0, 0)))
+	for _, id := range []int64{1, 2} {
+		assert.NoError(t, m.ReplicaManager.Put(NewReplica(&querypb.Replica{
+			ID:            id,
+			CollectionID:  1,
+			ResourceGroup: DefaultResourceGroupName,
+		}, typeutil.NewUniqueSet())))
+	}
+
+	// none of the replicas is moved if the transfer is invalid
+	err := m.TransferReplica(1, DefaultResourceGroupName, "rg2", 2)
+	assert.ErrorIs(t, err, merr.ErrResourceGroupNotFound)
+	err = m.TransferReplica(1, DefaultResourceGroupName, "rg1", 3)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	assert.Len(t, m.ReplicaManager.GetByResourceGroup(DefaultResourceGroupName), 2)
+
+	assert.NoError(t, m.TransferReplica(1, DefaultResourceGroupName, "rg1", 2))
+	assert.Len(t, m.ReplicaManager.GetByResourceGroup("rg1"), 2)
+
+	// resource group with replicas can't be removed
+	err = m.RemoveResourceGroup("rg1")
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	assert.True(t, m.ResourceManager.ContainResourceGroup("rg1"))
+
+	assert.NoError(t, m.TransferReplica(1, "rg1", DefaultResourceGroupName, 2))
+	assert.NoError(t, m.RemoveResourceGroup("rg1"))
+	assert.False(t, m.ResourceManager.ContainResourceGroup("rg1"))
+}
+
+func TestMetaMoveCollectionReplicas(t *testing.T) {
+	paramtable.Init()
+	catalog := mocks.NewQueryCoordCatalog(t)
+	catalog.EXPECT().SaveReplica(mock.Anything).Return(nil)
+	catalog.EXPECT().SaveReplica(mock.Anything, mock.Anything).Return(nil)
+	catalog.EXPECT().SaveResourceGroup(mock.Anything).Return(nil)
+	catalog.EXPECT().SaveResourceGroup(mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	nodeMgr := session.NewNodeManager()
+	m := NewMeta(params.RandomIncrementIDAllocator(), catalog, nodeMgr)
+	assert.NoError(t, m.ResourceManager.AddResourceGroup("rg1", newResourceGroupConfig(2, 2)))
+	for _, node := range []int64{1, 2} {
+		nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   node,
+			Address:  "localhost",
+			Hostname: "localhost",
+		}))
+		m.ResourceManager.HandleNodeUp(node)
+	}
+	for _, id := range []int64{1, 2} {
+		assert.NoError(t, m.ReplicaManager.Put(NewReplica(&querypb.Replica{
+			ID:            id,
+			CollectionID:  1,
+			ResourceGroup: DefaultResourceGroupName,
+		}, typeutil.NewUniqueSet())))
+	}
+
+	// none of the replicas is moved if the move is invalid
+	err := m.MoveCollectionReplicas(1, "rg2", 0)
+	assert.ErrorIs(t, err, merr.ErrResourceGroupNotFound)
+	err = m.MoveCollectionReplicas(1, "rg1", 1)
+	assert.ErrorIs(t, err, merr.ErrResourceGroupNodeNotEnough)
+	err = m.MoveCollectionReplicas(2, "rg1", 0)
+	assert.ErrorIs(t, err, merr.ErrCollectionNotLoaded)
+	assert.Len(t, m.ReplicaManager.GetByResourceGroup(DefaultResourceGroupName), 2)
+
+	assert.NoError(t, m.MoveCollectionReplicas(1, "rg1", 0))
+	assert.Len(t, m.ReplicaManager.GetByResourceGroup("rg1"), 2)
+}
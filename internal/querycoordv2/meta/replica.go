@@ -61,6 +61,17 @@ func (replica *Replica) GetResourceGroup() string {
 	return replica.replicaPB.GetResourceGroup()
 }
 
+// GetTenant returns the tenant of the replica, replicas of different tenants never share query nodes.
+func (replica *Replica) GetTenant() string {
+	return replica.replicaPB.GetTenant()
+}
+
+// IsStandby returns whether the replica is a warm standby replica,
+// standby replica is loaded but not serving until it's promoted.
+func (replica *Replica) IsStandby() bool {
+	return replica.replicaPB.GetStandby()
+}
+
 // GetNodes returns the rw nodes of the replica.
 // readonly, don't modify the returned slice.
 func (replica *Replica) GetNodes() []int64 {
@@ -138,6 +149,11 @@ func (replica *mutableReplica) SetResourceGroup(resourceGroup string) {
 	replica.replicaPB.ResourceGroup = resourceGroup
 }
 
+// SetStandby sets the replica as standby replica or not.
+func (replica *mutableReplica) SetStandby(standby bool) {
+	replica.replicaPB.Standby = standby
+}
+
 // AddRWNode adds the node to rw nodes of the replica.
 func (replica *mutableReplica) AddRWNode(nodes ...int64) {
 	replica.Replica.AddRWNode(nodes...)
@@ -21,6 +21,7 @@ import (
 	"sync"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/metastore"
@@ -99,7 +100,74 @@ func (m *ReplicaManager) Spawn(collection int64, replicaNumInRG map[string]int)
 	if m.collIDToReplicaIDs[collection] != nil {
 		return nil, fmt.Errorf("replicas of collection %d is already spawned", collection)
 	}
+	return m.spawn(collection, "", replicaNumInRG)
+}
+
+// SpawnMore spawns extra replicas for collection whose replicas have been spawned,
+// it's used to top up replicas of best-effort loaded collection.
+func (m *ReplicaManager) SpawnMore(collection int64, replicaNumInRG map[string]int) ([]*Replica, error) {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+	if m.collIDToReplicaIDs[collection] == nil {
+		return nil, fmt.Errorf("replicas of collection %d is not spawned", collection)
+	}
+	return m.spawn(collection, "", replicaNumInRG)
+}
+
+// SpawnForTenant spawns replicas for the collection of the tenant, whose replicas may have been spawned,
+// the resource groups should have enough nodes to isolate all replicas of the collection from the other tenants.
+// The check and the spawn are done under lock, so the concurrent spawns of different tenants can't count on the same nodes.
+func (m *ReplicaManager) SpawnForTenant(collection int64, tenant string, replicaNumInRG map[string]int, rgNodes map[string][]int64) ([]*Replica, error) {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	requiredNumInRG := make(map[string]int)
+	for replicaID := range m.collIDToReplicaIDs[collection] {
+		requiredNumInRG[m.replicas[replicaID].GetResourceGroup()]++
+	}
+	for rgName, num := range replicaNumInRG {
+		requiredNumInRG[rgName] += num
+		if isolated := len(rgNodes[rgName]) - m.getTenantReservedNodeNum(tenant, rgName); requiredNumInRG[rgName] > isolated {
+			return nil, errors.Wrapf(ErrNodeNotEnough, "resource group %s has only %d nodes not reserved by other tenants, %d replicas required",
+				rgName, isolated, requiredNumInRG[rgName])
+		}
+	}
+	return m.spawn(collection, tenant, replicaNumInRG)
+}
+
+// GetTenantReservedNodeNum returns the number of nodes in the resource group reserved by the replicas of the tenants other than the given one,
+// every replica reserves one node, the extra nodes held by it could be reclaimed by recovery.
+func (m *ReplicaManager) GetTenantReservedNodeNum(tenant string, rgName string) int {
+	m.rwmutex.RLock()
+	defer m.rwmutex.RUnlock()
+	return m.getTenantReservedNodeNum(tenant, rgName)
+}
+
+func (m *ReplicaManager) getTenantReservedNodeNum(tenant string, rgName string) int {
+	reserved := 0
+	for _, replica := range m.replicas {
+		if replica.GetTenant() != "" && replica.GetTenant() != tenant && replica.GetResourceGroup() == rgName {
+			reserved++
+		}
+	}
+	return reserved
+}
+
+// GetByOtherTenants returns the replicas of the tenants other than the given one, replicas without tenant are excluded.
+func (m *ReplicaManager) GetByOtherTenants(tenant string) []*Replica {
+	m.rwmutex.RLock()
+	defer m.rwmutex.RUnlock()
 
+	replicas := make([]*Replica, 0)
+	for _, replica := range m.replicas {
+		if replica.GetTenant() != "" && replica.GetTenant() != tenant {
+			replicas = append(replicas, replica)
+		}
+	}
+	return replicas
+}
+
+func (m *ReplicaManager) spawn(collection int64, tenant string, replicaNumInRG map[string]int) ([]*Replica, error) {
 	replicas := make([]*Replica, 0)
 	for rgName, replicaNum := range replicaNumInRG {
 		for ; replicaNum > 0; replicaNum-- {
@@ -111,6 +179,7 @@ func (m *ReplicaManager) Spawn(collection int64, replicaNumInRG map[string]int)
 				ID:            id,
 				CollectionID:  collection,
 				ResourceGroup: rgName,
+				Tenant:        tenant,
 			}))
 		}
 	}
@@ -189,6 +258,76 @@ func (m *ReplicaManager) TransferReplica(collectionID typeutil.UniqueID, srcRGNa
 	return m.put(replicas...)
 }
 
+// MoveCollectionReplicas moves all replicas of the collection into dstRGName at once,
+// the replicas which are already in dstRGName are kept as is.
+// Node Change will be executed by replica_observer in background.
+func (m *ReplicaManager) MoveCollectionReplicas(collectionID typeutil.UniqueID, dstRGName string) error {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	if m.collIDToReplicaIDs[collectionID] == nil {
+		return merr.WrapErrCollectionNotLoaded(collectionID)
+	}
+
+	replicas := make([]*Replica, 0, len(m.collIDToReplicaIDs[collectionID]))
+	for replicaID := range m.collIDToReplicaIDs[collectionID] {
+		replica := m.replicas[replicaID]
+		if replica.GetResourceGroup() == dstRGName {
+			continue
+		}
+		mutableReplica := replica.copyForWrite()
+		mutableReplica.SetResourceGroup(dstRGName)
+		replicas = append(replicas, mutableReplica.IntoReplica())
+	}
+	return m.put(replicas...)
+}
+
+// SetStandby marks given replicas as standby replica or primary replica.
+func (m *ReplicaManager) SetStandby(standby bool, replicaIDs ...typeutil.UniqueID) error {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	replicas := make([]*Replica, 0, len(replicaIDs))
+	for _, replicaID := range replicaIDs {
+		replica, ok := m.replicas[replicaID]
+		if !ok {
+			return merr.WrapErrReplicaNotFound(replicaID)
+		}
+		mutableReplica := replica.copyForWrite()
+		mutableReplica.SetStandby(standby)
+		replicas = append(replicas, mutableReplica.IntoReplica())
+	}
+	return m.put(replicas...)
+}
+
+// PromoteStandby promotes the standby replica to primary, and demotes the primary replica to standby.
+// Both replicas should belong to same collection.
+func (m *ReplicaManager) PromoteStandby(primaryID typeutil.UniqueID, standbyID typeutil.UniqueID) error {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	primary, ok := m.replicas[primaryID]
+	if !ok {
+		return merr.WrapErrReplicaNotFound(primaryID)
+	}
+	standby, ok := m.replicas[standbyID]
+	if !ok {
+		return merr.WrapErrReplicaNotFound(standbyID)
+	}
+	if primary.GetCollectionID() != standby.GetCollectionID() {
+		return merr.WrapErrParameterInvalidMsg("replica %d and replica %d belong to different collections", primaryID, standbyID)
+	}
+	if primary.IsStandby() || !standby.IsStandby() {
+		return merr.WrapErrParameterInvalidMsg("replica %d is not primary or replica %d is not standby", primaryID, standbyID)
+	}
+
+	mutablePrimary := primary.copyForWrite()
+	mutablePrimary.SetStandby(true)
+	mutableStandby := standby.copyForWrite()
+	mutableStandby.SetStandby(false)
+	return m.put(mutablePrimary.IntoReplica(), mutableStandby.IntoReplica())
+}
+
 // getSrcReplicasAndCheckIfTransferable checks if the collection can be transfer from srcRGName to dstRGName.
 func (m *ReplicaManager) getSrcReplicasAndCheckIfTransferable(collectionID typeutil.UniqueID, srcRGName string, replicaNum int) ([]*Replica, error) {
 	// Check if collection is loaded.
@@ -231,6 +370,27 @@ func (m *ReplicaManager) RemoveCollection(collectionID typeutil.UniqueID) error
 	return nil
 }
 
+// RemoveReplicas removes the given replicas of the collection,
+// the replicas spawned by a failed load are removed by this.
+func (m *ReplicaManager) RemoveReplicas(collectionID typeutil.UniqueID, replicas ...typeutil.UniqueID) error {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	for _, replica := range replicas {
+		if err := m.catalog.ReleaseReplica(collectionID, replica); err != nil {
+			return err
+		}
+		delete(m.replicas, replica)
+		if ids, ok := m.collIDToReplicaIDs[collectionID]; ok {
+			ids.Remove(replica)
+			if ids.Len() == 0 {
+				delete(m.collIDToReplicaIDs, collectionID)
+			}
+		}
+	}
+	return nil
+}
+
 func (m *ReplicaManager) GetByCollection(collectionID typeutil.UniqueID) []*Replica {
 	m.rwmutex.RLock()
 	defer m.rwmutex.RUnlock()
@@ -310,6 +470,12 @@ func (m *ReplicaManager) GetByResourceGroup(rgName string) []*Replica {
 // 2. Add new incoming nodes into the replica if they are not in-used by other replicas of same collection.
 // 3. replicas in same resource group will shared the nodes in resource group fairly.
 func (m *ReplicaManager) RecoverNodesInCollection(collectionID typeutil.UniqueID, rgs map[string]typeutil.UniqueSet) error {
+	return m.RecoverNodesInCollectionWithZones(collectionID, rgs, nil)
+}
+
+// RecoverNodesInCollectionWithZones works like RecoverNodesInCollection,
+// but the incoming nodes are allocated following the zone placement policy.
+func (m *ReplicaManager) RecoverNodesInCollectionWithZones(collectionID typeutil.UniqueID, rgs map[string]typeutil.UniqueSet, placement *ZonePlacement) error {
 	if err := m.validateResourceGroups(rgs); err != nil {
 		return err
 	}
@@ -332,7 +498,8 @@ func (m *ReplicaManager) RecoverNodesInCollection(collectionID typeutil.UniqueID
 			// There may be not enough incoming nodes for current replica,
 			// Even we filtering the nodes that are used by other replica of same collection in other resource group,
 			// current replica's expected node may be still used by other replica of same collection in same resource group.
-			incomingNode := replicaHelper.AllocateIncomingNodes(incomingNodeCount)
+			nodes := append(lo.Without(assignment.rwNodes.Collect(), roNodes...), recoverableNodes...)
+			incomingNode := replicaHelper.AllocateIncomingNodesByZone(incomingNodeCount, nodes, placement)
 			if len(roNodes) == 0 && len(recoverableNodes) == 0 && len(incomingNode) == 0 {
 				// nothing to do.
 				return
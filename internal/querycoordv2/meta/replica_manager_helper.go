@@ -3,6 +3,9 @@ package meta
 import (
 	"sort"
 
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -270,3 +273,81 @@ func (s replicaAssignmentInfoSortByAvailableAndRecoverable) Less(i, j int) bool
 	// Otherwise unstable assignment may cause unnecessary node transfer.
 	return left < right || (left == right && s.replicaAssignmentInfoSorter[i].replicaID < s.replicaAssignmentInfoSorter[j].replicaID)
 }
+
+// ZonePlacement describes how the nodes of replicas should be placed across availability zones.
+type ZonePlacement struct {
+	Policy querypb.ZonePlacementPolicy
+	// node id -> zone, the node without zone is regarded as in an anonymous zone.
+	NodeZones map[int64]string
+}
+
+func (p *ZonePlacement) enabled() bool {
+	return p != nil && p.Policy != querypb.ZonePlacementPolicy_ZonePlacementNone
+}
+
+// packZone returns the zone that the nodes should be packed into,
+// the zone holding most of the nodes, or the zone with most candidates if nodes is empty.
+func (p *ZonePlacement) packZone(nodes []int64, candidates []int64) string {
+	if len(nodes) == 0 {
+		nodes = candidates
+	}
+	count := make(map[string]int)
+	for _, node := range nodes {
+		count[p.NodeZones[node]]++
+	}
+	zone, most := "", 0
+	for z, c := range count {
+		// reach stable result by zone name.
+		if c > most || (c == most && z < zone) {
+			zone, most = z, c
+		}
+	}
+	return zone
+}
+
+// sortedIncomingNodes returns the incoming nodes in order to reach stable assignment.
+func (h *replicasInSameRGAssignmentHelper) sortedIncomingNodes() []int64 {
+	nodes := h.incomingNodes.Collect()
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i] < nodes[j] })
+	return nodes
+}
+
+// AllocateIncomingNodesByZone allocates at most n incoming nodes for the replica which holds the given nodes,
+// following the zone placement policy.
+// Spread policy prefers the nodes in the zones unused by the replica, and falls back to any node.
+// Pack policy only allocates the nodes in the zone of the replica.
+func (h *replicasInSameRGAssignmentHelper) AllocateIncomingNodesByZone(n int, nodes []int64, placement *ZonePlacement) []int64 {
+	if !placement.enabled() {
+		return h.AllocateIncomingNodes(n)
+	}
+
+	candidates := h.sortedIncomingNodes()
+	nodeIDs := make([]int64, 0, n)
+	switch placement.Policy {
+	case querypb.ZonePlacementPolicy_ZonePlacementSpread:
+		usedZones := typeutil.NewSet[string]()
+		for _, node := range nodes {
+			usedZones.Insert(placement.NodeZones[node])
+		}
+		for _, node := range candidates {
+			if len(nodeIDs) < n && !usedZones.Contain(placement.NodeZones[node]) {
+				usedZones.Insert(placement.NodeZones[node])
+				nodeIDs = append(nodeIDs, node)
+			}
+		}
+		for _, node := range candidates {
+			if len(nodeIDs) < n && !lo.Contains(nodeIDs, node) {
+				nodeIDs = append(nodeIDs, node)
+			}
+		}
+	case querypb.ZonePlacementPolicy_ZonePlacementPack:
+		zone := placement.packZone(nodes, candidates)
+		for _, node := range candidates {
+			if len(nodeIDs) < n && placement.NodeZones[node] == zone {
+				nodeIDs = append(nodeIDs, node)
+			}
+		}
+	}
+	h.incomingNodes.Remove(nodeIDs...)
+	return nodeIDs
+}
@@ -17,8 +17,10 @@
 package meta
 
 import (
+	"sync"
 	"testing"
 
+	"github.com/cockroachdb/errors"
 	"github.com/golang/protobuf/proto"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/suite"
@@ -31,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -258,6 +261,143 @@ func (suite *ReplicaManagerSuite) TestNodeManipulate() {
 	}
 }
 
+func (suite *ReplicaManagerSuite) TestRecoverNodesWithZones() {
+	mgr := suite.mgr
+
+	rgs := map[string]typeutil.UniqueSet{
+		"RGZ": typeutil.NewUniqueSet(11, 12, 13, 14),
+	}
+	zones := map[int64]string{11: "az1", 12: "az1", 13: "az2", 14: "az2"}
+	zonesOf := func(replica *Replica) typeutil.Set[string] {
+		ret := typeutil.NewSet[string]()
+		for _, node := range replica.GetNodes() {
+			ret.Insert(zones[node])
+		}
+		return ret
+	}
+
+	// spread
+	_, err := mgr.Spawn(200, map[string]int{"RGZ": 2})
+	suite.NoError(err)
+	err = mgr.RecoverNodesInCollectionWithZones(200, rgs, &ZonePlacement{
+		Policy:    querypb.ZonePlacementPolicy_ZonePlacementSpread,
+		NodeZones: zones,
+	})
+	suite.NoError(err)
+	for _, replica := range mgr.GetByCollection(200) {
+		suite.Len(replica.GetNodes(), 2)
+		suite.ElementsMatch([]string{"az1", "az2"}, zonesOf(replica).Collect())
+	}
+
+	// pack
+	_, err = mgr.Spawn(201, map[string]int{"RGZ": 2})
+	suite.NoError(err)
+	err = mgr.RecoverNodesInCollectionWithZones(201, rgs, &ZonePlacement{
+		Policy:    querypb.ZonePlacementPolicy_ZonePlacementPack,
+		NodeZones: zones,
+	})
+	suite.NoError(err)
+	for _, replica := range mgr.GetByCollection(201) {
+		suite.Len(replica.GetNodes(), 2)
+		suite.Equal(1, zonesOf(replica).Len())
+	}
+
+	// pack replica only grows in its own zone
+	rgs["RGZ"].Insert(15, 16)
+	zones[15] = "az2"
+	zones[16] = "az3"
+	err = mgr.RecoverNodesInCollectionWithZones(201, rgs, &ZonePlacement{
+		Policy:    querypb.ZonePlacementPolicy_ZonePlacementPack,
+		NodeZones: zones,
+	})
+	suite.NoError(err)
+	for _, replica := range mgr.GetByCollection(201) {
+		suite.Equal(1, zonesOf(replica).Len())
+		suite.NotContains(replica.GetNodes(), int64(16))
+	}
+}
+
+func (suite *ReplicaManagerSuite) TestStandby() {
+	mgr := suite.mgr
+
+	replicas := mgr.GetByCollection(101)
+	suite.Len(replicas, 2)
+	primary, standby := replicas[0], replicas[1]
+
+	err := mgr.SetStandby(true, standby.GetID())
+	suite.NoError(err)
+	suite.True(mgr.Get(standby.GetID()).IsStandby())
+	suite.False(mgr.Get(primary.GetID()).IsStandby())
+
+	// invalid promotion
+	err = mgr.PromoteStandby(standby.GetID(), primary.GetID())
+	suite.Error(err)
+	err = mgr.PromoteStandby(primary.GetID(), mgr.GetByCollection(100)[0].GetID())
+	suite.Error(err)
+	err = mgr.PromoteStandby(primary.GetID(), 10000)
+	suite.ErrorIs(err, merr.ErrReplicaNotFound)
+
+	err = mgr.PromoteStandby(primary.GetID(), standby.GetID())
+	suite.NoError(err)
+	suite.True(mgr.Get(primary.GetID()).IsStandby())
+	suite.False(mgr.Get(standby.GetID()).IsStandby())
+
+	// Check these modifications are applied to meta store
+	suite.clearMemory()
+	mgr.Recover(lo.Keys(suite.collections))
+	suite.True(mgr.Get(primary.GetID()).IsStandby())
+	suite.False(mgr.Get(standby.GetID()).IsStandby())
+}
+
+func (suite *ReplicaManagerSuite) TestSpawnForTenant() {
+	mgr := suite.mgr
+	rgNodes := map[string][]int64{"RG3": suite.rgs["RG3"].Collect()}
+
+	// the concurrent spawns of different tenants can't both count on the same nodes
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i, tenant := range []string{"a", "b"} {
+		wg.Add(1)
+		go func(i int, tenant string) {
+			defer wg.Done()
+			_, errs[i] = mgr.SpawnForTenant(int64(200+i), tenant, map[string]int{"RG3": 2}, rgNodes)
+		}(i, tenant)
+	}
+	wg.Wait()
+	suite.Len(lo.Filter(errs, func(err error, _ int) bool { return err == nil }), 1)
+	suite.Len(lo.Filter(errs, func(err error, _ int) bool { return errors.Is(err, ErrNodeNotEnough) }), 1)
+
+	spawned := append(mgr.GetByCollection(200), mgr.GetByCollection(201)...)
+	suite.Len(spawned, 2)
+	tenant := spawned[0].GetTenant()
+	suite.Equal(2, mgr.GetTenantReservedNodeNum("c", "RG3"))
+
+	// the replicas of the same tenant don't reserve nodes against each other
+	_, err := mgr.SpawnForTenant(202, tenant, map[string]int{"RG3": 3}, rgNodes)
+	suite.NoError(err)
+	suite.Len(mgr.GetByOtherTenants("c"), 5)
+	suite.Empty(mgr.GetByOtherTenants(tenant))
+}
+
+func (suite *ReplicaManagerSuite) TestMoveCollectionReplicas() {
+	mgr := suite.mgr
+
+	err := mgr.MoveCollectionReplicas(10086, "RG3")
+	suite.ErrorIs(err, merr.ErrCollectionNotLoaded)
+
+	err = mgr.MoveCollectionReplicas(103, "RG3")
+	suite.NoError(err)
+	suite.Equal([]string{"RG3"}, mgr.GetResourceGroupByCollection(103).Collect())
+	suite.Len(mgr.GetByCollection(103), 3)
+
+	// the replicas are persisted
+	suite.clearMemory()
+	mgr.Recover(lo.Keys(suite.collections))
+	for _, replica := range mgr.GetByCollection(103) {
+		suite.Equal("RG3", replica.GetResourceGroup())
+	}
+}
+
 func (suite *ReplicaManagerSuite) spawnAll() {
 	mgr := suite.mgr
 
@@ -30,9 +30,10 @@ func newResourceGroupConfig(request int32, limit int32) *rgpb.ResourceGroupConfi
 }
 
 type ResourceGroup struct {
-	name  string
-	nodes typeutil.UniqueSet
-	cfg   *rgpb.ResourceGroupConfig
+	name         string
+	nodes        typeutil.UniqueSet
+	cfg          *rgpb.ResourceGroupConfig
+	nodeSelector map[string]string
 }
 
 // NewResourceGroup create resource group.
@@ -58,6 +59,7 @@ func NewResourceGroupFromMeta(meta *querypb.ResourceGroup) *ResourceGroup {
 		}
 	}
 	rg := NewResourceGroup(meta.Name, meta.Config)
+	rg.nodeSelector = meta.GetNodeSelector()
 	for _, node := range meta.GetNodes() {
 		rg.nodes.Insert(node)
 	}
@@ -91,6 +93,22 @@ func (rg *ResourceGroup) GetConfigCloned() *rgpb.ResourceGroupConfig {
 	return proto.Clone(rg.cfg).(*rgpb.ResourceGroupConfig)
 }
 
+// GetNodeSelector return the node label selector of resource group.
+func (rg *ResourceGroup) GetNodeSelector() map[string]string {
+	return rg.nodeSelector
+}
+
+// MatchNodeLabels return whether the node with given labels can be assigned to resource group automatically.
+// A resource group without selector accepts any node.
+func (rg *ResourceGroup) MatchNodeLabels(labels map[string]string) bool {
+	for key, value := range rg.nodeSelector {
+		if v, ok := labels[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
 // GetNodes return nodes of resource group.
 func (rg *ResourceGroup) GetNodes() []int64 {
 	return rg.nodes.Collect()
@@ -166,19 +184,21 @@ func (rg *ResourceGroup) HasTo(rgName string) bool {
 func (rg *ResourceGroup) GetMeta() *querypb.ResourceGroup {
 	capacity := rg.GetCapacity()
 	return &querypb.ResourceGroup{
-		Name:     rg.name,
-		Capacity: int32(capacity),
-		Nodes:    rg.nodes.Collect(),
-		Config:   rg.GetConfigCloned(),
+		Name:         rg.name,
+		Capacity:     int32(capacity),
+		Nodes:        rg.nodes.Collect(),
+		Config:       rg.GetConfigCloned(),
+		NodeSelector: rg.nodeSelector,
 	}
 }
 
 // Snapshot return a snapshot of resource group.
 func (rg *ResourceGroup) Snapshot() *ResourceGroup {
 	return &ResourceGroup{
-		name:  rg.name,
-		nodes: rg.nodes.Clone(),
-		cfg:   rg.GetConfigCloned(),
+		name:         rg.name,
+		nodes:        rg.nodes.Clone(),
+		cfg:          rg.GetConfigCloned(),
+		nodeSelector: rg.nodeSelector,
 	}
 }
 
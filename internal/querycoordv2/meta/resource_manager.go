@@ -117,9 +117,18 @@ func (rm *ResourceManager) Recover() error {
 // AddResourceGroup create a new ResourceGroup.
 // Do no changed with node, all node will be reassign to new resource group by auto recover.
 func (rm *ResourceManager) AddResourceGroup(rgName string, cfg *rgpb.ResourceGroupConfig) error {
+	return rm.AddResourceGroupWithNodeSelector(rgName, cfg, nil)
+}
+
+// AddResourceGroupWithNodeSelector create a new ResourceGroup with a node label selector.
+// Only the nodes whose labels match all of the selector will be assigned to it automatically.
+func (rm *ResourceManager) AddResourceGroupWithNodeSelector(rgName string, cfg *rgpb.ResourceGroupConfig, nodeSelector map[string]string) error {
 	if len(rgName) == 0 {
 		return merr.WrapErrParameterMissing("resource group name couldn't be empty")
 	}
+	if rgName == DefaultResourceGroupName && len(nodeSelector) > 0 {
+		return merr.WrapErrParameterInvalidMsg("node selector couldn't be set on the default resource group")
+	}
 	if cfg == nil {
 		// Use default config if not set, compatible with old client.
 		cfg = newResourceGroupConfig(0, 0)
@@ -130,7 +139,7 @@ func (rm *ResourceManager) AddResourceGroup(rgName string, cfg *rgpb.ResourceGro
 	if rm.groups[rgName] != nil {
 		// Idempotent promise.
 		// If resource group already exist, check if configuration is the same,
-		if proto.Equal(rm.groups[rgName].GetConfig(), cfg) {
+		if proto.Equal(rm.groups[rgName].GetConfig(), cfg) && isSameNodeSelector(rm.groups[rgName].GetNodeSelector(), nodeSelector) {
 			return nil
 		}
 		return merr.WrapErrResourceGroupAlreadyExist(rgName)
@@ -146,10 +155,14 @@ func (rm *ResourceManager) AddResourceGroup(rgName string, cfg *rgpb.ResourceGro
 	}
 
 	rg := NewResourceGroup(rgName, cfg)
+	if len(nodeSelector) > 0 {
+		rg.nodeSelector = nodeSelector
+	}
 	if err := rm.catalog.SaveResourceGroup(rg.GetMeta()); err != nil {
 		log.Warn("failed to add resource group",
 			zap.String("rgName", rgName),
 			zap.Any("config", cfg),
+			zap.Any("nodeSelector", nodeSelector),
 			zap.Error(err),
 		)
 		return merr.WrapErrResourceGroupServiceAvailable()
@@ -159,6 +172,7 @@ func (rm *ResourceManager) AddResourceGroup(rgName string, cfg *rgpb.ResourceGro
 	log.Info("add resource group",
 		zap.String("rgName", rgName),
 		zap.Any("config", cfg),
+		zap.Any("nodeSelector", nodeSelector),
 	)
 
 	// notify that resource group config has been changed.
@@ -281,6 +295,10 @@ func (rm *ResourceManager) RemoveResourceGroup(rgName string) error {
 	rm.rwmutex.Lock()
 	defer rm.rwmutex.Unlock()
 
+	return rm.removeResourceGroup(rgName)
+}
+
+func (rm *ResourceManager) removeResourceGroup(rgName string) error {
 	if rm.groups[rgName] == nil {
 		// Idempotent promise: delete a non-exist rg should be ok
 		return nil
@@ -536,6 +554,43 @@ func (rm *ResourceManager) AutoRecoverResourceGroup(rgName string) error {
 	return nil
 }
 
+// FillResourceGroupFromDefault transfers the oversized nodes of the default resource group to the given resource group,
+// up to its requested node number, the default resource group keeps its requested nodes.
+// Return the number of transferred nodes.
+func (rm *ResourceManager) FillResourceGroupFromDefault(rgName string) (int, error) {
+	if rgName == DefaultResourceGroupName {
+		return 0, merr.WrapErrParameterInvalidMsg("couldn't fill the default resource group from itself")
+	}
+
+	rm.rwmutex.Lock()
+	defer rm.rwmutex.Unlock()
+
+	if rm.groups[rgName] == nil {
+		return 0, merr.WrapErrResourceGroupNotFound(rgName)
+	}
+
+	filled := 0
+	// the resource groups are copied on write, so fetch them again after every transfer.
+	for rm.groups[rgName].MissingNumOfNodes() > 0 && rm.groups[DefaultResourceGroupName].OversizedNumOfNodes() > 0 &&
+		rm.hasNodeMatchedRG(rm.groups[DefaultResourceGroupName], rm.groups[rgName]) {
+		node, err := rm.transferOneNodeFromRGToRG(rm.groups[DefaultResourceGroupName], rm.groups[rgName])
+		if err != nil {
+			log.Warn("failed to fill resource group from default resource group",
+				zap.String("rgName", rgName),
+				zap.Int("filled", filled),
+				zap.Error(err),
+			)
+			return filled, err
+		}
+		log.Info("fill resource group from default resource group",
+			zap.String("rgName", rgName),
+			zap.Int64("node", node),
+		)
+		filled++
+	}
+	return filled, nil
+}
+
 // recoverMissingNodeRG recover resource group by transfer node from other resource group.
 func (rm *ResourceManager) recoverMissingNodeRG(rgName string) error {
 	for rm.groups[rgName].MissingNumOfNodes() > 0 {
@@ -567,7 +622,7 @@ func (rm *ResourceManager) selectMissingRecoverSourceRG(rg *ResourceGroup) *Reso
 	// First, Transfer node from most redundant resource group first. `len(nodes) > limits`
 	if redundantRG := rm.findMaxRGWithGivenFilter(
 		func(sourceRG *ResourceGroup) bool {
-			return rg.GetName() != sourceRG.GetName() && sourceRG.RedundantNumOfNodes() > 0
+			return rg.GetName() != sourceRG.GetName() && sourceRG.RedundantNumOfNodes() > 0 && rm.hasNodeMatchedRG(sourceRG, rg)
 		},
 		func(sourceRG *ResourceGroup) int {
 			return sourceRG.RedundantNumOfNodes()
@@ -580,7 +635,7 @@ func (rm *ResourceManager) selectMissingRecoverSourceRG(rg *ResourceGroup) *Reso
 	// `TransferFrom` configured resource group at high priority.
 	return rm.findMaxRGWithGivenFilter(
 		func(sourceRG *ResourceGroup) bool {
-			return rg.GetName() != sourceRG.GetName() && sourceRG.OversizedNumOfNodes() > 0
+			return rg.GetName() != sourceRG.GetName() && sourceRG.OversizedNumOfNodes() > 0 && rm.hasNodeMatchedRG(sourceRG, rg)
 		},
 		func(sourceRG *ResourceGroup) int {
 			if rg.HasFrom(sourceRG.GetName()) {
@@ -624,7 +679,7 @@ func (rm *ResourceManager) selectRedundantRecoverTargetRG(rg *ResourceGroup) *Re
 	// First, Transfer node to most missing resource group first.
 	if missingRG := rm.findMaxRGWithGivenFilter(
 		func(targetRG *ResourceGroup) bool {
-			return rg.GetName() != targetRG.GetName() && targetRG.MissingNumOfNodes() > 0
+			return rg.GetName() != targetRG.GetName() && targetRG.MissingNumOfNodes() > 0 && rm.hasNodeMatchedRG(rg, targetRG)
 		},
 		func(targetRG *ResourceGroup) int {
 			return targetRG.MissingNumOfNodes()
@@ -637,7 +692,7 @@ func (rm *ResourceManager) selectRedundantRecoverTargetRG(rg *ResourceGroup) *Re
 	// `TransferTo` configured resource group at high priority.
 	if selectRG := rm.findMaxRGWithGivenFilter(
 		func(targetRG *ResourceGroup) bool {
-			return rg.GetName() != targetRG.GetName() && targetRG.ReachLimitNumOfNodes() > 0
+			return rg.GetName() != targetRG.GetName() && targetRG.ReachLimitNumOfNodes() > 0 && rm.hasNodeMatchedRG(rg, targetRG)
 		},
 		func(targetRG *ResourceGroup) int {
 			if rg.HasTo(targetRG.GetName()) {
@@ -659,17 +714,53 @@ func (rm *ResourceManager) selectRedundantRecoverTargetRG(rg *ResourceGroup) *Re
 
 // transferOneNodeFromRGToRG transfer one node from source resource group to target resource group.
 func (rm *ResourceManager) transferOneNodeFromRGToRG(sourceRG *ResourceGroup, targetRG *ResourceGroup) (int64, error) {
-	if sourceRG.NodeNum() == 0 {
+	// TODO: select node by some load strategy, such as segment loaded.
+	node, ok := rm.selectNodeMatchedRG(sourceRG, targetRG)
+	if !ok {
 		return -1, ErrNodeNotEnough
 	}
-	// TODO: select node by some load strategy, such as segment loaded.
-	node := sourceRG.GetNodes()[0]
 	if err := rm.transferNode(targetRG.GetName(), node); err != nil {
 		return -1, err
 	}
 	return node, nil
 }
 
+// selectNodeMatchedRG select a node of source resource group which matches the node selector of target resource group.
+func (rm *ResourceManager) selectNodeMatchedRG(sourceRG *ResourceGroup, targetRG *ResourceGroup) (int64, bool) {
+	for _, node := range sourceRG.GetNodes() {
+		if targetRG.MatchNodeLabels(rm.getNodeLabels(node)) {
+			return node, true
+		}
+	}
+	return -1, false
+}
+
+// hasNodeMatchedRG return whether source resource group has any node can be transferred to target resource group.
+func (rm *ResourceManager) hasNodeMatchedRG(sourceRG *ResourceGroup, targetRG *ResourceGroup) bool {
+	_, ok := rm.selectNodeMatchedRG(sourceRG, targetRG)
+	return ok
+}
+
+// GetNodeZones returns the availability zones of given nodes,
+// the node which is not online or doesn't advertise its zone is omitted.
+func (rm *ResourceManager) GetNodeZones(nodes ...int64) map[int64]string {
+	zones := make(map[int64]string, len(nodes))
+	for _, node := range nodes {
+		if info := rm.nodeMgr.Get(node); info != nil && info.Zone() != "" {
+			zones[node] = info.Zone()
+		}
+	}
+	return zones
+}
+
+// getNodeLabels return the labels of given node, nil if node is not online.
+func (rm *ResourceManager) getNodeLabels(node int64) map[string]string {
+	if info := rm.nodeMgr.Get(node); info != nil {
+		return info.Labels()
+	}
+	return nil
+}
+
 // assignIncomingNodeWithNodeCheck assign node to resource group with node status check.
 func (rm *ResourceManager) assignIncomingNodeWithNodeCheck(node int64) (string, error) {
 	// node is on stopping or stopped, remove it from incoming node set.
@@ -704,7 +795,7 @@ func (rm *ResourceManager) assignIncomingNode(node int64) (string, error) {
 	}
 
 	// select a resource group to assign incoming node.
-	rg = rm.mustSelectAssignIncomingNodeTargetRG()
+	rg = rm.mustSelectAssignIncomingNodeTargetRG(rm.getNodeLabels(node))
 	if err := rm.transferNode(rg.GetName(), node); err != nil {
 		return "", errors.Wrap(err, "at finally assign to default resource group")
 	}
@@ -712,11 +803,13 @@ func (rm *ResourceManager) assignIncomingNode(node int64) (string, error) {
 }
 
 // mustSelectAssignIncomingNodeTargetRG select resource group for assign incoming node.
-func (rm *ResourceManager) mustSelectAssignIncomingNodeTargetRG() *ResourceGroup {
+// Only the resource groups whose node selector match the labels of incoming node are selected,
+// the node which doesn't match any of them will be assigned to default resource group.
+func (rm *ResourceManager) mustSelectAssignIncomingNodeTargetRG(labels map[string]string) *ResourceGroup {
 	// First, Assign it to rg with the most missing nodes at high priority.
 	if rg := rm.findMaxRGWithGivenFilter(
 		func(rg *ResourceGroup) bool {
-			return rg.MissingNumOfNodes() > 0
+			return rg.MissingNumOfNodes() > 0 && rg.MatchNodeLabels(labels)
 		},
 		func(rg *ResourceGroup) int {
 			return rg.MissingNumOfNodes()
@@ -728,7 +821,7 @@ func (rm *ResourceManager) mustSelectAssignIncomingNodeTargetRG() *ResourceGroup
 	// Second, assign it to rg do not reach limit.
 	if rg := rm.findMaxRGWithGivenFilter(
 		func(rg *ResourceGroup) bool {
-			return rg.ReachLimitNumOfNodes() > 0
+			return rg.ReachLimitNumOfNodes() > 0 && rg.MatchNodeLabels(labels)
 		},
 		func(rg *ResourceGroup) int {
 			return rg.ReachLimitNumOfNodes()
@@ -909,3 +1002,16 @@ func (rm *ResourceManager) validateResourceGroupIsDeletable(rgName string) error
 	}
 	return nil
 }
+
+// isSameNodeSelector return whether two node selectors are the same.
+func isSameNodeSelector(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, value := range a {
+		if v, ok := b[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
@@ -208,6 +208,97 @@ func (suite *ResourceManagerSuite) TestManipulateResourceGroup() {
 	suite.NoError(err)
 }
 
+func (suite *ResourceManagerSuite) TestFillResourceGroupFromDefault() {
+	for i := int64(1); i <= 3; i++ {
+		suite.manager.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   i,
+			Address:  "localhost",
+			Hostname: "localhost",
+		}))
+		defer suite.manager.nodeMgr.Remove(i)
+		suite.manager.HandleNodeUp(i)
+	}
+	suite.Equal(3, suite.manager.GetResourceGroup(DefaultResourceGroupName).NodeNum())
+
+	// the default rg keeps its requested node
+	err := suite.manager.UpdateResourceGroups(map[string]*rgpb.ResourceGroupConfig{
+		DefaultResourceGroupName: newResourceGroupConfig(1, 3),
+	})
+	suite.NoError(err)
+	err = suite.manager.AddResourceGroup("rg1", newResourceGroupConfig(5, 5))
+	suite.NoError(err)
+	filled, err := suite.manager.FillResourceGroupFromDefault("rg1")
+	suite.NoError(err)
+	suite.Equal(2, filled)
+	suite.Equal(2, suite.manager.GetResourceGroup("rg1").NodeNum())
+	suite.Equal(1, suite.manager.GetResourceGroup(DefaultResourceGroupName).NodeNum())
+
+	// no more oversized node in default rg
+	filled, err = suite.manager.FillResourceGroupFromDefault("rg1")
+	suite.NoError(err)
+	suite.Equal(0, filled)
+
+	_, err = suite.manager.FillResourceGroupFromDefault("rg_not_exist")
+	suite.ErrorIs(err, merr.ErrResourceGroupNotFound)
+	_, err = suite.manager.FillResourceGroupFromDefault(DefaultResourceGroupName)
+	suite.ErrorIs(err, merr.ErrParameterInvalid)
+}
+
+func (suite *ResourceManagerSuite) TestNodeSelector() {
+	err := suite.manager.AddResourceGroupWithNodeSelector(DefaultResourceGroupName, newResourceGroupConfig(0, 100), map[string]string{"zone": "az1"})
+	suite.ErrorIs(err, merr.ErrParameterInvalid)
+
+	err = suite.manager.AddResourceGroupWithNodeSelector("rg1", newResourceGroupConfig(2, 2), map[string]string{"zone": "az1"})
+	suite.NoError(err)
+	suite.Equal(map[string]string{"zone": "az1"}, suite.manager.GetResourceGroup("rg1").GetNodeSelector())
+	// idempotent only if the selector is the same
+	err = suite.manager.AddResourceGroupWithNodeSelector("rg1", newResourceGroupConfig(2, 2), map[string]string{"zone": "az1"})
+	suite.NoError(err)
+	err = suite.manager.AddResourceGroupWithNodeSelector("rg1", newResourceGroupConfig(2, 2), map[string]string{"zone": "az2"})
+	suite.ErrorIs(err, merr.ErrResourceGroupAlreadyExist)
+
+	labels := map[int64]map[string]string{
+		1: {"zone": "az1"},
+		2: {"zone": "az2"},
+		3: nil,
+		4: {"zone": "az1", "disk": "ssd"},
+	}
+	for i := int64(1); i <= 4; i++ {
+		suite.manager.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   i,
+			Address:  "localhost",
+			Hostname: "localhost",
+			Labels:   labels[i],
+		}))
+		defer suite.manager.nodeMgr.Remove(i)
+		suite.manager.HandleNodeUp(i)
+	}
+	// only the nodes matching the selector are assigned to rg1, others fall to default rg.
+	suite.ElementsMatch([]int64{1, 4}, suite.manager.GetResourceGroup("rg1").GetNodes())
+	suite.ElementsMatch([]int64{2, 3}, suite.manager.GetResourceGroup(DefaultResourceGroupName).GetNodes())
+
+	// auto recover never transfers the unmatched nodes.
+	err = suite.manager.UpdateResourceGroups(map[string]*rgpb.ResourceGroupConfig{
+		"rg1": newResourceGroupConfig(3, 3),
+	})
+	suite.NoError(err)
+	err = suite.manager.AutoRecoverResourceGroup("rg1")
+	suite.ErrorIs(err, ErrNodeNotEnough)
+	suite.ElementsMatch([]int64{1, 4}, suite.manager.GetResourceGroup("rg1").GetNodes())
+	filled, err := suite.manager.FillResourceGroupFromDefault("rg1")
+	suite.NoError(err)
+	suite.Zero(filled)
+
+	// the selector is persisted.
+	rgs, err := querycoord.NewCatalog(suite.kv).GetResourceGroups()
+	suite.NoError(err)
+	for _, rg := range rgs {
+		if rg.GetName() == "rg1" {
+			suite.Equal(map[string]string{"zone": "az1"}, NewResourceGroupFromMeta(rg).GetNodeSelector())
+		}
+	}
+}
+
 func (suite *ResourceManagerSuite) TestNodeUpAndDown() {
 	suite.manager.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
 		NodeID:   1,
@@ -143,6 +143,8 @@ type SegmentDistManager struct {
 
 	// nodeID -> []*Segment
 	segments map[typeutil.UniqueID]nodeSegments
+	// nodeID -> sealed segments being loaded
+	loadings map[typeutil.UniqueID][]*querypb.SegmentLoadingProgress
 }
 
 type nodeSegments struct {
@@ -180,6 +182,7 @@ func composeNodeSegments(segments []*Segment) nodeSegments {
 func NewSegmentDistManager() *SegmentDistManager {
 	return &SegmentDistManager{
 		segments: make(map[typeutil.UniqueID]nodeSegments),
+		loadings: make(map[typeutil.UniqueID][]*querypb.SegmentLoadingProgress),
 	}
 }
 
@@ -193,6 +196,24 @@ func (m *SegmentDistManager) Update(nodeID typeutil.UniqueID, segments ...*Segme
 	m.segments[nodeID] = composeNodeSegments(segments)
 }
 
+// RemoveCollection removes all segments of the collection from all nodes, returns the removed segments.
+func (m *SegmentDistManager) RemoveCollection(collectionID typeutil.UniqueID) []*Segment {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	removed := make([]*Segment, 0)
+	for nodeID, segments := range m.segments {
+		if len(segments.collSegments[collectionID]) == 0 {
+			continue
+		}
+		removed = append(removed, segments.collSegments[collectionID]...)
+		m.segments[nodeID] = composeNodeSegments(lo.Filter(segments.segments, func(segment *Segment, _ int) bool {
+			return segment.GetCollectionID() != collectionID
+		}))
+	}
+	return removed
+}
+
 // GetByFilter return segment list which match all given filters
 func (m *SegmentDistManager) GetByFilter(filters ...SegmentDistFilter) []*Segment {
 	m.rwmutex.RLock()
@@ -244,3 +265,31 @@ func (m *SegmentDistManager) GetSegmentDist(segmentID int64) []int64 {
 	}
 	return ret
 }
+
+// UpdateLoading updates the sealed segments being loaded on the node
+func (m *SegmentDistManager) UpdateLoading(nodeID typeutil.UniqueID, progresses ...*querypb.SegmentLoadingProgress) {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	if len(progresses) == 0 {
+		delete(m.loadings, nodeID)
+		return
+	}
+	m.loadings[nodeID] = progresses
+}
+
+// GetLoadingProgress returns the loading progress of the segment on each node
+func (m *SegmentDistManager) GetLoadingProgress(segmentID int64) []*querypb.SegmentLoadingProgress {
+	m.rwmutex.RLock()
+	defer m.rwmutex.RUnlock()
+
+	ret := make([]*querypb.SegmentLoadingProgress, 0)
+	for _, progresses := range m.loadings {
+		for _, progress := range progresses {
+			if progress.GetSegmentID() == segmentID {
+				ret = append(ret, progress)
+			}
+		}
+	}
+	return ret
+}
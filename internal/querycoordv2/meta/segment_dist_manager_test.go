@@ -185,6 +185,40 @@ func (suite *SegmentDistManagerSuite) AssertShard(segments []*Segment, shard str
 	return true
 }
 
+func (suite *SegmentDistManagerSuite) TestRemoveCollection() {
+	dist := suite.dist
+	other := SegmentFromInfo(&datapb.SegmentInfo{
+		ID:            5,
+		CollectionID:  suite.collection + 1,
+		PartitionID:   suite.partitions[0],
+		InsertChannel: "dmc0",
+	})
+	dist.Update(suite.nodes[2], suite.segments[3].Clone(), suite.segments[4].Clone(), other)
+
+	removed := dist.RemoveCollection(suite.collection)
+	suite.Len(removed, 8)
+	suite.Empty(dist.GetByFilter(WithCollectionID(suite.collection)))
+	suite.Len(dist.GetByFilter(), 1)
+	suite.True(suite.AssertIDs(dist.GetByFilter(), 5))
+	suite.Empty(dist.RemoveCollection(suite.collection))
+}
+
+func (suite *SegmentDistManagerSuite) TestLoadingProgress() {
+	dist := NewSegmentDistManager()
+	dist.UpdateLoading(1, &querypb.SegmentLoadingProgress{SegmentID: 10, LoadedBytes: 1, TotalBytes: 10})
+	dist.UpdateLoading(2,
+		&querypb.SegmentLoadingProgress{SegmentID: 10, LoadedBytes: 5, TotalBytes: 10},
+		&querypb.SegmentLoadingProgress{SegmentID: 11, LoadedBytes: 0, TotalBytes: 10},
+	)
+	suite.Len(dist.GetLoadingProgress(10), 2)
+	suite.Len(dist.GetLoadingProgress(11), 1)
+	suite.Len(dist.GetLoadingProgress(12), 0)
+
+	dist.UpdateLoading(2)
+	suite.Len(dist.GetLoadingProgress(10), 1)
+	suite.Len(dist.GetLoadingProgress(11), 0)
+}
+
 func TestSegmentDistManager(t *testing.T) {
 	suite.Run(t, new(SegmentDistManagerSuite))
 }
@@ -0,0 +1,139 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// ShardBlockManager keeps the shards blocked by operators,
+// the blocked shards are omitted from the shard leaders, so clients route around them.
+type ShardBlockManager struct {
+	rwmutex sync.RWMutex
+	catalog metastore.QueryCoordCatalog
+	blocks  map[int64]map[string]*querypb.ShardBlock // CollectionID -> Channel -> block
+}
+
+func NewShardBlockManager(catalog metastore.QueryCoordCatalog) *ShardBlockManager {
+	return &ShardBlockManager{
+		catalog: catalog,
+		blocks:  make(map[int64]map[string]*querypb.ShardBlock),
+	}
+}
+
+// RecoverShardBlocks loads the blocked shards from meta store.
+func (m *ShardBlockManager) RecoverShardBlocks() error {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	blocks, err := m.catalog.GetShardBlocks()
+	if err != nil {
+		return err
+	}
+	for _, block := range blocks {
+		m.putBlock(block)
+		log.Info("recover shard block",
+			zap.Int64("collectionID", block.GetCollectionID()),
+			zap.String("channel", block.GetChannel()),
+			zap.String("reason", block.GetReason()))
+	}
+	return nil
+}
+
+func (m *ShardBlockManager) putBlock(block *querypb.ShardBlock) {
+	if _, ok := m.blocks[block.GetCollectionID()]; !ok {
+		m.blocks[block.GetCollectionID()] = make(map[string]*querypb.ShardBlock)
+	}
+	m.blocks[block.GetCollectionID()][block.GetChannel()] = block
+}
+
+// BlockShard blocks the given shard, the reason is overwritten if the shard has been blocked.
+func (m *ShardBlockManager) BlockShard(collectionID int64, channel string, reason string) error {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	block := &querypb.ShardBlock{
+		CollectionID: collectionID,
+		Channel:      channel,
+		Reason:       reason,
+		BlockTime:    time.Now().UnixMilli(),
+	}
+	if err := m.catalog.SaveShardBlock(block); err != nil {
+		return err
+	}
+	m.putBlock(block)
+	return nil
+}
+
+func (m *ShardBlockManager) UnblockShard(collectionID int64, channel string) error {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	if _, ok := m.blocks[collectionID][channel]; !ok {
+		return nil
+	}
+	if err := m.catalog.RemoveShardBlock(collectionID, channel); err != nil {
+		return err
+	}
+	delete(m.blocks[collectionID], channel)
+	if len(m.blocks[collectionID]) == 0 {
+		delete(m.blocks, collectionID)
+	}
+	return nil
+}
+
+// UnblockCollectionShards removes all the blocked shards of the given collection.
+func (m *ShardBlockManager) UnblockCollectionShards(collectionID int64) error {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	for channel := range m.blocks[collectionID] {
+		if err := m.catalog.RemoveShardBlock(collectionID, channel); err != nil {
+			return err
+		}
+		delete(m.blocks[collectionID], channel)
+	}
+	delete(m.blocks, collectionID)
+	return nil
+}
+
+func (m *ShardBlockManager) IsShardBlocked(collectionID int64, channel string) bool {
+	m.rwmutex.RLock()
+	defer m.rwmutex.RUnlock()
+
+	_, ok := m.blocks[collectionID][channel]
+	return ok
+}
+
+// GetShardBlocks returns the blocked shards of the given collection.
+func (m *ShardBlockManager) GetShardBlocks(collectionID int64) []*querypb.ShardBlock {
+	m.rwmutex.RLock()
+	defer m.rwmutex.RUnlock()
+
+	ret := make([]*querypb.ShardBlock, 0, len(m.blocks[collectionID]))
+	for _, block := range m.blocks[collectionID] {
+		ret = append(ret, block)
+	}
+	return ret
+}
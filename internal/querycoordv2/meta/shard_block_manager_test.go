@@ -0,0 +1,65 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+func TestShardBlockManager(t *testing.T) {
+	catalog := mocks.NewQueryCoordCatalog(t)
+	catalog.EXPECT().GetShardBlocks().Return([]*querypb.ShardBlock{
+		{CollectionID: 1, Channel: "dmc0", Reason: "corrupt index"},
+	}, nil)
+	catalog.EXPECT().SaveShardBlock(mock.Anything).Return(nil)
+	catalog.EXPECT().RemoveShardBlock(mock.Anything, mock.Anything).Return(nil)
+
+	m := NewShardBlockManager(catalog)
+	assert.NoError(t, m.RecoverShardBlocks())
+	assert.True(t, m.IsShardBlocked(1, "dmc0"))
+	assert.False(t, m.IsShardBlocked(1, "dmc1"))
+
+	assert.NoError(t, m.BlockShard(1, "dmc1", ""))
+	assert.NoError(t, m.BlockShard(2, "dmc0", ""))
+	assert.Len(t, m.GetShardBlocks(1), 2)
+
+	assert.NoError(t, m.UnblockShard(1, "dmc0"))
+	assert.False(t, m.IsShardBlocked(1, "dmc0"))
+	// unblock a shard not blocked
+	assert.NoError(t, m.UnblockShard(1, "dmc0"))
+
+	assert.NoError(t, m.UnblockCollectionShards(1))
+	assert.Empty(t, m.GetShardBlocks(1))
+	assert.True(t, m.IsShardBlocked(2, "dmc0"))
+}
+
+func TestShardBlockManagerFailed(t *testing.T) {
+	catalog := mocks.NewQueryCoordCatalog(t)
+	mockErr := errors.New("mock error")
+	catalog.EXPECT().SaveShardBlock(mock.Anything).Return(mockErr)
+
+	m := NewShardBlockManager(catalog)
+	assert.ErrorIs(t, m.BlockShard(1, "dmc0", ""), mockErr)
+	assert.False(t, m.IsShardBlocked(1, "dmc0"))
+}
@@ -337,6 +337,32 @@ func (mgr *TargetManager) RemovePartition(collectionID int64, partitionIDs ...in
 	}
 }
 
+// RemoveSegments removes the sealed segments from both current and next targets,
+// the segments come back once the next target is pulled again.
+func (mgr *TargetManager) RemoveSegments(collectionID int64, segmentIDs ...int64) {
+	mgr.rwMutex.Lock()
+	defer mgr.rwMutex.Unlock()
+
+	log.Info("remove segments from targets",
+		zap.Int64("collectionID", collectionID),
+		zap.Int64s("segmentIDs", segmentIDs))
+
+	segmentSet := typeutil.NewUniqueSet(segmentIDs...)
+	for _, targets := range []*target{mgr.current, mgr.next} {
+		oldTarget := targets.getCollectionTarget(collectionID)
+		if oldTarget == nil {
+			continue
+		}
+		segments := make(map[int64]*datapb.SegmentInfo)
+		for _, segment := range oldTarget.GetAllSegments() {
+			if !segmentSet.Contain(segment.GetID()) {
+				segments[segment.GetID()] = segment
+			}
+		}
+		targets.updateCollectionTarget(collectionID, NewCollectionTarget(segments, oldTarget.GetAllDmChannels()))
+	}
+}
+
 func (mgr *TargetManager) removePartitionFromCollectionTarget(oldTarget *CollectionTarget, partitionSet typeutil.UniqueSet) *CollectionTarget {
 	segments := make(map[int64]*datapb.SegmentInfo)
 	for _, segment := range oldTarget.GetAllSegments() {
@@ -276,6 +276,22 @@ func (suite *TargetManagerSuite) TestRemovePartition() {
 	suite.assertChannels([]string{}, suite.mgr.GetDmChannelsByCollection(collectionID, CurrentTarget))
 }
 
+func (suite *TargetManagerSuite) TestRemoveSegments() {
+	collectionID := int64(1000)
+	suite.mgr.RemoveSegments(collectionID, 1)
+	suite.assertSegments(append([]int64{2, 3, 4}, suite.level0Segments...), suite.mgr.GetSealedSegmentsByCollection(collectionID, NextTarget))
+	suite.assertChannels(suite.channels[collectionID], suite.mgr.GetDmChannelsByCollection(collectionID, NextTarget))
+
+	suite.mgr.UpdateCollectionCurrentTarget(collectionID)
+	suite.mgr.RemoveSegments(collectionID, 2, 3)
+	suite.assertSegments(append([]int64{4}, suite.level0Segments...), suite.mgr.GetSealedSegmentsByCollection(collectionID, CurrentTarget))
+	suite.assertChannels(suite.channels[collectionID], suite.mgr.GetDmChannelsByCollection(collectionID, CurrentTarget))
+
+	// the segments come back with the next target
+	suite.NoError(suite.mgr.UpdateCollectionNextTarget(collectionID))
+	suite.assertSegments(suite.getAllSegment(collectionID, suite.partitions[collectionID]), suite.mgr.GetSealedSegmentsByCollection(collectionID, NextTarget))
+}
+
 func (suite *TargetManagerSuite) TestRemoveCollection() {
 	collectionID := int64(1000)
 	suite.assertSegments(suite.getAllSegment(collectionID, suite.partitions[collectionID]), suite.mgr.GetSealedSegmentsByCollection(collectionID, NextTarget))
@@ -0,0 +1,247 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/checkers"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// UnavailablePeriod is a time range during which the collection is not readable,
+// End is zero if the collection is still unavailable.
+type UnavailablePeriod struct {
+	Start time.Time
+	End   time.Time
+}
+
+// CollectionAvailability is the availability record of a collection within a time window.
+type CollectionAvailability struct {
+	TrackingSince time.Time
+	Window        time.Duration
+	Unavailable   time.Duration
+	Available     bool
+	Periods       []UnavailablePeriod
+}
+
+// UptimeRatio returns the fraction of the window during which the collection was readable.
+func (a *CollectionAvailability) UptimeRatio() float64 {
+	if a.Window <= 0 {
+		return 1
+	}
+	return 1 - float64(a.Unavailable)/float64(a.Window)
+}
+
+type availabilityRecord struct {
+	since     time.Time
+	available bool
+	periods   []UnavailablePeriod
+}
+
+// AvailabilityObserver samples whether each loaded collection is readable periodically,
+// and keeps the unavailable periods of the collections within the retention time.
+// A collection is readable only if every channel in current target has an available shard leader.
+type AvailabilityObserver struct {
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+	meta      *meta.Meta
+	targetMgr *meta.TargetManager
+	dist      *meta.DistributionManager
+	nodeMgr   *session.NodeManager
+
+	mut     sync.RWMutex
+	records map[int64]*availabilityRecord
+
+	stopOnce sync.Once
+}
+
+func NewAvailabilityObserver(
+	meta *meta.Meta,
+	targetMgr *meta.TargetManager,
+	dist *meta.DistributionManager,
+	nodeMgr *session.NodeManager,
+) *AvailabilityObserver {
+	return &AvailabilityObserver{
+		meta:      meta,
+		targetMgr: targetMgr,
+		dist:      dist,
+		nodeMgr:   nodeMgr,
+		records:   make(map[int64]*availabilityRecord),
+	}
+}
+
+func (ob *AvailabilityObserver) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	ob.cancel = cancel
+
+	ob.wg.Add(1)
+	go ob.schedule(ctx)
+}
+
+func (ob *AvailabilityObserver) Stop() {
+	ob.stopOnce.Do(func() {
+		if ob.cancel != nil {
+			ob.cancel()
+		}
+		ob.wg.Wait()
+	})
+}
+
+func (ob *AvailabilityObserver) schedule(ctx context.Context) {
+	defer ob.wg.Done()
+	log.Info("Start check collection availability loop")
+
+	ticker := time.NewTicker(params.Params.QueryCoordCfg.AvailabilityCheckInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("Close availability observer")
+			return
+
+		case <-ticker.C:
+			ob.sample(time.Now())
+		}
+	}
+}
+
+func (ob *AvailabilityObserver) sample(now time.Time) {
+	retention := params.Params.QueryCoordCfg.AvailabilityRetention.GetAsDuration(time.Second)
+
+	ob.mut.Lock()
+	defer ob.mut.Unlock()
+
+	loaded := make(map[int64]struct{})
+	for _, collection := range ob.meta.CollectionManager.GetAllCollections() {
+		// only track the collections which have been loaded, loading is not regarded as unavailable
+		if collection.GetStatus() != querypb.LoadStatus_Loaded {
+			continue
+		}
+		collectionID := collection.GetCollectionID()
+		loaded[collectionID] = struct{}{}
+
+		available := ob.isReadable(collectionID)
+		record, ok := ob.records[collectionID]
+		if !ok {
+			record = &availabilityRecord{since: now, available: true}
+			ob.records[collectionID] = record
+		}
+		if record.available && !available {
+			log.Warn("collection becomes unavailable", zap.Int64("collectionID", collectionID))
+			record.periods = append(record.periods, UnavailablePeriod{Start: now})
+		} else if !record.available && available {
+			log.Info("collection becomes available", zap.Int64("collectionID", collectionID))
+			record.periods[len(record.periods)-1].End = now
+		}
+		record.available = available
+
+		// drop the periods out of retention
+		expired := now.Add(-retention)
+		for len(record.periods) > 0 && !record.periods[0].End.IsZero() && record.periods[0].End.Before(expired) {
+			record.periods = record.periods[1:]
+		}
+		if record.since.Before(expired) {
+			record.since = expired
+		}
+	}
+
+	for collectionID := range ob.records {
+		if _, ok := loaded[collectionID]; !ok {
+			delete(ob.records, collectionID)
+		}
+	}
+}
+
+func (ob *AvailabilityObserver) isReadable(collectionID int64) bool {
+	channels := ob.targetMgr.GetDmChannelsByCollection(collectionID, meta.CurrentTarget)
+	if len(channels) == 0 {
+		return false
+	}
+
+	currentTargets := ob.targetMgr.GetSealedSegmentsByCollection(collectionID, meta.CurrentTarget)
+	for _, channel := range channels {
+		leaders := ob.dist.LeaderViewManager.GetByFilter(meta.WithChannelName2LeaderView(channel.GetChannelName()))
+		readable := false
+		for _, leader := range leaders {
+			if checkers.CheckLeaderAvailable(ob.nodeMgr, leader, currentTargets) == nil {
+				readable = true
+				break
+			}
+		}
+		if !readable {
+			return false
+		}
+	}
+	return true
+}
+
+// GetAvailability returns the availability record of the given collection within the recent window,
+// the window is limited by the retention and the time since the collection has been tracked.
+// Returns false if the collection is not tracked yet.
+func (ob *AvailabilityObserver) GetAvailability(collectionID int64, window time.Duration) (*CollectionAvailability, bool) {
+	return ob.getAvailability(collectionID, window, time.Now())
+}
+
+func (ob *AvailabilityObserver) getAvailability(collectionID int64, window time.Duration, now time.Time) (*CollectionAvailability, bool) {
+	ob.mut.RLock()
+	defer ob.mut.RUnlock()
+
+	record, ok := ob.records[collectionID]
+	if !ok {
+		return nil, false
+	}
+
+	retention := params.Params.QueryCoordCfg.AvailabilityRetention.GetAsDuration(time.Second)
+	if window <= 0 || window > retention {
+		window = retention
+	}
+	start := now.Add(-window)
+	if start.Before(record.since) {
+		start = record.since
+	}
+
+	ret := &CollectionAvailability{
+		TrackingSince: record.since,
+		Window:        now.Sub(start),
+		Available:     record.available,
+	}
+	for _, period := range record.periods {
+		end := period.End
+		if end.IsZero() {
+			end = now
+		}
+		if !end.After(start) {
+			continue
+		}
+		from := period.Start
+		if from.Before(start) {
+			from = start
+		}
+		ret.Unavailable += end.Sub(from)
+		ret.Periods = append(ret.Periods, period)
+	}
+	return ret, true
+}
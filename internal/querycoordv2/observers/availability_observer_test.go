@@ -0,0 +1,200 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type AvailabilityObserverSuite struct {
+	suite.Suite
+
+	kv kv.MetaKv
+	// dependency
+	meta      *meta.Meta
+	broker    *meta.MockBroker
+	targetMgr *meta.TargetManager
+	distMgr   *meta.DistributionManager
+	nodeMgr   *session.NodeManager
+
+	observer *AvailabilityObserver
+
+	collectionID int64
+	channel      string
+}
+
+func (suite *AvailabilityObserverSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (suite *AvailabilityObserverSuite) SetupTest() {
+	config := GenerateEtcdConfig()
+	cli, err := etcd.GetEtcdClient(
+		config.UseEmbedEtcd.GetAsBool(),
+		config.EtcdUseSSL.GetAsBool(),
+		config.Endpoints.GetAsStrings(),
+		config.EtcdTLSCert.GetValue(),
+		config.EtcdTLSKey.GetValue(),
+		config.EtcdTLSCACert.GetValue(),
+		config.EtcdTLSMinVersion.GetValue())
+	suite.Require().NoError(err)
+	suite.kv = etcdkv.NewEtcdKV(cli, config.MetaRootPath.GetValue())
+
+	store := querycoord.NewCatalog(suite.kv)
+	suite.nodeMgr = session.NewNodeManager()
+	suite.meta = meta.NewMeta(RandomIncrementIDAllocator(), store, suite.nodeMgr)
+	suite.broker = meta.NewMockBroker(suite.T())
+	suite.targetMgr = meta.NewTargetManager(suite.broker, suite.meta)
+	suite.distMgr = meta.NewDistributionManager()
+	suite.observer = NewAvailabilityObserver(suite.meta, suite.targetMgr, suite.distMgr, suite.nodeMgr)
+
+	suite.collectionID = 100
+	suite.channel = "100-dmc0"
+	suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   1,
+		Address:  "localhost",
+		Hostname: "localhost",
+	}))
+
+	collection := utils.CreateTestCollection(suite.collectionID, 1)
+	collection.Status = querypb.LoadStatus_Loaded
+	suite.NoError(suite.meta.CollectionManager.PutCollection(collection))
+	suite.NoError(suite.meta.CollectionManager.PutPartition(utils.CreateTestPartition(suite.collectionID, 10)))
+	suite.NoError(suite.meta.ReplicaManager.Put(utils.CreateTestReplica(1, suite.collectionID, []int64{1})))
+
+	channels := []*datapb.VchannelInfo{
+		{
+			CollectionID: suite.collectionID,
+			ChannelName:  suite.channel,
+		},
+	}
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, suite.collectionID).Return(channels, nil, nil)
+	suite.targetMgr.UpdateCollectionNextTarget(suite.collectionID)
+	suite.targetMgr.UpdateCollectionCurrentTarget(suite.collectionID)
+}
+
+func (suite *AvailabilityObserverSuite) TearDownTest() {
+	suite.kv.RemoveWithPrefix("")
+	suite.kv.Close()
+}
+
+func (suite *AvailabilityObserverSuite) setLeaderAvailable(available bool) {
+	if available {
+		suite.distMgr.LeaderViewManager.Update(1, utils.CreateTestLeaderView(1, suite.collectionID, suite.channel, map[int64]int64{}, map[int64]*meta.Segment{}))
+	} else {
+		suite.distMgr.LeaderViewManager.Update(1)
+	}
+}
+
+func (suite *AvailabilityObserverSuite) TestUptime() {
+	start := time.Now()
+
+	_, ok := suite.observer.getAvailability(suite.collectionID, 0, start)
+	suite.False(ok)
+
+	suite.setLeaderAvailable(true)
+	suite.observer.sample(start)
+
+	// unavailable for 10 seconds
+	suite.setLeaderAvailable(false)
+	suite.observer.sample(start.Add(30 * time.Second))
+	suite.setLeaderAvailable(true)
+	suite.observer.sample(start.Add(40 * time.Second))
+
+	availability, ok := suite.observer.getAvailability(suite.collectionID, 0, start.Add(100*time.Second))
+	suite.True(ok)
+	suite.True(availability.Available)
+	suite.Equal(start, availability.TrackingSince)
+	suite.Equal(100*time.Second, availability.Window)
+	suite.Equal(10*time.Second, availability.Unavailable)
+	suite.InDelta(0.9, availability.UptimeRatio(), 1e-9)
+	suite.Len(availability.Periods, 1)
+
+	// window covers part of the unavailable period
+	availability, ok = suite.observer.getAvailability(suite.collectionID, 65*time.Second, start.Add(100*time.Second))
+	suite.True(ok)
+	suite.Equal(65*time.Second, availability.Window)
+	suite.Equal(5*time.Second, availability.Unavailable)
+
+	// window after the unavailable period
+	availability, ok = suite.observer.getAvailability(suite.collectionID, 50*time.Second, start.Add(100*time.Second))
+	suite.True(ok)
+	suite.Zero(availability.Unavailable)
+	suite.Empty(availability.Periods)
+	suite.Equal(1.0, availability.UptimeRatio())
+
+	// still unavailable
+	suite.setLeaderAvailable(false)
+	suite.observer.sample(start.Add(100 * time.Second))
+	availability, ok = suite.observer.getAvailability(suite.collectionID, 0, start.Add(120*time.Second))
+	suite.True(ok)
+	suite.False(availability.Available)
+	suite.Equal(30*time.Second, availability.Unavailable)
+	suite.Len(availability.Periods, 2)
+	suite.True(availability.Periods[1].End.IsZero())
+}
+
+func (suite *AvailabilityObserverSuite) TestRetention() {
+	paramtable.Get().Save(Params.QueryCoordCfg.AvailabilityRetention.Key, "60")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.AvailabilityRetention.Key)
+
+	start := time.Now()
+	suite.setLeaderAvailable(false)
+	suite.observer.sample(start)
+	suite.setLeaderAvailable(true)
+	suite.observer.sample(start.Add(10 * time.Second))
+
+	// the unavailable period is out of retention
+	suite.observer.sample(start.Add(100 * time.Second))
+	availability, ok := suite.observer.getAvailability(suite.collectionID, time.Hour, start.Add(100*time.Second))
+	suite.True(ok)
+	suite.Equal(60*time.Second, availability.Window)
+	suite.Equal(start.Add(40*time.Second), availability.TrackingSince)
+	suite.Empty(availability.Periods)
+}
+
+func (suite *AvailabilityObserverSuite) TestCollectionReleased() {
+	suite.setLeaderAvailable(true)
+	suite.observer.sample(time.Now())
+	_, ok := suite.observer.GetAvailability(suite.collectionID, 0)
+	suite.True(ok)
+
+	suite.NoError(suite.meta.CollectionManager.RemoveCollection(suite.collectionID))
+	suite.observer.sample(time.Now())
+	_, ok = suite.observer.GetAvailability(suite.collectionID, 0)
+	suite.False(ok)
+}
+
+func TestAvailabilityObserver(t *testing.T) {
+	suite.Run(t, new(AvailabilityObserverSuite))
+}
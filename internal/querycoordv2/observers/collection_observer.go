@@ -33,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/pkg/eventlog"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/syncutil"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -48,6 +49,8 @@ type CollectionObserver struct {
 	partitionLoadedCount map[int64]int
 
 	loadTasks *typeutil.ConcurrentMap[string, LoadTask]
+	// notified after each round of observation
+	notifier *syncutil.VersionedNotifier
 
 	stopOnce sync.Once
 }
@@ -56,6 +59,7 @@ type LoadTask struct {
 	LoadType     querypb.LoadType
 	CollectionID int64
 	PartitionIDs []int64
+	CreatedAt    time.Time
 }
 
 func NewCollectionObserver(
@@ -73,6 +77,7 @@ func NewCollectionObserver(
 		checkerController:    checherController,
 		partitionLoadedCount: make(map[int64]int),
 		loadTasks:            typeutil.NewConcurrentMap[string, LoadTask](),
+		notifier:             syncutil.NewVersionedNotifier(),
 	}
 
 	// Add load task for collection recovery
@@ -127,7 +132,7 @@ func (ob *CollectionObserver) LoadCollection(ctx context.Context, collectionID i
 		key = fmt.Sprintf("LoadCollection_%d", collectionID)
 	}
 
-	ob.loadTasks.Insert(key, LoadTask{LoadType: querypb.LoadType_LoadCollection, CollectionID: collectionID})
+	ob.loadTasks.Insert(key, LoadTask{LoadType: querypb.LoadType_LoadCollection, CollectionID: collectionID, CreatedAt: time.Now()})
 }
 
 func (ob *CollectionObserver) LoadPartitions(ctx context.Context, collectionID int64, partitionIDs []int64) {
@@ -139,12 +144,20 @@ func (ob *CollectionObserver) LoadPartitions(ctx context.Context, collectionID i
 		key = fmt.Sprintf("LoadPartition_%d_%v", collectionID, partitionIDs)
 	}
 
-	ob.loadTasks.Insert(key, LoadTask{LoadType: querypb.LoadType_LoadPartition, CollectionID: collectionID, PartitionIDs: partitionIDs})
+	ob.loadTasks.Insert(key, LoadTask{LoadType: querypb.LoadType_LoadPartition, CollectionID: collectionID, PartitionIDs: partitionIDs, CreatedAt: time.Now()})
 }
 
 func (ob *CollectionObserver) Observe(ctx context.Context) {
 	ob.observeTimeout()
 	ob.observeLoadStatus(ctx)
+	ob.observeDegradedReplicas()
+	ob.notifier.NotifyAll()
+}
+
+// Listen returns a listener notified after each round of observation from now on,
+// the state out of the collection meta, e.g. the availability of replicas, may be changed by then.
+func (ob *CollectionObserver) Listen() *syncutil.VersionedListener {
+	return ob.notifier.Listen(syncutil.VersionedListenAtLatest)
 }
 
 func (ob *CollectionObserver) observeTimeout() {
@@ -247,12 +260,19 @@ func (ob *CollectionObserver) observeLoadStatus(ctx context.Context) {
 		}
 
 		loaded := true
+		// whether the task is loading segments, rather than recovered with loaded partitions
+		observed := false
 		for _, partition := range partitions {
 			if partition.LoadPercentage == 100 {
 				continue
 			}
+			observed = true
 			if ob.readyToObserve(partition.CollectionID) {
 				replicaNum := ob.meta.GetReplicaNumber(partition.GetCollectionID())
+				if collection.GetBestEffort() {
+					// best-effort loaded collection may hold less replicas than expected
+					replicaNum = int32(len(ob.meta.ReplicaManager.GetByCollection(partition.GetCollectionID())))
+				}
 				ob.observePartitionLoadStatus(ctx, partition, replicaNum)
 			}
 			partition = ob.meta.GetPartition(partition.PartitionID)
@@ -268,6 +288,9 @@ func (ob *CollectionObserver) observeLoadStatus(ctx context.Context) {
 				zap.Int64s("partitionIDs", task.PartitionIDs),
 				zap.Stringer("loadType", task.LoadType))
 			ob.loadTasks.Remove(traceID)
+			if observed {
+				ob.recordLoadThroughput(task, partitions)
+			}
 		}
 
 		return true
@@ -279,6 +302,44 @@ func (ob *CollectionObserver) observeLoadStatus(ctx context.Context) {
 	}
 }
 
+// recordLoadThroughput records the throughput of the finished load task,
+// which is used to estimate the duration of the following loads of the collection.
+func (ob *CollectionObserver) recordLoadThroughput(task LoadTask, partitions []*meta.Partition) {
+	segmentNum := 0
+	for _, partition := range partitions {
+		segmentNum += len(ob.targetMgr.GetSealedSegmentsByPartition(partition.GetCollectionID(), partition.GetPartitionID(), meta.CurrentTarget))
+	}
+	meta.GlobalLoadThroughputRecorder.Record(task.CollectionID, segmentNum, time.Since(task.CreatedAt))
+}
+
+// observeDegradedReplicas tops up replicas for best-effort loaded collections,
+// cause nodes may be added into resource group after the collection loaded.
+func (ob *CollectionObserver) observeDegradedReplicas() {
+	for _, collection := range ob.meta.CollectionManager.GetAllCollections() {
+		if !collection.GetBestEffort() {
+			continue
+		}
+		replicaNum := len(ob.meta.ReplicaManager.GetByCollection(collection.GetCollectionID()))
+		if replicaNum == 0 || replicaNum >= int(collection.GetReplicaNumber()) {
+			continue
+		}
+
+		log := log.With(zap.Int64("collectionID", collection.GetCollectionID()))
+		replicas, err := utils.SpawnReplicasWithRGBestEffort(ob.meta, collection.GetCollectionID(), collection.GetTenant(),
+			collection.GetResourceGroups(), collection.GetReplicaNumber())
+		if err != nil {
+			log.Warn("failed to top up replicas for degraded collection", zap.Error(err))
+			continue
+		}
+		for _, replica := range replicas {
+			log.Info("top up replica for degraded collection",
+				zap.Int64("replicaID", replica.GetID()),
+				zap.String("resourceGroup", replica.GetResourceGroup()),
+				zap.Int64s("nodes", replica.GetNodes()))
+		}
+	}
+}
+
 func (ob *CollectionObserver) observePartitionLoadStatus(ctx context.Context, partition *meta.Partition, replicaNum int32) {
 	log := log.Ctx(ctx).WithRateGroup("qcv2.observePartitionLoadStatus", 1, 60).With(
 		zap.Int64("collectionID", partition.GetCollectionID()),
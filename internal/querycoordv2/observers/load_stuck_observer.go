@@ -0,0 +1,255 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/task"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+type loadProgress struct {
+	percentage int32
+	updatedAt  time.Time
+	retryTimes int
+	// the load is given up after the max retry times, it's not retried again until it makes progress
+	givenUp bool
+}
+
+// LoadStuckObserver watches the loading collections, if a collection makes no progress for a while,
+// the unfinished segment loads of it are dispatched to other nodes in the same replica.
+// The load failure is reported to GlobalFailedLoadCache after the max retry times,
+// and the collection is not retried any more unless the load makes progress again.
+type LoadStuckObserver struct {
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+	meta      *meta.Meta
+	dist      *meta.DistributionManager
+	nodeMgr   *session.NodeManager
+	scheduler task.Scheduler
+
+	mut      sync.Mutex
+	progress map[int64]*loadProgress // collectionID -> load progress
+
+	stopOnce sync.Once
+}
+
+func NewLoadStuckObserver(
+	meta *meta.Meta,
+	dist *meta.DistributionManager,
+	nodeMgr *session.NodeManager,
+	scheduler task.Scheduler,
+) *LoadStuckObserver {
+	return &LoadStuckObserver{
+		meta:      meta,
+		dist:      dist,
+		nodeMgr:   nodeMgr,
+		scheduler: scheduler,
+		progress:  make(map[int64]*loadProgress),
+	}
+}
+
+func (ob *LoadStuckObserver) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	ob.cancel = cancel
+
+	ob.wg.Add(1)
+	go ob.schedule(ctx)
+}
+
+func (ob *LoadStuckObserver) Stop() {
+	ob.stopOnce.Do(func() {
+		if ob.cancel != nil {
+			ob.cancel()
+		}
+		ob.wg.Wait()
+	})
+}
+
+func (ob *LoadStuckObserver) schedule(ctx context.Context) {
+	defer ob.wg.Done()
+	log.Info("Start check load stuck loop")
+
+	ticker := time.NewTicker(params.Params.QueryCoordCfg.LoadStuckCheckInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("Close load stuck observer")
+			return
+
+		case <-ticker.C:
+			ob.check(ctx, time.Now())
+		}
+	}
+}
+
+func (ob *LoadStuckObserver) check(ctx context.Context, now time.Time) {
+	timeout := params.Params.QueryCoordCfg.LoadStuckTimeout.GetAsDuration(time.Second)
+	maxRetryTimes := params.Params.QueryCoordCfg.LoadStuckMaxRetryTimes.GetAsInt()
+
+	ob.mut.Lock()
+	defer ob.mut.Unlock()
+
+	loading := make(map[int64]struct{})
+	for _, collection := range ob.meta.CollectionManager.GetAllCollections() {
+		if collection.GetStatus() != querypb.LoadStatus_Loading {
+			continue
+		}
+		collectionID := collection.GetCollectionID()
+		loading[collectionID] = struct{}{}
+
+		percentage := ob.meta.CollectionManager.CalculateLoadPercentage(collectionID)
+		progress, ok := ob.progress[collectionID]
+		if !ok || progress.percentage != percentage {
+			ob.progress[collectionID] = &loadProgress{
+				percentage: percentage,
+				updatedAt:  now,
+			}
+			continue
+		}
+		if progress.givenUp || now.Sub(progress.updatedAt) < timeout {
+			continue
+		}
+
+		log := log.Ctx(ctx).With(
+			zap.Int64("collectionID", collectionID),
+			zap.Int32("loadPercentage", percentage),
+			zap.Int("retryTimes", progress.retryTimes),
+		)
+		if progress.retryTimes >= maxRetryTimes {
+			err := merr.WrapErrServiceInternal(fmt.Sprintf("load of collection %d makes no progress after %d retries", collectionID, progress.retryTimes))
+			log.Warn("give up retrying stuck load", zap.Error(err))
+			meta.GlobalFailedLoadCache.Put(collectionID, err)
+			progress.givenUp = true
+			continue
+		}
+
+		dispatched := ob.redispatch(ctx, collectionID)
+		log.Info("load makes no progress, dispatch unfinished segment loads to other nodes",
+			zap.Int("dispatched", dispatched))
+		progress.retryTimes++
+		progress.updatedAt = now
+	}
+
+	for collectionID := range ob.progress {
+		if _, ok := loading[collectionID]; !ok {
+			delete(ob.progress, collectionID)
+		}
+	}
+}
+
+// redispatch replaces the unfinished segment load tasks of the collection with the ones loading on other nodes,
+// returns the number of tasks dispatched.
+func (ob *LoadStuckObserver) redispatch(ctx context.Context, collectionID int64) int {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID))
+
+	tasks := append(ob.scheduler.GetTasksBySource(utils.SegmentChecker), ob.scheduler.GetTasksBySource(utils.LoadStuckRetry)...)
+	dispatched := 0
+	for _, t := range tasks {
+		segmentTask, ok := t.(*task.SegmentTask)
+		if !ok || segmentTask.CollectionID() != collectionID || len(segmentTask.Actions()) != 1 {
+			continue
+		}
+		action := segmentTask.Actions()[0].(*task.SegmentAction)
+		if action.Type() != task.ActionTypeGrow {
+			continue
+		}
+
+		replica := ob.meta.ReplicaManager.Get(segmentTask.ReplicaID())
+		if replica == nil {
+			continue
+		}
+		dstNode, ok := ob.selectAlternateNode(replica, action.Node())
+		if !ok {
+			log.Warn("no alternate node to load segment",
+				zap.Int64("segmentID", action.SegmentID()),
+				zap.Int64("node", action.Node()))
+			continue
+		}
+		// the retried task can't be replaced by one with the same priority,
+		// cancel it and the segment is dispatched again in the next retry.
+		if segmentTask.Priority() >= task.TaskPriorityHigh {
+			segmentTask.Cancel(merr.WrapErrServiceInternal("segment load makes no progress"))
+			continue
+		}
+
+		newTask, err := task.NewSegmentTask(ctx,
+			params.Params.QueryCoordCfg.SegmentTaskTimeout.GetAsDuration(time.Millisecond),
+			utils.LoadStuckRetry,
+			collectionID,
+			replica,
+			task.NewSegmentActionWithScope(dstNode, task.ActionTypeGrow, action.Shard(), action.SegmentID(), action.Scope()),
+		)
+		if err != nil {
+			log.Warn("failed to create segment task for stuck load",
+				zap.Int64("segmentID", action.SegmentID()),
+				zap.Error(err))
+			continue
+		}
+		newTask.SetPriority(task.TaskPriorityHigh)
+		newTask.SetReason("load stuck")
+		// the stuck task is replaced by the new one with higher priority
+		if err := ob.scheduler.Add(newTask); err != nil {
+			log.Warn("failed to add segment task for stuck load",
+				zap.Int64("segmentID", action.SegmentID()),
+				zap.Error(err))
+			continue
+		}
+		dispatched++
+	}
+	return dispatched
+}
+
+// selectAlternateNode selects the online node hosting the fewest segments in the replica other than the given one.
+func (ob *LoadStuckObserver) selectAlternateNode(replica *meta.Replica, stuckNode int64) (int64, bool) {
+	selected, minSegmentNum := int64(-1), 0
+	for _, node := range replica.GetNodes() {
+		info := ob.nodeMgr.Get(node)
+		if node == stuckNode || info == nil || info.IsStoppingState() {
+			continue
+		}
+		segmentNum := len(ob.dist.SegmentDistManager.GetByFilter(meta.WithNodeID(node)))
+		if selected == -1 || segmentNum < minSegmentNum {
+			selected, minSegmentNum = node, segmentNum
+		}
+	}
+	return selected, selected != -1
+}
+
+// GetRetryTimes returns how many times the segment loads of the collection have been dispatched to other nodes.
+func (ob *LoadStuckObserver) GetRetryTimes(collectionID int64) int {
+	ob.mut.Lock()
+	defer ob.mut.Unlock()
+
+	if progress, ok := ob.progress[collectionID]; ok {
+		return progress.retryTimes
+	}
+	return 0
+}
@@ -0,0 +1,179 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/task"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type LoadStuckObserverSuite struct {
+	suite.Suite
+
+	kv kv.MetaKv
+	// dependency
+	meta      *meta.Meta
+	distMgr   *meta.DistributionManager
+	nodeMgr   *session.NodeManager
+	scheduler *task.MockScheduler
+
+	observer *LoadStuckObserver
+}
+
+func (suite *LoadStuckObserverSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (suite *LoadStuckObserverSuite) SetupTest() {
+	config := GenerateEtcdConfig()
+	cli, err := etcd.GetEtcdClient(
+		config.UseEmbedEtcd.GetAsBool(),
+		config.EtcdUseSSL.GetAsBool(),
+		config.Endpoints.GetAsStrings(),
+		config.EtcdTLSCert.GetValue(),
+		config.EtcdTLSKey.GetValue(),
+		config.EtcdTLSCACert.GetValue(),
+		config.EtcdTLSMinVersion.GetValue())
+	suite.Require().NoError(err)
+	suite.kv = etcdkv.NewEtcdKV(cli, config.MetaRootPath.GetValue())
+
+	store := querycoord.NewCatalog(suite.kv)
+	suite.nodeMgr = session.NewNodeManager()
+	suite.meta = meta.NewMeta(RandomIncrementIDAllocator(), store, suite.nodeMgr)
+	suite.distMgr = meta.NewDistributionManager()
+	suite.scheduler = task.NewMockScheduler(suite.T())
+	suite.observer = NewLoadStuckObserver(suite.meta, suite.distMgr, suite.nodeMgr, suite.scheduler)
+	meta.GlobalFailedLoadCache = meta.NewFailedLoadCache()
+
+	for _, node := range []int64{1, 2, 3} {
+		suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   node,
+			Address:  "localhost",
+			Hostname: "localhost",
+		}))
+	}
+}
+
+func (suite *LoadStuckObserverSuite) TearDownTest() {
+	suite.kv.RemoveWithPrefix("")
+	suite.kv.Close()
+}
+
+func (suite *LoadStuckObserverSuite) TestRetryStuckLoad() {
+	paramtable.Get().Save(Params.QueryCoordCfg.LoadStuckTimeout.Key, "10")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.LoadStuckTimeout.Key)
+	paramtable.Get().Save(Params.QueryCoordCfg.LoadStuckMaxRetryTimes.Key, "1")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.LoadStuckMaxRetryTimes.Key)
+
+	ctx := context.Background()
+	collection := utils.CreateTestCollection(100, 1)
+	collection.Status = querypb.LoadStatus_Loading
+	suite.NoError(suite.meta.CollectionManager.PutCollection(collection, utils.CreateTestPartition(100, 10)))
+	replica := utils.CreateTestReplica(1, 100, []int64{1, 2, 3})
+	suite.NoError(suite.meta.ReplicaManager.Put(replica))
+	// node 2 hosts more segments than node 3
+	suite.distMgr.SegmentDistManager.Update(2, utils.CreateTestSegment(100, 10, 2, 2, 1, "100-dmc0"))
+
+	// the segment load on node 1 never finishes
+	stuck, err := task.NewSegmentTask(ctx, time.Minute, utils.SegmentChecker, 100, replica,
+		task.NewSegmentActionWithScope(1, task.ActionTypeGrow, "100-dmc0", 1, querypb.DataScope_Historical))
+	suite.NoError(err)
+	suite.scheduler.EXPECT().GetTasksBySource(utils.SegmentChecker).Return([]task.Task{stuck})
+	suite.scheduler.EXPECT().GetTasksBySource(utils.LoadStuckRetry).Return(nil)
+	suite.scheduler.EXPECT().Add(mock.Anything).Run(func(t task.Task) {
+		suite.Equal(utils.LoadStuckRetry, t.Source())
+		suite.Equal(task.TaskPriorityHigh, t.Priority())
+		suite.Len(t.Actions(), 1)
+		suite.EqualValues(3, t.Actions()[0].Node())
+		suite.EqualValues(1, t.(*task.SegmentTask).SegmentID())
+	}).Return(nil).Once()
+
+	now := time.Now()
+	suite.observer.check(ctx, now)
+	suite.observer.check(ctx, now.Add(5*time.Second))
+	suite.scheduler.AssertNotCalled(suite.T(), "Add", mock.Anything)
+	suite.Zero(suite.observer.GetRetryTimes(100))
+
+	// no progress within the timeout
+	suite.observer.check(ctx, now.Add(11*time.Second))
+	suite.scheduler.AssertNumberOfCalls(suite.T(), "Add", 1)
+	suite.Equal(1, suite.observer.GetRetryTimes(100))
+	suite.NoError(meta.GlobalFailedLoadCache.Get(100))
+
+	// give up after max retry times
+	suite.observer.check(ctx, now.Add(22*time.Second))
+	suite.Error(meta.GlobalFailedLoadCache.Get(100))
+	suite.Equal(1, suite.observer.GetRetryTimes(100))
+
+	// the given up load is not retried again
+	meta.GlobalFailedLoadCache.Remove(100)
+	suite.observer.check(ctx, now.Add(33*time.Second))
+	suite.observer.check(ctx, now.Add(44*time.Second))
+	suite.scheduler.AssertNumberOfCalls(suite.T(), "Add", 1)
+	suite.Equal(1, suite.observer.GetRetryTimes(100))
+	suite.NoError(meta.GlobalFailedLoadCache.Get(100))
+
+	// the load makes progress, it could be retried again
+	partition := suite.meta.CollectionManager.GetPartition(10).Clone()
+	partition.LoadPercentage = 50
+	suite.NoError(suite.meta.CollectionManager.PutPartition(partition))
+	suite.observer.check(ctx, now.Add(55*time.Second))
+	suite.Zero(suite.observer.GetRetryTimes(100))
+}
+
+func (suite *LoadStuckObserverSuite) TestProgressResetsTimer() {
+	paramtable.Get().Save(Params.QueryCoordCfg.LoadStuckTimeout.Key, "10")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.LoadStuckTimeout.Key)
+
+	ctx := context.Background()
+	collection := utils.CreateTestCollection(100, 1)
+	collection.Status = querypb.LoadStatus_Loading
+	partition := utils.CreateTestPartition(100, 10)
+	suite.NoError(suite.meta.CollectionManager.PutCollection(collection, partition))
+
+	now := time.Now()
+	suite.observer.check(ctx, now)
+
+	// load makes progress
+	partition = partition.Clone()
+	partition.LoadPercentage = 50
+	suite.NoError(suite.meta.CollectionManager.PutPartition(partition))
+	suite.observer.check(ctx, now.Add(11*time.Second))
+	suite.observer.check(ctx, now.Add(15*time.Second))
+	suite.scheduler.AssertNotCalled(suite.T(), "GetTasksBySource", mock.Anything)
+	suite.Zero(suite.observer.GetRetryTimes(100))
+}
+
+func TestLoadStuckObserver(t *testing.T) {
+	suite.Run(t, new(LoadStuckObserverSuite))
+}
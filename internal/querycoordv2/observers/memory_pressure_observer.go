@@ -0,0 +1,232 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/balance"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/task"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+)
+
+// MemoryPressureObserver watches memory usage of query nodes,
+// and moves segments of the lowest priority collection out of the memory-pressured nodes.
+type MemoryPressureObserver struct {
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+	meta      *meta.Meta
+	dist      *meta.DistributionManager
+	nodeMgr   *session.NodeManager
+	cluster   session.Cluster
+	balancer  balance.Balance
+	scheduler task.Scheduler
+
+	stopOnce sync.Once
+}
+
+func NewMemoryPressureObserver(
+	meta *meta.Meta,
+	dist *meta.DistributionManager,
+	nodeMgr *session.NodeManager,
+	cluster session.Cluster,
+	balancer balance.Balance,
+	scheduler task.Scheduler,
+) *MemoryPressureObserver {
+	return &MemoryPressureObserver{
+		meta:      meta,
+		dist:      dist,
+		nodeMgr:   nodeMgr,
+		cluster:   cluster,
+		balancer:  balancer,
+		scheduler: scheduler,
+	}
+}
+
+func (ob *MemoryPressureObserver) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	ob.cancel = cancel
+
+	ob.wg.Add(1)
+	go ob.schedule(ctx)
+}
+
+func (ob *MemoryPressureObserver) Stop() {
+	ob.stopOnce.Do(func() {
+		if ob.cancel != nil {
+			ob.cancel()
+		}
+		ob.wg.Wait()
+	})
+}
+
+func (ob *MemoryPressureObserver) schedule(ctx context.Context) {
+	defer ob.wg.Done()
+	log.Info("Start check memory pressure loop")
+
+	ticker := time.NewTicker(params.Params.QueryCoordCfg.MemoryPressureCheckInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("Close memory pressure observer")
+			return
+
+		case <-ticker.C:
+			if params.Params.QueryCoordCfg.EnableMemoryPressureEviction.GetAsBool() {
+				ob.checkMemoryPressure(ctx)
+			}
+		}
+	}
+}
+
+func (ob *MemoryPressureObserver) checkMemoryPressure(ctx context.Context) {
+	threshold := params.Params.QueryCoordCfg.MemoryPressureThreshold.GetAsFloat() / 100
+
+	usages := make(map[int64]float64)
+	for _, node := range ob.nodeMgr.GetAll() {
+		if node.IsStoppingState() {
+			continue
+		}
+		memory, usage, err := utils.GetNodeMemory(ctx, ob.cluster, node.ID())
+		if err != nil {
+			log.Warn("failed to get memory usage of query node", zap.Int64("nodeID", node.ID()), zap.Error(err))
+			continue
+		}
+		if memory == 0 {
+			log.Warn("invalid memory capacity of query node", zap.Int64("nodeID", node.ID()))
+			continue
+		}
+		usages[node.ID()] = float64(usage) / float64(memory)
+	}
+
+	for nodeID, usage := range usages {
+		if usage < threshold {
+			continue
+		}
+		log.Info("query node is under memory pressure",
+			zap.Int64("nodeID", nodeID),
+			zap.Float64("memoryUsage", usage),
+			zap.Float64("threshold", threshold))
+		ob.relieve(ctx, nodeID, usages, threshold)
+	}
+}
+
+// relieve moves segments of the lowest priority collection on the given node to other nodes in the same replica,
+// collections without any available destination node are skipped.
+func (ob *MemoryPressureObserver) relieve(ctx context.Context, nodeID int64, usages map[int64]float64, threshold float64) {
+	segments := ob.dist.SegmentDistManager.GetByFilter(meta.WithNodeID(nodeID))
+	collectionSegments := lo.GroupBy(segments, func(segment *meta.Segment) int64 { return segment.GetCollectionID() })
+
+	priorities := make(map[int64]int32)
+	for collectionID := range collectionSegments {
+		if collection := ob.meta.CollectionManager.GetCollection(collectionID); collection != nil {
+			priorities[collectionID] = collection.GetPriority()
+		}
+	}
+	collections := lo.Keys(priorities)
+	sort.Slice(collections, func(i, j int) bool {
+		pi, pj := priorities[collections[i]], priorities[collections[j]]
+		if pi != pj {
+			return pi < pj
+		}
+		return collections[i] < collections[j]
+	})
+
+	for _, collectionID := range collections {
+		replica := ob.meta.ReplicaManager.GetByCollectionAndNode(collectionID, nodeID)
+		if replica == nil {
+			continue
+		}
+		dstNodes := lo.Filter(replica.GetNodes(), func(node int64, _ int) bool {
+			usage, ok := usages[node]
+			return node != nodeID && ok && usage < threshold
+		})
+		if len(dstNodes) == 0 {
+			continue
+		}
+
+		victims := collectionSegments[collectionID]
+		sort.Slice(victims, func(i, j int) bool {
+			return utils.GetSegmentSize(victims[i].SegmentInfo) > utils.GetSegmentSize(victims[j].SegmentInfo)
+		})
+		step := params.Params.QueryCoordCfg.MemoryPressureEvictSegmentStep.GetAsInt()
+		if len(victims) > step {
+			victims = victims[:step]
+		}
+
+		ob.evict(ctx, replica, nodeID, dstNodes, victims)
+		return
+	}
+	log.Warn("no collection could be evicted from memory-pressured query node", zap.Int64("nodeID", nodeID))
+}
+
+func (ob *MemoryPressureObserver) evict(ctx context.Context, replica *meta.Replica, srcNode int64, dstNodes []int64, segments []*meta.Segment) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", replica.GetCollectionID()),
+		zap.Int64("replicaID", replica.GetID()),
+		zap.Int64("srcNode", srcNode),
+	)
+
+	plans := ob.balancer.AssignSegment(replica.GetCollectionID(), segments, dstNodes, false)
+	for _, plan := range plans {
+		t, err := task.NewSegmentTask(ctx,
+			params.Params.QueryCoordCfg.SegmentTaskTimeout.GetAsDuration(time.Millisecond),
+			utils.MemoryEviction,
+			replica.GetCollectionID(),
+			replica,
+			task.NewSegmentActionWithScope(plan.To, task.ActionTypeGrow, plan.Segment.GetInsertChannel(), plan.Segment.GetID(), querypb.DataScope_Historical),
+			task.NewSegmentActionWithScope(srcNode, task.ActionTypeReduce, plan.Segment.GetInsertChannel(), plan.Segment.GetID(), querypb.DataScope_Historical),
+		)
+		if err != nil {
+			log.Warn("failed to create segment task for memory eviction",
+				zap.Int64("segmentID", plan.Segment.GetID()),
+				zap.Int64("dstNode", plan.To),
+				zap.Error(err))
+			continue
+		}
+		t.SetReason("memory pressure")
+		if err := ob.scheduler.Add(t); err != nil {
+			t.Cancel(err)
+			log.Warn("failed to add segment task for memory eviction",
+				zap.Int64("segmentID", plan.Segment.GetID()),
+				zap.Int64("dstNode", plan.To),
+				zap.Error(err))
+			continue
+		}
+		log.Info("move segment out of memory-pressured node",
+			zap.Int64("segmentID", plan.Segment.GetID()),
+			zap.Int64("dstNode", plan.To))
+		metrics.QueryCoordMemoryPressureEvictCount.WithLabelValues(
+			fmt.Sprint(srcNode),
+			fmt.Sprint(replica.GetCollectionID()),
+		).Inc()
+	}
+}
@@ -0,0 +1,179 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/kv"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
+	"github.com/milvus-io/milvus/internal/querycoordv2/balance"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/task"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type MemoryPressureObserverSuite struct {
+	suite.Suite
+
+	kv kv.MetaKv
+	// dependency
+	meta      *meta.Meta
+	distMgr   *meta.DistributionManager
+	nodeMgr   *session.NodeManager
+	cluster   *session.MockCluster
+	balancer  *balance.MockBalancer
+	scheduler *task.MockScheduler
+
+	observer *MemoryPressureObserver
+}
+
+func (suite *MemoryPressureObserverSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (suite *MemoryPressureObserverSuite) SetupTest() {
+	config := GenerateEtcdConfig()
+	cli, err := etcd.GetEtcdClient(
+		config.UseEmbedEtcd.GetAsBool(),
+		config.EtcdUseSSL.GetAsBool(),
+		config.Endpoints.GetAsStrings(),
+		config.EtcdTLSCert.GetValue(),
+		config.EtcdTLSKey.GetValue(),
+		config.EtcdTLSCACert.GetValue(),
+		config.EtcdTLSMinVersion.GetValue())
+	suite.Require().NoError(err)
+	suite.kv = etcdkv.NewEtcdKV(cli, config.MetaRootPath.GetValue())
+
+	store := querycoord.NewCatalog(suite.kv)
+	suite.nodeMgr = session.NewNodeManager()
+	suite.meta = meta.NewMeta(RandomIncrementIDAllocator(), store, suite.nodeMgr)
+	suite.distMgr = meta.NewDistributionManager()
+	suite.cluster = session.NewMockCluster(suite.T())
+	suite.balancer = balance.NewMockBalancer(suite.T())
+	suite.scheduler = task.NewMockScheduler(suite.T())
+	suite.observer = NewMemoryPressureObserver(suite.meta, suite.distMgr, suite.nodeMgr, suite.cluster, suite.balancer, suite.scheduler)
+
+	for _, node := range []int64{1, 2, 3} {
+		suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   node,
+			Address:  "localhost",
+			Hostname: "localhost",
+		}))
+	}
+}
+
+func (suite *MemoryPressureObserverSuite) TearDownTest() {
+	suite.kv.RemoveWithPrefix("")
+	suite.kv.Close()
+}
+
+func (suite *MemoryPressureObserverSuite) mockMemoryUsage(usages map[int64]uint64) {
+	suite.cluster.EXPECT().GetMetrics(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, nodeID int64, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+			infos := metricsinfo.QueryNodeInfos{
+				BaseComponentInfos: metricsinfo.BaseComponentInfos{
+					HardwareInfos: metricsinfo.HardwareMetrics{
+						Memory:      100,
+						MemoryUsage: usages[nodeID],
+					},
+				},
+			}
+			resp, err := metricsinfo.MarshalComponentInfos(infos)
+			if err != nil {
+				return nil, err
+			}
+			return &milvuspb.GetMetricsResponse{
+				Status:   merr.Success(),
+				Response: resp,
+			}, nil
+		})
+}
+
+func (suite *MemoryPressureObserverSuite) TestEvictLowestPriorityCollection() {
+	paramtable.Get().Save(Params.QueryCoordCfg.MemoryPressureEvictSegmentStep.Key, "2")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.MemoryPressureEvictSegmentStep.Key)
+
+	highPriority := utils.CreateTestCollection(100, 1)
+	highPriority.Priority = 10
+	lowPriority := utils.CreateTestCollection(101, 1)
+	lowPriority.Priority = 1
+	suite.NoError(suite.meta.CollectionManager.PutCollection(highPriority))
+	suite.NoError(suite.meta.CollectionManager.PutCollection(lowPriority))
+	suite.NoError(suite.meta.ReplicaManager.Put(utils.CreateTestReplica(1, 100, []int64{1, 2, 3})))
+	suite.NoError(suite.meta.ReplicaManager.Put(utils.CreateTestReplica(2, 101, []int64{1, 2, 3})))
+
+	suite.distMgr.SegmentDistManager.Update(1,
+		utils.CreateTestSegment(100, 10, 1, 1, 1, "100-dmc0"),
+		utils.CreateTestSegment(101, 11, 2, 1, 1, "101-dmc0"),
+		utils.CreateTestSegment(101, 11, 3, 1, 1, "101-dmc0"),
+		utils.CreateTestSegment(101, 11, 4, 1, 1, "101-dmc0"),
+	)
+
+	// node 1 and node 3 are memory-pressured
+	suite.mockMemoryUsage(map[int64]uint64{1: 95, 2: 50, 3: 92})
+
+	suite.balancer.EXPECT().AssignSegment(int64(101), mock.Anything, []int64{2}, false).RunAndReturn(
+		func(collectionID int64, segments []*meta.Segment, nodes []int64, manualBalance bool) []balance.SegmentAssignPlan {
+			suite.Len(segments, 2)
+			plans := make([]balance.SegmentAssignPlan, 0, len(segments))
+			for _, segment := range segments {
+				plans = append(plans, balance.SegmentAssignPlan{Segment: segment, To: 2})
+			}
+			return plans
+		})
+	added := make([]task.Task, 0)
+	suite.scheduler.EXPECT().Add(mock.Anything).RunAndReturn(func(t task.Task) error {
+		added = append(added, t)
+		return nil
+	})
+
+	suite.observer.checkMemoryPressure(context.Background())
+
+	suite.Len(added, 2)
+	for _, t := range added {
+		suite.Equal(int64(101), t.CollectionID())
+		suite.Equal(utils.MemoryEviction, t.Source())
+		suite.Equal(int64(1), t.Actions()[1].Node())
+	}
+}
+
+func (suite *MemoryPressureObserverSuite) TestNoAvailableDestination() {
+	suite.NoError(suite.meta.CollectionManager.PutCollection(utils.CreateTestCollection(100, 1)))
+	suite.NoError(suite.meta.ReplicaManager.Put(utils.CreateTestReplica(1, 100, []int64{1, 2})))
+	suite.distMgr.SegmentDistManager.Update(1, utils.CreateTestSegment(100, 10, 1, 1, 1, "100-dmc0"))
+
+	// all nodes in replica are memory-pressured, nothing to do
+	suite.mockMemoryUsage(map[int64]uint64{1: 95, 2: 95, 3: 10})
+	suite.observer.checkMemoryPressure(context.Background())
+}
+
+func TestMemoryPressureObserver(t *testing.T) {
+	suite.Run(t, new(MemoryPressureObserverSuite))
+}
@@ -0,0 +1,150 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// NodeLoadSample is the load of a query node at a point in time.
+type NodeLoadSample struct {
+	Timestamp  time.Time
+	SegmentNum int
+	ChannelNum int
+	MemorySize int64 // the total size of the segments on the node, in bytes
+}
+
+// NodeLoadObserver samples the load of each query node periodically from the distribution,
+// and keeps the samples within the retention time.
+type NodeLoadObserver struct {
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	dist    *meta.DistributionManager
+	nodeMgr *session.NodeManager
+
+	mut     sync.RWMutex
+	samples map[int64][]NodeLoadSample // nodeID -> samples, from the oldest to the latest
+
+	stopOnce sync.Once
+}
+
+func NewNodeLoadObserver(
+	dist *meta.DistributionManager,
+	nodeMgr *session.NodeManager,
+) *NodeLoadObserver {
+	return &NodeLoadObserver{
+		dist:    dist,
+		nodeMgr: nodeMgr,
+		samples: make(map[int64][]NodeLoadSample),
+	}
+}
+
+func (ob *NodeLoadObserver) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	ob.cancel = cancel
+
+	ob.wg.Add(1)
+	go ob.schedule(ctx)
+}
+
+func (ob *NodeLoadObserver) Stop() {
+	ob.stopOnce.Do(func() {
+		if ob.cancel != nil {
+			ob.cancel()
+		}
+		ob.wg.Wait()
+	})
+}
+
+func (ob *NodeLoadObserver) schedule(ctx context.Context) {
+	defer ob.wg.Done()
+	log.Info("Start sample node load loop")
+
+	ticker := time.NewTicker(params.Params.QueryCoordCfg.NodeLoadSampleInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("Close node load observer")
+			return
+
+		case <-ticker.C:
+			ob.sample(time.Now())
+		}
+	}
+}
+
+func (ob *NodeLoadObserver) sample(now time.Time) {
+	expired := now.Add(-params.Params.QueryCoordCfg.NodeLoadHistoryRetention.GetAsDuration(time.Second))
+
+	ob.mut.Lock()
+	defer ob.mut.Unlock()
+
+	for _, node := range ob.nodeMgr.GetAll() {
+		segments := ob.dist.SegmentDistManager.GetByFilter(meta.WithNodeID(node.ID()))
+		channels := ob.dist.ChannelDistManager.GetByFilter(meta.WithNodeID2Channel(node.ID()))
+		sample := NodeLoadSample{
+			Timestamp:  now,
+			SegmentNum: len(segments),
+			ChannelNum: len(channels),
+		}
+		for _, segment := range segments {
+			sample.MemorySize += utils.GetSegmentSize(segment.SegmentInfo)
+		}
+		ob.samples[node.ID()] = append(ob.samples[node.ID()], sample)
+	}
+
+	// the history of the removed nodes is kept until out of retention as well
+	for nodeID, samples := range ob.samples {
+		i := 0
+		for i < len(samples) && samples[i].Timestamp.Before(expired) {
+			i++
+		}
+		if i == len(samples) {
+			delete(ob.samples, nodeID)
+			continue
+		}
+		ob.samples[nodeID] = samples[i:]
+	}
+}
+
+// GetHistory returns the load samples of the given node since the given time,
+// returns false if the node has never been sampled within the retention.
+func (ob *NodeLoadObserver) GetHistory(nodeID int64, since time.Time) ([]NodeLoadSample, bool) {
+	ob.mut.RLock()
+	defer ob.mut.RUnlock()
+
+	samples, ok := ob.samples[nodeID]
+	if !ok {
+		return nil, false
+	}
+	ret := make([]NodeLoadSample, 0, len(samples))
+	for _, sample := range samples {
+		if !sample.Timestamp.Before(since) {
+			ret = append(ret, sample)
+		}
+	}
+	return ret, true
+}
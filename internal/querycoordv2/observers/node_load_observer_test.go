@@ -0,0 +1,107 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type NodeLoadObserverSuite struct {
+	suite.Suite
+
+	dist    *meta.DistributionManager
+	nodeMgr *session.NodeManager
+
+	observer *NodeLoadObserver
+}
+
+func (suite *NodeLoadObserverSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (suite *NodeLoadObserverSuite) SetupTest() {
+	suite.dist = meta.NewDistributionManager()
+	suite.nodeMgr = session.NewNodeManager()
+	suite.observer = NewNodeLoadObserver(suite.dist, suite.nodeMgr)
+
+	suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   1,
+		Address:  "localhost",
+		Hostname: "localhost",
+	}))
+}
+
+func (suite *NodeLoadObserverSuite) TestSample() {
+	start := time.Now()
+	_, ok := suite.observer.GetHistory(1, time.Time{})
+	suite.False(ok)
+
+	suite.observer.sample(start)
+	suite.dist.SegmentDistManager.Update(1,
+		utils.CreateTestSegment(100, 10, 1, 1, 1, "100-dmc0"),
+		utils.CreateTestSegment(100, 10, 2, 1, 1, "100-dmc0"),
+	)
+	suite.dist.ChannelDistManager.Update(1, utils.CreateTestChannel(100, 1, 1, "100-dmc0"))
+	suite.observer.sample(start.Add(time.Minute))
+
+	samples, ok := suite.observer.GetHistory(1, time.Time{})
+	suite.True(ok)
+	suite.Len(samples, 2)
+	suite.Equal(0, samples[0].SegmentNum)
+	suite.Equal(2, samples[1].SegmentNum)
+	suite.Equal(1, samples[1].ChannelNum)
+
+	samples, ok = suite.observer.GetHistory(1, start.Add(time.Second))
+	suite.True(ok)
+	suite.Len(samples, 1)
+	suite.Equal(start.Add(time.Minute), samples[0].Timestamp)
+}
+
+func (suite *NodeLoadObserverSuite) TestRetention() {
+	paramtable.Get().Save(Params.QueryCoordCfg.NodeLoadHistoryRetention.Key, "60")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.NodeLoadHistoryRetention.Key)
+
+	start := time.Now()
+	suite.observer.sample(start)
+	suite.observer.sample(start.Add(30 * time.Second))
+	suite.observer.sample(start.Add(90 * time.Second))
+	samples, ok := suite.observer.GetHistory(1, time.Time{})
+	suite.True(ok)
+	suite.Len(samples, 2)
+
+	// history of the removed node is dropped after out of retention
+	suite.nodeMgr.Remove(1)
+	suite.observer.sample(start.Add(120 * time.Second))
+	_, ok = suite.observer.GetHistory(1, time.Time{})
+	suite.True(ok)
+	suite.observer.sample(start.Add(200 * time.Second))
+	_, ok = suite.observer.GetHistory(1, time.Time{})
+	suite.False(ok)
+}
+
+func TestNodeLoadObserver(t *testing.T) {
+	suite.Run(t, new(NodeLoadObserverSuite))
+}
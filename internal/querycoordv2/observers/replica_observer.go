@@ -21,6 +21,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
@@ -37,6 +38,9 @@ type ReplicaObserver struct {
 	meta    *meta.Meta
 	distMgr *meta.DistributionManager
 
+	// called after the standby replica of the collection is promoted
+	standbyPromotedHandler func(collectionID int64)
+
 	stopOnce sync.Once
 }
 
@@ -47,6 +51,12 @@ func NewReplicaObserver(meta *meta.Meta, distMgr *meta.DistributionManager) *Rep
 	}
 }
 
+// SetStandbyPromotedHandler sets the handler called after the standby replica of the collection is promoted,
+// it must be set before the observer starts.
+func (ob *ReplicaObserver) SetStandbyPromotedHandler(handler func(collectionID int64)) {
+	ob.standbyPromotedHandler = handler
+}
+
 func (ob *ReplicaObserver) Start() {
 	ctx, cancel := context.WithCancel(context.Background())
 	ob.cancel = cancel
@@ -79,6 +89,7 @@ func (ob *ReplicaObserver) schedule(ctx context.Context) {
 
 		// do check once.
 		ob.checkNodesInReplica()
+		ob.promoteStandbyReplicas()
 	}
 }
 
@@ -134,3 +145,38 @@ func (ob *ReplicaObserver) checkNodesInReplica() {
 		}
 	}
 }
+
+// promoteStandbyReplicas promotes a standby replica to serve if any primary replica has lost all its rw nodes,
+// the unavailable primary replica will be demoted to standby.
+func (ob *ReplicaObserver) promoteStandbyReplicas() {
+	for _, collectionID := range ob.meta.GetAll() {
+		replicas := ob.meta.ReplicaManager.GetByCollection(collectionID)
+		standbys := lo.Filter(replicas, func(replica *meta.Replica, _ int) bool {
+			return replica.IsStandby() && replica.RWNodesCount() > 0
+		})
+		for _, replica := range replicas {
+			if len(standbys) == 0 {
+				break
+			}
+			if replica.IsStandby() || replica.RWNodesCount() > 0 {
+				continue
+			}
+
+			standby := standbys[0]
+			standbys = standbys[1:]
+			logger := log.With(
+				zap.Int64("collectionID", collectionID),
+				zap.Int64("primaryReplicaID", replica.GetID()),
+				zap.Int64("standbyReplicaID", standby.GetID()),
+			)
+			if err := ob.meta.ReplicaManager.PromoteStandby(replica.GetID(), standby.GetID()); err != nil {
+				logger.Warn("failed to promote standby replica", zap.Error(err))
+				continue
+			}
+			logger.Info("primary replica unavailable, standby replica promoted")
+			if ob.standbyPromotedHandler != nil {
+				ob.standbyPromotedHandler(collectionID)
+			}
+		}
+	}
+}
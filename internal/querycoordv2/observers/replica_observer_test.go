@@ -25,6 +25,7 @@ import (
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
@@ -192,6 +193,44 @@ func (suite *ReplicaObserverSuite) TestCheckNodesInReplica() {
 	}, 30*time.Second, 2*time.Second)
 }
 
+func (suite *ReplicaObserverSuite) TestPromoteStandbyReplicas() {
+	// promote the replicas by hand
+	suite.observer.Stop()
+	observer := NewReplicaObserver(suite.meta, suite.distMgr)
+	promoted := make([]int64, 0)
+	observer.SetStandbyPromotedHandler(func(collectionID int64) {
+		promoted = append(promoted, collectionID)
+	})
+
+	collectionID := suite.collectionID + 1
+	err := suite.meta.CollectionManager.PutCollection(utils.CreateTestCollection(collectionID, 2))
+	suite.NoError(err)
+	err = suite.meta.ReplicaManager.Put(
+		meta.NewReplica(&querypb.Replica{
+			ID:            1,
+			CollectionID:  collectionID,
+			ResourceGroup: meta.DefaultResourceGroupName,
+		}, typeutil.NewUniqueSet()),
+		meta.NewReplica(&querypb.Replica{
+			ID:            2,
+			CollectionID:  collectionID,
+			ResourceGroup: meta.DefaultResourceGroupName,
+			Nodes:         []int64{1},
+			Standby:       true,
+		}, typeutil.NewUniqueSet()),
+	)
+	suite.NoError(err)
+
+	observer.promoteStandbyReplicas()
+	suite.True(suite.meta.ReplicaManager.Get(1).IsStandby())
+	suite.False(suite.meta.ReplicaManager.Get(2).IsStandby())
+	suite.Equal([]int64{collectionID}, promoted)
+
+	// nothing to promote
+	observer.promoteStandbyReplicas()
+	suite.Len(promoted, 1)
+}
+
 func (suite *ReplicaObserverSuite) TearDownSuite() {
 	suite.kv.Close()
 	suite.observer.Stop()
@@ -19,10 +19,12 @@ package observers
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/samber/lo"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
@@ -32,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/lock"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -67,6 +70,9 @@ type TargetObserver struct {
 	updateChan           chan targetUpdateRequest
 	mut                  sync.Mutex                // Guard readyNotifiers
 	readyNotifiers       map[int64][]chan struct{} // CollectionID -> Notifiers
+	// hold the target promotion of loaded collections if paused
+	paused      atomic.Bool
+	pausedSince atomic.Time
 
 	dispatcher *taskDispatcher[int64]
 	keylocks   *lock.KeyLock[int64]
@@ -184,6 +190,10 @@ func (ob *TargetObserver) check(ctx context.Context, collectionID int64) {
 	if !ob.meta.Exist(collectionID) {
 		ob.ReleaseCollection(collectionID)
 		ob.targetMgr.RemoveCollection(collectionID)
+		if err := ob.meta.LoadCheckpointManager.RemoveLoadCheckpoint(collectionID); err != nil {
+			log.Warn("failed to remove load checkpoint", zap.Int64("collectionID", collectionID), zap.Error(err))
+		}
+		deleteTargetLagMetrics(collectionID)
 		log.Info("collection has been removed from target observer",
 			zap.Int64("collectionID", collectionID))
 		return
@@ -192,7 +202,7 @@ func (ob *TargetObserver) check(ctx context.Context, collectionID int64) {
 	ob.keylocks.Lock(collectionID)
 	defer ob.keylocks.Unlock(collectionID)
 
-	if ob.shouldUpdateCurrentTarget(ctx, collectionID) {
+	if !ob.isTargetUpdateHeld(collectionID) && ob.shouldUpdateCurrentTarget(ctx, collectionID) {
 		ob.updateCurrentTarget(collectionID)
 	}
 
@@ -200,16 +210,42 @@ func (ob *TargetObserver) check(ctx context.Context, collectionID int64) {
 		// update next target in collection level
 		ob.updateNextTarget(collectionID)
 	}
+
+	label := utils.CollectionMetricsLabel(collectionID)
+	if label == "" {
+		// the collection may be labeled before the label config changed
+		deleteTargetLagMetrics(collectionID)
+		return
+	}
+	segmentNum, size := utils.GetTargetLag(ob.targetMgr, collectionID)
+	metrics.QueryCoordTargetLagSegmentNum.WithLabelValues(label).Set(float64(segmentNum))
+	metrics.QueryCoordTargetLagSize.WithLabelValues(label).Set(float64(size))
+}
+
+// deleteTargetLagMetrics removes the target lag metrics of the collection.
+func deleteTargetLagMetrics(collectionID int64) {
+	label := strconv.FormatInt(collectionID, 10)
+	metrics.QueryCoordTargetLagSegmentNum.DeleteLabelValues(label)
+	metrics.QueryCoordTargetLagSize.DeleteLabelValues(label)
 }
 
 func (ob *TargetObserver) init(ctx context.Context, collectionID int64) {
+	// the recovered current target and replicas match the load checkpoint,
+	// skip re-deriving the load plan on init, and leave pulling next target to the async check as usual
+	if ob.matchLoadCheckpoint(collectionID) {
+		log.Info("collection recovered from load checkpoint, skip pulling next target on init",
+			zap.Int64("collectionID", collectionID))
+		ob.dispatcher.AddTask(collectionID)
+		return
+	}
+
 	// pull next target first if not exist
 	if !ob.targetMgr.IsNextTargetExist(collectionID) {
 		ob.updateNextTarget(collectionID)
 	}
 
 	// try to update current target if all segment/channel are ready
-	if ob.shouldUpdateCurrentTarget(ctx, collectionID) {
+	if !ob.isTargetUpdateHeld(collectionID) && ob.shouldUpdateCurrentTarget(ctx, collectionID) {
 		ob.updateCurrentTarget(collectionID)
 	}
 	// refresh collection loading status upon restart
@@ -263,7 +299,33 @@ func (ob *TargetObserver) clean() {
 	}
 }
 
+// PauseTargetUpdates holds the target promotion of all loaded collections until resumed,
+// the next target won't be refreshed either, collections still loading are not affected.
+func (ob *TargetObserver) PauseTargetUpdates(since time.Time) {
+	ob.pausedSince.Store(since)
+	ob.paused.Store(true)
+}
+
+// ResumeTargetUpdates resumes the target updates, the held next targets are promoted at once.
+func (ob *TargetObserver) ResumeTargetUpdates() {
+	if ob.paused.CompareAndSwap(true, false) {
+		ob.dispatcher.AddTask(ob.meta.GetAll()...)
+	}
+}
+
+// IsTargetUpdatePaused returns whether the target updates are paused, and the time when paused.
+func (ob *TargetObserver) IsTargetUpdatePaused() (bool, time.Time) {
+	return ob.paused.Load(), ob.pausedSince.Load()
+}
+
+func (ob *TargetObserver) isTargetUpdateHeld(collectionID int64) bool {
+	return ob.paused.Load() && ob.targetMgr.IsCurrentTargetExist(collectionID)
+}
+
 func (ob *TargetObserver) shouldUpdateNextTarget(collectionID int64) bool {
+	if ob.isTargetUpdateHeld(collectionID) && ob.targetMgr.IsNextTargetExist(collectionID) {
+		return false
+	}
 	return !ob.targetMgr.IsNextTargetExist(collectionID) || ob.isNextTargetExpired(collectionID)
 }
 
@@ -492,6 +554,8 @@ func (ob *TargetObserver) updateCurrentTarget(collectionID int64) {
 	log := log.Ctx(context.TODO()).WithRateGroup("qcv2.TargetObserver", 1, 60)
 	log.RatedInfo(10, "observer trigger update current target", zap.Int64("collectionID", collectionID))
 	if ob.targetMgr.UpdateCollectionCurrentTarget(collectionID) {
+		ob.saveLoadCheckpoint(collectionID)
+
 		ob.mut.Lock()
 		defer ob.mut.Unlock()
 		notifiers := ob.readyNotifiers[collectionID]
@@ -504,3 +568,21 @@ func (ob *TargetObserver) updateCurrentTarget(collectionID int64) {
 		}
 	}
 }
+
+func (ob *TargetObserver) matchLoadCheckpoint(collectionID int64) bool {
+	if !ob.targetMgr.IsCurrentTargetExist(collectionID) {
+		return false
+	}
+	targetVersion := ob.targetMgr.GetCollectionTargetVersion(collectionID, meta.CurrentTarget)
+	replicas := ob.meta.ReplicaManager.GetByCollection(collectionID)
+	return ob.meta.LoadCheckpointManager.MatchLoadCheckpoint(collectionID, targetVersion, replicas)
+}
+
+func (ob *TargetObserver) saveLoadCheckpoint(collectionID int64) {
+	targetVersion := ob.targetMgr.GetCollectionTargetVersion(collectionID, meta.CurrentTarget)
+	replicas := ob.meta.ReplicaManager.GetByCollection(collectionID)
+	err := ob.meta.LoadCheckpointManager.SaveLoadCheckpoint(collectionID, targetVersion, replicas)
+	if err != nil {
+		log.Warn("failed to save load checkpoint", zap.Int64("collectionID", collectionID), zap.Error(err))
+	}
+}
@@ -18,6 +18,7 @@ package observers
 
 import (
 	"context"
+	"strconv"
 	"testing"
 	"time"
 
@@ -33,6 +34,7 @@ import (
 	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
@@ -162,6 +164,9 @@ func (suite *TargetObserverSuite) TestTriggerUpdateTarget() {
 		ID:            13,
 		PartitionID:   suite.partitionID,
 		InsertChannel: "channel-1",
+		Binlogs: []*datapb.FieldBinlog{
+			{Binlogs: []*datapb.Binlog{{LogSize: 1024}}},
+		},
 	})
 	suite.targetMgr.UpdateCollectionCurrentTarget(suite.collectionID)
 
@@ -175,6 +180,11 @@ func (suite *TargetObserverSuite) TestTriggerUpdateTarget() {
 	}, 7*time.Second, 1*time.Second)
 	suite.broker.AssertExpectations(suite.T())
 
+	// the new segment lags behind until current target updated
+	segmentNum, size := utils.GetTargetLag(suite.targetMgr, suite.collectionID)
+	suite.Equal(1, segmentNum)
+	suite.Equal(int64(1024), size)
+
 	// Manually update next target
 	ready, err := suite.observer.UpdateNextTarget(suite.collectionID)
 	suite.NoError(err)
@@ -289,6 +299,98 @@ func (s *TargetObserverCheckSuite) TestCheck() {
 	s.True(s.observer.dispatcher.tasks.Contain(s.collectionID))
 }
 
+func (s *TargetObserverCheckSuite) TestTargetLagMetrics() {
+	s.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, s.collectionID).Return(nil, nil, nil).Maybe()
+	s.broker.EXPECT().GetPartitions(mock.Anything, s.collectionID).Return([]int64{s.partitionID}, nil).Maybe()
+	label := strconv.FormatInt(s.collectionID, 10)
+
+	// no target lag metrics unless the collection metrics label is enabled
+	s.observer.check(context.Background(), s.collectionID)
+	s.False(metrics.QueryCoordTargetLagSegmentNum.DeleteLabelValues(label))
+
+	paramtable.Get().Save(Params.QueryCoordCfg.EnableCollectionMetricsLabel.Key, "true")
+	s.observer.check(context.Background(), s.collectionID)
+	s.True(metrics.QueryCoordTargetLagSegmentNum.DeleteLabelValues(label))
+
+	// the metrics are removed after the label disabled
+	s.observer.check(context.Background(), s.collectionID)
+	paramtable.Get().Reset(Params.QueryCoordCfg.EnableCollectionMetricsLabel.Key)
+	s.observer.check(context.Background(), s.collectionID)
+	s.False(metrics.QueryCoordTargetLagSize.DeleteLabelValues(label))
+}
+
+func (s *TargetObserverCheckSuite) TestLoadCheckpoint() {
+	ctx := context.Background()
+	s.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, s.collectionID).Return([]*datapb.VchannelInfo{
+		{
+			CollectionID: s.collectionID,
+			ChannelName:  "channel-1",
+		},
+	}, nil, nil).Once()
+	s.targetMgr.UpdateCollectionNextTarget(s.collectionID)
+	s.True(s.targetMgr.UpdateCollectionCurrentTarget(s.collectionID))
+	s.observer.saveLoadCheckpoint(s.collectionID)
+
+	checkpoint := s.meta.GetLoadCheckpoint(s.collectionID)
+	s.Require().NotNil(checkpoint)
+	s.Equal(s.targetMgr.GetCollectionTargetVersion(s.collectionID, meta.CurrentTarget), checkpoint.GetTargetVersion())
+	s.Len(checkpoint.GetReplicas(), 1)
+
+	// recovered state matches the checkpoint, skip pulling next target on init
+	s.True(s.observer.matchLoadCheckpoint(s.collectionID))
+	s.observer.init(ctx, s.collectionID)
+	s.False(s.targetMgr.IsNextTargetExist(s.collectionID))
+	s.True(s.observer.dispatcher.tasks.Contain(s.collectionID))
+
+	// the target updates resume as usual
+	s.True(s.observer.shouldUpdateNextTarget(s.collectionID))
+	s.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, s.collectionID).Return([]*datapb.VchannelInfo{
+		{
+			CollectionID: s.collectionID,
+			ChannelName:  "channel-1",
+		},
+	}, nil, nil).Once()
+	s.observer.check(ctx, s.collectionID)
+	s.True(s.targetMgr.IsNextTargetExist(s.collectionID))
+	s.False(s.observer.shouldUpdateNextTarget(s.collectionID))
+
+	// replica layout changed
+	s.meta.ReplicaManager.Get(checkpoint.GetReplicas()[0].GetID()).AddRWNode(3)
+	s.False(s.observer.matchLoadCheckpoint(s.collectionID))
+
+	s.meta.InvalidateLoadCheckpoints()
+	s.Nil(s.meta.GetLoadCheckpoint(s.collectionID))
+	s.Eventually(func() bool {
+		keys, _, err := s.kv.LoadWithPrefix(querycoord.LoadCheckpointPrefix)
+		return err == nil && len(keys) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func (s *TargetObserverCheckSuite) TestPauseTargetUpdates() {
+	s.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, s.collectionID).Return([]*datapb.VchannelInfo{
+		{
+			CollectionID: s.collectionID,
+			ChannelName:  "channel-1",
+		},
+	}, nil, nil)
+	s.targetMgr.UpdateCollectionNextTarget(s.collectionID)
+
+	// collection still loading is not held
+	s.observer.PauseTargetUpdates(time.Now())
+	s.False(s.observer.isTargetUpdateHeld(s.collectionID))
+
+	s.True(s.targetMgr.UpdateCollectionCurrentTarget(s.collectionID))
+	s.targetMgr.UpdateCollectionNextTarget(s.collectionID)
+	s.True(s.observer.isTargetUpdateHeld(s.collectionID))
+	s.False(s.observer.shouldUpdateNextTarget(s.collectionID))
+
+	s.observer.ResumeTargetUpdates()
+	paused, _ := s.observer.IsTargetUpdatePaused()
+	s.False(paused)
+	s.False(s.observer.isTargetUpdateHeld(s.collectionID))
+	s.True(s.observer.dispatcher.tasks.Contain(s.collectionID))
+}
+
 func TestTargetObserver(t *testing.T) {
 	suite.Run(t, new(TargetObserverSuite))
 	suite.Run(t, new(TargetObserverCheckSuite))
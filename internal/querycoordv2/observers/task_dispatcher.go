@@ -33,15 +33,15 @@ type taskDispatcher[K comparable] struct {
 	tasks      *typeutil.ConcurrentMap[K, bool]
 	pool       *conc.Pool[any]
 	notifyCh   chan struct{}
-	taskRunner task[K]
+	taskRunner taskFunc[K]
 	wg         sync.WaitGroup
 	cancel     context.CancelFunc
 	stopOnce   sync.Once
 }
 
-type task[K comparable] func(context.Context, K)
+type taskFunc[K comparable] func(context.Context, K)
 
-func newTaskDispatcher[K comparable](runner task[K]) *taskDispatcher[K] {
+func newTaskDispatcher[K comparable](runner taskFunc[K]) *taskDispatcher[K] {
 	return &taskDispatcher[K]{
 		tasks:      typeutil.NewConcurrentMap[K, bool](),
 		pool:       conc.NewPool[any](paramtable.Get().QueryCoordCfg.ObserverTaskParallel.GetAsInt()),
@@ -19,6 +19,7 @@ package querycoordv2
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/mock"
@@ -191,6 +192,187 @@ func (suite *OpsServiceSuite) TestActiveCheckers() {
 	suite.True(suite.checkerController.IsActive(utils.ChannelChecker))
 }
 
+func (suite *OpsServiceSuite) TestTriggerCheckerRun() {
+	ctx := context.Background()
+
+	// test server unhealthy
+	suite.server.UpdateStateCode(commonpb.StateCode_Abnormal)
+	resp, err := suite.server.TriggerCheckerRun(ctx, &querypb.TriggerCheckerRunRequest{})
+	suite.NoError(err)
+	suite.False(merr.Ok(resp.GetStatus()))
+
+	// test unknown checker
+	suite.server.UpdateStateCode(commonpb.StateCode_Healthy)
+	resp, err = suite.server.TriggerCheckerRun(ctx, &querypb.TriggerCheckerRunRequest{
+		CheckerID: 100,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+
+	// test collection not loaded
+	resp, err = suite.server.TriggerCheckerRun(ctx, &querypb.TriggerCheckerRunRequest{
+		CheckerID:    int32(utils.ChannelChecker),
+		CollectionID: 1000,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrCollectionNotLoaded)
+
+	// test nothing to do
+	resp, err = suite.server.TriggerCheckerRun(ctx, &querypb.TriggerCheckerRunRequest{
+		CheckerID: int32(utils.ChannelChecker),
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Empty(resp.GetTasks())
+
+	// test unknown checker name
+	resp, err = suite.server.TriggerCheckerRun(ctx, &querypb.TriggerCheckerRunRequest{
+		CheckerNames: []string{utils.ChannelCheckerName, "unknown_checker"},
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+	suite.Contains(resp.GetStatus().GetReason(), "unknown_checker")
+
+	// test run checkers by name
+	resp, err = suite.server.TriggerCheckerRun(ctx, &querypb.TriggerCheckerRunRequest{
+		CheckerID:    int32(utils.ChannelChecker),
+		CheckerNames: []string{utils.SegmentCheckerName},
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Empty(resp.GetTasks())
+
+	// other checkers still run while balance is suspended
+	suite.checkerController.Deactivate(utils.BalanceChecker)
+	defer suite.checkerController.Activate(utils.BalanceChecker)
+	resp, err = suite.server.TriggerCheckerRun(ctx, &querypb.TriggerCheckerRunRequest{
+		CheckerID: int32(utils.ChannelChecker),
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+
+	// the balance checker is rejected while balance is suspended unless forced
+	resp, err = suite.server.TriggerCheckerRun(ctx, &querypb.TriggerCheckerRunRequest{
+		CheckerNames: []string{utils.ChannelCheckerName, utils.BalanceCheckerName},
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrServiceUnavailable)
+
+	// the balance checker is skipped when running all checkers
+	resp, err = suite.server.TriggerCheckerRun(ctx, &querypb.TriggerCheckerRunRequest{})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+
+	// run all checkers
+	resp, err = suite.server.TriggerCheckerRun(ctx, &querypb.TriggerCheckerRunRequest{
+		Force: true,
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+}
+
+func (suite *OpsServiceSuite) TestDescribeChecker() {
+	ctx := context.Background()
+
+	// test server unhealthy
+	suite.server.UpdateStateCode(commonpb.StateCode_Abnormal)
+	resp, err := suite.server.DescribeChecker(ctx, &querypb.DescribeCheckerRequest{})
+	suite.NoError(err)
+	suite.False(merr.Ok(resp.GetStatus()))
+
+	// test unknown checker
+	suite.server.UpdateStateCode(commonpb.StateCode_Healthy)
+	resp, err = suite.server.DescribeChecker(ctx, &querypb.DescribeCheckerRequest{
+		CheckerID: 100,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+
+	// never run
+	suite.taskScheduler.EXPECT().GetTasksBySource(utils.ChannelChecker).Return(nil).Once()
+	resp, err = suite.server.DescribeChecker(ctx, &querypb.DescribeCheckerRequest{
+		CheckerID: int32(utils.ChannelChecker),
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Equal(int32(utils.ChannelChecker), resp.GetChecker().GetId())
+	suite.True(resp.GetChecker().GetActivated())
+	suite.Zero(resp.GetChecker().GetLastRunTime())
+	suite.Empty(resp.GetPendingTasks())
+
+	// the latest run is recorded
+	triggerResp, err := suite.server.TriggerCheckerRun(ctx, &querypb.TriggerCheckerRunRequest{
+		CheckerID: int32(utils.ChannelChecker),
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(triggerResp.GetStatus()))
+	listResp, err := suite.server.ListCheckers(ctx, &querypb.ListCheckersRequest{
+		CheckerIDs: []int32{int32(utils.ChannelChecker)},
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(listResp.GetStatus()))
+	checkerInfo, ok := lo.Find(listResp.GetCheckerInfos(), func(info *querypb.CheckerInfo) bool {
+		return info.GetId() == int32(utils.ChannelChecker)
+	})
+	suite.Require().True(ok)
+	suite.NotZero(checkerInfo.GetLastRunTime())
+	suite.Zero(checkerInfo.GetLastGeneratedTaskNum())
+
+	// pending tasks generated by the checker
+	pending, err := task.NewChannelTask(ctx, time.Second, utils.ChannelChecker, 1000, meta.NilReplica,
+		task.NewChannelAction(1, task.ActionTypeGrow, "channel-1"))
+	suite.NoError(err)
+	suite.taskScheduler.EXPECT().GetTasksBySource(utils.ChannelChecker).Return([]task.Task{pending}).Once()
+	resp, err = suite.server.DescribeChecker(ctx, &querypb.DescribeCheckerRequest{
+		CheckerID: int32(utils.ChannelChecker),
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.NotZero(resp.GetChecker().GetLastRunTime())
+	suite.Len(resp.GetPendingTasks(), 1)
+	suite.Equal(int64(1000), resp.GetPendingTasks()[0].GetCollectionID())
+	suite.Equal("channel-1", resp.GetPendingTasks()[0].GetShard())
+	suite.True(resp.GetPendingTasks()[0].GetAdded())
+}
+
+func (suite *OpsServiceSuite) TestGetTenantViolations() {
+	ctx := context.Background()
+
+	// test server unhealthy
+	suite.server.UpdateStateCode(commonpb.StateCode_Abnormal)
+	resp, err := suite.server.GetTenantViolations(ctx, &querypb.GetTenantViolationsRequest{})
+	suite.NoError(err)
+	suite.False(merr.Ok(resp.GetStatus()))
+
+	suite.server.UpdateStateCode(commonpb.StateCode_Healthy)
+	for collectionID, tenant := range map[int64]string{1000: "a", 1001: "b", 1002: ""} {
+		collection := utils.CreateTestCollection(collectionID, 1)
+		collection.Tenant = tenant
+		suite.meta.CollectionManager.PutCollection(collection)
+	}
+	suite.dist.SegmentDistManager.Update(1,
+		utils.CreateTestSegment(1000, 1, 1, 1, 1, "1000-dmc0"),
+		utils.CreateTestSegment(1002, 2, 2, 1, 1, "1002-dmc0"))
+	suite.dist.ChannelDistManager.Update(2, utils.CreateTestChannel(1000, 2, 1, "1000-dmc1"))
+	suite.dist.SegmentDistManager.Update(2, utils.CreateTestSegment(1002, 2, 3, 2, 1, "1002-dmc0"))
+
+	// no violation, collection without tenant could share node with any tenant
+	resp, err = suite.server.GetTenantViolations(ctx, &querypb.GetTenantViolationsRequest{})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Empty(resp.GetViolations())
+
+	// node 2 serves both tenant a and tenant b
+	suite.dist.SegmentDistManager.Update(2, utils.CreateTestSegment(1001, 3, 4, 2, 1, "1001-dmc0"))
+	resp, err = suite.server.GetTenantViolations(ctx, &querypb.GetTenantViolationsRequest{})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Len(resp.GetViolations(), 1)
+	suite.Equal(int64(2), resp.GetViolations()[0].GetNodeID())
+	suite.Equal([]string{"a", "b"}, resp.GetViolations()[0].GetTenants())
+	suite.Equal([]int64{1000, 1001}, resp.GetViolations()[0].GetCollectionIDs())
+}
+
 func (suite *OpsServiceSuite) TestListQueryNode() {
 	// test server unhealthy
 	suite.server.UpdateStateCode(commonpb.StateCode_Abnormal)
@@ -283,6 +465,215 @@ func (suite *OpsServiceSuite) TestGetQueryNodeDistribution() {
 	suite.Equal(2, len(resp.GetSealedSegmentIDs()))
 }
 
+func (suite *OpsServiceSuite) TestCanStopNode() {
+	ctx := context.Background()
+
+	// test server unhealthy
+	suite.server.UpdateStateCode(commonpb.StateCode_Abnormal)
+	resp, err := suite.server.CanStopNode(ctx, &querypb.CanStopNodeRequest{NodeID: 1})
+	suite.NoError(err)
+	suite.False(merr.Ok(resp.GetStatus()))
+
+	// test node not found
+	suite.server.UpdateStateCode(commonpb.StateCode_Healthy)
+	resp, err = suite.server.CanStopNode(ctx, &querypb.CanStopNodeRequest{NodeID: 1})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrNodeNotFound)
+
+	for _, nodeID := range []int64{1, 2, 3} {
+		suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   nodeID,
+			Address:  "localhost",
+			Hostname: "localhost",
+		}))
+	}
+	// channel1 is led by node 1 and node 2, node 1 serves its segment on node 3,
+	// channel2 is led by node 1 only
+	suite.dist.LeaderViewManager.Update(1,
+		&meta.LeaderView{
+			ID:           1,
+			CollectionID: 1,
+			Channel:      "channel1",
+			Segments:     map[int64]*querypb.SegmentDist{1: {NodeID: 3}},
+		},
+		&meta.LeaderView{
+			ID:           1,
+			CollectionID: 1,
+			Channel:      "channel2",
+		},
+	)
+	suite.dist.LeaderViewManager.Update(2, &meta.LeaderView{
+		ID:           2,
+		CollectionID: 1,
+		Channel:      "channel1",
+		Segments:     map[int64]*querypb.SegmentDist{1: {NodeID: 2}},
+	})
+
+	resp, err = suite.server.CanStopNode(ctx, &querypb.CanStopNodeRequest{NodeID: 1})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.False(resp.GetCanStop())
+	suite.Len(resp.GetShardsAtRisk(), 1)
+	suite.Equal(int64(1), resp.GetShardsAtRisk()[0].GetCollectionID())
+	suite.Equal("channel2", resp.GetShardsAtRisk()[0].GetChannel())
+
+	resp, err = suite.server.CanStopNode(ctx, &querypb.CanStopNodeRequest{NodeID: 2})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.True(resp.GetCanStop())
+	suite.Empty(resp.GetShardsAtRisk())
+
+	resp, err = suite.server.CanStopNode(ctx, &querypb.CanStopNodeRequest{NodeID: 3})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.True(resp.GetCanStop())
+
+	// channel1 loses its last available leader once node 2 stopped
+	suite.nodeMgr.Remove(3)
+	resp, err = suite.server.CanStopNode(ctx, &querypb.CanStopNodeRequest{NodeID: 2})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.False(resp.GetCanStop())
+	suite.Len(resp.GetShardsAtRisk(), 1)
+	suite.Equal("channel1", resp.GetShardsAtRisk()[0].GetChannel())
+}
+
+func (suite *OpsServiceSuite) TestDecommissionNode() {
+	ctx := context.Background()
+
+	// test server unhealthy
+	suite.server.UpdateStateCode(commonpb.StateCode_Abnormal)
+	resp, err := suite.server.DecommissionNode(ctx, &querypb.DecommissionNodeRequest{NodeID: 1})
+	suite.NoError(err)
+	suite.False(merr.Ok(resp.GetStatus()))
+	progressResp, err := suite.server.GetDecommissionProgress(ctx, &querypb.GetDecommissionProgressRequest{NodeID: 1})
+	suite.NoError(err)
+	suite.False(merr.Ok(progressResp.GetStatus()))
+
+	// test node not found
+	suite.server.UpdateStateCode(commonpb.StateCode_Healthy)
+	resp, err = suite.server.DecommissionNode(ctx, &querypb.DecommissionNodeRequest{NodeID: 1})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrNodeNotFound)
+
+	for _, nodeID := range []int64{1, 2} {
+		suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   nodeID,
+			Address:  "localhost",
+			Hostname: "localhost",
+		}))
+	}
+	suite.dist.SegmentDistManager.Update(1, utils.CreateTestSegment(1, 1, 1, 1, 1, "channel1"))
+	suite.dist.ChannelDistManager.Update(1, utils.CreateTestChannel(1, 1, 1, "channel1"))
+
+	// test stopping balance disabled
+	paramtable.Get().Save(Params.QueryCoordCfg.EnableStoppingBalance.Key, "false")
+	resp, err = suite.server.DecommissionNode(ctx, &querypb.DecommissionNodeRequest{NodeID: 1})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrServiceUnavailable)
+	paramtable.Get().Reset(Params.QueryCoordCfg.EnableStoppingBalance.Key)
+
+	// test node not being decommissioned
+	progressResp, err = suite.server.GetDecommissionProgress(ctx, &querypb.GetDecommissionProgressRequest{NodeID: 1})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(progressResp.GetStatus()), merr.ErrParameterInvalid)
+
+	// test start draining
+	resp, err = suite.server.DecommissionNode(ctx, &querypb.DecommissionNodeRequest{NodeID: 1})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Equal(querypb.DecommissionState_DecommissionDraining, resp.GetProgress().GetState())
+	suite.EqualValues(1, resp.GetProgress().GetTotalSegments())
+	suite.EqualValues(1, resp.GetProgress().GetRemainingSegments())
+	suite.EqualValues(1, resp.GetProgress().GetTotalChannels())
+	suite.EqualValues(1, resp.GetProgress().GetRemainingChannels())
+	stopping, err := suite.nodeMgr.IsStoppingNode(1)
+	suite.NoError(err)
+	suite.True(stopping)
+
+	// test wait until timeout
+	resp, err = suite.server.DecommissionNode(ctx, &querypb.DecommissionNodeRequest{NodeID: 1, WaitTimeout: 100})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Equal(querypb.DecommissionState_DecommissionDraining, resp.GetProgress().GetState())
+
+	// test wait until drained
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		suite.dist.SegmentDistManager.Update(1)
+		suite.dist.ChannelDistManager.Update(1)
+	}()
+	resp, err = suite.server.DecommissionNode(ctx, &querypb.DecommissionNodeRequest{NodeID: 1, WaitTimeout: 10000})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Equal(querypb.DecommissionState_DecommissionCompleted, resp.GetProgress().GetState())
+	suite.EqualValues(1, resp.GetProgress().GetTotalSegments())
+	suite.EqualValues(0, resp.GetProgress().GetRemainingSegments())
+
+	// test abort once the node comes back healthy
+	suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   1,
+		Address:  "localhost",
+		Hostname: "localhost",
+	}))
+	progressResp, err = suite.server.GetDecommissionProgress(ctx, &querypb.GetDecommissionProgressRequest{NodeID: 1})
+	suite.NoError(err)
+	suite.True(merr.Ok(progressResp.GetStatus()))
+	suite.Equal(querypb.DecommissionState_DecommissionAborted, progressResp.GetProgress().GetState())
+
+	// test decommission again after aborted
+	resp, err = suite.server.DecommissionNode(ctx, &querypb.DecommissionNodeRequest{NodeID: 1})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Equal(querypb.DecommissionState_DecommissionCompleted, resp.GetProgress().GetState())
+	suite.EqualValues(0, resp.GetProgress().GetTotalSegments())
+}
+
+func (suite *OpsServiceSuite) TestRecoverDecommissions() {
+	ctx := context.Background()
+	suite.server.UpdateStateCode(commonpb.StateCode_Healthy)
+	for _, nodeID := range []int64{1, 2} {
+		suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   nodeID,
+			Address:  "localhost",
+			Hostname: "localhost",
+		}))
+	}
+	suite.dist.SegmentDistManager.Update(1, utils.CreateTestSegment(1, 1, 1, 1, 1, "channel1"))
+	for _, nodeID := range []int64{1, 2} {
+		resp, err := suite.server.DecommissionNode(ctx, &querypb.DecommissionNodeRequest{NodeID: nodeID})
+		suite.NoError(err)
+		suite.True(merr.Ok(resp.GetStatus()))
+	}
+
+	// failover, node 1 is still registered and node 2 is gone
+	suite.server.decommissions = typeutil.ConcurrentMap[int64, *nodeDecommission]{}
+	suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   1,
+		Address:  "localhost",
+		Hostname: "localhost",
+	}))
+	suite.nodeMgr.Remove(2)
+	suite.NoError(suite.server.recoverDecommissions())
+
+	stopping, err := suite.nodeMgr.IsStoppingNode(1)
+	suite.NoError(err)
+	suite.True(stopping)
+	progressResp, err := suite.server.GetDecommissionProgress(ctx, &querypb.GetDecommissionProgressRequest{NodeID: 1})
+	suite.NoError(err)
+	suite.True(merr.Ok(progressResp.GetStatus()))
+	suite.Equal(querypb.DecommissionState_DecommissionDraining, progressResp.GetProgress().GetState())
+	suite.EqualValues(1, progressResp.GetProgress().GetTotalSegments())
+
+	progressResp, err = suite.server.GetDecommissionProgress(ctx, &querypb.GetDecommissionProgressRequest{NodeID: 2})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(progressResp.GetStatus()), merr.ErrParameterInvalid)
+	decommissions, err := suite.store.GetNodeDecommissions()
+	suite.NoError(err)
+	suite.Len(decommissions, 1)
+	suite.EqualValues(1, decommissions[0].GetNodeID())
+}
+
 func (suite *OpsServiceSuite) TestCheckQueryNodeDistribution() {
 	// test server unhealthy
 	suite.server.UpdateStateCode(commonpb.StateCode_Abnormal)
@@ -373,6 +764,77 @@ func (suite *OpsServiceSuite) TestCheckQueryNodeDistribution() {
 	suite.True(merr.Ok(resp))
 }
 
+func (suite *OpsServiceSuite) TestForceSync() {
+	// test server unhealthy
+	suite.server.UpdateStateCode(commonpb.StateCode_Abnormal)
+	ctx := context.Background()
+	resp, err := suite.server.ForceSync(ctx, &querypb.ForceSyncRequest{})
+	suite.NoError(err)
+	suite.False(merr.Ok(resp.GetStatus()))
+
+	suite.server.UpdateStateCode(commonpb.StateCode_Healthy)
+	suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   1,
+		Address:  "localhost",
+		Hostname: "localhost",
+	}))
+	suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   2,
+		Address:  "localhost",
+		Hostname: "localhost",
+	}))
+	suite.dist.SegmentDistManager.Update(1, &meta.Segment{
+		SegmentInfo: &datapb.SegmentInfo{ID: 100, CollectionID: 1, InsertChannel: "channel1"},
+		Node:        1,
+	})
+
+	// node 1 has lost segment 100 and loaded segment 101, node 2 failed to sync
+	suite.distController.EXPECT().SyncNode(mock.Anything, int64(1)).RunAndReturn(func(ctx context.Context, nodeID int64) error {
+		suite.dist.SegmentDistManager.Update(1, &meta.Segment{
+			SegmentInfo: &datapb.SegmentInfo{ID: 101, CollectionID: 1, InsertChannel: "channel1"},
+			Node:        1,
+		})
+		suite.dist.ChannelDistManager.Update(1, &meta.DmChannel{
+			VchannelInfo: &datapb.VchannelInfo{CollectionID: 1, ChannelName: "channel1"},
+			Node:         1,
+		})
+		return nil
+	})
+	suite.distController.EXPECT().SyncNode(mock.Anything, int64(2)).Return(merr.WrapErrServiceUnavailable("mock"))
+
+	resp, err = suite.server.ForceSync(ctx, &querypb.ForceSyncRequest{})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Len(resp.GetNodeResults(), 2)
+	result := resp.GetNodeResults()[0]
+	suite.Equal(int64(1), result.GetNodeID())
+	suite.Equal(int32(1), result.GetSegmentsAdded())
+	suite.Equal(int32(1), result.GetSegmentsRemoved())
+	suite.Equal(int32(1), result.GetChannelsAdded())
+	suite.Equal(int32(0), result.GetChannelsRemoved())
+	suite.Empty(result.GetError())
+	suite.Equal(int64(2), resp.GetNodeResults()[1].GetNodeID())
+	suite.NotEmpty(resp.GetNodeResults()[1].GetError())
+	suite.Empty(resp.GetRefreshedCollections())
+
+	// test sync collection not loaded
+	resp, err = suite.server.ForceSync(ctx, &querypb.ForceSyncRequest{
+		CollectionID: 1000,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrCollectionNotLoaded)
+
+	// test sync collection without target, nothing to do
+	suite.meta.CollectionManager.PutCollection(utils.CreateTestCollection(1000, 1))
+	resp, err = suite.server.ForceSync(ctx, &querypb.ForceSyncRequest{
+		CollectionID: 1000,
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Zero(resp.GetActionNum())
+	suite.Empty(resp.GetNodeResults())
+}
+
 func (suite *OpsServiceSuite) TestSuspendAndResumeBalance() {
 	// test server unhealthy
 	suite.server.UpdateStateCode(commonpb.StateCode_Abnormal)
@@ -391,11 +853,104 @@ func (suite *OpsServiceSuite) TestSuspendAndResumeBalance() {
 	suite.NoError(err)
 	suite.True(merr.Ok(resp))
 	suite.False(suite.checkerController.IsActive(utils.BalanceChecker))
+	state, err := suite.store.GetBalanceState()
+	suite.NoError(err)
+	suite.True(state.GetSuspended())
+	suite.NotZero(state.GetSuspendedTime())
 
 	resp, err = suite.server.ResumeBalance(ctx, &querypb.ResumeBalanceRequest{})
 	suite.NoError(err)
 	suite.True(merr.Ok(resp))
 	suite.True(suite.checkerController.IsActive(utils.BalanceChecker))
+	state, err = suite.store.GetBalanceState()
+	suite.NoError(err)
+	suite.False(state.GetSuspended())
+}
+
+func (suite *OpsServiceSuite) TestPauseAndResumeTargetUpdates() {
+	// test server unhealthy
+	suite.server.UpdateStateCode(commonpb.StateCode_Abnormal)
+	ctx := context.Background()
+	resp, err := suite.server.PauseTargetUpdates(ctx, &querypb.PauseTargetUpdatesRequest{})
+	suite.NoError(err)
+	suite.False(merr.Ok(resp))
+
+	resp, err = suite.server.ResumeTargetUpdates(ctx, &querypb.ResumeTargetUpdatesRequest{})
+	suite.NoError(err)
+	suite.False(merr.Ok(resp))
+
+	// test pause success
+	suite.server.UpdateStateCode(commonpb.StateCode_Healthy)
+	resp, err = suite.server.PauseTargetUpdates(ctx, &querypb.PauseTargetUpdatesRequest{})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp))
+	paused, since := suite.targetObserver.IsTargetUpdatePaused()
+	suite.True(paused)
+	state, err := suite.store.GetTargetUpdateState()
+	suite.NoError(err)
+	suite.True(state.GetPaused())
+	suite.Equal(since.UnixMilli(), state.GetPausedTime())
+
+	// pause again keeps the paused time
+	resp, err = suite.server.PauseTargetUpdates(ctx, &querypb.PauseTargetUpdatesRequest{})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp))
+	_, since2 := suite.targetObserver.IsTargetUpdatePaused()
+	suite.Equal(since, since2)
+
+	resp, err = suite.server.ResumeTargetUpdates(ctx, &querypb.ResumeTargetUpdatesRequest{})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp))
+	paused, _ = suite.targetObserver.IsTargetUpdatePaused()
+	suite.False(paused)
+	state, err = suite.store.GetTargetUpdateState()
+	suite.NoError(err)
+	suite.False(state.GetPaused())
+}
+
+func (suite *OpsServiceSuite) TestBlockShard() {
+	ctx := context.Background()
+
+	// test server unhealthy
+	suite.server.UpdateStateCode(commonpb.StateCode_Abnormal)
+	status, err := suite.server.BlockShard(ctx, &querypb.BlockShardRequest{CollectionID: 1000, Channel: "1000-dmc0"})
+	suite.NoError(err)
+	suite.False(merr.Ok(status))
+	status, err = suite.server.UnblockShard(ctx, &querypb.UnblockShardRequest{CollectionID: 1000, Channel: "1000-dmc0"})
+	suite.NoError(err)
+	suite.False(merr.Ok(status))
+
+	// test collection not loaded
+	suite.server.UpdateStateCode(commonpb.StateCode_Healthy)
+	status, err = suite.server.BlockShard(ctx, &querypb.BlockShardRequest{CollectionID: 1000, Channel: "1000-dmc0"})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(status), merr.ErrCollectionNotLoaded)
+
+	suite.meta.CollectionManager.PutCollection(utils.CreateTestCollection(1000, 1))
+	suite.meta.CollectionManager.PutPartition(utils.CreateTestPartition(1000, 10))
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, int64(1000)).Return([]*datapb.VchannelInfo{
+		{CollectionID: 1000, ChannelName: "1000-dmc0"},
+	}, nil, nil)
+	suite.targetMgr.UpdateCollectionNextTarget(1000)
+
+	// test channel not found
+	status, err = suite.server.BlockShard(ctx, &querypb.BlockShardRequest{CollectionID: 1000, Channel: "1000-dmc1"})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(status), merr.ErrChannelNotFound)
+
+	status, err = suite.server.BlockShard(ctx, &querypb.BlockShardRequest{CollectionID: 1000, Channel: "1000-dmc0", Reason: "corrupt index"})
+	suite.NoError(err)
+	suite.True(merr.Ok(status))
+	suite.True(suite.meta.ShardBlockManager.IsShardBlocked(1000, "1000-dmc0"))
+	blocks, err := suite.store.GetShardBlocks()
+	suite.NoError(err)
+	suite.Len(blocks, 1)
+	suite.Equal("corrupt index", blocks[0].GetReason())
+
+	status, err = suite.server.UnblockShard(ctx, &querypb.UnblockShardRequest{CollectionID: 1000, Channel: "1000-dmc0"})
+	suite.NoError(err)
+	suite.True(merr.Ok(status))
+	suite.False(suite.meta.ShardBlockManager.IsShardBlocked(1000, "1000-dmc0"))
 }
 
 func (suite *OpsServiceSuite) TestSuspendAndResumeNode() {
@@ -593,6 +1148,7 @@ func (suite *OpsServiceSuite) TestTransferSegment() {
 	}
 
 	// test transfer segment success, expect generate 1 balance segment task
+	suite.taskScheduler.EXPECT().TrackBalance(mock.Anything).Return(int64(1)).Maybe()
 	suite.taskScheduler.EXPECT().Add(mock.Anything).RunAndReturn(func(t task.Task) error {
 		actions := t.Actions()
 		suite.Equal(len(actions), 2)
@@ -609,6 +1165,7 @@ func (suite *OpsServiceSuite) TestTransferSegment() {
 
 	// test copy mode, expect generate 1 load segment task
 	suite.taskScheduler.ExpectedCalls = nil
+	suite.taskScheduler.EXPECT().TrackBalance(mock.Anything).Return(int64(1)).Maybe()
 	suite.taskScheduler.EXPECT().Add(mock.Anything).RunAndReturn(func(t task.Task) error {
 		actions := t.Actions()
 		suite.Equal(len(actions), 1)
@@ -627,6 +1184,7 @@ func (suite *OpsServiceSuite) TestTransferSegment() {
 	// test transfer all segments, expect generate 4 load segment task
 	suite.taskScheduler.ExpectedCalls = nil
 	counter := atomic.NewInt64(0)
+	suite.taskScheduler.EXPECT().TrackBalance(mock.Anything).Return(int64(1)).Maybe()
 	suite.taskScheduler.EXPECT().Add(mock.Anything).RunAndReturn(func(t task.Task) error {
 		actions := t.Actions()
 		suite.Equal(len(actions), 2)
@@ -647,6 +1205,7 @@ func (suite *OpsServiceSuite) TestTransferSegment() {
 	suite.taskScheduler.ExpectedCalls = nil
 	counter = atomic.NewInt64(0)
 	nodeSet := typeutil.NewUniqueSet()
+	suite.taskScheduler.EXPECT().TrackBalance(mock.Anything).Return(int64(1)).Maybe()
 	suite.taskScheduler.EXPECT().Add(mock.Anything).RunAndReturn(func(t task.Task) error {
 		actions := t.Actions()
 		suite.Equal(len(actions), 2)
@@ -886,3 +1445,71 @@ func (suite *OpsServiceSuite) TestTransferChannel() {
 func TestOpsService(t *testing.T) {
 	suite.Run(t, new(OpsServiceSuite))
 }
+
+func (suite *OpsServiceSuite) TestBalanceLayout() {
+	ctx := context.Background()
+
+	// test server unhealthy
+	suite.server.UpdateStateCode(commonpb.StateCode_Abnormal)
+	status, err := suite.server.CaptureBalanceLayout(ctx, &querypb.CaptureBalanceLayoutRequest{CollectionID: 1000})
+	suite.NoError(err)
+	suite.False(merr.Ok(status))
+	status, err = suite.server.ClearBalanceLayout(ctx, &querypb.ClearBalanceLayoutRequest{CollectionID: 1000})
+	suite.NoError(err)
+	suite.False(merr.Ok(status))
+	resp, err := suite.server.GetBalanceLayoutStatus(ctx, &querypb.GetBalanceLayoutStatusRequest{})
+	suite.NoError(err)
+	suite.False(merr.Ok(resp.GetStatus()))
+
+	// test collection not loaded
+	suite.server.UpdateStateCode(commonpb.StateCode_Healthy)
+	status, err = suite.server.CaptureBalanceLayout(ctx, &querypb.CaptureBalanceLayoutRequest{CollectionID: 1000})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(status), merr.ErrCollectionNotLoaded)
+	status, err = suite.server.ClearBalanceLayout(ctx, &querypb.ClearBalanceLayoutRequest{CollectionID: 1000})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(status), merr.ErrCollectionNotLoaded)
+	resp, err = suite.server.GetBalanceLayoutStatus(ctx, &querypb.GetBalanceLayoutStatusRequest{CollectionIDs: []int64{1000}})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrCollectionNotLoaded)
+
+	// test collection still loading
+	collection := utils.CreateTestCollection(1000, 1)
+	collection.Status = querypb.LoadStatus_Loading
+	suite.meta.CollectionManager.PutCollection(collection)
+	suite.meta.ReplicaManager.Put(utils.CreateTestReplica(1, 1000, []int64{1, 2}))
+	status, err = suite.server.CaptureBalanceLayout(ctx, &querypb.CaptureBalanceLayoutRequest{CollectionID: 1000})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(status), merr.ErrCollectionNotFullyLoaded)
+
+	// test capture layout
+	collection.Status = querypb.LoadStatus_Loaded
+	suite.meta.CollectionManager.PutCollection(collection)
+	suite.dist.SegmentDistManager.Update(1, utils.CreateTestSegment(1000, 1, 1, 1, 1, "1000-dmc0"))
+	suite.dist.SegmentDistManager.Update(2, utils.CreateTestSegment(1000, 1, 2, 2, 1, "1000-dmc0"))
+	suite.dist.ChannelDistManager.Update(1, utils.CreateTestChannel(1000, 1, 1, "1000-dmc0"))
+	status, err = suite.server.CaptureBalanceLayout(ctx, &querypb.CaptureBalanceLayoutRequest{CollectionID: 1000})
+	suite.NoError(err)
+	suite.True(merr.Ok(status))
+	layout := suite.meta.BalanceLayoutManager.GetLayout(1000)
+	suite.NotNil(layout)
+	suite.Equal(map[int64]int64{1: 1, 2: 2}, layout.Replicas[1].Segments)
+	suite.Equal(map[string]int64{"1000-dmc0": 1}, layout.Replicas[1].Channels)
+
+	resp, err = suite.server.GetBalanceLayoutStatus(ctx, &querypb.GetBalanceLayoutStatusRequest{})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Len(resp.GetStatuses(), 1)
+	suite.True(resp.GetStatuses()[0].GetActive())
+	suite.EqualValues(2, resp.GetStatuses()[0].GetSegmentNum())
+	suite.EqualValues(1, resp.GetStatuses()[0].GetChannelNum())
+
+	// test clear layout
+	status, err = suite.server.ClearBalanceLayout(ctx, &querypb.ClearBalanceLayoutRequest{CollectionID: 1000})
+	suite.NoError(err)
+	suite.True(merr.Ok(status))
+	resp, err = suite.server.GetBalanceLayoutStatus(ctx, &querypb.GetBalanceLayoutStatusRequest{CollectionIDs: []int64{1000}})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.False(resp.GetStatuses()[0].GetActive())
+}
@@ -18,6 +18,8 @@ package querycoordv2
 
 import (
 	"context"
+	"sort"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
@@ -25,11 +27,13 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/checkers"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -50,12 +54,7 @@ func (s *Server) ListCheckers(ctx context.Context, req *querypb.ListCheckersRequ
 	}
 	for _, checker := range checkers {
 		if checkerIDSet.Len() == 0 || checkerIDSet.Contain(int32(checker.ID())) {
-			resp.CheckerInfos = append(resp.CheckerInfos, &querypb.CheckerInfo{
-				Id:        int32(checker.ID()),
-				Activated: checker.IsActive(),
-				Desc:      checker.ID().String(),
-				Found:     true,
-			})
+			resp.CheckerInfos = append(resp.CheckerInfos, s.getCheckerInfo(checker))
 			checkerIDSet.Remove(int32(checker.ID()))
 		}
 	}
@@ -98,6 +97,146 @@ func (s *Server) DeactivateChecker(ctx context.Context, req *querypb.DeactivateC
 	return merr.Success(), nil
 }
 
+// DescribeChecker returns the latest run of the given checker, and the tasks it generated which are still pending.
+func (s *Server) DescribeChecker(ctx context.Context, req *querypb.DescribeCheckerRequest) (*querypb.DescribeCheckerResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int32("checkerID", req.GetCheckerID()),
+	)
+	log.Info("describe checker request received")
+
+	errMsg := "failed to describe checker"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.DescribeCheckerResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+
+	checker, ok := lo.Find(s.checkerController.Checkers(), func(checker checkers.Checker) bool {
+		return int32(checker.ID()) == req.GetCheckerID()
+	})
+	if !ok {
+		err := merr.WrapErrParameterInvalidMsg("invalid checker id %d", req.GetCheckerID())
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.DescribeCheckerResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	tasks, err := s.checkerController.GetPendingTasks(checker.ID())
+	if err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.DescribeCheckerResponse{
+			Status: merr.Status(merr.WrapErrServiceInternal(err.Error())),
+		}, nil
+	}
+	pending := make([]*querypb.CheckerTaskInfo, 0, len(tasks))
+	for _, t := range tasks {
+		info := newCheckerTaskInfo(t)
+		info.Added = true
+		pending = append(pending, info)
+	}
+
+	return &querypb.DescribeCheckerResponse{
+		Status:       merr.Success(),
+		Checker:      s.getCheckerInfo(checker),
+		PendingTasks: pending,
+	}, nil
+}
+
+// TriggerCheckerRun runs a single iteration of the given checkers immediately rather than waiting for their intervals,
+// all checkers are run if no checker given. It's rejected while the automatic balance is suspended unless forced.
+// Returns the generated tasks and whether they have been submitted to scheduler.
+func (s *Server) TriggerCheckerRun(ctx context.Context, req *querypb.TriggerCheckerRunRequest) (*querypb.TriggerCheckerRunResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int32("checkerID", req.GetCheckerID()),
+		zap.Strings("checkers", req.GetCheckerNames()),
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Bool("force", req.GetForce()),
+	)
+	log.Info("trigger checker run request received")
+
+	errMsg := "failed to trigger checker run"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.TriggerCheckerRunResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+
+	if req.GetCollectionID() > 0 && !s.meta.CollectionManager.Exist(req.GetCollectionID()) {
+		err := merr.WrapErrCollectionNotLoaded(req.GetCollectionID())
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.TriggerCheckerRunResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	checkerTypes := make([]utils.CheckerType, 0)
+	if req.GetCheckerID() != 0 {
+		checkerTypes = append(checkerTypes, utils.CheckerType(req.GetCheckerID()))
+	}
+	if len(req.GetCheckerNames()) > 0 || len(checkerTypes) == 0 {
+		checkerList, err := s.selectCheckers(req.GetCheckerNames())
+		if err != nil {
+			log.Warn(errMsg, zap.Error(err))
+			return &querypb.TriggerCheckerRunResponse{
+				Status: merr.Status(err),
+			}, nil
+		}
+		for _, checker := range checkerList {
+			if !lo.Contains(checkerTypes, checker.ID()) {
+				checkerTypes = append(checkerTypes, checker.ID())
+			}
+		}
+	}
+
+	// the suspended balance checker is skipped when running all checkers, and rejected if requested explicitly
+	if active, err := s.checkerController.IsActive(utils.BalanceChecker); err == nil && !active && !req.GetForce() &&
+		lo.Contains(checkerTypes, utils.BalanceChecker) {
+		if req.GetCheckerID() != 0 || len(req.GetCheckerNames()) > 0 {
+			err := merr.WrapErrServiceUnavailable("automatic balance is suspended", "set force to trigger the balance checker anyway")
+			log.Warn(errMsg, zap.Error(err))
+			return &querypb.TriggerCheckerRunResponse{
+				Status: merr.Status(err),
+			}, nil
+		}
+		log.Info("automatic balance is suspended, skip the balance checker")
+		checkerTypes = lo.Without(checkerTypes, utils.BalanceChecker)
+	}
+
+	infos := make([]*querypb.CheckerTaskInfo, 0)
+	addedNum, failedNum := 0, 0
+	for _, checkerType := range checkerTypes {
+		added, failed, err := s.checkerController.RunOnce(ctx, checkerType, req.GetCollectionID())
+		if err != nil {
+			err = merr.WrapErrParameterInvalidMsg("invalid checker id %d: %s", checkerType, err.Error())
+			log.Warn(errMsg, zap.Error(err))
+			return &querypb.TriggerCheckerRunResponse{
+				Status: merr.Status(err),
+			}, nil
+		}
+		for _, t := range added {
+			info := newCheckerTaskInfo(t)
+			info.Added = true
+			infos = append(infos, info)
+		}
+		for t, err := range failed {
+			info := newCheckerTaskInfo(t)
+			info.Error = err.Error()
+			infos = append(infos, info)
+		}
+		addedNum += len(added)
+		failedNum += len(failed)
+	}
+	log.Info("checker run finished", zap.Int("addedTaskNum", addedNum), zap.Int("failedTaskNum", failedNum))
+
+	return &querypb.TriggerCheckerRunResponse{
+		Status: merr.Success(),
+		Tasks:  infos,
+	}, nil
+}
+
 // return all available node list, for each node, return it's (nodeID, ip_address)
 func (s *Server) ListQueryNode(ctx context.Context, req *querypb.ListQueryNodeRequest) (*querypb.ListQueryNodeResponse, error) {
 	log := log.Ctx(ctx)
@@ -116,6 +255,7 @@ func (s *Server) ListQueryNode(ctx context.Context, req *querypb.ListQueryNodeRe
 			ID:      nodeInfo.ID(),
 			Address: nodeInfo.Addr(),
 			State:   nodeInfo.GetState().String(),
+			Labels:  nodeInfo.Labels(),
 		}
 	})
 
@@ -146,13 +286,7 @@ func (s *Server) GetQueryNodeDistribution(ctx context.Context, req *querypb.GetQ
 		}, nil
 	}
 
-	segments := s.dist.SegmentDistManager.GetByFilter(meta.WithNodeID(req.GetNodeID()))
-	channels := s.dist.ChannelDistManager.GetByFilter(meta.WithNodeID2Channel(req.GetNodeID()))
-	return &querypb.GetQueryNodeDistributionResponse{
-		Status:           merr.Success(),
-		ChannelNames:     lo.Map(channels, func(c *meta.DmChannel, _ int) string { return c.GetChannelName() }),
-		SealedSegmentIDs: lo.Map(segments, func(s *meta.Segment, _ int) int64 { return s.GetID() }),
-	}, nil
+	return s.getQueryNodeDistribution(req.GetNodeID()), nil
 }
 
 // suspend background balance for all query node, include stopping balance and auto balance
@@ -165,7 +299,17 @@ func (s *Server) SuspendBalance(ctx context.Context, req *querypb.SuspendBalance
 		return merr.Status(err), nil
 	}
 
-	err := s.checkerController.Deactivate(utils.BalanceChecker)
+	// keep balance suspended across failover
+	err := s.store.SaveBalanceState(&querypb.BalanceState{
+		Suspended:     true,
+		SuspendedTime: time.Now().UnixMilli(),
+	})
+	if err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	err = s.checkerController.Deactivate(utils.BalanceChecker)
 	if err != nil {
 		log.Warn(errMsg, zap.Error(err))
 		return merr.Status(err), nil
@@ -185,7 +329,125 @@ func (s *Server) ResumeBalance(ctx context.Context, req *querypb.ResumeBalanceRe
 		return merr.Status(err), nil
 	}
 
-	err := s.checkerController.Activate(utils.BalanceChecker)
+	err := s.store.SaveBalanceState(&querypb.BalanceState{})
+	if err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	err = s.checkerController.Activate(utils.BalanceChecker)
+	if err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	return merr.Success(), nil
+}
+
+// pause target updates for all collections, the next targets are held until resumed
+func (s *Server) PauseTargetUpdates(ctx context.Context, req *querypb.PauseTargetUpdatesRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx)
+
+	log.Info("PauseTargetUpdates request received")
+
+	errMsg := "failed to pause target updates"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	if paused, _ := s.targetObserver.IsTargetUpdatePaused(); paused {
+		return merr.Success(), nil
+	}
+
+	now := time.Now()
+	err := s.store.SaveTargetUpdateState(&querypb.TargetUpdateState{
+		Paused:     true,
+		PausedTime: now.UnixMilli(),
+	})
+	if err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(errors.Wrap(err, errMsg)), nil
+	}
+	s.targetObserver.PauseTargetUpdates(now)
+
+	return merr.Success(), nil
+}
+
+// resume target updates for all collections, the held next targets are promoted at once
+func (s *Server) ResumeTargetUpdates(ctx context.Context, req *querypb.ResumeTargetUpdatesRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx)
+
+	log.Info("ResumeTargetUpdates request received")
+
+	errMsg := "failed to resume target updates"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	err := s.store.SaveTargetUpdateState(&querypb.TargetUpdateState{})
+	if err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(errors.Wrap(err, errMsg)), nil
+	}
+	s.targetObserver.ResumeTargetUpdates()
+
+	return merr.Success(), nil
+}
+
+// block the shard from serving until unblocked, GetShardLeaders fails unless the caller allows omitting blocked channels
+func (s *Server) BlockShard(ctx context.Context, req *querypb.BlockShardRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("channel", req.GetChannel()),
+	)
+
+	log.Info("BlockShard request received", zap.String("reason", req.GetReason()))
+
+	errMsg := "failed to block shard"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	if !s.meta.CollectionManager.Exist(req.GetCollectionID()) {
+		err := merr.WrapErrCollectionNotLoaded(req.GetCollectionID())
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	if s.targetMgr.GetDmChannel(req.GetCollectionID(), req.GetChannel(), meta.CurrentTargetFirst) == nil {
+		err := merr.WrapErrChannelNotFound(req.GetChannel(), "channel not found in target")
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	err := s.meta.ShardBlockManager.BlockShard(req.GetCollectionID(), req.GetChannel(), req.GetReason())
+	if err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	return merr.Success(), nil
+}
+
+// unblock the shard, do nothing if the shard is not blocked
+func (s *Server) UnblockShard(ctx context.Context, req *querypb.UnblockShardRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("channel", req.GetChannel()),
+	)
+
+	log.Info("UnblockShard request received")
+
+	errMsg := "failed to unblock shard"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	err := s.meta.ShardBlockManager.UnblockShard(req.GetCollectionID(), req.GetChannel())
 	if err != nil {
 		log.Warn(errMsg, zap.Error(err))
 		return merr.Status(err), nil
@@ -247,6 +509,120 @@ func (s *Server) ResumeNode(ctx context.Context, req *querypb.ResumeNodeRequest)
 	return merr.Success(), nil
 }
 
+// CanStopNode checks whether the given node can be stopped without making any shard unavailable,
+// it's used as the gate of graceful shutdown.
+func (s *Server) CanStopNode(ctx context.Context, req *querypb.CanStopNodeRequest) (*querypb.CanStopNodeResponse, error) {
+	log := log.Ctx(ctx).With(zap.Int64("nodeID", req.GetNodeID()))
+	log.Info("CanStopNode request received")
+
+	errMsg := "failed to check whether query node can be stopped"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.CanStopNodeResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	if s.nodeMgr.Get(req.GetNodeID()) == nil {
+		err := merr.WrapErrNodeNotFound(req.GetNodeID(), errMsg)
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.CanStopNodeResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	shards := s.getShardsAtRisk(req.GetNodeID())
+	if len(shards) > 0 {
+		log.Info("stopping query node makes shards unavailable", zap.Int("shardNum", len(shards)))
+	}
+	return &querypb.CanStopNodeResponse{
+		Status:       merr.Success(),
+		CanStop:      len(shards) == 0,
+		ShardsAtRisk: shards,
+	}, nil
+}
+
+// DecommissionNode drains the given node by marking it stopping, the segments and channels on it are moved to
+// the other nodes of the same replicas. It waits for the node to be drained if the wait timeout is set,
+// and the progress could be polled by GetDecommissionProgress.
+func (s *Server) DecommissionNode(ctx context.Context, req *querypb.DecommissionNodeRequest) (*querypb.DecommissionNodeResponse, error) {
+	log := log.Ctx(ctx).With(zap.Int64("nodeID", req.GetNodeID()))
+	log.Info("DecommissionNode request received")
+
+	errMsg := "failed to decommission query node"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.DecommissionNodeResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+
+	if s.nodeMgr.Get(req.GetNodeID()) == nil {
+		err := merr.WrapErrNodeNotFound(req.GetNodeID(), errMsg)
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.DecommissionNodeResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	if !paramtable.Get().QueryCoordCfg.EnableStoppingBalance.GetAsBool() {
+		err := merr.WrapErrServiceUnavailable("stopping balance is disabled, the node can't be drained")
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.DecommissionNodeResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	decommission, err := s.startDecommission(req.GetNodeID())
+	if err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.DecommissionNodeResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+	var progress *querypb.DecommissionProgress
+	if req.GetWaitTimeout() > 0 {
+		ctx, cancel := context.WithTimeout(ctx, time.Duration(req.GetWaitTimeout())*time.Millisecond)
+		defer cancel()
+		progress = s.waitDecommission(ctx, req.GetNodeID(), decommission)
+	} else {
+		progress = s.getDecommissionProgress(req.GetNodeID(), decommission)
+	}
+	log.Info("decommission query node", zap.String("state", progress.GetState().String()))
+	return &querypb.DecommissionNodeResponse{
+		Status:   merr.Success(),
+		Progress: progress,
+	}, nil
+}
+
+// GetDecommissionProgress returns the progress of draining the node decommissioned by DecommissionNode.
+func (s *Server) GetDecommissionProgress(ctx context.Context, req *querypb.GetDecommissionProgressRequest) (*querypb.GetDecommissionProgressResponse, error) {
+	log := log.Ctx(ctx).With(zap.Int64("nodeID", req.GetNodeID()))
+	log.Info("GetDecommissionProgress request received")
+
+	errMsg := "failed to get decommission progress"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.GetDecommissionProgressResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+
+	decommission, ok := s.decommissions.Get(req.GetNodeID())
+	if !ok {
+		err := merr.WrapErrParameterInvalidMsg("node %d is not being decommissioned", req.GetNodeID())
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.GetDecommissionProgressResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	return &querypb.GetDecommissionProgressResponse{
+		Status:   merr.Success(),
+		Progress: s.getDecommissionProgress(req.GetNodeID(), decommission),
+	}, nil
+}
+
 // transfer segment from source to target,
 // if no segment_id specified, default to transfer all segment on the source node.
 // if no target_nodeId specified, default to move segment to all other nodes
@@ -309,7 +685,7 @@ func (s *Server) TransferSegment(ctx context.Context, req *querypb.TransferSegme
 			}
 		}
 
-		err := s.balanceSegments(ctx, replica.GetCollectionID(), replica, srcNode, dstNodeSet.Collect(), toBalance.Collect(), false, req.GetCopyMode())
+		_, err := s.balanceSegments(ctx, replica.GetCollectionID(), replica, dstNodeSet.Collect(), toBalance.Collect(), false, req.GetCopyMode())
 		if err != nil {
 			msg := "failed to balance segments"
 			log.Warn(msg, zap.Error(err))
@@ -379,7 +755,7 @@ func (s *Server) TransferChannel(ctx context.Context, req *querypb.TransferChann
 			}
 		}
 
-		err := s.balanceChannels(ctx, replica.GetCollectionID(), replica, srcNode, dstNodeSet.Collect(), toBalance.Collect(), false, req.GetCopyMode())
+		err := s.balanceChannels(ctx, replica.GetCollectionID(), replica, dstNodeSet.Collect(), toBalance.Collect(), false, req.GetCopyMode())
 		if err != nil {
 			msg := "failed to balance channels"
 			log.Warn(msg, zap.Error(err))
@@ -458,3 +834,210 @@ func (s *Server) CheckQueryNodeDistribution(ctx context.Context, req *querypb.Ch
 
 	return merr.Success(), nil
 }
+
+// ForceSync pulls the actual data distribution from every query node, reconciles coordinator's dist meta with it,
+// and then triggers next target re-evaluation for all loaded collections.
+// It's a heavy consistency-repair tool, which makes no correction on a healthy cluster.
+// If the collection is given, it reconciles the distribution of the collection with its current target instead,
+// rather than waiting for the next run of the channel and segment checkers.
+func (s *Server) ForceSync(ctx context.Context, req *querypb.ForceSyncRequest) (*querypb.ForceSyncResponse, error) {
+	log := log.Ctx(ctx)
+	log.Info("ForceSync request received", zap.Int64("collectionID", req.GetCollectionID()))
+
+	errMsg := "failed to force sync cluster"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.ForceSyncResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+
+	if req.GetCollectionID() != 0 {
+		return s.forceSyncCollection(ctx, req.GetCollectionID()), nil
+	}
+
+	nodes := lo.Map(s.nodeMgr.GetAll(), func(node *session.NodeInfo, _ int) int64 { return node.ID() })
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i] < nodes[j] })
+	results := s.forceSync(ctx, nodes)
+
+	refreshed := make([]int64, 0)
+	for _, collectionID := range s.meta.CollectionManager.GetAll() {
+		if _, err := s.targetObserver.UpdateNextTarget(collectionID); err != nil {
+			log.Warn("failed to re-evaluate next target", zap.Int64("collectionID", collectionID), zap.Error(err))
+			continue
+		}
+		refreshed = append(refreshed, collectionID)
+	}
+
+	log.Info("ForceSync done", zap.Any("nodeResults", results), zap.Int64s("refreshedCollections", refreshed))
+	return &querypb.ForceSyncResponse{
+		Status:               merr.Success(),
+		NodeResults:          results,
+		RefreshedCollections: refreshed,
+	}, nil
+}
+
+// GetTenantViolations returns the query nodes which hold data of collections from different tenants.
+func (s *Server) GetTenantViolations(ctx context.Context, req *querypb.GetTenantViolationsRequest) (*querypb.GetTenantViolationsResponse, error) {
+	log := log.Ctx(ctx)
+	log.Info("get tenant violations request received")
+
+	errMsg := "failed to get tenant violations"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.GetTenantViolationsResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+
+	tenants := make(map[int64]string)
+	for _, collection := range s.meta.CollectionManager.GetAllCollections() {
+		if collection.GetTenant() != "" {
+			tenants[collection.GetCollectionID()] = collection.GetTenant()
+		}
+	}
+
+	collectionsOnNode := make(map[int64]typeutil.UniqueSet)
+	record := func(nodeID, collectionID int64) {
+		if _, ok := tenants[collectionID]; !ok {
+			return
+		}
+		if _, ok := collectionsOnNode[nodeID]; !ok {
+			collectionsOnNode[nodeID] = typeutil.NewUniqueSet()
+		}
+		collectionsOnNode[nodeID].Insert(collectionID)
+	}
+	for _, segment := range s.dist.SegmentDistManager.GetByFilter() {
+		record(segment.Node, segment.GetCollectionID())
+	}
+	for _, channel := range s.dist.ChannelDistManager.GetByFilter() {
+		record(channel.Node, channel.GetCollectionID())
+	}
+
+	violations := make([]*querypb.TenantViolation, 0)
+	for nodeID, collections := range collectionsOnNode {
+		collectionIDs := collections.Collect()
+		nodeTenants := lo.Uniq(lo.Map(collectionIDs, func(collectionID int64, _ int) string { return tenants[collectionID] }))
+		if len(nodeTenants) < 2 {
+			continue
+		}
+		sort.Strings(nodeTenants)
+		sort.Slice(collectionIDs, func(i, j int) bool { return collectionIDs[i] < collectionIDs[j] })
+		violations = append(violations, &querypb.TenantViolation{
+			NodeID:        nodeID,
+			Tenants:       nodeTenants,
+			CollectionIDs: collectionIDs,
+		})
+	}
+	sort.Slice(violations, func(i, j int) bool { return violations[i].GetNodeID() < violations[j].GetNodeID() })
+	if len(violations) > 0 {
+		log.Warn("tenant isolation violated", zap.Any("violations", violations))
+	}
+
+	return &querypb.GetTenantViolationsResponse{
+		Status:     merr.Success(),
+		Violations: violations,
+	}, nil
+}
+
+// CaptureBalanceLayout records the current distribution of the collection as its balance layout,
+// balance checker moves the drifted segments and channels back to the layout instead of balancing freely.
+func (s *Server) CaptureBalanceLayout(ctx context.Context, req *querypb.CaptureBalanceLayoutRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", req.GetCollectionID()))
+	log.Info("CaptureBalanceLayout request received")
+
+	errMsg := "failed to capture balance layout"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(errors.Wrap(err, errMsg)), nil
+	}
+
+	collection := s.meta.CollectionManager.GetCollection(req.GetCollectionID())
+	if collection == nil {
+		err := merr.WrapErrCollectionNotLoaded(req.GetCollectionID())
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+	if collection.GetStatus() != querypb.LoadStatus_Loaded {
+		err := merr.WrapErrCollectionNotFullyLoaded(req.GetCollectionID())
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	layout := meta.CaptureBalanceLayout(s.meta, s.dist, req.GetCollectionID())
+	if err := s.meta.BalanceLayoutManager.PutLayout(layout); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(errors.Wrap(err, errMsg)), nil
+	}
+	log.Info("balance layout captured", zap.Int("segmentNum", layout.SegmentNum()), zap.Int("channelNum", layout.ChannelNum()))
+	return merr.Success(), nil
+}
+
+// ClearBalanceLayout removes the balance layout of the collection, the collection will be balanced freely.
+func (s *Server) ClearBalanceLayout(ctx context.Context, req *querypb.ClearBalanceLayoutRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", req.GetCollectionID()))
+	log.Info("ClearBalanceLayout request received")
+
+	errMsg := "failed to clear balance layout"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(errors.Wrap(err, errMsg)), nil
+	}
+
+	if !s.meta.CollectionManager.Exist(req.GetCollectionID()) {
+		err := merr.WrapErrCollectionNotLoaded(req.GetCollectionID())
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	if err := s.meta.BalanceLayoutManager.RemoveLayout(req.GetCollectionID()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(errors.Wrap(err, errMsg)), nil
+	}
+	return merr.Success(), nil
+}
+
+func (s *Server) GetBalanceLayoutStatus(ctx context.Context, req *querypb.GetBalanceLayoutStatusRequest) (*querypb.GetBalanceLayoutStatusResponse, error) {
+	log := log.Ctx(ctx).With(zap.Int64s("collectionIDs", req.GetCollectionIDs()))
+	log.Info("GetBalanceLayoutStatus request received")
+
+	errMsg := "failed to get balance layout status"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.GetBalanceLayoutStatusResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+
+	collectionIDs := req.GetCollectionIDs()
+	if len(collectionIDs) == 0 {
+		collectionIDs = s.meta.CollectionManager.GetAll()
+		sort.Slice(collectionIDs, func(i, j int) bool { return collectionIDs[i] < collectionIDs[j] })
+	}
+
+	statuses := make([]*querypb.BalanceLayoutStatus, 0, len(collectionIDs))
+	for _, collectionID := range collectionIDs {
+		if !s.meta.CollectionManager.Exist(collectionID) {
+			err := merr.WrapErrCollectionNotLoaded(collectionID)
+			log.Warn(errMsg, zap.Error(err))
+			return &querypb.GetBalanceLayoutStatusResponse{
+				Status: merr.Status(err),
+			}, nil
+		}
+		status := &querypb.BalanceLayoutStatus{
+			CollectionID: collectionID,
+		}
+		if layout := s.meta.BalanceLayoutManager.GetLayout(collectionID); layout != nil {
+			status.Active = true
+			status.CapturedTime = layout.CapturedAt.UnixMilli()
+			status.SegmentNum = int64(layout.SegmentNum())
+			status.ChannelNum = int64(layout.ChannelNum())
+		}
+		statuses = append(statuses, status)
+	}
+
+	return &querypb.GetBalanceLayoutStatusResponse{
+		Status:   merr.Success(),
+		Statuses: statuses,
+	}, nil
+}
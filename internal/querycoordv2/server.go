@@ -50,6 +50,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
@@ -108,10 +109,14 @@ type Server struct {
 	checkerController *checkers.CheckerController
 
 	// Observers
-	collectionObserver *observers.CollectionObserver
-	targetObserver     *observers.TargetObserver
-	replicaObserver    *observers.ReplicaObserver
-	resourceObserver   *observers.ResourceObserver
+	collectionObserver   *observers.CollectionObserver
+	targetObserver       *observers.TargetObserver
+	replicaObserver      *observers.ReplicaObserver
+	resourceObserver     *observers.ResourceObserver
+	memoryObserver       *observers.MemoryPressureObserver
+	availabilityObserver *observers.AvailabilityObserver
+	nodeLoadObserver     *observers.NodeLoadObserver
+	loadStuckObserver    *observers.LoadStuckObserver
 
 	balancer    balance.Balance
 	balancerMap map[string]balance.Balance
@@ -122,6 +127,17 @@ type Server struct {
 
 	nodeUpEventChan chan int64
 	notifyNodeUp    chan struct{}
+
+	// distribution of the releasing collections when release starts
+	releaseBaselines typeutil.ConcurrentMap[int64, *releaseBaseline]
+	// distribution of the decommissioning nodes when decommission starts
+	decommissions typeutil.ConcurrentMap[int64, *nodeDecommission]
+	// errors reported by query nodes in the latest health check
+	nodeServingErrors typeutil.ConcurrentMap[int64, *nodeServingError]
+	// the readable shard leaders computed recently
+	shardLeaderCache typeutil.ConcurrentMap[shardLeaderCacheKey, *shardLeaderCacheEntry]
+	// the metric types being refreshed in background
+	refreshingMetrics typeutil.ConcurrentMap[string, struct{}]
 }
 
 func NewQueryCoord(ctx context.Context) (*Server, error) {
@@ -300,6 +316,16 @@ func (s *Server) initQueryCoord() error {
 		s.taskScheduler,
 		s.broker,
 	)
+	for name, balancer := range s.balancerMap {
+		s.checkerController.RegisterBalancer(name, balancer)
+	}
+	// keep balance suspended across failover
+	if balanceState, stateErr := s.store.GetBalanceState(); stateErr != nil {
+		log.Warn("failed to get balance state", zap.Error(stateErr))
+	} else if balanceState.GetSuspended() {
+		log.Info("balance is suspended", zap.Time("suspendedSince", time.UnixMilli(balanceState.GetSuspendedTime())))
+		s.checkerController.Deactivate(utils.BalanceChecker)
+	}
 
 	// Init observers
 	s.initObserver()
@@ -349,17 +375,31 @@ func (s *Server) initMeta() error {
 		return err
 	}
 
-	s.dist = &meta.DistributionManager{
-		SegmentDistManager: meta.NewSegmentDistManager(),
-		ChannelDistManager: meta.NewChannelDistManager(),
-		LeaderViewManager:  meta.NewLeaderViewManager(),
-	}
+	s.dist = meta.NewDistributionManager()
 	s.targetMgr = meta.NewTargetManager(s.broker, s.meta)
 	err = s.targetMgr.Recover(s.store)
 	if err != nil {
 		log.Warn("failed to recover collection targets", zap.Error(err))
 	}
 
+	// load checkpoints are only used to speed up the restart, it's fine to go without them
+	err = s.meta.LoadCheckpointManager.RecoverLoadCheckpoints()
+	if err != nil {
+		log.Warn("failed to recover load checkpoints", zap.Error(err))
+	}
+
+	err = s.meta.ShardBlockManager.RecoverShardBlocks()
+	if err != nil {
+		log.Warn("failed to recover shard blocks", zap.Error(err))
+		return err
+	}
+
+	err = s.meta.BalanceLayoutManager.RecoverBalanceLayouts()
+	if err != nil {
+		log.Warn("failed to recover balance layouts", zap.Error(err))
+		return err
+	}
+
 	log.Info("QueryCoord server initMeta done", zap.Duration("duration", record.ElapseSpan()))
 	return nil
 }
@@ -373,6 +413,14 @@ func (s *Server) initObserver() {
 		s.broker,
 		s.cluster,
 	)
+	// keep target updates paused across failover
+	state, err := s.store.GetTargetUpdateState()
+	if err != nil {
+		log.Warn("failed to get target update state", zap.Error(err))
+	} else if state.GetPaused() {
+		log.Info("target updates are paused", zap.Time("pausedSince", time.UnixMilli(state.GetPausedTime())))
+		s.targetObserver.PauseTargetUpdates(time.UnixMilli(state.GetPausedTime()))
+	}
 	s.collectionObserver = observers.NewCollectionObserver(
 		s.dist,
 		s.meta,
@@ -385,8 +433,35 @@ func (s *Server) initObserver() {
 		s.meta,
 		s.dist,
 	)
+	// the cached shard leaders of the demoted primary replica are stale
+	s.replicaObserver.SetStandbyPromotedHandler(s.invalidateShardLeaderCache)
 
 	s.resourceObserver = observers.NewResourceObserver(s.meta)
+
+	s.memoryObserver = observers.NewMemoryPressureObserver(
+		s.meta,
+		s.dist,
+		s.nodeMgr,
+		s.cluster,
+		s.balancer,
+		s.taskScheduler,
+	)
+
+	s.availabilityObserver = observers.NewAvailabilityObserver(
+		s.meta,
+		s.targetMgr,
+		s.dist,
+		s.nodeMgr,
+	)
+
+	s.nodeLoadObserver = observers.NewNodeLoadObserver(s.dist, s.nodeMgr)
+
+	s.loadStuckObserver = observers.NewLoadStuckObserver(
+		s.meta,
+		s.dist,
+		s.nodeMgr,
+		s.taskScheduler,
+	)
 }
 
 func (s *Server) afterStart() {}
@@ -413,6 +488,7 @@ func (s *Server) startQueryCoord() error {
 			Address:  node.Address,
 			Hostname: node.HostName,
 			Version:  node.Version,
+			Labels:   node.ServerLabels,
 		}))
 		s.taskScheduler.AddExecutor(node.ServerID)
 
@@ -420,6 +496,11 @@ func (s *Server) startQueryCoord() error {
 			s.nodeMgr.Stopping(node.ServerID)
 		}
 	}
+	// the nodes being decommissioned before failover go on draining
+	if err := s.recoverDecommissions(); err != nil {
+		log.Warn("failed to recover node decommissions", zap.Error(err))
+		return err
+	}
 	s.checkReplicas()
 	for _, node := range sessions {
 		s.handleNodeUp(node.ServerID)
@@ -453,6 +534,10 @@ func (s *Server) startServerLoop() {
 	s.targetObserver.Start()
 	s.replicaObserver.Start()
 	s.resourceObserver.Start()
+	s.memoryObserver.Start()
+	s.availabilityObserver.Start()
+	s.nodeLoadObserver.Start()
+	s.loadStuckObserver.Start()
 
 	log.Info("start task scheduler...")
 	s.taskScheduler.Start()
@@ -504,6 +589,18 @@ func (s *Server) Stop() error {
 	if s.resourceObserver != nil {
 		s.resourceObserver.Stop()
 	}
+	if s.memoryObserver != nil {
+		s.memoryObserver.Stop()
+	}
+	if s.availabilityObserver != nil {
+		s.availabilityObserver.Stop()
+	}
+	if s.nodeLoadObserver != nil {
+		s.nodeLoadObserver.Stop()
+	}
+	if s.loadStuckObserver != nil {
+		s.loadStuckObserver.Stop()
+	}
 
 	if s.distController != nil {
 		log.Info("stop dist controller...")
@@ -633,13 +730,16 @@ func (s *Server) watchNodes(revision int64) {
 				log.Info("add node to NodeManager",
 					zap.Int64("nodeID", nodeID),
 					zap.String("nodeAddr", addr),
+					zap.Any("labels", event.Session.ServerLabels),
 				)
 				s.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
 					NodeID:   nodeID,
 					Address:  addr,
 					Hostname: event.Session.HostName,
 					Version:  event.Session.Version,
+					Labels:   event.Session.ServerLabels,
 				}))
+				s.meta.InvalidateLoadCheckpoints()
 				s.nodeUpEventChan <- nodeID
 				select {
 				case s.notifyNodeUp <- struct{}{}:
@@ -654,14 +754,16 @@ func (s *Server) watchNodes(revision int64) {
 					zap.String("nodeAddr", addr),
 				)
 				s.nodeMgr.Stopping(nodeID)
+				s.metricsCacheManager.InvalidateMetrics()
 				s.checkerController.Check()
 
 			case sessionutil.SessionDelEvent:
 				nodeID := event.Session.ServerID
 				log.Info("a node down, remove it", zap.Int64("nodeID", nodeID))
 				s.nodeMgr.Remove(nodeID)
+				s.meta.InvalidateLoadCheckpoints()
 				s.handleNodeDown(nodeID)
-				s.metricsCacheManager.InvalidateSystemInfoMetrics()
+				s.metricsCacheManager.InvalidateMetrics()
 			}
 		}
 	}
@@ -688,7 +790,7 @@ func (s *Server) tryHandleNodeUp() {
 	log := log.Ctx(s.ctx).WithRateGroup("qcv2.Server", 1, 60)
 	ctx, cancel := context.WithTimeout(s.ctx, Params.QueryCoordCfg.CheckHealthRPCTimeout.GetAsDuration(time.Millisecond))
 	defer cancel()
-	reasons, err := s.checkNodeHealth(ctx)
+	reasons, _, err := s.checkNodeHealth(ctx)
 	if err != nil {
 		log.RatedWarn(10, "unhealthy node exist, node up will be delayed",
 			zap.Int("delayedNodeUpEvents", len(s.nodeUpEventChan)),
@@ -701,7 +803,7 @@ func (s *Server) tryHandleNodeUp() {
 		if s.nodeMgr.Get(nodeID) != nil {
 			// only if all nodes are healthy, node up event will be handled
 			s.handleNodeUp(nodeID)
-			s.metricsCacheManager.InvalidateSystemInfoMetrics()
+			s.metricsCacheManager.InvalidateMetrics()
 			s.checkerController.Check()
 		} else {
 			log.Warn("node already down",
@@ -721,6 +823,8 @@ func (s *Server) handleNodeDown(node int64) {
 	log := log.With(zap.Int64("nodeID", node))
 	s.taskScheduler.RemoveExecutor(node)
 	s.distController.Remove(node)
+	s.nodeServingErrors.Remove(node)
+	s.removeDecommission(node)
 
 	// Clear dist
 	s.dist.LeaderViewManager.Update(node)
@@ -19,11 +19,15 @@ package querycoordv2
 import (
 	"context"
 	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
-	"go.uber.org/multierr"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
@@ -31,15 +35,20 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
-	"github.com/milvus-io/milvus/internal/querycoordv2/checkers"
+	"github.com/milvus-io/milvus/internal/querycoordv2/balance"
 	"github.com/milvus-io/milvus/internal/querycoordv2/job"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/observers"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/task"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/retry"
+	"github.com/milvus-io/milvus/pkg/util/syncutil"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -54,6 +63,31 @@ var (
 // ErrLoadWithDefaultRG           = errors.New("load operation can't use default resource group and other resource group together")
 )
 
+// EstimatedLoadTimeKey is the key of the estimated load time in milliseconds,
+// set in the extra info of the LoadCollection response status.
+const EstimatedLoadTimeKey = "estimated_load_time_ms"
+
+// BalanceOperationIDKey is the key of the balance operation ID set in the extra info of the LoadBalance response status,
+// the progress of the operation could be polled by GetLoadBalanceStatus.
+const BalanceOperationIDKey = "balance_operation_id"
+
+// RequestedReplicaNumberKey and ReplicaShortfallKey are the keys of the requested replica number
+// and the number of replicas not spawned yet, set in the extra info of the GetReplicas response status
+// if the collection is loaded with fewer replicas than requested.
+const (
+	RequestedReplicaNumberKey = "requested_replica_number"
+	ReplicaShortfallKey       = "replica_shortfall"
+)
+
+// StandbyReplicaIDsKey is the key of the comma separated IDs of the standby replicas,
+// set in the extra info of the GetReplicas response status, as ReplicaInfo has no standby flag.
+const StandbyReplicaIDsKey = "standby_replica_ids"
+
+// NodeHostnamesKey is the key of the comma separated "nodeID:hostname" pairs of the replica nodes,
+// set in the extra info of the GetReplicas response status if with_shard_nodes is true, as ReplicaInfo has no hostnames.
+// The nodes gone from the cluster are omitted.
+const NodeHostnamesKey = "node_hostnames"
+
 func (s *Server) ShowCollections(ctx context.Context, req *querypb.ShowCollectionsRequest) (*querypb.ShowCollectionsResponse, error) {
 	log.Ctx(ctx).Info("show collections request received", zap.Int64s("collections", req.GetCollectionIDs()))
 
@@ -75,6 +109,25 @@ func (s *Server) ShowCollections(ctx context.Context, req *querypb.ShowCollectio
 		isGetAll = true
 	}
 	collections := collectionSet.Collect()
+	if req.GetPageSize() > 0 {
+		// page in the order of collection id, the page token is the first collection id of the next page
+		sort.Slice(collections, func(i, j int) bool {
+			return collections[i] < collections[j]
+		})
+		if req.GetPageToken() != "" {
+			start, err := strconv.ParseInt(req.GetPageToken(), 10, 64)
+			if err != nil {
+				err = merr.WrapErrParameterInvalid("collection id", req.GetPageToken(), "invalid page token")
+				log.Warn("show collection failed", zap.Error(err))
+				return &querypb.ShowCollectionsResponse{
+					Status: merr.Status(err),
+				}, nil
+			}
+			collections = lo.Filter(collections, func(collectionID int64, _ int) bool {
+				return collectionID >= start
+			})
+		}
+	}
 
 	resp := &querypb.ShowCollectionsResponse{
 		Status:                &commonpb.Status{},
@@ -83,11 +136,14 @@ func (s *Server) ShowCollections(ctx context.Context, req *querypb.ShowCollectio
 		QueryServiceAvailable: make([]bool, 0, len(collectionSet)),
 	}
 	for _, collectionID := range collections {
+		if req.GetPageSize() > 0 && int64(len(resp.CollectionIDs)) >= req.GetPageSize() {
+			resp.NextPageToken = strconv.FormatInt(collectionID, 10)
+			break
+		}
 		log := log.With(zap.Int64("collectionID", collectionID))
 
 		collection := s.meta.CollectionManager.GetCollection(collectionID)
 		percentage := s.meta.CollectionManager.CalculateLoadPercentage(collectionID)
-		refreshProgress := int64(0)
 		if percentage < 0 {
 			if isGetAll {
 				// The collection is released during this,
@@ -111,19 +167,220 @@ func (s *Server) ShowCollections(ctx context.Context, req *querypb.ShowCollectio
 			}, nil
 		}
 
-		if collection.IsRefreshed() {
-			refreshProgress = 100
-		}
-
 		resp.CollectionIDs = append(resp.CollectionIDs, collectionID)
 		resp.InMemoryPercentages = append(resp.InMemoryPercentages, int64(percentage))
 		resp.QueryServiceAvailable = append(resp.QueryServiceAvailable, s.checkAnyReplicaAvailable(collectionID))
-		resp.RefreshProgress = append(resp.RefreshProgress, refreshProgress)
+		resp.RefreshProgress = append(resp.RefreshProgress, s.getRefreshProgress(collection))
+		estimatedRemaining := int64(0)
+		if percentage < 100 {
+			estimatedRemaining = meta.GlobalLoadThroughputRecorder.Remaining(collectionID).Milliseconds()
+		}
+		resp.EstimatedRemainingMs = append(resp.EstimatedRemainingMs, estimatedRemaining)
+		if req.GetWithReplicaDetail() {
+			resp.ReplicaPercentages = append(resp.ReplicaPercentages, &querypb.ReplicaLoadPercentages{
+				Percentages: s.getReplicaLoadPercentages(collectionID, int64(percentage)),
+			})
+		}
+	}
+
+	return resp, nil
+}
+
+// GetLoadInfo returns the consolidated load state of the collection,
+// which saves the round trips of ShowCollections, GetPartitionStates and GetReplicas.
+func (s *Server) GetLoadInfo(ctx context.Context, req *querypb.GetLoadInfoRequest) (*querypb.GetLoadInfoResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionId()),
+	)
+
+	log.Info("get load info request received")
+	errMsg := "failed to get load info"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.GetLoadInfoResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+
+	collection := s.meta.CollectionManager.GetCollection(req.GetCollectionId())
+	percentage := s.meta.CollectionManager.CalculateLoadPercentage(req.GetCollectionId())
+	if collection == nil || percentage < 0 {
+		err := merr.WrapErrCollectionNotLoaded(req.GetCollectionId())
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.GetLoadInfoResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	resourceGroups := lo.Uniq(lo.Map(s.meta.ReplicaManager.GetByCollection(req.GetCollectionId()), func(replica *meta.Replica, _ int) string {
+		return replica.GetResourceGroup()
+	}))
+	sort.Strings(resourceGroups)
+	partitions := lo.Map(s.meta.GetPartitionsByCollection(req.GetCollectionId()), func(partition *meta.Partition, _ int) int64 {
+		return partition.GetPartitionID()
+	})
+	sort.Slice(partitions, func(i, j int) bool {
+		return partitions[i] < partitions[j]
+	})
+
+	return &querypb.GetLoadInfoResponse{
+		Status:          merr.Success(),
+		LoadType:        collection.GetLoadType(),
+		Partitions:      partitions,
+		LoadPercentage:  percentage,
+		ReplicaNumber:   collection.GetReplicaNumber(),
+		ResourceGroups:  resourceGroups,
+		RefreshProgress: s.getRefreshProgress(collection),
+		Readable:        s.checkAnyReplicaAvailable(req.GetCollectionId()),
+	}, nil
+}
+
+// GetLoadState returns the explicit load state of the collection,
+// a failed load is reported as Loading with the cached error, clients may retry it.
+func (s *Server) GetLoadState(ctx context.Context, req *querypb.GetLoadStateRequest) (*querypb.GetLoadStateResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+	)
+
+	log.Info("get load state request received")
+	errMsg := "failed to get load state"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.GetLoadStateResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+	defer meta.GlobalFailedLoadCache.TryExpire()
+
+	resp := &querypb.GetLoadStateResponse{
+		Status: merr.Success(),
+	}
+	loadErr := meta.GlobalFailedLoadCache.Get(req.GetCollectionID())
+	if loadErr != nil {
+		resp.LoadError = merr.Status(loadErr)
+	}
+
+	collection := s.meta.CollectionManager.GetCollection(req.GetCollectionID())
+	if collection != nil {
+		if collection.GetStatus() == querypb.LoadStatus_Loaded {
+			resp.State = commonpb.LoadState_LoadStateLoaded
+		} else {
+			resp.State = commonpb.LoadState_LoadStateLoading
+		}
+		return resp, nil
+	}
+
+	_, err := s.broker.DescribeCollection(ctx, req.GetCollectionID())
+	if errors.Is(err, merr.ErrCollectionNotFound) {
+		resp.State = commonpb.LoadState_LoadStateNotExist
+		resp.LoadError = nil
+		return resp, nil
+	} else if err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.GetLoadStateResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
 	}
 
+	if loadErr != nil {
+		// the failed load is removed from meta
+		resp.State = commonpb.LoadState_LoadStateLoading
+	} else {
+		resp.State = commonpb.LoadState_LoadStateNotLoad
+	}
 	return resp, nil
 }
 
+// WatchCollections pushes the load states of the watched collections to the stream,
+// a snapshot is sent first, then the changed states whenever the collection meta is updated
+// or the collection observer finishes a round of observation.
+// The released collections are sent with released set to true.
+func (s *Server) WatchCollections(req *querypb.WatchCollectionsRequest, stream querypb.QueryCoord_WatchCollectionsServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	log := log.Ctx(ctx).With(
+		zap.Int64s("collectionIDs", req.GetCollectionIDs()),
+	)
+
+	log.Info("watch collections request received")
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		cancel()
+		msg := "failed to watch collections"
+		log.Warn(msg, zap.Error(err))
+		return stream.Send(&querypb.WatchCollectionsResponse{
+			Status: merr.Status(errors.Wrap(err, msg)),
+		})
+	}
+
+	// listen before taking the snapshot, so no change is missed,
+	// the listeners quit once the client disconnects
+	changed := make(chan struct{}, 1)
+	wg := sync.WaitGroup{}
+	defer wg.Wait()
+	defer cancel()
+	for _, listener := range []*syncutil.VersionedListener{s.meta.CollectionManager.Listen(), s.collectionObserver.Listen()} {
+		listener := listener
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for listener.Wait(ctx) == nil {
+				select {
+				case changed <- struct{}{}:
+				default:
+				}
+			}
+		}()
+	}
+
+	sent := make(map[int64]*querypb.CollectionLoadState)
+	for snapshot := true; ; snapshot = false {
+		states := s.getCollectionLoadStates(req.GetCollectionIDs())
+		toSend := make([]*querypb.CollectionLoadState, 0)
+		for collectionID, state := range states {
+			last, ok := sent[collectionID]
+			if snapshot || !ok || last.GetLoadPercentage() != state.GetLoadPercentage() ||
+				last.GetQueryServiceAvailable() != state.GetQueryServiceAvailable() {
+				toSend = append(toSend, state)
+			}
+		}
+		for collectionID := range sent {
+			if _, ok := states[collectionID]; !ok {
+				toSend = append(toSend, &querypb.CollectionLoadState{
+					CollectionID: collectionID,
+					Released:     true,
+				})
+			}
+		}
+
+		if snapshot || len(toSend) > 0 {
+			sort.Slice(toSend, func(i, j int) bool {
+				return toSend[i].GetCollectionID() < toSend[j].GetCollectionID()
+			})
+			err := stream.Send(&querypb.WatchCollectionsResponse{
+				Status:   merr.Success(),
+				Snapshot: snapshot,
+				States:   toSend,
+			})
+			if err != nil {
+				log.Warn("failed to send collection load states", zap.Error(err))
+				return err
+			}
+		}
+		sent = states
+
+		select {
+		case <-ctx.Done():
+			log.Info("stop watching collections", zap.Error(ctx.Err()))
+			return nil
+		case <-s.ctx.Done():
+			// end the stream for the client to watch again on the new query coord
+			err := merr.WrapErrServiceUnavailable("query coord is stopping")
+			log.Info("stop watching collections", zap.Error(err))
+			return err
+		case <-changed:
+		}
+	}
+}
+
 func (s *Server) ShowPartitions(ctx context.Context, req *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
 	log := log.Ctx(ctx).With(
 		zap.Int64("collectionID", req.GetCollectionID()),
@@ -138,11 +395,21 @@ func (s *Server) ShowPartitions(ctx context.Context, req *querypb.ShowPartitions
 			Status: merr.Status(errors.Wrap(err, msg)),
 		}, nil
 	}
+	if req.GetWithPartitionStatus() && req.GetWithFailureReasons() {
+		err := merr.WrapErrParameterInvalidMsg("with_partition_status and with_failure_reasons can't be set together")
+		log.Warn("failed to show partitions", zap.Error(err))
+		return &querypb.ShowPartitionsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
 	defer meta.GlobalFailedLoadCache.TryExpire()
 
 	partitions := req.GetPartitionIDs()
 	percentages := make([]int64, 0)
-	refreshProgress := int64(0)
+	failures := make([]*querypb.PartitionLoadFailure, 0)
+	loadedPartitions := make([]int64, 0, len(partitions))
+	var statuses []*commonpb.Status
+	failedNum := 0
 
 	if len(partitions) == 0 {
 		partitions = lo.Map(s.meta.GetPartitionsByCollection(req.GetCollectionID()), func(partition *meta.Partition, _ int) int64 {
@@ -151,7 +418,35 @@ func (s *Server) ShowPartitions(ctx context.Context, req *querypb.ShowPartitions
 	}
 	for _, partitionID := range partitions {
 		percentage := s.meta.GetPartitionLoadPercentage(partitionID)
+		if req.GetWithPartitionStatus() {
+			// keep the failed partitions in place, with their status set
+			status := merr.Success()
+			if percentage < 0 {
+				err := s.getPartitionLoadFailure(req.GetCollectionID(), partitionID)
+				if err == nil {
+					err = merr.WrapErrPartitionNotLoaded(partitionID)
+				}
+				status = merr.Status(err)
+				percentage = 0
+				failedNum++
+			}
+			loadedPartitions = append(loadedPartitions, partitionID)
+			percentages = append(percentages, int64(percentage))
+			statuses = append(statuses, status)
+			continue
+		}
 		if percentage < 0 {
+			if req.GetWithFailureReasons() {
+				// the failed partitions are removed from meta, while the loading ones are not
+				if err := s.getPartitionLoadFailure(req.GetCollectionID(), partitionID); err != nil {
+					failures = append(failures, &querypb.PartitionLoadFailure{
+						PartitionID: partitionID,
+						Reason:      merr.Status(err),
+					})
+					continue
+				}
+			}
+
 			err := meta.GlobalFailedLoadCache.Get(req.GetCollectionID())
 			if err != nil {
 				status := merr.Status(err)
@@ -167,23 +462,33 @@ func (s *Server) ShowPartitions(ctx context.Context, req *querypb.ShowPartitions
 				Status: merr.Status(err),
 			}, nil
 		}
+		loadedPartitions = append(loadedPartitions, partitionID)
 		percentages = append(percentages, int64(percentage))
 	}
 
 	collection := s.meta.GetCollection(req.GetCollectionID())
-	if collection != nil && collection.IsRefreshed() {
-		refreshProgress = 100
+	refreshProgresses := make([]int64, len(loadedPartitions))
+	for i, partitionID := range loadedPartitions {
+		if statuses != nil && !merr.Ok(statuses[i]) {
+			continue
+		}
+		refreshProgresses[i] = s.getRefreshProgress(collection, partitionID)
 	}
-	refreshProgresses := make([]int64, len(partitions))
-	for i := range partitions {
-		refreshProgresses[i] = refreshProgress
+
+	status := merr.Success()
+	if failedNum > 0 && failedNum == len(partitions) {
+		// fail the request only if none of the partitions is loaded
+		status = statuses[0]
+		log.Warn("show partitions failed", zap.Error(merr.Error(status)))
 	}
 
 	return &querypb.ShowPartitionsResponse{
-		Status:              merr.Success(),
-		PartitionIDs:        partitions,
+		Status:              status,
+		PartitionIDs:        loadedPartitions,
 		InMemoryPercentages: percentages,
 		RefreshProgress:     refreshProgresses,
+		Failures:            failures,
+		PartitionStatus:     statuses,
 	}, nil
 }
 
@@ -193,37 +498,83 @@ func (s *Server) LoadCollection(ctx context.Context, req *querypb.LoadCollection
 		zap.Int32("replicaNumber", req.GetReplicaNumber()),
 		zap.Strings("resourceGroups", req.GetResourceGroups()),
 		zap.Bool("refreshMode", req.GetRefresh()),
+		zap.Bool("bestEffort", req.GetBestEffort()),
+		zap.Int32("priority", req.GetPriority()),
 	)
 
 	log.Info("load collection request received",
 		zap.Any("schema", req.Schema),
 		zap.Int64s("fieldIndexes", lo.Values(req.GetFieldIndexID())),
 	)
-	metrics.QueryCoordLoadCount.WithLabelValues(metrics.TotalLabel).Inc()
+	metrics.QueryCoordLoadCount.WithLabelValues(metrics.TotalLabel, utils.CollectionMetricsLabel(req.GetCollectionID())).Inc()
 
 	if err := merr.CheckHealthy(s.State()); err != nil {
 		msg := "failed to load collection"
 		log.Warn(msg, zap.Error(err))
-		metrics.QueryCoordLoadCount.WithLabelValues(metrics.FailLabel).Inc()
+		metrics.QueryCoordLoadCount.WithLabelValues(metrics.FailLabel, utils.CollectionMetricsLabel(req.GetCollectionID())).Inc()
 		return merr.Status(errors.Wrap(err, msg)), nil
 	}
 
 	// If refresh mode is ON.
 	if req.GetRefresh() {
-		err := s.refreshCollection(req.GetCollectionID())
+		err := s.refreshCollection(ctx, req.GetCollectionID(), req.GetWaitRefresh())
 		if err != nil {
 			log.Warn("failed to refresh collection", zap.Error(err))
 		}
 		return merr.Status(err), nil
 	}
 
+	if err := s.resolveReplicaNumber(req); err != nil {
+		msg := "failed to load collection"
+		log.Warn(msg, zap.Error(err))
+		metrics.QueryCoordLoadCount.WithLabelValues(metrics.FailLabel, utils.CollectionMetricsLabel(req.GetCollectionID())).Inc()
+		return merr.Status(errors.Wrap(err, msg)), nil
+	}
+
 	if err := s.checkResourceGroup(req.GetCollectionID(), req.GetResourceGroups()); err != nil {
 		msg := "failed to load collection"
 		log.Warn(msg, zap.Error(err))
-		metrics.QueryCoordLoadCount.WithLabelValues(metrics.FailLabel).Inc()
+		metrics.QueryCoordLoadCount.WithLabelValues(metrics.FailLabel, utils.CollectionMetricsLabel(req.GetCollectionID())).Inc()
+		return merr.Status(errors.Wrap(err, msg)), nil
+	}
+
+	if err := checkReplicaSpread(req); err != nil {
+		msg := "failed to load collection"
+		log.Warn(msg, zap.Error(err))
+		metrics.QueryCoordLoadCount.WithLabelValues(metrics.FailLabel, utils.CollectionMetricsLabel(req.GetCollectionID())).Inc()
+		return merr.Status(errors.Wrap(err, msg)), nil
+	}
+
+	if err := s.checkSegmentNodeHints(req); err != nil {
+		msg := "failed to load collection"
+		log.Warn(msg, zap.Error(err))
+		metrics.QueryCoordLoadCount.WithLabelValues(metrics.FailLabel, utils.CollectionMetricsLabel(req.GetCollectionID())).Inc()
+		return merr.Status(errors.Wrap(err, msg)), nil
+	}
+
+	if err := s.checkBalancePolicy(req.GetBalancePolicy()); err != nil {
+		msg := "failed to load collection"
+		log.Warn(msg, zap.Error(err))
+		metrics.QueryCoordLoadCount.WithLabelValues(metrics.FailLabel, utils.CollectionMetricsLabel(req.GetCollectionID())).Inc()
+		return merr.Status(errors.Wrap(err, msg)), nil
+	}
+
+	// the retried load of a loaded collection needs no job
+	unchanged, err := job.IsCollectionLoadUnchanged(ctx, s.meta, s.broker, req)
+	if err != nil {
+		msg := "failed to load collection"
+		log.Warn(msg, zap.Error(err))
+		metrics.QueryCoordLoadCount.WithLabelValues(metrics.FailLabel, utils.CollectionMetricsLabel(req.GetCollectionID())).Inc()
 		return merr.Status(errors.Wrap(err, msg)), nil
 	}
+	if unchanged {
+		log.Info("collection has been loaded with the same replica number, skip the load")
+		metrics.QueryCoordLoadCount.WithLabelValues(metrics.SuccessLabel, utils.CollectionMetricsLabel(req.GetCollectionID())).Inc()
+		return merr.Success(), nil
+	}
 
+	// the load replaces the release in progress, if any
+	s.releaseBaselines.Remove(req.GetCollectionID())
 	loadJob := job.NewLoadCollectionJob(ctx,
 		req,
 		s.dist,
@@ -235,17 +586,28 @@ func (s *Server) LoadCollection(ctx context.Context, req *querypb.LoadCollection
 		s.collectionObserver,
 		s.nodeMgr,
 	)
-	s.jobScheduler.Add(loadJob)
-	err := loadJob.Wait()
+	// the loaded collection consumes no more memory
+	if !s.meta.CollectionManager.Exist(req.GetCollectionID()) {
+		loadJob.SetAdmission(s.loadMemoryAdmission(req.GetCollectionID(), nil, req.GetReplicaNumber(), req.GetAutoRetry()))
+	}
+	s.jobScheduler.AddWithPriority(loadJob, req.GetPriority())
+	err = loadJob.Wait()
 	if err != nil {
 		msg := "failed to load collection"
 		log.Warn(msg, zap.Error(err))
-		metrics.QueryCoordLoadCount.WithLabelValues(metrics.FailLabel).Inc()
+		metrics.QueryCoordLoadCount.WithLabelValues(metrics.FailLabel, utils.CollectionMetricsLabel(req.GetCollectionID())).Inc()
 		return merr.Status(errors.Wrap(err, msg)), nil
 	}
 
-	metrics.QueryCoordLoadCount.WithLabelValues(metrics.SuccessLabel).Inc()
-	return merr.Success(), nil
+	metrics.QueryCoordLoadCount.WithLabelValues(metrics.SuccessLabel, utils.CollectionMetricsLabel(req.GetCollectionID())).Inc()
+	status := merr.Success()
+	if estimate := s.estimateLoadTime(req.GetCollectionID()); estimate > 0 {
+		log.Info("estimated load time", zap.Duration("estimate", estimate))
+		status.ExtraInfo = map[string]string{
+			EstimatedLoadTimeKey: strconv.FormatInt(estimate.Milliseconds(), 10),
+		}
+	}
+	return status, nil
 }
 
 func (s *Server) ReleaseCollection(ctx context.Context, req *querypb.ReleaseCollectionRequest) (*commonpb.Status, error) {
@@ -259,10 +621,16 @@ func (s *Server) ReleaseCollection(ctx context.Context, req *querypb.ReleaseColl
 	if err := merr.CheckHealthy(s.State()); err != nil {
 		msg := "failed to release collection"
 		log.Warn(msg, zap.Error(err))
-		metrics.QueryCoordReleaseCount.WithLabelValues(metrics.FailLabel).Inc()
+		metrics.QueryCoordReleaseCount.WithLabelValues(metrics.FailLabel, utils.CollectionMetricsLabel(req.GetCollectionID())).Inc()
 		return merr.Status(errors.Wrap(err, msg)), nil
 	}
 
+	// the baseline is kept until the release job finishes,
+	// as the job waits for the segments and channels released from query nodes
+	if s.meta.CollectionManager.Exist(req.GetCollectionID()) {
+		s.recordReleaseBaseline(req.GetCollectionID())
+	}
+
 	releaseJob := job.NewReleaseCollectionJob(ctx,
 		req,
 		s.dist,
@@ -275,56 +643,172 @@ func (s *Server) ReleaseCollection(ctx context.Context, req *querypb.ReleaseColl
 	)
 	s.jobScheduler.Add(releaseJob)
 	err := releaseJob.Wait()
+	s.releaseBaselines.Remove(req.GetCollectionID())
 	if err != nil {
 		msg := "failed to release collection"
 		log.Warn(msg, zap.Error(err))
-		metrics.QueryCoordReleaseCount.WithLabelValues(metrics.FailLabel).Inc()
+		metrics.QueryCoordReleaseCount.WithLabelValues(metrics.FailLabel, utils.CollectionMetricsLabel(req.GetCollectionID())).Inc()
 		return merr.Status(errors.Wrap(err, msg)), nil
 	}
 
 	log.Info("collection released")
 	metrics.QueryCoordReleaseLatency.WithLabelValues().Observe(float64(tr.ElapseSpan().Milliseconds()))
 	meta.GlobalFailedLoadCache.Remove(req.GetCollectionID())
+	s.invalidateShardLeaderCache(req.GetCollectionID())
+
+	return merr.Success(), nil
+}
+
+// CancelLoad aborts the in-flight load of the collection, the partially loaded segments are released,
+// the cancellation is recorded so that ShowCollections reports the collection load canceled.
+// It's a no-op if the collection is not loading.
+func (s *Server) CancelLoad(ctx context.Context, req *querypb.CancelLoadRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+	)
+
+	log.Info("cancel load request received")
+	errMsg := "failed to cancel load"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(errors.Wrap(err, errMsg)), nil
+	}
+
+	cancelErr := merr.WrapErrCollectionLoadCanceled(req.GetCollectionID(), "canceled by request")
+	canceled := s.jobScheduler.CancelLoad(req.GetCollectionID(), cancelErr)
+	collection := s.meta.CollectionManager.GetCollection(req.GetCollectionID())
+	loading := collection != nil && collection.GetStatus() == querypb.LoadStatus_Loading
+	if canceled == 0 && !loading {
+		log.Info("no load in progress, skip canceling")
+		return merr.Success(), nil
+	}
+
+	// the release job runs after the canceled load jobs, as jobs of the same collection run sequentially
+	releaseJob := job.NewReleaseCollectionJob(ctx,
+		&querypb.ReleaseCollectionRequest{
+			Base:         req.GetBase(),
+			CollectionID: req.GetCollectionID(),
+		},
+		s.dist,
+		s.meta,
+		s.broker,
+		s.cluster,
+		s.targetMgr,
+		s.targetObserver,
+		s.checkerController,
+	)
+	s.jobScheduler.Add(releaseJob)
+	if err := releaseJob.Wait(); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(errors.Wrap(err, errMsg)), nil
+	}
 
+	meta.GlobalFailedLoadCache.Put(req.GetCollectionID(), cancelErr)
+	s.invalidateShardLeaderCache(req.GetCollectionID())
+	log.Info("load canceled", zap.Int("canceledJobNum", canceled), zap.Bool("loading", loading))
 	return merr.Success(), nil
 }
 
+// GetReleaseProgress returns how many segments and channels of the collection remain on query nodes,
+// compared with the distribution when the release starts.
+func (s *Server) GetReleaseProgress(ctx context.Context, req *querypb.GetReleaseProgressRequest) (*querypb.GetReleaseProgressResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+	)
+
+	log.Info("get release progress request received")
+	errMsg := "failed to get release progress"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.GetReleaseProgressResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+
+	segmentNum, channelNum := s.getCollectionDistNum(req.GetCollectionID())
+	baseline, ok := s.releaseBaselines.Get(req.GetCollectionID())
+	if !ok {
+		if s.meta.CollectionManager.Exist(req.GetCollectionID()) {
+			err := merr.WrapErrParameterInvalidMsg("collection %d is loaded and not being released", req.GetCollectionID())
+			log.Warn(errMsg, zap.Error(err))
+			return &querypb.GetReleaseProgressResponse{
+				Status: merr.Status(err),
+			}, nil
+		}
+		// the release start is unknown, e.g. query coord restarted during release
+		baseline = &releaseBaseline{
+			segmentNum: segmentNum,
+			channelNum: channelNum,
+		}
+	}
+
+	total := baseline.segmentNum + baseline.channelNum
+	remaining := segmentNum + channelNum
+	progress := int64(100)
+	if remaining > 0 {
+		progress = 0
+		if total > remaining {
+			progress = int64((total - remaining) * 100 / total)
+		}
+	}
+
+	return &querypb.GetReleaseProgressResponse{
+		Status:            merr.Success(),
+		TotalSegments:     int64(baseline.segmentNum),
+		RemainingSegments: int64(segmentNum),
+		TotalChannels:     int64(baseline.channelNum),
+		RemainingChannels: int64(channelNum),
+		Progress:          progress,
+		Complete:          remaining == 0,
+	}, nil
+}
+
 func (s *Server) LoadPartitions(ctx context.Context, req *querypb.LoadPartitionsRequest) (*commonpb.Status, error) {
 	log := log.Ctx(ctx).With(
 		zap.Int64("collectionID", req.GetCollectionID()),
 		zap.Int32("replicaNumber", req.GetReplicaNumber()),
 		zap.Strings("resourceGroups", req.GetResourceGroups()),
 		zap.Bool("refreshMode", req.GetRefresh()),
+		zap.Int32("priority", req.GetPriority()),
 	)
 
 	log.Info("received load partitions request",
 		zap.Any("schema", req.Schema),
 		zap.Int64s("partitions", req.GetPartitionIDs()))
-	metrics.QueryCoordLoadCount.WithLabelValues(metrics.TotalLabel).Inc()
+	metrics.QueryCoordLoadCount.WithLabelValues(metrics.TotalLabel, utils.CollectionMetricsLabel(req.GetCollectionID())).Inc()
 
 	if err := merr.CheckHealthy(s.State()); err != nil {
 		msg := "failed to load partitions"
 		log.Warn(msg, zap.Error(err))
-		metrics.QueryCoordLoadCount.WithLabelValues(metrics.FailLabel).Inc()
+		metrics.QueryCoordLoadCount.WithLabelValues(metrics.FailLabel, utils.CollectionMetricsLabel(req.GetCollectionID())).Inc()
 		return merr.Status(errors.Wrap(err, msg)), nil
 	}
 
 	// If refresh mode is ON.
 	if req.GetRefresh() {
-		err := s.refreshCollection(req.GetCollectionID())
+		err := s.refreshCollection(ctx, req.GetCollectionID(), req.GetWaitRefresh())
 		if err != nil {
 			log.Warn("failed to refresh partitions", zap.Error(err))
 		}
 		return merr.Status(err), nil
 	}
 
+	if err := s.resolvePartitionReplicaNumber(req); err != nil {
+		msg := "failed to load partitions"
+		log.Warn(msg, zap.Error(err))
+		metrics.QueryCoordLoadCount.WithLabelValues(metrics.FailLabel, utils.CollectionMetricsLabel(req.GetCollectionID())).Inc()
+		return merr.Status(errors.Wrap(err, msg)), nil
+	}
+
 	if err := s.checkResourceGroup(req.GetCollectionID(), req.GetResourceGroups()); err != nil {
 		msg := "failed to load partitions"
 		log.Warn(msg, zap.Error(err))
-		metrics.QueryCoordLoadCount.WithLabelValues(metrics.FailLabel).Inc()
+		metrics.QueryCoordLoadCount.WithLabelValues(metrics.FailLabel, utils.CollectionMetricsLabel(req.GetCollectionID())).Inc()
 		return merr.Status(errors.Wrap(err, msg)), nil
 	}
 
+	// the load replaces the release in progress, if any
+	s.releaseBaselines.Remove(req.GetCollectionID())
 	loadJob := job.NewLoadPartitionJob(ctx,
 		req,
 		s.dist,
@@ -336,16 +820,28 @@ func (s *Server) LoadPartitions(ctx context.Context, req *querypb.LoadPartitions
 		s.collectionObserver,
 		s.nodeMgr,
 	)
-	s.jobScheduler.Add(loadJob)
+	// the loaded partitions consume no more memory
+	toLoad := lo.Filter(req.GetPartitionIDs(), func(partitionID int64, _ int) bool {
+		return s.meta.CollectionManager.GetPartition(partitionID) == nil
+	})
+	if len(toLoad) > 0 {
+		loadJob.SetAdmission(s.loadMemoryAdmission(req.GetCollectionID(), toLoad, req.GetReplicaNumber(), req.GetAutoRetry()))
+	}
+	priority := req.GetPriority()
+	if collection := s.meta.CollectionManager.GetCollection(req.GetCollectionID()); collection != nil && priority == job.DefaultJobPriority {
+		// the partitions are loaded with the priority of the loaded collection
+		priority = collection.GetPriority()
+	}
+	s.jobScheduler.AddWithPriority(loadJob, priority)
 	err := loadJob.Wait()
 	if err != nil {
 		msg := "failed to load partitions"
 		log.Warn(msg, zap.Error(err))
-		metrics.QueryCoordLoadCount.WithLabelValues(metrics.FailLabel).Inc()
+		metrics.QueryCoordLoadCount.WithLabelValues(metrics.FailLabel, utils.CollectionMetricsLabel(req.GetCollectionID())).Inc()
 		return merr.Status(errors.Wrap(err, msg)), nil
 	}
 
-	metrics.QueryCoordLoadCount.WithLabelValues(metrics.SuccessLabel).Inc()
+	metrics.QueryCoordLoadCount.WithLabelValues(metrics.SuccessLabel, utils.CollectionMetricsLabel(req.GetCollectionID())).Inc()
 	return merr.Success(), nil
 }
 
@@ -366,25 +862,86 @@ func (s *Server) checkResourceGroup(collectionID int64, resourceGroups []string)
 	return nil
 }
 
+// checkReplicaSpread checks whether the replicas are spread evenly across the given resource groups,
+// if the request requires so.
+func checkReplicaSpread(req *querypb.LoadCollectionRequest) error {
+	if !req.GetSpreadReplicasEvenly() {
+		return nil
+	}
+	replicaNumInRG, err := utils.ParseReplicaNumInRG(req.GetResourceGroups(), req.GetReplicaNumber())
+	if err != nil {
+		return errors.Mark(merr.WrapErrParameterInvalidMsg("replicas can't be spread evenly, %s", err.Error()), err)
+	}
+	expected := int(req.GetReplicaNumber()) / len(replicaNumInRG)
+	for rgName, num := range replicaNumInRG {
+		if num != expected {
+			return merr.WrapErrParameterInvalid(fmt.Sprintf("%d replicas in each resource group", expected),
+				fmt.Sprintf("%d replicas in resource group %s", num, rgName), "replicas can't be spread evenly")
+		}
+	}
+	return nil
+}
+
+// checkSegmentNodeHints checks whether the hinted nodes belong to the resource groups the collection is loaded in.
+func (s *Server) checkSegmentNodeHints(req *querypb.LoadCollectionRequest) error {
+	if len(req.GetSegmentNodeHints()) == 0 {
+		return nil
+	}
+	// the loaded collection keeps its replicas in place, otherwise the replicas will be spawned in the requested resource groups
+	resourceGroups := lo.Uniq(lo.Map(s.meta.ReplicaManager.GetByCollection(req.GetCollectionID()), func(replica *meta.Replica, _ int) string {
+		return replica.GetResourceGroup()
+	}))
+	if len(resourceGroups) == 0 {
+		resourceGroups = req.GetResourceGroups()
+	}
+	if len(resourceGroups) == 0 {
+		resourceGroups = []string{meta.DefaultResourceGroupName}
+	}
+	for segmentID, node := range req.GetSegmentNodeHints() {
+		inRG := lo.ContainsBy(resourceGroups, func(rgName string) bool {
+			return s.meta.ResourceManager.ContainsNode(rgName, node)
+		})
+		if !inRG {
+			return merr.WrapErrParameterInvalidMsg("invalid node hint of segment %d, node %d is not in resource groups %v",
+				segmentID, node, resourceGroups)
+		}
+	}
+	return nil
+}
+
+// checkBalancePolicy checks whether the balance policy names a registered balancer,
+// the empty policy stands for the global balancer.
+func (s *Server) checkBalancePolicy(policy string) error {
+	if policy == "" {
+		return nil
+	}
+	if _, ok := s.balancerMap[policy]; !ok {
+		names := lo.Keys(s.balancerMap)
+		sort.Strings(names)
+		return merr.WrapErrParameterInvalid(fmt.Sprintf("one of %v", names), policy, "unknown balance policy")
+	}
+	return nil
+}
+
 func (s *Server) ReleasePartitions(ctx context.Context, req *querypb.ReleasePartitionsRequest) (*commonpb.Status, error) {
 	log := log.Ctx(ctx).With(
 		zap.Int64("collectionID", req.GetCollectionID()),
 	)
 
 	log.Info("release partitions", zap.Int64s("partitions", req.GetPartitionIDs()))
-	metrics.QueryCoordReleaseCount.WithLabelValues(metrics.TotalLabel).Inc()
+	metrics.QueryCoordReleaseCount.WithLabelValues(metrics.TotalLabel, utils.CollectionMetricsLabel(req.GetCollectionID())).Inc()
 
 	if err := merr.CheckHealthy(s.State()); err != nil {
 		msg := "failed to release partitions"
 		log.Warn(msg, zap.Error(err))
-		metrics.QueryCoordReleaseCount.WithLabelValues(metrics.FailLabel).Inc()
+		metrics.QueryCoordReleaseCount.WithLabelValues(metrics.FailLabel, utils.CollectionMetricsLabel(req.GetCollectionID())).Inc()
 		return merr.Status(errors.Wrap(err, msg)), nil
 	}
 
 	if len(req.GetPartitionIDs()) == 0 {
 		err := merr.WrapErrParameterInvalid("any partition", "empty partition list")
 		log.Warn("no partition to release", zap.Error(err))
-		metrics.QueryCoordReleaseCount.WithLabelValues(metrics.FailLabel).Inc()
+		metrics.QueryCoordReleaseCount.WithLabelValues(metrics.FailLabel, utils.CollectionMetricsLabel(req.GetCollectionID())).Inc()
 		return merr.Status(err), nil
 	}
 
@@ -404,17 +961,64 @@ func (s *Server) ReleasePartitions(ctx context.Context, req *querypb.ReleasePart
 	if err != nil {
 		msg := "failed to release partitions"
 		log.Warn(msg, zap.Error(err))
-		metrics.QueryCoordReleaseCount.WithLabelValues(metrics.FailLabel).Inc()
+		metrics.QueryCoordReleaseCount.WithLabelValues(metrics.FailLabel, utils.CollectionMetricsLabel(req.GetCollectionID())).Inc()
 		return merr.Status(errors.Wrap(err, msg)), nil
 	}
 
-	metrics.QueryCoordReleaseCount.WithLabelValues(metrics.SuccessLabel).Inc()
+	metrics.QueryCoordReleaseCount.WithLabelValues(metrics.SuccessLabel, utils.CollectionMetricsLabel(req.GetCollectionID())).Inc()
 	metrics.QueryCoordReleaseLatency.WithLabelValues().Observe(float64(tr.ElapseSpan().Milliseconds()))
 
 	meta.GlobalFailedLoadCache.Remove(req.GetCollectionID())
 	return merr.Success(), nil
 }
 
+// ReleaseSegments evicts the given sealed segments from memory without releasing the partitions,
+// the segments are removed from targets to avoid being reloaded, until the next target is pulled.
+func (s *Server) ReleaseSegments(ctx context.Context, req *querypb.ReleaseSegmentsRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64s("segmentIDs", req.GetSegmentIDs()),
+	)
+
+	log.Info("release segments request received")
+	errMsg := "failed to release segments"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(errors.Wrap(err, errMsg)), nil
+	}
+
+	if !s.meta.CollectionManager.Exist(req.GetCollectionID()) {
+		err := merr.WrapErrCollectionNotLoaded(req.GetCollectionID())
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+	if len(req.GetSegmentIDs()) == 0 {
+		err := merr.WrapErrParameterInvalid("any segment", "empty segment list")
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+	for _, segmentID := range req.GetSegmentIDs() {
+		if s.targetMgr.GetSealedSegment(req.GetCollectionID(), segmentID, meta.CurrentTarget) == nil {
+			err := merr.WrapErrSegmentNotFound(segmentID, "segment not in current target")
+			log.Warn(errMsg, zap.Error(err))
+			return merr.Status(err), nil
+		}
+	}
+
+	// remove the segments from targets first, otherwise the segment checker loads them again at once
+	s.targetMgr.RemoveSegments(req.GetCollectionID(), req.GetSegmentIDs()...)
+	segmentSet := typeutil.NewUniqueSet(req.GetSegmentIDs()...)
+	segments := s.dist.SegmentDistManager.GetByFilter(meta.WithCollectionID(req.GetCollectionID()),
+		meta.SegmentDistFilterFunc(func(segment *meta.Segment) bool {
+			return segmentSet.Contain(segment.GetID())
+		}))
+	if err := s.releaseSegments(ctx, req.GetCollectionID(), segments); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(errors.Wrap(err, errMsg)), nil
+	}
+	return merr.Success(), nil
+}
+
 func (s *Server) GetPartitionStates(ctx context.Context, req *querypb.GetPartitionStatesRequest) (*querypb.GetPartitionStatesResponse, error) {
 	log := log.Ctx(ctx).With(
 		zap.Int64("collectionID", req.GetCollectionID()),
@@ -439,20 +1043,19 @@ func (s *Server) GetPartitionStates(ctx context.Context, req *querypb.GetPartiti
 	switch s.meta.GetLoadType(req.GetCollectionID()) {
 	case querypb.LoadType_LoadCollection:
 		collection := s.meta.GetCollection(req.GetCollectionID())
-		state := querypb.PartitionState_PartialInMemory
-		if collection.LoadPercentage >= 100 {
-			state = querypb.PartitionState_InMemory
-		}
 		releasedPartitions := typeutil.NewUniqueSet(collection.GetReleasedPartitions()...)
-		for _, partition := range req.GetPartitionIDs() {
-			if releasedPartitions.Contain(partition) {
+		for _, partitionID := range req.GetPartitionIDs() {
+			if releasedPartitions.Contain(partitionID) {
 				log.Warn(msg)
 				return notLoadResp, nil
 			}
-			states = append(states, &querypb.PartitionStates{
-				PartitionID: partition,
-				State:       state,
-			})
+			// report the progress of each partition rather than the collection-wide one,
+			// the partitions loaded before the collection load keep their own progress as well
+			loadPercentage := collection.LoadPercentage
+			if partition := s.meta.GetPartition(partitionID); partition != nil {
+				loadPercentage = partition.LoadPercentage
+			}
+			states = append(states, newPartitionStates(partitionID, loadPercentage))
 		}
 
 	case querypb.LoadType_LoadPartition:
@@ -462,14 +1065,7 @@ func (s *Server) GetPartitionStates(ctx context.Context, req *querypb.GetPartiti
 				log.Warn(msg, zap.Int64("partition", partitionID))
 				return notLoadResp, nil
 			}
-			state := querypb.PartitionState_PartialInMemory
-			if partition.LoadPercentage >= 100 {
-				state = querypb.PartitionState_InMemory
-			}
-			states = append(states, &querypb.PartitionStates{
-				PartitionID: partitionID,
-				State:       state,
-			})
+			states = append(states, newPartitionStates(partitionID, partition.LoadPercentage))
 		}
 
 	default:
@@ -499,12 +1095,18 @@ func (s *Server) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfo
 	}
 
 	infos := make([]*querypb.SegmentInfo, 0, len(req.GetSegmentIDs()))
-	if len(req.GetSegmentIDs()) == 0 {
+	if len(req.GetSegmentIDs()) == 0 && req.GetChannel() != "" {
+		infos = s.getChannelSegmentInfo(req.GetCollectionID(), req.GetChannel())
+	} else if len(req.GetSegmentIDs()) == 0 {
 		infos = s.getCollectionSegmentInfo(req.GetCollectionID())
 	} else {
 		for _, segmentID := range req.GetSegmentIDs() {
 			segments := s.dist.SegmentDistManager.GetByFilter(meta.WithSegmentID(segmentID))
 			if len(segments) == 0 {
+				if info := s.getLoadingSegmentInfo(req.GetCollectionID(), segmentID); info != nil {
+					infos = append(infos, info)
+					continue
+				}
 				err := merr.WrapErrSegmentNotLoaded(segmentID)
 				msg := fmt.Sprintf("segment %v not found in any node", segmentID)
 				log.Warn(msg, zap.Int64("segment", segmentID))
@@ -549,85 +1151,93 @@ func (s *Server) SyncNewCreatedPartition(ctx context.Context, req *querypb.SyncN
 	return merr.Success(), nil
 }
 
-// refreshCollection must be called after loading a collection. It looks for new segments that are not loaded yet and
-// tries to load them up. It returns when all segments of the given collection are loaded, or when error happens.
-// Note that a collection's loading progress always stays at 100% after a successful load and will not get updated
-// during refreshCollection.
-func (s *Server) refreshCollection(collectionID int64) error {
-	collection := s.meta.CollectionManager.GetCollection(collectionID)
-	if collection == nil {
-		return merr.WrapErrCollectionNotLoaded(collectionID)
-	}
-
-	// Check that collection is fully loaded.
-	if collection.GetStatus() != querypb.LoadStatus_Loaded {
-		return merr.WrapErrCollectionNotLoaded(collectionID, "collection not fully loaded")
-	}
+// SyncNewCreatedPartitions syncs a batch of new created partitions of the collection in a single job,
+// the partitions failed to sync are reported with the reasons instead of failing the whole batch.
+func (s *Server) SyncNewCreatedPartitions(ctx context.Context, req *querypb.SyncNewCreatedPartitionsRequest) (*querypb.SyncNewCreatedPartitionsResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64s("partitionIDs", req.GetPartitionIDs()),
+	)
 
-	// Pull the latest target.
-	readyCh, err := s.targetObserver.UpdateNextTarget(collectionID)
-	if err != nil {
-		return err
-	}
+	log.Info("received sync new created partitions request")
 
-	collection.SetRefreshNotifier(readyCh)
-	return nil
+	failedMsg := "failed to sync new created partitions"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(failedMsg, zap.Error(err))
+		return &querypb.SyncNewCreatedPartitionsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	if len(req.GetPartitionIDs()) == 0 {
+		err := merr.WrapErrParameterInvalidMsg("no partition to sync")
+		log.Warn(failedMsg, zap.Error(err))
+		return &querypb.SyncNewCreatedPartitionsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	syncJob := job.NewSyncNewCreatedPartitionsJob(ctx, req, s.meta, s.cluster, s.broker)
+	s.jobScheduler.Add(syncJob)
+	err := syncJob.Wait()
+	if err != nil {
+		log.Warn(failedMsg, zap.Error(err))
+	}
+
+	synced := syncJob.SyncedPartitions()
+	sort.Slice(synced, func(i, j int) bool {
+		return synced[i] < synced[j]
+	})
+	failures := make([]*querypb.PartitionLoadFailure, 0, len(syncJob.FailedPartitions()))
+	for partitionID, err := range syncJob.FailedPartitions() {
+		failures = append(failures, &querypb.PartitionLoadFailure{
+			PartitionID: partitionID,
+			Reason:      merr.Status(err),
+		})
+	}
+	sort.Slice(failures, func(i, j int) bool {
+		return failures[i].GetPartitionID() < failures[j].GetPartitionID()
+	})
+	return &querypb.SyncNewCreatedPartitionsResponse{
+		Status:             merr.Status(err),
+		SyncedPartitionIDs: synced,
+		Failures:           failures,
+	}, nil
 }
 
-// This is totally same to refreshCollection, remove it for now
-// refreshPartitions must be called after loading a collection. It looks for new segments that are not loaded yet and
-// tries to load them up. It returns when all segments of the given collection are loaded, or when error happens.
-// Note that a collection's loading progress always stays at 100% after a successful load and will not get updated
-// during refreshPartitions.
-// func (s *Server) refreshPartitions(ctx context.Context, collID int64, partIDs []int64) (*commonpb.Status, error) {
-// 	ctx, cancel := context.WithTimeout(ctx, Params.QueryCoordCfg.LoadTimeoutSeconds.GetAsDuration(time.Second))
-// 	defer cancel()
-
-// 	log := log.Ctx(ctx).With(
-// 		zap.Int64("collectionID", collID),
-// 		zap.Int64s("partitionIDs", partIDs),
-// 	)
-// 	if s.status.Load() != commonpb.StateCode_Healthy {
-// 		msg := "failed to refresh partitions"
-// 		log.Warn(msg, zap.Error(ErrNotHealthy))
-// 		metrics.QueryCoordReleaseCount.WithLabelValues(metrics.FailLabel).Inc()
-// 		return utils.WrapStatus(commonpb.ErrorCode_UnexpectedError, msg, ErrNotHealthy), nil
-// 	}
-
-// 	// Check that all partitions are fully loaded.
-// 	if s.meta.CollectionManager.GetCurrentLoadPercentage(collID) != 100 {
-// 		errMsg := "partitions must be fully loaded before refreshing"
-// 		log.Warn(errMsg)
-// 		return &commonpb.Status{
-// 			ErrorCode: commonpb.ErrorCode_UnexpectedError,
-// 			Reason:    errMsg,
-// 		}, nil
-// 	}
-
-// 	// Pull the latest target.
-// 	readyCh, err := s.targetObserver.UpdateNextTarget(collID)
-// 	if err != nil {
-// 		log.Warn("failed to update next target", zap.Error(err))
-// 		return &commonpb.Status{
-// 			ErrorCode: commonpb.ErrorCode_UnexpectedError,
-// 			Reason:    err.Error(),
-// 		}, nil
-// 	}
-
-// 	select {
-// 	case <-ctx.Done():
-// 		log.Warn("refresh partitions failed as context canceled")
-// 		return &commonpb.Status{
-// 			ErrorCode: commonpb.ErrorCode_UnexpectedError,
-// 			Reason:    "context canceled",
-// 		}, nil
-// 	case <-readyCh:
-// 		log.Info("refresh partitions succeeded")
-// 		return &commonpb.Status{
-// 			ErrorCode: commonpb.ErrorCode_Success,
-// 		}, nil
-// 	}
-// }
+// refreshCollection pulls the latest target of a fully loaded collection and loads the new segments.
+// If wait is true, it returns when the new target is fully loaded, or fails after the load timeout.
+// Otherwise it returns immediately, the refresh progress could be polled by ShowCollections/ShowPartitions.
+func (s *Server) refreshCollection(ctx context.Context, collectionID int64, wait bool) error {
+	collection := s.meta.CollectionManager.GetCollection(collectionID)
+	if collection == nil {
+		return merr.WrapErrCollectionNotLoaded(collectionID)
+	}
+
+	// Check that collection is fully loaded.
+	if collection.GetStatus() != querypb.LoadStatus_Loaded {
+		return merr.WrapErrCollectionNotLoaded(collectionID, "collection not fully loaded")
+	}
+
+	// Pull the latest target.
+	readyCh, err := s.targetObserver.UpdateNextTarget(collectionID)
+	if err != nil {
+		return err
+	}
+
+	collection.SetRefreshNotifier(readyCh)
+	if !wait {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, Params.QueryCoordCfg.LoadTimeoutSeconds.GetAsDuration(time.Second))
+	defer cancel()
+	select {
+	case <-ctx.Done():
+		return errors.Wrapf(ctx.Err(), "timeout waiting for refresh of collection %d", collectionID)
+	case <-readyCh:
+		return nil
+	}
+}
 
 func (s *Server) isStoppingNode(nodeID int64) error {
 	isStopping, err := s.nodeMgr.IsStoppingNode(nodeID)
@@ -651,7 +1261,10 @@ func (s *Server) LoadBalance(ctx context.Context, req *querypb.LoadBalanceReques
 	log.Info("load balance request received",
 		zap.Int64s("source", req.GetSourceNodeIDs()),
 		zap.Int64s("dest", req.GetDstNodeIDs()),
-		zap.Int64s("segments", req.GetSealedSegmentIDs()))
+		zap.Int64s("segments", req.GetSealedSegmentIDs()),
+		zap.Bool("balanceChannels", req.GetBalanceChannels()),
+		zap.Int64("partitionID", req.GetPartitionID()),
+		zap.Bool("async", req.GetAsync()))
 
 	if err := merr.CheckHealthy(s.State()); err != nil {
 		msg := "failed to load balance"
@@ -659,90 +1272,302 @@ func (s *Server) LoadBalance(ctx context.Context, req *querypb.LoadBalanceReques
 		return merr.Status(errors.Wrap(err, msg)), nil
 	}
 
-	// Verify request
-	if len(req.GetSourceNodeIDs()) != 1 {
-		err := merr.WrapErrParameterInvalid("only 1 source node", fmt.Sprintf("%d source nodes", len(req.GetSourceNodeIDs())))
-		msg := "source nodes can only contain 1 node"
-		log.Warn(msg, zap.Int("source-nodes-num", len(req.GetSourceNodeIDs())))
+	// channels are moved after all segments moved, which needs waiting for the segment moves
+	if req.GetAsync() && req.GetBalanceChannels() {
+		err := merr.WrapErrParameterInvalidMsg("async load balance can't balance channels")
+		log.Warn("failed to load balance", zap.Error(err))
 		return merr.Status(err), nil
 	}
-	if s.meta.CollectionManager.CalculateLoadPercentage(req.GetCollectionID()) < 100 {
-		err := merr.WrapErrCollectionNotFullyLoaded(req.GetCollectionID())
-		msg := "can't balance segments of not fully loaded collection"
-		log.Warn(msg)
+
+	replica, srcNodes, dstNodes, toBalance, err := s.prepareLoadBalance(ctx, req)
+	if err != nil {
 		return merr.Status(err), nil
 	}
-	srcNode := req.GetSourceNodeIDs()[0]
-	replica := s.meta.ReplicaManager.GetByCollectionAndNode(req.GetCollectionID(), srcNode)
-	if replica == nil {
-		err := merr.WrapErrNodeNotFound(srcNode, fmt.Sprintf("source node not found in any replica of collection %d", req.GetCollectionID()))
-		msg := "source node not found in any replica"
-		log.Warn(msg)
-		return merr.Status(err), nil
+	if collection := s.meta.GetCollection(replica.GetCollectionID()); collection != nil && collection.GetBalanceExcluded() {
+		log.Warn("collection is excluded from auto balance, balance it manually as requested")
 	}
-	if err := s.isStoppingNode(srcNode); err != nil {
-		return merr.Status(errors.Wrap(err,
-			fmt.Sprintf("can't balance, because the source node[%d] is invalid", srcNode))), nil
+
+	var (
+		channels        []*meta.DmChannel
+		channelDstNodes []int64
+	)
+	if req.GetBalanceChannels() {
+		channels = s.getChannelsToBalance(ctx, replica, srcNodes)
+		// channels must leave the source nodes, otherwise the shard leader stays where it is
+		channelDstNodes = lo.Without(dstNodes, req.GetSourceNodeIDs()...)
+		if len(channels) > 0 && len(channelDstNodes) == 0 {
+			err := merr.WrapErrParameterInvalid("destination nodes other than the source nodes", "no destination node for channels")
+			log.Warn("failed to balance channels", zap.Error(err))
+			return merr.Status(err), nil
+		}
 	}
 
-	// when no dst node specified, default to use all other nodes in same
-	dstNodeSet := typeutil.NewUniqueSet()
-	if len(req.GetDstNodeIDs()) == 0 {
-		dstNodeSet.Insert(replica.GetNodes()...)
-	} else {
-		for _, dstNode := range req.GetDstNodeIDs() {
-			if !replica.Contains(dstNode) {
-				err := merr.WrapErrNodeNotFound(dstNode, "destination node not found in the same replica")
-				log.Warn("failed to balance to the destination node", zap.Error(err))
-				return merr.Status(err), nil
-			}
-			dstNodeSet.Insert(dstNode)
+	operationID, err := s.balanceSegments(ctx, replica.GetCollectionID(), replica, dstNodes, toBalance, !req.GetAsync(), false)
+	if err != nil {
+		msg := "failed to balance segments"
+		log.Warn(msg, zap.Int64("operationID", operationID), zap.Error(err))
+		status := merr.Status(errors.Wrap(err, msg))
+		if operationID != 0 {
+			// the segment moves may be still in flight
+			status.ExtraInfo = map[string]string{BalanceOperationIDKey: strconv.FormatInt(operationID, 10)}
 		}
+		return status, nil
 	}
 
-	// check whether dstNode is healthy
-	for dstNode := range dstNodeSet {
-		if err := s.isStoppingNode(dstNode); err != nil {
-			return merr.Status(errors.Wrap(err,
-				fmt.Sprintf("can't balance, because the destination node[%d] is invalid", dstNode))), nil
+	// move channels after all segments moved, so the shard leader won't flap during the balance
+	if len(channels) > 0 {
+		err = s.balanceChannels(ctx, replica.GetCollectionID(), replica, channelDstNodes, channels, true, false)
+		if err != nil {
+			msg := "failed to balance channels"
+			log.Warn(msg, zap.Error(err))
+			return merr.Status(errors.Wrap(err, msg)), nil
 		}
 	}
 
-	// check sealed segment list
-	segments := s.dist.SegmentDistManager.GetByFilter(meta.WithCollectionID(req.GetCollectionID()), meta.WithNodeID(srcNode))
-	segmentsMap := lo.SliceToMap(segments, func(s *meta.Segment) (int64, *meta.Segment) {
-		return s.GetID(), s
+	status := merr.Success()
+	if operationID != 0 {
+		status.ExtraInfo = map[string]string{BalanceOperationIDKey: strconv.FormatInt(operationID, 10)}
+	}
+	return status, nil
+}
+
+// GetLoadBalanceStatus returns the progress of the segment moves of a LoadBalance operation.
+func (s *Server) GetLoadBalanceStatus(ctx context.Context, req *querypb.GetLoadBalanceStatusRequest) (*querypb.GetLoadBalanceStatusResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("operationID", req.GetOperationID()),
+	)
+
+	log.Info("get load balance status request received")
+	errMsg := "failed to get load balance status"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.GetLoadBalanceStatusResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+
+	status, ok := s.taskScheduler.GetBalanceStatus(req.GetOperationID())
+	if !ok {
+		err := merr.WrapErrParameterInvalidMsg("balance operation %d not found or expired", req.GetOperationID())
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.GetLoadBalanceStatusResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	return &querypb.GetLoadBalanceStatusResponse{
+		Status:      merr.Success(),
+		OperationID: req.GetOperationID(),
+		Planned:     int32(status.Planned),
+		Completed:   int32(status.Completed),
+		InFlight:    int32(status.InFlight),
+		Failed:      int32(status.Failed),
+	}, nil
+}
+
+// DryRunLoadBalance validates the load balance request as LoadBalance does,
+// and returns the planned segment moves without executing them.
+func (s *Server) DryRunLoadBalance(ctx context.Context, req *querypb.LoadBalanceRequest) (*querypb.DryRunLoadBalanceResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+	)
+
+	log.Info("dry run load balance request received",
+		zap.Int64s("source", req.GetSourceNodeIDs()),
+		zap.Int64s("dest", req.GetDstNodeIDs()),
+		zap.Int64s("segments", req.GetSealedSegmentIDs()))
+
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		msg := "failed to dry run load balance"
+		log.Warn(msg, zap.Error(err))
+		return &querypb.DryRunLoadBalanceResponse{
+			Status: merr.Status(errors.Wrap(err, msg)),
+		}, nil
+	}
+
+	_, _, dstNodes, toBalance, err := s.prepareLoadBalance(ctx, req)
+	if err != nil {
+		return &querypb.DryRunLoadBalanceResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	plans, err := s.assignBalanceSegments(req.GetCollectionID(), toBalance, dstNodes)
+	if err != nil {
+		msg := "failed to dry run load balance"
+		log.Warn(msg, zap.Error(err))
+		return &querypb.DryRunLoadBalanceResponse{
+			Status: merr.Status(errors.Wrap(err, msg)),
+		}, nil
+	}
+	ret := lo.Map(plans, func(plan balance.SegmentAssignPlan, _ int) *querypb.SegmentBalancePlan {
+		return &querypb.SegmentBalancePlan{
+			SegmentID:  plan.Segment.GetID(),
+			SourceNode: plan.Segment.Node,
+			TargetNode: plan.To,
+			Size:       utils.GetSegmentSize(plan.Segment.SegmentInfo),
+		}
+	})
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].GetSegmentID() < ret[j].GetSegmentID()
 	})
+	return &querypb.DryRunLoadBalanceResponse{
+		Status: merr.Success(),
+		Plans:  ret,
+	}, nil
+}
 
-	toBalance := typeutil.NewSet[*meta.Segment]()
-	if len(req.GetSealedSegmentIDs()) == 0 {
-		toBalance.Insert(segments...)
-	} else {
-		// check whether sealed segment exist
-		for _, segmentID := range req.GetSealedSegmentIDs() {
-			segment, ok := segmentsMap[segmentID]
-			if !ok {
-				err := merr.WrapErrSegmentNotFound(segmentID, "segment not found in source node")
-				return merr.Status(err), nil
-			}
+// RebalanceCollection balances all replicas of the collection with the balancer.
+func (s *Server) RebalanceCollection(ctx context.Context, req *querypb.RebalanceCollectionRequest) (*querypb.RebalanceCollectionResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+	)
 
-			// Only balance segments in targets
-			existInTarget := s.targetMgr.GetSealedSegment(segment.GetCollectionID(), segment.GetID(), meta.CurrentTarget) != nil
-			if !existInTarget {
-				log.Info("segment doesn't exist in current target, skip it", zap.Int64("segmentID", segmentID))
-				continue
+	log.Info("rebalance collection request received")
+
+	errMsg := "failed to rebalance collection"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.RebalanceCollectionResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+
+	if s.meta.CollectionManager.CalculateLoadPercentage(req.GetCollectionID()) < 100 {
+		err := merr.WrapErrCollectionNotFullyLoaded(req.GetCollectionID())
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.RebalanceCollectionResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+
+	// stop auto balance of the collection, avoid conflicting plans
+	if !s.checkerController.StartManualBalance(req.GetCollectionID()) {
+		err := merr.WrapErrServiceUnavailable("collection is being balanced")
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.RebalanceCollectionResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+	defer s.checkerController.FinishManualBalance(req.GetCollectionID())
+
+	pendings, _ := s.checkerController.GetPendingTasks(utils.BalanceChecker)
+	if lo.ContainsBy(pendings, func(t task.Task) bool { return t.CollectionID() == req.GetCollectionID() }) {
+		err := merr.WrapErrServiceUnavailable("collection is being balanced by auto balance")
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.RebalanceCollectionResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+
+	segmentPlans, channelPlans := s.planCollectionBalance(req.GetCollectionID())
+	resp := newRebalanceCollectionResponse(segmentPlans, channelPlans)
+	err := s.executeBalancePlans(ctx, segmentPlans, channelPlans)
+	if err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		resp.Status = merr.Status(errors.Wrap(err, errMsg))
+	}
+	return resp, nil
+}
+
+// DryRunRebalanceCollection validates the rebalance request as RebalanceCollection does,
+// and returns the balance plans without executing them.
+func (s *Server) DryRunRebalanceCollection(ctx context.Context, req *querypb.RebalanceCollectionRequest) (*querypb.RebalanceCollectionResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+	)
+
+	log.Info("dry run rebalance collection request received")
+
+	errMsg := "failed to dry run rebalance collection"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.RebalanceCollectionResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+
+	if s.meta.CollectionManager.CalculateLoadPercentage(req.GetCollectionID()) < 100 {
+		err := merr.WrapErrCollectionNotFullyLoaded(req.GetCollectionID())
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.RebalanceCollectionResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+
+	segmentPlans, channelPlans := s.planCollectionBalance(req.GetCollectionID())
+	return newRebalanceCollectionResponse(segmentPlans, channelPlans), nil
+}
+
+func newRebalanceCollectionResponse(segmentPlans []balance.SegmentAssignPlan, channelPlans []balance.ChannelAssignPlan) *querypb.RebalanceCollectionResponse {
+	return &querypb.RebalanceCollectionResponse{
+		Status: merr.Success(),
+		SegmentPlans: lo.Map(segmentPlans, func(plan balance.SegmentAssignPlan, _ int) *querypb.SegmentBalancePlan {
+			return &querypb.SegmentBalancePlan{
+				SegmentID:  plan.Segment.GetID(),
+				SourceNode: plan.From,
+				TargetNode: plan.To,
+				Size:       utils.GetSegmentSize(plan.Segment.SegmentInfo),
 			}
-			toBalance.Insert(segment)
-		}
+		}),
+		ChannelPlans: lo.Map(channelPlans, func(plan balance.ChannelAssignPlan, _ int) *querypb.ChannelBalancePlan {
+			return &querypb.ChannelBalancePlan{
+				Channel:    plan.Channel.GetChannelName(),
+				SourceNode: plan.From,
+				TargetNode: plan.To,
+			}
+		}),
+	}
+}
+
+// SetCollectionBalanceMode excludes the collection from auto balance or includes it back,
+// the collection could still be balanced manually by LoadBalance.
+func (s *Server) SetCollectionBalanceMode(ctx context.Context, req *querypb.SetCollectionBalanceModeRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Bool("balanceExcluded", req.GetBalanceExcluded()),
+	)
+
+	log.Info("set collection balance mode request received")
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		msg := "failed to set collection balance mode"
+		log.Warn(msg, zap.Error(err))
+		return merr.Status(errors.Wrap(err, msg)), nil
 	}
 
-	err := s.balanceSegments(ctx, replica.GetCollectionID(), replica, srcNode, dstNodeSet.Collect(), toBalance.Collect(), true, false)
+	err := s.meta.CollectionManager.SetBalanceExcluded(req.GetCollectionID(), req.GetBalanceExcluded())
 	if err != nil {
-		msg := "failed to balance segments"
+		msg := "failed to set collection balance mode"
 		log.Warn(msg, zap.Error(err))
 		return merr.Status(errors.Wrap(err, msg)), nil
 	}
+	return merr.Success(), nil
+}
+
+// SetBalancePolicy selects the balancer used to balance the collection automatically,
+// the global balancer is used again if the policy is empty.
+func (s *Server) SetBalancePolicy(ctx context.Context, req *querypb.SetBalancePolicyRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("balancePolicy", req.GetBalancePolicy()),
+	)
+
+	log.Info("set balance policy request received")
+	errMsg := "failed to set balance policy"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(errors.Wrap(err, errMsg)), nil
+	}
 
+	if err := s.checkBalancePolicy(req.GetBalancePolicy()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(errors.Wrap(err, errMsg)), nil
+	}
+
+	if err := s.meta.CollectionManager.SetBalancePolicy(req.GetCollectionID(), req.GetBalancePolicy()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(errors.Wrap(err, errMsg)), nil
+	}
 	return merr.Success(), nil
 }
 
@@ -801,7 +1626,28 @@ func (s *Server) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest
 		return resp, nil
 	}
 
-	if metricType != metricsinfo.SystemInfoMetrics {
+	switch metricType {
+	case metricsinfo.SystemInfoMetrics:
+		resp.Response, err = s.getCachedMetrics(ctx, metricType, func(ctx context.Context) (string, error) {
+			return s.getSystemInfoMetrics(ctx, req)
+		})
+		if err != nil {
+			msg := "failed to get system info metrics"
+			log.Warn(msg, zap.Error(err))
+			resp.Status = merr.Status(errors.Wrap(err, msg))
+			return resp, nil
+		}
+	case metricsinfo.CollectionDistributionMetrics:
+		resp.Response, err = s.getCachedMetrics(ctx, metricType, func(context.Context) (string, error) {
+			return s.getCollectionDistributionMetrics()
+		})
+		if err != nil {
+			msg := "failed to get collection distribution metrics"
+			log.Warn(msg, zap.Error(err))
+			resp.Status = merr.Status(errors.Wrap(err, msg))
+			return resp, nil
+		}
+	default:
 		msg := "invalid metric type"
 		err := errors.New(metricsinfo.MsgUnimplementedMetric)
 		log.Warn(msg, zap.Error(err))
@@ -809,14 +1655,6 @@ func (s *Server) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest
 		return resp, nil
 	}
 
-	resp.Response, err = s.getSystemInfoMetrics(ctx, req)
-	if err != nil {
-		msg := "failed to get system info metrics"
-		log.Warn(msg, zap.Error(err))
-		resp.Status = merr.Status(errors.Wrap(err, msg))
-		return resp, nil
-	}
-
 	return resp, nil
 }
 
@@ -840,126 +1678,466 @@ func (s *Server) GetReplicas(ctx context.Context, req *milvuspb.GetReplicasReque
 		Replicas: make([]*milvuspb.ReplicaInfo, 0),
 	}
 
-	replicas := s.meta.ReplicaManager.GetByCollection(req.GetCollectionID())
-	if len(replicas) == 0 {
-		return &milvuspb.GetReplicasResponse{
-			Replicas: make([]*milvuspb.ReplicaInfo, 0),
+	replicas := s.meta.ReplicaManager.GetByCollection(req.GetCollectionID())
+	if len(replicas) == 0 {
+		return &milvuspb.GetReplicasResponse{
+			Replicas: make([]*milvuspb.ReplicaInfo, 0),
+		}, nil
+	}
+
+	standbyIDs := make([]string, 0)
+	for _, replica := range replicas {
+		resp.Replicas = append(resp.Replicas, s.fillReplicaInfo(replica, req.GetWithShardNodes()))
+		if replica.IsStandby() {
+			standbyIDs = append(standbyIDs, strconv.FormatInt(replica.GetID(), 10))
+		}
+	}
+
+	extraInfo := make(map[string]string)
+	if len(standbyIDs) > 0 {
+		extraInfo[StandbyReplicaIDsKey] = strings.Join(standbyIDs, ",")
+	}
+	collection := s.meta.CollectionManager.GetCollection(req.GetCollectionID())
+	if req.GetWithShardNodes() {
+		hostnames := lo.Map(s.fillReplicaNodes(replicas), func(node *commonpb.NodeInfo, _ int) string {
+			return fmt.Sprintf("%d:%s", node.GetNodeId(), node.GetHostname())
+		})
+		if len(hostnames) > 0 {
+			extraInfo[NodeHostnamesKey] = strings.Join(hostnames, ",")
+		}
+	}
+	if shortfall := int(collection.GetReplicaNumber()) - len(replicas); shortfall > 0 {
+		log.Warn("collection loaded with fewer replicas than requested",
+			zap.Int32("requestedReplicaNumber", collection.GetReplicaNumber()),
+			zap.Int("replicaNumber", len(replicas)))
+		extraInfo[RequestedReplicaNumberKey] = strconv.Itoa(int(collection.GetReplicaNumber()))
+		extraInfo[ReplicaShortfallKey] = strconv.Itoa(shortfall)
+	}
+	if len(extraInfo) > 0 {
+		resp.Status.ExtraInfo = extraInfo
+	}
+	return resp, nil
+}
+
+// ListReplicas works like GetReplicas, but supports filtering the replicas by resource group,
+// and sorting them by node number or load percentage.
+func (s *Server) ListReplicas(ctx context.Context, req *querypb.ListReplicasRequest) (*querypb.ListReplicasResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("resourceGroup", req.GetResourceGroup()),
+		zap.String("sortKey", req.GetSortKey().String()),
+	)
+
+	log.Info("list replicas request received", zap.Bool("with-shard-nodes", req.GetWithShardNodes()))
+
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		msg := "failed to list replicas"
+		log.Warn(msg, zap.Error(err))
+		return &querypb.ListReplicasResponse{
+			Status: merr.Status(errors.Wrap(err, msg)),
+		}, nil
+	}
+
+	replicas := s.meta.ReplicaManager.GetByCollection(req.GetCollectionID())
+	if req.GetResourceGroup() != "" {
+		// the unknown resource group just filters out all replicas
+		replicas = lo.Filter(replicas, func(replica *meta.Replica, _ int) bool {
+			return replica.GetResourceGroup() == req.GetResourceGroup()
+		})
+	}
+	s.sortReplicas(req.GetCollectionID(), replicas, req.GetSortKey(), req.GetDescending())
+
+	resp := &querypb.ListReplicasResponse{
+		Status:   merr.Success(),
+		Replicas: make([]*milvuspb.ReplicaInfo, 0, len(replicas)),
+	}
+	for _, replica := range replicas {
+		resp.Replicas = append(resp.Replicas, s.fillReplicaInfo(replica, req.GetWithShardNodes()))
+	}
+	if req.GetWithShardNodes() {
+		resp.Nodes = s.fillReplicaNodes(replicas)
+	}
+	return resp, nil
+}
+
+// DescribeReplica returns the nodes, resource group, shard leaders and segment number per node of a single replica.
+func (s *Server) DescribeReplica(ctx context.Context, req *querypb.DescribeReplicaRequest) (*querypb.DescribeReplicaResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("replicaID", req.GetReplicaID()),
+	)
+
+	log.Info("describe replica request received")
+	errMsg := "failed to describe replica"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.DescribeReplicaResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+
+	replica := s.meta.ReplicaManager.Get(req.GetReplicaID())
+	if replica == nil {
+		err := merr.WrapErrReplicaNotFound(req.GetReplicaID())
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.DescribeReplicaResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	nodeSegmentNum := make(map[int64]int32)
+	for _, node := range replica.GetNodes() {
+		nodeSegmentNum[node] = 0
+	}
+	for _, segment := range s.dist.SegmentDistManager.GetByFilter(meta.WithReplica(replica)) {
+		nodeSegmentNum[segment.Node]++
+	}
+	return &querypb.DescribeReplicaResponse{
+		Status:         merr.Success(),
+		Replica:        s.fillReplicaInfo(replica, true),
+		NodeSegmentNum: nodeSegmentNum,
+	}, nil
+}
+
+func (s *Server) GetShardLeaders(ctx context.Context, req *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error) {
+	log := log.Ctx(ctx).WithRateGroup("qcv2.GetShardLeaders", 1, 60).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("resourceGroup", req.GetResourceGroup()),
+		zap.String("preferZone", req.GetPreferZone()),
+	)
+
+	log.RatedInfo(10, "get shard leaders request received")
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		msg := "failed to get shard leaders"
+		log.Warn(msg, zap.Error(err))
+		return &querypb.GetShardLeadersResponse{
+			Status: merr.Status(errors.Wrap(err, msg)),
+		}, nil
+	}
+
+	return s.getShardLeaders(ctx, req), nil
+}
+
+// GetShardLeadersBatch returns the shard leaders of multiple collections in one request,
+// the failure of a collection is reported in its own status rather than failing the batch.
+func (s *Server) GetShardLeadersBatch(ctx context.Context, req *querypb.GetShardLeadersBatchRequest) (*querypb.GetShardLeadersBatchResponse, error) {
+	log := log.Ctx(ctx).WithRateGroup("qcv2.GetShardLeadersBatch", 1, 60).With(
+		zap.Int64s("collectionIDs", req.GetCollectionIDs()),
+		zap.String("resourceGroup", req.GetResourceGroup()),
+		zap.String("preferZone", req.GetPreferZone()),
+	)
+
+	log.RatedInfo(10, "get shard leaders batch request received")
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		msg := "failed to get shard leaders batch"
+		log.Warn(msg, zap.Error(err))
+		return &querypb.GetShardLeadersBatchResponse{
+			Status: merr.Status(errors.Wrap(err, msg)),
+		}, nil
+	}
+
+	if req.GetResourceGroup() != "" && !s.meta.ResourceManager.ContainResourceGroup(req.GetResourceGroup()) {
+		err := merr.WrapErrResourceGroupNotFound(req.GetResourceGroup())
+		log.Warn("failed to get shard leaders batch", zap.Error(err))
+		return &querypb.GetShardLeadersBatchResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	collections := make([]*querypb.CollectionShardLeaders, 0, len(req.GetCollectionIDs()))
+	for _, collectionID := range lo.Uniq(req.GetCollectionIDs()) {
+		leaders := s.getShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+			Base:                     req.GetBase(),
+			CollectionID:             collectionID,
+			ResourceGroup:            req.GetResourceGroup(),
+			PreferZone:               req.GetPreferZone(),
+			WithReadableReplicaCount: req.GetWithReadableReplicaCount(),
+			WithLeaderErrors:         req.GetWithLeaderErrors(),
+		})
+		collections = append(collections, &querypb.CollectionShardLeaders{
+			CollectionID: collectionID,
+			Leaders:      leaders,
+		})
+	}
+	return &querypb.GetShardLeadersBatchResponse{
+		Status:      merr.Success(),
+		Collections: collections,
+	}, nil
+}
+
+// GetHandoffLag returns the sealed segments of the next target not served by the shard leaders yet,
+// which shows the handoff backlog causing momentary query gaps.
+func (s *Server) GetHandoffLag(ctx context.Context, req *querypb.GetHandoffLagRequest) (*querypb.GetHandoffLagResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+	)
+
+	log.Info("get handoff lag request received")
+	errMsg := "failed to get handoff lag"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.GetHandoffLagResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+
+	if !s.meta.CollectionManager.Exist(req.GetCollectionID()) {
+		err := merr.WrapErrCollectionNotLoaded(req.GetCollectionID())
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.GetHandoffLagResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	return &querypb.GetHandoffLagResponse{
+		Status: merr.Success(),
+		Shards: s.getHandoffLag(req.GetCollectionID()),
+	}, nil
+}
+
+// GetCollectionTargets returns the current and next target of the given collection,
+// which are what the checkers and observers operate on.
+func (s *Server) GetCollectionTargets(ctx context.Context, req *querypb.GetCollectionTargetsRequest) (*querypb.GetCollectionTargetsResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+	)
+
+	log.Info("get collection targets request received")
+	errMsg := "failed to get collection targets"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.GetCollectionTargetsResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+
+	if !s.meta.CollectionManager.Exist(req.GetCollectionID()) {
+		err := merr.WrapErrCollectionNotLoaded(req.GetCollectionID())
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.GetCollectionTargetsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	return &querypb.GetCollectionTargetsResponse{
+		Status:        merr.Success(),
+		CurrentTarget: s.getTargetSnapshot(req.GetCollectionID(), meta.CurrentTarget),
+		NextTarget:    s.getTargetSnapshot(req.GetCollectionID(), meta.NextTarget),
+	}, nil
+}
+
+// GetAvailabilitySLA returns the uptime of the given collection within the recent time window,
+// the availability is sampled periodically by checking whether every channel has a readable shard leader.
+func (s *Server) GetAvailabilitySLA(ctx context.Context, req *querypb.GetAvailabilitySLARequest) (*querypb.GetAvailabilitySLAResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("windowSeconds", req.GetWindowSeconds()),
+	)
+
+	log.Info("get availability SLA request received")
+	errMsg := "failed to get availability SLA"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.GetAvailabilitySLAResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+
+	if !s.meta.CollectionManager.Exist(req.GetCollectionID()) {
+		err := merr.WrapErrCollectionNotLoaded(req.GetCollectionID())
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.GetAvailabilitySLAResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	availability, ok := s.availabilityObserver.GetAvailability(req.GetCollectionID(), time.Duration(req.GetWindowSeconds())*time.Second)
+	if !ok {
+		err := merr.WrapErrCollectionNotFullyLoaded(req.GetCollectionID(), "availability is tracked after the collection has been loaded")
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.GetAvailabilitySLAResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	periods := lo.Map(availability.Periods, func(period observers.UnavailablePeriod, _ int) *querypb.UnavailablePeriod {
+		ret := &querypb.UnavailablePeriod{
+			StartTime: period.Start.UnixMilli(),
+		}
+		if !period.End.IsZero() {
+			ret.EndTime = period.End.UnixMilli()
+		}
+		return ret
+	})
+	return &querypb.GetAvailabilitySLAResponse{
+		Status:             merr.Success(),
+		UptimeRatio:        availability.UptimeRatio(),
+		UnavailableSeconds: int64(availability.Unavailable.Seconds()),
+		WindowSeconds:      int64(availability.Window.Seconds()),
+		TrackingSince:      availability.TrackingSince.UnixMilli(),
+		Available:          availability.Available,
+		UnavailablePeriods: periods,
+	}, nil
+}
+
+func (s *Server) GetCollectionLoadInfo(ctx context.Context, req *querypb.GetCollectionLoadInfoRequest) (*querypb.GetCollectionLoadInfoResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+	)
+
+	log.Info("get collection load info request received")
+	errMsg := "failed to get collection load info"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.GetCollectionLoadInfoResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+
+	collection := s.meta.CollectionManager.GetCollection(req.GetCollectionID())
+	if collection == nil {
+		err := merr.WrapErrCollectionNotLoaded(req.GetCollectionID())
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.GetCollectionLoadInfoResponse{
+			Status: merr.Status(err),
 		}, nil
 	}
 
-	for _, replica := range replicas {
-		resp.Replicas = append(resp.Replicas, s.fillReplicaInfo(replica, req.GetWithShardNodes()))
+	resp := &querypb.GetCollectionLoadInfoResponse{
+		Status:   merr.Success(),
+		LoadInfo: collection.CollectionLoadInfo,
+	}
+	if checkpoint := s.meta.LoadCheckpointManager.GetLoadCheckpoint(req.GetCollectionID()); checkpoint != nil {
+		resp.Checkpoint = checkpoint
+		resp.CheckpointAgeMs = time.Since(time.UnixMilli(checkpoint.GetTimestamp())).Milliseconds()
 	}
 	return resp, nil
 }
 
-func (s *Server) GetShardLeaders(ctx context.Context, req *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error) {
-	log := log.Ctx(ctx).WithRateGroup("qcv2.GetShardLeaders", 1, 60).With(
+func (s *Server) RecommendReplicaCount(ctx context.Context, req *querypb.RecommendReplicaCountRequest) (*querypb.RecommendReplicaCountResponse, error) {
+	log := log.Ctx(ctx).With(
 		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Float64("targetUtilization", req.GetTargetUtilization()),
 	)
 
-	log.RatedInfo(10, "get shard leaders request received")
+	log.Info("recommend replica count request received")
+	errMsg := "failed to recommend replica count"
 	if err := merr.CheckHealthy(s.State()); err != nil {
-		msg := "failed to get shard leaders"
-		log.Warn(msg, zap.Error(err))
-		return &querypb.GetShardLeadersResponse{
-			Status: merr.Status(errors.Wrap(err, msg)),
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.RecommendReplicaCountResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
 		}, nil
 	}
 
-	resp := &querypb.GetShardLeadersResponse{
-		Status: merr.Success(),
-	}
-
-	percentage := s.meta.CollectionManager.CalculateLoadPercentage(req.GetCollectionID())
-	if percentage < 0 {
+	collection := s.meta.CollectionManager.GetCollection(req.GetCollectionID())
+	if collection == nil {
 		err := merr.WrapErrCollectionNotLoaded(req.GetCollectionID())
-		log.Warn("failed to GetShardLeaders", zap.Error(err))
-		resp.Status = merr.Status(err)
-		return resp, nil
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.RecommendReplicaCountResponse{
+			Status: merr.Status(err),
+		}, nil
 	}
-	collection := s.meta.CollectionManager.GetCollection(req.GetCollectionID())
-	if collection != nil && collection.GetStatus() == querypb.LoadStatus_Loaded {
-		// when collection is loaded, regard collection as readable, set percentage == 100
-		percentage = 100
+
+	utilization := req.GetTargetUtilization()
+	if utilization == 0 {
+		utilization = Params.QueryCoordCfg.ReplicaRecommendTargetUtilization.GetAsFloat()
 	}
-	if percentage < 100 {
-		err := merr.WrapErrCollectionNotFullyLoaded(req.GetCollectionID())
-		msg := fmt.Sprintf("collection %v is not fully loaded", req.GetCollectionID())
-		log.Warn(msg)
-		resp.Status = merr.Status(err)
-		return resp, nil
+	capacity := Params.QueryCoordCfg.ReplicaRecommendNodeCapacity.GetAsFloat()
+	if utilization <= 0 || utilization > 1 || capacity <= 0 {
+		err := merr.WrapErrParameterInvalidMsg("target utilization %v should be in (0, 1] and node capacity %v should be positive", utilization, capacity)
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.RecommendReplicaCountResponse{
+			Status: merr.Status(err),
+		}, nil
 	}
 
-	channels := s.targetMgr.GetDmChannelsByCollection(req.GetCollectionID(), meta.CurrentTarget)
-	if len(channels) == 0 {
-		err := merr.WrapErrCollectionOnRecovering(req.GetCollectionID(),
-			"loaded collection do not found any channel in target, may be in recovery")
-		log.Warn("failed to get channels", zap.Error(err))
-		resp.Status = merr.Status(err)
-		return resp, nil
+	shards, unavailableNodes := s.estimateShardLoads(ctx, req.GetCollectionID())
+	if len(shards) == 0 {
+		err := merr.WrapErrCollectionNotFullyLoaded(req.GetCollectionID(), "no channel found in current target")
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.RecommendReplicaCountResponse{
+			Status: merr.Status(err),
+		}, nil
 	}
 
-	currentTargets := s.targetMgr.GetSealedSegmentsByCollection(req.GetCollectionID(), meta.CurrentTarget)
-	for _, channel := range channels {
-		log := log.With(zap.String("channel", channel.GetChannelName()))
-
-		leaders := s.dist.LeaderViewManager.GetByFilter(meta.WithChannelName2LeaderView(channel.GetChannelName()))
-
-		readableLeaders := make(map[int64]*meta.LeaderView)
-
-		var channelErr error
-		if len(leaders) == 0 {
-			channelErr = merr.WrapErrChannelLack(channel.GetChannelName(), "channel not subscribed")
+	var peak *querypb.ShardLoadEstimate
+	recommended := int32(1)
+	for _, shard := range shards {
+		shard.RequiredReplicaNumber = int32(math.Ceil(shard.GetNqPerSecond() / (capacity * utilization)))
+		if shard.RequiredReplicaNumber < 1 {
+			shard.RequiredReplicaNumber = 1
 		}
-
-		for _, leader := range leaders {
-			if err := checkers.CheckLeaderAvailable(s.nodeMgr, leader, currentTargets); err != nil {
-				multierr.AppendInto(&channelErr, err)
-				continue
-			}
-
-			readableLeaders[leader.ID] = leader
+		if peak == nil || shard.GetNqPerSecond() > peak.GetNqPerSecond() {
+			peak = shard
 		}
-
-		if len(readableLeaders) == 0 {
-			msg := fmt.Sprintf("channel %s is not available in any replica", channel.GetChannelName())
-			log.Warn(msg, zap.Error(channelErr))
-			resp.Status = merr.Status(
-				errors.Wrap(merr.WrapErrChannelNotAvailable(channel.GetChannelName()), channelErr.Error()))
-			resp.Shards = nil
-			return resp, nil
+		if shard.RequiredReplicaNumber > recommended {
+			recommended = shard.RequiredReplicaNumber
 		}
+	}
+	rationale := fmt.Sprintf("the busiest shard %s serves %.2f nq/s, a replica keeps it under %.0f%% utilization of node capacity %.2f nq/s with %d replicas",
+		peak.GetChannelName(), peak.GetNqPerSecond(), utilization*100, capacity, recommended)
 
-		readableLeaders = filterDupLeaders(s.meta.ReplicaManager, readableLeaders)
-		ids := make([]int64, 0, len(leaders))
-		addrs := make([]string, 0, len(leaders))
-		for _, leader := range readableLeaders {
-			info := s.nodeMgr.Get(leader.ID)
-			if info != nil {
-				ids = append(ids, info.ID())
-				addrs = append(addrs, info.Addr())
-			}
+	// each replica takes one node at least
+	replicas := s.meta.ReplicaManager.GetByCollection(req.GetCollectionID())
+	rgs := lo.Uniq(lo.Map(replicas, func(replica *meta.Replica, _ int) string { return replica.GetResourceGroup() }))
+	nodeNum := 0
+	for _, rg := range rgs {
+		nodes, err := s.meta.ResourceManager.GetNodes(rg)
+		if err == nil {
+			nodeNum += len(nodes)
 		}
+	}
+	if nodeNum > 0 && recommended > int32(nodeNum) {
+		recommended = int32(nodeNum)
+		rationale += fmt.Sprintf(", limited by %d nodes in resource groups %v", nodeNum, rgs)
+	}
+	if len(unavailableNodes) > 0 {
+		rationale += fmt.Sprintf(", metrics of nodes %v are unavailable and not counted", unavailableNodes)
+	}
 
-		// to avoid node down during GetShardLeaders
-		if len(ids) == 0 {
-			msg := fmt.Sprintf("channel %s is not available in any replica", channel.GetChannelName())
-			log.Warn(msg, zap.Error(channelErr))
-			resp.Status = merr.Status(
-				errors.Wrap(merr.WrapErrChannelNotAvailable(channel.GetChannelName()), channelErr.Error()))
-			resp.Shards = nil
-			return resp, nil
-		}
+	return &querypb.RecommendReplicaCountResponse{
+		Status:                   merr.Success(),
+		CurrentReplicaNumber:     collection.GetReplicaNumber(),
+		RecommendedReplicaNumber: recommended,
+		Rationale:                rationale,
+		Shards:                   shards,
+	}, nil
+}
 
-		resp.Shards = append(resp.Shards, &querypb.ShardLeadersList{
-			ChannelName: channel.GetChannelName(),
-			NodeIds:     ids,
-			NodeAddrs:   addrs,
-		})
+func (s *Server) GetNodeLoadHistory(ctx context.Context, req *querypb.GetNodeLoadHistoryRequest) (*querypb.GetNodeLoadHistoryResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("nodeID", req.GetNodeID()),
+		zap.Int64("since", req.GetSince()),
+	)
+
+	log.Info("get node load history request received")
+	errMsg := "failed to get node load history"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.GetNodeLoadHistoryResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
 	}
 
-	return resp, nil
+	samples, ok := s.nodeLoadObserver.GetHistory(req.GetNodeID(), time.UnixMilli(req.GetSince()))
+	if !ok {
+		err := merr.WrapErrNodeNotFound(req.GetNodeID(), "node load has not been sampled")
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.GetNodeLoadHistoryResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	return &querypb.GetNodeLoadHistoryResponse{
+		Status: merr.Success(),
+		Samples: lo.Map(samples, func(sample observers.NodeLoadSample, _ int) *querypb.NodeLoadSample {
+			return &querypb.NodeLoadSample{
+				Timestamp:  sample.Timestamp.UnixMilli(),
+				SegmentNum: int64(sample.SegmentNum),
+				ChannelNum: int64(sample.ChannelNum),
+				MemorySize: sample.MemorySize,
+			}
+		}),
+	}, nil
 }
 
 func (s *Server) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
@@ -967,40 +2145,152 @@ func (s *Server) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthReque
 		return &milvuspb.CheckHealthResponse{Status: merr.Status(err), IsHealthy: false, Reasons: []string{err.Error()}}, nil
 	}
 
-	errReasons, err := s.checkNodeHealth(ctx)
-	if err != nil || len(errReasons) != 0 {
-		return &milvuspb.CheckHealthResponse{Status: merr.Success(), IsHealthy: false, Reasons: errReasons}, nil
+	isHealthy, errReasons, _ := s.checkHealth(ctx)
+	return &milvuspb.CheckHealthResponse{Status: merr.Success(), IsHealthy: isHealthy, Reasons: errReasons}, nil
+}
+
+// CheckNodeHealth works like CheckHealth, but also returns the health of each query node.
+func (s *Server) CheckNodeHealth(ctx context.Context, req *querypb.CheckNodeHealthRequest) (*querypb.CheckNodeHealthResponse, error) {
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		return &querypb.CheckNodeHealthResponse{Status: merr.Status(err), IsHealthy: false, Reasons: []string{err.Error()}}, nil
 	}
 
-	return &milvuspb.CheckHealthResponse{Status: merr.Success(), IsHealthy: true, Reasons: errReasons}, nil
+	isHealthy, errReasons, nodes := s.checkHealth(ctx)
+	return &querypb.CheckNodeHealthResponse{
+		Status:    merr.Success(),
+		IsHealthy: isHealthy,
+		Reasons:   errReasons,
+		Nodes:     nodes,
+	}, nil
+}
+
+func (s *Server) checkHealth(ctx context.Context) (bool, []string, []*querypb.NodeHealth) {
+	errReasons, nodes, err := s.checkNodeHealth(ctx)
+	isHealthy := err == nil && len(errReasons) == 0
+	// paused target updates doesn't make query coord unhealthy, but should be noticed
+	if paused, since := s.targetObserver.IsTargetUpdatePaused(); paused {
+		errReasons = append(errReasons, fmt.Sprintf("target updates are paused since %s", since.Format(time.RFC3339)))
+	}
+	// so does suspended balance
+	if active, err := s.checkerController.IsActive(utils.BalanceChecker); err == nil && !active {
+		errReasons = append(errReasons, "automatic balance is suspended")
+	}
+	return isHealthy, errReasons, nodes
 }
 
-func (s *Server) checkNodeHealth(ctx context.Context) ([]string, error) {
-	group, ctx := errgroup.WithContext(ctx)
+// checkNodeHealth checks all query nodes concurrently, each with its own timeout,
+// so one hung node won't block the whole check until the caller's deadline.
+// The node which times out is reported as unhealthy rather than failing the check.
+func (s *Server) checkNodeHealth(ctx context.Context) ([]string, []*querypb.NodeHealth, error) {
+	group := &errgroup.Group{}
+	if limit := Params.QueryCoordCfg.CheckHealthMaxConcurrency.GetAsInt(); limit > 0 {
+		group.SetLimit(limit)
+	}
 	errReasons := make([]string, 0)
+	nodes := make([]*querypb.NodeHealth, 0)
 
 	mu := &sync.Mutex{}
 	for _, node := range s.nodeMgr.GetAll() {
 		node := node
 		group.Go(func() error {
-			resp, err := s.cluster.GetComponentStates(ctx, node.ID())
+			health := &querypb.NodeHealth{
+				NodeID:  node.ID(),
+				Address: node.Addr(),
+				Healthy: true,
+			}
+			defer func() {
+				mu.Lock()
+				defer mu.Unlock()
+				nodes = append(nodes, health)
+			}()
+
+			resp, err := s.getNodeComponentStates(ctx, node.ID())
+			if err != nil && ctx.Err() == nil && errors.Is(err, context.DeadlineExceeded) {
+				reason := fmt.Sprintf("QueryNode %d health check timeout", node.ID())
+				s.setNodeServingError(ctx, node.ID(), reason)
+				health.Healthy = false
+				health.Reason = reason
+				mu.Lock()
+				defer mu.Unlock()
+				errReasons = append(errReasons, reason)
+				return nil
+			}
 			if err != nil {
+				// the retries are exhausted
+				s.setNodeServingError(ctx, node.ID(), err.Error())
+				health.Healthy = false
+				health.Reason = err.Error()
+				mu.Lock()
+				defer mu.Unlock()
+				errReasons = append(errReasons, err.Error())
 				return err
 			}
 
 			err = merr.AnalyzeState("QueryNode", node.ID(), resp)
 			if err != nil {
+				s.setNodeServingError(ctx, node.ID(), err.Error())
+				health.Healthy = false
+				health.Reason = err.Error()
 				mu.Lock()
 				defer mu.Unlock()
 				errReasons = append(errReasons, err.Error())
+				return nil
 			}
+			s.nodeServingErrors.Remove(node.ID())
 			return nil
 		})
 	}
 
 	err := group.Wait()
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].GetNodeID() < nodes[j].GetNodeID()
+	})
+	// the errors of the nodes removed during the check are left behind
+	s.nodeServingErrors.Range(func(nodeID int64, _ *nodeServingError) bool {
+		if s.nodeMgr.Get(nodeID) == nil {
+			s.nodeServingErrors.Remove(nodeID)
+		}
+		return true
+	})
+
+	return errReasons, nodes, err
+}
 
-	return errReasons, err
+// setNodeServingError records the error of the node found by health check,
+// nothing is recorded if the node is gone or the check is aborted by the caller.
+func (s *Server) setNodeServingError(ctx context.Context, nodeID int64, reason string) {
+	if ctx.Err() != nil || s.nodeMgr.Get(nodeID) == nil {
+		return
+	}
+	s.nodeServingErrors.Insert(nodeID, &nodeServingError{reason: reason, timestamp: time.Now()})
+}
+
+// getNodeComponentStates gets the component states of the query node,
+// the failed rpc is retried with exponential backoff, so a brief network blip won't make the node unhealthy.
+// All attempts share the rpc timeout, so the retries won't make the check of a hung node slower.
+// No more attempt is made once the ctx is done.
+func (s *Server) getNodeComponentStates(ctx context.Context, nodeID int64) (*milvuspb.ComponentStates, error) {
+	attempts := Params.QueryCoordCfg.CheckHealthRetryAttempts.GetAsInt()
+	if attempts < 1 {
+		attempts = 1
+	}
+	timeout := Params.QueryCoordCfg.CheckHealthRPCTimeout.GetAsDuration(time.Millisecond)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	attemptTimeout := timeout / time.Duration(attempts)
+
+	var resp *milvuspb.ComponentStates
+	err := retry.Do(ctx, func() error {
+		nodeCtx, cancel := context.WithTimeout(ctx, attemptTimeout)
+		defer cancel()
+		var err error
+		resp, err = s.cluster.GetComponentStates(nodeCtx, nodeID)
+		return err
+	},
+		retry.Attempts(uint(attempts)),
+		retry.Sleep(Params.QueryCoordCfg.CheckHealthRetryInterval.GetAsDuration(time.Millisecond)),
+	)
+	return resp, err
 }
 
 func (s *Server) CreateResourceGroup(ctx context.Context, req *milvuspb.CreateResourceGroupRequest) (*commonpb.Status, error) {
@@ -1022,6 +2312,52 @@ func (s *Server) CreateResourceGroup(ctx context.Context, req *milvuspb.CreateRe
 	return merr.Success(), nil
 }
 
+// CreateResourceGroupWithAutoFill creates the resource group, and populates it with the spare nodes
+// of the default resource group at once if auto fill is set, rather than waiting for the auto recovery.
+func (s *Server) CreateResourceGroupWithAutoFill(ctx context.Context, req *querypb.CreateResourceGroupWithAutoFillRequest) (*querypb.CreateResourceGroupWithAutoFillResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.String("rgName", req.GetResourceGroup()),
+		zap.Bool("autoFill", req.GetAutoFill()),
+		zap.Any("nodeSelector", req.GetNodeSelector()),
+	)
+
+	log.Info("create resource group with auto fill request received")
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn("failed to create resource group", zap.Error(err))
+		return &querypb.CreateResourceGroupWithAutoFillResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	err := s.meta.ResourceManager.AddResourceGroupWithNodeSelector(req.GetResourceGroup(), req.GetConfig(), req.GetNodeSelector())
+	if err != nil {
+		log.Warn("failed to create resource group", zap.Error(err))
+		return &querypb.CreateResourceGroupWithAutoFillResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	if !req.GetAutoFill() {
+		return &querypb.CreateResourceGroupWithAutoFillResponse{
+			Status: merr.Success(),
+		}, nil
+	}
+
+	placed, err := s.meta.ResourceManager.FillResourceGroupFromDefault(req.GetResourceGroup())
+	if err != nil {
+		// the resource group has been created, the missing nodes will be recovered automatically
+		log.Warn("failed to fill resource group", zap.Int("placed", placed), zap.Error(err))
+		return &querypb.CreateResourceGroupWithAutoFillResponse{
+			Status:        merr.Status(err),
+			PlacedNodeNum: int32(placed),
+		}, nil
+	}
+	log.Info("resource group filled from default resource group", zap.Int("placed", placed))
+	return &querypb.CreateResourceGroupWithAutoFillResponse{
+		Status:        merr.Success(),
+		PlacedNodeNum: int32(placed),
+	}, nil
+}
+
 func (s *Server) UpdateResourceGroups(ctx context.Context, req *querypb.UpdateResourceGroupsRequest) (*commonpb.Status, error) {
 	log := log.Ctx(ctx).With(
 		zap.Any("rgName", req.GetResourceGroups()),
@@ -1052,14 +2388,7 @@ func (s *Server) DropResourceGroup(ctx context.Context, req *milvuspb.DropResour
 		return merr.Status(err), nil
 	}
 
-	replicas := s.meta.ReplicaManager.GetByResourceGroup(req.GetResourceGroup())
-	if len(replicas) > 0 {
-		err := merr.WrapErrParameterInvalid("empty resource group", fmt.Sprintf("resource group %s has collection %d loaded", req.GetResourceGroup(), replicas[0].GetCollectionID()))
-		return merr.Status(errors.Wrap(err,
-			fmt.Sprintf("some replicas still loaded in resource group[%s], release it first", req.GetResourceGroup()))), nil
-	}
-
-	err := s.meta.ResourceManager.RemoveResourceGroup(req.GetResourceGroup())
+	err := s.meta.RemoveResourceGroup(req.GetResourceGroup())
 	if err != nil {
 		log.Warn("failed to drop resource group", zap.Error(err))
 		return merr.Status(err), nil
@@ -1088,10 +2417,111 @@ func (s *Server) TransferNode(ctx context.Context, req *milvuspb.TransferNodeReq
 	}
 	// Recover all replica on the source and target resource group.
 	utils.RecoverAllCollection(s.meta)
+	if Params.QueryCoordCfg.BalanceOnTransferNode.GetAsBool() {
+		s.balanceResourceGroups(req.GetSourceResourceGroup(), req.GetTargetResourceGroup())
+	}
+
+	return merr.Success(), nil
+}
+
+// TransferNodeByFraction transfers the given fraction of the source resource group's nodes to the target resource group,
+// the node number is rounded half away from zero, e.g. 0.5 of 3 nodes transfers 2 nodes.
+func (s *Server) TransferNodeByFraction(ctx context.Context, req *querypb.TransferNodeByFractionRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.String("source", req.GetSourceResourceGroup()),
+		zap.String("target", req.GetTargetResourceGroup()),
+		zap.Float64("fraction", req.GetFraction()),
+	)
+
+	log.Info("transfer node by fraction request received")
+	errMsg := "failed to transfer node by fraction"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(errors.Wrap(err, errMsg)), nil
+	}
+
+	if req.GetFraction() <= 0 || req.GetFraction() > 1 {
+		err := merr.WrapErrParameterInvalidMsg("fraction %v should be in (0, 1]", req.GetFraction())
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+	nodes, err := s.meta.ResourceManager.GetNodes(req.GetSourceResourceGroup())
+	if err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(errors.Wrap(err, errMsg)), nil
+	}
+	nodeNum := int(math.Round(req.GetFraction() * float64(len(nodes))))
+	if nodeNum == 0 {
+		err := merr.WrapErrParameterInvalid("at least 1 node to transfer",
+			fmt.Sprintf("fraction %v of %d nodes rounds to 0", req.GetFraction(), len(nodes)))
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+	available := lo.CountBy(nodes, func(node int64) bool {
+		info := s.nodeMgr.Get(node)
+		return info != nil && !info.IsStoppingState()
+	})
+	if nodeNum > available {
+		err := merr.WrapErrParameterInvalid(fmt.Sprintf("at most %d available nodes", available),
+			fmt.Sprintf("fraction %v of %d nodes requires %d nodes", req.GetFraction(), len(nodes), nodeNum))
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	log.Info("transfer nodes", zap.Int("nodeNum", nodeNum))
+	if err := s.meta.ResourceManager.TransferNode(req.GetSourceResourceGroup(), req.GetTargetResourceGroup(), nodeNum); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+	// Recover all replica on the source and target resource group.
+	utils.RecoverAllCollection(s.meta)
+	if Params.QueryCoordCfg.BalanceOnTransferNode.GetAsBool() {
+		s.balanceResourceGroups(req.GetSourceResourceGroup(), req.GetTargetResourceGroup())
+	}
 
 	return merr.Success(), nil
 }
 
+// GetTransferNodeStatus reports whether the replicas affected by node transfer are still recovering,
+// since TransferNode returns before the data on transferred nodes has been moved.
+func (s *Server) GetTransferNodeStatus(ctx context.Context, req *querypb.GetTransferNodeStatusRequest) (*querypb.GetTransferNodeStatusResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.String("source", req.GetSourceResourceGroup()),
+		zap.String("target", req.GetTargetResourceGroup()),
+	)
+
+	log.Info("get transfer node status request received")
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		msg := "failed to get transfer node status"
+		log.Warn(msg, zap.Error(err))
+		return &querypb.GetTransferNodeStatusResponse{
+			Status: merr.Status(errors.Wrap(err, msg)),
+		}, nil
+	}
+
+	rgs := typeutil.NewSet[string]()
+	for _, rgName := range []string{req.GetSourceResourceGroup(), req.GetTargetResourceGroup()} {
+		if rgName == "" {
+			continue
+		}
+		if !s.meta.ResourceManager.ContainResourceGroup(rgName) {
+			err := merr.WrapErrResourceGroupNotFound(rgName)
+			log.Warn("failed to get transfer node status", zap.Error(err))
+			return &querypb.GetTransferNodeStatusResponse{
+				Status: merr.Status(err),
+			}, nil
+		}
+		rgs.Insert(rgName)
+	}
+
+	pending := s.getReplicaRecoveryStatus(rgs)
+	return &querypb.GetTransferNodeStatusResponse{
+		Status:          merr.Success(),
+		Recovering:      len(pending) > 0,
+		PendingReplicas: pending,
+	}, nil
+}
+
 func (s *Server) TransferReplica(ctx context.Context, req *querypb.TransferReplicaRequest) (*commonpb.Status, error) {
 	log := log.Ctx(ctx).With(
 		zap.String("source", req.GetSourceResourceGroup()),
@@ -1105,7 +2535,6 @@ func (s *Server) TransferReplica(ctx context.Context, req *querypb.TransferRepli
 		return merr.Status(err), nil
 	}
 
-	// TODO: !!!WARNING, replica manager and resource manager doesn't protected with each other by lock.
 	if ok := s.meta.ResourceManager.ContainResourceGroup(req.GetSourceResourceGroup()); !ok {
 		err := merr.WrapErrResourceGroupNotFound(req.GetSourceResourceGroup())
 		return merr.Status(errors.Wrap(err,
@@ -1118,9 +2547,72 @@ func (s *Server) TransferReplica(ctx context.Context, req *querypb.TransferRepli
 			fmt.Sprintf("the target resource group[%s] doesn't exist", req.GetTargetResourceGroup()))), nil
 	}
 
-	// Apply change into replica manager.
+	if !req.GetForce() {
+		err := s.checkTransferReplicaCapacity(ctx, req.GetCollectionID(), req.GetTargetResourceGroup(), req.GetNumReplica())
+		if err != nil {
+			log.Warn("failed to transfer replica between resource group", zap.Error(err))
+			return merr.Status(err), nil
+		}
+	}
+
+	// Apply change into replica manager, the resource groups are rechecked under lock.
 	err := s.meta.TransferReplica(req.GetCollectionID(), req.GetSourceResourceGroup(), req.GetTargetResourceGroup(), int(req.GetNumReplica()))
-	return merr.Status(err), nil
+	if err != nil {
+		return merr.Status(err), nil
+	}
+	// the cached shard leaders may be filtered by the old resource groups
+	s.invalidateShardLeaderCache(req.GetCollectionID())
+	return merr.Success(), nil
+}
+
+// MoveCollectionToResourceGroup moves all replicas of the collection into the target resource group at once,
+// and recovers the replica nodes with the new resource group.
+func (s *Server) MoveCollectionToResourceGroup(ctx context.Context, req *querypb.MoveCollectionToResourceGroupRequest) (*querypb.MoveCollectionToResourceGroupResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("target", req.GetTargetResourceGroup()),
+	)
+
+	log.Info("move collection to resource group request received")
+	errMsg := "failed to move collection to resource group"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.MoveCollectionToResourceGroupResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+
+	collection := s.meta.CollectionManager.GetCollection(req.GetCollectionID())
+	if collection == nil {
+		err := merr.WrapErrCollectionNotLoaded(req.GetCollectionID())
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.MoveCollectionToResourceGroupResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	// the nodes reserved by other tenants can't hold the replicas of the tenant
+	reservedNodeNum := 0
+	if collection.GetTenant() != "" {
+		reservedNodeNum = s.meta.ReplicaManager.GetTenantReservedNodeNum(collection.GetTenant(), req.GetTargetResourceGroup())
+	}
+	// the resource group is checked and the replicas are moved under lock
+	if err := s.meta.MoveCollectionReplicas(req.GetCollectionID(), req.GetTargetResourceGroup(), reservedNodeNum); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.MoveCollectionToResourceGroupResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+	utils.RecoverReplicaOfCollection(s.meta, req.GetCollectionID())
+	s.invalidateShardLeaderCache(req.GetCollectionID())
+
+	replicas := s.meta.ReplicaManager.GetByCollection(req.GetCollectionID())
+	return &querypb.MoveCollectionToResourceGroupResponse{
+		Status: merr.Success(),
+		Replicas: lo.Map(replicas, func(replica *meta.Replica, _ int) *milvuspb.ReplicaInfo {
+			return s.fillReplicaInfo(replica, false)
+		}),
+	}, nil
 }
 
 func (s *Server) ListResourceGroups(ctx context.Context, req *milvuspb.ListResourceGroupsRequest) (*milvuspb.ListResourceGroupsResponse, error) {
@@ -1191,6 +2683,7 @@ func (s *Server) DescribeResourceGroup(ctx context.Context, req *querypb.Describ
 	}
 
 	nodes := make([]*commonpb.NodeInfo, 0, len(rg.GetNodes()))
+	suspendedNodes := make([]int64, 0)
 	for _, nodeID := range rg.GetNodes() {
 		nodeSessionInfo := s.nodeMgr.Get(nodeID)
 		// Filter offline nodes and nodes in stopping state
@@ -1200,6 +2693,9 @@ func (s *Server) DescribeResourceGroup(ctx context.Context, req *querypb.Describ
 				Address:  nodeSessionInfo.Addr(),
 				Hostname: nodeSessionInfo.Hostname(),
 			})
+			if nodeSessionInfo.GetState() == session.NodeStateSuspend {
+				suspendedNodes = append(suspendedNodes, nodeID)
+			}
 		}
 	}
 
@@ -1212,6 +2708,120 @@ func (s *Server) DescribeResourceGroup(ctx context.Context, req *querypb.Describ
 		NumIncomingNode:  incomingNodes,
 		Config:           rg.GetConfig(),
 		Nodes:            nodes,
+		SuspendedNodes:   suspendedNodes,
+		NodeSelector:     rg.GetNodeSelector(),
+		NumNode:          int32(rg.NodeNum()),
+		NumMissingNode:   int32(rg.MissingNumOfNodes()),
+		NumRedundantNode: int32(rg.RedundantNumOfNodes()),
+	}
+	if req.GetVerbose() {
+		replicaIDs := lo.Map(replicasInRG, func(replica *meta.Replica, _ int) int64 { return replica.GetID() })
+		collectionIDs := lo.Keys(loadedReplicas)
+		sort.Slice(replicaIDs, func(i, j int) bool { return replicaIDs[i] < replicaIDs[j] })
+		sort.Slice(collectionIDs, func(i, j int) bool { return collectionIDs[i] < collectionIDs[j] })
+		resp.ResourceGroup.ReplicaIDs = replicaIDs
+		resp.ResourceGroup.CollectionIDs = collectionIDs
+	}
+	return resp, nil
+}
+
+func (s *Server) GetResourceGroupDrift(ctx context.Context, req *querypb.GetResourceGroupDriftRequest) (*querypb.GetResourceGroupDriftResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.String("rgName", req.GetResourceGroup()),
+	)
+
+	log.Info("get resource group drift request received")
+	errMsg := "failed to get resource group drift"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.GetResourceGroupDriftResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+
+	rgNames := s.meta.ResourceManager.ListResourceGroups()
+	if req.GetResourceGroup() != "" {
+		rgNames = []string{req.GetResourceGroup()}
+	}
+
+	drifts := make([]*querypb.ResourceGroupDrift, 0, len(rgNames))
+	for _, rgName := range rgNames {
+		rg := s.meta.ResourceManager.GetResourceGroup(rgName)
+		if rg == nil {
+			err := merr.WrapErrResourceGroupNotFound(rgName)
+			log.Warn(errMsg, zap.Error(err))
+			return &querypb.GetResourceGroupDriftResponse{
+				Status: merr.Status(err),
+			}, nil
+		}
+		drifts = append(drifts, &querypb.ResourceGroupDrift{
+			ResourceGroup:    rgName,
+			RequestedNodeNum: rg.GetConfig().GetRequests().GetNodeNum(),
+			LimitNodeNum:     rg.GetConfig().GetLimits().GetNodeNum(),
+			ActualNodeNum:    int32(rg.NodeNum()),
+			WithinConfig:     rg.MissingNumOfNodes() == 0 && rg.RedundantNumOfNodes() == 0,
+		})
+	}
+
+	return &querypb.GetResourceGroupDriftResponse{
+		Status: merr.Success(),
+		Drifts: drifts,
+	}, nil
+}
+
+// GetResourceGroupUtilization returns the nodes and the segment memory hosted of resource groups,
+// along with the fraction of the memory capacity used.
+func (s *Server) GetResourceGroupUtilization(ctx context.Context, req *querypb.GetResourceGroupUtilizationRequest) (*querypb.GetResourceGroupUtilizationResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Strings("rgNames", req.GetResourceGroups()),
+	)
+
+	log.Info("get resource group utilization request received")
+	errMsg := "failed to get resource group utilization"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.GetResourceGroupUtilizationResponse{
+			Status: merr.Status(errors.Wrap(err, errMsg)),
+		}, nil
+	}
+
+	rgNames := req.GetResourceGroups()
+	if len(rgNames) == 0 {
+		rgNames = s.meta.ResourceManager.ListResourceGroups()
+		sort.Strings(rgNames)
+	}
+
+	utilizations := make([]*querypb.ResourceGroupUtilization, 0, len(rgNames))
+	for _, rgName := range rgNames {
+		utilization, err := s.getResourceGroupUtilization(ctx, rgName)
+		if err != nil {
+			log.Warn(errMsg, zap.Error(err))
+			return &querypb.GetResourceGroupUtilizationResponse{
+				Status: merr.Status(err),
+			}, nil
+		}
+		utilizations = append(utilizations, utilization)
+	}
+
+	return &querypb.GetResourceGroupUtilizationResponse{
+		Status:       merr.Success(),
+		Utilizations: utilizations,
+	}, nil
+}
+
+func (s *Server) GetClusterLoadSummary(ctx context.Context, req *querypb.GetClusterLoadSummaryRequest) (*querypb.GetClusterLoadSummaryResponse, error) {
+	log := log.Ctx(ctx)
+
+	log.Info("get cluster load summary request received")
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		msg := "failed to get cluster load summary"
+		log.Warn(msg, zap.Error(err))
+		return &querypb.GetClusterLoadSummaryResponse{
+			Status: merr.Status(errors.Wrap(err, msg)),
+		}, nil
 	}
+
+	resp := s.getClusterLoadSummary()
+	resp.Status = merr.Success()
 	return resp, nil
 }
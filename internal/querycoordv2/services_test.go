@@ -19,7 +19,11 @@ package querycoordv2
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"math"
 	"sort"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -27,9 +31,12 @@ import (
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"go.uber.org/atomic"
+	"google.golang.org/grpc"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/rgpb"
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
@@ -206,6 +213,8 @@ func (suite *ServiceSuite) SetupTest() {
 		distController:      suite.distController,
 		ctx:                 context.Background(),
 	}
+	suite.server.checkerController = checkers.NewCheckerController(suite.meta, suite.dist,
+		suite.targetMgr, suite.balancer, suite.nodeMgr, suite.taskScheduler, suite.broker)
 
 	suite.server.UpdateStateCode(commonpb.StateCode_Healthy)
 }
@@ -254,6 +263,391 @@ func (suite *ServiceSuite) TestShowCollections() {
 	suite.Equal(resp.GetStatus().GetCode(), merr.Code(merr.ErrServiceNotReady))
 }
 
+func (suite *ServiceSuite) TestGetLoadState() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+	suite.broker.ExpectedCalls = nil
+
+	// loaded and loading collections
+	suite.updateCollectionStatus(1000, querypb.LoadStatus_Loaded)
+	suite.updateCollectionStatus(1001, querypb.LoadStatus_Loading)
+	resp, err := server.GetLoadState(ctx, &querypb.GetLoadStateRequest{CollectionID: 1000})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Equal(commonpb.LoadState_LoadStateLoaded, resp.GetState())
+	suite.Nil(resp.GetLoadError())
+	resp, err = server.GetLoadState(ctx, &querypb.GetLoadStateRequest{CollectionID: 1001})
+	suite.NoError(err)
+	suite.Equal(commonpb.LoadState_LoadStateLoading, resp.GetState())
+
+	// collection not exist
+	suite.broker.EXPECT().DescribeCollection(mock.Anything, int64(999)).
+		Return(nil, merr.WrapErrCollectionNotFound(999))
+	resp, err = server.GetLoadState(ctx, &querypb.GetLoadStateRequest{CollectionID: 999})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Equal(commonpb.LoadState_LoadStateNotExist, resp.GetState())
+
+	// collection not loaded
+	suite.broker.EXPECT().DescribeCollection(mock.Anything, int64(998)).
+		Return(nil, nil)
+	resp, err = server.GetLoadState(ctx, &querypb.GetLoadStateRequest{CollectionID: 998})
+	suite.NoError(err)
+	suite.Equal(commonpb.LoadState_LoadStateNotLoad, resp.GetState())
+
+	// failed load reported as loading with the cached error
+	meta.GlobalFailedLoadCache.Put(998, merr.WrapErrServiceMemoryLimitExceeded(100, 10))
+	defer meta.GlobalFailedLoadCache.Remove(998)
+	resp, err = server.GetLoadState(ctx, &querypb.GetLoadStateRequest{CollectionID: 998})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Equal(commonpb.LoadState_LoadStateLoading, resp.GetState())
+	suite.ErrorIs(merr.Error(resp.GetLoadError()), merr.ErrServiceMemoryLimitExceeded)
+
+	// broker failed
+	suite.broker.EXPECT().DescribeCollection(mock.Anything, int64(997)).
+		Return(nil, merr.WrapErrServiceInternal("mock"))
+	resp, err = server.GetLoadState(ctx, &querypb.GetLoadStateRequest{CollectionID: 997})
+	suite.NoError(err)
+	suite.False(merr.Ok(resp.GetStatus()))
+
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err = server.GetLoadState(ctx, &querypb.GetLoadStateRequest{CollectionID: 1000})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+}
+
+func (suite *ServiceSuite) TestShowCollectionsWithPagination() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	// page through all collections in the order of id
+	collections := make([]int64, 0, len(suite.collections))
+	req := &querypb.ShowCollectionsRequest{
+		PageSize: 1,
+	}
+	for {
+		resp, err := server.ShowCollections(ctx, req)
+		suite.NoError(err)
+		suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		suite.Len(resp.GetCollectionIDs(), 1)
+		suite.Len(resp.GetInMemoryPercentages(), 1)
+		collections = append(collections, resp.GetCollectionIDs()...)
+		if resp.GetNextPageToken() == "" {
+			break
+		}
+		req.PageToken = resp.GetNextPageToken()
+	}
+	suite.Equal(suite.sortInt64(append([]int64(nil), suite.collections...)), collections)
+
+	// page size larger than the collection number
+	resp, err := server.ShowCollections(ctx, &querypb.ShowCollectionsRequest{
+		PageSize: int64(len(suite.collections) + 1),
+	})
+	suite.NoError(err)
+	suite.Len(resp.GetCollectionIDs(), len(suite.collections))
+	suite.Empty(resp.GetNextPageToken())
+
+	// the released collection is skipped
+	released := collections[0]
+	colBak := suite.meta.CollectionManager.GetCollection(released)
+	suite.NoError(suite.meta.CollectionManager.RemoveCollection(released))
+	resp, err = server.ShowCollections(ctx, &querypb.ShowCollectionsRequest{
+		PageSize: 1,
+	})
+	suite.NoError(err)
+	suite.Equal([]int64{collections[1]}, resp.GetCollectionIDs())
+	suite.NoError(suite.meta.CollectionManager.PutCollection(colBak))
+
+	// invalid page token
+	resp, err = server.ShowCollections(ctx, &querypb.ShowCollectionsRequest{
+		PageSize:  1,
+		PageToken: "invalid",
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+}
+
+func (suite *ServiceSuite) TestShowCollectionsWithReplicaDetail() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	collection := suite.collections[1]
+	replicas := suite.meta.ReplicaManager.GetByCollection(collection)
+	suite.Len(replicas, 3)
+	sort.Slice(replicas, func(i, j int) bool {
+		return replicas[i].GetID() < replicas[j].GetID()
+	})
+
+	// replica 0 loads all segments, replica 1 loads half of them, replica 2 loads nothing
+	segments := make([]*meta.Segment, 0)
+	for partition, ids := range suite.segments[collection] {
+		for _, id := range ids {
+			segments = append(segments, utils.CreateTestSegment(collection, partition, id, 0, 1, "test-channel"))
+		}
+	}
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].GetID() < segments[j].GetID()
+	})
+	full := lo.Map(segments, func(segment *meta.Segment, _ int) *meta.Segment {
+		segment = segment.Clone()
+		segment.Node = replicas[0].GetNodes()[0]
+		return segment
+	})
+	half := lo.Map(segments[:len(segments)/2], func(segment *meta.Segment, _ int) *meta.Segment {
+		segment = segment.Clone()
+		segment.Node = replicas[1].GetNodes()[0]
+		return segment
+	})
+	suite.dist.SegmentDistManager.Update(replicas[0].GetNodes()[0], full...)
+	suite.dist.SegmentDistManager.Update(replicas[1].GetNodes()[0], half...)
+
+	req := &querypb.ShowCollectionsRequest{
+		CollectionIDs:     []int64{collection},
+		WithReplicaDetail: true,
+	}
+	resp, err := server.ShowCollections(ctx, req)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	suite.Len(resp.GetReplicaPercentages(), 1)
+	percentages := resp.GetReplicaPercentages()[0].GetPercentages()
+	suite.Len(percentages, 3)
+	suite.EqualValues(100, percentages[replicas[0].GetID()])
+	suite.EqualValues(50, percentages[replicas[1].GetID()])
+	suite.EqualValues(0, percentages[replicas[2].GetID()])
+
+	// the replica without available node is regarded as not loaded
+	for _, node := range replicas[0].GetNodes() {
+		suite.nodeMgr.Remove(node)
+	}
+	resp, err = server.ShowCollections(ctx, req)
+	suite.NoError(err)
+	suite.EqualValues(0, resp.GetReplicaPercentages()[0].GetPercentages()[replicas[0].GetID()])
+
+	// not set without the flag
+	req.WithReplicaDetail = false
+	resp, err = server.ShowCollections(ctx, req)
+	suite.NoError(err)
+	suite.Empty(resp.GetReplicaPercentages())
+}
+
+func (suite *ServiceSuite) TestShowCollectionsWithEstimatedRemaining() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+	meta.GlobalLoadThroughputRecorder = meta.NewLoadThroughputRecorder()
+
+	collection := suite.collections[0]
+	suite.updateCollectionStatus(collection, querypb.LoadStatus_Loading)
+	req := &querypb.ShowCollectionsRequest{
+		CollectionIDs: []int64{collection},
+	}
+
+	// no load history
+	suite.Equal(time.Duration(0), server.estimateLoadTime(collection))
+	resp, err := server.ShowCollections(ctx, req)
+	suite.NoError(err)
+	suite.Equal([]int64{0}, resp.GetEstimatedRemainingMs())
+
+	meta.GlobalLoadThroughputRecorder.SetEstimate(collection, time.Hour)
+	resp, err = server.ShowCollections(ctx, req)
+	suite.NoError(err)
+	suite.Len(resp.GetEstimatedRemainingMs(), 1)
+	suite.Greater(resp.GetEstimatedRemainingMs()[0], int64(0))
+
+	// loaded collection has nothing remaining
+	suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+	resp, err = server.ShowCollections(ctx, req)
+	suite.NoError(err)
+	suite.Equal([]int64{0}, resp.GetEstimatedRemainingMs())
+}
+
+func (suite *ServiceSuite) TestGetLoadInfo() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	for _, collection := range suite.collections {
+		suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+		resp, err := server.GetLoadInfo(ctx, &querypb.GetLoadInfoRequest{
+			CollectionId: collection,
+		})
+		suite.NoError(err)
+		suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		suite.Equal(suite.loadTypes[collection], resp.GetLoadType())
+		suite.EqualValues(100, resp.GetLoadPercentage())
+		suite.Equal(suite.replicaNumber[collection], resp.GetReplicaNumber())
+		suite.Equal([]string{meta.DefaultResourceGroupName}, resp.GetResourceGroups())
+		suite.ElementsMatch(suite.partitions[collection], resp.GetPartitions())
+		suite.True(resp.GetReadable())
+	}
+
+	// collection not loaded
+	resp, err := server.GetLoadInfo(ctx, &querypb.GetLoadInfoRequest{
+		CollectionId: 999,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrCollectionNotLoaded)
+
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err = server.GetLoadInfo(ctx, &querypb.GetLoadInfoRequest{
+		CollectionId: suite.collections[0],
+	})
+	suite.NoError(err)
+	suite.Equal(merr.Code(merr.ErrServiceNotReady), resp.GetStatus().GetCode())
+}
+
+func (suite *ServiceSuite) TestReleaseSegments() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	collection := suite.collections[0]
+	srcNode := suite.meta.ReplicaManager.GetByCollection(collection)[0].GetNodes()[0]
+	suite.updateSegmentDist(collection, srcNode)
+	suite.NotNil(suite.targetMgr.GetSealedSegment(collection, 1, meta.CurrentTarget))
+
+	suite.taskScheduler.ExpectedCalls = make([]*mock.Call, 0)
+	suite.taskScheduler.EXPECT().Add(mock.Anything).Run(func(t task.Task) {
+		actions := t.Actions()
+		suite.Len(actions, 1)
+		suite.Equal(task.ActionTypeReduce, actions[0].Type())
+		suite.Equal(srcNode, actions[0].Node())
+		suite.EqualValues(1, t.(*task.SegmentTask).SegmentID())
+		t.Cancel(nil)
+	}).Return(nil).Once()
+	resp, err := server.ReleaseSegments(ctx, &querypb.ReleaseSegmentsRequest{
+		CollectionID: collection,
+		SegmentIDs:   []int64{1},
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp))
+	suite.taskScheduler.AssertExpectations(suite.T())
+	// the released segment is removed from target to avoid reloading
+	suite.Nil(suite.targetMgr.GetSealedSegment(collection, 1, meta.CurrentTarget))
+	suite.NotNil(suite.targetMgr.GetSealedSegment(collection, 2, meta.CurrentTarget))
+
+	// segment not in current target
+	resp, err = server.ReleaseSegments(ctx, &querypb.ReleaseSegmentsRequest{
+		CollectionID: collection,
+		SegmentIDs:   []int64{1},
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrSegmentNotFound)
+
+	// empty segment list
+	resp, err = server.ReleaseSegments(ctx, &querypb.ReleaseSegmentsRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrParameterInvalid)
+
+	// collection not loaded
+	resp, err = server.ReleaseSegments(ctx, &querypb.ReleaseSegmentsRequest{
+		CollectionID: 999,
+		SegmentIDs:   []int64{1},
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrCollectionNotLoaded)
+
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err = server.ReleaseSegments(ctx, &querypb.ReleaseSegmentsRequest{
+		CollectionID: collection,
+		SegmentIDs:   []int64{2},
+	})
+	suite.NoError(err)
+	suite.Equal(merr.Code(merr.ErrServiceNotReady), resp.GetCode())
+}
+
+type mockWatchCollectionsServer struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses chan *querypb.WatchCollectionsResponse
+}
+
+func (s *mockWatchCollectionsServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockWatchCollectionsServer) Send(resp *querypb.WatchCollectionsResponse) error {
+	s.responses <- resp
+	return nil
+}
+
+func (suite *ServiceSuite) TestWatchCollections() {
+	suite.loadAll()
+	server := suite.server
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &mockWatchCollectionsServer{
+		ctx:       ctx,
+		responses: make(chan *querypb.WatchCollectionsResponse, 16),
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- server.WatchCollections(&querypb.WatchCollectionsRequest{}, stream)
+	}()
+
+	// initial snapshot
+	resp := <-stream.responses
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.True(resp.GetSnapshot())
+	suite.Equal(suite.collections, lo.Map(resp.GetStates(), func(state *querypb.CollectionLoadState, _ int) int64 {
+		return state.GetCollectionID()
+	}))
+
+	// only the changed states are pushed after release
+	suite.NoError(suite.meta.CollectionManager.RemoveCollection(suite.collections[0]))
+	suite.Eventually(func() bool {
+		select {
+		case resp := <-stream.responses:
+			suite.False(resp.GetSnapshot())
+			return lo.ContainsBy(resp.GetStates(), func(state *querypb.CollectionLoadState) bool {
+				return state.GetCollectionID() == suite.collections[0] && state.GetReleased()
+			})
+		default:
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the stream ends once the client disconnects
+	cancel()
+	suite.NoError(<-errCh)
+
+	// the stream ends once the query coord stops
+	serverCtx := server.ctx
+	defer func() { server.ctx = serverCtx }()
+	stopCtx, stop := context.WithCancel(context.Background())
+	server.ctx = stopCtx
+	stream = &mockWatchCollectionsServer{
+		ctx:       context.Background(),
+		responses: make(chan *querypb.WatchCollectionsResponse, 16),
+	}
+	go func() {
+		errCh <- server.WatchCollections(&querypb.WatchCollectionsRequest{}, stream)
+	}()
+	resp = <-stream.responses
+	suite.True(resp.GetSnapshot())
+	stop()
+	suite.ErrorIs(<-errCh, merr.ErrServiceUnavailable)
+
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	stream = &mockWatchCollectionsServer{
+		ctx:       context.Background(),
+		responses: make(chan *querypb.WatchCollectionsResponse, 1),
+	}
+	suite.NoError(server.WatchCollections(&querypb.WatchCollectionsRequest{}, stream))
+	resp = <-stream.responses
+	suite.Equal(merr.Code(merr.ErrServiceNotReady), resp.GetStatus().GetCode())
+}
+
 func (suite *ServiceSuite) TestShowPartitions() {
 	suite.loadAll()
 	ctx := context.Background()
@@ -325,6 +719,94 @@ func (suite *ServiceSuite) TestShowPartitions() {
 	suite.Equal(resp.GetStatus().GetCode(), merr.Code(merr.ErrServiceNotReady))
 }
 
+func (suite *ServiceSuite) TestShowPartitionsWithFailureReasons() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	// partition 102 failed and removed, partition 103 still loading
+	collection := int64(1001)
+	suite.NoError(suite.meta.CollectionManager.RemovePartition(collection, 102))
+	meta.GlobalFailedLoadCache.PutPartition(collection, 102, merr.WrapErrServiceMemoryLimitExceeded(100, 10))
+	defer meta.GlobalFailedLoadCache.Remove(collection)
+
+	req := &querypb.ShowPartitionsRequest{
+		CollectionID:       collection,
+		PartitionIDs:       []int64{102, 103},
+		WithFailureReasons: true,
+	}
+	resp, err := server.ShowPartitions(ctx, req)
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Equal([]int64{103}, resp.GetPartitionIDs())
+	suite.Len(resp.GetInMemoryPercentages(), 1)
+	suite.Len(resp.GetRefreshProgress(), 1)
+	suite.Len(resp.GetFailures(), 1)
+	suite.EqualValues(102, resp.GetFailures()[0].GetPartitionID())
+	suite.ErrorIs(merr.Error(resp.GetFailures()[0].GetReason()), merr.ErrServiceMemoryLimitExceeded)
+
+	// fails the whole request without failure reasons
+	req.WithFailureReasons = false
+	resp, err = server.ShowPartitions(ctx, req)
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrPartitionNotLoaded)
+
+	// the partition not loaded without failure recorded
+	meta.GlobalFailedLoadCache.Remove(collection)
+	req.WithFailureReasons = true
+	resp, err = server.ShowPartitions(ctx, req)
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrPartitionNotLoaded)
+}
+
+func (suite *ServiceSuite) TestShowPartitionsWithPartitionStatus() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	// partition 102 failed and removed, partition 103 still loading
+	collection := int64(1001)
+	suite.NoError(suite.meta.CollectionManager.RemovePartition(collection, 102))
+	meta.GlobalFailedLoadCache.PutPartition(collection, 102, merr.WrapErrServiceMemoryLimitExceeded(100, 10))
+	defer meta.GlobalFailedLoadCache.Remove(collection)
+
+	req := &querypb.ShowPartitionsRequest{
+		CollectionID:        collection,
+		PartitionIDs:        []int64{102, 103},
+		WithPartitionStatus: true,
+	}
+	resp, err := server.ShowPartitions(ctx, req)
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Equal([]int64{102, 103}, resp.GetPartitionIDs())
+	suite.Len(resp.GetInMemoryPercentages(), 2)
+	suite.EqualValues(0, resp.GetInMemoryPercentages()[0])
+	suite.Len(resp.GetRefreshProgress(), 2)
+	suite.Len(resp.GetPartitionStatus(), 2)
+	suite.ErrorIs(merr.Error(resp.GetPartitionStatus()[0]), merr.ErrServiceMemoryLimitExceeded)
+	suite.True(merr.Ok(resp.GetPartitionStatus()[1]))
+
+	// fails the whole request if all partitions failed
+	req.PartitionIDs = []int64{102}
+	resp, err = server.ShowPartitions(ctx, req)
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrServiceMemoryLimitExceeded)
+
+	// the partition not loaded without failure recorded
+	meta.GlobalFailedLoadCache.Remove(collection)
+	req.PartitionIDs = []int64{102, 103}
+	resp, err = server.ShowPartitions(ctx, req)
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.ErrorIs(merr.Error(resp.GetPartitionStatus()[0]), merr.ErrPartitionNotLoaded)
+
+	// the partition status and the failure reasons can't be reported together
+	req.WithFailureReasons = true
+	resp, err = server.ShowPartitions(ctx, req)
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+}
+
 func (suite *ServiceSuite) TestLoadCollection() {
 	ctx := context.Background()
 	server := suite.server
@@ -363,6 +845,137 @@ func (suite *ServiceSuite) TestLoadCollection() {
 	suite.Equal(resp.GetCode(), merr.Code(merr.ErrServiceNotReady))
 }
 
+func (suite *ServiceSuite) TestLoadCollectionMemoryAdmission() {
+	ctx := context.Background()
+	server := suite.server
+	collection := suite.collections[0]
+
+	paramtable.Get().Save(Params.QueryCoordCfg.LoadMemoryHeadroomRatio.Key, "0.2")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.LoadMemoryHeadroomRatio.Key)
+	paramtable.Get().Save(Params.QueryCoordCfg.LoadAdmissionRetryInterval.Key, "0.01")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.LoadAdmissionRetryInterval.Key)
+
+	// no query node reports memory, the load is admitted
+	getMetricsCall := suite.cluster.EXPECT().GetMetrics(mock.Anything, mock.Anything, mock.Anything).Return(nil, merr.ErrServiceNotReady)
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, collection).Return(nil, nil, nil).Once()
+	suite.NoError(server.checkLoadMemoryAdmission(ctx, collection, nil, 1))
+	getMetricsCall.Unset()
+
+	// the cluster has 10 nodes, the memory limit is 8000 bytes and 5000 bytes have been used
+	suite.mockNodeMemory(1000, 500)
+	recoveryInfoCall := suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, collection).Return(nil, []*datapb.SegmentInfo{
+		{
+			ID: 1,
+			Binlogs: []*datapb.FieldBinlog{
+				{Binlogs: []*datapb.Binlog{{LogSize: 4000}}},
+			},
+		},
+	}, nil)
+
+	resp, err := server.LoadCollection(ctx, &querypb.LoadCollectionRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrServiceMemoryLimitExceeded)
+	suite.False(server.meta.CollectionManager.Exist(collection))
+
+	// wait until the context is done
+	waitCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	resp, err = server.LoadCollection(waitCtx, &querypb.LoadCollectionRequest{
+		CollectionID: collection,
+		AutoRetry:    true,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrServiceMemoryLimitExceeded)
+
+	paramtable.Get().Save(Params.QueryCoordCfg.LoadMemoryHeadroomRatio.Key, "0.05")
+	recoveryInfoCall.Unset()
+	suite.expectGetRecoverInfo(collection)
+	suite.expectLoadPartitions()
+	resp, err = server.LoadCollection(ctx, &querypb.LoadCollectionRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp))
+	suite.assertLoaded(collection)
+}
+
+func (suite *ServiceSuite) TestCreateResourceGroupWithAutoFill() {
+	ctx := context.Background()
+	server := suite.server
+	defaultNodeNum := suite.meta.ResourceManager.GetResourceGroup(meta.DefaultResourceGroupName).NodeNum()
+
+	// create without auto fill
+	resp, err := server.CreateResourceGroupWithAutoFill(ctx, &querypb.CreateResourceGroupWithAutoFillRequest{
+		ResourceGroup: "rg1",
+		Config: &rgpb.ResourceGroupConfig{
+			Requests: &rgpb.ResourceGroupLimit{NodeNum: 2},
+			Limits:   &rgpb.ResourceGroupLimit{NodeNum: 2},
+		},
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.EqualValues(0, resp.GetPlacedNodeNum())
+	suite.Equal(0, suite.meta.ResourceManager.GetResourceGroup("rg1").NodeNum())
+
+	// create with auto fill
+	resp, err = server.CreateResourceGroupWithAutoFill(ctx, &querypb.CreateResourceGroupWithAutoFillRequest{
+		ResourceGroup: "rg2",
+		Config: &rgpb.ResourceGroupConfig{
+			Requests: &rgpb.ResourceGroupLimit{NodeNum: 3},
+			Limits:   &rgpb.ResourceGroupLimit{NodeNum: 3},
+		},
+		AutoFill: true,
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.EqualValues(3, resp.GetPlacedNodeNum())
+	suite.Equal(3, suite.meta.ResourceManager.GetResourceGroup("rg2").NodeNum())
+	suite.Equal(defaultNodeNum-3, suite.meta.ResourceManager.GetResourceGroup(meta.DefaultResourceGroupName).NodeNum())
+
+	// the conflicting config fails before filling
+	resp, err = server.CreateResourceGroupWithAutoFill(ctx, &querypb.CreateResourceGroupWithAutoFillRequest{
+		ResourceGroup: "rg2",
+		Config: &rgpb.ResourceGroupConfig{
+			Requests: &rgpb.ResourceGroupLimit{NodeNum: 4},
+			Limits:   &rgpb.ResourceGroupLimit{NodeNum: 4},
+		},
+		AutoFill: true,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrResourceGroupAlreadyExist)
+
+	// no spare node matches the node selector
+	resp, err = server.CreateResourceGroupWithAutoFill(ctx, &querypb.CreateResourceGroupWithAutoFillRequest{
+		ResourceGroup: "rg_selector",
+		Config: &rgpb.ResourceGroupConfig{
+			Requests: &rgpb.ResourceGroupLimit{NodeNum: 1},
+			Limits:   &rgpb.ResourceGroupLimit{NodeNum: 1},
+		},
+		AutoFill:     true,
+		NodeSelector: map[string]string{"zone": "az_not_exist"},
+	})
+	suite.NoError(err)
+	suite.NoError(merr.Error(resp.GetStatus()))
+	suite.EqualValues(0, resp.GetPlacedNodeNum())
+	describeResp, err := server.DescribeResourceGroup(ctx, &querypb.DescribeResourceGroupRequest{
+		ResourceGroup: "rg_selector",
+	})
+	suite.NoError(err)
+	suite.NoError(merr.Error(describeResp.GetStatus()))
+	suite.Equal(map[string]string{"zone": "az_not_exist"}, describeResp.GetResourceGroup().GetNodeSelector())
+
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err = server.CreateResourceGroupWithAutoFill(ctx, &querypb.CreateResourceGroupWithAutoFillRequest{
+		ResourceGroup: "rg3",
+		AutoFill:      true,
+	})
+	suite.NoError(err)
+	suite.Equal(merr.Code(merr.ErrServiceNotReady), resp.GetStatus().GetCode())
+}
+
 func (suite *ServiceSuite) TestResourceGroup() {
 	ctx := context.Background()
 	server := suite.server
@@ -459,16 +1072,72 @@ func (suite *ServiceSuite) TestResourceGroup() {
 	suite.Equal(map[int64]int32{1: 1}, resp2.GetResourceGroup().GetNumLoadedReplica())
 	suite.Equal(map[int64]int32{2: 1}, resp2.GetResourceGroup().GetNumIncomingNode())
 	suite.Equal(map[int64]int32{1: 1}, resp2.GetResourceGroup().GetNumOutgoingNode())
-
-	dropRG := &milvuspb.DropResourceGroupRequest{
-		ResourceGroup: "rg1",
-	}
-
-	resp3, err := server.DropResourceGroup(ctx, dropRG)
+	suite.Empty(resp2.GetResourceGroup().GetSuspendedNodes())
+	suite.Equal(int32(2), resp2.GetResourceGroup().GetNumNode())
+	suite.Zero(resp2.GetResourceGroup().GetNumMissingNode())
+	suite.Zero(resp2.GetResourceGroup().GetNumRedundantNode())
+	suite.Empty(resp2.GetResourceGroup().GetReplicaIDs())
+	suite.Empty(resp2.GetResourceGroup().GetCollectionIDs())
+
+	// list replicas and collections in verbose mode
+	resp2, err = server.DescribeResourceGroup(ctx, &querypb.DescribeResourceGroupRequest{
+		ResourceGroup: "rg11",
+		Verbose:       true,
+	})
 	suite.NoError(err)
-	suite.Equal(commonpb.ErrorCode_Success, resp3.ErrorCode)
-
-	resp4, err := server.ListResourceGroups(ctx, listRG)
+	suite.Equal([]int64{1}, resp2.GetResourceGroup().GetReplicaIDs())
+	suite.Equal([]int64{1}, resp2.GetResourceGroup().GetCollectionIDs())
+
+	// report the difference between current and min/max node number
+	suite.NoError(server.meta.ResourceManager.UpdateResourceGroups(map[string]*rgpb.ResourceGroupConfig{
+		"rg11": {
+			Requests: &rgpb.ResourceGroupLimit{NodeNum: 3},
+			Limits:   &rgpb.ResourceGroupLimit{NodeNum: 4},
+		},
+		"rg12": {
+			Requests: &rgpb.ResourceGroupLimit{NodeNum: 1},
+			Limits:   &rgpb.ResourceGroupLimit{NodeNum: 1},
+		},
+	}))
+	resp2, err = server.DescribeResourceGroup(ctx, describeRG)
+	suite.NoError(err)
+	suite.Equal(int32(2), resp2.GetResourceGroup().GetNumNode())
+	suite.Equal(int32(1), resp2.GetResourceGroup().GetNumMissingNode())
+	suite.Zero(resp2.GetResourceGroup().GetNumRedundantNode())
+	resp2, err = server.DescribeResourceGroup(ctx, &querypb.DescribeResourceGroupRequest{
+		ResourceGroup: "rg12",
+	})
+	suite.NoError(err)
+	suite.Zero(resp2.GetResourceGroup().GetNumMissingNode())
+	suite.Equal(int32(1), resp2.GetResourceGroup().GetNumRedundantNode())
+	suite.NoError(server.meta.ResourceManager.UpdateResourceGroups(map[string]*rgpb.ResourceGroupConfig{
+		"rg11": {
+			Requests: &rgpb.ResourceGroupLimit{NodeNum: 2},
+			Limits:   &rgpb.ResourceGroupLimit{NodeNum: 2},
+		},
+		"rg12": {
+			Requests: &rgpb.ResourceGroupLimit{NodeNum: 2},
+			Limits:   &rgpb.ResourceGroupLimit{NodeNum: 2},
+		},
+	}))
+
+	// suspended node is reported
+	suite.NoError(server.nodeMgr.Suspend(1011))
+	resp2, err = server.DescribeResourceGroup(ctx, describeRG)
+	suite.NoError(err)
+	suite.Equal([]int64{1011}, resp2.GetResourceGroup().GetSuspendedNodes())
+	suite.Equal(int32(2), resp2.GetResourceGroup().GetNumAvailableNode())
+	suite.NoError(server.nodeMgr.Resume(1011))
+
+	dropRG := &milvuspb.DropResourceGroupRequest{
+		ResourceGroup: "rg1",
+	}
+
+	resp3, err := server.DropResourceGroup(ctx, dropRG)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp3.ErrorCode)
+
+	resp4, err := server.ListResourceGroups(ctx, listRG)
 	suite.NoError(err)
 	suite.Equal(commonpb.ErrorCode_Success, resp4.GetStatus().GetErrorCode())
 	suite.Len(resp4.GetResourceGroups(), 3)
@@ -521,6 +1190,269 @@ func (suite *ServiceSuite) TestResourceGroupFailed() {
 	suite.ErrorIs(merr.Error(resp5.GetStatus()), merr.ErrServiceNotReady)
 }
 
+func (suite *ServiceSuite) TestMoveCollectionToResourceGroup() {
+	ctx := context.Background()
+	server := suite.server
+
+	// collection not loaded
+	resp, err := server.MoveCollectionToResourceGroup(ctx, &querypb.MoveCollectionToResourceGroupRequest{
+		CollectionID:        1,
+		TargetResourceGroup: "rg1",
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrCollectionNotLoaded)
+
+	server.meta.CollectionManager.PutCollection(utils.CreateTestCollection(1, 2))
+	for _, replicaID := range []int64{1, 2} {
+		server.meta.ReplicaManager.Put(meta.NewReplica(&querypb.Replica{
+			ID:            replicaID,
+			CollectionID:  1,
+			ResourceGroup: meta.DefaultResourceGroupName,
+		}, typeutil.NewUniqueSet()))
+	}
+
+	// resource group not found
+	resp, err = server.MoveCollectionToResourceGroup(ctx, &querypb.MoveCollectionToResourceGroupRequest{
+		CollectionID:        1,
+		TargetResourceGroup: "rg1",
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrResourceGroupNotFound)
+
+	// node not enough
+	server.meta.ResourceManager.AddResourceGroup("rg1", &rgpb.ResourceGroupConfig{
+		Requests: &rgpb.ResourceGroupLimit{NodeNum: 2},
+		Limits:   &rgpb.ResourceGroupLimit{NodeNum: 2},
+	})
+	server.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   1011,
+		Address:  "localhost",
+		Hostname: "localhost",
+	}))
+	server.meta.ResourceManager.HandleNodeUp(1011)
+	resp, err = server.MoveCollectionToResourceGroup(ctx, &querypb.MoveCollectionToResourceGroupRequest{
+		CollectionID:        1,
+		TargetResourceGroup: "rg1",
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrResourceGroupNodeNotEnough)
+	suite.Equal([]string{meta.DefaultResourceGroupName}, server.meta.ReplicaManager.GetResourceGroupByCollection(1).Collect())
+
+	server.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   1012,
+		Address:  "localhost",
+		Hostname: "localhost",
+	}))
+	server.meta.ResourceManager.HandleNodeUp(1012)
+	resp, err = server.MoveCollectionToResourceGroup(ctx, &querypb.MoveCollectionToResourceGroupRequest{
+		CollectionID:        1,
+		TargetResourceGroup: "rg1",
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Len(resp.GetReplicas(), 2)
+	nodes := typeutil.NewUniqueSet()
+	for _, replica := range resp.GetReplicas() {
+		suite.Equal("rg1", replica.GetResourceGroupName())
+		suite.Len(replica.GetNodeIds(), 1)
+		nodes.Insert(replica.GetNodeIds()...)
+	}
+	suite.ElementsMatch([]int64{1011, 1012}, nodes.Collect())
+
+	// server unhealthy
+	server.UpdateStateCode(commonpb.StateCode_Abnormal)
+	resp, err = server.MoveCollectionToResourceGroup(ctx, &querypb.MoveCollectionToResourceGroupRequest{
+		CollectionID:        1,
+		TargetResourceGroup: "rg1",
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+}
+
+func (suite *ServiceSuite) TestGetResourceGroupDrift() {
+	ctx := context.Background()
+	server := suite.server
+
+	server.meta.ResourceManager.AddResourceGroup("rg1", &rgpb.ResourceGroupConfig{
+		Requests: &rgpb.ResourceGroupLimit{NodeNum: 2},
+		Limits:   &rgpb.ResourceGroupLimit{NodeNum: 3},
+	})
+	server.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   1011,
+		Address:  "localhost",
+		Hostname: "localhost",
+	}))
+	server.meta.ResourceManager.HandleNodeUp(1011)
+
+	resp, err := server.GetResourceGroupDrift(ctx, &querypb.GetResourceGroupDriftRequest{
+		ResourceGroup: "rg1",
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Len(resp.GetDrifts(), 1)
+	suite.Equal("rg1", resp.GetDrifts()[0].GetResourceGroup())
+	suite.Equal(int32(2), resp.GetDrifts()[0].GetRequestedNodeNum())
+	suite.Equal(int32(3), resp.GetDrifts()[0].GetLimitNodeNum())
+	suite.Equal(int32(1), resp.GetDrifts()[0].GetActualNodeNum())
+	suite.False(resp.GetDrifts()[0].GetWithinConfig())
+
+	server.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   1012,
+		Address:  "localhost",
+		Hostname: "localhost",
+	}))
+	server.meta.ResourceManager.HandleNodeUp(1012)
+	resp, err = server.GetResourceGroupDrift(ctx, &querypb.GetResourceGroupDriftRequest{
+		ResourceGroup: "rg1",
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Equal(int32(2), resp.GetDrifts()[0].GetActualNodeNum())
+	suite.True(resp.GetDrifts()[0].GetWithinConfig())
+
+	// omit resource group name
+	resp, err = server.GetResourceGroupDrift(ctx, &querypb.GetResourceGroupDriftRequest{})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.ElementsMatch(server.meta.ResourceManager.ListResourceGroups(),
+		lo.Map(resp.GetDrifts(), func(drift *querypb.ResourceGroupDrift, _ int) string { return drift.GetResourceGroup() }))
+
+	// resource group not found
+	resp, err = server.GetResourceGroupDrift(ctx, &querypb.GetResourceGroupDriftRequest{
+		ResourceGroup: "rg2",
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrResourceGroupNotFound)
+
+	// server unhealthy
+	server.UpdateStateCode(commonpb.StateCode_Abnormal)
+	resp, err = server.GetResourceGroupDrift(ctx, &querypb.GetResourceGroupDriftRequest{})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+}
+
+func (suite *ServiceSuite) TestGetResourceGroupUtilization() {
+	ctx := context.Background()
+	server := suite.server
+
+	server.meta.ResourceManager.AddResourceGroup("rg1", &rgpb.ResourceGroupConfig{
+		Requests: &rgpb.ResourceGroupLimit{NodeNum: 1},
+		Limits:   &rgpb.ResourceGroupLimit{NodeNum: 1},
+	})
+	server.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   1011,
+		Address:  "localhost",
+		Hostname: "localhost",
+	}))
+	server.meta.ResourceManager.HandleNodeUp(1011)
+
+	// two segments of 100 bytes on the node
+	segments := make([]*meta.Segment, 0)
+	for _, id := range []int64{1, 2} {
+		segment := utils.CreateTestSegment(1, 1, id, 1011, 1, "test-channel")
+		segment.Binlogs = []*datapb.FieldBinlog{
+			{Binlogs: []*datapb.Binlog{{LogSize: 100}}},
+		}
+		segments = append(segments, segment)
+	}
+	suite.dist.SegmentDistManager.Update(1011, segments...)
+	suite.mockNodeMemory(1000, 0)
+
+	resp, err := server.GetResourceGroupUtilization(ctx, &querypb.GetResourceGroupUtilizationRequest{
+		ResourceGroups: []string{"rg1"},
+	})
+	suite.NoError(err)
+	suite.NoError(merr.Error(resp.GetStatus()))
+	suite.Len(resp.GetUtilizations(), 1)
+	utilization := resp.GetUtilizations()[0]
+	suite.Equal("rg1", utilization.GetResourceGroup())
+	suite.Equal(int32(1), utilization.GetNumNode())
+	suite.Equal(int64(200), utilization.GetSegmentMemorySize())
+	suite.Equal(int64(1000), utilization.GetMemoryCapacity())
+	suite.InDelta(0.2, utilization.GetUsedRatio(), 0.0001)
+
+	// all resource groups
+	resp, err = server.GetResourceGroupUtilization(ctx, &querypb.GetResourceGroupUtilizationRequest{})
+	suite.NoError(err)
+	suite.NoError(merr.Error(resp.GetStatus()))
+	suite.ElementsMatch(server.meta.ResourceManager.ListResourceGroups(),
+		lo.Map(resp.GetUtilizations(), func(u *querypb.ResourceGroupUtilization, _ int) string { return u.GetResourceGroup() }))
+
+	// resource group not found
+	resp, err = server.GetResourceGroupUtilization(ctx, &querypb.GetResourceGroupUtilizationRequest{
+		ResourceGroups: []string{"rg2"},
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrResourceGroupNotFound)
+
+	// server unhealthy
+	server.UpdateStateCode(commonpb.StateCode_Abnormal)
+	resp, err = server.GetResourceGroupUtilization(ctx, &querypb.GetResourceGroupUtilizationRequest{})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+}
+
+func (suite *ServiceSuite) TestTransferNodeByFraction() {
+	ctx := context.Background()
+	server := suite.server
+
+	server.resourceObserver = observers.NewResourceObserver(server.meta)
+	server.resourceObserver.Start()
+	defer server.resourceObserver.Stop()
+
+	err := server.meta.ResourceManager.AddResourceGroup("rg1", &rgpb.ResourceGroupConfig{
+		Requests: &rgpb.ResourceGroupLimit{NodeNum: 0},
+		Limits:   &rgpb.ResourceGroupLimit{NodeNum: 0},
+	})
+	suite.NoError(err)
+	req := &querypb.TransferNodeByFractionRequest{
+		SourceResourceGroup: meta.DefaultResourceGroupName,
+		TargetResourceGroup: "rg1",
+	}
+
+	// invalid fraction
+	for _, fraction := range []float64{0, -0.5, 1.5, 0.01} {
+		req.Fraction = fraction
+		resp, err := server.TransferNodeByFraction(ctx, req)
+		suite.NoError(err)
+		suite.ErrorIs(merr.Error(resp), merr.ErrParameterInvalid)
+	}
+
+	// 2.5 nodes rounds to 3 nodes
+	req.Fraction = 0.25
+	resp, err := server.TransferNodeByFraction(ctx, req)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.ErrorCode)
+	suite.Eventually(func() bool {
+		nodes, err := server.meta.ResourceManager.GetNodes("rg1")
+		return err == nil && len(nodes) == 3
+	}, 5*time.Second, 100*time.Millisecond)
+
+	// computed node number exceeds available nodes
+	nodes, err := server.meta.ResourceManager.GetNodes(meta.DefaultResourceGroupName)
+	suite.NoError(err)
+	suite.Len(nodes, len(suite.nodes)-3)
+	for _, node := range nodes[:4] {
+		suite.nodeMgr.Remove(node)
+	}
+	req.Fraction = 0.5
+	resp, err = server.TransferNodeByFraction(ctx, req)
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrParameterInvalid)
+
+	// resource group not found
+	req.SourceResourceGroup = "rg_not_exist"
+	resp, err = server.TransferNodeByFraction(ctx, req)
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrResourceGroupNotFound)
+
+	// server unhealthy
+	server.UpdateStateCode(commonpb.StateCode_Abnormal)
+	resp, err = server.TransferNodeByFraction(ctx, req)
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrServiceNotReady)
+}
+
 func (suite *ServiceSuite) TestTransferNode() {
 	ctx := context.Background()
 	server := suite.server
@@ -833,55 +1765,405 @@ func (suite *ServiceSuite) TestTransferReplica() {
 	suite.ErrorIs(merr.Error(resp), merr.ErrServiceNotReady)
 }
 
-func (suite *ServiceSuite) TestLoadCollectionFailed() {
+func (suite *ServiceSuite) TestTransferReplicaOverCapacity() {
 	suite.loadAll()
 	ctx := context.Background()
 	server := suite.server
 
-	// Test load with different replica number
-	for _, collection := range suite.collections {
-		req := &querypb.LoadCollectionRequest{
-			CollectionID:  collection,
-			ReplicaNumber: suite.replicaNumber[collection] + 1,
+	// each segment of the collection takes 100 bytes
+	collection := suite.collections[0]
+	vChannels := lo.Map(suite.channels[collection], func(channel string, _ int) *datapb.VchannelInfo {
+		return &datapb.VchannelInfo{
+			CollectionID: collection,
+			ChannelName:  channel,
+		}
+	})
+	segmentInfos := make([]*datapb.SegmentInfo, 0)
+	for partition, segments := range suite.segments[collection] {
+		for _, segment := range segments {
+			segmentInfos = append(segmentInfos, &datapb.SegmentInfo{
+				ID:            segment,
+				InsertChannel: suite.channels[collection][segment%2],
+				PartitionID:   partition,
+				CollectionID:  collection,
+				Binlogs: []*datapb.FieldBinlog{
+					{Binlogs: []*datapb.Binlog{{LogSize: 100}}},
+				},
+			})
 		}
-		resp, err := server.LoadCollection(ctx, req)
-		suite.NoError(err)
-		suite.ErrorIs(merr.Error(resp), merr.ErrParameterInvalid)
 	}
+	suite.broker.ExpectedCalls = nil
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, collection, mock.Anything, mock.Anything).
+		Return(vChannels, segmentInfos, nil)
+	suite.NoError(suite.targetMgr.UpdateCollectionNextTarget(collection))
+	suite.True(suite.targetMgr.UpdateCollectionCurrentTarget(collection))
 
-	req := &querypb.LoadCollectionRequest{
-		CollectionID:   0,
-		ReplicaNumber:  2,
-		ResourceGroups: []string{meta.DefaultResourceGroupName, "rg"},
+	suite.NoError(server.meta.ResourceManager.AddResourceGroup("rg1", &rgpb.ResourceGroupConfig{
+		Requests: &rgpb.ResourceGroupLimit{NodeNum: 1},
+		Limits:   &rgpb.ResourceGroupLimit{NodeNum: 1},
+	}))
+	server.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   1001,
+		Address:  "localhost",
+		Hostname: "localhost",
+	}))
+	server.meta.ResourceManager.HandleNodeUp(1001)
+
+	// headroom of rg1 is 900 - 850 = 50 bytes, less than the replica footprint
+	suite.mockNodeMemory(1000, 850)
+	req := &querypb.TransferReplicaRequest{
+		SourceResourceGroup: meta.DefaultResourceGroupName,
+		TargetResourceGroup: "rg1",
+		CollectionID:        collection,
+		NumReplica:          1,
 	}
-	resp, err := server.LoadCollection(ctx, req)
+	resp, err := server.TransferReplica(ctx, req)
 	suite.NoError(err)
-	suite.Equal(commonpb.ErrorCode_IllegalArgument, resp.ErrorCode)
+	suite.ErrorIs(merr.Error(resp), merr.ErrParameterInvalid)
+	suite.Empty(server.meta.GetByResourceGroup("rg1"))
+
+	// force to transfer
+	req.Force = true
+	resp, err = server.TransferReplica(ctx, req)
+	suite.NoError(err)
+	suite.NoError(merr.Error(resp))
+	suite.Len(server.meta.GetByResourceGroup("rg1"), 1)
+}
+
+func (suite *ServiceSuite) TestLoadCollectionWithDifferentReplicaNumber() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
 
-	// Test load with partitions loaded
 	for _, collection := range suite.collections {
-		if suite.loadTypes[collection] != querypb.LoadType_LoadPartition {
+		if suite.loadTypes[collection] != querypb.LoadType_LoadCollection {
 			continue
 		}
+		replicaNumber := suite.replicaNumber[collection]
 
+		// same replica number, no job is scheduled
 		req := &querypb.LoadCollectionRequest{
-			CollectionID: collection,
+			CollectionID:  collection,
+			ReplicaNumber: replicaNumber,
 		}
+		scheduler := server.jobScheduler
+		// the scheduler isn't started, the load would never finish if a job is scheduled
+		server.jobScheduler = job.NewScheduler()
 		resp, err := server.LoadCollection(ctx, req)
+		server.jobScheduler = scheduler
 		suite.NoError(err)
-		suite.Equal(commonpb.ErrorCode_IllegalArgument, resp.ErrorCode)
-	}
+		suite.Equal(commonpb.ErrorCode_Success, resp.ErrorCode)
+		suite.Len(suite.meta.ReplicaManager.GetByCollection(collection), int(replicaNumber))
 
-	// Test load with wrong rg num
-	for _, collection := range suite.collections {
-		req := &querypb.LoadCollectionRequest{
-			CollectionID:   collection,
-			ReplicaNumber:  suite.replicaNumber[collection] + 1,
-			ResourceGroups: []string{"rg1", "rg2"},
-		}
-		resp, err := server.LoadCollection(ctx, req)
+		// increase replicas
+		req.ReplicaNumber = replicaNumber + 1
+		resp, err = server.LoadCollection(ctx, req)
 		suite.NoError(err)
-		suite.Equal(commonpb.ErrorCode_IllegalArgument, resp.ErrorCode)
+		suite.Equal(commonpb.ErrorCode_Success, resp.ErrorCode)
+		suite.Len(suite.meta.ReplicaManager.GetByCollection(collection), int(replicaNumber+1))
+		suite.Equal(replicaNumber+1, suite.meta.GetCollection(collection).GetReplicaNumber())
+
+		// retry is safe
+		resp, err = server.LoadCollection(ctx, req)
+		suite.NoError(err)
+		suite.Equal(commonpb.ErrorCode_Success, resp.ErrorCode)
+		suite.Len(suite.meta.ReplicaManager.GetByCollection(collection), int(replicaNumber+1))
+
+		// decrease replicas
+		req.ReplicaNumber = replicaNumber
+		resp, err = server.LoadCollection(ctx, req)
+		suite.NoError(err)
+		suite.ErrorIs(merr.Error(resp), merr.ErrParameterInvalid)
+		suite.Len(suite.meta.ReplicaManager.GetByCollection(collection), int(replicaNumber+1))
+	}
+}
+
+func (suite *ServiceSuite) TestCheckReplicaSpread() {
+	// spread evenly
+	suite.NoError(checkReplicaSpread(&querypb.LoadCollectionRequest{
+		ReplicaNumber:        4,
+		ResourceGroups:       []string{"rg1", "rg2", "rg1", "rg2"},
+		SpreadReplicasEvenly: true,
+	}))
+
+	// not required
+	suite.NoError(checkReplicaSpread(&querypb.LoadCollectionRequest{
+		ReplicaNumber:  3,
+		ResourceGroups: []string{"rg1", "rg1", "rg2"},
+	}))
+
+	// replica number not divisible
+	err := checkReplicaSpread(&querypb.LoadCollectionRequest{
+		ReplicaNumber:        3,
+		ResourceGroups:       []string{"rg1", "rg1", "rg2"},
+		SpreadReplicasEvenly: true,
+	})
+	suite.ErrorIs(err, merr.ErrParameterInvalid)
+
+	// uneven resource groups
+	err = checkReplicaSpread(&querypb.LoadCollectionRequest{
+		ReplicaNumber:        4,
+		ResourceGroups:       []string{"rg1", "rg1", "rg1", "rg2"},
+		SpreadReplicasEvenly: true,
+	})
+	suite.ErrorIs(err, merr.ErrParameterInvalid)
+
+	// resource group number mismatches the replica number
+	err = checkReplicaSpread(&querypb.LoadCollectionRequest{
+		ReplicaNumber:        3,
+		ResourceGroups:       []string{"rg1", "rg2"},
+		SpreadReplicasEvenly: true,
+	})
+	suite.ErrorIs(err, merr.ErrParameterInvalid)
+	suite.True(errors.Is(err, utils.ErrUseWrongNumRG))
+	suite.ErrorIs(merr.Error(merr.Status(err)), merr.ErrParameterInvalid)
+
+	// rejected by LoadCollection
+	resp, err := suite.server.LoadCollection(context.Background(), &querypb.LoadCollectionRequest{
+		CollectionID:         1000,
+		ReplicaNumber:        3,
+		ResourceGroups:       []string{"rg1", "rg1", "rg2"},
+		SpreadReplicasEvenly: true,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrParameterInvalid)
+}
+
+func (suite *ServiceSuite) TestLoadCollectionWithSegmentNodeHints() {
+	ctx := context.Background()
+	server := suite.server
+
+	// the hinted node is in the default resource group
+	suite.NoError(server.checkSegmentNodeHints(&querypb.LoadCollectionRequest{
+		CollectionID:     1000,
+		SegmentNodeHints: map[int64]int64{1: suite.nodes[0]},
+	}))
+
+	// the hinted node is out of the resource groups
+	resp, err := server.LoadCollection(ctx, &querypb.LoadCollectionRequest{
+		CollectionID:     1000,
+		ReplicaNumber:    1,
+		SegmentNodeHints: map[int64]int64{1: 999},
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrParameterInvalid)
+
+	// the hints of the loaded collection are checked against the resource groups of its replicas
+	suite.loadAll()
+	suite.NoError(server.meta.ResourceManager.AddResourceGroup("rg1", &rgpb.ResourceGroupConfig{
+		Requests: &rgpb.ResourceGroupLimit{NodeNum: 0},
+		Limits:   &rgpb.ResourceGroupLimit{NodeNum: 0},
+	}))
+	suite.NoError(server.checkSegmentNodeHints(&querypb.LoadCollectionRequest{
+		CollectionID:     1000,
+		ResourceGroups:   []string{"rg1"},
+		SegmentNodeHints: map[int64]int64{1: suite.nodes[0]},
+	}))
+}
+
+func (suite *ServiceSuite) TestLoadCollectionWithZeroReplicaNumber() {
+	ctx := context.Background()
+	server := suite.server
+
+	// one replica per resource group
+	config := &rgpb.ResourceGroupConfig{
+		Requests: &rgpb.ResourceGroupLimit{NodeNum: 0},
+		Limits:   &rgpb.ResourceGroupLimit{NodeNum: 0},
+	}
+	suite.NoError(server.meta.ResourceManager.AddResourceGroup("rg1", config))
+	suite.NoError(server.meta.ResourceManager.AddResourceGroup("rg2", config))
+	req := &querypb.LoadCollectionRequest{
+		CollectionID:   1000,
+		ResourceGroups: []string{"rg1", "rg2"},
+	}
+	suite.NoError(server.resolveReplicaNumber(req))
+	suite.EqualValues(2, req.GetReplicaNumber())
+
+	// unknown resource group
+	resp, err := server.LoadCollection(ctx, &querypb.LoadCollectionRequest{
+		CollectionID:   1000,
+		ResourceGroups: []string{"rg1", "rg3"},
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrResourceGroupNotFound)
+
+	// duplicated resource groups
+	resp, err = server.LoadCollection(ctx, &querypb.LoadCollectionRequest{
+		CollectionID:   1000,
+		ResourceGroups: []string{"rg1", "rg1"},
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrParameterInvalid)
+
+	// no primary replica left
+	resp, err = server.LoadCollection(ctx, &querypb.LoadCollectionRequest{
+		CollectionID:         1000,
+		ResourceGroups:       []string{"rg1", "rg2"},
+		StandbyReplicaNumber: 2,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrParameterInvalid)
+
+	// invalid default replica number
+	paramtable.Get().Save(Params.QueryCoordCfg.DefaultReplicaNumber.Key, "0")
+	resp, err = server.LoadCollection(ctx, &querypb.LoadCollectionRequest{
+		CollectionID: 1000,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrParameterInvalid)
+	paramtable.Get().Reset(Params.QueryCoordCfg.DefaultReplicaNumber.Key)
+
+	// negative replica number
+	resp, err = server.LoadCollection(ctx, &querypb.LoadCollectionRequest{
+		CollectionID:  1000,
+		ReplicaNumber: -1,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrParameterInvalid)
+
+	// the default replica number
+	suite.expectGetRecoverInfo(1000)
+	suite.expectLoadPartitions()
+	resp, err = server.LoadCollection(ctx, &querypb.LoadCollectionRequest{
+		CollectionID: 1000,
+	})
+	suite.NoError(err)
+	suite.NoError(merr.Error(resp))
+	suite.EqualValues(1, suite.meta.GetReplicaNumber(1000))
+
+	// the replica number of the loaded collection
+	paramtable.Get().Save(Params.QueryCoordCfg.DefaultReplicaNumber.Key, "2")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.DefaultReplicaNumber.Key)
+	req = &querypb.LoadCollectionRequest{
+		CollectionID:   1000,
+		ResourceGroups: []string{"rg1", "rg2"},
+	}
+	suite.NoError(server.resolveReplicaNumber(req))
+	suite.EqualValues(1, req.GetReplicaNumber())
+	resp, err = server.LoadCollection(ctx, &querypb.LoadCollectionRequest{
+		CollectionID: 1000,
+	})
+	suite.NoError(err)
+	suite.NoError(merr.Error(resp))
+	suite.EqualValues(1, suite.meta.GetReplicaNumber(1000))
+}
+
+func (suite *ServiceSuite) TestLoadPartitionsWithZeroReplicaNumber() {
+	ctx := context.Background()
+	server := suite.server
+
+	// one replica per resource group
+	config := &rgpb.ResourceGroupConfig{
+		Requests: &rgpb.ResourceGroupLimit{NodeNum: 0},
+		Limits:   &rgpb.ResourceGroupLimit{NodeNum: 0},
+	}
+	suite.NoError(server.meta.ResourceManager.AddResourceGroup("rg1", config))
+	suite.NoError(server.meta.ResourceManager.AddResourceGroup("rg2", config))
+	req := &querypb.LoadPartitionsRequest{
+		CollectionID:   1001,
+		PartitionIDs:   suite.partitions[1001],
+		ResourceGroups: []string{"rg1", "rg2"},
+	}
+	suite.NoError(server.resolvePartitionReplicaNumber(req))
+	suite.EqualValues(2, req.GetReplicaNumber())
+
+	// unknown resource group
+	resp, err := server.LoadPartitions(ctx, &querypb.LoadPartitionsRequest{
+		CollectionID:   1001,
+		PartitionIDs:   suite.partitions[1001],
+		ResourceGroups: []string{"rg1", "rg3"},
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrResourceGroupNotFound)
+
+	// negative replica number
+	resp, err = server.LoadPartitions(ctx, &querypb.LoadPartitionsRequest{
+		CollectionID:  1001,
+		PartitionIDs:  suite.partitions[1001],
+		ReplicaNumber: -1,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrParameterInvalid)
+
+	// the default replica number
+	paramtable.Get().Save(Params.QueryCoordCfg.DefaultReplicaNumber.Key, "2")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.DefaultReplicaNumber.Key)
+	suite.expectGetRecoverInfo(1001)
+	suite.expectLoadPartitions()
+	resp, err = server.LoadPartitions(ctx, &querypb.LoadPartitionsRequest{
+		CollectionID: 1001,
+		PartitionIDs: suite.partitions[1001][:1],
+	})
+	suite.NoError(err)
+	suite.NoError(merr.Error(resp))
+	suite.EqualValues(2, suite.meta.GetReplicaNumber(1001))
+
+	// the partitions share the replicas of the loaded collection
+	paramtable.Get().Save(Params.QueryCoordCfg.DefaultReplicaNumber.Key, "1")
+	req = &querypb.LoadPartitionsRequest{
+		CollectionID: 1001,
+		PartitionIDs: suite.partitions[1001][1:],
+	}
+	suite.NoError(server.resolvePartitionReplicaNumber(req))
+	suite.EqualValues(2, req.GetReplicaNumber())
+	resp, err = server.LoadPartitions(ctx, req)
+	suite.NoError(err)
+	suite.NoError(merr.Error(resp))
+	suite.EqualValues(2, suite.meta.GetReplicaNumber(1001))
+}
+
+func (suite *ServiceSuite) TestLoadCollectionFailed() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	// Test load partition loaded collection with different replica number
+	for _, collection := range suite.collections {
+		if suite.loadTypes[collection] != querypb.LoadType_LoadPartition {
+			continue
+		}
+		req := &querypb.LoadCollectionRequest{
+			CollectionID:  collection,
+			ReplicaNumber: suite.replicaNumber[collection] + 1,
+		}
+		resp, err := server.LoadCollection(ctx, req)
+		suite.NoError(err)
+		suite.ErrorIs(merr.Error(resp), merr.ErrParameterInvalid)
+	}
+
+	req := &querypb.LoadCollectionRequest{
+		CollectionID:   0,
+		ReplicaNumber:  2,
+		ResourceGroups: []string{meta.DefaultResourceGroupName, "rg"},
+	}
+	resp, err := server.LoadCollection(ctx, req)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_IllegalArgument, resp.ErrorCode)
+
+	// Test load with partitions loaded
+	for _, collection := range suite.collections {
+		if suite.loadTypes[collection] != querypb.LoadType_LoadPartition {
+			continue
+		}
+
+		req := &querypb.LoadCollectionRequest{
+			CollectionID: collection,
+		}
+		resp, err := server.LoadCollection(ctx, req)
+		suite.NoError(err)
+		suite.Equal(commonpb.ErrorCode_IllegalArgument, resp.ErrorCode)
+	}
+
+	// Test load with wrong rg num
+	for _, collection := range suite.collections {
+		req := &querypb.LoadCollectionRequest{
+			CollectionID:   collection,
+			ReplicaNumber:  suite.replicaNumber[collection] + 1,
+			ResourceGroups: []string{"rg1", "rg2"},
+		}
+		resp, err := server.LoadCollection(ctx, req)
+		suite.NoError(err)
+		suite.Equal(commonpb.ErrorCode_IllegalArgument, resp.ErrorCode)
 	}
 }
 
@@ -1020,6 +2302,142 @@ func (suite *ServiceSuite) TestReleaseCollection() {
 	suite.Equal(resp.GetCode(), merr.Code(merr.ErrServiceNotReady))
 }
 
+func (suite *ServiceSuite) TestCancelLoad() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	suite.cluster.EXPECT().ReleasePartitions(mock.Anything, mock.Anything, mock.Anything).
+		Return(merr.Success(), nil)
+
+	// cancel the loading collection
+	suite.updateCollectionStatus(1000, querypb.LoadStatus_Loading)
+	resp, err := server.CancelLoad(ctx, &querypb.CancelLoadRequest{CollectionID: 1000})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp))
+	suite.assertReleased(1000)
+	showResp, err := server.ShowCollections(ctx, &querypb.ShowCollectionsRequest{CollectionIDs: []int64{1000}})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(showResp.GetStatus()), merr.ErrCollectionLoadCanceled)
+
+	// cancel the loaded collection is a no-op
+	suite.updateCollectionStatus(1001, querypb.LoadStatus_Loaded)
+	resp, err = server.CancelLoad(ctx, &querypb.CancelLoadRequest{CollectionID: 1001})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp))
+	suite.True(suite.meta.Exist(1001))
+
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err = server.CancelLoad(ctx, &querypb.CancelLoadRequest{CollectionID: 1001})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrServiceNotReady)
+}
+
+func (suite *ServiceSuite) TestGetReleaseProgress() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	collection := suite.collections[0]
+	suite.updateChannelDist(collection)
+	channels := suite.dist.ChannelDistManager.GetByCollectionAndFilter(collection)
+	suite.Len(channels, 2)
+
+	// Test collection is not being released
+	resp, err := server.GetReleaseProgress(ctx, &querypb.GetReleaseProgressRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+
+	server.recordReleaseBaseline(collection)
+	defer server.releaseBaselines.Remove(collection)
+	resp, err = server.GetReleaseProgress(ctx, &querypb.GetReleaseProgressRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.EqualValues(2, resp.GetTotalChannels())
+	suite.EqualValues(2, resp.GetRemainingChannels())
+	suite.EqualValues(0, resp.GetProgress())
+	suite.False(resp.GetComplete())
+
+	// release channels one by one
+	suite.dist.ChannelDistManager.Update(channels[0].Node)
+	resp, err = server.GetReleaseProgress(ctx, &querypb.GetReleaseProgressRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.EqualValues(1, resp.GetRemainingChannels())
+	suite.EqualValues(50, resp.GetProgress())
+	suite.False(resp.GetComplete())
+
+	suite.dist.ChannelDistManager.Update(channels[1].Node)
+	resp, err = server.GetReleaseProgress(ctx, &querypb.GetReleaseProgressRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.EqualValues(0, resp.GetRemainingChannels())
+	suite.EqualValues(100, resp.GetProgress())
+	suite.True(resp.GetComplete())
+	// the progress read never drops the baseline
+	suite.True(server.releaseBaselines.Contain(collection))
+
+	// Test collection not loaded
+	resp, err = server.GetReleaseProgress(ctx, &querypb.GetReleaseProgressRequest{
+		CollectionID: 999,
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.True(resp.GetComplete())
+
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err = server.GetReleaseProgress(ctx, &querypb.GetReleaseProgressRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.Equal(resp.GetStatus().GetCode(), merr.Code(merr.ErrServiceNotReady))
+}
+
+func (suite *ServiceSuite) TestGetReleaseProgressAfterRelease() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	collection := suite.collections[0]
+	suite.updateChannelDist(collection)
+	channels := suite.dist.ChannelDistManager.GetByCollectionAndFilter(collection)
+	suite.Len(channels, 2)
+	suite.cluster.EXPECT().ReleasePartitions(mock.Anything, mock.Anything, mock.Anything).
+		Return(merr.Success(), nil)
+
+	// the query nodes release the channels while the release job waits
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		for _, channel := range channels {
+			suite.dist.ChannelDistManager.Update(channel.Node)
+		}
+	}()
+	status, err := server.ReleaseCollection(ctx, &querypb.ReleaseCollectionRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(status))
+
+	// the baseline expires once the release finishes
+	suite.False(server.releaseBaselines.Contain(collection))
+	resp, err := server.GetReleaseProgress(ctx, &querypb.GetReleaseProgressRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.EqualValues(0, resp.GetRemainingChannels())
+	suite.EqualValues(100, resp.GetProgress())
+	suite.True(resp.GetComplete())
+}
+
 func (suite *ServiceSuite) TestReleasePartition() {
 	suite.loadAll()
 	ctx := context.Background()
@@ -1063,13 +2481,14 @@ func (suite *ServiceSuite) TestReleasePartition() {
 }
 
 func (suite *ServiceSuite) TestRefreshCollection() {
+	ctx := context.Background()
 	server := suite.server
 
 	server.collectionObserver.Start()
 
 	// Test refresh all collections.
 	for _, collection := range suite.collections {
-		err := server.refreshCollection(collection)
+		err := server.refreshCollection(ctx, collection, false)
 		// Collection not loaded error.
 		suite.ErrorIs(err, merr.ErrCollectionNotLoaded)
 	}
@@ -1080,7 +2499,7 @@ func (suite *ServiceSuite) TestRefreshCollection() {
 	// Test refresh all collections again when collections are loaded. This time should fail with collection not 100% loaded.
 	for _, collection := range suite.collections {
 		suite.updateCollectionStatus(collection, querypb.LoadStatus_Loading)
-		err := server.refreshCollection(collection)
+		err := server.refreshCollection(ctx, collection, false)
 		suite.ErrorIs(err, merr.ErrCollectionNotLoaded)
 	}
 
@@ -1091,13 +2510,9 @@ func (suite *ServiceSuite) TestRefreshCollection() {
 		suite.updateSegmentDist(id, suite.nodes[0])
 		suite.updateCollectionStatus(id, querypb.LoadStatus_Loaded)
 
-		err := server.refreshCollection(id)
+		err := server.refreshCollection(ctx, id, true)
 		suite.NoError(err)
 
-		readyCh, err := server.targetObserver.UpdateNextTarget(id)
-		suite.NoError(err)
-		<-readyCh
-
 		// Now the refresh must be done
 		collection := server.meta.CollectionManager.GetCollection(id)
 		suite.True(collection.IsRefreshed())
@@ -1106,48 +2521,187 @@ func (suite *ServiceSuite) TestRefreshCollection() {
 	// Test refresh not ready
 	for _, id := range suite.collections {
 		suite.updateChannelDistWithoutSegment(id)
-		err := server.refreshCollection(id)
+		err := server.refreshCollection(ctx, id, false)
 		suite.NoError(err)
 
 		// Now the refresh must be not done
 		collection := server.meta.CollectionManager.GetCollection(id)
 		suite.False(collection.IsRefreshed())
 	}
+
+	// Test wait for refresh timeout
+	paramtable.Get().Save(Params.QueryCoordCfg.LoadTimeoutSeconds.Key, "1")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.LoadTimeoutSeconds.Key)
+	for _, id := range suite.collections {
+		err := server.refreshCollection(ctx, id, true)
+		suite.ErrorIs(err, context.DeadlineExceeded)
+		suite.Equal(merr.TimeoutCode, merr.Code(err))
+	}
 }
 
-func (suite *ServiceSuite) TestGetPartitionStates() {
+func (suite *ServiceSuite) TestRefreshProgress() {
 	suite.loadAll()
 	ctx := context.Background()
 	server := suite.server
+	collection := int64(1000)
 
-	// Test get partitions' state
-	for _, collection := range suite.collections {
-		req := &querypb.GetPartitionStatesRequest{
-			CollectionID: collection,
-			PartitionIDs: suite.partitions[collection],
+	// not refreshing
+	resp, err := server.ShowCollections(ctx, &querypb.ShowCollectionsRequest{CollectionIDs: []int64{collection}})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Equal([]int64{100}, resp.GetRefreshProgress())
+
+	// segments 11 and 12 are added into partition 100 in the next target
+	suite.broker.ExpectedCalls = nil
+	suite.broker.EXPECT().GetPartitions(mock.Anything, collection).Return(suite.partitions[collection], nil).Maybe()
+	vChannels := lo.Map(suite.channels[collection], func(channel string, _ int) *datapb.VchannelInfo {
+		return &datapb.VchannelInfo{CollectionID: collection, ChannelName: channel}
+	})
+	segmentInfos := make([]*datapb.SegmentInfo, 0)
+	suite.segments[collection][100] = append(suite.segments[collection][100], 11, 12)
+	defer func() {
+		suite.segments[collection][100] = suite.segments[collection][100][:2]
+	}()
+	for partition, segments := range suite.segments[collection] {
+		for _, segment := range segments {
+			segmentInfos = append(segmentInfos, &datapb.SegmentInfo{
+				ID:            segment,
+				InsertChannel: suite.channels[collection][segment%2],
+				PartitionID:   partition,
+				CollectionID:  collection,
+			})
 		}
-		resp, err := server.GetPartitionStates(ctx, req)
+	}
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, collection, mock.Anything, mock.Anything).
+		Return(vChannels, segmentInfos, nil).Maybe()
+	suite.NoError(suite.targetMgr.UpdateCollectionNextTarget(collection))
+	notifier := make(chan struct{})
+	suite.meta.CollectionManager.GetCollection(collection).SetRefreshNotifier(notifier)
+
+	showPartitions := func() []int64 {
+		resp, err := server.ShowPartitions(ctx, &querypb.ShowPartitionsRequest{
+			CollectionID: collection,
+			PartitionIDs: []int64{100, 101},
+		})
 		suite.NoError(err)
-		suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
-		suite.Len(resp.PartitionDescriptions, len(suite.partitions[collection]))
+		suite.True(merr.Ok(resp.GetStatus()))
+		return resp.GetRefreshProgress()
 	}
+	suite.Equal([]int64{0, 99}, showPartitions())
 
-	// Test when server is not healthy
-	server.UpdateStateCode(commonpb.StateCode_Initializing)
-	req := &querypb.GetPartitionStatesRequest{
-		CollectionID: suite.collections[0],
-	}
-	resp, err := server.GetPartitionStates(ctx, req)
+	node := suite.meta.ReplicaManager.GetByCollection(collection)[0].GetNodes()[0]
+	suite.dist.SegmentDistManager.Update(node, utils.CreateTestSegment(collection, 100, 11, node, 1, "1000-dmc1"))
+	suite.Equal([]int64{50, 99}, showPartitions())
+	resp, err = server.ShowCollections(ctx, &querypb.ShowCollectionsRequest{CollectionIDs: []int64{collection}})
 	suite.NoError(err)
-	suite.Equal(resp.GetStatus().GetCode(), merr.Code(merr.ErrServiceNotReady))
+	suite.Equal([]int64{50}, resp.GetRefreshProgress())
+
+	// all new segments are loaded, but the current target is not updated yet
+	suite.dist.SegmentDistManager.Update(node,
+		utils.CreateTestSegment(collection, 100, 11, node, 1, "1000-dmc1"),
+		utils.CreateTestSegment(collection, 100, 12, node, 1, "1000-dmc0"))
+	suite.Equal([]int64{99, 99}, showPartitions())
+
+	close(notifier)
+	suite.Equal([]int64{100, 100}, showPartitions())
+
+	// the collection is released during refresh
+	suite.Equal(int64(100), server.getRefreshProgress(nil))
 }
 
-func (suite *ServiceSuite) TestGetSegmentInfo() {
-	suite.loadAll()
+func (suite *ServiceSuite) TestSyncNewCreatedPartitions() {
 	ctx := context.Background()
 	server := suite.server
 
-	// Test get all segments
+	// collection not loaded, nothing to sync
+	resp, err := server.SyncNewCreatedPartitions(ctx, &querypb.SyncNewCreatedPartitionsRequest{
+		CollectionID: 888,
+		PartitionIDs: []int64{998, 999},
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Equal([]int64{998, 999}, resp.GetSyncedPartitionIDs())
+	suite.Empty(resp.GetFailures())
+
+	// no partition
+	resp, err = server.SyncNewCreatedPartitions(ctx, &querypb.SyncNewCreatedPartitionsRequest{
+		CollectionID: 888,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err = server.SyncNewCreatedPartitions(ctx, &querypb.SyncNewCreatedPartitionsRequest{
+		CollectionID: 888,
+		PartitionIDs: []int64{998},
+	})
+	suite.NoError(err)
+	suite.Equal(merr.Code(merr.ErrServiceNotReady), resp.GetStatus().GetCode())
+}
+
+func (suite *ServiceSuite) TestGetPartitionStates() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	// Test get partitions' state
+	for _, collection := range suite.collections {
+		req := &querypb.GetPartitionStatesRequest{
+			CollectionID: collection,
+			PartitionIDs: suite.partitions[collection],
+		}
+		resp, err := server.GetPartitionStates(ctx, req)
+		suite.NoError(err)
+		suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		suite.Len(resp.PartitionDescriptions, len(suite.partitions[collection]))
+	}
+
+	// Test the partitions loaded before the collection load report their own progress
+	for _, collection := range suite.collections {
+		if suite.loadTypes[collection] != querypb.LoadType_LoadCollection {
+			continue
+		}
+		suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+		partitions := suite.partitions[collection]
+		partition := suite.meta.GetPartition(partitions[0]).Clone()
+		partition.LoadPercentage = 50
+		suite.meta.PutPartition(partition)
+
+		req := &querypb.GetPartitionStatesRequest{
+			CollectionID: collection,
+			PartitionIDs: partitions,
+		}
+		resp, err := server.GetPartitionStates(ctx, req)
+		suite.NoError(err)
+		suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		for _, state := range resp.GetPartitionDescriptions() {
+			if state.GetPartitionID() == partitions[0] {
+				suite.Equal(querypb.PartitionState_PartialInMemory, state.GetState())
+				suite.EqualValues(50, state.GetInMemoryPercentage())
+			} else {
+				suite.Equal(querypb.PartitionState_InMemory, state.GetState())
+				suite.EqualValues(100, state.GetInMemoryPercentage())
+			}
+		}
+	}
+
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	req := &querypb.GetPartitionStatesRequest{
+		CollectionID: suite.collections[0],
+	}
+	resp, err := server.GetPartitionStates(ctx, req)
+	suite.NoError(err)
+	suite.Equal(resp.GetStatus().GetCode(), merr.Code(merr.ErrServiceNotReady))
+}
+
+func (suite *ServiceSuite) TestGetSegmentInfo() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	// Test get all segments
 	for i, collection := range suite.collections {
 		suite.updateSegmentDist(collection, int64(i))
 		req := &querypb.GetSegmentInfoRequest{
@@ -1181,12 +2735,102 @@ func (suite *ServiceSuite) TestGetSegmentInfo() {
 	suite.Equal(resp.GetStatus().GetCode(), merr.Code(merr.ErrServiceNotReady))
 }
 
-func (suite *ServiceSuite) TestLoadBalance() {
+func (suite *ServiceSuite) TestGetSegmentInfoByChannel() {
 	suite.loadAll()
 	ctx := context.Background()
 	server := suite.server
 
-	// Test get balance first segment
+	collection := int64(1000)
+	suite.dist.SegmentDistManager.Update(1,
+		utils.CreateTestSegment(collection, 100, 2, 1, 1, "1000-dmc0"),
+		utils.CreateTestSegment(collection, 100, 1, 1, 1, "1000-dmc0"),
+		utils.CreateTestSegment(collection, 101, 3, 1, 1, "1000-dmc1"),
+	)
+	suite.dist.SegmentDistManager.Update(2,
+		utils.CreateTestSegment(collection, 100, 1, 2, 1, "1000-dmc0"),
+	)
+
+	resp, err := server.GetSegmentInfo(ctx, &querypb.GetSegmentInfoRequest{
+		CollectionID: collection,
+		Channel:      "1000-dmc0",
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Len(resp.GetInfos(), 2)
+	suite.EqualValues(1, resp.GetInfos()[0].GetSegmentID())
+	suite.ElementsMatch([]int64{1, 2}, resp.GetInfos()[0].GetNodeIds())
+	suite.EqualValues(2, resp.GetInfos()[1].GetSegmentID())
+	suite.Equal("1000-dmc0", resp.GetInfos()[1].GetDmChannel())
+
+	// channel without segments
+	resp, err = server.GetSegmentInfo(ctx, &querypb.GetSegmentInfoRequest{
+		CollectionID: collection,
+		Channel:      "1000-dmc2",
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Empty(resp.GetInfos())
+}
+
+func (suite *ServiceSuite) TestGetSegmentInfoWithLoadPercentage() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	// segment 1, 2 loaded, segment 3 being loaded, segment 4 not loaded yet
+	collection := int64(1000)
+	suite.dist.SegmentDistManager.Update(1,
+		utils.CreateTestSegment(collection, 100, 1, 1, 1, "test-channel"),
+		utils.CreateTestSegment(collection, 100, 2, 1, 1, "test-channel"),
+	)
+	suite.dist.SegmentDistManager.UpdateLoading(1, &querypb.SegmentLoadingProgress{
+		SegmentID:   3,
+		Collection:  collection,
+		Partition:   101,
+		LoadedBytes: 30,
+		TotalBytes:  100,
+	})
+	defer suite.dist.SegmentDistManager.UpdateLoading(1)
+
+	expected := map[int64]int64{1: 100, 2: 100, 3: 30, 4: 0}
+	resp, err := server.GetSegmentInfo(ctx, &querypb.GetSegmentInfoRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Len(resp.GetInfos(), len(expected))
+	for _, info := range resp.GetInfos() {
+		suite.Equal(expected[info.GetSegmentID()], info.GetLoadPercentage(), "segment %d", info.GetSegmentID())
+	}
+
+	resp, err = server.GetSegmentInfo(ctx, &querypb.GetSegmentInfoRequest{
+		CollectionID: collection,
+		SegmentIDs:   []int64{3, 4},
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Len(resp.GetInfos(), 2)
+	for _, info := range resp.GetInfos() {
+		suite.Equal(expected[info.GetSegmentID()], info.GetLoadPercentage())
+		suite.EqualValues(101, info.GetPartitionID())
+		suite.Empty(info.GetNodeIds())
+	}
+
+	// segment neither in distribution nor in target
+	resp, err = server.GetSegmentInfo(ctx, &querypb.GetSegmentInfoRequest{
+		CollectionID: collection,
+		SegmentIDs:   []int64{999},
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrSegmentNotLoaded)
+}
+
+func (suite *ServiceSuite) TestDryRunLoadBalance() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+	suite.mockNodeMemory(1024*1024*1024, 0)
+
 	for _, collection := range suite.collections {
 		replicas := suite.meta.ReplicaManager.GetByCollection(collection)
 		nodes := replicas[0].GetNodes()
@@ -1201,57 +2845,209 @@ func (suite *ServiceSuite) TestLoadBalance() {
 			DstNodeIDs:       []int64{dstNode},
 			SealedSegmentIDs: segments,
 		}
+		// no task is submitted
 		suite.taskScheduler.ExpectedCalls = make([]*mock.Call, 0)
-		suite.taskScheduler.EXPECT().Add(mock.Anything).Run(func(task task.Task) {
-			actions := task.Actions()
-			suite.Len(actions, 2)
-			growAction, reduceAction := actions[0], actions[1]
-			suite.Equal(dstNode, growAction.Node())
-			suite.Equal(srcNode, reduceAction.Node())
-			task.Cancel(nil)
-		}).Return(nil)
-		resp, err := server.LoadBalance(ctx, req)
+		resp, err := server.DryRunLoadBalance(ctx, req)
 		suite.NoError(err)
-		suite.Equal(commonpb.ErrorCode_Success, resp.ErrorCode)
-		suite.taskScheduler.AssertExpectations(suite.T())
+		suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		suite.Len(resp.GetPlans(), len(segments))
+		for _, plan := range resp.GetPlans() {
+			suite.Contains(segments, plan.GetSegmentID())
+			suite.Equal(srcNode, plan.GetSourceNode())
+			suite.Equal(dstNode, plan.GetTargetNode())
+		}
+		suite.taskScheduler.AssertNotCalled(suite.T(), "Add", mock.Anything)
 	}
 
+	// the same validation as load balance
+	resp, err := server.DryRunLoadBalance(ctx, &querypb.LoadBalanceRequest{
+		CollectionID: suite.collections[0],
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+
 	// Test when server is not healthy
 	server.UpdateStateCode(commonpb.StateCode_Initializing)
-	req := &querypb.LoadBalanceRequest{
+	resp, err = server.DryRunLoadBalance(ctx, &querypb.LoadBalanceRequest{
 		CollectionID:  suite.collections[0],
 		SourceNodeIDs: []int64{1},
-		DstNodeIDs:    []int64{100 + 1},
+	})
+	suite.NoError(err)
+	suite.Equal(merr.Code(merr.ErrServiceNotReady), resp.GetStatus().GetCode())
+}
+
+func (suite *ServiceSuite) TestRebalanceCollection() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+	collection := suite.collections[0]
+	suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+	// balancer only moves segments in both current and next target
+	suite.expectGetRecoverInfo(collection)
+	suite.NoError(suite.targetMgr.UpdateCollectionNextTarget(collection))
+
+	// all segments are loaded on one node
+	nodes := suite.sortInt64(suite.meta.ReplicaManager.GetByCollection(collection)[0].GetNodes())
+	srcNode := nodes[0]
+	metaSegments := make([]*meta.Segment, 0)
+	for partition, segments := range suite.segments[collection] {
+		for _, segment := range segments {
+			s := utils.CreateTestSegment(collection, partition, segment, srcNode, 1, "test-channel")
+			s.NumOfRows = 100
+			metaSegments = append(metaSegments, s)
+		}
 	}
-	resp, err := server.LoadBalance(ctx, req)
+	suite.dist.SegmentDistManager.Update(srcNode, metaSegments...)
+
+	// dry run, no task is submitted
+	suite.taskScheduler.ExpectedCalls = make([]*mock.Call, 0)
+	resp, err := server.DryRunRebalanceCollection(ctx, &querypb.RebalanceCollectionRequest{
+		CollectionID: collection,
+	})
 	suite.NoError(err)
-	suite.Equal(resp.GetCode(), merr.Code(merr.ErrServiceNotReady))
+	suite.NoError(merr.Error(resp.GetStatus()))
+	suite.NotEmpty(resp.GetSegmentPlans())
+	for _, plan := range resp.GetSegmentPlans() {
+		suite.Equal(srcNode, plan.GetSourceNode())
+		suite.NotEqual(srcNode, plan.GetTargetNode())
+	}
+	suite.taskScheduler.AssertNotCalled(suite.T(), "Add", mock.Anything)
+
+	// collection is being balanced
+	suite.True(server.checkerController.StartManualBalance(collection))
+	resp, err = server.RebalanceCollection(ctx, &querypb.RebalanceCollectionRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrServiceUnavailable)
+	server.checkerController.FinishManualBalance(collection)
+
+	// execute the plans
+	suite.taskScheduler.EXPECT().GetTasksBySource(utils.BalanceChecker).Return(nil)
+	suite.taskScheduler.EXPECT().Add(mock.Anything).Run(func(task task.Task) {
+		actions := task.Actions()
+		suite.Len(actions, 2)
+		suite.Equal(srcNode, actions[1].Node())
+		task.Cancel(nil)
+	}).Return(nil)
+	resp, err = server.RebalanceCollection(ctx, &querypb.RebalanceCollectionRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.NoError(merr.Error(resp.GetStatus()))
+	suite.NotEmpty(resp.GetSegmentPlans())
+	suite.taskScheduler.AssertNumberOfCalls(suite.T(), "Add", len(resp.GetSegmentPlans()))
+	// auto balance is resumed after the manual balance
+	suite.True(server.checkerController.StartManualBalance(collection))
+	server.checkerController.FinishManualBalance(collection)
+
+	// collection not fully loaded
+	suite.updateCollectionStatus(collection, querypb.LoadStatus_Loading)
+	resp, err = server.RebalanceCollection(ctx, &querypb.RebalanceCollectionRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrCollectionNotFullyLoaded)
+	resp, err = server.DryRunRebalanceCollection(ctx, &querypb.RebalanceCollectionRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrCollectionNotFullyLoaded)
+
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err = server.RebalanceCollection(ctx, &querypb.RebalanceCollectionRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.Equal(merr.Code(merr.ErrServiceNotReady), resp.GetStatus().GetCode())
+	resp, err = server.DryRunRebalanceCollection(ctx, &querypb.RebalanceCollectionRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.Equal(merr.Code(merr.ErrServiceNotReady), resp.GetStatus().GetCode())
 }
 
-func (suite *ServiceSuite) TestLoadBalanceWithNoDstNode() {
+func (suite *ServiceSuite) TestBalanceResourceGroups() {
+	suite.loadAll()
+	server := suite.server
+	collection := suite.collections[0]
+	suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+	// balancer only moves segments in both current and next target
+	suite.expectGetRecoverInfo(collection)
+	suite.NoError(suite.targetMgr.UpdateCollectionNextTarget(collection))
+
+	// all segments are loaded on one node
+	replica := suite.meta.ReplicaManager.GetByCollection(collection)[0]
+	srcNode := suite.sortInt64(replica.GetNodes())[0]
+	metaSegments := make([]*meta.Segment, 0)
+	for partition, segments := range suite.segments[collection] {
+		for _, segment := range segments {
+			s := utils.CreateTestSegment(collection, partition, segment, srcNode, 1, "test-channel")
+			s.NumOfRows = 100
+			metaSegments = append(metaSegments, s)
+		}
+	}
+	suite.dist.SegmentDistManager.Update(srcNode, metaSegments...)
+
+	// balance is suspended
+	suite.taskScheduler.ExpectedCalls = make([]*mock.Call, 0)
+	suite.NoError(server.checkerController.Deactivate(utils.BalanceChecker))
+	server.balanceResourceGroups(replica.GetResourceGroup())
+	suite.taskScheduler.AssertNotCalled(suite.T(), "Add", mock.Anything)
+	suite.NoError(server.checkerController.Activate(utils.BalanceChecker))
+
+	// balance the collections of the resource group
+	added := atomic.NewInt32(0)
+	suite.taskScheduler.EXPECT().Add(mock.Anything).Run(func(task task.Task) {
+		actions := task.Actions()
+		suite.Len(actions, 2)
+		suite.Equal(srcNode, actions[1].Node())
+		added.Inc()
+		task.Cancel(nil)
+	}).Return(nil)
+	server.balanceResourceGroups(replica.GetResourceGroup())
+	suite.Eventually(func() bool {
+		return added.Load() > 0
+	}, 5*time.Second, 10*time.Millisecond)
+	// auto balance is resumed after the manual balance
+	suite.Eventually(func() bool {
+		if server.checkerController.StartManualBalance(collection) {
+			server.checkerController.FinishManualBalance(collection)
+			return true
+		}
+		return false
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func (suite *ServiceSuite) TestLoadBalance() {
 	suite.loadAll()
 	ctx := context.Background()
 	server := suite.server
+	suite.mockNodeMemory(1024*1024*1024, 0)
 
 	// Test get balance first segment
 	for _, collection := range suite.collections {
 		replicas := suite.meta.ReplicaManager.GetByCollection(collection)
 		nodes := replicas[0].GetNodes()
 		srcNode := nodes[0]
+		dstNode := nodes[1]
 		suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
 		suite.updateSegmentDist(collection, srcNode)
 		segments := suite.getAllSegments(collection)
 		req := &querypb.LoadBalanceRequest{
 			CollectionID:     collection,
 			SourceNodeIDs:    []int64{srcNode},
+			DstNodeIDs:       []int64{dstNode},
 			SealedSegmentIDs: segments,
 		}
 		suite.taskScheduler.ExpectedCalls = make([]*mock.Call, 0)
+		suite.taskScheduler.EXPECT().TrackBalance(mock.Anything).Return(int64(1)).Maybe()
 		suite.taskScheduler.EXPECT().Add(mock.Anything).Run(func(task task.Task) {
 			actions := task.Actions()
 			suite.Len(actions, 2)
 			growAction, reduceAction := actions[0], actions[1]
-			suite.Contains(nodes, growAction.Node())
+			suite.Equal(dstNode, growAction.Node())
 			suite.Equal(srcNode, reduceAction.Node())
 			task.Cancel(nil)
 		}).Return(nil)
@@ -1273,395 +3069,2355 @@ func (suite *ServiceSuite) TestLoadBalanceWithNoDstNode() {
 	suite.Equal(resp.GetCode(), merr.Code(merr.ErrServiceNotReady))
 }
 
-func (suite *ServiceSuite) TestLoadBalanceWithEmptySegmentList() {
+func (suite *ServiceSuite) TestGetLoadBalanceStatus() {
 	suite.loadAll()
 	ctx := context.Background()
 	server := suite.server
+	suite.mockNodeMemory(1024*1024*1024, 0)
 
-	srcNode := int64(1001)
-	dstNode := int64(1002)
-	metaSegments := make([]*meta.Segment, 0)
-	segmentOnCollection := make(map[int64][]int64)
-
-	// update two collection's dist
-	for _, collection := range suite.collections {
-		replicas := suite.meta.ReplicaManager.GetByCollection(collection)
-		replicas[0].AddRWNode(srcNode)
-		replicas[0].AddRWNode(dstNode)
-		suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+	// LoadBalance returns the balance operation ID
+	collection := suite.collections[0]
+	replicas := suite.meta.ReplicaManager.GetByCollection(collection)
+	nodes := replicas[0].GetNodes()
+	srcNode := nodes[0]
+	dstNode := nodes[1]
+	suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+	suite.updateSegmentDist(collection, srcNode)
+	suite.taskScheduler.ExpectedCalls = make([]*mock.Call, 0)
+	suite.taskScheduler.EXPECT().TrackBalance(mock.Anything).Return(int64(100))
+	suite.taskScheduler.EXPECT().Add(mock.Anything).Run(func(task task.Task) {
+		task.Cancel(nil)
+	}).Return(nil)
+	resp, err := server.LoadBalance(ctx, &querypb.LoadBalanceRequest{
+		CollectionID:     collection,
+		SourceNodeIDs:    []int64{srcNode},
+		DstNodeIDs:       []int64{dstNode},
+		SealedSegmentIDs: suite.getAllSegments(collection),
+	})
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.ErrorCode)
+	suite.Equal("100", resp.GetExtraInfo()[BalanceOperationIDKey])
+
+	// async LoadBalance returns the operation ID without waiting for the segment moves
+	suite.taskScheduler.ExpectedCalls = make([]*mock.Call, 0)
+	suite.taskScheduler.EXPECT().TrackBalance(mock.Anything).Return(int64(102))
+	added := make([]task.Task, 0)
+	suite.taskScheduler.EXPECT().Add(mock.Anything).Run(func(task task.Task) {
+		added = append(added, task)
+	}).Return(nil)
+	resp, err = server.LoadBalance(ctx, &querypb.LoadBalanceRequest{
+		CollectionID:     collection,
+		SourceNodeIDs:    []int64{srcNode},
+		DstNodeIDs:       []int64{dstNode},
+		SealedSegmentIDs: suite.getAllSegments(collection),
+		Async:            true,
+	})
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.ErrorCode)
+	suite.Equal("102", resp.GetExtraInfo()[BalanceOperationIDKey])
+	suite.NotEmpty(added)
+	for _, t := range added {
+		suite.Equal(task.TaskStatusStarted, t.Status())
+		t.Cancel(nil)
+	}
 
-		for partition, segments := range suite.segments[collection] {
-			for _, segment := range segments {
-				metaSegments = append(metaSegments,
-					utils.CreateTestSegment(collection, partition, segment, srcNode, 1, "test-channel"))
+	// async LoadBalance can't balance channels
+	resp, err = server.LoadBalance(ctx, &querypb.LoadBalanceRequest{
+		CollectionID:    collection,
+		SourceNodeIDs:   []int64{srcNode},
+		DstNodeIDs:      []int64{dstNode},
+		BalanceChannels: true,
+		Async:           true,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrParameterInvalid)
 
-				segmentOnCollection[collection] = append(segmentOnCollection[collection], segment)
-			}
-		}
-	}
-	suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
-		NodeID:   1001,
-		Address:  "localhost",
-		Hostname: "localhost",
-	}))
-	suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
-		NodeID:   1002,
-		Address:  "localhost",
-		Hostname: "localhost",
-	}))
-	defer func() {
-		for _, collection := range suite.collections {
-			replicas := suite.meta.ReplicaManager.GetByCollection(collection)
-			suite.meta.ReplicaManager.RemoveNode(replicas[0].GetID(), srcNode)
-			suite.meta.ReplicaManager.RemoveNode(replicas[0].GetID(), dstNode)
-		}
-		suite.nodeMgr.Remove(1001)
-		suite.nodeMgr.Remove(1002)
-	}()
-	suite.dist.SegmentDistManager.Update(srcNode, metaSegments...)
+	suite.taskScheduler.EXPECT().GetBalanceStatus(int64(100)).Return(task.BalanceStatus{
+		Planned:   4,
+		Completed: 1,
+		InFlight:  2,
+		Failed:    1,
+	}, true)
+	statusResp, err := server.GetLoadBalanceStatus(ctx, &querypb.GetLoadBalanceStatusRequest{OperationID: 100})
+	suite.NoError(merr.CheckRPCCall(statusResp, err))
+	suite.EqualValues(100, statusResp.GetOperationID())
+	suite.EqualValues(4, statusResp.GetPlanned())
+	suite.EqualValues(1, statusResp.GetCompleted())
+	suite.EqualValues(2, statusResp.GetInFlight())
+	suite.EqualValues(1, statusResp.GetFailed())
+
+	// unknown or expired operation
+	suite.taskScheduler.EXPECT().GetBalanceStatus(int64(101)).Return(task.BalanceStatus{}, false)
+	statusResp, err = server.GetLoadBalanceStatus(ctx, &querypb.GetLoadBalanceStatusRequest{OperationID: 101})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(statusResp.GetStatus()), merr.ErrParameterInvalid)
 
-	// expect each collection can only trigger its own segment's balance
-	for _, collection := range suite.collections {
-		req := &querypb.LoadBalanceRequest{
-			CollectionID:  collection,
-			SourceNodeIDs: []int64{srcNode},
-			DstNodeIDs:    []int64{dstNode},
-		}
-		suite.taskScheduler.ExpectedCalls = make([]*mock.Call, 0)
-		suite.taskScheduler.EXPECT().Add(mock.Anything).Run(func(t task.Task) {
-			actions := t.Actions()
-			suite.Len(actions, 2)
-			growAction := actions[0].(*task.SegmentAction)
-			reduceAction := actions[1].(*task.SegmentAction)
-			suite.True(lo.Contains(segmentOnCollection[collection], growAction.SegmentID()))
-			suite.True(lo.Contains(segmentOnCollection[collection], reduceAction.SegmentID()))
-			suite.Equal(dstNode, growAction.Node())
-			suite.Equal(srcNode, reduceAction.Node())
-			t.Cancel(nil)
-		}).Return(nil)
-		resp, err := server.LoadBalance(ctx, req)
-		suite.NoError(err)
-		suite.Equal(commonpb.ErrorCode_Success, resp.ErrorCode)
-		suite.taskScheduler.AssertExpectations(suite.T())
-	}
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	statusResp, err = server.GetLoadBalanceStatus(ctx, &querypb.GetLoadBalanceStatusRequest{OperationID: 100})
+	suite.NoError(err)
+	suite.Equal(statusResp.GetStatus().GetCode(), merr.Code(merr.ErrServiceNotReady))
 }
 
-func (suite *ServiceSuite) TestLoadBalanceFailed() {
+func (suite *ServiceSuite) TestSetCollectionBalanceMode() {
 	suite.loadAll()
 	ctx := context.Background()
 	server := suite.server
+	suite.mockNodeMemory(1024*1024*1024, 0)
+
+	collection := suite.collections[0]
+	resp, err := server.SetCollectionBalanceMode(ctx, &querypb.SetCollectionBalanceModeRequest{
+		CollectionID:    collection,
+		BalanceExcluded: true,
+	})
+	suite.NoError(err)
+	suite.NoError(merr.Error(resp))
+	suite.True(suite.meta.GetCollection(collection).GetBalanceExcluded())
+
+	// manual balance is still allowed
+	replicas := suite.meta.ReplicaManager.GetByCollection(collection)
+	nodes := replicas[0].GetNodes()
+	srcNode, dstNode := nodes[0], nodes[1]
+	suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+	suite.updateSegmentDist(collection, srcNode)
+	suite.taskScheduler.ExpectedCalls = make([]*mock.Call, 0)
+	suite.taskScheduler.EXPECT().TrackBalance(mock.Anything).Return(int64(1)).Maybe()
+	suite.taskScheduler.EXPECT().Add(mock.Anything).Run(func(task task.Task) {
+		task.Cancel(nil)
+	}).Return(nil)
+	resp, err = server.LoadBalance(ctx, &querypb.LoadBalanceRequest{
+		CollectionID:     collection,
+		SourceNodeIDs:    []int64{srcNode},
+		DstNodeIDs:       []int64{dstNode},
+		SealedSegmentIDs: suite.getAllSegments(collection),
+	})
+	suite.NoError(err)
+	suite.NoError(merr.Error(resp))
+
+	resp, err = server.SetCollectionBalanceMode(ctx, &querypb.SetCollectionBalanceModeRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.NoError(merr.Error(resp))
+	suite.False(suite.meta.GetCollection(collection).GetBalanceExcluded())
+
+	// Test collection not loaded
+	resp, err = server.SetCollectionBalanceMode(ctx, &querypb.SetCollectionBalanceModeRequest{
+		CollectionID:    999,
+		BalanceExcluded: true,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrCollectionNotLoaded)
+
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err = server.SetCollectionBalanceMode(ctx, &querypb.SetCollectionBalanceModeRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.Equal(resp.GetCode(), merr.Code(merr.ErrServiceNotReady))
+}
+
+func (suite *ServiceSuite) TestSetBalancePolicy() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+	server.balancerMap = map[string]balance.Balance{
+		balance.RowCountBasedBalancerName: suite.balancer,
+		balance.ScoreBasedBalancerName:    suite.balancer,
+	}
+
+	collection := suite.collections[0]
+	resp, err := server.SetBalancePolicy(ctx, &querypb.SetBalancePolicyRequest{
+		CollectionID:  collection,
+		BalancePolicy: balance.ScoreBasedBalancerName,
+	})
+	suite.NoError(err)
+	suite.NoError(merr.Error(resp))
+	suite.Equal(balance.ScoreBasedBalancerName, suite.meta.GetCollection(collection).GetBalancePolicy())
+
+	// Test unknown policy
+	resp, err = server.SetBalancePolicy(ctx, &querypb.SetBalancePolicyRequest{
+		CollectionID:  collection,
+		BalancePolicy: "unknown",
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrParameterInvalid)
+	suite.Equal(balance.ScoreBasedBalancerName, suite.meta.GetCollection(collection).GetBalancePolicy())
+
+	// Test reset to the global balancer
+	resp, err = server.SetBalancePolicy(ctx, &querypb.SetBalancePolicyRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.NoError(merr.Error(resp))
+	suite.Empty(suite.meta.GetCollection(collection).GetBalancePolicy())
+
+	// Test collection not loaded
+	resp, err = server.SetBalancePolicy(ctx, &querypb.SetBalancePolicyRequest{
+		CollectionID:  999,
+		BalancePolicy: balance.ScoreBasedBalancerName,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrCollectionNotLoaded)
+
+	// Test load with unknown policy
+	resp, err = server.LoadCollection(ctx, &querypb.LoadCollectionRequest{
+		CollectionID:  1000,
+		BalancePolicy: "unknown",
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrParameterInvalid)
+
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err = server.SetBalancePolicy(ctx, &querypb.SetBalancePolicyRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.Equal(resp.GetCode(), merr.Code(merr.ErrServiceNotReady))
+}
+
+func (suite *ServiceSuite) TestLoadBalanceWithCapacity() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	paramtable.Get().Save(Params.QueryCoordCfg.NodeMaxSegmentNum.Key, "1")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.NodeMaxSegmentNum.Key)
+	suite.taskScheduler.EXPECT().GetNodeSegmentDelta(mock.Anything).Return(0)
+	suite.taskScheduler.EXPECT().GetNodeSegmentSizeDelta(mock.Anything).Return(0)
+
+	collection := suite.collections[0]
+	replicas := suite.meta.ReplicaManager.GetByCollection(collection)
+	nodes := replicas[0].GetNodes()
+	srcNode, dstNode := nodes[0], nodes[1]
+	suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+	suite.updateSegmentDist(collection, srcNode)
+	// the destination node reaches the cap of segment number
+	suite.dist.SegmentDistManager.Update(dstNode, utils.CreateTestSegment(collection, 1, 999, dstNode, 1, "dmc0"))
+
+	resp, err := server.LoadBalance(ctx, &querypb.LoadBalanceRequest{
+		CollectionID:     collection,
+		SourceNodeIDs:    []int64{srcNode},
+		DstNodeIDs:       []int64{dstNode},
+		SealedSegmentIDs: suite.getAllSegments(collection),
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrServiceQuotaExceeded)
+	suite.Contains(resp.GetReason(), "reach the cap")
+
+	// the destination node could take only one more segment, none of the segments is moved
+	suite.mockNodeMemory(1024*1024*1024, 0)
+	suite.Require().Greater(len(suite.getAllSegments(collection)), 1)
+	paramtable.Get().Save(Params.QueryCoordCfg.NodeMaxSegmentNum.Key, "2")
+	resp, err = server.LoadBalance(ctx, &querypb.LoadBalanceRequest{
+		CollectionID:     collection,
+		SourceNodeIDs:    []int64{srcNode},
+		DstNodeIDs:       []int64{dstNode},
+		SealedSegmentIDs: suite.getAllSegments(collection),
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrServiceQuotaExceeded)
+	suite.Contains(resp.GetReason(), "only 1 of")
+}
+
+func (suite *ServiceSuite) TestLoadBalanceWithMultipleSourceNodes() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+	suite.mockNodeMemory(1024*1024*1024, 0)
 
-	// Test load balance without source node
 	for _, collection := range suite.collections {
 		replicas := suite.meta.ReplicaManager.GetByCollection(collection)
-		dstNode := replicas[0].GetNodes()[1]
-		segments := suite.getAllSegments(collection)
+		nodes := suite.sortInt64(replicas[0].GetNodes())
+		suite.Require().GreaterOrEqual(len(nodes), 3)
+		srcNodes := nodes[:2]
+		suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+
+		// spread the segments over the source nodes
+		segmentOnNode := make(map[int64]int64)
+		metaSegments := make(map[int64][]*meta.Segment)
+		i := 0
+		for partition, segments := range suite.segments[collection] {
+			for _, segment := range segments {
+				node := srcNodes[i%len(srcNodes)]
+				segmentOnNode[segment] = node
+				metaSegments[node] = append(metaSegments[node],
+					utils.CreateTestSegment(collection, partition, segment, node, 1, "test-channel"))
+				i++
+			}
+		}
+		for _, node := range srcNodes {
+			suite.dist.SegmentDistManager.Update(node, metaSegments[node]...)
+		}
+
 		req := &querypb.LoadBalanceRequest{
-			CollectionID:     collection,
-			DstNodeIDs:       []int64{dstNode},
-			SealedSegmentIDs: segments,
+			CollectionID:  collection,
+			SourceNodeIDs: srcNodes,
 		}
+		suite.taskScheduler.ExpectedCalls = make([]*mock.Call, 0)
+		suite.taskScheduler.EXPECT().TrackBalance(mock.Anything).Return(int64(1)).Maybe()
+		suite.taskScheduler.EXPECT().Add(mock.Anything).Run(func(t task.Task) {
+			actions := t.Actions()
+			suite.Len(actions, 2)
+			growAction := actions[0].(*task.SegmentAction)
+			reduceAction := actions[1].(*task.SegmentAction)
+			// segments are never moved between the source nodes
+			suite.NotContains(srcNodes, growAction.Node())
+			suite.Equal(segmentOnNode[reduceAction.SegmentID()], reduceAction.Node())
+			t.Cancel(nil)
+		}).Return(nil).Times(len(segmentOnNode))
 		resp, err := server.LoadBalance(ctx, req)
 		suite.NoError(err)
+		suite.Equal(commonpb.ErrorCode_Success, resp.ErrorCode)
+		suite.taskScheduler.AssertExpectations(suite.T())
+
+		// stopping source node is skipped
+		suite.nodeMgr.Stopping(srcNodes[0])
+		suite.taskScheduler.ExpectedCalls = make([]*mock.Call, 0)
+		suite.taskScheduler.EXPECT().TrackBalance(mock.Anything).Return(int64(1)).Maybe()
+		suite.taskScheduler.EXPECT().Add(mock.Anything).Run(func(t task.Task) {
+			suite.Equal(srcNodes[1], t.Actions()[1].Node())
+			t.Cancel(nil)
+		}).Return(nil).Times(len(metaSegments[srcNodes[1]]))
+		resp, err = server.LoadBalance(ctx, req)
+		suite.NoError(err)
+		suite.Equal(commonpb.ErrorCode_Success, resp.ErrorCode)
+		suite.taskScheduler.AssertExpectations(suite.T())
+
+		// all source nodes stopping
+		suite.nodeMgr.Stopping(srcNodes[1])
+		resp, err = server.LoadBalance(ctx, req)
+		suite.NoError(err)
 		suite.ErrorIs(merr.Error(resp), merr.ErrParameterInvalid)
+		for _, node := range srcNodes {
+			suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+				NodeID:   node,
+				Address:  "localhost",
+				Hostname: "localhost",
+			}))
+		}
 	}
 
-	// Test load balance with not fully loaded
+	// source nodes in different replicas
+	collection := suite.collections[1]
+	replicas := suite.meta.ReplicaManager.GetByCollection(collection)
+	resp, err := server.LoadBalance(ctx, &querypb.LoadBalanceRequest{
+		CollectionID:  collection,
+		SourceNodeIDs: []int64{replicas[0].GetNodes()[0], replicas[1].GetNodes()[0]},
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrParameterInvalid)
+}
+
+func (suite *ServiceSuite) TestLoadBalanceWithChannels() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+	suite.mockNodeMemory(1024*1024*1024, 0)
+
+	collection := suite.collections[0]
+	replicas := suite.meta.ReplicaManager.GetByCollection(collection)
+	nodes := suite.sortInt64(replicas[0].GetNodes())
+	srcNode, dstNode := nodes[0], nodes[1]
+	suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+	suite.updateChannelDist(collection)
+	suite.updateSegmentDist(collection, srcNode)
+	suite.fetchHeartbeats(time.Now())
+	segments := suite.getAllSegments(collection)
+
+	req := &querypb.LoadBalanceRequest{
+		CollectionID:    collection,
+		SourceNodeIDs:   []int64{srcNode},
+		DstNodeIDs:      []int64{dstNode},
+		BalanceChannels: true,
+	}
+	tasks := make([]task.Task, 0)
+	suite.taskScheduler.ExpectedCalls = make([]*mock.Call, 0)
+	suite.taskScheduler.EXPECT().TrackBalance(mock.Anything).Return(int64(1)).Maybe()
+	suite.taskScheduler.EXPECT().Add(mock.Anything).Run(func(t task.Task) {
+		tasks = append(tasks, t)
+		t.Cancel(nil)
+	}).Return(nil)
+	resp, err := server.LoadBalance(ctx, req)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.ErrorCode)
+
+	// channel moves after all segment moves
+	suite.Len(tasks, len(segments)+1)
+	for _, t := range tasks[:len(segments)] {
+		suite.IsType(&task.SegmentTask{}, t)
+	}
+	channelTask, ok := tasks[len(segments)].(*task.ChannelTask)
+	suite.True(ok)
+	suite.Equal(suite.channels[collection][0], channelTask.Channel())
+	suite.Equal(dstNode, channelTask.Actions()[0].Node())
+	suite.Equal(srcNode, channelTask.Actions()[1].Node())
+
+	// skip channel whose leader is unavailable
+	suite.dist.LeaderViewManager.Update(srcNode)
+	tasks = tasks[:0]
+	resp, err = server.LoadBalance(ctx, req)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.ErrorCode)
+	suite.Len(tasks, len(segments))
+	for _, t := range tasks {
+		suite.IsType(&task.SegmentTask{}, t)
+	}
+}
+
+func (suite *ServiceSuite) TestLoadBalanceSkipSuspendedNode() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+	suite.mockNodeMemory(1024*1024*1024, 0)
+
+	collection := suite.collections[0]
+	replicas := suite.meta.ReplicaManager.GetByCollection(collection)
+	nodes := suite.sortInt64(replicas[0].GetNodes())
+	srcNode, suspendedNode := nodes[0], nodes[1]
+	suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+	suite.updateSegmentDist(collection, srcNode)
+	suite.NoError(suite.nodeMgr.Suspend(suspendedNode))
+	defer suite.nodeMgr.Resume(suspendedNode)
+
+	// all destination nodes are suspended
+	resp, err := server.LoadBalance(ctx, &querypb.LoadBalanceRequest{
+		CollectionID:  collection,
+		SourceNodeIDs: []int64{srcNode},
+		DstNodeIDs:    []int64{suspendedNode},
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrParameterInvalid)
+
+	// suspended node is skipped from the default destination nodes
+	suite.taskScheduler.ExpectedCalls = make([]*mock.Call, 0)
+	suite.taskScheduler.EXPECT().TrackBalance(mock.Anything).Return(int64(1)).Maybe()
+	suite.taskScheduler.EXPECT().Add(mock.Anything).Run(func(t task.Task) {
+		suite.NotEqual(suspendedNode, t.Actions()[0].Node())
+		t.Cancel(nil)
+	}).Return(nil).Maybe()
+	resp, err = server.LoadBalance(ctx, &querypb.LoadBalanceRequest{
+		CollectionID:  collection,
+		SourceNodeIDs: []int64{srcNode},
+	})
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.ErrorCode)
+}
+
+func (suite *ServiceSuite) TestLoadBalanceWithNoDstNode() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+	suite.mockNodeMemory(1024*1024*1024, 0)
+
+	// Test get balance first segment
 	for _, collection := range suite.collections {
 		replicas := suite.meta.ReplicaManager.GetByCollection(collection)
 		nodes := replicas[0].GetNodes()
 		srcNode := nodes[0]
-		dstNode := nodes[1]
-		suite.updateCollectionStatus(collection, querypb.LoadStatus_Loading)
+		suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+		suite.updateSegmentDist(collection, srcNode)
 		segments := suite.getAllSegments(collection)
 		req := &querypb.LoadBalanceRequest{
 			CollectionID:     collection,
 			SourceNodeIDs:    []int64{srcNode},
-			DstNodeIDs:       []int64{dstNode},
 			SealedSegmentIDs: segments,
 		}
+		suite.taskScheduler.ExpectedCalls = make([]*mock.Call, 0)
+		suite.taskScheduler.EXPECT().TrackBalance(mock.Anything).Return(int64(1)).Maybe()
+		suite.taskScheduler.EXPECT().Add(mock.Anything).Run(func(task task.Task) {
+			actions := task.Actions()
+			suite.Len(actions, 2)
+			growAction, reduceAction := actions[0], actions[1]
+			suite.Contains(nodes, growAction.Node())
+			suite.Equal(srcNode, reduceAction.Node())
+			task.Cancel(nil)
+		}).Return(nil)
 		resp, err := server.LoadBalance(ctx, req)
 		suite.NoError(err)
-		suite.ErrorIs(merr.Error(resp), merr.ErrCollectionNotFullyLoaded)
+		suite.Equal(commonpb.ErrorCode_Success, resp.ErrorCode)
+		suite.taskScheduler.AssertExpectations(suite.T())
+	}
+
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	req := &querypb.LoadBalanceRequest{
+		CollectionID:  suite.collections[0],
+		SourceNodeIDs: []int64{1},
+		DstNodeIDs:    []int64{100 + 1},
+	}
+	resp, err := server.LoadBalance(ctx, req)
+	suite.NoError(err)
+	suite.Equal(resp.GetCode(), merr.Code(merr.ErrServiceNotReady))
+}
+
+func (suite *ServiceSuite) TestLoadBalanceWithEmptySegmentList() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+	suite.mockNodeMemory(1024*1024*1024, 0)
+
+	srcNode := int64(1001)
+	dstNode := int64(1002)
+	metaSegments := make([]*meta.Segment, 0)
+	segmentOnCollection := make(map[int64][]int64)
+
+	// update two collection's dist
+	for _, collection := range suite.collections {
+		replicas := suite.meta.ReplicaManager.GetByCollection(collection)
+		replicas[0].AddRWNode(srcNode)
+		replicas[0].AddRWNode(dstNode)
+		suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+
+		for partition, segments := range suite.segments[collection] {
+			for _, segment := range segments {
+				metaSegments = append(metaSegments,
+					utils.CreateTestSegment(collection, partition, segment, srcNode, 1, "test-channel"))
+
+				segmentOnCollection[collection] = append(segmentOnCollection[collection], segment)
+			}
+		}
+	}
+	suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   1001,
+		Address:  "localhost",
+		Hostname: "localhost",
+	}))
+	suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   1002,
+		Address:  "localhost",
+		Hostname: "localhost",
+	}))
+	defer func() {
+		for _, collection := range suite.collections {
+			replicas := suite.meta.ReplicaManager.GetByCollection(collection)
+			suite.meta.ReplicaManager.RemoveNode(replicas[0].GetID(), srcNode)
+			suite.meta.ReplicaManager.RemoveNode(replicas[0].GetID(), dstNode)
+		}
+		suite.nodeMgr.Remove(1001)
+		suite.nodeMgr.Remove(1002)
+	}()
+	suite.dist.SegmentDistManager.Update(srcNode, metaSegments...)
+
+	// expect each collection can only trigger its own segment's balance
+	for _, collection := range suite.collections {
+		req := &querypb.LoadBalanceRequest{
+			CollectionID:  collection,
+			SourceNodeIDs: []int64{srcNode},
+			DstNodeIDs:    []int64{dstNode},
+		}
+		suite.taskScheduler.ExpectedCalls = make([]*mock.Call, 0)
+		suite.taskScheduler.EXPECT().TrackBalance(mock.Anything).Return(int64(1)).Maybe()
+		suite.taskScheduler.EXPECT().Add(mock.Anything).Run(func(t task.Task) {
+			actions := t.Actions()
+			suite.Len(actions, 2)
+			growAction := actions[0].(*task.SegmentAction)
+			reduceAction := actions[1].(*task.SegmentAction)
+			suite.True(lo.Contains(segmentOnCollection[collection], growAction.SegmentID()))
+			suite.True(lo.Contains(segmentOnCollection[collection], reduceAction.SegmentID()))
+			suite.Equal(dstNode, growAction.Node())
+			suite.Equal(srcNode, reduceAction.Node())
+			t.Cancel(nil)
+		}).Return(nil)
+		resp, err := server.LoadBalance(ctx, req)
+		suite.NoError(err)
+		suite.Equal(commonpb.ErrorCode_Success, resp.ErrorCode)
+		suite.taskScheduler.AssertExpectations(suite.T())
+	}
+}
+
+func (suite *ServiceSuite) TestLoadBalanceWithPartition() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+	suite.mockNodeMemory(1024*1024*1024, 0)
+
+	collection := int64(1000)
+	partition := int64(100)
+	replicas := suite.meta.ReplicaManager.GetByCollection(collection)
+	nodes := replicas[0].GetNodes()
+	srcNode := nodes[0]
+	dstNode := nodes[1]
+	suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+	suite.updateSegmentDist(collection, srcNode)
+
+	// only the segments of the partition are balanced
+	req := &querypb.LoadBalanceRequest{
+		CollectionID:  collection,
+		SourceNodeIDs: []int64{srcNode},
+		DstNodeIDs:    []int64{dstNode},
+		PartitionID:   partition,
+	}
+	balanced := make([]int64, 0)
+	suite.taskScheduler.ExpectedCalls = make([]*mock.Call, 0)
+	suite.taskScheduler.EXPECT().TrackBalance(mock.Anything).Return(int64(1)).Maybe()
+	suite.taskScheduler.EXPECT().Add(mock.Anything).Run(func(t task.Task) {
+		balanced = append(balanced, t.(*task.SegmentTask).SegmentID())
+		t.Cancel(nil)
+	}).Return(nil)
+	resp, err := server.LoadBalance(ctx, req)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.ErrorCode)
+	suite.ElementsMatch(suite.segments[collection][partition], balanced)
+
+	// segment of other partitions
+	req.SealedSegmentIDs = suite.segments[collection][101][:1]
+	resp, err = server.LoadBalance(ctx, req)
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrSegmentNotFound)
+
+	// channels can't be balanced by partition
+	req.SealedSegmentIDs = nil
+	req.BalanceChannels = true
+	resp, err = server.LoadBalance(ctx, req)
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrParameterInvalid)
+
+	// partition not loaded
+	req.BalanceChannels = false
+	req.PartitionID = 999
+	resp, err = server.LoadBalance(ctx, req)
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrPartitionNotLoaded)
+
+	// partition of another collection
+	req.PartitionID = suite.partitions[1001][0]
+	resp, err = server.LoadBalance(ctx, req)
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrPartitionNotLoaded)
+}
+
+func (suite *ServiceSuite) TestLoadBalanceOverCapacity() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	collection := suite.collections[0]
+	suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+	nodes := suite.meta.ReplicaManager.GetByCollection(collection)[0].GetNodes()
+	srcNode, dstNode := nodes[0], nodes[1]
+	metaSegments := make([]*meta.Segment, 0)
+	for partition, segments := range suite.segments[collection] {
+		for _, segment := range segments {
+			metaSegment := utils.CreateTestSegment(collection, partition, segment, srcNode, 1, "test-channel")
+			metaSegment.Binlogs = []*datapb.FieldBinlog{
+				{Binlogs: []*datapb.Binlog{{LogSize: 100}}},
+			}
+			metaSegments = append(metaSegments, metaSegment)
+		}
+	}
+	suite.dist.SegmentDistManager.Update(srcNode, metaSegments...)
+
+	// headroom of dst node is 900 - 850 = 50 bytes, less than the segment size
+	suite.mockNodeMemory(1000, 850)
+	resp, err := server.LoadBalance(ctx, &querypb.LoadBalanceRequest{
+		CollectionID:  collection,
+		SourceNodeIDs: []int64{srcNode},
+		DstNodeIDs:    []int64{dstNode},
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrServiceMemoryLimitExceeded)
+	suite.taskScheduler.AssertNotCalled(suite.T(), "Add", mock.Anything)
+}
+
+func (suite *ServiceSuite) TestLoadBalanceFailed() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	// Test load balance without source node
+	for _, collection := range suite.collections {
+		replicas := suite.meta.ReplicaManager.GetByCollection(collection)
+		dstNode := replicas[0].GetNodes()[1]
+		segments := suite.getAllSegments(collection)
+		req := &querypb.LoadBalanceRequest{
+			CollectionID:     collection,
+			DstNodeIDs:       []int64{dstNode},
+			SealedSegmentIDs: segments,
+		}
+		resp, err := server.LoadBalance(ctx, req)
+		suite.NoError(err)
+		suite.ErrorIs(merr.Error(resp), merr.ErrParameterInvalid)
+	}
+
+	// Test load balance with not fully loaded
+	for _, collection := range suite.collections {
+		replicas := suite.meta.ReplicaManager.GetByCollection(collection)
+		nodes := replicas[0].GetNodes()
+		srcNode := nodes[0]
+		dstNode := nodes[1]
+		suite.updateCollectionStatus(collection, querypb.LoadStatus_Loading)
+		segments := suite.getAllSegments(collection)
+		req := &querypb.LoadBalanceRequest{
+			CollectionID:     collection,
+			SourceNodeIDs:    []int64{srcNode},
+			DstNodeIDs:       []int64{dstNode},
+			SealedSegmentIDs: segments,
+		}
+		resp, err := server.LoadBalance(ctx, req)
+		suite.NoError(err)
+		suite.ErrorIs(merr.Error(resp), merr.ErrCollectionNotFullyLoaded)
+	}
+
+	// Test load balance with source node and dest node not in the same replica
+	for _, collection := range suite.collections {
+		if suite.replicaNumber[collection] <= 1 {
+			continue
+		}
+
+		replicas := suite.meta.ReplicaManager.GetByCollection(collection)
+		srcNode := replicas[0].GetNodes()[0]
+		dstNode := replicas[1].GetNodes()[0]
+		suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+		suite.updateSegmentDist(collection, srcNode)
+		segments := suite.getAllSegments(collection)
+		req := &querypb.LoadBalanceRequest{
+			CollectionID:     collection,
+			SourceNodeIDs:    []int64{srcNode},
+			DstNodeIDs:       []int64{dstNode},
+			SealedSegmentIDs: segments,
+		}
+		resp, err := server.LoadBalance(ctx, req)
+		suite.NoError(err)
+		suite.ErrorIs(merr.Error(resp), merr.ErrNodeNotFound)
+	}
+
+	// Test balance task failed
+	suite.mockNodeMemory(1024*1024*1024, 0)
+	for _, collection := range suite.collections {
+		replicas := suite.meta.ReplicaManager.GetByCollection(collection)
+		nodes := replicas[0].GetNodes()
+		srcNode := nodes[0]
+		dstNode := nodes[1]
+		suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+		suite.updateSegmentDist(collection, srcNode)
+		segments := suite.getAllSegments(collection)
+		req := &querypb.LoadBalanceRequest{
+			CollectionID:     collection,
+			SourceNodeIDs:    []int64{srcNode},
+			DstNodeIDs:       []int64{dstNode},
+			SealedSegmentIDs: segments,
+		}
+		suite.taskScheduler.EXPECT().TrackBalance(mock.Anything).Return(int64(1)).Maybe()
+		suite.taskScheduler.EXPECT().Add(mock.Anything).Run(func(balanceTask task.Task) {
+			balanceTask.Cancel(errors.New("mock error"))
+		}).Return(nil)
+		resp, err := server.LoadBalance(ctx, req)
+		suite.NoError(err)
+		suite.Equal(commonpb.ErrorCode_UnexpectedError, resp.ErrorCode)
+		suite.Contains(resp.Reason, "mock error")
+
+		suite.meta.ReplicaManager.RecoverNodesInCollection(collection, map[string]typeutil.UniqueSet{meta.DefaultResourceGroupName: typeutil.NewUniqueSet(10)})
+		req.SourceNodeIDs = []int64{10}
+		resp, err = server.LoadBalance(ctx, req)
+		suite.NoError(err)
+		suite.Equal(commonpb.ErrorCode_UnexpectedError, resp.ErrorCode)
+
+		req.SourceNodeIDs = []int64{srcNode}
+		req.DstNodeIDs = []int64{10}
+		resp, err = server.LoadBalance(ctx, req)
+		suite.NoError(err)
+		suite.Equal(commonpb.ErrorCode_UnexpectedError, resp.ErrorCode)
+
+		suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   10,
+			Address:  "localhost",
+			Hostname: "localhost",
+		}))
+		suite.nodeMgr.Stopping(10)
+		resp, err = server.LoadBalance(ctx, req)
+		suite.NoError(err)
+		suite.Equal(commonpb.ErrorCode_UnexpectedError, resp.ErrorCode)
+		suite.nodeMgr.Remove(10)
+		suite.meta.ReplicaManager.RemoveNode(replicas[0].GetID(), 10)
+	}
+}
+
+func (suite *ServiceSuite) TestShowConfigurations() {
+	ctx := context.Background()
+	server := suite.server
+
+	req := &internalpb.ShowConfigurationsRequest{
+		Pattern: "querycoord.Port",
+	}
+	resp, err := server.ShowConfigurations(ctx, req)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	suite.Len(resp.Configuations, 1)
+	suite.Equal("querycoord.port", resp.Configuations[0].Key)
+
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	req = &internalpb.ShowConfigurationsRequest{
+		Pattern: "querycoord.Port",
+	}
+	resp, err = server.ShowConfigurations(ctx, req)
+	suite.NoError(err)
+	suite.Equal(resp.GetStatus().GetCode(), merr.Code(merr.ErrServiceNotReady))
+}
+
+func (suite *ServiceSuite) TestGetMetrics() {
+	ctx := context.Background()
+	server := suite.server
+
+	for _, node := range suite.nodes {
+		suite.cluster.EXPECT().GetMetrics(ctx, node, mock.Anything).Return(&milvuspb.GetMetricsResponse{
+			Status:        merr.Success(),
+			ComponentName: "QueryNode",
+		}, nil)
+	}
+
+	metricReq := make(map[string]string)
+	metricReq[metricsinfo.MetricTypeKey] = "system_info"
+	req, err := json.Marshal(metricReq)
+	suite.NoError(err)
+	resp, err := server.GetMetrics(ctx, &milvuspb.GetMetricsRequest{
+		Base:    &commonpb.MsgBase{},
+		Request: string(req),
+	})
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	topology := metricsinfo.QueryCoordTopology{}
+	suite.NoError(metricsinfo.UnmarshalTopology(resp.GetResponse(), &topology))
+	suite.NotNil(topology.Cluster.Self.TargetLag)
+	suite.Zero(topology.Cluster.Self.TargetLag.Total.SegmentNum)
+
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err = server.GetMetrics(ctx, &milvuspb.GetMetricsRequest{
+		Base:    &commonpb.MsgBase{},
+		Request: string(req),
+	})
+	suite.NoError(err)
+	suite.Equal(resp.GetStatus().GetCode(), merr.Code(merr.ErrServiceNotReady))
+}
+
+func (suite *ServiceSuite) TestGetCollectionDistributionMetrics() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	collection := suite.collections[0]
+	node := suite.nodes[0]
+	suite.updateSegmentDist(collection, node)
+
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.CollectionDistributionMetrics)
+	suite.NoError(err)
+	resp, err := server.GetMetrics(ctx, req)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+
+	distMetrics := metricsinfo.QueryCoordCollectionDistributionMetrics{}
+	suite.NoError(metricsinfo.UnmarshalComponentInfos(resp.GetResponse(), &distMetrics))
+	suite.Len(distMetrics.Collections, len(suite.collections))
+	collectionMetrics := distMetrics.Collections[collection]
+	suite.Equal(len(lo.Flatten(lo.Values(suite.segments[collection]))), collectionMetrics.NodeSegmentNum[node])
+	suite.Len(collectionMetrics.Replicas, int(suite.replicaNumber[collection]))
+
+	// Test unimplemented metric type
+	req, err = metricsinfo.ConstructRequestByMetricType("unknown")
+	suite.NoError(err)
+	resp, err = server.GetMetrics(ctx, req)
+	suite.NoError(err)
+	suite.NotEqual(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+}
+
+func (suite *ServiceSuite) TestGetMetricsWithCache() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	paramtable.Get().Save(Params.QueryCoordCfg.MetricsCacheTTL.Key, "10000")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.MetricsCacheTTL.Key)
+
+	collection := suite.collections[0]
+	suite.updateSegmentDist(collection, suite.nodes[0])
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.CollectionDistributionMetrics)
+	suite.NoError(err)
+	resp, err := server.GetMetrics(ctx, req)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	cached, _, ok := server.metricsCacheManager.GetMetrics(metricsinfo.CollectionDistributionMetrics)
+	suite.True(ok)
+	suite.Equal(resp.GetResponse(), cached.GetResponse())
+
+	// served from cache within the TTL
+	suite.updateSegmentDist(collection, suite.nodes[1])
+	cachedResp, err := server.GetMetrics(ctx, req)
+	suite.NoError(err)
+	suite.Equal(resp.GetResponse(), cachedResp.GetResponse())
+
+	// refreshed once invalidated
+	server.metricsCacheManager.InvalidateMetrics()
+	resp, err = server.GetMetrics(ctx, req)
+	suite.NoError(err)
+	suite.NotEqual(cachedResp.GetResponse(), resp.GetResponse())
+	distMetrics := metricsinfo.QueryCoordCollectionDistributionMetrics{}
+	suite.NoError(metricsinfo.UnmarshalComponentInfos(resp.GetResponse(), &distMetrics))
+	suite.Equal(len(lo.Flatten(lo.Values(suite.segments[collection]))), distMetrics.Collections[collection].NodeSegmentNum[suite.nodes[1]])
+}
+
+func (suite *ServiceSuite) TestDescribeReplica() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	collection := suite.collections[0]
+	replica := suite.meta.ReplicaManager.GetByCollection(collection)[0]
+	node := replica.GetNodes()[0]
+	suite.updateSegmentDist(collection, node)
+	suite.updateChannelDist(collection)
+
+	resp, err := server.DescribeReplica(ctx, &querypb.DescribeReplicaRequest{
+		ReplicaID: replica.GetID(),
+	})
+	suite.NoError(err)
+	suite.NoError(merr.Error(resp.GetStatus()))
+	suite.Equal(replica.GetID(), resp.GetReplica().GetReplicaID())
+	suite.Equal(replica.GetResourceGroup(), resp.GetReplica().GetResourceGroupName())
+	suite.ElementsMatch(replica.GetNodes(), resp.GetReplica().GetNodeIds())
+	suite.Len(resp.GetNodeSegmentNum(), len(replica.GetNodes()))
+	suite.EqualValues(len(suite.getAllSegments(collection)), resp.GetNodeSegmentNum()[node])
+
+	// Test unknown replica
+	resp, err = server.DescribeReplica(ctx, &querypb.DescribeReplicaRequest{
+		ReplicaID: 999,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrReplicaNotFound)
+
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err = server.DescribeReplica(ctx, &querypb.DescribeReplicaRequest{
+		ReplicaID: replica.GetID(),
+	})
+	suite.NoError(err)
+	suite.Equal(resp.GetStatus().GetCode(), merr.Code(merr.ErrServiceNotReady))
+}
+
+func (suite *ServiceSuite) TestGetReplicas() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	for _, collection := range suite.collections {
+		suite.updateChannelDist(collection)
+		req := &milvuspb.GetReplicasRequest{
+			CollectionID: collection,
+		}
+		resp, err := server.GetReplicas(ctx, req)
+		suite.NoError(err)
+		suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		suite.EqualValues(suite.replicaNumber[collection], len(resp.Replicas))
+		suite.Empty(resp.GetStatus().GetExtraInfo())
+	}
+
+	// Test get with hostnames of nodes, the node gone is omitted
+	collection := suite.collections[0]
+	replicas := suite.meta.ReplicaManager.GetByCollection(collection)
+	goneNode := replicas[0].GetNodes()[0]
+	goneInfo := suite.nodeMgr.Get(goneNode)
+	suite.nodeMgr.Remove(goneNode)
+	resp, err := server.GetReplicas(ctx, &milvuspb.GetReplicasRequest{
+		CollectionID:   collection,
+		WithShardNodes: true,
+	})
+	suite.nodeMgr.Add(goneInfo)
+	suite.NoError(err)
+	suite.NoError(merr.Error(resp.GetStatus()))
+	expected := make([]string, 0)
+	for _, node := range suite.sortInt64(lo.Uniq(lo.FlatMap(replicas, func(replica *meta.Replica, _ int) []int64 {
+		return replica.GetNodes()
+	}))) {
+		if node != goneNode {
+			expected = append(expected, fmt.Sprintf("%d:localhost", node))
+		}
+	}
+	suite.Equal(strings.Join(expected, ","), resp.GetStatus().GetExtraInfo()[NodeHostnamesKey])
+
+	// Test get with shard nodes
+	for _, collection := range suite.collections {
+		replicas := suite.meta.ReplicaManager.GetByCollection(collection)
+		for _, replica := range replicas {
+			suite.updateSegmentDist(collection, replica.GetNodes()[0])
+		}
+		suite.updateChannelDist(collection)
+		req := &milvuspb.GetReplicasRequest{
+			CollectionID:   collection,
+			WithShardNodes: true,
+		}
+		resp, err := server.GetReplicas(ctx, req)
+		suite.NoError(err)
+		suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		suite.EqualValues(suite.replicaNumber[collection], len(resp.Replicas))
+
+		// Test no dup nodeIDs in shardReplica
+		for _, replica := range resp.GetReplicas() {
+			suite.Equal(collection, replica.CollectionID)
+			for _, shardReplica := range replica.GetShardReplicas() {
+				gotNodeIDsSet := typeutil.NewUniqueSet(shardReplica.GetNodeIds()...)
+				suite.Equal(len(shardReplica.GetNodeIds()), gotNodeIDsSet.Len())
+				if len(resp.GetReplicas()) > 0 {
+					suite.True(gotNodeIDsSet.Len() < len(replica.GetNodeIds()))
+				}
+			}
+
+			suite.Equal(len(replica.GetNodeIds()), len(suite.meta.ReplicaManager.Get(replica.ReplicaID).GetNodes()))
+		}
+	}
+
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	req := &milvuspb.GetReplicasRequest{
+		CollectionID: suite.collections[0],
+	}
+	resp, err = server.GetReplicas(ctx, req)
+	suite.NoError(err)
+	suite.Equal(resp.GetStatus().GetCode(), merr.Code(merr.ErrServiceNotReady))
+}
+
+func (suite *ServiceSuite) TestListReplicas() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	collection := suite.collections[1]
+	replicas := suite.meta.ReplicaManager.GetByCollection(collection)
+	suite.updateSegmentDist(collection, replicas[1].GetNodes()[0])
+	suite.updateChannelDist(collection)
+
+	// filter by resource group
+	resp, err := server.ListReplicas(ctx, &querypb.ListReplicasRequest{
+		CollectionID:  collection,
+		ResourceGroup: replicas[0].GetResourceGroup(),
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.NotEmpty(resp.GetReplicas())
+	for _, replica := range resp.GetReplicas() {
+		suite.Equal(replicas[0].GetResourceGroup(), replica.GetResourceGroupName())
+	}
+
+	// the unknown resource group returns empty
+	resp, err = server.ListReplicas(ctx, &querypb.ListReplicasRequest{
+		CollectionID:  collection,
+		ResourceGroup: "rg_not_exist",
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Empty(resp.GetReplicas())
+
+	// sort by node number
+	resp, err = server.ListReplicas(ctx, &querypb.ListReplicasRequest{
+		CollectionID: collection,
+		SortKey:      querypb.ReplicaSortKey_ReplicaNodeNum,
+		Descending:   true,
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Len(resp.GetReplicas(), len(replicas))
+	for i := 1; i < len(resp.GetReplicas()); i++ {
+		suite.GreaterOrEqual(len(resp.GetReplicas()[i-1].GetNodeIds()), len(resp.GetReplicas()[i].GetNodeIds()))
+	}
+
+	// sort by load percentage
+	percentages := server.getReplicaLoadPercentages(collection, int64(suite.meta.CalculateLoadPercentage(collection)))
+	resp, err = server.ListReplicas(ctx, &querypb.ListReplicasRequest{
+		CollectionID: collection,
+		SortKey:      querypb.ReplicaSortKey_ReplicaLoadPercentage,
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Len(resp.GetReplicas(), len(replicas))
+	for i := 1; i < len(resp.GetReplicas()); i++ {
+		prev, cur := resp.GetReplicas()[i-1], resp.GetReplicas()[i]
+		suite.LessOrEqual(percentages[prev.GetReplicaID()], percentages[cur.GetReplicaID()])
+		if percentages[prev.GetReplicaID()] == percentages[cur.GetReplicaID()] {
+			suite.Less(prev.GetReplicaID(), cur.GetReplicaID())
+		}
+	}
+
+	suite.Empty(resp.GetNodes())
+
+	// with hostnames of nodes, the node gone is omitted
+	goneNode := replicas[0].GetNodes()[0]
+	suite.nodeMgr.Remove(goneNode)
+	resp, err = server.ListReplicas(ctx, &querypb.ListReplicasRequest{
+		CollectionID:   collection,
+		WithShardNodes: true,
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	nodeIDs := typeutil.NewUniqueSet()
+	for _, replica := range replicas {
+		nodeIDs.Insert(replica.GetNodes()...)
+	}
+	suite.Len(resp.GetNodes(), nodeIDs.Len()-1)
+	for i, node := range resp.GetNodes() {
+		if i > 0 {
+			suite.Less(resp.GetNodes()[i-1].GetNodeId(), node.GetNodeId())
+		}
+		suite.NotEqual(goneNode, node.GetNodeId())
+		suite.Equal("localhost", node.GetHostname())
+	}
+
+	// the collection not loaded
+	resp, err = server.ListReplicas(ctx, &querypb.ListReplicasRequest{
+		CollectionID: 999,
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Empty(resp.GetReplicas())
+
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err = server.ListReplicas(ctx, &querypb.ListReplicasRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.Equal(merr.Code(merr.ErrServiceNotReady), resp.GetStatus().GetCode())
+}
+
+func (suite *ServiceSuite) TestGetReplicasWithShortfall() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	// the collection is loaded with fewer replicas than requested
+	collection := suite.meta.CollectionManager.GetCollection(suite.collections[0]).Clone()
+	collection.ReplicaNumber += 2
+	suite.meta.CollectionManager.PutCollection(collection)
+
+	resp, err := server.GetReplicas(ctx, &milvuspb.GetReplicasRequest{
+		CollectionID: collection.GetCollectionID(),
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.EqualValues(suite.replicaNumber[collection.GetCollectionID()], len(resp.GetReplicas()))
+	suite.Equal(strconv.Itoa(int(collection.GetReplicaNumber())), resp.GetStatus().GetExtraInfo()[RequestedReplicaNumberKey])
+	suite.Equal("2", resp.GetStatus().GetExtraInfo()[ReplicaShortfallKey])
+}
+
+func (suite *ServiceSuite) TestGetReplicasWithStandbyReplica() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	collection := suite.collections[1]
+	replicas := suite.meta.ReplicaManager.GetByCollection(collection)
+	standbyIDs := lo.Map(replicas[1:], func(replica *meta.Replica, _ int) int64 { return replica.GetID() })
+	suite.NoError(suite.meta.ReplicaManager.SetStandby(true, standbyIDs...))
+
+	resp, err := server.GetReplicas(ctx, &milvuspb.GetReplicasRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Len(resp.GetReplicas(), len(replicas))
+	gotIDs := strings.Split(resp.GetStatus().GetExtraInfo()[StandbyReplicaIDsKey], ",")
+	suite.ElementsMatch(lo.Map(standbyIDs, func(id int64, _ int) string { return strconv.FormatInt(id, 10) }), gotIDs)
+
+	// no standby replica
+	resp, err = server.GetReplicas(ctx, &milvuspb.GetReplicasRequest{
+		CollectionID: suite.collections[0],
+	})
+	suite.NoError(err)
+	suite.NotContains(resp.GetStatus().GetExtraInfo(), StandbyReplicaIDsKey)
+}
+
+func (suite *ServiceSuite) TestGetReplicasWhenNoAvailableNodes() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	replicas := suite.meta.ReplicaManager.GetByCollection(suite.collections[0])
+	for _, replica := range replicas {
+		suite.updateSegmentDist(suite.collections[0], replica.GetNodes()[0])
+	}
+	suite.updateChannelDist(suite.collections[0])
+
+	suite.meta.ReplicaManager.Put(utils.CreateTestReplica(100001, suite.collections[0], []int64{}))
+
+	req := &milvuspb.GetReplicasRequest{
+		CollectionID:   suite.collections[0],
+		WithShardNodes: true,
+	}
+	resp, err := server.GetReplicas(ctx, req)
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+}
+
+func (suite *ServiceSuite) TestGetQueryNodeDistribution() {
+	ctx := context.Background()
+	server := suite.server
+
+	node := suite.nodes[0]
+	suite.dist.SegmentDistManager.Update(node,
+		utils.CreateTestSegment(2, 20, 3, node, 1, "2-dmc0"),
+		utils.CreateTestSegment(1, 10, 1, node, 1, "1-dmc0"),
+		utils.CreateTestSegment(1, 11, 2, node, 1, "1-dmc0"),
+	)
+	suite.dist.SegmentDistManager.Update(suite.nodes[1], utils.CreateTestSegment(1, 10, 4, suite.nodes[1], 1, "1-dmc0"))
+	suite.dist.ChannelDistManager.Update(node, utils.CreateTestChannel(1, node, 1, "1-dmc0"))
+	suite.dist.LeaderViewManager.Update(node, &meta.LeaderView{
+		ID:           node,
+		CollectionID: 1,
+		Channel:      "1-dmc0",
+		Segments: map[int64]*querypb.SegmentDist{
+			1: {NodeID: node, Version: 1},
+			4: {NodeID: suite.nodes[1], Version: 1},
+		},
+		GrowingSegments: map[int64]*meta.Segment{
+			5: utils.CreateTestSegment(1, 10, 5, node, 1, "1-dmc0"),
+		},
+		TargetVersion: 100,
+	})
+
+	resp, err := server.GetQueryNodeDistribution(ctx, &querypb.GetQueryNodeDistributionRequest{
+		NodeID: node,
+	})
+	suite.NoError(err)
+	suite.NoError(merr.Error(resp.GetStatus()))
+	suite.Equal(node, resp.GetID())
+	suite.Len(resp.GetSegments(), 3)
+	for i, segment := range resp.GetSegments() {
+		suite.EqualValues(i+1, segment.GetID())
+	}
+	suite.EqualValues(2, resp.GetSegments()[2].GetCollection())
+	suite.EqualValues(20, resp.GetSegments()[2].GetPartition())
+	suite.Equal("2-dmc0", resp.GetSegments()[2].GetChannel())
+	suite.Len(resp.GetChannels(), 1)
+	suite.EqualValues(1, resp.GetChannels()[0].GetCollection())
+	suite.Equal("1-dmc0", resp.GetChannels()[0].GetChannel())
+	suite.Len(resp.GetLeaderViews(), 1)
+	view := resp.GetLeaderViews()[0]
+	suite.EqualValues(1, view.GetCollection())
+	suite.Len(view.GetSegmentDist(), 2)
+	suite.Equal([]int64{5}, view.GetGrowingSegmentIDs())
+	suite.Contains(view.GetGrowingSegments(), int64(5))
+	suite.EqualValues(100, view.GetTargetVersion())
+
+	// Test node not found
+	resp, err = server.GetQueryNodeDistribution(ctx, &querypb.GetQueryNodeDistributionRequest{
+		NodeID: -1,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrNodeNotFound)
+
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err = server.GetQueryNodeDistribution(ctx, &querypb.GetQueryNodeDistributionRequest{
+		NodeID: node,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+}
+
+func (suite *ServiceSuite) TestCheckHealth() {
+	ctx := context.Background()
+	server := suite.server
+
+	// Test for server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err := server.CheckHealth(ctx, &milvuspb.CheckHealthRequest{})
+	suite.NoError(err)
+	suite.Equal(resp.IsHealthy, false)
+	suite.NotEmpty(resp.Reasons)
+
+	// Test for components state fail
+	for _, node := range suite.nodes {
+		suite.cluster.EXPECT().GetComponentStates(mock.Anything, node).Return(
+			&milvuspb.ComponentStates{
+				State:  &milvuspb.ComponentInfo{StateCode: commonpb.StateCode_Abnormal},
+				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			},
+			nil).Once()
+	}
+	server.UpdateStateCode(commonpb.StateCode_Healthy)
+	resp, err = server.CheckHealth(ctx, &milvuspb.CheckHealthRequest{})
+	suite.NoError(err)
+	suite.Equal(resp.IsHealthy, false)
+	suite.NotEmpty(resp.Reasons)
+
+	// Test for server is healthy
+	for _, node := range suite.nodes {
+		suite.cluster.EXPECT().GetComponentStates(mock.Anything, node).Return(
+			&milvuspb.ComponentStates{
+				State:  &milvuspb.ComponentInfo{StateCode: commonpb.StateCode_Healthy},
+				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			},
+			nil).Once()
+	}
+	resp, err = server.CheckHealth(ctx, &milvuspb.CheckHealthRequest{})
+	suite.NoError(err)
+	suite.Equal(resp.IsHealthy, true)
+	suite.Empty(resp.Reasons)
+
+	// Test for target updates paused
+	for _, node := range suite.nodes {
+		suite.cluster.EXPECT().GetComponentStates(mock.Anything, node).Return(
+			&milvuspb.ComponentStates{
+				State:  &milvuspb.ComponentInfo{StateCode: commonpb.StateCode_Healthy},
+				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			},
+			nil).Once()
+	}
+	server.targetObserver.PauseTargetUpdates(time.Now())
+	defer server.targetObserver.ResumeTargetUpdates()
+	resp, err = server.CheckHealth(ctx, &milvuspb.CheckHealthRequest{})
+	suite.NoError(err)
+	suite.Equal(resp.IsHealthy, true)
+	suite.Len(resp.Reasons, 1)
+	suite.Contains(resp.Reasons[0], "target updates are paused")
+
+	// Test for balance suspended
+	for _, node := range suite.nodes {
+		suite.cluster.EXPECT().GetComponentStates(mock.Anything, node).Return(
+			&milvuspb.ComponentStates{
+				State:  &milvuspb.ComponentInfo{StateCode: commonpb.StateCode_Healthy},
+				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			},
+			nil).Once()
+	}
+	server.targetObserver.ResumeTargetUpdates()
+	server.checkerController.Deactivate(utils.BalanceChecker)
+	defer server.checkerController.Activate(utils.BalanceChecker)
+	resp, err = server.CheckHealth(ctx, &milvuspb.CheckHealthRequest{})
+	suite.NoError(err)
+	suite.Equal(resp.IsHealthy, true)
+	suite.Len(resp.Reasons, 1)
+	suite.Contains(resp.Reasons[0], "balance is suspended")
+}
+
+func (suite *ServiceSuite) TestCheckNodeHealth() {
+	ctx := context.Background()
+	server := suite.server
+
+	// Test for server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err := server.CheckNodeHealth(ctx, &querypb.CheckNodeHealthRequest{})
+	suite.NoError(err)
+	suite.False(resp.GetIsHealthy())
+	suite.NotEmpty(resp.GetReasons())
+	server.UpdateStateCode(commonpb.StateCode_Healthy)
+
+	paramtable.Get().Save(Params.QueryCoordCfg.CheckHealthRPCTimeout.Key, "1000")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.CheckHealthRPCTimeout.Key)
+
+	abnormalNode, hungNode := suite.nodes[0], suite.nodes[1]
+	for _, node := range suite.nodes {
+		switch node {
+		case abnormalNode:
+			suite.cluster.EXPECT().GetComponentStates(mock.Anything, node).Return(
+				&milvuspb.ComponentStates{
+					State:  &milvuspb.ComponentInfo{StateCode: commonpb.StateCode_Abnormal},
+					Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+				},
+				nil).Once()
+		case hungNode:
+			// all attempts to the hung node share the rpc timeout
+			suite.cluster.EXPECT().GetComponentStates(mock.Anything, node).RunAndReturn(
+				func(ctx context.Context, node int64) (*milvuspb.ComponentStates, error) {
+					<-ctx.Done()
+					return nil, ctx.Err()
+				})
+		default:
+			suite.cluster.EXPECT().GetComponentStates(mock.Anything, node).Return(
+				&milvuspb.ComponentStates{
+					State:  &milvuspb.ComponentInfo{StateCode: commonpb.StateCode_Healthy},
+					Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+				},
+				nil).Once()
+		}
+	}
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	start := time.Now()
+	resp, err = server.CheckNodeHealth(ctx, &querypb.CheckNodeHealthRequest{})
+	suite.NoError(err)
+	suite.Less(time.Since(start), 2*time.Second)
+	suite.NoError(merr.Error(resp.GetStatus()))
+	suite.False(resp.GetIsHealthy())
+	// the reasons keep the same as CheckHealth
+	suite.Len(resp.GetReasons(), 2)
+	suite.Len(resp.GetNodes(), len(suite.nodes))
+	for i, health := range resp.GetNodes() {
+		if i > 0 {
+			suite.Less(resp.GetNodes()[i-1].GetNodeID(), health.GetNodeID())
+		}
+		switch health.GetNodeID() {
+		case abnormalNode:
+			suite.False(health.GetHealthy())
+			suite.NotEmpty(health.GetReason())
+		case hungNode:
+			suite.False(health.GetHealthy())
+			suite.Contains(health.GetReason(), "health check timeout")
+		default:
+			suite.True(health.GetHealthy())
+			suite.Empty(health.GetReason())
+		}
+	}
+}
+
+func (suite *ServiceSuite) TestCheckNodeHealthRetry() {
+	ctx := context.Background()
+	server := suite.server
+
+	paramtable.Get().Save(Params.QueryCoordCfg.CheckHealthRetryAttempts.Key, "3")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.CheckHealthRetryAttempts.Key)
+	paramtable.Get().Save(Params.QueryCoordCfg.CheckHealthRetryInterval.Key, "1")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.CheckHealthRetryInterval.Key)
+
+	healthy := &milvuspb.ComponentStates{
+		State:  &milvuspb.ComponentInfo{StateCode: commonpb.StateCode_Healthy},
+		Status: merr.Success(),
+	}
+	rpcErr := merr.WrapErrServiceNotReady("QueryNode", 0, "unreachable")
+
+	// the transient failure doesn't make the node unhealthy
+	flakyNode := suite.nodes[0]
+	for _, node := range suite.nodes {
+		if node == flakyNode {
+			suite.cluster.EXPECT().GetComponentStates(mock.Anything, node).Return(nil, rpcErr).Twice()
+		}
+		suite.cluster.EXPECT().GetComponentStates(mock.Anything, node).Return(healthy, nil).Once()
+	}
+	resp, err := server.CheckNodeHealth(ctx, &querypb.CheckNodeHealthRequest{})
+	suite.NoError(err)
+	suite.True(resp.GetIsHealthy())
+	suite.Empty(resp.GetReasons())
+
+	// the node is unhealthy after the retries are exhausted
+	for _, node := range suite.nodes {
+		if node == flakyNode {
+			suite.cluster.EXPECT().GetComponentStates(mock.Anything, node).Return(nil, rpcErr).Times(3)
+			continue
+		}
+		suite.cluster.EXPECT().GetComponentStates(mock.Anything, node).Return(healthy, nil).Once()
+	}
+	resp, err = server.CheckNodeHealth(ctx, &querypb.CheckNodeHealthRequest{})
+	suite.NoError(err)
+	suite.False(resp.GetIsHealthy())
+	suite.Len(resp.GetReasons(), 1)
+	health, ok := lo.Find(resp.GetNodes(), func(health *querypb.NodeHealth) bool {
+		return health.GetNodeID() == flakyNode
+	})
+	suite.Require().True(ok)
+	suite.False(health.GetHealthy())
+}
+
+func (suite *ServiceSuite) TestCheckNodeHealthConcurrency() {
+	ctx := context.Background()
+	server := suite.server
+
+	paramtable.Get().Save(Params.QueryCoordCfg.CheckHealthMaxConcurrency.Key, "2")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.CheckHealthMaxConcurrency.Key)
+
+	running := atomic.NewInt32(0)
+	maxRunning := atomic.NewInt32(0)
+	for _, node := range suite.nodes {
+		suite.cluster.EXPECT().GetComponentStates(mock.Anything, node).RunAndReturn(
+			func(ctx context.Context, node int64) (*milvuspb.ComponentStates, error) {
+				current := running.Inc()
+				defer running.Dec()
+				for {
+					prev := maxRunning.Load()
+					if current <= prev || maxRunning.CompareAndSwap(prev, current) {
+						break
+					}
+				}
+				time.Sleep(10 * time.Millisecond)
+				return &milvuspb.ComponentStates{
+					State:  &milvuspb.ComponentInfo{StateCode: commonpb.StateCode_Healthy},
+					Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+				}, nil
+			}).Once()
+	}
+	resp, err := server.CheckNodeHealth(ctx, &querypb.CheckNodeHealthRequest{})
+	suite.NoError(err)
+	suite.True(resp.GetIsHealthy())
+	suite.Len(resp.GetNodes(), len(suite.nodes))
+	suite.LessOrEqual(maxRunning.Load(), int32(2))
+}
+
+func (suite *ServiceSuite) TestGetShardLeaders() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	for _, collection := range suite.collections {
+		suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+		suite.updateChannelDist(collection)
+		req := &querypb.GetShardLeadersRequest{
+			CollectionID: collection,
+		}
+
+		suite.fetchHeartbeats(time.Now())
+		resp, err := server.GetShardLeaders(ctx, req)
+		suite.NoError(err)
+		suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		suite.Len(resp.Shards, len(suite.channels[collection]))
+		for _, shard := range resp.Shards {
+			suite.Len(shard.NodeIds, int(suite.replicaNumber[collection]))
+		}
+		suite.Equal(suite.dist.LeaderViewManager.GetRoutingGeneration(), resp.GetRoutingGeneration())
+	}
+
+	// routing generation keeps if the leaders don't change, and bumps after leader changed
+	suite.updateChannelDist(suite.collections[0])
+	generation := suite.dist.LeaderViewManager.GetRoutingGeneration()
+	suite.updateChannelDist(suite.collections[0])
+	resp, err := server.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+		CollectionID: suite.collections[0],
+	})
+	suite.NoError(err)
+	suite.Equal(generation, resp.GetRoutingGeneration())
+	leaders := suite.dist.LeaderViewManager.GetByFilter(meta.WithCollectionID2LeaderView(suite.collections[0]))
+	suite.NotEmpty(leaders)
+	suite.dist.LeaderViewManager.Update(leaders[0].ID)
+	suite.Greater(suite.dist.LeaderViewManager.GetRoutingGeneration(), generation)
+
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	req := &querypb.GetShardLeadersRequest{
+		CollectionID: suite.collections[0],
+	}
+	resp, err = server.GetShardLeaders(ctx, req)
+	suite.NoError(err)
+	suite.Equal(resp.GetStatus().GetCode(), merr.Code(merr.ErrServiceNotReady))
+}
+
+func (suite *ServiceSuite) TestGetShardLeadersBatch() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	for _, collection := range suite.collections {
+		suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+	}
+	suite.updateChannelDistOfCollections(suite.collections...)
+	suite.fetchHeartbeats(time.Now())
+
+	// the not loaded collection fails alone
+	notLoaded := int64(999)
+	req := &querypb.GetShardLeadersBatchRequest{
+		CollectionIDs: append([]int64{notLoaded}, suite.collections...),
+	}
+	resp, err := server.GetShardLeadersBatch(ctx, req)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	suite.Len(resp.GetCollections(), len(suite.collections)+1)
+	for _, collection := range resp.GetCollections() {
+		if collection.GetCollectionID() == notLoaded {
+			suite.Equal(merr.Code(merr.ErrCollectionNotLoaded), collection.GetLeaders().GetStatus().GetCode())
+			continue
+		}
+		suite.Equal(commonpb.ErrorCode_Success, collection.GetLeaders().GetStatus().GetErrorCode())
+		suite.Len(collection.GetLeaders().GetShards(), len(suite.channels[collection.GetCollectionID()]))
+		for _, shard := range collection.GetLeaders().GetShards() {
+			suite.Len(shard.NodeIds, int(suite.replicaNumber[collection.GetCollectionID()]))
+		}
+	}
+
+	// resource group not found
+	resp, err = server.GetShardLeadersBatch(ctx, &querypb.GetShardLeadersBatchRequest{
+		CollectionIDs: suite.collections,
+		ResourceGroup: "rg_not_exist",
+	})
+	suite.NoError(err)
+	suite.Equal(merr.Code(merr.ErrResourceGroupNotFound), resp.GetStatus().GetCode())
+
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err = server.GetShardLeadersBatch(ctx, req)
+	suite.NoError(err)
+	suite.Equal(merr.Code(merr.ErrServiceNotReady), resp.GetStatus().GetCode())
+}
+
+func (suite *ServiceSuite) TestGetHandoffLag() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	collection := suite.collections[0]
+	suite.updateChannelDist(collection)
+	suite.NoError(suite.targetMgr.UpdateCollectionNextTarget(collection))
+
+	// all segments of the next target are served
+	req := &querypb.GetHandoffLagRequest{
+		CollectionID: collection,
+	}
+	resp, err := server.GetHandoffLag(ctx, req)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	suite.Len(resp.GetShards(), len(suite.channels[collection]))
+	for _, shard := range resp.GetShards() {
+		suite.Empty(shard.GetPendingSegmentIDs())
+		suite.Zero(shard.GetStalenessMs())
+	}
+
+	// the leader lacks a segment of the next target
+	channel := suite.channels[collection][1]
+	view := suite.dist.LeaderViewManager.GetByFilter(meta.WithChannelName2LeaderView(channel))[0].Clone()
+	delete(view.Segments, 1)
+	suite.dist.LeaderViewManager.Update(view.ID, view)
+	time.Sleep(time.Millisecond)
+	resp, err = server.GetHandoffLag(ctx, req)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	for _, shard := range resp.GetShards() {
+		if shard.GetChannel() == channel {
+			suite.Equal(view.ID, shard.GetLeaderID())
+			suite.Equal([]int64{1}, shard.GetPendingSegmentIDs())
+			suite.Positive(shard.GetStalenessMs())
+		} else {
+			suite.Empty(shard.GetPendingSegmentIDs())
+		}
+	}
+
+	// collection not loaded
+	resp, err = server.GetHandoffLag(ctx, &querypb.GetHandoffLagRequest{
+		CollectionID: 999,
+	})
+	suite.NoError(err)
+	suite.Equal(merr.Code(merr.ErrCollectionNotLoaded), resp.GetStatus().GetCode())
+
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err = server.GetHandoffLag(ctx, req)
+	suite.NoError(err)
+	suite.Equal(merr.Code(merr.ErrServiceNotReady), resp.GetStatus().GetCode())
+}
+
+func (suite *ServiceSuite) TestGetCollectionTargets() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	collection := suite.collections[0]
+	suite.NoError(suite.targetMgr.UpdateCollectionNextTarget(collection))
+
+	req := &querypb.GetCollectionTargetsRequest{
+		CollectionID: collection,
+	}
+	resp, err := server.GetCollectionTargets(ctx, req)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	for scope, target := range map[meta.TargetScope]*querypb.TargetSnapshot{
+		meta.CurrentTarget: resp.GetCurrentTarget(),
+		meta.NextTarget:    resp.GetNextTarget(),
+	} {
+		suite.Equal(suite.targetMgr.GetCollectionTargetVersion(collection, scope), target.GetVersion())
+		suite.ElementsMatch(lo.Keys(suite.targetMgr.GetSealedSegmentsByCollection(collection, scope)), target.GetSealedSegmentIDs())
+		suite.ElementsMatch(lo.Keys(suite.targetMgr.GetDmChannelsByCollection(collection, scope)), target.GetChannels())
+	}
+	suite.ElementsMatch(suite.channels[collection], resp.GetNextTarget().GetChannels())
+
+	// collection not loaded
+	resp, err = server.GetCollectionTargets(ctx, &querypb.GetCollectionTargetsRequest{
+		CollectionID: 999,
+	})
+	suite.NoError(err)
+	suite.Equal(merr.Code(merr.ErrCollectionNotLoaded), resp.GetStatus().GetCode())
+
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err = server.GetCollectionTargets(ctx, req)
+	suite.NoError(err)
+	suite.Equal(merr.Code(merr.ErrServiceNotReady), resp.GetStatus().GetCode())
+}
+
+func (suite *ServiceSuite) TestGetShardLeadersWithGrowingFreshness() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	collection := suite.collections[0]
+	suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+	suite.updateChannelDist(collection)
+	suite.fetchHeartbeats(time.Now())
+
+	leader := suite.dist.LeaderViewManager.GetByFilter(meta.WithCollectionID2LeaderView(collection))[0].Clone()
+	leader.NumOfGrowingRows = 100
+	leader.GrowingSegments = map[int64]*meta.Segment{
+		1000: {SegmentInfo: &datapb.SegmentInfo{ID: 1000, StartPosition: &msgpb.MsgPosition{Timestamp: 10}}},
+		1001: {SegmentInfo: &datapb.SegmentInfo{ID: 1001, StartPosition: &msgpb.MsgPosition{Timestamp: 20}}},
+	}
+	suite.dist.LeaderViewManager.Update(leader.ID, leader)
+
+	// growing freshness is not returned by default
+	resp, err := server.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	for _, shard := range resp.GetShards() {
+		suite.Empty(shard.GetGrowingRowNums())
+		suite.Empty(shard.GetGrowingTimestamps())
+	}
+
+	resp, err = server.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+		CollectionID:         collection,
+		WithGrowingFreshness: true,
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	for _, shard := range resp.GetShards() {
+		suite.Len(shard.GetGrowingRowNums(), len(shard.GetNodeIds()))
+		suite.Len(shard.GetGrowingTimestamps(), len(shard.GetNodeIds()))
+		if shard.GetChannelName() != leader.Channel {
+			continue
+		}
+		idx := lo.IndexOf(shard.GetNodeIds(), leader.ID)
+		suite.Require().GreaterOrEqual(idx, 0)
+		suite.EqualValues(100, shard.GetGrowingRowNums()[idx])
+		suite.EqualValues(20, shard.GetGrowingTimestamps()[idx])
+	}
+}
+
+func (suite *ServiceSuite) TestGetShardLeadersWithReadableReplicaCount() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	for _, collection := range suite.collections {
+		suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+		suite.updateChannelDist(collection)
+		suite.fetchHeartbeats(time.Now())
+
+		// readable replica count is not returned by default
+		resp, err := server.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+			CollectionID: collection,
+		})
+		suite.NoError(err)
+		suite.True(merr.Ok(resp.GetStatus()))
+		for _, shard := range resp.GetShards() {
+			suite.Zero(shard.GetReadableReplicaCount())
+		}
+
+		resp, err = server.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+			CollectionID:             collection,
+			WithReadableReplicaCount: true,
+		})
+		suite.NoError(err)
+		suite.True(merr.Ok(resp.GetStatus()))
+		replicaNum := len(suite.meta.ReplicaManager.GetByCollection(collection))
+		suite.EqualValues(replicaNum, resp.GetReadableReplicaCount())
+		for _, shard := range resp.GetShards() {
+			suite.EqualValues(replicaNum, shard.GetReadableReplicaCount())
+			suite.Len(shard.GetNodeIds(), replicaNum)
+		}
+	}
+
+	// a replica without the leader of one channel is not readable
+	collection := suite.collections[1]
+	suite.updateChannelDist(collection)
+	replicas := suite.meta.ReplicaManager.GetByCollection(collection)
+	views := suite.dist.LeaderViewManager.GetByFilter(meta.WithReplica2LeaderView(replicas[0]))
+	suite.Require().NotEmpty(views)
+	suite.dist.LeaderViewManager.Update(views[0].ID, lo.Filter(views, func(view *meta.LeaderView, _ int) bool {
+		return view.ID == views[0].ID && view.Channel != views[0].Channel
+	})...)
+	resp, err := server.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+		CollectionID:             collection,
+		WithReadableReplicaCount: true,
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.EqualValues(len(replicas)-1, resp.GetReadableReplicaCount())
+}
+
+func (suite *ServiceSuite) TestGetShardLeadersWithReadableLeaderNum() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	collection := suite.collections[1]
+	channels := suite.channels[collection]
+	suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+	suite.updateChannelDist(collection)
+	suite.fetchHeartbeats(time.Now())
+
+	// a replica has two leaders of the first channel during balance
+	replica, ok := lo.Find(suite.meta.ReplicaManager.GetByCollection(collection), func(replica *meta.Replica) bool {
+		return replica.NodesCount() >= 2
+	})
+	suite.Require().True(ok)
+	node := suite.sortInt64(replica.GetNodes())[1]
+	segments := lo.Flatten(lo.Values(suite.segments[collection]))
+	views := make([]*meta.LeaderView, 0, 2)
+	for _, channel := range []string{channels[1], channels[0]} {
+		views = append(views, &meta.LeaderView{
+			ID:           node,
+			CollectionID: collection,
+			Channel:      channel,
+			Segments: lo.SliceToMap(segments, func(segment int64) (int64, *querypb.SegmentDist) {
+				return segment, &querypb.SegmentDist{NodeID: node, Version: time.Now().Unix()}
+			}),
+		})
 	}
+	suite.dist.LeaderViewManager.Update(node, views...)
 
-	// Test load balance with source node and dest node not in the same replica
-	for _, collection := range suite.collections {
-		if suite.replicaNumber[collection] <= 1 {
-			continue
+	resp, err := server.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	replicaNum := int(suite.replicaNumber[collection])
+	for _, shard := range resp.GetShards() {
+		// the node ids are still deduplicated by replica
+		suite.Len(shard.GetNodeIds(), replicaNum)
+		if shard.GetChannelName() == channels[0] {
+			suite.EqualValues(replicaNum+1, shard.GetReadableLeaderNum())
+		} else {
+			suite.EqualValues(replicaNum, shard.GetReadableLeaderNum())
 		}
+	}
 
-		replicas := suite.meta.ReplicaManager.GetByCollection(collection)
-		srcNode := replicas[0].GetNodes()[0]
-		dstNode := replicas[1].GetNodes()[0]
-		suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
-		suite.updateSegmentDist(collection, srcNode)
-		segments := suite.getAllSegments(collection)
-		req := &querypb.LoadBalanceRequest{
-			CollectionID:     collection,
-			SourceNodeIDs:    []int64{srcNode},
-			DstNodeIDs:       []int64{dstNode},
-			SealedSegmentIDs: segments,
+	// the unavailable leaders are not counted
+	suite.nodeMgr.Remove(node)
+	resp, err = server.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	for _, shard := range resp.GetShards() {
+		if shard.GetChannelName() == channels[0] {
+			suite.EqualValues(replicaNum, shard.GetReadableLeaderNum())
 		}
-		resp, err := server.LoadBalance(ctx, req)
-		suite.NoError(err)
-		suite.ErrorIs(merr.Error(resp), merr.ErrNodeNotFound)
 	}
+}
 
-	// Test balance task failed
-	for _, collection := range suite.collections {
-		replicas := suite.meta.ReplicaManager.GetByCollection(collection)
-		nodes := replicas[0].GetNodes()
-		srcNode := nodes[0]
-		dstNode := nodes[1]
-		suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
-		suite.updateSegmentDist(collection, srcNode)
-		segments := suite.getAllSegments(collection)
-		req := &querypb.LoadBalanceRequest{
-			CollectionID:     collection,
-			SourceNodeIDs:    []int64{srcNode},
-			DstNodeIDs:       []int64{dstNode},
-			SealedSegmentIDs: segments,
+func (suite *ServiceSuite) TestGetShardLeadersWithLeaderErrors() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	collection := suite.collections[0]
+	suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+	suite.updateChannelDist(collection)
+	suite.fetchHeartbeats(time.Now())
+
+	leader := suite.dist.LeaderViewManager.GetByFilter(meta.WithCollectionID2LeaderView(collection))[0]
+	checkHealth := func(abnormal int64) {
+		for _, node := range suite.nodes {
+			stateCode := commonpb.StateCode_Healthy
+			if node == abnormal {
+				stateCode = commonpb.StateCode_Abnormal
+			}
+			suite.cluster.EXPECT().GetComponentStates(mock.Anything, node).Return(
+				&milvuspb.ComponentStates{
+					State:  &milvuspb.ComponentInfo{StateCode: stateCode},
+					Status: merr.Success(),
+				},
+				nil).Once()
 		}
-		suite.taskScheduler.EXPECT().Add(mock.Anything).Run(func(balanceTask task.Task) {
-			balanceTask.Cancel(errors.New("mock error"))
-		}).Return(nil)
-		resp, err := server.LoadBalance(ctx, req)
+		_, err := server.CheckHealth(ctx, &milvuspb.CheckHealthRequest{})
 		suite.NoError(err)
-		suite.Equal(commonpb.ErrorCode_UnexpectedError, resp.ErrorCode)
-		suite.Contains(resp.Reason, "mock error")
+	}
+	checkHealth(leader.ID)
 
-		suite.meta.ReplicaManager.RecoverNodesInCollection(collection, map[string]typeutil.UniqueSet{meta.DefaultResourceGroupName: typeutil.NewUniqueSet(10)})
-		req.SourceNodeIDs = []int64{10}
-		resp, err = server.LoadBalance(ctx, req)
-		suite.NoError(err)
-		suite.Equal(commonpb.ErrorCode_UnexpectedError, resp.ErrorCode)
+	// leader errors are not returned by default
+	resp, err := server.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	for _, shard := range resp.GetShards() {
+		suite.Empty(shard.GetLeaderErrors())
+	}
 
-		req.SourceNodeIDs = []int64{srcNode}
-		req.DstNodeIDs = []int64{10}
-		resp, err = server.LoadBalance(ctx, req)
-		suite.NoError(err)
-		suite.Equal(commonpb.ErrorCode_UnexpectedError, resp.ErrorCode)
+	resp, err = server.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+		CollectionID:     collection,
+		WithLeaderErrors: true,
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	for _, shard := range resp.GetShards() {
+		if shard.GetChannelName() != leader.Channel {
+			suite.Empty(shard.GetLeaderErrors())
+			continue
+		}
+		suite.Require().Len(shard.GetLeaderErrors(), 1)
+		suite.Equal(leader.ID, shard.GetLeaderErrors()[0].GetNodeID())
+		suite.NotEmpty(shard.GetLeaderErrors()[0].GetReason())
+	}
 
-		suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
-			NodeID:   10,
-			Address:  "localhost",
-			Hostname: "localhost",
-		}))
-		suite.nodeMgr.Stopping(10)
-		resp, err = server.LoadBalance(ctx, req)
-		suite.NoError(err)
-		suite.Equal(commonpb.ErrorCode_UnexpectedError, resp.ErrorCode)
-		suite.nodeMgr.Remove(10)
-		suite.meta.ReplicaManager.RemoveNode(replicas[0].GetID(), 10)
+	// the error is cleared once the leader recovered, and the error of the removed node is cleared too
+	server.nodeServingErrors.Insert(1000, &nodeServingError{reason: "removed", timestamp: time.Now()})
+	checkHealth(-1)
+	_, ok := server.nodeServingErrors.Get(1000)
+	suite.False(ok)
+	resp, err = server.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+		CollectionID:     collection,
+		WithLeaderErrors: true,
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	for _, shard := range resp.GetShards() {
+		suite.Empty(shard.GetLeaderErrors())
 	}
 }
 
-func (suite *ServiceSuite) TestShowConfigurations() {
+func (suite *ServiceSuite) TestRecommendReplicaCount() {
+	paramtable.Get().Save(Params.QueryCoordCfg.ReplicaRecommendNodeCapacity.Key, "100")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.ReplicaRecommendNodeCapacity.Key)
+
+	suite.loadAll()
 	ctx := context.Background()
 	server := suite.server
 
-	req := &internalpb.ShowConfigurationsRequest{
-		Pattern: "querycoord.Port",
-	}
-	resp, err := server.ShowConfigurations(ctx, req)
+	// Test collection not loaded
+	resp, err := server.RecommendReplicaCount(ctx, &querypb.RecommendReplicaCountRequest{
+		CollectionID: 999,
+	})
 	suite.NoError(err)
-	suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
-	suite.Len(resp.Configuations, 1)
-	suite.Equal("querycoord.port", resp.Configuations[0].Key)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrCollectionNotLoaded)
+
+	collection := suite.collections[0]
+	suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+	suite.updateChannelDist(collection)
+	suite.mockNodeNQRate(60)
+
+	// Test invalid target utilization
+	resp, err = server.RecommendReplicaCount(ctx, &querypb.RecommendReplicaCountRequest{
+		CollectionID:      collection,
+		TargetUtilization: 1.5,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+
+	resp, err = server.RecommendReplicaCount(ctx, &querypb.RecommendReplicaCountRequest{
+		CollectionID:      collection,
+		TargetUtilization: 0.5,
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Equal(suite.replicaNumber[collection], resp.GetCurrentReplicaNumber())
+	suite.Len(resp.GetShards(), len(suite.channels[collection]))
+	// every node serves a single shard leader
+	replicaNum := float64(suite.replicaNumber[collection])
+	required := int32(math.Ceil(60 * replicaNum / 50))
+	for _, shard := range resp.GetShards() {
+		suite.InDelta(60*replicaNum, shard.GetNqPerSecond(), 1e-9)
+		suite.Equal(required, shard.GetRequiredReplicaNumber())
+	}
+	suite.LessOrEqual(resp.GetRecommendedReplicaNumber(), required)
+	suite.Positive(resp.GetRecommendedReplicaNumber())
+	suite.NotEmpty(resp.GetRationale())
 
 	// Test when server is not healthy
 	server.UpdateStateCode(commonpb.StateCode_Initializing)
-	req = &internalpb.ShowConfigurationsRequest{
-		Pattern: "querycoord.Port",
-	}
-	resp, err = server.ShowConfigurations(ctx, req)
+	resp, err = server.RecommendReplicaCount(ctx, &querypb.RecommendReplicaCountRequest{
+		CollectionID: collection,
+	})
 	suite.NoError(err)
 	suite.Equal(resp.GetStatus().GetCode(), merr.Code(merr.ErrServiceNotReady))
 }
 
-func (suite *ServiceSuite) TestGetMetrics() {
+func (suite *ServiceSuite) TestGetShardLeadersWithBlockedShard() {
+	suite.loadAll()
 	ctx := context.Background()
 	server := suite.server
 
-	for _, node := range suite.nodes {
-		suite.cluster.EXPECT().GetMetrics(ctx, node, mock.Anything).Return(&milvuspb.GetMetricsResponse{
-			Status:        merr.Success(),
-			ComponentName: "QueryNode",
-		}, nil)
-	}
+	collection := suite.collections[0]
+	suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+	suite.updateChannelDist(collection)
+	suite.fetchHeartbeats(time.Now())
+
+	blocked := suite.channels[collection][0]
+	suite.NoError(suite.meta.ShardBlockManager.BlockShard(collection, blocked, "corrupt index"))
+	// the caller doesn't route around the blocked shards
+	resp, err := server.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrChannelNotAvailable)
+	suite.Empty(resp.GetShards())
+	suite.Equal([]string{blocked}, resp.GetBlockedChannels())
 
-	metricReq := make(map[string]string)
-	metricReq[metricsinfo.MetricTypeKey] = "system_info"
-	req, err := json.Marshal(metricReq)
+	resp, err = server.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+		CollectionID:         collection,
+		AllowBlockedChannels: true,
+	})
 	suite.NoError(err)
-	resp, err := server.GetMetrics(ctx, &milvuspb.GetMetricsRequest{
-		Base:    &commonpb.MsgBase{},
-		Request: string(req),
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Len(resp.GetShards(), len(suite.channels[collection])-1)
+	for _, shard := range resp.GetShards() {
+		suite.NotEqual(blocked, shard.GetChannelName())
+	}
+	suite.Equal([]string{blocked}, resp.GetBlockedChannels())
+	suite.Empty(resp.GetUnavailableChannels())
+
+	// no shard left to serve
+	for _, channel := range suite.channels[collection][1:] {
+		suite.NoError(suite.meta.ShardBlockManager.BlockShard(collection, channel, "corrupt index"))
+	}
+	resp, err = server.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+		CollectionID:         collection,
+		AllowBlockedChannels: true,
 	})
 	suite.NoError(err)
-	suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrChannelNotAvailable)
+	suite.ElementsMatch(suite.channels[collection], resp.GetBlockedChannels())
+	for _, channel := range suite.channels[collection][1:] {
+		suite.NoError(suite.meta.ShardBlockManager.UnblockShard(collection, channel))
+	}
 
-	// Test when server is not healthy
-	server.UpdateStateCode(commonpb.StateCode_Initializing)
-	resp, err = server.GetMetrics(ctx, &milvuspb.GetMetricsRequest{
-		Base:    &commonpb.MsgBase{},
-		Request: string(req),
+	suite.NoError(suite.meta.ShardBlockManager.UnblockShard(collection, blocked))
+	resp, err = server.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+		CollectionID: collection,
 	})
 	suite.NoError(err)
-	suite.Equal(resp.GetStatus().GetCode(), merr.Code(merr.ErrServiceNotReady))
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Len(resp.GetShards(), len(suite.channels[collection]))
+	suite.Empty(resp.GetBlockedChannels())
 }
 
-func (suite *ServiceSuite) TestGetReplicas() {
+func (suite *ServiceSuite) TestGetShardLeadersWithResourceGroup() {
 	suite.loadAll()
 	ctx := context.Background()
 	server := suite.server
 
+	err := suite.meta.ResourceManager.AddResourceGroup("rg1", &rgpb.ResourceGroupConfig{
+		Requests: &rgpb.ResourceGroupLimit{NodeNum: 0},
+		Limits:   &rgpb.ResourceGroupLimit{NodeNum: 0},
+	})
+	suite.NoError(err)
+
 	for _, collection := range suite.collections {
+		suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
 		suite.updateChannelDist(collection)
-		req := &milvuspb.GetReplicasRequest{
-			CollectionID: collection,
-		}
-		resp, err := server.GetReplicas(ctx, req)
+		suite.fetchHeartbeats(time.Now())
+
+		// all nodes are in default resource group
+		resp, err := server.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+			CollectionID:  collection,
+			ResourceGroup: meta.DefaultResourceGroupName,
+		})
 		suite.NoError(err)
-		suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
-		suite.EqualValues(suite.replicaNumber[collection], len(resp.Replicas))
-	}
+		suite.True(merr.Ok(resp.GetStatus()))
+		suite.Len(resp.Shards, len(suite.channels[collection]))
 
-	// Test get with shard nodes
-	for _, collection := range suite.collections {
-		replicas := suite.meta.ReplicaManager.GetByCollection(collection)
-		for _, replica := range replicas {
-			suite.updateSegmentDist(collection, replica.GetNodes()[0])
-		}
-		suite.updateChannelDist(collection)
-		req := &milvuspb.GetReplicasRequest{
-			CollectionID:   collection,
-			WithShardNodes: true,
-		}
-		resp, err := server.GetReplicas(ctx, req)
+		// no leader in rg1
+		resp, err = server.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+			CollectionID:  collection,
+			ResourceGroup: "rg1",
+		})
 		suite.NoError(err)
-		suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
-		suite.EqualValues(suite.replicaNumber[collection], len(resp.Replicas))
+		suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrChannelNotAvailable)
 
-		// Test no dup nodeIDs in shardReplica
-		for _, replica := range resp.GetReplicas() {
-			suite.Equal(collection, replica.CollectionID)
-			for _, shardReplica := range replica.GetShardReplicas() {
-				gotNodeIDsSet := typeutil.NewUniqueSet(shardReplica.GetNodeIds()...)
-				suite.Equal(len(shardReplica.GetNodeIds()), gotNodeIDsSet.Len())
-				if len(resp.GetReplicas()) > 0 {
-					suite.True(gotNodeIDsSet.Len() < len(replica.GetNodeIds()))
-				}
-			}
+		// resource group not found
+		resp, err = server.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+			CollectionID:  collection,
+			ResourceGroup: "rg2",
+		})
+		suite.NoError(err)
+		suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrResourceGroupNotFound)
+	}
+}
 
-			suite.Equal(len(replica.GetNodeIds()), len(suite.meta.ReplicaManager.Get(replica.ReplicaID).GetNodes()))
-		}
+func (suite *ServiceSuite) TestGetShardLeadersWithPreferZone() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	collection := suite.collections[1]
+	suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+	suite.updateChannelDist(collection)
+
+	// nodes of the first replica are in zone az1
+	replicas := suite.meta.ReplicaManager.GetByCollection(collection)
+	localNodes := replicas[0].GetNodes()
+	for _, node := range localNodes {
+		suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   node,
+			Address:  "localhost",
+			Hostname: "localhost",
+			Labels:   map[string]string{session.ZoneLabel: "az1"},
+		}))
 	}
+	suite.fetchHeartbeats(time.Now())
 
-	// Test when server is not healthy
-	server.UpdateStateCode(commonpb.StateCode_Initializing)
-	req := &milvuspb.GetReplicasRequest{
-		CollectionID: suite.collections[0],
+	resp, err := server.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+		CollectionID: collection,
+		PreferZone:   "az1",
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Len(resp.GetShards(), len(suite.channels[collection]))
+	for _, shard := range resp.GetShards() {
+		suite.False(shard.GetZoneFallback())
+		suite.Len(shard.GetNodeIds(), 1)
+		suite.Contains(localNodes, shard.GetNodeIds()[0])
 	}
-	resp, err := server.GetReplicas(ctx, req)
+
+	// no leader in az2, fallback to all leaders
+	resp, err = server.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+		CollectionID: collection,
+		PreferZone:   "az2",
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	for _, shard := range resp.GetShards() {
+		suite.True(shard.GetZoneFallback())
+		suite.Len(shard.GetNodeIds(), len(replicas))
+	}
+}
+
+func (suite *ServiceSuite) TestGetShardLeadersWithWaitTimeout() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	collection := suite.collections[0]
+	suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+	suite.fetchHeartbeats(time.Now())
+
+	// no leader, fail immediately
+	resp, err := server.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrChannelNotAvailable)
+	suite.ElementsMatch(suite.channels[collection], resp.GetUnavailableChannels())
+
+	// no leader until timeout
+	start := time.Now()
+	resp, err = server.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+		CollectionID: collection,
+		WaitTimeout:  100,
+	})
+	suite.NoError(err)
+	suite.GreaterOrEqual(time.Since(start), 100*time.Millisecond)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrChannelNotAvailable)
+	suite.ElementsMatch(suite.channels[collection], resp.GetUnavailableChannels())
+
+	// no leader until the configured timeout
+	paramtable.Get().Save(Params.QueryCoordCfg.ShardLeaderWaitTimeout.Key, "100")
+	start = time.Now()
+	resp, err = server.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.GreaterOrEqual(time.Since(start), 100*time.Millisecond)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrChannelNotAvailable)
+
+	// the negative wait timeout opts out of the configured one
+	paramtable.Get().Save(Params.QueryCoordCfg.ShardLeaderWaitTimeout.Key, "10000")
+	start = time.Now()
+	resp, err = server.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+		CollectionID: collection,
+		WaitTimeout:  -1,
+	})
+	suite.NoError(err)
+	suite.Less(time.Since(start), time.Second)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrChannelNotAvailable)
+	suite.ElementsMatch(suite.channels[collection], resp.GetUnavailableChannels())
+	paramtable.Get().Reset(Params.QueryCoordCfg.ShardLeaderWaitTimeout.Key)
+
+	// the waiting stops once the request context is done
+	cancelCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	start = time.Now()
+	resp, err = server.GetShardLeaders(cancelCtx, &querypb.GetShardLeadersRequest{
+		CollectionID: collection,
+		WaitTimeout:  10000,
+	})
+	suite.NoError(err)
+	suite.Less(time.Since(start), 10*time.Second)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrChannelNotAvailable)
+
+	// leaders become available during waiting
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		suite.updateChannelDist(collection)
+	}()
+	resp, err = server.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+		CollectionID: collection,
+		WaitTimeout:  10000,
+	})
 	suite.NoError(err)
-	suite.Equal(resp.GetStatus().GetCode(), merr.Code(merr.ErrServiceNotReady))
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Len(resp.GetShards(), len(suite.channels[collection]))
+	suite.Empty(resp.GetUnavailableChannels())
 }
 
-func (suite *ServiceSuite) TestGetReplicasWhenNoAvailableNodes() {
+func (suite *ServiceSuite) TestGetShardLeadersWithStandbyReplica() {
 	suite.loadAll()
 	ctx := context.Background()
 	server := suite.server
 
-	replicas := suite.meta.ReplicaManager.GetByCollection(suite.collections[0])
-	for _, replica := range replicas {
-		suite.updateSegmentDist(suite.collections[0], replica.GetNodes()[0])
-	}
-	suite.updateChannelDist(suite.collections[0])
+	collection := suite.collections[1]
+	suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+	suite.updateChannelDist(collection)
+	suite.fetchHeartbeats(time.Now())
 
-	suite.meta.ReplicaManager.Put(utils.CreateTestReplica(100001, suite.collections[0], []int64{}))
+	replicas := suite.meta.ReplicaManager.GetByCollection(collection)
+	standbyIDs := lo.Map(replicas[1:], func(replica *meta.Replica, _ int) int64 { return replica.GetID() })
+	suite.NoError(suite.meta.ReplicaManager.SetStandby(true, standbyIDs...))
 
-	req := &milvuspb.GetReplicasRequest{
-		CollectionID:   suite.collections[0],
-		WithShardNodes: true,
+	// standby replicas are excluded
+	req := &querypb.GetShardLeadersRequest{
+		CollectionID: collection,
 	}
-	resp, err := server.GetReplicas(ctx, req)
+	resp, err := server.GetShardLeaders(ctx, req)
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	for _, shard := range resp.Shards {
+		suite.Len(shard.NodeIds, 1)
+	}
+
+	// standby replicas serve when primary replica unavailable
+	for _, node := range replicas[0].GetNodes() {
+		suite.dist.LeaderViewManager.Update(node)
+	}
+	resp, err = server.GetShardLeaders(ctx, req)
 	suite.NoError(err)
 	suite.True(merr.Ok(resp.GetStatus()))
+	for _, shard := range resp.Shards {
+		suite.Len(shard.NodeIds, len(standbyIDs))
+	}
 }
 
-func (suite *ServiceSuite) TestCheckHealth() {
+func (suite *ServiceSuite) TestGetShardLeadersWithReplica() {
+	suite.loadAll()
 	ctx := context.Background()
 	server := suite.server
 
-	// Test for server is not healthy
-	server.UpdateStateCode(commonpb.StateCode_Initializing)
-	resp, err := server.CheckHealth(ctx, &milvuspb.CheckHealthRequest{})
-	suite.NoError(err)
-	suite.Equal(resp.IsHealthy, false)
-	suite.NotEmpty(resp.Reasons)
+	collection := suite.collections[1]
+	suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+	suite.updateChannelDist(collection)
+	suite.fetchHeartbeats(time.Now())
 
-	// Test for components state fail
-	for _, node := range suite.nodes {
-		suite.cluster.EXPECT().GetComponentStates(mock.Anything, node).Return(
-			&milvuspb.ComponentStates{
-				State:  &milvuspb.ComponentInfo{StateCode: commonpb.StateCode_Abnormal},
-				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
-			},
-			nil).Once()
+	// only the leaders of the given replica are returned
+	replicas := suite.meta.ReplicaManager.GetByCollection(collection)
+	pinned := replicas[0]
+	req := &querypb.GetShardLeadersRequest{
+		CollectionID: collection,
+		ReplicaID:    pinned.GetID(),
 	}
-	server.UpdateStateCode(commonpb.StateCode_Healthy)
-	resp, err = server.CheckHealth(ctx, &milvuspb.CheckHealthRequest{})
+	resp, err := server.GetShardLeaders(ctx, req)
 	suite.NoError(err)
-	suite.Equal(resp.IsHealthy, false)
-	suite.NotEmpty(resp.Reasons)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Len(resp.GetShards(), len(suite.channels[collection]))
+	for _, shard := range resp.GetShards() {
+		suite.Len(shard.GetNodeIds(), 1)
+		suite.True(pinned.Contains(shard.GetNodeIds()[0]))
+	}
 
-	// Test for server is healthy
-	for _, node := range suite.nodes {
-		suite.cluster.EXPECT().GetComponentStates(mock.Anything, node).Return(
-			&milvuspb.ComponentStates{
-				State:  &milvuspb.ComponentInfo{StateCode: commonpb.StateCode_Healthy},
-				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
-			},
-			nil).Once()
+	// never fallback to other replicas
+	for _, node := range pinned.GetNodes() {
+		suite.dist.LeaderViewManager.Update(node)
 	}
-	resp, err = server.CheckHealth(ctx, &milvuspb.CheckHealthRequest{})
+	resp, err = server.GetShardLeaders(ctx, req)
 	suite.NoError(err)
-	suite.Equal(resp.IsHealthy, true)
-	suite.Empty(resp.Reasons)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrChannelNotAvailable)
+	suite.Contains(resp.GetStatus().GetReason(), "no available leader in replica")
+	suite.ElementsMatch(suite.channels[collection], resp.GetUnavailableChannels())
+
+	// the replica of other collection
+	otherReplica := suite.meta.ReplicaManager.GetByCollection(suite.collections[0])[0]
+	resp, err = server.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+		CollectionID: collection,
+		ReplicaID:    otherReplica.GetID(),
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrReplicaNotFound)
 }
 
-func (suite *ServiceSuite) TestGetShardLeaders() {
+func (suite *ServiceSuite) TestGetShardLeadersWithCache() {
+	paramtable.Get().Save(Params.QueryCoordCfg.ShardLeaderCacheTTL.Key, "60000")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.ShardLeaderCacheTTL.Key)
+
 	suite.loadAll()
 	ctx := context.Background()
 	server := suite.server
 
-	for _, collection := range suite.collections {
-		suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
-		suite.updateChannelDist(collection)
-		req := &querypb.GetShardLeadersRequest{
-			CollectionID: collection,
-		}
+	collection := suite.collections[1]
+	suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+	suite.updateChannelDist(collection)
+	suite.fetchHeartbeats(time.Now())
 
-		suite.fetchHeartbeats(time.Now())
-		resp, err := server.GetShardLeaders(ctx, req)
-		suite.NoError(err)
-		suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
-		suite.Len(resp.Shards, len(suite.channels[collection]))
-		for _, shard := range resp.Shards {
-			suite.Len(shard.NodeIds, int(suite.replicaNumber[collection]))
+	req := &querypb.GetShardLeadersRequest{
+		CollectionID: collection,
+	}
+	resp, err := server.GetShardLeaders(ctx, req)
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Equal(1, server.shardLeaderCache.Len())
+
+	// served from cache
+	cached, err := server.GetShardLeaders(ctx, req)
+	suite.NoError(err)
+	suite.True(merr.Ok(cached.GetStatus()))
+	suite.Equal(resp.GetShards(), cached.GetShards())
+	suite.Same(resp.GetShards()[0], cached.GetShards()[0])
+
+	// the cache is invalidated once a leader loses segments, which changes the leader view generation only
+	generation := suite.dist.LeaderViewManager.GetRoutingGeneration()
+	lost := cached.GetShards()[0]
+	lostLeader := lost.GetNodeIds()[0]
+	views := lo.Map(suite.dist.LeaderViewManager.GetByFilter(meta.WithNodeID2LeaderView(lostLeader)),
+		func(view *meta.LeaderView, _ int) *meta.LeaderView {
+			view = view.Clone()
+			if view.Channel == lost.GetChannelName() {
+				view.Segments = make(map[int64]*querypb.SegmentDist)
+			}
+			return view
+		})
+	viewGeneration := suite.dist.LeaderViewManager.GetViewGeneration()
+	suite.dist.LeaderViewManager.Update(lostLeader, views...)
+	suite.Equal(generation, suite.dist.LeaderViewManager.GetRoutingGeneration())
+	suite.Greater(suite.dist.LeaderViewManager.GetViewGeneration(), viewGeneration)
+	resp, err = server.GetShardLeaders(ctx, req)
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	for _, shard := range resp.GetShards() {
+		if shard.GetChannelName() == lost.GetChannelName() {
+			suite.NotContains(shard.GetNodeIds(), lostLeader)
 		}
 	}
+	suite.updateChannelDist(collection)
+	resp, err = server.GetShardLeaders(ctx, req)
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	cached = resp
 
-	// Test when server is not healthy
-	server.UpdateStateCode(commonpb.StateCode_Initializing)
-	req := &querypb.GetShardLeadersRequest{
-		CollectionID: suite.collections[0],
+	// the requests with leader errors are not cached
+	resp, err = server.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+		CollectionID:     collection,
+		WithLeaderErrors: true,
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Equal(1, server.shardLeaderCache.Len())
+
+	// the cache is invalidated once a leader goes offline
+	offline := cached.GetShards()[0].GetNodeIds()[0]
+	suite.nodeMgr.Remove(offline)
+	resp, err = server.GetShardLeaders(ctx, req)
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	for _, shard := range resp.GetShards() {
+		suite.NotContains(shard.GetNodeIds(), offline)
 	}
-	resp, err := server.GetShardLeaders(ctx, req)
+
+	// the cache is invalidated once the routing changes
+	leaders := suite.dist.LeaderViewManager.GetByFilter(meta.WithCollectionID2LeaderView(collection))
+	suite.NotEmpty(leaders)
+	suite.dist.LeaderViewManager.Update(leaders[0].ID)
+	resp, err = server.GetShardLeaders(ctx, req)
 	suite.NoError(err)
-	suite.Equal(resp.GetStatus().GetCode(), merr.Code(merr.ErrServiceNotReady))
+	suite.Equal(suite.dist.LeaderViewManager.GetRoutingGeneration(), resp.GetRoutingGeneration())
+	for _, shard := range resp.GetShards() {
+		suite.NotContains(shard.GetNodeIds(), leaders[0].ID)
+	}
+
+	server.invalidateShardLeaderCache(collection)
+	suite.Zero(server.shardLeaderCache.Len())
 }
 
 func (suite *ServiceSuite) TestGetShardLeadersFailed() {
@@ -1703,6 +5459,19 @@ func (suite *ServiceSuite) TestGetShardLeadersFailed() {
 		resp, err = server.GetShardLeaders(ctx, req)
 		suite.NoError(err)
 		suite.Equal(commonpb.ErrorCode_NoReplicaAvailable, resp.GetStatus().GetErrorCode())
+
+		// the missing segments are tolerated
+		paramtable.Get().Save(Params.QueryCoordCfg.LeaderMaxMissingSegmentRatio.Key, "1")
+		resp, err = server.GetShardLeaders(ctx, req)
+		suite.NoError(err)
+		suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		resp, err = server.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+			CollectionID:             collection,
+			WithReadableReplicaCount: true,
+		})
+		suite.NoError(err)
+		suite.EqualValues(len(suite.meta.ReplicaManager.GetByCollection(collection)), resp.GetReadableReplicaCount())
+		paramtable.Get().Reset(Params.QueryCoordCfg.LeaderMaxMissingSegmentRatio.Key)
 	}
 
 	// channel not subscribed
@@ -1728,6 +5497,262 @@ func (suite *ServiceSuite) TestGetShardLeadersFailed() {
 	suite.True(errors.Is(merr.Error(resp.GetStatus()), merr.ErrCollectionNotLoaded))
 }
 
+func (suite *ServiceSuite) TestGetClusterLoadSummary() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	replicaNum := 0
+	shardNum := 0
+	for _, collection := range suite.collections {
+		suite.updateChannelDist(collection)
+		replicaNum += int(suite.replicaNumber[collection])
+		shardNum += int(suite.replicaNumber[collection]) * len(suite.channels[collection])
+	}
+	suite.fetchHeartbeats(time.Now())
+
+	resp, err := server.GetClusterLoadSummary(ctx, &querypb.GetClusterLoadSummaryRequest{})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.EqualValues(len(suite.collections), resp.GetNumLoadedCollections())
+	suite.EqualValues(replicaNum, resp.GetNumReplicas())
+	suite.EqualValues(len(suite.nodes), resp.GetNumNodesPerRg()[meta.DefaultResourceGroupName])
+
+	// all shards become unhealthy after leaders gone
+	for _, node := range suite.nodes {
+		suite.dist.LeaderViewManager.Update(node)
+	}
+	resp, err = server.GetClusterLoadSummary(ctx, &querypb.GetClusterLoadSummaryRequest{})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.EqualValues(shardNum, resp.GetNumUnhealthyShards())
+
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err = server.GetClusterLoadSummary(ctx, &querypb.GetClusterLoadSummaryRequest{})
+	suite.NoError(err)
+	suite.Equal(resp.GetStatus().GetCode(), merr.Code(merr.ErrServiceNotReady))
+}
+
+func (suite *ServiceSuite) TestGetAvailabilitySLA() {
+	paramtable.Get().Save(Params.QueryCoordCfg.AvailabilityCheckInterval.Key, "1")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.AvailabilityCheckInterval.Key)
+
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+	server.availabilityObserver = observers.NewAvailabilityObserver(
+		server.meta,
+		server.targetMgr,
+		server.dist,
+		server.nodeMgr,
+	)
+
+	for _, collection := range suite.collections {
+		suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+	}
+	// the collections share the nodes, only the first collection is served
+	suite.updateChannelDist(suite.collections[0])
+	suite.fetchHeartbeats(time.Now())
+
+	// Test collection not loaded
+	resp, err := server.GetAvailabilitySLA(ctx, &querypb.GetAvailabilitySLARequest{
+		CollectionID: 999,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrCollectionNotLoaded)
+
+	// Test availability not tracked yet
+	collection := suite.collections[0]
+	resp, err = server.GetAvailabilitySLA(ctx, &querypb.GetAvailabilitySLARequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrCollectionNotFullyLoaded)
+
+	server.availabilityObserver.Start()
+	defer server.availabilityObserver.Stop()
+	suite.Eventually(func() bool {
+		resp, err = server.GetAvailabilitySLA(ctx, &querypb.GetAvailabilitySLARequest{
+			CollectionID:  collection,
+			WindowSeconds: 60,
+		})
+		return err == nil && merr.Ok(resp.GetStatus())
+	}, 5*time.Second, 100*time.Millisecond)
+	suite.True(resp.GetAvailable())
+	suite.Equal(1.0, resp.GetUptimeRatio())
+	suite.Empty(resp.GetUnavailablePeriods())
+
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err = server.GetAvailabilitySLA(ctx, &querypb.GetAvailabilitySLARequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.Equal(resp.GetStatus().GetCode(), merr.Code(merr.ErrServiceNotReady))
+}
+
+func (suite *ServiceSuite) TestGetCollectionLoadInfo() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	// Test collection not loaded
+	resp, err := server.GetCollectionLoadInfo(ctx, &querypb.GetCollectionLoadInfoRequest{
+		CollectionID: 999,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrCollectionNotLoaded)
+
+	// Test no checkpoint taken
+	collection := suite.collections[0]
+	resp, err = server.GetCollectionLoadInfo(ctx, &querypb.GetCollectionLoadInfoRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.NoError(merr.Error(resp.GetStatus()))
+	suite.Equal(collection, resp.GetLoadInfo().GetCollectionID())
+	suite.Nil(resp.GetCheckpoint())
+
+	replicas := server.meta.ReplicaManager.GetByCollection(collection)
+	suite.NoError(server.meta.LoadCheckpointManager.SaveLoadCheckpoint(collection, 1, replicas))
+	resp, err = server.GetCollectionLoadInfo(ctx, &querypb.GetCollectionLoadInfoRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.NoError(merr.Error(resp.GetStatus()))
+	suite.EqualValues(1, resp.GetCheckpoint().GetTargetVersion())
+	suite.Len(resp.GetCheckpoint().GetReplicas(), len(replicas))
+	suite.GreaterOrEqual(resp.GetCheckpointAgeMs(), int64(0))
+
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err = server.GetCollectionLoadInfo(ctx, &querypb.GetCollectionLoadInfoRequest{
+		CollectionID: collection,
+	})
+	suite.NoError(err)
+	suite.Equal(resp.GetStatus().GetCode(), merr.Code(merr.ErrServiceNotReady))
+}
+
+func (suite *ServiceSuite) TestGetNodeLoadHistory() {
+	paramtable.Get().Save(Params.QueryCoordCfg.NodeLoadSampleInterval.Key, "1")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.NodeLoadSampleInterval.Key)
+
+	ctx := context.Background()
+	server := suite.server
+	server.nodeLoadObserver = observers.NewNodeLoadObserver(server.dist, server.nodeMgr)
+
+	// Test node not sampled yet
+	node := suite.nodes[0]
+	resp, err := server.GetNodeLoadHistory(ctx, &querypb.GetNodeLoadHistoryRequest{
+		NodeID: node,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrNodeNotFound)
+
+	suite.dist.SegmentDistManager.Update(node, utils.CreateTestSegment(1, 1, 1, node, 1, "test-channel"))
+	server.nodeLoadObserver.Start()
+	defer server.nodeLoadObserver.Stop()
+	suite.Eventually(func() bool {
+		resp, err = server.GetNodeLoadHistory(ctx, &querypb.GetNodeLoadHistoryRequest{
+			NodeID: node,
+		})
+		return err == nil && merr.Ok(resp.GetStatus())
+	}, 5*time.Second, 100*time.Millisecond)
+	suite.NotEmpty(resp.GetSamples())
+	suite.EqualValues(1, resp.GetSamples()[0].GetSegmentNum())
+
+	// Test samples filtered by since
+	resp, err = server.GetNodeLoadHistory(ctx, &querypb.GetNodeLoadHistoryRequest{
+		NodeID: node,
+		Since:  time.Now().Add(time.Hour).UnixMilli(),
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.Empty(resp.GetSamples())
+
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err = server.GetNodeLoadHistory(ctx, &querypb.GetNodeLoadHistoryRequest{
+		NodeID: node,
+	})
+	suite.NoError(err)
+	suite.Equal(resp.GetStatus().GetCode(), merr.Code(merr.ErrServiceNotReady))
+}
+
+func (suite *ServiceSuite) TestGetTransferNodeStatus() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	suite.updateChannelDistOfCollections(suite.collections...)
+	suite.fetchHeartbeats(time.Now())
+
+	resp, err := server.GetTransferNodeStatus(ctx, &querypb.GetTransferNodeStatusRequest{})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.False(resp.GetRecovering())
+
+	// node transferred out but still holding data
+	collection := suite.collections[0]
+	replica := suite.meta.ReplicaManager.GetByCollection(collection)[0]
+	nodes := suite.sortInt64(replica.GetNodes())
+	outbound := nodes[len(nodes)-1]
+	suite.meta.ReplicaManager.Put(meta.NewReplica(&querypb.Replica{
+		ID:            replica.GetID(),
+		CollectionID:  collection,
+		Nodes:         nodes[:len(nodes)-1],
+		RoNodes:       []int64{outbound},
+		ResourceGroup: replica.GetResourceGroup(),
+	}))
+	resp, err = server.GetTransferNodeStatus(ctx, &querypb.GetTransferNodeStatusRequest{
+		TargetResourceGroup: meta.DefaultResourceGroupName,
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.True(resp.GetRecovering())
+	suite.Len(resp.GetPendingReplicas(), 1)
+	suite.Equal(replica.GetID(), resp.GetPendingReplicas()[0].GetReplicaID())
+	suite.Equal([]int64{outbound}, resp.GetPendingReplicas()[0].GetOutboundNodes())
+	suite.Empty(resp.GetPendingReplicas()[0].GetUnserviceableChannels())
+
+	// replicas in other resource group are not affected
+	err = server.meta.ResourceManager.AddResourceGroup("rg1", &rgpb.ResourceGroupConfig{
+		Requests: &rgpb.ResourceGroupLimit{NodeNum: 0},
+		Limits:   &rgpb.ResourceGroupLimit{NodeNum: 0},
+	})
+	suite.NoError(err)
+	resp, err = server.GetTransferNodeStatus(ctx, &querypb.GetTransferNodeStatusRequest{
+		SourceResourceGroup: "rg1",
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.False(resp.GetRecovering())
+
+	// shard leaders are not serviceable yet
+	for _, node := range suite.nodes {
+		suite.dist.LeaderViewManager.Update(node)
+	}
+	resp, err = server.GetTransferNodeStatus(ctx, &querypb.GetTransferNodeStatusRequest{})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp.GetStatus()))
+	suite.True(resp.GetRecovering())
+	suite.Len(resp.GetPendingReplicas(), int(suite.replicaNumber[1000]+suite.replicaNumber[1001]))
+
+	// resource group not found
+	resp, err = server.GetTransferNodeStatus(ctx, &querypb.GetTransferNodeStatusRequest{
+		SourceResourceGroup: "rgggg",
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrResourceGroupNotFound)
+
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err = server.GetTransferNodeStatus(ctx, &querypb.GetTransferNodeStatusRequest{})
+	suite.NoError(err)
+	suite.Equal(resp.GetStatus().GetCode(), merr.Code(merr.ErrServiceNotReady))
+}
+
 func (suite *ServiceSuite) TestHandleNodeUp() {
 	suite.server.replicaObserver = observers.NewReplicaObserver(
 		suite.server.meta,
@@ -1944,6 +5969,47 @@ func (suite *ServiceSuite) getAllSegments(collection int64) []int64 {
 	return allSegments
 }
 
+func (suite *ServiceSuite) mockNodeMemory(memory, usage uint64) {
+	suite.cluster.EXPECT().GetMetrics(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, nodeID int64, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+			resp, err := metricsinfo.MarshalComponentInfos(metricsinfo.QueryNodeInfos{
+				BaseComponentInfos: metricsinfo.BaseComponentInfos{
+					HardwareInfos: metricsinfo.HardwareMetrics{
+						Memory:      memory,
+						MemoryUsage: usage,
+					},
+				},
+			})
+			if err != nil {
+				return nil, err
+			}
+			return &milvuspb.GetMetricsResponse{
+				Status:   merr.Success(),
+				Response: resp,
+			}, nil
+		}).Maybe()
+}
+
+func (suite *ServiceSuite) mockNodeNQRate(rate float64) {
+	suite.cluster.EXPECT().GetMetrics(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, nodeID int64, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+			resp, err := metricsinfo.MarshalComponentInfos(metricsinfo.QueryNodeInfos{
+				QuotaMetrics: &metricsinfo.QueryNodeQuotaMetrics{
+					Rms: []metricsinfo.RateMetric{
+						{Label: metricsinfo.NQPerSecond, Rate: rate},
+					},
+				},
+			})
+			if err != nil {
+				return nil, err
+			}
+			return &milvuspb.GetMetricsResponse{
+				Status:   merr.Success(),
+				Response: resp,
+			}, nil
+		}).Maybe()
+}
+
 func (suite *ServiceSuite) updateSegmentDist(collection, node int64) {
 	metaSegments := make([]*meta.Segment, 0)
 	for partition, segments := range suite.segments[collection] {
@@ -1986,6 +6052,21 @@ func (suite *ServiceSuite) updateChannelDist(collection int64) {
 	}
 }
 
+// updateChannelDistOfCollections updates the channel dist of the given collections,
+// keeping the leader views of all of them on the shared nodes.
+func (suite *ServiceSuite) updateChannelDistOfCollections(collections ...int64) {
+	views := make(map[int64][]*meta.LeaderView)
+	for _, collection := range collections {
+		suite.updateChannelDist(collection)
+		for _, view := range suite.dist.LeaderViewManager.GetByFilter(meta.WithCollectionID2LeaderView(collection)) {
+			views[view.ID] = append(views[view.ID], view)
+		}
+	}
+	for node, nodeViews := range views {
+		suite.dist.LeaderViewManager.Update(node, nodeViews...)
+	}
+}
+
 func (suite *ServiceSuite) sortInt64(ints []int64) []int64 {
 	sort.Slice(ints, func(i int, j int) bool {
 		return ints[i] < ints[j]
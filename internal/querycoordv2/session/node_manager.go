@@ -131,6 +131,19 @@ func (m *NodeManager) GetAll() []*NodeInfo {
 	return ret
 }
 
+// GetNodesByLabel returns all nodes which advertise the given label with the given value.
+func (m *NodeManager) GetNodesByLabel(key, value string) []*NodeInfo {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	ret := make([]*NodeInfo, 0)
+	for _, n := range m.nodes {
+		if v, ok := n.immutableInfo.Labels[key]; ok && v == value {
+			ret = append(ret, n)
+		}
+	}
+	return ret
+}
+
 func NewNodeManager() *NodeManager {
 	return &NodeManager{
 		nodes: make(map[int64]*NodeInfo),
@@ -139,6 +152,9 @@ func NewNodeManager() *NodeManager {
 
 type State int
 
+// ZoneLabel is the node label key which identifies the availability zone of the node.
+const ZoneLabel = "zone"
+
 const (
 	NormalStateName   = "Normal"
 	StoppingStateName = "Stopping"
@@ -150,6 +166,7 @@ type ImmutableNodeInfo struct {
 	Address  string
 	Hostname string
 	Version  semver.Version
+	Labels   map[string]string
 }
 
 const (
@@ -188,6 +205,20 @@ func (n *NodeInfo) Hostname() string {
 	return n.immutableInfo.Hostname
 }
 
+// Labels returns a copy of the labels advertised by the node when it registered.
+func (n *NodeInfo) Labels() map[string]string {
+	labels := make(map[string]string, len(n.immutableInfo.Labels))
+	for k, v := range n.immutableInfo.Labels {
+		labels[k] = v
+	}
+	return labels
+}
+
+// Zone returns the availability zone of the node, empty if the node doesn't advertise it.
+func (n *NodeInfo) Zone() string {
+	return n.immutableInfo.Labels[ZoneLabel]
+}
+
 func (n *NodeInfo) SegmentCnt() int {
 	n.mu.RLock()
 	defer n.mu.RUnlock()
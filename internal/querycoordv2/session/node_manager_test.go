@@ -105,6 +105,40 @@ func (s *NodeManagerSuite) TestNodeInfo() {
 	s.NotNil(node.LastHeartbeat())
 }
 
+func (s *NodeManagerSuite) TestNodeLabels() {
+	s.nodeManager.Add(NewNodeInfo(ImmutableNodeInfo{
+		NodeID: 1,
+		Labels: map[string]string{"zone": "az1"},
+	}))
+	s.nodeManager.Add(NewNodeInfo(ImmutableNodeInfo{
+		NodeID: 2,
+		Labels: map[string]string{"zone": "az2"},
+	}))
+	s.nodeManager.Add(NewNodeInfo(ImmutableNodeInfo{
+		NodeID: 3,
+	}))
+
+	nodes := s.nodeManager.GetNodesByLabel("zone", "az1")
+	s.Len(nodes, 1)
+	s.Equal(int64(1), nodes[0].ID())
+	s.Equal("az1", nodes[0].Zone())
+	s.Equal("", s.nodeManager.Get(3).Zone())
+	s.Len(s.nodeManager.GetNodesByLabel("zone", "az3"), 0)
+
+	// modify the returned labels shouldn't affect the node
+	labels := nodes[0].Labels()
+	labels["zone"] = "az2"
+	s.Equal("az1", s.nodeManager.Get(1).Labels()["zone"])
+
+	// labels refresh when node re-registers
+	s.nodeManager.Add(NewNodeInfo(ImmutableNodeInfo{
+		NodeID: 1,
+		Labels: map[string]string{"zone": "az2"},
+	}))
+	s.Len(s.nodeManager.GetNodesByLabel("zone", "az1"), 0)
+	s.Len(s.nodeManager.GetNodesByLabel("zone", "az2"), 2)
+}
+
 func TestNodeManagerSuite(t *testing.T) {
 	suite.Run(t, new(NodeManagerSuite))
 }
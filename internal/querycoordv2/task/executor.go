@@ -18,6 +18,7 @@ package task
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -36,6 +37,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/indexparams"
@@ -63,6 +65,10 @@ type Executor struct {
 
 	executingTasks   *typeutil.ConcurrentSet[string] // task index
 	executingTaskNum atomic.Int32
+
+	nodeID           int64
+	recoveryStart    time.Time
+	executingLoadNum atomic.Int32
 }
 
 func NewExecutor(meta *meta.Meta,
@@ -71,6 +77,8 @@ func NewExecutor(meta *meta.Meta,
 	targetMgr *meta.TargetManager,
 	cluster session.Cluster,
 	nodeMgr *session.NodeManager,
+	nodeID int64,
+	recoveryStart time.Time,
 ) *Executor {
 	return &Executor{
 		doneCh:    make(chan struct{}),
@@ -82,6 +90,8 @@ func NewExecutor(meta *meta.Meta,
 		nodeMgr:   nodeMgr,
 
 		executingTasks: typeutil.NewConcurrentSet[string](),
+		nodeID:         nodeID,
+		recoveryStart:  recoveryStart,
 	}
 }
 
@@ -105,6 +115,11 @@ func (ex *Executor) Execute(task Task, step int) bool {
 		ex.executingTaskNum.Dec()
 		return false
 	}
+	if isLoadSegmentAction(task, step) && !ex.acquireLoadSlot() {
+		ex.executingTasks.Remove(task.Index())
+		ex.executingTaskNum.Dec()
+		return false
+	}
 
 	log := log.With(
 		zap.Int64("taskID", task.ID()),
@@ -131,6 +146,32 @@ func (ex *Executor) Execute(task Task, step int) bool {
 	return true
 }
 
+func isLoadSegmentAction(task Task, step int) bool {
+	action, ok := task.Actions()[step].(*SegmentAction)
+	return ok && (action.Type() == ActionTypeGrow || action.Type() == ActionTypeUpdate)
+}
+
+// acquireLoadSlot returns false if the node is loading too many segments during recovery.
+func (ex *Executor) acquireLoadSlot() bool {
+	limit := Params.QueryCoordCfg.RecoveryLoadConcurrencyPerNode.GetAsInt32()
+	inRecovery := time.Since(ex.recoveryStart) < Params.QueryCoordCfg.RecoveryLoadThrottleDuration.GetAsDuration(time.Second)
+	if num := ex.executingLoadNum.Inc(); limit > 0 && inRecovery && num > limit {
+		ex.executingLoadNum.Dec()
+		return false
+	}
+	ex.updateLoadMetrics()
+	return true
+}
+
+func (ex *Executor) releaseLoadSlot() {
+	ex.executingLoadNum.Dec()
+	ex.updateLoadMetrics()
+}
+
+func (ex *Executor) updateLoadMetrics() {
+	metrics.QueryCoordInflightSegmentLoadNum.WithLabelValues(fmt.Sprint(ex.nodeID)).Set(float64(ex.executingLoadNum.Load()))
+}
+
 func (ex *Executor) removeTask(task Task, step int) {
 	if task.Err() != nil {
 		log.Info("execute action done, remove it",
@@ -146,6 +187,7 @@ func (ex *Executor) removeTask(task Task, step int) {
 func (ex *Executor) executeSegmentAction(task *SegmentTask, step int) {
 	switch task.Actions()[step].Type() {
 	case ActionTypeGrow, ActionTypeUpdate:
+		defer ex.releaseLoadSlot()
 		ex.loadSegment(task, step)
 
 	case ActionTypeReduce:
@@ -125,6 +125,58 @@ func (_c *MockScheduler_Dispatch_Call) RunAndReturn(run func(int64)) *MockSchedu
 	return _c
 }
 
+// GetBalanceStatus provides a mock function with given fields: operationID
+func (_m *MockScheduler) GetBalanceStatus(operationID int64) (BalanceStatus, bool) {
+	ret := _m.Called(operationID)
+
+	var r0 BalanceStatus
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(int64) (BalanceStatus, bool)); ok {
+		return rf(operationID)
+	}
+	if rf, ok := ret.Get(0).(func(int64) BalanceStatus); ok {
+		r0 = rf(operationID)
+	} else {
+		r0 = ret.Get(0).(BalanceStatus)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) bool); ok {
+		r1 = rf(operationID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// MockScheduler_GetBalanceStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBalanceStatus'
+type MockScheduler_GetBalanceStatus_Call struct {
+	*mock.Call
+}
+
+// GetBalanceStatus is a helper method to define mock.On call
+//   - operationID int64
+func (_e *MockScheduler_Expecter) GetBalanceStatus(operationID interface{}) *MockScheduler_GetBalanceStatus_Call {
+	return &MockScheduler_GetBalanceStatus_Call{Call: _e.mock.On("GetBalanceStatus", operationID)}
+}
+
+func (_c *MockScheduler_GetBalanceStatus_Call) Run(run func(operationID int64)) *MockScheduler_GetBalanceStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *MockScheduler_GetBalanceStatus_Call) Return(_a0 BalanceStatus, _a1 bool) *MockScheduler_GetBalanceStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockScheduler_GetBalanceStatus_Call) RunAndReturn(run func(int64) (BalanceStatus, bool)) *MockScheduler_GetBalanceStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetChannelTaskNum provides a mock function with given fields:
 func (_m *MockScheduler) GetChannelTaskNum() int {
 	ret := _m.Called()
@@ -250,6 +302,48 @@ func (_c *MockScheduler_GetNodeSegmentDelta_Call) RunAndReturn(run func(int64) i
 	return _c
 }
 
+// GetNodeSegmentSizeDelta provides a mock function with given fields: nodeID
+func (_m *MockScheduler) GetNodeSegmentSizeDelta(nodeID int64) int64 {
+	ret := _m.Called(nodeID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(int64) int64); ok {
+		r0 = rf(nodeID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	return r0
+}
+
+// MockScheduler_GetNodeSegmentSizeDelta_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNodeSegmentSizeDelta'
+type MockScheduler_GetNodeSegmentSizeDelta_Call struct {
+	*mock.Call
+}
+
+// GetNodeSegmentSizeDelta is a helper method to define mock.On call
+//   - nodeID int64
+func (_e *MockScheduler_Expecter) GetNodeSegmentSizeDelta(nodeID interface{}) *MockScheduler_GetNodeSegmentSizeDelta_Call {
+	return &MockScheduler_GetNodeSegmentSizeDelta_Call{Call: _e.mock.On("GetNodeSegmentSizeDelta", nodeID)}
+}
+
+func (_c *MockScheduler_GetNodeSegmentSizeDelta_Call) Run(run func(nodeID int64)) *MockScheduler_GetNodeSegmentSizeDelta_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *MockScheduler_GetNodeSegmentSizeDelta_Call) Return(_a0 int64) *MockScheduler_GetNodeSegmentSizeDelta_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockScheduler_GetNodeSegmentSizeDelta_Call) RunAndReturn(run func(int64) int64) *MockScheduler_GetNodeSegmentSizeDelta_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSegmentTaskNum provides a mock function with given fields:
 func (_m *MockScheduler) GetSegmentTaskNum() int {
 	ret := _m.Called()
@@ -291,6 +385,50 @@ func (_c *MockScheduler_GetSegmentTaskNum_Call) RunAndReturn(run func() int) *Mo
 	return _c
 }
 
+// GetTasksBySource provides a mock function with given fields: source
+func (_m *MockScheduler) GetTasksBySource(source Source) []Task {
+	ret := _m.Called(source)
+
+	var r0 []Task
+	if rf, ok := ret.Get(0).(func(Source) []Task); ok {
+		r0 = rf(source)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Task)
+		}
+	}
+
+	return r0
+}
+
+// MockScheduler_GetTasksBySource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTasksBySource'
+type MockScheduler_GetTasksBySource_Call struct {
+	*mock.Call
+}
+
+// GetTasksBySource is a helper method to define mock.On call
+//   - source Source
+func (_e *MockScheduler_Expecter) GetTasksBySource(source interface{}) *MockScheduler_GetTasksBySource_Call {
+	return &MockScheduler_GetTasksBySource_Call{Call: _e.mock.On("GetTasksBySource", source)}
+}
+
+func (_c *MockScheduler_GetTasksBySource_Call) Run(run func(source Source)) *MockScheduler_GetTasksBySource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(Source))
+	})
+	return _c
+}
+
+func (_c *MockScheduler_GetTasksBySource_Call) Return(_a0 []Task) *MockScheduler_GetTasksBySource_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockScheduler_GetTasksBySource_Call) RunAndReturn(run func(Source) []Task) *MockScheduler_GetTasksBySource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveByNode provides a mock function with given fields: node
 func (_m *MockScheduler) RemoveByNode(node int64) {
 	_m.Called(node)
@@ -421,6 +559,48 @@ func (_c *MockScheduler_Stop_Call) RunAndReturn(run func()) *MockScheduler_Stop_
 	return _c
 }
 
+// TrackBalance provides a mock function with given fields: tasks
+func (_m *MockScheduler) TrackBalance(tasks []Task) int64 {
+	ret := _m.Called(tasks)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func([]Task) int64); ok {
+		r0 = rf(tasks)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	return r0
+}
+
+// MockScheduler_TrackBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TrackBalance'
+type MockScheduler_TrackBalance_Call struct {
+	*mock.Call
+}
+
+// TrackBalance is a helper method to define mock.On call
+//   - tasks []Task
+func (_e *MockScheduler_Expecter) TrackBalance(tasks interface{}) *MockScheduler_TrackBalance_Call {
+	return &MockScheduler_TrackBalance_Call{Call: _e.mock.On("TrackBalance", tasks)}
+}
+
+func (_c *MockScheduler_TrackBalance_Call) Run(run func(tasks []Task)) *MockScheduler_TrackBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]Task))
+	})
+	return _c
+}
+
+func (_c *MockScheduler_TrackBalance_Call) Return(_a0 int64) *MockScheduler_TrackBalance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockScheduler_TrackBalance_Call) RunAndReturn(run func([]Task) int64) *MockScheduler_TrackBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockScheduler creates a new instance of MockScheduler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockScheduler(t interface {
@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -142,9 +143,42 @@ type Scheduler interface {
 	Dispatch(node int64)
 	RemoveByNode(node int64)
 	GetNodeSegmentDelta(nodeID int64) int
+	GetNodeSegmentSizeDelta(nodeID int64) int64
 	GetNodeChannelDelta(nodeID int64) int
 	GetChannelTaskNum() int
 	GetSegmentTaskNum() int
+	GetTasksBySource(source Source) []Task
+	TrackBalance(tasks []Task) int64
+	GetBalanceStatus(operationID int64) (BalanceStatus, bool)
+}
+
+// BalanceStatus is the progress of a manual balance operation.
+type BalanceStatus struct {
+	Planned   int
+	Completed int
+	InFlight  int
+	Failed    int
+}
+
+// balanceOperation tracks the tasks of a manual balance operation.
+type balanceOperation struct {
+	tasks      []Task
+	finishedAt time.Time // zero if some tasks are in flight
+}
+
+func (op *balanceOperation) status() BalanceStatus {
+	status := BalanceStatus{Planned: len(op.tasks)}
+	for _, task := range op.tasks {
+		switch task.Status() {
+		case TaskStatusSucceeded:
+			status.Completed++
+		case TaskStatusFailed, TaskStatusCanceled:
+			status.Failed++
+		default:
+			status.InFlight++
+		}
+	}
+	return status
 }
 
 type taskScheduler struct {
@@ -165,6 +199,12 @@ type taskScheduler struct {
 	channelTasks map[replicaChannelIndex]Task
 	processQueue *taskQueue
 	waitQueue    *taskQueue
+
+	// segment loads are throttled for a while since scheduler created, to smooth the recovery after failover
+	recoveryStart time.Time
+
+	balanceOps     map[int64]*balanceOperation // operation ID -> operation
+	balanceTaskOps map[int64]int64             // task ID -> operation ID
 }
 
 func NewScheduler(ctx context.Context,
@@ -196,6 +236,11 @@ func NewScheduler(ctx context.Context,
 		channelTasks: make(map[replicaChannelIndex]Task),
 		processQueue: newTaskQueue(),
 		waitQueue:    newTaskQueue(),
+
+		recoveryStart: time.Now(),
+
+		balanceOps:     make(map[int64]*balanceOperation),
+		balanceTaskOps: make(map[int64]int64),
 	}
 }
 
@@ -208,6 +253,7 @@ func (scheduler *taskScheduler) Stop() {
 	for nodeID, executor := range scheduler.executors {
 		executor.Stop()
 		delete(scheduler.executors, nodeID)
+		metrics.QueryCoordInflightSegmentLoadNum.DeleteLabelValues(fmt.Sprint(nodeID))
 	}
 
 	for _, task := range scheduler.segmentTasks {
@@ -231,7 +277,9 @@ func (scheduler *taskScheduler) AddExecutor(nodeID int64) {
 		scheduler.broker,
 		scheduler.targetMgr,
 		scheduler.cluster,
-		scheduler.nodeMgr)
+		scheduler.nodeMgr,
+		nodeID,
+		scheduler.recoveryStart)
 
 	scheduler.executors[nodeID] = executor
 	executor.Start(scheduler.ctx)
@@ -467,6 +515,7 @@ func (scheduler *taskScheduler) Dispatch(node int64) {
 	default:
 		scheduler.rwmutex.Lock()
 		defer scheduler.rwmutex.Unlock()
+		scheduler.cleanBalanceOperations()
 		scheduler.schedule(node)
 	}
 }
@@ -478,6 +527,30 @@ func (scheduler *taskScheduler) GetNodeSegmentDelta(nodeID int64) int {
 	return calculateNodeDelta(nodeID, scheduler.segmentTasks)
 }
 
+// GetNodeSegmentSizeDelta returns the estimated memory size change of the node by the pending segment tasks,
+// the size of segment is estimated by its binlog size.
+func (scheduler *taskScheduler) GetNodeSegmentSizeDelta(nodeID int64) int64 {
+	scheduler.rwmutex.RLock()
+	defer scheduler.rwmutex.RUnlock()
+
+	delta := int64(0)
+	for _, task := range scheduler.segmentTasks {
+		for _, action := range task.Actions() {
+			if action.Node() != nodeID {
+				continue
+			}
+			segmentAction := action.(*SegmentAction)
+			segment := scheduler.targetMgr.GetSealedSegment(task.CollectionID(), segmentAction.SegmentID(), meta.NextTargetFirst)
+			if action.Type() == ActionTypeGrow {
+				delta += utils.GetSegmentSize(segment)
+			} else if action.Type() == ActionTypeReduce {
+				delta -= utils.GetSegmentSize(segment)
+			}
+		}
+	}
+	return delta
+}
+
 func (scheduler *taskScheduler) GetNodeChannelDelta(nodeID int64) int {
 	scheduler.rwmutex.RLock()
 	defer scheduler.rwmutex.RUnlock()
@@ -499,6 +572,83 @@ func (scheduler *taskScheduler) GetSegmentTaskNum() int {
 	return len(scheduler.segmentTasks)
 }
 
+// GetTasksBySource returns the pending tasks generated by the given source.
+func (scheduler *taskScheduler) GetTasksBySource(source Source) []Task {
+	scheduler.rwmutex.RLock()
+	defer scheduler.rwmutex.RUnlock()
+
+	tasks := make([]Task, 0)
+	for _, task := range scheduler.channelTasks {
+		if task.Source() == source {
+			tasks = append(tasks, task)
+		}
+	}
+	for _, task := range scheduler.segmentTasks {
+		if task.Source() == source {
+			tasks = append(tasks, task)
+		}
+	}
+	return tasks
+}
+
+// TrackBalance tracks the added tasks as a manual balance operation, returns the operation ID.
+func (scheduler *taskScheduler) TrackBalance(tasks []Task) int64 {
+	scheduler.rwmutex.Lock()
+	defer scheduler.rwmutex.Unlock()
+
+	scheduler.cleanBalanceOperations()
+	operationID := scheduler.idAllocator()
+	op := &balanceOperation{tasks: tasks}
+	for _, task := range tasks {
+		// the task may have been finished and removed
+		if scheduler.tasks.Contain(task.ID()) {
+			scheduler.balanceTaskOps[task.ID()] = operationID
+		}
+	}
+	if op.status().InFlight == 0 {
+		op.finishedAt = time.Now()
+	}
+	scheduler.balanceOps[operationID] = op
+	return operationID
+}
+
+// GetBalanceStatus returns the progress of the manual balance operation,
+// the finished operation is kept for queryCoord.balanceStatusRetention.
+func (scheduler *taskScheduler) GetBalanceStatus(operationID int64) (BalanceStatus, bool) {
+	scheduler.rwmutex.Lock()
+	defer scheduler.rwmutex.Unlock()
+
+	scheduler.cleanBalanceOperations()
+	op, ok := scheduler.balanceOps[operationID]
+	if !ok {
+		return BalanceStatus{}, false
+	}
+	return op.status(), true
+}
+
+// cleanBalanceOperations removes the balance operations finished before the retention, caller must hold the lock.
+func (scheduler *taskScheduler) cleanBalanceOperations() {
+	retention := Params.QueryCoordCfg.BalanceStatusRetention.GetAsDuration(time.Second)
+	for operationID, op := range scheduler.balanceOps {
+		if !op.finishedAt.IsZero() && time.Since(op.finishedAt) > retention {
+			delete(scheduler.balanceOps, operationID)
+		}
+	}
+}
+
+// onBalanceTaskRemoved marks the balance operation finished once all its tasks are removed, caller must hold the lock.
+func (scheduler *taskScheduler) onBalanceTaskRemoved(task Task) {
+	operationID, ok := scheduler.balanceTaskOps[task.ID()]
+	if !ok {
+		return
+	}
+	delete(scheduler.balanceTaskOps, task.ID())
+	op := scheduler.balanceOps[operationID]
+	if op != nil && op.status().InFlight == 0 {
+		op.finishedAt = time.Now()
+	}
+}
+
 func calculateNodeDelta[K comparable, T ~map[K]Task](nodeID int64, tasks T) int {
 	delta := 0
 	for _, task := range tasks {
@@ -760,6 +910,9 @@ func (scheduler *taskScheduler) recordSegmentTaskError(task *SegmentTask) {
 		zap.Error(task.err),
 	)
 	meta.GlobalFailedLoadCache.Put(task.collectionID, task.Err())
+	if segment := scheduler.targetMgr.GetSealedSegment(task.CollectionID(), task.SegmentID(), meta.NextTargetFirst); segment != nil {
+		meta.GlobalFailedLoadCache.PutPartition(task.CollectionID(), segment.GetPartitionID(), task.Err())
+	}
 }
 
 func (scheduler *taskScheduler) remove(task Task) {
@@ -796,6 +949,7 @@ func (scheduler *taskScheduler) remove(task Task) {
 		log = log.With(zap.Int64("segmentID", task.SegmentID()))
 	}
 
+	scheduler.onBalanceTaskRemoved(task)
 	scheduler.updateTaskMetrics()
 	log.Info("task removed")
 	metrics.QueryCoordTaskLatency.WithLabelValues(scheduler.getTaskMetricsLabel(task), task.Shard()).Observe(float64(task.GetTaskLatency()))
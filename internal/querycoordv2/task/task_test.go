@@ -1500,6 +1500,123 @@ func (suite *TaskSuite) TestNoExecutor() {
 	suite.AssertTaskNum(0, 0, 0, 0)
 }
 
+func (suite *TaskSuite) TestGetTasksBySource() {
+	ctx := context.Background()
+	timeout := 10 * time.Second
+	targetNode := int64(-1)
+	channel := Params.CommonCfg.RootCoordDml.GetValue() + "-test"
+
+	suite.meta.ReplicaManager.Put(utils.CreateTestReplica(suite.replica.GetID(), suite.collection, []int64{1, 2, 3, -1}))
+	suite.dist.ChannelDistManager.Update(targetNode, meta.DmChannelFromVChannel(&datapb.VchannelInfo{
+		CollectionID: suite.collection,
+		ChannelName:  channel,
+	}))
+	for _, segment := range suite.loadSegments {
+		task, err := NewSegmentTask(
+			ctx,
+			timeout,
+			WrapIDSource(1),
+			suite.collection,
+			suite.replica,
+			NewSegmentAction(targetNode, ActionTypeGrow, channel, segment),
+		)
+		suite.NoError(err)
+		err = suite.scheduler.Add(task)
+		suite.NoError(err)
+	}
+
+	tasks := suite.scheduler.GetTasksBySource(WrapIDSource(1))
+	suite.Len(tasks, len(suite.loadSegments))
+	for _, task := range tasks {
+		suite.Equal(WrapIDSource(1), task.Source())
+	}
+	suite.Empty(suite.scheduler.GetTasksBySource(WrapIDSource(2)))
+}
+
+func (suite *TaskSuite) TestBalanceStatus() {
+	ctx := context.Background()
+	timeout := 10 * time.Second
+	targetNode := int64(-1)
+	channel := Params.CommonCfg.RootCoordDml.GetValue() + "-test"
+
+	suite.meta.ReplicaManager.Put(utils.CreateTestReplica(suite.replica.GetID(), suite.collection, []int64{1, 2, 3, -1}))
+	suite.dist.ChannelDistManager.Update(targetNode, meta.DmChannelFromVChannel(&datapb.VchannelInfo{
+		CollectionID: suite.collection,
+		ChannelName:  channel,
+	}))
+	tasks := make([]Task, 0, len(suite.loadSegments))
+	for _, segment := range suite.loadSegments {
+		task, err := NewSegmentTask(
+			ctx,
+			timeout,
+			utils.ManualBalance,
+			suite.collection,
+			suite.replica,
+			NewSegmentAction(targetNode, ActionTypeGrow, channel, segment),
+		)
+		suite.NoError(err)
+		err = suite.scheduler.Add(task)
+		suite.NoError(err)
+		tasks = append(tasks, task)
+	}
+	suite.Require().GreaterOrEqual(len(tasks), 2)
+
+	operationID := suite.scheduler.TrackBalance(tasks)
+	status, ok := suite.scheduler.GetBalanceStatus(operationID)
+	suite.True(ok)
+	suite.Equal(BalanceStatus{Planned: len(tasks), InFlight: len(tasks)}, status)
+
+	tasks[0].SetStatus(TaskStatusSucceeded)
+	tasks[1].Fail(errors.New("mock error"))
+	status, ok = suite.scheduler.GetBalanceStatus(operationID)
+	suite.True(ok)
+	suite.Equal(BalanceStatus{Planned: len(tasks), Completed: 1, Failed: 1, InFlight: len(tasks) - 2}, status)
+
+	// the finished operation is cleaned after the retention
+	paramtable.Get().Save(Params.QueryCoordCfg.BalanceStatusRetention.Key, "0")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.BalanceStatusRetention.Key)
+	for _, task := range tasks {
+		suite.scheduler.remove(task)
+	}
+	// cleaned by the scheduling without waiting for the query
+	suite.scheduler.Dispatch(targetNode)
+	suite.scheduler.rwmutex.RLock()
+	suite.NotContains(suite.scheduler.balanceOps, operationID)
+	suite.scheduler.rwmutex.RUnlock()
+	_, ok = suite.scheduler.GetBalanceStatus(operationID)
+	suite.False(ok)
+}
+
+func (suite *TaskSuite) TestRecoveryLoadThrottle() {
+	paramtable.Get().Save(Params.QueryCoordCfg.RecoveryLoadConcurrencyPerNode.Key, "2")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.RecoveryLoadConcurrencyPerNode.Key)
+
+	ctx := context.Background()
+	timeout := 10 * time.Second
+	loadTask, err := NewSegmentTask(ctx, timeout, WrapIDSource(0), suite.collection, suite.replica,
+		NewSegmentAction(1, ActionTypeGrow, "test-channel", 1))
+	suite.NoError(err)
+	releaseTask, err := NewSegmentTask(ctx, timeout, WrapIDSource(0), suite.collection, suite.replica,
+		NewSegmentAction(1, ActionTypeReduce, "test-channel", 1))
+	suite.NoError(err)
+	suite.True(isLoadSegmentAction(loadTask, 0))
+	suite.False(isLoadSegmentAction(releaseTask, 0))
+
+	executor := NewExecutor(suite.meta, suite.dist, suite.broker, suite.target, suite.cluster, suite.nodeMgr, 1, time.Now())
+	suite.True(executor.acquireLoadSlot())
+	suite.True(executor.acquireLoadSlot())
+	suite.False(executor.acquireLoadSlot())
+	suite.EqualValues(2, executor.executingLoadNum.Load())
+
+	executor.releaseLoadSlot()
+	suite.True(executor.acquireLoadSlot())
+
+	// not throttled after recovery
+	executor.recoveryStart = time.Now().Add(-time.Hour)
+	suite.True(executor.acquireLoadSlot())
+	suite.EqualValues(3, executor.executingLoadNum.Load())
+}
+
 func (suite *TaskSuite) AssertTaskNum(process, wait, channel, segment int) {
 	scheduler := suite.scheduler
 
@@ -28,6 +28,9 @@ const (
 	IndexCheckerName   = "index_checker"
 	LeaderCheckerName  = "leader_checker"
 	ManualBalanceName  = "manual_balance"
+	MemoryEvictionName = "memory_eviction"
+	ManualReleaseName  = "manual_release"
+	LoadStuckRetryName = "load_stuck_retry"
 )
 
 type CheckerType int32
@@ -39,6 +42,9 @@ const (
 	IndexChecker
 	LeaderChecker
 	ManualBalance
+	MemoryEviction
+	ManualRelease
+	LoadStuckRetry
 )
 
 var checkerNames = map[CheckerType]string{
@@ -48,6 +54,9 @@ var checkerNames = map[CheckerType]string{
 	IndexChecker:   IndexCheckerName,
 	LeaderChecker:  LeaderCheckerName,
 	ManualBalance:  ManualBalanceName,
+	MemoryEviction: MemoryEvictionName,
+	ManualRelease:  ManualReleaseName,
+	LoadStuckRetry: LoadStuckRetryName,
 }
 
 func (s CheckerType) String() string {
@@ -17,10 +17,13 @@
 package utils
 
 import (
+	"sort"
+
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -117,9 +120,73 @@ func RecoverReplicaOfCollection(m *meta.Meta, collectionID typeutil.UniqueID) {
 		return
 	}
 
-	if err := m.ReplicaManager.RecoverNodesInCollection(collectionID, rgs); err != nil {
+	collection := m.CollectionManager.GetCollection(collectionID)
+	replicas := m.ReplicaManager.GetByCollection(collectionID)
+	if tenant := getTenant(replicas); tenant != "" {
+		isolateTenantNodes(m, tenant, replicas, rgs)
+	}
+
+	var placement *meta.ZonePlacement
+	if collection != nil && collection.GetZonePlacement() != querypb.ZonePlacementPolicy_ZonePlacementNone {
+		nodes := typeutil.NewUniqueSet()
+		for _, rg := range rgs {
+			nodes.Insert(rg.Collect()...)
+		}
+		for _, replica := range replicas {
+			nodes.Insert(replica.GetNodes()...)
+			nodes.Insert(replica.GetRONodes()...)
+		}
+		placement = &meta.ZonePlacement{
+			Policy:    collection.GetZonePlacement(),
+			NodeZones: m.ResourceManager.GetNodeZones(nodes.Collect()...),
+		}
+	}
+
+	if err := m.ReplicaManager.RecoverNodesInCollectionWithZones(collectionID, rgs, placement); err != nil {
 		logger.Warn("fail to set available nodes in replica", zap.Error(err))
 	}
+
+	// replicas may be spawned by top up or replica number increase, keep the standby replicas.
+	if collection != nil && collection.GetStandbyReplicaNumber() > 0 {
+		standbyIDs, err := MarkStandbyReplicas(m, collectionID, collection.GetStandbyReplicaNumber())
+		if err != nil {
+			logger.Warn("fail to mark standby replicas", zap.Error(err))
+		} else if len(standbyIDs) > 0 {
+			logger.Info("standby replicas marked", zap.Int64s("replicaIDs", standbyIDs))
+		}
+	}
+}
+
+// MarkStandbyReplicas marks the latest spawned primary replicas of the collection as standby,
+// until there are standbyNum standby replicas, one primary replica is kept at least.
+// Returns the IDs of the replicas newly marked.
+func MarkStandbyReplicas(m *meta.Meta, collectionID int64, standbyNum int32) ([]int64, error) {
+	replicas := m.ReplicaManager.GetByCollection(collectionID)
+	lack := int(standbyNum)
+	if lack > len(replicas)-1 {
+		lack = len(replicas) - 1
+	}
+	primaries := make([]*meta.Replica, 0, len(replicas))
+	for _, replica := range replicas {
+		if replica.IsStandby() {
+			lack--
+		} else {
+			primaries = append(primaries, replica)
+		}
+	}
+	if lack <= 0 {
+		return nil, nil
+	}
+	sort.Slice(primaries, func(i, j int) bool {
+		return primaries[i].GetID() > primaries[j].GetID()
+	})
+	standbyIDs := lo.Map(primaries[:lack], func(replica *meta.Replica, _ int) int64 {
+		return replica.GetID()
+	})
+	if err := m.ReplicaManager.SetStandby(true, standbyIDs...); err != nil {
+		return nil, err
+	}
+	return standbyIDs, nil
 }
 
 // RecoverAllCollectionrecovers all replica of all collection in resource group.
@@ -129,7 +196,59 @@ func RecoverAllCollection(m *meta.Meta) {
 	}
 }
 
-func checkResourceGroup(m *meta.Meta, resourceGroups []string, replicaNumber int32) (map[string]int, error) {
+// isolateTenantNodes removes the nodes not available for the replicas of the tenant from the resource groups.
+// The nodes held by other tenants are not available, and the replicas of other tenants without any node
+// reserve one node each, which is handed over by the replicas of the tenant if there is no free node.
+func isolateTenantNodes(m *meta.Meta, tenant string, replicas []*meta.Replica, rgs map[string]typeutil.UniqueSet) {
+	others := m.ReplicaManager.GetByOtherTenants(tenant)
+	held := typeutil.NewUniqueSet()
+	for _, replica := range others {
+		held.Insert(replica.GetNodes()...)
+		held.Insert(replica.GetRONodes()...)
+	}
+	for rgName, nodes := range rgs {
+		nodes.Remove(held.Collect()...)
+
+		starving := lo.CountBy(others, func(replica *meta.Replica) bool {
+			return replica.GetResourceGroup() == rgName && replica.RWNodesCount() == 0
+		})
+		// keep one node for each replica of the tenant at least
+		spare := nodes.Len() - lo.CountBy(replicas, func(replica *meta.Replica) bool {
+			return replica.GetResourceGroup() == rgName
+		})
+		if starving > spare {
+			starving = spare
+		}
+		if starving <= 0 {
+			continue
+		}
+		// the free nodes are handed over first, then the ones held by the tenant
+		candidates := nodes.Collect()
+		sort.Slice(candidates, func(i, j int) bool {
+			iHeld := lo.ContainsBy(replicas, func(replica *meta.Replica) bool { return replica.Contains(candidates[i]) })
+			jHeld := lo.ContainsBy(replicas, func(replica *meta.Replica) bool { return replica.Contains(candidates[j]) })
+			if iHeld != jHeld {
+				return jHeld
+			}
+			return candidates[i] < candidates[j]
+		})
+		nodes.Remove(candidates[:starving]...)
+	}
+}
+
+// GetTenantOccupiedNodes returns the nodes held by the replicas of the tenants other than the given one,
+// replicas without tenant don't occupy any node.
+func GetTenantOccupiedNodes(m *meta.Meta, tenant string) typeutil.UniqueSet {
+	occupied := typeutil.NewUniqueSet()
+	for _, replica := range m.ReplicaManager.GetByOtherTenants(tenant) {
+		occupied.Insert(replica.GetNodes()...)
+		occupied.Insert(replica.GetRONodes()...)
+	}
+	return occupied
+}
+
+// ParseReplicaNumInRG returns the expected replica number in each resource group.
+func ParseReplicaNumInRG(resourceGroups []string, replicaNumber int32) (map[string]int, error) {
 	if len(resourceGroups) != 0 && len(resourceGroups) != 1 && len(resourceGroups) != int(replicaNumber) {
 		return nil, ErrUseWrongNumRG
 	}
@@ -147,6 +266,14 @@ func checkResourceGroup(m *meta.Meta, resourceGroups []string, replicaNumber int
 			replicaNumInRG[rgName] += 1
 		}
 	}
+	return replicaNumInRG, nil
+}
+
+func checkResourceGroup(m *meta.Meta, resourceGroups []string, replicaNumber int32) (map[string]int, error) {
+	replicaNumInRG, err := ParseReplicaNumInRG(resourceGroups, replicaNumber)
+	if err != nil {
+		return nil, err
+	}
 
 	// TODO: !!!Warning, ResourceManager and ReplicaManager doesn't protected with each other in concurrent operation.
 	// 1. replica1 got rg1's node snapshot but doesn't spawn finished.
@@ -184,3 +311,154 @@ func SpawnReplicasWithRG(m *meta.Meta, collection int64, resourceGroups []string
 	RecoverReplicaOfCollection(m, collection)
 	return replicas, nil
 }
+
+// SpawnTenantReplicasWithRG works like SpawnReplicasWithRG, but the replicas are isolated from the replicas of other tenants,
+// the resource groups should have enough nodes not reserved by other tenants to hold them.
+func SpawnTenantReplicasWithRG(m *meta.Meta, collection int64, tenant string, resourceGroups []string, replicaNumber int32) ([]*meta.Replica, error) {
+	replicaNumInRG, err := checkResourceGroup(m, resourceGroups, replicaNumber)
+	if err != nil {
+		return nil, err
+	}
+	rgNodes, err := getNodesOfRGs(m, replicaNumInRG)
+	if err != nil {
+		return nil, err
+	}
+
+	replicas, err := m.ReplicaManager.SpawnForTenant(collection, tenant, replicaNumInRG, rgNodes)
+	if err != nil {
+		return nil, err
+	}
+	RecoverReplicaOfCollection(m, collection)
+	return replicas, nil
+}
+
+func getNodesOfRGs(m *meta.Meta, replicaNumInRG map[string]int) (map[string][]int64, error) {
+	rgNodes := make(map[string][]int64)
+	for rgName := range replicaNumInRG {
+		nodes, err := m.ResourceManager.GetNodes(rgName)
+		if err != nil {
+			return nil, err
+		}
+		rgNodes[rgName] = nodes
+	}
+	return rgNodes, nil
+}
+
+// SpawnMoreReplicasWithRG spawns extra replicas for the collection whose replicas have been spawned,
+// to reach the given replica number in the resource groups, replicas which have been spawned are counted in,
+// even if they are not in the given resource groups.
+func SpawnMoreReplicasWithRG(m *meta.Meta, collection int64, resourceGroups []string, replicaNumber int32) ([]*meta.Replica, error) {
+	replicaNumInRG, err := checkResourceGroup(m, resourceGroups, replicaNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	spawned := m.ReplicaManager.GetByCollection(collection)
+	lackNum := int(replicaNumber) - len(spawned)
+	if lackNum <= 0 {
+		return nil, nil
+	}
+	spawnedNumInRG := make(map[string]int)
+	for _, replica := range spawned {
+		spawnedNumInRG[replica.GetResourceGroup()]++
+	}
+	rgNames := lo.Keys(replicaNumInRG)
+	sort.Strings(rgNames)
+	spawnNumInRG := make(map[string]int)
+	for _, rgName := range rgNames {
+		num := replicaNumInRG[rgName] - spawnedNumInRG[rgName]
+		if num > lackNum {
+			num = lackNum
+		}
+		if num > 0 {
+			spawnNumInRG[rgName] = num
+			lackNum -= num
+		}
+	}
+
+	replicas, err := spawnMore(m, collection, getTenant(spawned), spawnNumInRG)
+	if err != nil {
+		return nil, err
+	}
+	RecoverReplicaOfCollection(m, collection)
+	return replicas, nil
+}
+
+// getTenant returns the tenant of the replicas of a collection.
+func getTenant(replicas []*meta.Replica) string {
+	if len(replicas) == 0 {
+		return ""
+	}
+	return replicas[0].GetTenant()
+}
+
+// spawnMore spawns more replicas for the collection, isolated from other tenants if tenant given,
+// the replicas of collection must have been spawned if no tenant given.
+func spawnMore(m *meta.Meta, collection int64, tenant string, spawnNumInRG map[string]int) ([]*meta.Replica, error) {
+	if tenant == "" {
+		return m.ReplicaManager.SpawnMore(collection, spawnNumInRG)
+	}
+	rgNodes, err := getNodesOfRGs(m, spawnNumInRG)
+	if err != nil {
+		return nil, err
+	}
+	return m.ReplicaManager.SpawnForTenant(collection, tenant, spawnNumInRG, rgNodes)
+}
+
+// SpawnReplicasWithRGBestEffort spawns as many replicas as the resource groups can hold for given collection,
+// replicas which have been spawned are counted in, so it can be called again to top up replicas.
+// Every replica needs one node at least, so the extra nodes held by the spawned replicas are counted
+// as available too, the recovery will hand them over to the new replicas.
+// The replicas are isolated from the replicas of other tenants if tenant given.
+func SpawnReplicasWithRGBestEffort(m *meta.Meta, collection int64, tenant string, resourceGroups []string, replicaNumber int32) ([]*meta.Replica, error) {
+	replicaNumInRG, err := ParseReplicaNumInRG(resourceGroups, replicaNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	existed := m.ReplicaManager.GetByCollection(collection)
+	spawnedNumInRG := make(map[string]int)
+	for _, replica := range existed {
+		spawnedNumInRG[replica.GetResourceGroup()]++
+	}
+
+	spawnNumInRG := make(map[string]int)
+	for rgName, num := range replicaNumInRG {
+		nodes, err := m.ResourceManager.GetNodes(rgName)
+		if err != nil {
+			return nil, err
+		}
+		// nodes still held by the replicas in other resource groups can't be reclaimed.
+		available := lo.Filter(nodes, func(node int64, _ int) bool {
+			return !lo.ContainsBy(existed, func(replica *meta.Replica) bool {
+				return replica.GetResourceGroup() != rgName && (replica.Contains(node) || replica.ContainRONode(node))
+			})
+		})
+		capacity := len(available)
+		if tenant != "" {
+			capacity -= m.ReplicaManager.GetTenantReservedNodeNum(tenant, rgName)
+		}
+		if num > capacity {
+			num = capacity
+		}
+		num -= spawnedNumInRG[rgName]
+		if num > 0 {
+			spawnNumInRG[rgName] = num
+		}
+	}
+	if len(spawnNumInRG) == 0 {
+		return nil, nil
+	}
+
+	var replicas []*meta.Replica
+	if len(existed) == 0 && tenant == "" {
+		replicas, err = m.ReplicaManager.Spawn(collection, spawnNumInRG)
+	} else {
+		replicas, err = spawnMore(m, collection, tenant, spawnNumInRG)
+	}
+	if err != nil {
+		return nil, err
+	}
+	RecoverReplicaOfCollection(m, collection)
+	return replicas, nil
+}
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
@@ -131,6 +132,136 @@ func TestSpawnReplicasWithRG(t *testing.T) {
 	}
 }
 
+func TestSpawnReplicasWithRGBestEffort(t *testing.T) {
+	paramtable.Init()
+	config := GenerateEtcdConfig()
+	cli, _ := etcd.GetEtcdClient(
+		config.UseEmbedEtcd.GetAsBool(),
+		config.EtcdUseSSL.GetAsBool(),
+		config.Endpoints.GetAsStrings(),
+		config.EtcdTLSCert.GetValue(),
+		config.EtcdTLSKey.GetValue(),
+		config.EtcdTLSCACert.GetValue(),
+		config.EtcdTLSMinVersion.GetValue())
+	kv := etcdKV.NewEtcdKV(cli, config.MetaRootPath.GetValue())
+
+	store := querycoord.NewCatalog(kv)
+	nodeMgr := session.NewNodeManager()
+	m := meta.NewMeta(RandomIncrementIDAllocator(), store, nodeMgr)
+	for i := 1; i <= 2; i++ {
+		nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   int64(i),
+			Address:  "localhost",
+			Hostname: "localhost",
+		}))
+		m.ResourceManager.HandleNodeUp(int64(i))
+	}
+
+	// only 2 nodes in resource group, spawn 2 replicas
+	replicas, err := SpawnReplicasWithRGBestEffort(m, 1000, "", nil, 3)
+	assert.NoError(t, err)
+	assert.Len(t, replicas, 2)
+
+	// no more node, nothing to top up
+	replicas, err = SpawnReplicasWithRGBestEffort(m, 1000, "", nil, 3)
+	assert.NoError(t, err)
+	assert.Len(t, replicas, 0)
+
+	// top up replica after new node comes, the node has been recovered into the spawned replicas
+	nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   3,
+		Address:  "localhost",
+		Hostname: "localhost",
+	}))
+	m.ResourceManager.HandleNodeUp(3)
+	RecoverReplicaOfCollection(m, 1000)
+	replicas, err = SpawnReplicasWithRGBestEffort(m, 1000, "", nil, 3)
+	assert.NoError(t, err)
+	assert.Len(t, replicas, 1)
+	assert.Len(t, m.ReplicaManager.GetByCollection(1000), 3)
+	// the extra nodes turn to ro nodes, and come to the new replicas after released
+	assertRecoveredOneNodePerReplica := func(collection int64) {
+		for _, replica := range m.ReplicaManager.GetByCollection(collection) {
+			if replica.RONodesCount() > 0 {
+				assert.NoError(t, m.ReplicaManager.RemoveNode(replica.GetID(), replica.GetRONodes()...))
+			}
+		}
+		RecoverReplicaOfCollection(m, collection)
+		for _, replica := range m.ReplicaManager.GetByCollection(collection) {
+			assert.Equal(t, 1, replica.RWNodesCount())
+		}
+	}
+	assertRecoveredOneNodePerReplica(1000)
+
+	// the only replica holds all nodes, hands over the extra nodes to the new replicas
+	replicas, err = SpawnReplicasWithRGBestEffort(m, 1002, "", nil, 1)
+	assert.NoError(t, err)
+	assert.Len(t, replicas, 1)
+	assert.Len(t, m.ReplicaManager.Get(replicas[0].GetID()).GetNodes(), 3)
+	replicas, err = SpawnReplicasWithRGBestEffort(m, 1002, "", nil, 4)
+	assert.NoError(t, err)
+	assert.Len(t, replicas, 2)
+	assert.Len(t, m.ReplicaManager.GetByCollection(1002), 3)
+	assertRecoveredOneNodePerReplica(1002)
+
+	_, err = SpawnReplicasWithRGBestEffort(m, 1001, "", []string{"rg1", "rg2"}, 3)
+	assert.ErrorIs(t, err, ErrUseWrongNumRG)
+}
+
+func TestSpawnMoreReplicasWithRG(t *testing.T) {
+	paramtable.Init()
+	config := GenerateEtcdConfig()
+	cli, _ := etcd.GetEtcdClient(
+		config.UseEmbedEtcd.GetAsBool(),
+		config.EtcdUseSSL.GetAsBool(),
+		config.Endpoints.GetAsStrings(),
+		config.EtcdTLSCert.GetValue(),
+		config.EtcdTLSKey.GetValue(),
+		config.EtcdTLSCACert.GetValue(),
+		config.EtcdTLSMinVersion.GetValue())
+	kv := etcdKV.NewEtcdKV(cli, config.MetaRootPath.GetValue())
+
+	store := querycoord.NewCatalog(kv)
+	nodeMgr := session.NewNodeManager()
+	m := meta.NewMeta(RandomIncrementIDAllocator(), store, nodeMgr)
+	m.ResourceManager.AddResourceGroup("rg1", &rgpb.ResourceGroupConfig{
+		Requests: &rgpb.ResourceGroupLimit{NodeNum: 2},
+		Limits:   &rgpb.ResourceGroupLimit{NodeNum: 2},
+	})
+	m.ResourceManager.AddResourceGroup("rg2", &rgpb.ResourceGroupConfig{
+		Requests: &rgpb.ResourceGroupLimit{NodeNum: 2},
+		Limits:   &rgpb.ResourceGroupLimit{NodeNum: 2},
+	})
+	for i := 1; i <= 4; i++ {
+		nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   int64(i),
+			Address:  "localhost",
+			Hostname: "localhost",
+		}))
+		m.ResourceManager.HandleNodeUp(int64(i))
+	}
+
+	replicas, err := SpawnReplicasWithRG(m, 1000, []string{"rg1"}, 1)
+	assert.NoError(t, err)
+	assert.Len(t, replicas, 1)
+
+	// the replica in rg1 is counted in, only one more replica is needed in rg2
+	replicas, err = SpawnMoreReplicasWithRG(m, 1000, []string{"rg2"}, 2)
+	assert.NoError(t, err)
+	assert.Len(t, replicas, 1)
+	assert.Equal(t, "rg2", replicas[0].GetResourceGroup())
+	assert.Len(t, m.ReplicaManager.GetByCollection(1000), 2)
+
+	// retry spawns nothing
+	replicas, err = SpawnMoreReplicasWithRG(m, 1000, []string{"rg2"}, 2)
+	assert.NoError(t, err)
+	assert.Len(t, replicas, 0)
+	assert.Len(t, m.ReplicaManager.GetByCollection(1000), 2)
+
+	_, err = SpawnMoreReplicasWithRG(m, 1000, []string{"rg2"}, 3)
+	assert.ErrorIs(t, err, meta.ErrNodeNotEnough)
+}
+
 func TestAddNodesToCollectionsInRGFailed(t *testing.T) {
 	paramtable.Init()
 
@@ -269,3 +400,126 @@ func TestAddNodesToCollectionsInRG(t *testing.T) {
 	assert.Len(t, m.ReplicaManager.Get(3).GetNodes(), 2)
 	assert.Len(t, m.ReplicaManager.Get(4).GetNodes(), 2)
 }
+
+func TestTenantIsolation(t *testing.T) {
+	paramtable.Init()
+	config := GenerateEtcdConfig()
+	cli, _ := etcd.GetEtcdClient(
+		config.UseEmbedEtcd.GetAsBool(),
+		config.EtcdUseSSL.GetAsBool(),
+		config.Endpoints.GetAsStrings(),
+		config.EtcdTLSCert.GetValue(),
+		config.EtcdTLSKey.GetValue(),
+		config.EtcdTLSCACert.GetValue(),
+		config.EtcdTLSMinVersion.GetValue())
+	kv := etcdKV.NewEtcdKV(cli, config.MetaRootPath.GetValue())
+
+	store := querycoord.NewCatalog(kv)
+	nodeMgr := session.NewNodeManager()
+	m := meta.NewMeta(RandomIncrementIDAllocator(), store, nodeMgr)
+	for i := 1; i <= 4; i++ {
+		nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   int64(i),
+			Address:  "localhost",
+			Hostname: "localhost",
+		}))
+		m.ResourceManager.HandleNodeUp(int64(i))
+	}
+
+	// the only replica of tenant a holds all nodes
+	replicas, err := SpawnTenantReplicasWithRG(m, 1000, "a", nil, 1)
+	assert.NoError(t, err)
+	assert.Len(t, replicas, 1)
+	assert.Equal(t, "a", replicas[0].GetTenant())
+	assert.Len(t, m.ReplicaManager.Get(replicas[0].GetID()).GetNodes(), 4)
+	// collection without tenant doesn't occupy any node
+	_, err = SpawnReplicasWithRG(m, 1001, nil, 2)
+	assert.NoError(t, err)
+
+	assert.ElementsMatch(t, []int64{1, 2, 3, 4}, GetTenantOccupiedNodes(m, "b").Collect())
+	assert.Empty(t, GetTenantOccupiedNodes(m, "a"))
+	assert.Equal(t, 1, m.ReplicaManager.GetTenantReservedNodeNum("b", meta.DefaultResourceGroupName))
+	assert.Zero(t, m.ReplicaManager.GetTenantReservedNodeNum("a", meta.DefaultResourceGroupName))
+
+	// the replica of tenant a reserves one node only, tenant b shares the resource group
+	_, err = SpawnTenantReplicasWithRG(m, 1002, "b", nil, 4)
+	assert.ErrorIs(t, err, meta.ErrNodeNotEnough)
+	replicas, err = SpawnTenantReplicasWithRG(m, 1002, "b", nil, 3)
+	assert.NoError(t, err)
+	assert.Len(t, replicas, 3)
+	_, err = SpawnTenantReplicasWithRG(m, 1003, "c", nil, 1)
+	assert.ErrorIs(t, err, meta.ErrNodeNotEnough)
+
+	// tenant a hands over the extra nodes to tenant b
+	RecoverReplicaOfCollection(m, 1000)
+	replicaA := m.ReplicaManager.GetByCollection(1000)[0]
+	assert.Equal(t, 1, replicaA.RWNodesCount())
+	assert.Equal(t, 3, replicaA.RONodesCount())
+	assert.NoError(t, m.ReplicaManager.RemoveNode(replicaA.GetID(), replicaA.GetRONodes()...))
+	RecoverReplicaOfCollection(m, 1002)
+	nodesB := typeutil.NewUniqueSet()
+	for _, replica := range m.ReplicaManager.GetByCollection(1002) {
+		assert.Equal(t, 1, replica.RWNodesCount())
+		nodesB.Insert(replica.GetNodes()...)
+	}
+	assert.Equal(t, 3, nodesB.Len())
+	assert.False(t, nodesB.Contain(m.ReplicaManager.GetByCollection(1000)[0].GetNodes()...))
+}
+
+func TestMarkStandbyReplicas(t *testing.T) {
+	paramtable.Init()
+	config := GenerateEtcdConfig()
+	cli, _ := etcd.GetEtcdClient(
+		config.UseEmbedEtcd.GetAsBool(),
+		config.EtcdUseSSL.GetAsBool(),
+		config.Endpoints.GetAsStrings(),
+		config.EtcdTLSCert.GetValue(),
+		config.EtcdTLSKey.GetValue(),
+		config.EtcdTLSCACert.GetValue(),
+		config.EtcdTLSMinVersion.GetValue())
+	kv := etcdKV.NewEtcdKV(cli, config.MetaRootPath.GetValue())
+
+	store := querycoord.NewCatalog(kv)
+	nodeMgr := session.NewNodeManager()
+	m := meta.NewMeta(RandomIncrementIDAllocator(), store, nodeMgr)
+	addNode := func(nodeID int64) {
+		nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   nodeID,
+			Address:  "localhost",
+			Hostname: "localhost",
+		}))
+		m.ResourceManager.HandleNodeUp(nodeID)
+	}
+	addNode(1)
+	addNode(2)
+
+	collection := CreateTestCollection(1000, 3)
+	collection.BestEffort = true
+	collection.StandbyReplicaNumber = 2
+	assert.NoError(t, m.CollectionManager.PutCollection(collection))
+	standbyNum := func() int {
+		return len(lo.Filter(m.ReplicaManager.GetByCollection(1000), func(replica *meta.Replica, _ int) bool {
+			return replica.IsStandby()
+		}))
+	}
+
+	// only 2 replicas spawned, keep one primary replica
+	replicas, err := SpawnReplicasWithRGBestEffort(m, 1000, "", nil, 3)
+	assert.NoError(t, err)
+	assert.Len(t, replicas, 2)
+	assert.Equal(t, 1, standbyNum())
+
+	// the topped up replica is marked as standby too
+	addNode(3)
+	RecoverReplicaOfCollection(m, 1000)
+	replicas, err = SpawnReplicasWithRGBestEffort(m, 1000, "", nil, 3)
+	assert.NoError(t, err)
+	assert.Len(t, replicas, 1)
+	assert.True(t, m.ReplicaManager.Get(replicas[0].GetID()).IsStandby())
+	assert.Equal(t, 2, standbyNum())
+
+	// nothing to mark if standby replicas are enough
+	standbyIDs, err := MarkStandbyReplicas(m, 1000, 2)
+	assert.NoError(t, err)
+	assert.Empty(t, standbyIDs)
+}
@@ -0,0 +1,97 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
+)
+
+// CollectionMetricsLabel returns the collection id label value of the collection level metrics.
+// It's empty, which means no such label, unless the collection metrics label is enabled
+// and the collection is in the allow list.
+func CollectionMetricsLabel(collectionID int64) string {
+	if !params.Params.QueryCoordCfg.EnableCollectionMetricsLabel.GetAsBool() {
+		return ""
+	}
+
+	label := strconv.FormatInt(collectionID, 10)
+	restricted := false
+	for _, id := range params.Params.QueryCoordCfg.CollectionMetricsLabelAllowList.GetAsStrings() {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			continue
+		}
+		if id == label {
+			return label
+		}
+		restricted = true
+	}
+	// empty allow list means all collections
+	if !restricted {
+		return label
+	}
+	return ""
+}
+
+// GetTargetLag returns the number and estimated size of segments in next target of the collection,
+// which are not loaded into current target yet.
+func GetTargetLag(targetMgr *meta.TargetManager, collectionID int64) (int, int64) {
+	currentTargets := targetMgr.GetSealedSegmentsByCollection(collectionID, meta.CurrentTarget)
+	segmentNum, size := 0, int64(0)
+	for segmentID, segment := range targetMgr.GetSealedSegmentsByCollection(collectionID, meta.NextTarget) {
+		if _, ok := currentTargets[segmentID]; !ok {
+			segmentNum++
+			size += GetSegmentSize(segment)
+		}
+	}
+	return segmentNum, size
+}
+
+// GetQueryNodeInfos returns the system info metrics of the given query node.
+func GetQueryNodeInfos(ctx context.Context, cluster session.Cluster, nodeID int64) (*metricsinfo.QueryNodeInfos, error) {
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SystemInfoMetrics)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := cluster.GetMetrics(ctx, nodeID, req)
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		return nil, err
+	}
+
+	infos := &metricsinfo.QueryNodeInfos{}
+	if err := metricsinfo.UnmarshalComponentInfos(resp.GetResponse(), infos); err != nil {
+		return nil, err
+	}
+	return infos, nil
+}
+
+// GetNodeMemory returns the memory capacity and usage of the given query node, in bytes.
+func GetNodeMemory(ctx context.Context, cluster session.Cluster, nodeID int64) (uint64, uint64, error) {
+	infos, err := GetQueryNodeInfos(ctx, cluster, nodeID)
+	if err != nil {
+		return 0, 0, err
+	}
+	return infos.HardwareInfos.Memory, infos.HardwareInfos.MemoryUsage, nil
+}
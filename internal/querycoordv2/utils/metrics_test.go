@@ -0,0 +1,78 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestCollectionMetricsLabel(t *testing.T) {
+	paramtable.Init()
+	cfg := &paramtable.Get().QueryCoordCfg
+
+	// disabled by default
+	assert.Equal(t, "", CollectionMetricsLabel(100))
+
+	paramtable.Get().Save(cfg.EnableCollectionMetricsLabel.Key, "true")
+	defer paramtable.Get().Reset(cfg.EnableCollectionMetricsLabel.Key)
+	assert.Equal(t, "100", CollectionMetricsLabel(100))
+	assert.Equal(t, "101", CollectionMetricsLabel(101))
+
+	paramtable.Get().Save(cfg.CollectionMetricsLabelAllowList.Key, "100, 102")
+	defer paramtable.Get().Reset(cfg.CollectionMetricsLabelAllowList.Key)
+	assert.Equal(t, "100", CollectionMetricsLabel(100))
+	assert.Equal(t, "", CollectionMetricsLabel(101))
+	assert.Equal(t, "102", CollectionMetricsLabel(102))
+}
+
+func TestGetNodeMemory(t *testing.T) {
+	ctx := context.Background()
+	cluster := session.NewMockCluster(t)
+	infos, err := metricsinfo.MarshalComponentInfos(metricsinfo.QueryNodeInfos{
+		BaseComponentInfos: metricsinfo.BaseComponentInfos{
+			HardwareInfos: metricsinfo.HardwareMetrics{
+				Memory:      1000,
+				MemoryUsage: 400,
+			},
+		},
+	})
+	assert.NoError(t, err)
+	cluster.EXPECT().GetMetrics(mock.Anything, int64(1), mock.Anything).Return(&milvuspb.GetMetricsResponse{
+		Status:   merr.Success(),
+		Response: infos,
+	}, nil)
+	cluster.EXPECT().GetMetrics(mock.Anything, int64(2), mock.Anything).Return(nil, errors.New("mock error"))
+
+	memory, usage, err := GetNodeMemory(ctx, cluster, 1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1000, memory)
+	assert.EqualValues(t, 400, usage)
+
+	_, _, err = GetNodeMemory(ctx, cluster, 2)
+	assert.Error(t, err)
+}
@@ -35,15 +35,17 @@ func MergeMetaSegmentIntoSegmentInfo(info *querypb.SegmentInfo, segments ...*met
 	first := segments[0]
 	if info.GetSegmentID() == 0 {
 		*info = querypb.SegmentInfo{
-			NodeID:       paramtable.GetNodeID(),
-			SegmentID:    first.GetID(),
-			CollectionID: first.GetCollectionID(),
-			PartitionID:  first.GetPartitionID(),
-			NumRows:      first.GetNumOfRows(),
-			DmChannel:    first.GetInsertChannel(),
-			NodeIds:      make([]int64, 0),
-			SegmentState: commonpb.SegmentState_Sealed,
-			IndexInfos:   make([]*querypb.FieldIndexInfo, 0),
+			NodeID:         paramtable.GetNodeID(),
+			SegmentID:      first.GetID(),
+			CollectionID:   first.GetCollectionID(),
+			PartitionID:    first.GetPartitionID(),
+			NumRows:        first.GetNumOfRows(),
+			MemSize:        GetSegmentSize(first.SegmentInfo),
+			DmChannel:      first.GetInsertChannel(),
+			NodeIds:        make([]int64, 0),
+			SegmentState:   commonpb.SegmentState_Sealed,
+			IndexInfos:     make([]*querypb.FieldIndexInfo, 0),
+			LoadPercentage: 100,
 		}
 		for _, indexInfo := range first.IndexInfo {
 			info.IndexName = indexInfo.IndexName
@@ -156,3 +158,18 @@ func MergeDmChannelInfo(infos []*datapb.VchannelInfo) *meta.DmChannel {
 
 	return dmChannel
 }
+
+// GetSegmentSize returns the sum of binlog, statslog and deltalog size of given segment.
+func GetSegmentSize(segment *datapb.SegmentInfo) int64 {
+	size := int64(0)
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		size += getFieldSizeFromFieldBinlog(fieldBinlog)
+	}
+	for _, fieldBinlog := range segment.GetStatslogs() {
+		size += getFieldSizeFromFieldBinlog(fieldBinlog)
+	}
+	for _, fieldBinlog := range segment.GetDeltalogs() {
+		size += getFieldSizeFromFieldBinlog(fieldBinlog)
+	}
+	return size
+}
@@ -29,6 +29,49 @@ func (_m *MockLoader) EXPECT() *MockLoader_Expecter {
 	return &MockLoader_Expecter{mock: &_m.Mock}
 }
 
+// GetLoadingProgress provides a mock function with given fields:
+func (_m *MockLoader) GetLoadingProgress() []*querypb.SegmentLoadingProgress {
+	ret := _m.Called()
+
+	var r0 []*querypb.SegmentLoadingProgress
+	if rf, ok := ret.Get(0).(func() []*querypb.SegmentLoadingProgress); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*querypb.SegmentLoadingProgress)
+		}
+	}
+
+	return r0
+}
+
+// MockLoader_GetLoadingProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoadingProgress'
+type MockLoader_GetLoadingProgress_Call struct {
+	*mock.Call
+}
+
+// GetLoadingProgress is a helper method to define mock.On call
+func (_e *MockLoader_Expecter) GetLoadingProgress() *MockLoader_GetLoadingProgress_Call {
+	return &MockLoader_GetLoadingProgress_Call{Call: _e.mock.On("GetLoadingProgress")}
+}
+
+func (_c *MockLoader_GetLoadingProgress_Call) Run(run func()) *MockLoader_GetLoadingProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockLoader_GetLoadingProgress_Call) Return(_a0 []*querypb.SegmentLoadingProgress) *MockLoader_GetLoadingProgress_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLoader_GetLoadingProgress_Call) RunAndReturn(run func() []*querypb.SegmentLoadingProgress) *MockLoader_GetLoadingProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Load provides a mock function with given fields: ctx, collectionID, segmentType, version, segments
 func (_m *MockLoader) Load(ctx context.Context, collectionID int64, segmentType commonpb.SegmentState, version int64, segments ...*querypb.SegmentLoadInfo) ([]Segment, error) {
 	_va := make([]interface{}, len(segments))
@@ -88,6 +88,9 @@ type Loader interface {
 		segment *LocalSegment,
 		loadInfo *querypb.SegmentLoadInfo,
 	) error
+
+	// GetLoadingProgress returns the progress of the sealed segments being loaded
+	GetLoadingProgress() []*querypb.SegmentLoadingProgress
 }
 
 type LoadResource struct {
@@ -553,12 +556,23 @@ const (
 type loadResult struct {
 	status *atomic.Int32
 	cond   *sync.Cond
+
+	segmentType SegmentType
+	info        *querypb.SegmentLoadInfo
+	totalBytes  int64
+	loadedBytes *atomic.Int64
 }
 
-func newLoadResult() *loadResult {
+func newLoadResult(segmentType SegmentType, info *querypb.SegmentLoadInfo) *loadResult {
+	indexedFieldInfos, fieldBinlogs := separateIndexAndBinlog(info)
+	indexBytes, binlogBytes := getLoadingBytes(indexedFieldInfos, fieldBinlogs)
 	return &loadResult{
-		status: atomic.NewInt32(loading),
-		cond:   sync.NewCond(&sync.Mutex{}),
+		status:      atomic.NewInt32(loading),
+		cond:        sync.NewCond(&sync.Mutex{}),
+		segmentType: segmentType,
+		info:        info,
+		totalBytes:  indexBytes + binlogBytes,
+		loadedBytes: atomic.NewInt64(0),
 	}
 }
 
@@ -750,7 +764,7 @@ func (loader *segmentLoader) prepare(ctx context.Context, segmentType SegmentTyp
 		if len(loader.manager.Segment.GetBy(WithType(segmentType), WithID(segment.GetSegmentID()))) == 0 &&
 			!loader.loadingSegments.Contain(segment.GetSegmentID()) {
 			infos = append(infos, segment)
-			loader.loadingSegments.Insert(segment.GetSegmentID(), newLoadResult())
+			loader.loadingSegments.Insert(segment.GetSegmentID(), newLoadResult(segmentType, segment))
 		} else {
 			log.Info("skip loaded/loading segment",
 				zap.Int64("segmentID", segment.GetSegmentID()),
@@ -774,6 +788,32 @@ func (loader *segmentLoader) unregister(segments ...*querypb.SegmentLoadInfo) {
 	}
 }
 
+func (loader *segmentLoader) GetLoadingProgress() []*querypb.SegmentLoadingProgress {
+	progresses := make([]*querypb.SegmentLoadingProgress, 0)
+	loader.loadingSegments.Range(func(segmentID int64, result *loadResult) bool {
+		if result.segmentType != SegmentTypeSealed || result.status.Load() != loading {
+			return true
+		}
+		progresses = append(progresses, &querypb.SegmentLoadingProgress{
+			SegmentID:   segmentID,
+			Collection:  result.info.GetCollectionID(),
+			Partition:   result.info.GetPartitionID(),
+			Channel:     result.info.GetInsertChannel(),
+			LoadedBytes: result.loadedBytes.Load(),
+			TotalBytes:  result.totalBytes,
+		})
+		return true
+	})
+	return progresses
+}
+
+// addLoadedBytes records the loaded bytes of the segment being loaded
+func (loader *segmentLoader) addLoadedBytes(segmentID int64, bytes int64) {
+	if result, ok := loader.loadingSegments.Get(segmentID); ok {
+		result.loadedBytes.Add(bytes)
+	}
+}
+
 func (loader *segmentLoader) notifyLoadFinish(segments ...*querypb.SegmentLoadInfo) {
 	for _, loadInfo := range segments {
 		result, ok := loader.loadingSegments.Get(loadInfo.GetSegmentID())
@@ -1021,9 +1061,11 @@ func (loader *segmentLoader) loadSealedSegment(ctx context.Context, loadInfo *qu
 	log.Info("Start loading fields...",
 		zap.Int64s("indexedFields", lo.Keys(indexedFieldInfos)),
 	)
+	indexBytes, binlogBytes := getLoadingBytes(indexedFieldInfos, fieldBinlogs)
 	if err := loader.loadFieldsIndex(ctx, schemaHelper, segment, loadInfo.GetNumOfRows(), indexedFieldInfos); err != nil {
 		return err
 	}
+	loader.addLoadedBytes(segment.ID(), indexBytes)
 	loadFieldsIndexSpan := tr.RecordSpan()
 	metrics.QueryNodeLoadIndexLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Observe(float64(loadFieldsIndexSpan))
 
@@ -1049,6 +1091,7 @@ func (loader *segmentLoader) loadSealedSegment(ctx context.Context, loadInfo *qu
 	if err := loadSealedSegmentFields(ctx, collection, segment, fieldBinlogs, loadInfo.GetNumOfRows()); err != nil {
 		return err
 	}
+	loader.addLoadedBytes(segment.ID(), binlogBytes)
 	loadRawDataSpan := tr.RecordSpan()
 
 	// 4. rectify entries number for binlog in very rare cases
@@ -1667,6 +1710,19 @@ func (loader *segmentLoader) LoadIndex(ctx context.Context, segment *LocalSegmen
 	return loader.waitSegmentLoadDone(ctx, commonpb.SegmentState_SegmentStateNone, []int64{loadInfo.GetSegmentID()}, version)
 }
 
+// getLoadingBytes returns the size of the indexes and the binlogs of the fields without index to load
+func getLoadingBytes(indexedFieldInfos map[int64]*IndexedFieldInfo, fieldBinlogs []*datapb.FieldBinlog) (int64, int64) {
+	indexBytes := int64(0)
+	for _, info := range indexedFieldInfos {
+		indexBytes += info.IndexInfo.GetIndexSize()
+	}
+	binlogBytes := int64(0)
+	for _, fieldBinlog := range fieldBinlogs {
+		binlogBytes += getBinlogDataSize(fieldBinlog)
+	}
+	return indexBytes, binlogBytes
+}
+
 func getBinlogDataSize(fieldBinlog *datapb.FieldBinlog) int64 {
 	fieldSize := int64(0)
 	for _, binlog := range fieldBinlog.Binlogs {
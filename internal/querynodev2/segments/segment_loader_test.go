@@ -151,6 +151,48 @@ func (suite *SegmentLoaderSuite) TestLoad() {
 	suite.NoError(err)
 }
 
+func (suite *SegmentLoaderSuite) TestGetLoadingProgress() {
+	ctx := context.Background()
+	loader := suite.loader.(*segmentLoader)
+
+	sealed := &querypb.SegmentLoadInfo{
+		SegmentID:     suite.segmentID,
+		PartitionID:   suite.partitionID,
+		CollectionID:  suite.collectionID,
+		InsertChannel: "test-channel",
+		BinlogPaths: []*datapb.FieldBinlog{
+			{FieldID: 100, Binlogs: []*datapb.Binlog{{LogSize: 10}, {LogSize: 20}}},
+			{FieldID: 101, Binlogs: []*datapb.Binlog{{LogSize: 30}}},
+		},
+		IndexInfos: []*querypb.FieldIndexInfo{
+			{FieldID: 101, IndexFilePaths: []string{"index"}, IndexSize: 40},
+		},
+	}
+	growing := &querypb.SegmentLoadInfo{
+		SegmentID:    suite.segmentID + 1,
+		PartitionID:  suite.partitionID,
+		CollectionID: suite.collectionID,
+	}
+	loader.prepare(ctx, SegmentTypeSealed, sealed)
+	loader.prepare(ctx, SegmentTypeGrowing, growing)
+	defer loader.unregister(sealed, growing)
+
+	// only the sealed segments being loaded are reported
+	progresses := loader.GetLoadingProgress()
+	suite.Len(progresses, 1)
+	suite.Equal(suite.segmentID, progresses[0].GetSegmentID())
+	suite.Equal("test-channel", progresses[0].GetChannel())
+	suite.EqualValues(0, progresses[0].GetLoadedBytes())
+	// the binlogs of field 100 and the index of field 101
+	suite.EqualValues(70, progresses[0].GetTotalBytes())
+
+	loader.addLoadedBytes(suite.segmentID, 40)
+	suite.EqualValues(40, loader.GetLoadingProgress()[0].GetLoadedBytes())
+
+	loader.notifyLoadFinish(sealed)
+	suite.Len(loader.GetLoadingProgress(), 0)
+}
+
 func (suite *SegmentLoaderSuite) TestLoadFail() {
 	ctx := context.Background()
 
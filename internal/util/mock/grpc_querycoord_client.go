@@ -181,3 +181,179 @@ func (m *GrpcQueryCoordClient) TransferChannel(ctx context.Context, req *querypb
 func (m *GrpcQueryCoordClient) CheckQueryNodeDistribution(ctx context.Context, req *querypb.CheckQueryNodeDistributionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
+
+func (m *GrpcQueryCoordClient) GetClusterLoadSummary(ctx context.Context, req *querypb.GetClusterLoadSummaryRequest, opts ...grpc.CallOption) (*querypb.GetClusterLoadSummaryResponse, error) {
+	return &querypb.GetClusterLoadSummaryResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) ForceSync(ctx context.Context, req *querypb.ForceSyncRequest, opts ...grpc.CallOption) (*querypb.ForceSyncResponse, error) {
+	return &querypb.ForceSyncResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) GetTransferNodeStatus(ctx context.Context, req *querypb.GetTransferNodeStatusRequest, opts ...grpc.CallOption) (*querypb.GetTransferNodeStatusResponse, error) {
+	return &querypb.GetTransferNodeStatusResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) TriggerCheckerRun(ctx context.Context, req *querypb.TriggerCheckerRunRequest, opts ...grpc.CallOption) (*querypb.TriggerCheckerRunResponse, error) {
+	return &querypb.TriggerCheckerRunResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) GetAvailabilitySLA(ctx context.Context, req *querypb.GetAvailabilitySLARequest, opts ...grpc.CallOption) (*querypb.GetAvailabilitySLAResponse, error) {
+	return &querypb.GetAvailabilitySLAResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) GetReleaseProgress(ctx context.Context, req *querypb.GetReleaseProgressRequest, opts ...grpc.CallOption) (*querypb.GetReleaseProgressResponse, error) {
+	return &querypb.GetReleaseProgressResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) GetTenantViolations(ctx context.Context, req *querypb.GetTenantViolationsRequest, opts ...grpc.CallOption) (*querypb.GetTenantViolationsResponse, error) {
+	return &querypb.GetTenantViolationsResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) CaptureBalanceLayout(ctx context.Context, req *querypb.CaptureBalanceLayoutRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) ClearBalanceLayout(ctx context.Context, req *querypb.ClearBalanceLayoutRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) GetBalanceLayoutStatus(ctx context.Context, req *querypb.GetBalanceLayoutStatusRequest, opts ...grpc.CallOption) (*querypb.GetBalanceLayoutStatusResponse, error) {
+	return &querypb.GetBalanceLayoutStatusResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) GetCollectionLoadInfo(ctx context.Context, req *querypb.GetCollectionLoadInfoRequest, opts ...grpc.CallOption) (*querypb.GetCollectionLoadInfoResponse, error) {
+	return &querypb.GetCollectionLoadInfoResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) RecommendReplicaCount(ctx context.Context, req *querypb.RecommendReplicaCountRequest, opts ...grpc.CallOption) (*querypb.RecommendReplicaCountResponse, error) {
+	return &querypb.RecommendReplicaCountResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) PauseTargetUpdates(ctx context.Context, req *querypb.PauseTargetUpdatesRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) ResumeTargetUpdates(ctx context.Context, req *querypb.ResumeTargetUpdatesRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) GetNodeLoadHistory(ctx context.Context, req *querypb.GetNodeLoadHistoryRequest, opts ...grpc.CallOption) (*querypb.GetNodeLoadHistoryResponse, error) {
+	return &querypb.GetNodeLoadHistoryResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) BlockShard(ctx context.Context, req *querypb.BlockShardRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) UnblockShard(ctx context.Context, req *querypb.UnblockShardRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) GetResourceGroupDrift(ctx context.Context, req *querypb.GetResourceGroupDriftRequest, opts ...grpc.CallOption) (*querypb.GetResourceGroupDriftResponse, error) {
+	return &querypb.GetResourceGroupDriftResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) CanStopNode(ctx context.Context, req *querypb.CanStopNodeRequest, opts ...grpc.CallOption) (*querypb.CanStopNodeResponse, error) {
+	return &querypb.CanStopNodeResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) MoveCollectionToResourceGroup(ctx context.Context, req *querypb.MoveCollectionToResourceGroupRequest, opts ...grpc.CallOption) (*querypb.MoveCollectionToResourceGroupResponse, error) {
+	return &querypb.MoveCollectionToResourceGroupResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) GetShardLeadersBatch(ctx context.Context, req *querypb.GetShardLeadersBatchRequest, opts ...grpc.CallOption) (*querypb.GetShardLeadersBatchResponse, error) {
+	return &querypb.GetShardLeadersBatchResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) GetHandoffLag(ctx context.Context, req *querypb.GetHandoffLagRequest, opts ...grpc.CallOption) (*querypb.GetHandoffLagResponse, error) {
+	return &querypb.GetHandoffLagResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) CreateResourceGroupWithAutoFill(ctx context.Context, req *querypb.CreateResourceGroupWithAutoFillRequest, opts ...grpc.CallOption) (*querypb.CreateResourceGroupWithAutoFillResponse, error) {
+	return &querypb.CreateResourceGroupWithAutoFillResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) GetLoadInfo(ctx context.Context, req *querypb.GetLoadInfoRequest, opts ...grpc.CallOption) (*querypb.GetLoadInfoResponse, error) {
+	return &querypb.GetLoadInfoResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) ReleaseSegments(ctx context.Context, req *querypb.ReleaseSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) DryRunLoadBalance(ctx context.Context, req *querypb.LoadBalanceRequest, opts ...grpc.CallOption) (*querypb.DryRunLoadBalanceResponse, error) {
+	return &querypb.DryRunLoadBalanceResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) TransferNodeByFraction(ctx context.Context, req *querypb.TransferNodeByFractionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) CheckNodeHealth(ctx context.Context, req *querypb.CheckNodeHealthRequest, opts ...grpc.CallOption) (*querypb.CheckNodeHealthResponse, error) {
+	return &querypb.CheckNodeHealthResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) DescribeChecker(ctx context.Context, req *querypb.DescribeCheckerRequest, opts ...grpc.CallOption) (*querypb.DescribeCheckerResponse, error) {
+	return &querypb.DescribeCheckerResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) ListReplicas(ctx context.Context, req *querypb.ListReplicasRequest, opts ...grpc.CallOption) (*querypb.ListReplicasResponse, error) {
+	return &querypb.ListReplicasResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) CancelLoad(ctx context.Context, req *querypb.CancelLoadRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) GetLoadState(ctx context.Context, req *querypb.GetLoadStateRequest, opts ...grpc.CallOption) (*querypb.GetLoadStateResponse, error) {
+	return &querypb.GetLoadStateResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) RebalanceCollection(ctx context.Context, req *querypb.RebalanceCollectionRequest, opts ...grpc.CallOption) (*querypb.RebalanceCollectionResponse, error) {
+	return &querypb.RebalanceCollectionResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) DryRunRebalanceCollection(ctx context.Context, req *querypb.RebalanceCollectionRequest, opts ...grpc.CallOption) (*querypb.RebalanceCollectionResponse, error) {
+	return &querypb.RebalanceCollectionResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) GetResourceGroupUtilization(ctx context.Context, req *querypb.GetResourceGroupUtilizationRequest, opts ...grpc.CallOption) (*querypb.GetResourceGroupUtilizationResponse, error) {
+	return &querypb.GetResourceGroupUtilizationResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) SyncNewCreatedPartitions(ctx context.Context, req *querypb.SyncNewCreatedPartitionsRequest, opts ...grpc.CallOption) (*querypb.SyncNewCreatedPartitionsResponse, error) {
+	return &querypb.SyncNewCreatedPartitionsResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) WatchCollections(ctx context.Context, req *querypb.WatchCollectionsRequest, opts ...grpc.CallOption) (querypb.QueryCoord_WatchCollectionsClient, error) {
+	return nil, m.Err
+}
+
+func (m *GrpcQueryCoordClient) SetCollectionBalanceMode(ctx context.Context, req *querypb.SetCollectionBalanceModeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) GetLoadBalanceStatus(ctx context.Context, req *querypb.GetLoadBalanceStatusRequest, opts ...grpc.CallOption) (*querypb.GetLoadBalanceStatusResponse, error) {
+	return &querypb.GetLoadBalanceStatusResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) SetBalancePolicy(ctx context.Context, req *querypb.SetBalancePolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) DescribeReplica(ctx context.Context, req *querypb.DescribeReplicaRequest, opts ...grpc.CallOption) (*querypb.DescribeReplicaResponse, error) {
+	return &querypb.DescribeReplicaResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) GetCollectionTargets(ctx context.Context, req *querypb.GetCollectionTargetsRequest, opts ...grpc.CallOption) (*querypb.GetCollectionTargetsResponse, error) {
+	return &querypb.GetCollectionTargetsResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) DecommissionNode(ctx context.Context, req *querypb.DecommissionNodeRequest, opts ...grpc.CallOption) (*querypb.DecommissionNodeResponse, error) {
+	return &querypb.DecommissionNodeResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) GetDecommissionProgress(ctx context.Context, req *querypb.GetDecommissionProgressRequest, opts ...grpc.CallOption) (*querypb.GetDecommissionProgressResponse, error) {
+	return &querypb.GetDecommissionProgressResponse{}, m.Err
+}
@@ -49,6 +49,8 @@ const (
 	DefaultServiceRoot = "session/"
 	// DefaultIDKey default id key for Session
 	DefaultIDKey = "id"
+
+	serverLabelEnvPrefix = "MILVUS_SERVER_LABEL_"
 )
 
 // SessionEventType session event type
@@ -100,8 +102,9 @@ type SessionRaw struct {
 	IndexEngineVersion IndexEngineVersion `json:"IndexEngineVersion,omitempty"`
 	LeaseID            *clientv3.LeaseID  `json:"LeaseID,omitempty"`
 
-	HostName   string `json:"HostName,omitempty"`
-	EnableDisk bool   `json:"EnableDisk,omitempty"`
+	HostName     string            `json:"HostName,omitempty"`
+	EnableDisk   bool              `json:"EnableDisk,omitempty"`
+	ServerLabels map[string]string `json:"ServerLabels,omitempty"`
 }
 
 func (s *SessionRaw) GetAddress() string {
@@ -212,6 +215,25 @@ func (s *Session) MarshalJSON() ([]byte, error) {
 	return json.Marshal(s.SessionRaw)
 }
 
+// GetServerLabelsFromEnv collects the labels advertised by this server,
+// read from environment variables with the MILVUS_SERVER_LABEL_ prefix.
+// e.g. MILVUS_SERVER_LABEL_ZONE=az1 yields label zone=az1.
+func GetServerLabelsFromEnv() map[string]string {
+	labels := make(map[string]string)
+	for _, env := range os.Environ() {
+		kv := strings.SplitN(env, "=", 2)
+		if len(kv) != 2 || !strings.HasPrefix(kv[0], serverLabelEnvPrefix) {
+			continue
+		}
+		key := strings.ToLower(strings.TrimPrefix(kv[0], serverLabelEnvPrefix))
+		if key == "" {
+			continue
+		}
+		labels[key] = kv[1]
+	}
+	return labels
+}
+
 // Create a new Session object. Will use global etcd client
 func NewSession(ctx context.Context, opts ...SessionOption) *Session {
 	client, path := kvfactory.GetEtcdAndPath()
@@ -234,7 +256,8 @@ func NewSessionWithEtcd(ctx context.Context, metaRoot string, client *clientv3.C
 		Version:  common.Version,
 
 		SessionRaw: SessionRaw{
-			HostName: hostName,
+			HostName:     hostName,
+			ServerLabels: GetServerLabelsFromEnv(),
 		},
 
 		// options
@@ -766,6 +766,14 @@ func TestServerInfoOp(t *testing.T) {
 	})
 }
 
+func TestGetServerLabelsFromEnv(t *testing.T) {
+	t.Setenv("MILVUS_SERVER_LABEL_ZONE", "az1")
+	t.Setenv("MILVUS_SERVER_LABEL_", "ignored")
+	labels := GetServerLabelsFromEnv()
+	assert.Equal(t, "az1", labels["zone"])
+	assert.NotContains(t, labels, "")
+}
+
 func TestSession_apply(t *testing.T) {
 	session := &Session{}
 	opts := []SessionOption{WithTTL(100), WithRetryTimes(200)}
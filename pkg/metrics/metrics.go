@@ -111,6 +111,11 @@ const (
 	NumEntitiesAllLabel = "all"
 
 	taskTypeLabel = "task_type"
+
+	// node capacity label
+	capacityTypeLabelName   = "capacity_type"
+	SegmentNumCapacityLabel = "segment_num"
+	MemorySizeCapacityLabel = "memory_size"
 )
 
 var (
@@ -65,6 +65,7 @@ var (
 			Help:      "count of load request",
 		}, []string{
 			statusLabelName,
+			collectionIDLabelName,
 		})
 
 	QueryCoordReleaseCount = prometheus.NewCounterVec(
@@ -75,6 +76,7 @@ var (
 			Help:      "count of release request",
 		}, []string{
 			statusLabelName,
+			collectionIDLabelName,
 		})
 
 	QueryCoordLoadLatency = prometheus.NewHistogramVec(
@@ -130,6 +132,57 @@ var (
 			Help:      "latency of all kind of task in query coord scheduler scheduler",
 			Buckets:   longTaskBuckets,
 		}, []string{taskTypeLabel, channelNameLabelName})
+
+	QueryCoordMemoryPressureEvictCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryCoordRole,
+			Name:      "memory_pressure_evict_count",
+			Help:      "number of segments moved out of memory-pressured query nodes",
+		}, []string{
+			nodeIDLabelName,
+			collectionIDLabelName,
+		})
+
+	QueryCoordInflightSegmentLoadNum = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryCoordRole,
+			Name:      "inflight_segment_load_num",
+			Help:      "number of segment loads being executed on each QueryNode",
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	QueryCoordNodeCapacityLimit = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryCoordRole,
+			Name:      "node_capacity_limit",
+			Help:      "the cap of sealed segment number and estimated memory size(in bytes) balanced to each QueryNode, not positive for no limit",
+		}, []string{
+			capacityTypeLabelName,
+		})
+
+	QueryCoordTargetLagSegmentNum = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryCoordRole,
+			Name:      "target_lag_segment_num",
+			Help:      "number of segments in next target which are not loaded into current target yet",
+		}, []string{
+			collectionIDLabelName,
+		})
+
+	QueryCoordTargetLagSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryCoordRole,
+			Name:      "target_lag_size",
+			Help:      "estimated size(in bytes) of segments in next target which are not loaded into current target yet",
+		}, []string{
+			collectionIDLabelName,
+		})
 )
 
 // RegisterQueryCoord registers QueryCoord metrics
@@ -144,4 +197,9 @@ func RegisterQueryCoord(registry *prometheus.Registry) {
 	registry.MustRegister(QueryCoordNumQueryNodes)
 	registry.MustRegister(QueryCoordCurrentTargetCheckpointUnixSeconds)
 	registry.MustRegister(QueryCoordTaskLatency)
+	registry.MustRegister(QueryCoordMemoryPressureEvictCount)
+	registry.MustRegister(QueryCoordInflightSegmentLoadNum)
+	registry.MustRegister(QueryCoordNodeCapacityLimit)
+	registry.MustRegister(QueryCoordTargetLagSegmentNum)
+	registry.MustRegister(QueryCoordTargetLagSize)
 }
@@ -53,6 +53,7 @@ var (
 	ErrCollectionLoaded           = newMilvusError("collection already loaded", 104, false)
 	ErrCollectionIllegalSchema    = newMilvusError("illegal collection schema", 105, false)
 	ErrCollectionOnRecovering     = newMilvusError("collection on recovering", 106, true)
+	ErrCollectionLoadCanceled     = newMilvusError("collection load canceled", 107, false)
 
 	// Partition related
 	ErrPartitionNotFound       = newMilvusError("partition not found", 200, false)
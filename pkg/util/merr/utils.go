@@ -489,6 +489,15 @@ func WrapErrCollectionOnRecovering(collection any, msgAndArgs ...any) error {
 	return err
 }
 
+// WrapErrCollectionLoadCanceled wraps ErrCollectionLoadCanceled with collection
+func WrapErrCollectionLoadCanceled(collection any, msg ...string) error {
+	err := wrapFields(ErrCollectionLoadCanceled, value("collection", collection))
+	if len(msg) > 0 {
+		err = errors.Wrap(err, strings.Join(msg, "->"))
+	}
+	return err
+}
+
 func WrapErrAliasNotFound(db any, alias any, msg ...string) error {
 	err := wrapFields(ErrAliasNotFound,
 		value("database", db),
@@ -28,25 +28,26 @@ import (
 const DefaultMetricsRetention = time.Second * 5
 
 // MetricsCacheManager manage the cache of metrics information.
-// TODO(dragondriver): we can use a map to manage the metrics if there are too many kind metrics
 type MetricsCacheManager struct {
-	systemInfoMetrics                *milvuspb.GetMetricsResponse
-	systemInfoMetricsInvalid         bool
-	systemInfoMetricsLastUpdatedTime time.Time
-	systemInfoMetricsMtx             sync.RWMutex
+	// metric type -> cached metrics
+	metrics    map[string]*cachedMetrics
+	metricsMtx sync.RWMutex
 
 	retention    time.Duration
 	retentionMtx sync.RWMutex // necessary?
 }
 
+type cachedMetrics struct {
+	response        *milvuspb.GetMetricsResponse
+	invalid         bool
+	lastUpdatedTime time.Time
+}
+
 // NewMetricsCacheManager returns a cache manager of metrics information.
 func NewMetricsCacheManager() *MetricsCacheManager {
 	manager := &MetricsCacheManager{
-		systemInfoMetrics:                nil,
-		systemInfoMetricsInvalid:         false,
-		systemInfoMetricsLastUpdatedTime: time.Now(),
-		systemInfoMetricsMtx:             sync.RWMutex{},
-		retention:                        DefaultMetricsRetention,
+		metrics:   make(map[string]*cachedMetrics),
+		retention: DefaultMetricsRetention,
 	}
 
 	return manager
@@ -78,46 +79,70 @@ func (manager *MetricsCacheManager) ResetRetention() {
 
 // InvalidateSystemInfoMetrics invalidates the system information metrics.
 func (manager *MetricsCacheManager) InvalidateSystemInfoMetrics() {
-	manager.systemInfoMetricsMtx.Lock()
-	defer manager.systemInfoMetricsMtx.Unlock()
+	manager.metricsMtx.Lock()
+	defer manager.metricsMtx.Unlock()
 
-	manager.systemInfoMetricsInvalid = true
+	cached, ok := manager.metrics[SystemInfoMetrics]
+	if !ok {
+		cached = &cachedMetrics{}
+		manager.metrics[SystemInfoMetrics] = cached
+	}
+	cached.invalid = true
 }
 
 // IsSystemInfoMetricsValid checks if the manager's systemInfoMetrics is valid
 func (manager *MetricsCacheManager) IsSystemInfoMetricsValid() bool {
-	retention := manager.GetRetention()
-
-	manager.systemInfoMetricsMtx.RLock()
-	defer manager.systemInfoMetricsMtx.RUnlock()
-
-	return (!manager.systemInfoMetricsInvalid) &&
-		(manager.systemInfoMetrics != nil) &&
-		(time.Since(manager.systemInfoMetricsLastUpdatedTime) < retention)
+	_, err := manager.GetSystemInfoMetrics()
+	return err == nil
 }
 
 // GetSystemInfoMetrics returns the cached system information metrics.
 func (manager *MetricsCacheManager) GetSystemInfoMetrics() (*milvuspb.GetMetricsResponse, error) {
 	retention := manager.GetRetention()
 
-	manager.systemInfoMetricsMtx.RLock()
-	defer manager.systemInfoMetricsMtx.RUnlock()
-
-	if manager.systemInfoMetricsInvalid ||
-		manager.systemInfoMetrics == nil ||
-		time.Since(manager.systemInfoMetricsLastUpdatedTime) >= retention {
+	infos, lastUpdatedTime, ok := manager.GetMetrics(SystemInfoMetrics)
+	if !ok || time.Since(lastUpdatedTime) >= retention {
 		return nil, errInvalidSystemInfosMetricCache
 	}
 
-	return manager.systemInfoMetrics, nil
+	return infos, nil
 }
 
 // UpdateSystemInfoMetrics updates systemInfoMetrics by given info
 func (manager *MetricsCacheManager) UpdateSystemInfoMetrics(infos *milvuspb.GetMetricsResponse) {
-	manager.systemInfoMetricsMtx.Lock()
-	defer manager.systemInfoMetricsMtx.Unlock()
+	manager.UpdateMetrics(SystemInfoMetrics, infos)
+}
+
+// GetMetrics returns the cached metrics of the given type and the time it's updated,
+// returns false if it's not cached or has been invalidated, the retention is decided by caller.
+func (manager *MetricsCacheManager) GetMetrics(metricType string) (*milvuspb.GetMetricsResponse, time.Time, bool) {
+	manager.metricsMtx.RLock()
+	defer manager.metricsMtx.RUnlock()
 
-	manager.systemInfoMetrics = infos
-	manager.systemInfoMetricsInvalid = false
-	manager.systemInfoMetricsLastUpdatedTime = time.Now()
+	cached, ok := manager.metrics[metricType]
+	if !ok || cached.invalid || cached.response == nil {
+		return nil, time.Time{}, false
+	}
+	return cached.response, cached.lastUpdatedTime, true
+}
+
+// UpdateMetrics updates the cached metrics of the given type.
+func (manager *MetricsCacheManager) UpdateMetrics(metricType string, response *milvuspb.GetMetricsResponse) {
+	manager.metricsMtx.Lock()
+	defer manager.metricsMtx.Unlock()
+
+	manager.metrics[metricType] = &cachedMetrics{
+		response:        response,
+		lastUpdatedTime: time.Now(),
+	}
+}
+
+// InvalidateMetrics invalidates the cached metrics of all types, including the system information metrics.
+func (manager *MetricsCacheManager) InvalidateMetrics() {
+	manager.metricsMtx.Lock()
+	defer manager.metricsMtx.Unlock()
+
+	for _, cached := range manager.metrics {
+		cached.invalid = true
+	}
 }
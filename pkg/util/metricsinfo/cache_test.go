@@ -67,7 +67,7 @@ func TestMetricsCacheManager_InvalidateSystemInfoMetrics(t *testing.T) {
 	assert.NotNil(t, manager)
 
 	manager.InvalidateSystemInfoMetrics()
-	assert.Equal(t, true, manager.systemInfoMetricsInvalid)
+	assert.Equal(t, true, manager.metrics[SystemInfoMetrics].invalid)
 	assert.Equal(t, false, manager.IsSystemInfoMetricsValid())
 }
 
@@ -163,3 +163,35 @@ func TestMetricsCacheManager_GetSystemInfoMetrics(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 }
+
+func TestMetricsCacheManager_Metrics(t *testing.T) {
+	manager := NewMetricsCacheManager()
+	assert.NotNil(t, manager)
+
+	resp, _, ok := manager.GetMetrics(SystemInfoMetrics)
+	assert.False(t, ok)
+	assert.Nil(t, resp)
+
+	before := time.Now()
+	manager.UpdateMetrics(SystemInfoMetrics, &milvuspb.GetMetricsResponse{Response: "system_info"})
+	manager.UpdateMetrics(CollectionDistributionMetrics, &milvuspb.GetMetricsResponse{Response: "collection_distribution"})
+	resp, updatedTime, ok := manager.GetMetrics(SystemInfoMetrics)
+	assert.True(t, ok)
+	assert.Equal(t, "system_info", resp.GetResponse())
+	assert.False(t, updatedTime.Before(before))
+	// the system information metrics share the same cache
+	assert.True(t, manager.IsSystemInfoMetricsValid())
+	resp, err := manager.GetSystemInfoMetrics()
+	assert.NoError(t, err)
+	assert.Equal(t, "system_info", resp.GetResponse())
+	resp, _, ok = manager.GetMetrics(CollectionDistributionMetrics)
+	assert.True(t, ok)
+	assert.Equal(t, "collection_distribution", resp.GetResponse())
+
+	manager.InvalidateMetrics()
+	_, _, ok = manager.GetMetrics(SystemInfoMetrics)
+	assert.False(t, ok)
+	_, _, ok = manager.GetMetrics(CollectionDistributionMetrics)
+	assert.False(t, ok)
+	assert.False(t, manager.IsSystemInfoMetricsValid())
+}
@@ -30,6 +30,9 @@ const (
 
 	// CollectionStorageMetrics means users request for collection storage metrics.
 	CollectionStorageMetrics = "collection_storage"
+
+	// CollectionDistributionMetrics means users request for the data distribution and health of loaded collections.
+	CollectionDistributionMetrics = "collection_distribution"
 )
 
 // ParseMetricType returns the metric type of req
@@ -112,10 +112,43 @@ type QueryCoordConfiguration struct {
 	SearchResultChannelPrefix string `json:"search_result_channel_prefix"`
 }
 
+// QueryCoordTargetLag records the segments in next target which are not loaded into current target yet.
+type QueryCoordTargetLag struct {
+	SegmentNum int   `json:"segment_num"`
+	Size       int64 `json:"size"`
+}
+
+// QueryCoordTargetLagMetrics records the target lag of all loaded collections.
+type QueryCoordTargetLagMetrics struct {
+	Total       QueryCoordTargetLag            `json:"total"`
+	Collections map[int64]*QueryCoordTargetLag `json:"collections"`
+}
+
 // QueryCoordInfos implements ComponentInfos
 type QueryCoordInfos struct {
 	BaseComponentInfos
-	SystemConfigurations QueryCoordConfiguration `json:"system_configurations"`
+	SystemConfigurations QueryCoordConfiguration     `json:"system_configurations"`
+	TargetLag            *QueryCoordTargetLagMetrics `json:"target_lag"`
+}
+
+// QueryCoordReplicaMetrics records the nodes and readability of a replica.
+type QueryCoordReplicaMetrics struct {
+	ReplicaID int64   `json:"replica_id"`
+	Nodes     []int64 `json:"nodes"`
+	Readable  bool    `json:"readable"`
+}
+
+// QueryCoordCollectionMetrics records the data distribution and health of a loaded collection.
+type QueryCoordCollectionMetrics struct {
+	NodeSegmentNum map[int64]int               `json:"node_segment_num"`
+	Replicas       []*QueryCoordReplicaMetrics `json:"replicas"`
+	// number of segments in next target which are not loaded into current target yet
+	TargetLag int `json:"target_lag"`
+}
+
+// QueryCoordCollectionDistributionMetrics implements ComponentInfos
+type QueryCoordCollectionDistributionMetrics struct {
+	Collections map[int64]*QueryCoordCollectionMetrics `json:"collections"`
 }
 
 // ProxyConfiguration records the configuration of Proxy.
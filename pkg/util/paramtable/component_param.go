@@ -1484,6 +1484,10 @@ type queryCoordConfig struct {
 	EnableRGAutoRecover            ParamItem `refreshable:"true"`
 	CheckHealthInterval            ParamItem `refreshable:"false"`
 	CheckHealthRPCTimeout          ParamItem `refreshable:"true"`
+	CheckHealthMaxConcurrency      ParamItem `refreshable:"true"`
+	CheckHealthRetryAttempts       ParamItem `refreshable:"true"`
+	CheckHealthRetryInterval       ParamItem `refreshable:"true"`
+	ShardLeaderCacheTTL            ParamItem `refreshable:"true"`
 	BrokerTimeout                  ParamItem `refreshable:"false"`
 	CollectionRecoverTimesLimit    ParamItem `refreshable:"true"`
 	ObserverTaskParallel           ParamItem `refreshable:"false"`
@@ -1491,6 +1495,56 @@ type queryCoordConfig struct {
 	CheckNodeSessionInterval       ParamItem `refreshable:"false"`
 	GracefulStopTimeout            ParamItem `refreshable:"true"`
 	EnableStoppingBalance          ParamItem `refreshable:"true"`
+
+	// ---- Memory Pressure Eviction ---
+	EnableMemoryPressureEviction   ParamItem `refreshable:"true"`
+	MemoryPressureThreshold        ParamItem `refreshable:"true"`
+	MemoryPressureCheckInterval    ParamItem `refreshable:"false"`
+	MemoryPressureEvictSegmentStep ParamItem `refreshable:"true"`
+
+	// ---- Availability SLA ---
+	AvailabilityCheckInterval ParamItem `refreshable:"false"`
+	AvailabilityRetention     ParamItem `refreshable:"true"`
+
+	// ---- Recovery load throttle ---
+	RecoveryLoadConcurrencyPerNode ParamItem `refreshable:"true"`
+	RecoveryLoadThrottleDuration   ParamItem `refreshable:"true"`
+
+	// ---- Replica recommendation ---
+	ReplicaRecommendNodeCapacity      ParamItem `refreshable:"true"`
+	ReplicaRecommendTargetUtilization ParamItem `refreshable:"true"`
+
+	// ---- Node load history ---
+	NodeLoadSampleInterval   ParamItem `refreshable:"false"`
+	NodeLoadHistoryRetention ParamItem `refreshable:"true"`
+
+	// ---- Collection metrics label ---
+	EnableCollectionMetricsLabel    ParamItem `refreshable:"true"`
+	CollectionMetricsLabelAllowList ParamItem `refreshable:"true"`
+
+	// ---- Load memory admission ---
+	LoadMemoryHeadroomRatio    ParamItem `refreshable:"true"`
+	LoadAdmissionRetryInterval ParamItem `refreshable:"true"`
+
+	// ---- Leader availability ---
+	LeaderMaxMissingSegmentNum   ParamItem `refreshable:"true"`
+	LeaderMaxMissingSegmentRatio ParamItem `refreshable:"true"`
+
+	// ---- Stuck load retry ---
+	LoadStuckCheckInterval ParamItem `refreshable:"false"`
+	LoadStuckTimeout       ParamItem `refreshable:"true"`
+	LoadStuckMaxRetryTimes ParamItem `refreshable:"true"`
+
+	// ---- Node capacity ---
+	NodeMaxSegmentNum ParamItem `refreshable:"true"`
+	NodeMaxMemorySize ParamItem `refreshable:"true"`
+
+	BalanceStatusRetention ParamItem `refreshable:"true"`
+	DefaultReplicaNumber   ParamItem `refreshable:"true"`
+	ShardLeaderWaitTimeout ParamItem `refreshable:"true"`
+	MetricsCacheTTL        ParamItem `refreshable:"true"`
+	BalanceOnTransferNode  ParamItem `refreshable:"true"`
+	MaxRunningJobs         ParamItem `refreshable:"false"`
 }
 
 func (p *queryCoordConfig) init(base *BaseTable) {
@@ -1882,6 +1936,43 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 	}
 	p.CheckHealthRPCTimeout.Init(base.mgr)
 
+	p.CheckHealthMaxConcurrency = ParamItem{
+		Key:          "queryCoord.checkHealthMaxConcurrency",
+		Version:      "2.4.0",
+		DefaultValue: "16",
+		Doc:          "the max number of query nodes to check health concurrently, unlimited if it's not positive",
+		Export:       true,
+	}
+	p.CheckHealthMaxConcurrency.Init(base.mgr)
+
+	p.CheckHealthRetryAttempts = ParamItem{
+		Key:          "queryCoord.checkHealthRetryAttempts",
+		Version:      "2.4.0",
+		DefaultValue: "3",
+		Doc:          "the max attempts of check health rpc to a query node before it's regarded as unhealthy, all attempts share the checkHealthRPCTimeout",
+		Export:       true,
+	}
+	p.CheckHealthRetryAttempts.Init(base.mgr)
+
+	p.CheckHealthRetryInterval = ParamItem{
+		Key:          "queryCoord.checkHealthRetryInterval",
+		Version:      "2.4.0",
+		DefaultValue: "50",
+		Doc:          "ms, the initial backoff between the attempts of check health rpc, doubled after each attempt",
+		Export:       true,
+	}
+	p.CheckHealthRetryInterval.Init(base.mgr)
+
+	p.ShardLeaderCacheTTL = ParamItem{
+		Key:          "queryCoord.shardLeaderCacheTTL",
+		Version:      "2.4.0",
+		DefaultValue: "0",
+		Doc: `ms, the shard leaders cached longer than it are refreshed asynchronously,
+the cache is disabled if it's not positive`,
+		Export: true,
+	}
+	p.ShardLeaderCacheTTL.Init(base.mgr)
+
 	p.BrokerTimeout = ParamItem{
 		Key:          "queryCoord.brokerTimeout",
 		Version:      "2.3.0",
@@ -1967,6 +2058,290 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 		Export:       true,
 	}
 	p.EnableStoppingBalance.Init(base.mgr)
+
+	p.EnableMemoryPressureEviction = ParamItem{
+		Key:          "queryCoord.enableMemoryPressureEviction",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		Doc:          "whether move segments of the lowest priority collection out of memory-pressured query nodes",
+		Export:       true,
+	}
+	p.EnableMemoryPressureEviction.Init(base.mgr)
+
+	p.MemoryPressureThreshold = ParamItem{
+		Key:          "queryCoord.memoryPressureThreshold",
+		Version:      "2.4.0",
+		DefaultValue: "90",
+		Doc:          "the memory usage percentage above which a query node is considered memory-pressured",
+		Export:       true,
+	}
+	p.MemoryPressureThreshold.Init(base.mgr)
+
+	p.MemoryPressureCheckInterval = ParamItem{
+		Key:          "queryCoord.memoryPressureCheckInterval",
+		Version:      "2.4.0",
+		DefaultValue: "30",
+		Doc:          "the interval(in seconds) of check query node memory pressure",
+		Export:       true,
+	}
+	p.MemoryPressureCheckInterval.Init(base.mgr)
+
+	p.MemoryPressureEvictSegmentStep = ParamItem{
+		Key:          "queryCoord.memoryPressureEvictSegmentStep",
+		Version:      "2.4.0",
+		DefaultValue: "5",
+		Doc:          "the max number of segments moved out of a memory-pressured query node in one round",
+		Export:       true,
+	}
+	p.MemoryPressureEvictSegmentStep.Init(base.mgr)
+
+	p.AvailabilityCheckInterval = ParamItem{
+		Key:          "queryCoord.availabilityCheckInterval",
+		Version:      "2.4.0",
+		DefaultValue: "10",
+		Doc:          "the interval(in seconds) of sample whether the loaded collections are readable",
+		Export:       true,
+	}
+	p.AvailabilityCheckInterval.Init(base.mgr)
+
+	p.AvailabilityRetention = ParamItem{
+		Key:          "queryCoord.availabilityRetention",
+		Version:      "2.4.0",
+		DefaultValue: "86400",
+		Doc:          "the max time window(in seconds) of the collection availability record",
+		Export:       true,
+	}
+	p.AvailabilityRetention.Init(base.mgr)
+
+	p.RecoveryLoadConcurrencyPerNode = ParamItem{
+		Key:          "queryCoord.recoveryLoadConcurrencyPerNode",
+		Version:      "2.4.0",
+		DefaultValue: "0",
+		Doc:          "the max number of segments loading simultaneously on each query node during recovery, 0 means no limit",
+		Export:       true,
+	}
+	p.RecoveryLoadConcurrencyPerNode.Init(base.mgr)
+
+	p.RecoveryLoadThrottleDuration = ParamItem{
+		Key:          "queryCoord.recoveryLoadThrottleDuration",
+		Version:      "2.4.0",
+		DefaultValue: "600",
+		Doc:          "the time duration(in seconds) after query coord starts, during which the segment loads on each query node are throttled",
+		Export:       true,
+	}
+	p.RecoveryLoadThrottleDuration.Init(base.mgr)
+
+	p.ReplicaRecommendNodeCapacity = ParamItem{
+		Key:          "queryCoord.replicaRecommendNodeCapacity",
+		Version:      "2.4.0",
+		DefaultValue: "1000",
+		Doc:          "the search nq per second a query node is able to serve, used to recommend the replica number of collections",
+		Export:       true,
+	}
+	p.ReplicaRecommendNodeCapacity.Init(base.mgr)
+
+	p.ReplicaRecommendTargetUtilization = ParamItem{
+		Key:          "queryCoord.replicaRecommendTargetUtilization",
+		Version:      "2.4.0",
+		DefaultValue: "0.7",
+		Doc:          "the utilization of query node capacity each shard is expected to keep under, used to recommend the replica number of collections",
+		Export:       true,
+	}
+	p.ReplicaRecommendTargetUtilization.Init(base.mgr)
+
+	p.NodeLoadSampleInterval = ParamItem{
+		Key:          "queryCoord.nodeLoadSampleInterval",
+		Version:      "2.4.0",
+		DefaultValue: "60",
+		Formatter: func(v string) string {
+			// a non-positive interval would stop the sampler
+			if getAsInt(v) <= 0 {
+				return "60"
+			}
+			return v
+		},
+		Doc:    "the interval(in seconds) of sample the segment number and memory of each query node, must be positive",
+		Export: true,
+	}
+	p.NodeLoadSampleInterval.Init(base.mgr)
+
+	p.NodeLoadHistoryRetention = ParamItem{
+		Key:          "queryCoord.nodeLoadHistoryRetention",
+		Version:      "2.4.0",
+		DefaultValue: "86400",
+		Doc:          "the max time window(in seconds) of the query node load history",
+		Export:       true,
+	}
+	p.NodeLoadHistoryRetention.Init(base.mgr)
+
+	p.EnableCollectionMetricsLabel = ParamItem{
+		Key:          "queryCoord.enableCollectionMetricsLabel",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		Doc:          "label the load and release request counters and the target lag metrics with collection id, disabled by default to protect the metrics cardinality",
+		Export:       true,
+	}
+	p.EnableCollectionMetricsLabel.Init(base.mgr)
+
+	p.CollectionMetricsLabelAllowList = ParamItem{
+		Key:          "queryCoord.collectionMetricsLabelAllowList",
+		Version:      "2.4.0",
+		DefaultValue: "",
+		Doc:          "comma separated collection ids which are labeled in the load and release request counters and the target lag metrics, empty for all collections",
+		Export:       true,
+	}
+	p.CollectionMetricsLabelAllowList.Init(base.mgr)
+
+	p.LoadMemoryHeadroomRatio = ParamItem{
+		Key:          "queryCoord.loadMemoryHeadroomRatio",
+		Version:      "2.4.0",
+		DefaultValue: "0",
+		Doc: `the ratio of the total memory of query nodes which should be kept free after loading,
+the load which exceeds it is rejected, or waits if auto retry is set. The check is disabled if it's not positive`,
+		Export: true,
+	}
+	p.LoadMemoryHeadroomRatio.Init(base.mgr)
+
+	p.LoadAdmissionRetryInterval = ParamItem{
+		Key:          "queryCoord.loadAdmissionRetryInterval",
+		Version:      "2.4.0",
+		DefaultValue: "10",
+		Doc:          "the interval(in seconds) of checking the memory headroom again for the load waiting for admission",
+		Export:       true,
+	}
+	p.LoadAdmissionRetryInterval.Init(base.mgr)
+
+	p.LeaderMaxMissingSegmentNum = ParamItem{
+		Key:          "queryCoord.leaderMaxMissingSegmentNum",
+		Version:      "2.4.0",
+		DefaultValue: "0",
+		Doc:          "the number of target segments a shard leader may miss while still regarded as available when routing queries",
+		Export:       true,
+	}
+	p.LeaderMaxMissingSegmentNum.Init(base.mgr)
+
+	p.LeaderMaxMissingSegmentRatio = ParamItem{
+		Key:          "queryCoord.leaderMaxMissingSegmentRatio",
+		Version:      "2.4.0",
+		DefaultValue: "0",
+		Doc: `the ratio of target segments a shard leader may miss while still regarded as available when routing queries,
+the leader is available if either the number or the ratio of missing segments is tolerated`,
+		Export: true,
+	}
+	p.LeaderMaxMissingSegmentRatio.Init(base.mgr)
+
+	p.LoadStuckCheckInterval = ParamItem{
+		Key:          "queryCoord.loadStuckCheckInterval",
+		Version:      "2.4.0",
+		DefaultValue: "30",
+		Formatter: func(v string) string {
+			// a non-positive interval would stop the observer
+			if getAsInt(v) <= 0 {
+				return "30"
+			}
+			return v
+		},
+		Doc:    "the interval(in seconds) of check whether the loading collections make progress, must be positive",
+		Export: true,
+	}
+	p.LoadStuckCheckInterval.Init(base.mgr)
+
+	p.LoadStuckTimeout = ParamItem{
+		Key:          "queryCoord.loadStuckTimeout",
+		Version:      "2.4.0",
+		DefaultValue: "300",
+		Doc:          "the time(in seconds) a loading collection makes no progress before its segment loads are dispatched to other nodes",
+		Export:       true,
+	}
+	p.LoadStuckTimeout.Init(base.mgr)
+
+	p.LoadStuckMaxRetryTimes = ParamItem{
+		Key:          "queryCoord.loadStuckMaxRetryTimes",
+		Version:      "2.4.0",
+		DefaultValue: "3",
+		Doc:          "the max times of dispatching the segment loads of a stuck collection to other nodes, the load failure is reported after that",
+		Export:       true,
+	}
+	p.LoadStuckMaxRetryTimes.Init(base.mgr)
+
+	p.NodeMaxSegmentNum = ParamItem{
+		Key:          "queryCoord.nodeMaxSegmentNum",
+		Version:      "2.4.0",
+		DefaultValue: "0",
+		Doc:          "the max number of sealed segments assigned to a query node by balance, no limit if it's not positive",
+		Export:       true,
+	}
+	p.NodeMaxSegmentNum.Init(base.mgr)
+
+	p.NodeMaxMemorySize = ParamItem{
+		Key:          "queryCoord.nodeMaxMemorySize",
+		Version:      "2.4.0",
+		DefaultValue: "0",
+		Doc:          "the max estimated memory size(in MB) of sealed segments assigned to a query node by balance, no limit if it's not positive",
+		Export:       true,
+	}
+	p.NodeMaxMemorySize.Init(base.mgr)
+
+	p.BalanceStatusRetention = ParamItem{
+		Key:          "queryCoord.balanceStatusRetention",
+		Version:      "2.4.0",
+		DefaultValue: "600",
+		Doc:          "the time(in seconds) the status of a finished manual balance operation is kept for query",
+		Export:       true,
+	}
+	p.BalanceStatusRetention.Init(base.mgr)
+
+	p.DefaultReplicaNumber = ParamItem{
+		Key:          "queryCoord.defaultReplicaNumber",
+		Version:      "2.4.0",
+		DefaultValue: "1",
+		Doc:          "the replica number of collections loaded with zero replica number and no resource group specified",
+		Export:       true,
+	}
+	p.DefaultReplicaNumber.Init(base.mgr)
+
+	p.ShardLeaderWaitTimeout = ParamItem{
+		Key:          "queryCoord.shardLeaderWaitTimeout",
+		Version:      "2.4.0",
+		DefaultValue: "0",
+		Doc:          "the time(in ms) GetShardLeaders waits for readable leaders if the request sets no wait timeout, no wait if it's not positive, requests with negative wait timeout never wait",
+		Export:       true,
+	}
+	p.ShardLeaderWaitTimeout.Init(base.mgr)
+
+	p.MetricsCacheTTL = ParamItem{
+		Key:          "queryCoord.metricsCacheTTL",
+		Version:      "2.4.0",
+		DefaultValue: "0",
+		Doc:          "the time(in ms) the responses of GetMetrics are cached for, no cache if it's not positive",
+		Export:       true,
+	}
+	p.MetricsCacheTTL.Init(base.mgr)
+
+	p.BalanceOnTransferNode = ParamItem{
+		Key:          "queryCoord.balanceOnTransferNode",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		Doc:          "balance the collections of the source and target resource groups right after transferring nodes, instead of waiting for auto balance",
+		Export:       true,
+	}
+	p.BalanceOnTransferNode.Init(base.mgr)
+
+	p.MaxRunningJobs = ParamItem{
+		Key:          "queryCoord.maxRunningJobs",
+		Version:      "2.4.0",
+		DefaultValue: "16",
+		Formatter: func(v string) string {
+			// no job would be scheduled without any running slot
+			if getAsInt(v) <= 0 {
+				return "16"
+			}
+			return v
+		},
+		Doc:    "the max number of load and release jobs of different collections running at the same time, the others wait to be scheduled by priority, must be positive",
+		Export: true,
+	}
+	p.MaxRunningJobs.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
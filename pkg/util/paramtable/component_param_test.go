@@ -279,6 +279,10 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 3000, checkHealthInterval)
 		checkHealthRPCTimeout := Params.CheckHealthRPCTimeout.GetAsInt()
 		assert.Equal(t, 2000, checkHealthRPCTimeout)
+		assert.Equal(t, 16, Params.CheckHealthMaxConcurrency.GetAsInt())
+		assert.Equal(t, 3, Params.CheckHealthRetryAttempts.GetAsInt())
+		assert.Equal(t, 50*time.Millisecond, Params.CheckHealthRetryInterval.GetAsDuration(time.Millisecond))
+		assert.Equal(t, 0, Params.ShardLeaderCacheTTL.GetAsInt())
 
 		assert.Equal(t, 0.1, Params.GlobalRowCountFactor.GetAsFloat())
 		params.Save("queryCoord.globalRowCountFactor", "0.4")
@@ -305,6 +309,45 @@ func TestComponentParam(t *testing.T) {
 		params.Save("queryCoord.gracefulStopTimeout", "100")
 		assert.Equal(t, 100*time.Second, Params.GracefulStopTimeout.GetAsDuration(time.Second))
 		assert.Equal(t, true, Params.EnableStoppingBalance.GetAsBool())
+
+		assert.Equal(t, false, Params.EnableMemoryPressureEviction.GetAsBool())
+		assert.Equal(t, 90.0, Params.MemoryPressureThreshold.GetAsFloat())
+		assert.Equal(t, 30*time.Second, Params.MemoryPressureCheckInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 5, Params.MemoryPressureEvictSegmentStep.GetAsInt())
+		assert.Equal(t, 10*time.Second, Params.AvailabilityCheckInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 24*time.Hour, Params.AvailabilityRetention.GetAsDuration(time.Second))
+		assert.Equal(t, 0, Params.RecoveryLoadConcurrencyPerNode.GetAsInt())
+		assert.Equal(t, 10*time.Minute, Params.RecoveryLoadThrottleDuration.GetAsDuration(time.Second))
+		assert.Equal(t, 1000.0, Params.ReplicaRecommendNodeCapacity.GetAsFloat())
+		assert.Equal(t, 0.7, Params.ReplicaRecommendTargetUtilization.GetAsFloat())
+		assert.Equal(t, time.Minute, Params.NodeLoadSampleInterval.GetAsDuration(time.Second))
+		params.Save("queryCoord.nodeLoadSampleInterval", "0")
+		assert.Equal(t, time.Minute, Params.NodeLoadSampleInterval.GetAsDuration(time.Second))
+		params.Reset("queryCoord.nodeLoadSampleInterval")
+		assert.Equal(t, 24*time.Hour, Params.NodeLoadHistoryRetention.GetAsDuration(time.Second))
+		assert.False(t, Params.EnableCollectionMetricsLabel.GetAsBool())
+		assert.Empty(t, Params.CollectionMetricsLabelAllowList.GetValue())
+		assert.Equal(t, 0.0, Params.LoadMemoryHeadroomRatio.GetAsFloat())
+		assert.Equal(t, 10*time.Second, Params.LoadAdmissionRetryInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 0, Params.LeaderMaxMissingSegmentNum.GetAsInt())
+		assert.Equal(t, 0.0, Params.LeaderMaxMissingSegmentRatio.GetAsFloat())
+		assert.Equal(t, 30*time.Second, Params.LoadStuckCheckInterval.GetAsDuration(time.Second))
+		params.Save("queryCoord.loadStuckCheckInterval", "0")
+		assert.Equal(t, 30*time.Second, Params.LoadStuckCheckInterval.GetAsDuration(time.Second))
+		params.Reset("queryCoord.loadStuckCheckInterval")
+		assert.Equal(t, 5*time.Minute, Params.LoadStuckTimeout.GetAsDuration(time.Second))
+		assert.Equal(t, 3, Params.LoadStuckMaxRetryTimes.GetAsInt())
+		assert.Equal(t, 0, Params.NodeMaxSegmentNum.GetAsInt())
+		assert.Equal(t, int64(0), Params.NodeMaxMemorySize.GetAsInt64())
+		assert.Equal(t, 10*time.Minute, Params.BalanceStatusRetention.GetAsDuration(time.Second))
+		assert.Equal(t, 1, Params.DefaultReplicaNumber.GetAsInt())
+		assert.Equal(t, int64(0), Params.ShardLeaderWaitTimeout.GetAsInt64())
+		assert.Equal(t, int64(0), Params.MetricsCacheTTL.GetAsInt64())
+		assert.False(t, Params.BalanceOnTransferNode.GetAsBool())
+		assert.Equal(t, 16, Params.MaxRunningJobs.GetAsInt())
+		params.Save("queryCoord.maxRunningJobs", "0")
+		assert.Equal(t, 16, Params.MaxRunningJobs.GetAsInt())
+		params.Reset("queryCoord.maxRunningJobs")
 	})
 
 	t.Run("test queryNodeConfig", func(t *testing.T) {